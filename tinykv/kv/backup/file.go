@@ -0,0 +1,203 @@
+package backup
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"hash"
+	"hash/crc32"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/pingcap/errors"
+)
+
+// A backup file keeps the key/value pairs of a range sorted by key, like an SST file without the index and filter
+// blocks since it's only read sequentially. The file starts with fileMagic, each pair is encoded as the uvarint
+// lengths of the key and the value followed by them, and the footer is the number of the pairs and the CRC32 checksum
+// of the bytes before the checksum, both in big endian.
+
+const (
+	fileMagic  = "TKVBAK01"
+	footerSize = 8 + 4
+	// MetaFileName is the name of the file describing the backup files in a backup directory.
+	MetaFileName = "backupmeta"
+)
+
+var ErrCorrupted = errors.New("backup file is corrupted")
+
+// Writer writes a backup file, the file is written to a temporary file and renamed when it's finished, so a backup
+// file is never seen partially.
+type Writer struct {
+	path    string
+	file    *os.File
+	buf     *bufio.Writer
+	crc     hash.Hash32
+	count   uint64
+	lastKey []byte
+}
+
+func NewWriter(path string) (*Writer, error) {
+	file, err := os.Create(path + ".tmp")
+	if err != nil {
+		return nil, err
+	}
+	w := &Writer{
+		path: path,
+		file: file,
+		buf:  bufio.NewWriter(file),
+		crc:  crc32.NewIEEE(),
+	}
+	if err := w.write([]byte(fileMagic)); err != nil {
+		w.Abort()
+		return nil, err
+	}
+	return w, nil
+}
+
+// Add appends a pair to the file, the keys must be added in ascending order.
+func (w *Writer) Add(key, value []byte) error {
+	if w.count > 0 && bytes.Compare(key, w.lastKey) <= 0 {
+		return errors.Errorf("key %v is added after key %v", key, w.lastKey)
+	}
+	var lens [2 * binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(lens[:], uint64(len(key)))
+	n += binary.PutUvarint(lens[n:], uint64(len(value)))
+	for _, b := range [][]byte{lens[:n], key, value} {
+		if err := w.write(b); err != nil {
+			return err
+		}
+	}
+	w.count++
+	w.lastKey = append(w.lastKey[:0], key...)
+	return nil
+}
+
+// Count returns the number of the pairs added.
+func (w *Writer) Count() uint64 {
+	return w.count
+}
+
+// Finish writes the footer and renames the file to its path.
+func (w *Writer) Finish() error {
+	var count [8]byte
+	binary.BigEndian.PutUint64(count[:], w.count)
+	if err := w.write(count[:]); err != nil {
+		w.Abort()
+		return err
+	}
+	var checksum [4]byte
+	binary.BigEndian.PutUint32(checksum[:], w.crc.Sum32())
+	if _, err := w.buf.Write(checksum[:]); err != nil {
+		w.Abort()
+		return err
+	}
+	if err := w.buf.Flush(); err != nil {
+		w.Abort()
+		return err
+	}
+	if err := w.file.Sync(); err != nil {
+		w.Abort()
+		return err
+	}
+	if err := w.file.Close(); err != nil {
+		os.Remove(w.file.Name())
+		return err
+	}
+	return os.Rename(w.file.Name(), w.path)
+}
+
+// Abort removes the unfinished file.
+func (w *Writer) Abort() {
+	w.file.Close()
+	os.Remove(w.file.Name())
+}
+
+func (w *Writer) write(b []byte) error {
+	w.crc.Write(b)
+	_, err := w.buf.Write(b)
+	return err
+}
+
+// ReadFile calls fn with the pairs in a backup file in order. The whole file is verified before fn is called, so fn
+// is not called if the file is corrupted.
+func ReadFile(path string, fn func(key, value []byte) error) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if len(data) < len(fileMagic)+footerSize || string(data[:len(fileMagic)]) != fileMagic {
+		return ErrCorrupted
+	}
+	end := len(data) - 4
+	if crc32.ChecksumIEEE(data[:end]) != binary.BigEndian.Uint32(data[end:]) {
+		return ErrCorrupted
+	}
+	end -= 8
+	count := binary.BigEndian.Uint64(data[end:])
+
+	type pair struct{ key, value []byte }
+	pairs := make([]pair, 0, count)
+	for pos := len(fileMagic); pos < end; {
+		keyLen, n := binary.Uvarint(data[pos:end])
+		if n <= 0 {
+			return ErrCorrupted
+		}
+		pos += n
+		valueLen, n := binary.Uvarint(data[pos:end])
+		if n <= 0 || uint64(end-pos-n) < keyLen+valueLen {
+			return ErrCorrupted
+		}
+		pos += n
+		key := data[pos : pos+int(keyLen)]
+		pos += int(keyLen)
+		pairs = append(pairs, pair{key, data[pos : pos+int(valueLen)]})
+		pos += int(valueLen)
+	}
+	if uint64(len(pairs)) != count {
+		return ErrCorrupted
+	}
+	for _, p := range pairs {
+		if err := fn(p.key, p.value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Meta describes a backup, it's written to MetaFileName in the backup directory after all the files are written.
+type Meta struct {
+	// Version is the timestamp of the backup, the values committed before it are backed up.
+	Version uint64  `json:"version"`
+	Files   []*File `json:"files"`
+}
+
+// File is a backup file of the range [StartKey, EndKey), an empty EndKey means there is no upper bound.
+type File struct {
+	Name     string `json:"name"`
+	StartKey []byte `json:"start_key"`
+	EndKey   []byte `json:"end_key"`
+	KvCount  uint64 `json:"kv_count"`
+}
+
+func WriteMeta(dir string, meta *Meta) error {
+	data, err := json.MarshalIndent(meta, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(dir, MetaFileName), data, 0644)
+}
+
+func ReadMeta(dir string) (*Meta, error) {
+	data, err := ioutil.ReadFile(filepath.Join(dir, MetaFileName))
+	if err != nil {
+		return nil, err
+	}
+	meta := new(Meta)
+	if err := json.Unmarshal(data, meta); err != nil {
+		return nil, err
+	}
+	return meta, nil
+}
@@ -0,0 +1,80 @@
+package backup
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteReadFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "backup")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "1_1.sst")
+
+	w, err := NewWriter(path)
+	require.Nil(t, err)
+	require.Nil(t, w.Add([]byte("a"), []byte("1")))
+	require.Nil(t, w.Add([]byte("b"), []byte{}))
+	assert.NotNil(t, w.Add([]byte("b"), []byte("2")))
+	require.Nil(t, w.Add([]byte("c"), []byte("3")))
+	assert.Equal(t, uint64(3), w.Count())
+	// The file is not seen until it's finished.
+	_, err = os.Stat(path)
+	assert.True(t, os.IsNotExist(err))
+	require.Nil(t, w.Finish())
+
+	var keys, values []string
+	require.Nil(t, ReadFile(path, func(key, value []byte) error {
+		keys = append(keys, string(key))
+		values = append(values, string(value))
+		return nil
+	}))
+	assert.Equal(t, []string{"a", "b", "c"}, keys)
+	assert.Equal(t, []string{"1", "", "3"}, values)
+
+	data, err := ioutil.ReadFile(path)
+	require.Nil(t, err)
+	data[len(fileMagic)+2] ^= 0xff
+	require.Nil(t, ioutil.WriteFile(path, data, 0644))
+	called := false
+	err = ReadFile(path, func(key, value []byte) error {
+		called = true
+		return nil
+	})
+	assert.Equal(t, ErrCorrupted, err)
+	assert.False(t, called)
+}
+
+func TestAbort(t *testing.T) {
+	dir, err := ioutil.TempDir("", "backup")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	w, err := NewWriter(filepath.Join(dir, "1_1.sst"))
+	require.Nil(t, err)
+	require.Nil(t, w.Add([]byte("a"), []byte("1")))
+	w.Abort()
+	files, err := ioutil.ReadDir(dir)
+	require.Nil(t, err)
+	assert.Empty(t, files)
+}
+
+func TestMeta(t *testing.T) {
+	dir, err := ioutil.TempDir("", "backup")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	meta := &Meta{Version: 100, Files: []*File{
+		{Name: "2_1.sst", StartKey: []byte("a"), EndKey: []byte("b"), KvCount: 3},
+		{Name: "3_1.sst", StartKey: []byte("b"), KvCount: 1},
+	}}
+	require.Nil(t, WriteMeta(dir, meta))
+	got, err := ReadMeta(dir)
+	require.Nil(t, err)
+	assert.Equal(t, meta, got)
+}
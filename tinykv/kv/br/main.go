@@ -0,0 +1,278 @@
+// The br tool backs up a consistent snapshot of a TinyKV cluster to backup files and restores them to an empty
+// cluster.
+//
+//	br -scheduler 127.0.0.1:2379 -path /data/backup backup
+//	br -scheduler 127.0.0.1:2379 -path /data/backup restore
+//
+// The backup files are written and read by the stores, so the path must be a directory shared by all the stores and
+// the tool, e.g. a directory on a network file system, or a local directory if they run on one machine. The values
+// committed before a timestamp from the TSO are backed up, and they are restored as the values committed at that
+// timestamp, the TSO of the restored cluster must be after it, which holds for a cluster started after the backup.
+package main
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/kv/backup"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/scheduler_client"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/errorpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/tinykvpb"
+	"github.com/pingcap-incubator/tinykv/scheduler/pkg/logutil"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+var (
+	schedulerAddr = flag.String("scheduler", "127.0.0.1:2379", "scheduler address")
+	backupPath    = flag.String("path", "", "the backup directory shared by the stores")
+	logLevel      = flag.String("loglevel", "info", "the level of log")
+)
+
+const (
+	// scanRegionsLimit is the max number of regions got from the scheduler at a time.
+	scanRegionsLimit = 128
+	// requestTimeout is the timeout of backing up or restoring a region.
+	requestTimeout = 10 * time.Minute
+	// maxRetries is the max number of retries of a range, the range is retried after a region error or a lock.
+	maxRetries    = 20
+	retryInterval = 500 * time.Millisecond
+)
+
+func main() {
+	flag.Parse()
+	log.SetLevel(logutil.StringToZapLogLevel(*logLevel))
+	if *backupPath == "" || flag.NArg() != 1 {
+		fmt.Fprintf(os.Stderr, "usage: %s [flags] backup|restore\n", os.Args[0])
+		flag.PrintDefaults()
+		os.Exit(2)
+	}
+
+	client, err := scheduler_client.NewClient(strings.Split(*schedulerAddr, ","), "")
+	if err != nil {
+		log.Fatal("new scheduler client failed", zap.Error(err))
+	}
+	b := &br{
+		client: client,
+		path:   *backupPath,
+		stores: make(map[uint64]tinykvpb.TinyKvClient),
+	}
+	defer b.close()
+
+	ctx := context.Background()
+	switch flag.Arg(0) {
+	case "backup":
+		err = b.backup(ctx)
+	case "restore":
+		err = b.restore(ctx)
+	default:
+		err = errors.Errorf("unknown command %s", flag.Arg(0))
+	}
+	if err != nil {
+		log.Fatal(flag.Arg(0)+" failed", zap.Error(err))
+	}
+}
+
+type br struct {
+	client scheduler_client.Client
+	path   string
+	stores map[uint64]tinykvpb.TinyKvClient
+	conns  []*grpc.ClientConn
+}
+
+// backup backs up all the regions at a timestamp from the TSO, and writes the meta of the backup after the files.
+func (b *br) backup(ctx context.Context) error {
+	version, err := b.client.GetTS(ctx)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(b.path, 0755); err != nil {
+		return err
+	}
+	meta := &backup.Meta{Version: version}
+	log.Info("start backup", zap.Uint64("version", version), zap.String("path", b.path))
+	err = b.forEachRegion(ctx, nil, nil, func(ctx context.Context, store tinykvpb.TinyKvClient, reqCtx *kvrpcpb.Context,
+		startKey, endKey []byte) (*errorpb.Error, error) {
+		resp, err := store.Backup(ctx, &kvrpcpb.BackupRequest{
+			Context:  reqCtx,
+			StartKey: startKey,
+			EndKey:   endKey,
+			Version:  version,
+			Path:     b.path,
+		})
+		if err != nil {
+			return storeError(err), nil
+		}
+		if resp.RegionError != nil {
+			return resp.RegionError, nil
+		}
+		if locked := resp.Error.GetLocked(); locked != nil {
+			// The lock is resolved by its transaction or the readers after its TTL expires.
+			return &errorpb.Error{Message: fmt.Sprintf("key %q is locked by transaction %d", locked.Key, locked.LockVersion)}, nil
+		}
+		if resp.Error != nil {
+			return nil, errors.New(resp.Error.String())
+		}
+		if resp.FileName != "" {
+			meta.Files = append(meta.Files, &backup.File{
+				Name:     resp.FileName,
+				StartKey: startKey,
+				EndKey:   endKey,
+				KvCount:  resp.KvCount,
+			})
+		}
+		return nil, nil
+	})
+	if err != nil {
+		return err
+	}
+	if err = backup.WriteMeta(b.path, meta); err != nil {
+		return err
+	}
+	log.Info("finish backup", zap.Uint64("version", version), zap.Int("files", len(meta.Files)))
+	return nil
+}
+
+// restore restores the backup files to the regions overlapping their ranges.
+func (b *br) restore(ctx context.Context) error {
+	meta, err := backup.ReadMeta(b.path)
+	if err != nil {
+		return err
+	}
+	log.Info("start restore", zap.Uint64("version", meta.Version), zap.Int("files", len(meta.Files)))
+	for _, file := range meta.Files {
+		var count uint64
+		err = b.forEachRegion(ctx, file.StartKey, file.EndKey, func(ctx context.Context, store tinykvpb.TinyKvClient,
+			reqCtx *kvrpcpb.Context, startKey, endKey []byte) (*errorpb.Error, error) {
+			resp, err := store.Restore(ctx, &kvrpcpb.RestoreRequest{
+				Context:  reqCtx,
+				StartKey: startKey,
+				EndKey:   endKey,
+				Version:  meta.Version,
+				Path:     b.path,
+				FileName: file.Name,
+			})
+			if err != nil {
+				return storeError(err), nil
+			}
+			if resp.RegionError != nil {
+				return resp.RegionError, nil
+			}
+			if resp.Error != "" {
+				return nil, errors.New(resp.Error)
+			}
+			count += resp.KvCount
+			return nil, nil
+		})
+		if err != nil {
+			return errors.Annotatef(err, "restore file %s", file.Name)
+		}
+		if count != file.KvCount {
+			return errors.Errorf("%d keys are restored from file %s, expected %d", count, file.Name, file.KvCount)
+		}
+	}
+	log.Info("finish restore", zap.Uint64("version", meta.Version))
+	return nil
+}
+
+// forEachRegion calls fn with the regions in [startKey, endKey) in the key order, the range passed to fn is clamped
+// to the region. If fn returns a region error, the regions of the remaining range are scanned again later.
+func (b *br) forEachRegion(ctx context.Context, startKey, endKey []byte, fn func(ctx context.Context,
+	store tinykvpb.TinyKvClient, reqCtx *kvrpcpb.Context, startKey, endKey []byte) (*errorpb.Error, error)) error {
+	key := startKey
+	for retries := 0; ; {
+		regions, leaders, err := b.client.ScanRegions(ctx, key, endKey, scanRegionsLimit)
+		if err != nil {
+			return err
+		}
+		if len(regions) == 0 {
+			return errors.Errorf("no region found for key %q", key)
+		}
+		var regionErr *errorpb.Error
+		for i, region := range regions {
+			regionErr, err = b.sendToRegion(ctx, region, leaders[i], key, endKey, fn)
+			if err != nil {
+				return err
+			}
+			if regionErr != nil {
+				break
+			}
+			retries = 0
+			key = region.EndKey
+			if len(key) == 0 || (len(endKey) > 0 && bytes.Compare(key, endKey) >= 0) {
+				return nil
+			}
+		}
+		if regionErr != nil {
+			if retries++; retries > maxRetries {
+				return errors.Errorf("retry key %q too many times, last error %s", key, regionErr)
+			}
+			log.Info("retry region", zap.Binary("key", key), zap.Stringer("error", regionErr))
+			time.Sleep(retryInterval)
+		}
+	}
+}
+
+func (b *br) sendToRegion(ctx context.Context, region *metapb.Region, leader *metapb.Peer, startKey, endKey []byte,
+	fn func(ctx context.Context, store tinykvpb.TinyKvClient, reqCtx *kvrpcpb.Context, startKey, endKey []byte) (*errorpb.Error, error)) (*errorpb.Error, error) {
+	if bytes.Compare(startKey, region.StartKey) < 0 {
+		return &errorpb.Error{Message: fmt.Sprintf("region %d doesn't contain key %q", region.Id, startKey)}, nil
+	}
+	if leader.GetId() == 0 {
+		return &errorpb.Error{Message: fmt.Sprintf("region %d has no leader", region.Id)}, nil
+	}
+	if len(region.EndKey) > 0 && (len(endKey) == 0 || bytes.Compare(region.EndKey, endKey) < 0) {
+		endKey = region.EndKey
+	}
+	store, err := b.getStore(ctx, leader.StoreId)
+	if err != nil {
+		return nil, err
+	}
+	reqCtx := &kvrpcpb.Context{
+		RegionId:    region.Id,
+		RegionEpoch: region.RegionEpoch,
+		Peer:        leader,
+	}
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+	return fn(ctx, store, reqCtx, startKey, endKey)
+}
+
+// storeError converts the error of sending a request to a region error, so the region is retried after its leader
+// moves if the store is down.
+func storeError(err error) *errorpb.Error {
+	return &errorpb.Error{Message: err.Error()}
+}
+
+func (b *br) getStore(ctx context.Context, storeID uint64) (tinykvpb.TinyKvClient, error) {
+	if store, ok := b.stores[storeID]; ok {
+		return store, nil
+	}
+	meta, err := b.client.GetStore(ctx, storeID)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := grpc.Dial(meta.Address, grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+	b.conns = append(b.conns, conn)
+	b.stores[storeID] = tinykvpb.NewTinyKvClient(conn)
+	return b.stores[storeID], nil
+}
+
+func (b *br) close() {
+	for _, conn := range b.conns {
+		conn.Close()
+	}
+	b.client.Close()
+}
@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/pingcap-incubator/tinykv/scheduler/pkg/logutil"
@@ -20,6 +21,14 @@ type Config struct {
 	RaftBaseTickInterval     time.Duration
 	RaftHeartbeatTicks       int
 	RaftElectionTimeoutTicks int
+	// Enable the raft PreVote phase, so a peer which has been partitioned
+	// away can not disrupt the cluster when it rejoins.
+	RaftPreVote bool
+	// The max lease of a leader, reads on the leader are served locally
+	// without a ReadIndex round trip while the lease is valid. It must be
+	// less than the election timeout and needs PreVote to be enabled.
+	// Zero disables the lease.
+	RaftStoreMaxLeaderLease time.Duration
 
 	// Interval to gc unnecessary raft log (ms).
 	RaftLogGCTickInterval time.Duration
@@ -31,12 +40,35 @@ type Config struct {
 	// delay time before deleting a stale peer
 	SchedulerHeartbeatTickInterval      time.Duration
 	SchedulerStoreHeartbeatTickInterval time.Duration
+	// Interval to check whether the merging region can commit the merge or
+	// should roll it back.
+	MergeCheckTickInterval time.Duration
+	// Interval to pull the GC safe point from the scheduler, the regions led
+	// by the store are collected if the safe point advances.
+	GCTickInterval time.Duration
+	// Interval to fetch a ts from the TSO to advance the resolved ts of the
+	// regions led by the store, the followers serve the stale reads at or
+	// before the resolved ts. Zero disables it.
+	ResolvedTsTickInterval time.Duration
 
 	// When region [a,e) size meets regionMaxSize, it will be split into
 	// several regions [a,b), [b,c), [c,d), [d,e). And the size of [a,b),
 	// [b,c), [c,d) will be regionSplitSize (maybe a little larger).
 	RegionMaxSize   uint64
 	RegionSplitSize uint64
+	// When the QPS of a region exceeds regionSplitQPSThreshold, it will be
+	// split into two regions with balanced load. 0 means the region is never
+	// split by load.
+	RegionSplitQPSThreshold uint64
+
+	// Labels of the store, such as zone, rack and host. The scheduler
+	// spreads the replicas of a region across stores with different labels.
+	Labels map[string]string
+
+	// Store the expire time with every raw value, so the raw writes can
+	// carry a TTL. It changes the format of the raw values, so it must not
+	// be changed for a store which has raw data.
+	EnableRawTTL bool
 }
 
 func (c *Config) Validate() error {
@@ -53,9 +85,43 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("election tick must be greater than heartbeat tick.")
 	}
 
+	if c.RaftStoreMaxLeaderLease > 0 {
+		electionTimeout := c.RaftBaseTickInterval * time.Duration(c.RaftElectionTimeoutTicks)
+		if c.RaftStoreMaxLeaderLease >= electionTimeout {
+			return fmt.Errorf("max leader lease %v must be less than election timeout %v.",
+				c.RaftStoreMaxLeaderLease, electionTimeout)
+		}
+		if !c.RaftPreVote {
+			return fmt.Errorf("max leader lease requires raft pre-vote to be enabled.")
+		}
+	}
+
 	return nil
 }
 
+// ParseLabels parses labels in the form of "zone=z1,rack=r1,host=h1".
+func ParseLabels(s string) (map[string]string, error) {
+	labels := make(map[string]string)
+	if len(s) == 0 {
+		return labels, nil
+	}
+	for _, kv := range strings.Split(s, ",") {
+		pair := strings.Split(kv, "=")
+		if len(pair) != 2 {
+			return nil, fmt.Errorf("invalid label %q, it should be in the form of key=value", kv)
+		}
+		key, value := strings.ToLower(strings.TrimSpace(pair[0])), strings.TrimSpace(pair[1])
+		if len(key) == 0 || len(value) == 0 {
+			return nil, fmt.Errorf("invalid label %q, it should be in the form of key=value", kv)
+		}
+		if _, ok := labels[key]; ok {
+			return nil, fmt.Errorf("duplicated label key %q", key)
+		}
+		labels[key] = value
+	}
+	return labels, nil
+}
+
 const (
 	KB uint64 = 1024
 	MB uint64 = 1024 * 1024
@@ -76,8 +142,12 @@ func NewDefaultConfig() *Config {
 		SplitRegionCheckTickInterval:        10 * time.Second,
 		SchedulerHeartbeatTickInterval:      100 * time.Millisecond,
 		SchedulerStoreHeartbeatTickInterval: 10 * time.Second,
+		MergeCheckTickInterval:              10 * time.Second,
+		GCTickInterval:                      10 * time.Second,
+		ResolvedTsTickInterval:              1 * time.Second,
 		RegionMaxSize:                       144 * MB,
 		RegionSplitSize:                     96 * MB,
+		RegionSplitQPSThreshold:             3000,
 		DBPath:                              "/tmp/badger",
 	}
 }
@@ -95,6 +165,9 @@ func NewTestConfig() *Config {
 		SplitRegionCheckTickInterval:        100 * time.Millisecond,
 		SchedulerHeartbeatTickInterval:      100 * time.Millisecond,
 		SchedulerStoreHeartbeatTickInterval: 500 * time.Millisecond,
+		MergeCheckTickInterval:              100 * time.Millisecond,
+		GCTickInterval:                      100 * time.Millisecond,
+		ResolvedTsTickInterval:              100 * time.Millisecond,
 		RegionMaxSize:                       144 * MB,
 		RegionSplitSize:                     96 * MB,
 		DBPath:                              "/tmp/badger",
@@ -30,6 +30,8 @@ var (
 	storeAddr     = flag.String("addr", "", "store address")
 	dbPath        = flag.String("path", "", "directory path of db")
 	logLevel      = flag.String("loglevel", "info", "the level of log")
+	labels        = flag.String("labels", "", "labels of the store, e.g. zone=z1,rack=r1,host=h1")
+	enableRawTTL  = flag.Bool("enable-raw-ttl", false, "store the expire time with the raw values to support TTL")
 )
 
 func main() {
@@ -47,6 +49,14 @@ func main() {
 	if *logLevel != "" {
 		conf.LogLevel = *logLevel
 	}
+	if *labels != "" {
+		storeLabels, err := config.ParseLabels(*labels)
+		if err != nil {
+			log.Fatal("parse labels failed", zap.Error(err))
+		}
+		conf.Labels = storeLabels
+	}
+	conf.EnableRawTTL = *enableRawTTL
 
 	log.SetLevel(logutil.StringToZapLogLevel(conf.LogLevel))
 	log.Info(fmt.Sprintf("Server started with conf %+v", conf))
@@ -65,6 +75,9 @@ func main() {
 		log.Fatal("start failed", zap.Error(err))
 	}
 	server := server.NewServer(storage)
+	if conf.EnableRawTTL {
+		server.EnableRawTTL()
+	}
 
 	var alivePolicy = keepalive.EnforcementPolicy{
 		MinTime:             2 * time.Second, // If a client pings more than once every 2 seconds, terminate the connection
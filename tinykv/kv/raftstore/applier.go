@@ -7,11 +7,14 @@ import (
 	"github.com/Connor1996/badger"
 	"github.com/Connor1996/badger/y"
 	"github.com/pingcap-incubator/tinykv/kv/config"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/cdc"
 	"github.com/pingcap-incubator/tinykv/kv/raftstore/message"
 	"github.com/pingcap-incubator/tinykv/kv/raftstore/meta"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/runner"
 	"github.com/pingcap-incubator/tinykv/kv/raftstore/util"
 	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/eraftpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/errorpb"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_cmdpb"
 	rspb "github.com/pingcap-incubator/tinykv/proto/pkg/raft_serverpb"
@@ -44,6 +47,13 @@ type MsgApplyRefresh struct {
 	region *metapb.Region
 }
 
+// MsgApplyCatchUpLogs asks the applier of the source region of a merge to apply
+// the logs up to the PrepareMerge log for the target region.
+type MsgApplyCatchUpLogs struct {
+	targetRegionID uint64
+	merge          *raft_cmdpb.CommitMergeRequest
+}
+
 type MsgApplyRes struct {
 	regionID     uint64
 	execResults  []execResult
@@ -56,6 +66,10 @@ type execResultChangePeer struct {
 	confChange *eraftpb.ConfChange
 	peer       *metapb.Peer
 	region     *metapb.Region
+	// Only set for a ChangePeerV2 command, changes are the peers actually added
+	// to or removed from the region.
+	confChangeV2 *eraftpb.ConfChangeV2
+	changes      []*raft_cmdpb.ChangePeerRequest
 }
 
 type execResultCompactLog struct {
@@ -68,6 +82,27 @@ type execResultSplitRegion struct {
 	derived *metapb.Region
 }
 
+type execResultPrepareMerge struct {
+	region *metapb.Region
+	state  *rspb.MergeState
+}
+
+type execResultCommitMerge struct {
+	region *metapb.Region
+	source *metapb.Region
+}
+
+// execResultWaitMergeSource is reported when the CommitMerge log waits for the
+// source peer on this store to catch up its logs.
+type execResultWaitMergeSource struct {
+	merge *raft_cmdpb.CommitMergeRequest
+}
+
+type execResultRollbackMerge struct {
+	region *metapb.Region
+	commit uint64
+}
+
 /// Calls the callback of `cmd` when the Region is removed.
 func notifyRegionRemoved(regionID, peerID uint64, cmd pendingCmd) {
 	log.Debug(fmt.Sprintf("region %d is removed, peerID %d, index %d, term %d", regionID, peerID, cmd.index, cmd.term))
@@ -114,14 +149,22 @@ type applier struct {
 	applyState rspb.RaftApplyState
 
 	sizeDiffHint uint64
+
+	// resolver tracks the locks applied to the region, it's shared with the peer.
+	resolver *resolver
+
+	// waitMergeEntries are the committed entries from the CommitMerge log on, they
+	// are applied after the source peer of the merge catches up its logs.
+	waitMergeEntries []eraftpb.Entry
 }
 
 func newApplierFromPeer(peer *peer) *applier {
 	return &applier{
-		tag:    fmt.Sprintf("[region %d] %d", peer.Region().GetId(), peer.PeerId()),
-		id:     peer.PeerId(),
-		term:   peer.Term(),
-		region: peer.Region(),
+		tag:      fmt.Sprintf("[region %d] %d", peer.Region().GetId(), peer.PeerId()),
+		id:       peer.PeerId(),
+		term:     peer.Term(),
+		region:   peer.Region(),
+		resolver: peer.resolver,
 	}
 }
 
@@ -144,6 +187,10 @@ func (a *applier) handleTask(aCtx *applyContext, msg message.Msg) {
 		a.handleApply(aCtx, msg.Data.(*MsgApplyCommitted))
 	case message.MsgTypeApplyRefresh:
 		a.handleRefresh(msg.Data.(*MsgApplyRefresh))
+	case message.MsgTypeApplyCatchUpLogs:
+		a.handleCatchUpLogs(aCtx, msg.Data.(*MsgApplyCatchUpLogs))
+	case message.MsgTypeApplyResumeMerge:
+		a.handleResumeMerge(aCtx)
 	}
 }
 
@@ -160,11 +207,14 @@ func (a *applier) handleRefresh(reg *MsgApplyRefresh) {
 	if cmd := a.pendingCmds.takeConfChange(); cmd != nil {
 		notifyStaleCommand(a.region.Id, a.id, a.term, *cmd)
 	}
+	// The locks are scanned again after the snapshot is applied.
+	a.resolver.reset()
 	*a = applier{
-		tag:    fmt.Sprintf("[region %d] %d", reg.region.Id, reg.id),
-		id:     reg.id,
-		term:   reg.term,
-		region: reg.region,
+		tag:      fmt.Sprintf("[region %d] %d", reg.region.Id, reg.id),
+		id:       reg.id,
+		term:     reg.term,
+		region:   reg.region,
+		resolver: a.resolver,
 	}
 }
 
@@ -174,6 +224,12 @@ func (a *applier) handleApply(aCtx *applyContext, apply *MsgApplyCommitted) {
 		return
 	}
 	a.term = apply.term
+	if len(a.waitMergeEntries) > 0 {
+		// The entries can't be applied before the CommitMerge log.
+		a.waitMergeEntries = append(a.waitMergeEntries, apply.entries...)
+		apply.entries = apply.entries[:0]
+		return
+	}
 	a.handleRaftCommittedEntries(aCtx, apply.entries)
 	apply.entries = apply.entries[:0]
 }
@@ -251,6 +307,9 @@ type applyResultType int
 const (
 	applyResultTypeNone       applyResultType = 0
 	applyResultTypeExecResult applyResultType = 1
+	// The CommitMerge log waits for the source peer to catch up its logs, nothing
+	// of it is applied.
+	applyResultTypeWaitMergeSource applyResultType = 2
 )
 
 type applyResult struct {
@@ -288,22 +347,32 @@ func (c *applyCallback) push(cb *message.Callback, resp *raft_cmdpb.RaftCmdRespo
 type applyContext struct {
 	tag              string
 	notifier         chan<- message.Msg
+	router           *router
 	engines          *engine_util.Engines
 	cbs              []applyCallback
 	applyTaskResList []*MsgApplyRes
+	// peerMsgs are sent to the peers after the changes are written.
+	peerMsgs         []message.Msg
 	execCtx          *applyExecContext
 	wb               *engine_util.WriteBatch
 	lastAppliedIndex uint64
 	committedCount   int
+	// loadStats records the written flow of the regions if it's set.
+	loadStats *runner.LoadStats
+	// cdcObserver observes the applied commands if it's set.
+	cdcObserver *cdc.Observer
 }
 
-func newApplyContext(tag string, engines *engine_util.Engines,
-	notifier chan<- message.Msg, cfg *config.Config) *applyContext {
+func newApplyContext(tag string, engines *engine_util.Engines, router *router, cfg *config.Config,
+	loadStats *runner.LoadStats, cdcObserver *cdc.Observer) *applyContext {
 	return &applyContext{
-		tag:      tag,
-		engines:  engines,
-		notifier: notifier,
-		wb:       new(engine_util.WriteBatch),
+		tag:         tag,
+		engines:     engines,
+		notifier:    router.peerSender,
+		router:      router,
+		wb:          new(engine_util.WriteBatch),
+		loadStats:   loadStats,
+		cdcObserver: cdcObserver,
 	}
 }
 
@@ -376,6 +445,10 @@ func (ac *applyContext) flush() {
 		}
 		ac.applyTaskResList = ac.applyTaskResList[:0]
 	}
+	for _, msg := range ac.peerMsgs {
+		ac.notifier <- msg
+	}
+	ac.peerMsgs = ac.peerMsgs[:0]
 	ac.committedCount = 0
 }
 
@@ -385,6 +458,7 @@ func (a *applier) handleRaftCommittedEntries(aCtx *applyContext, committedEntrie
 		return
 	}
 	aCtx.prepareFor(a)
+	a.resolver.maybeInitialize(aCtx.engines.Kv, a.region, a.applyState.AppliedIndex)
 	aCtx.committedCount += len(committedEntries)
 	// If we send multiple ConfChange commands, only first one will be proposed correctly,
 	// others will be saved as a normal entry with no data, so we must re-propose these
@@ -397,6 +471,11 @@ func (a *applier) handleRaftCommittedEntries(aCtx *applyContext, committedEntrie
 			// This peer is about to be destroyed, skip everything.
 			break
 		}
+		if entry.Index <= a.applyState.AppliedIndex {
+			// The entry has been applied when this peer caught up the logs
+			// for the target region of a merge.
+			continue
+		}
 		expectedIndex := a.applyState.AppliedIndex + 1
 		if expectedIndex != entry.Index {
 			panic(fmt.Sprintf("%s expect index %d, but got %d", a.tag, expectedIndex, entry.Index))
@@ -407,13 +486,23 @@ func (a *applier) handleRaftCommittedEntries(aCtx *applyContext, committedEntrie
 			res = a.handleRaftEntryNormal(aCtx, entry)
 		case eraftpb.EntryType_EntryConfChange:
 			res = a.handleRaftEntryConfChange(aCtx, entry)
+		case eraftpb.EntryType_EntryConfChangeV2:
+			res = a.handleRaftEntryConfChangeV2(aCtx, entry)
 		}
 		switch res.tp {
 		case applyResultTypeNone:
 		case applyResultTypeExecResult:
 			results = append(results, res.data)
+		case applyResultTypeWaitMergeSource:
+			merge := res.data.(*raft_cmdpb.CommitMergeRequest)
+			results = append(results, &execResultWaitMergeSource{merge: merge})
+		}
+		if res.tp == applyResultTypeWaitMergeSource {
+			a.waitMergeEntries = append([]eraftpb.Entry(nil), committedEntries[i:]...)
+			break
 		}
 		aCtx.commit(a)
+		a.resolver.setAppliedIndex(a.applyState.AppliedIndex)
 	}
 	aCtx.finishFor(a, results)
 }
@@ -475,6 +564,34 @@ func (a *applier) handleRaftEntryConfChange(aCtx *applyContext, entry *eraftpb.E
 	}
 }
 
+func (a *applier) handleRaftEntryConfChangeV2(aCtx *applyContext, entry *eraftpb.Entry) applyResult {
+	index := entry.Index
+	term := entry.Term
+	confChange := new(eraftpb.ConfChangeV2)
+	if err := confChange.Unmarshal(entry.Data); err != nil {
+		panic(err)
+	}
+	cmd := new(raft_cmdpb.RaftCmdRequest)
+	if err := cmd.Unmarshal(confChange.Context); err != nil {
+		panic(err)
+	}
+	result := a.processRaftCmd(aCtx, index, term, cmd)
+	switch result.tp {
+	case applyResultTypeNone:
+		// If failed, tell Raft that the `ConfChange` was aborted, an empty
+		// ConfChangeV2 would leave the joint configuration instead.
+		return applyResult{tp: applyResultTypeExecResult, data: &execResultChangePeer{
+			confChange: new(eraftpb.ConfChange),
+		}}
+	case applyResultTypeExecResult:
+		cp := result.data.(*execResultChangePeer)
+		cp.confChangeV2 = confChange
+		return applyResult{tp: applyResultTypeExecResult, data: result.data}
+	default:
+		panic("unreachable")
+	}
+}
+
 func (a *applier) findCallback(index, term uint64, isConfChange bool) *message.Callback {
 	regionID := a.region.Id
 	peerID := a.id
@@ -508,8 +625,12 @@ func (a *applier) processRaftCmd(aCtx *applyContext, index, term uint64, cmd *ra
 	if index == 0 {
 		panic(fmt.Sprintf("%s process raft cmd need a none zero index", a.tag))
 	}
-	isConfChange := GetChangePeerCmd(cmd) != nil
+	isConfChange := GetChangePeerCmd(cmd) != nil || GetChangePeerV2Cmd(cmd) != nil
 	resp, txn, result := a.applyRaftCmd(aCtx, index, term, cmd)
+	if result.tp == applyResultTypeWaitMergeSource {
+		// The callback is kept until the command is applied.
+		return result
+	}
 	log.Debug(fmt.Sprintf("applied command. region_id %d, peer_id %d, index %d", a.region.Id, a.id, index))
 
 	// TODO: if we have exec_result, maybe we should return this callback too. Outer
@@ -536,6 +657,12 @@ func (a *applier) applyRaftCmd(aCtx *applyContext, index, term uint64,
 	aCtx.execCtx = a.newCtx(aCtx.engines, index, term)
 	aCtx.wb.SetSafePoint()
 	resp, txn, applyResult, err := a.execRaftCmd(aCtx, req)
+	if err == nil && applyResult.tp == applyResultTypeWaitMergeSource {
+		// Nothing is written, the command is applied again later.
+		aCtx.wb.RollbackToSafePoint()
+		aCtx.execCtx = nil
+		return nil, nil, applyResult
+	}
 	if err != nil {
 		// clear dirty values.
 		aCtx.wb.RollbackToSafePoint()
@@ -561,9 +688,41 @@ func (a *applier) applyRaftCmd(aCtx *applyContext, index, term uint64,
 			a.region = x.region
 		case *execResultSplitRegion:
 			a.region = x.derived
+		case *execResultPrepareMerge:
+			a.region = x.region
+		case *execResultCommitMerge:
+			a.region = x.region
+		case *execResultRollbackMerge:
+			a.region = x.region
 		default:
 		}
 	}
+	if err == nil {
+		if req.AdminRequest == nil {
+			a.resolver.track(req.Requests)
+		} else if applyResult.tp == applyResultTypeExecResult {
+			switch applyResult.data.(type) {
+			case *execResultSplitRegion, *execResultCommitMerge:
+				// The locks out of the new range are dropped and the locks of the source region are added, they
+				// are scanned again when the next entries are applied.
+				a.resolver.reset()
+			}
+		}
+	}
+	if aCtx.cdcObserver != nil && err == nil {
+		if req.AdminRequest == nil {
+			aCtx.cdcObserver.ObserveCmd(a.region.Id, req.Requests)
+		} else if applyResult.tp == applyResultTypeExecResult {
+			switch applyResult.data.(type) {
+			case *execResultSplitRegion, *execResultPrepareMerge, *execResultCommitMerge:
+				// The range of the region is changed, the consumers subscribe the new regions again.
+				aCtx.cdcObserver.RegionChanged(a.region.Id, &errorpb.Error{
+					Message:       fmt.Sprintf("region %d is changed", a.region.Id),
+					EpochNotMatch: &errorpb.EpochNotMatch{CurrentRegions: []*metapb.Region{a.region}},
+				})
+			}
+		}
+	}
 	return resp, txn, applyResult
 }
 
@@ -601,16 +760,24 @@ func (a *applier) execAdminCmd(aCtx *applyContext, req *raft_cmdpb.RaftCmdReques
 	switch cmdType {
 	case raft_cmdpb.AdminCmdType_ChangePeer:
 		adminResp, result, err = a.execChangePeer(aCtx, adminReq)
+	case raft_cmdpb.AdminCmdType_ChangePeerV2:
+		adminResp, result, err = a.execChangePeerV2(aCtx, adminReq)
 	case raft_cmdpb.AdminCmdType_Split:
 		adminResp, result, err = a.execSplit(aCtx, adminReq)
 	case raft_cmdpb.AdminCmdType_CompactLog:
 		adminResp, result, err = a.execCompactLog(aCtx, adminReq)
+	case raft_cmdpb.AdminCmdType_PrepareMerge:
+		adminResp, result, err = a.execPrepareMerge(aCtx, adminReq)
+	case raft_cmdpb.AdminCmdType_CommitMerge:
+		adminResp, result, err = a.execCommitMerge(aCtx, adminReq)
+	case raft_cmdpb.AdminCmdType_RollbackMerge:
+		adminResp, result, err = a.execRollbackMerge(aCtx, adminReq)
 	case raft_cmdpb.AdminCmdType_TransferLeader:
 		err = errors.New("transfer leader won't execute")
 	case raft_cmdpb.AdminCmdType_InvalidAdmin:
 		err = errors.New("unsupported command type")
 	}
-	if err != nil {
+	if err != nil || result.tp == applyResultTypeWaitMergeSource {
 		return
 	}
 	adminResp.CmdType = cmdType
@@ -636,6 +803,11 @@ func (a *applier) execNormalCmd(aCtx *applyContext, req *raft_cmdpb.RaftCmdReque
 			r, err = a.handleDelete(aCtx, req.GetDelete())
 			resps = append(resps, r)
 			hasWrite = true
+		case raft_cmdpb.CmdType_DeleteRange:
+			var r *raft_cmdpb.Response
+			r, err = a.handleDeleteRange(aCtx, req.GetDeleteRange())
+			resps = append(resps, r)
+			hasWrite = true
 		case raft_cmdpb.CmdType_Get:
 			var r *raft_cmdpb.Response
 			r, err = a.handleGet(aCtx, req.GetGet())
@@ -671,6 +843,9 @@ func (a *applier) handlePut(aCtx *applyContext, req *raft_cmdpb.PutRequest) (*ra
 	} else {
 		aCtx.wb.SetCF(engine_util.CfDefault, key, value)
 	}
+	if aCtx.loadStats != nil {
+		aCtx.loadStats.RecordWrite(a.region.GetId(), uint64(len(key)+len(value)), 1)
+	}
 	return &raft_cmdpb.Response{
 		CmdType: raft_cmdpb.CmdType_Put,
 	}, nil
@@ -687,11 +862,42 @@ func (a *applier) handleDelete(aCtx *applyContext, req *raft_cmdpb.DeleteRequest
 	} else {
 		aCtx.wb.DeleteCF(engine_util.CfDefault, key)
 	}
+	if aCtx.loadStats != nil {
+		aCtx.loadStats.RecordWrite(a.region.GetId(), uint64(len(key)), 1)
+	}
 	return &raft_cmdpb.Response{
 		CmdType: raft_cmdpb.CmdType_Delete,
 	}, nil
 }
 
+// handleDeleteRange deletes the keys in the range of the column family. The keys are read from the engine, so the
+// writes of the same command before it are not deleted.
+func (a *applier) handleDeleteRange(aCtx *applyContext, req *raft_cmdpb.DeleteRangeRequest) (*raft_cmdpb.Response, error) {
+	startKey, endKey := req.GetStartKey(), req.GetEndKey()
+	if err := util.CheckKeyInRegion(startKey, a.region); err != nil {
+		return nil, err
+	}
+	if len(a.region.EndKey) != 0 {
+		if len(endKey) == 0 {
+			return nil, &util.ErrKeyNotInRegion{Key: endKey, Region: a.region}
+		}
+		if err := util.CheckKeyInRegionInclusive(endKey, a.region); err != nil {
+			return nil, err
+		}
+	}
+
+	txn := aCtx.engines.Kv.NewTransaction(false)
+	defer txn.Discard()
+	cf := req.GetCf()
+	if len(cf) == 0 {
+		cf = engine_util.CfDefault
+	}
+	engine_util.DeleteRangeCF(txn, aCtx.wb, cf, startKey, endKey)
+	return &raft_cmdpb.Response{
+		CmdType: raft_cmdpb.CmdType_DeleteRange,
+	}, nil
+}
+
 func (a *applier) handleGet(aCtx *applyContext, req *raft_cmdpb.GetRequest) (*raft_cmdpb.Response, error) {
 	key := req.GetKey()
 	if err := util.CheckKeyInRegion(key, a.region); err != nil {
@@ -735,14 +941,30 @@ func (a *applier) execChangePeer(aCtx *applyContext, req *raft_cmdpb.AdminReques
 	switch changeType {
 	case eraftpb.ConfChangeType_AddNode:
 		if p := util.FindPeer(region, storeID); p != nil {
-			errMsg := fmt.Sprintf("%s can't add duplicated peer, peer %s, region %s",
+			if !p.IsLearner || p.Id != peer.Id {
+				errMsg := fmt.Sprintf("%s can't add duplicated peer, peer %s, region %s",
+					a.tag, p, a.region)
+				log.Error(errMsg)
+				err = errors.New(errMsg)
+				return
+			}
+			// Promote the learner to a voter.
+			p.IsLearner = false
+		} else {
+			region.Peers = append(region.Peers, peer)
+		}
+		log.Info(fmt.Sprintf("%s add peer successfully, peer %s, region %s", a.tag, peer, a.region))
+	case eraftpb.ConfChangeType_AddLearnerNode:
+		if p := util.FindPeer(region, storeID); p != nil {
+			errMsg := fmt.Sprintf("%s can't add duplicated learner, peer %s, region %s",
 				a.tag, p, a.region)
 			log.Error(errMsg)
 			err = errors.New(errMsg)
 			return
 		}
+		peer.IsLearner = true
 		region.Peers = append(region.Peers, peer)
-		log.Info(fmt.Sprintf("%s add peer successfully, peer %s, region %s", a.tag, peer, a.region))
+		log.Info(fmt.Sprintf("%s add learner successfully, peer %s, region %s", a.tag, peer, a.region))
 	case eraftpb.ConfChangeType_RemoveNode:
 		if p := util.RemovePeer(region, storeID); p != nil {
 			if !util.PeerEqual(p, peer) {
@@ -787,6 +1009,131 @@ func (a *applier) execChangePeer(aCtx *applyContext, req *raft_cmdpb.AdminReques
 	return
 }
 
+// execChangePeerV2 enters the joint configuration, the added voters become
+// incoming voters and the removed voters become outgoing voters, while learners
+// are added or removed at once. An empty ChangePeerV2 leaves the joint
+// configuration, the outgoing voters are removed from the region then.
+func (a *applier) execChangePeerV2(aCtx *applyContext, req *raft_cmdpb.AdminRequest) (
+	resp *raft_cmdpb.AdminResponse, result applyResult, err error) {
+	request := req.ChangePeerV2
+	region := new(metapb.Region)
+	err = util.CloneMsg(a.region, region)
+	if err != nil {
+		return
+	}
+	log.Info(fmt.Sprintf("%s exec ConfChangeV2, changes %v, epoch %s", a.tag, request.Changes, region.RegionEpoch))
+
+	var changes []*raft_cmdpb.ChangePeerRequest
+	if len(request.Changes) == 0 {
+		changes, err = a.leaveJoint(region)
+	} else {
+		changes, err = a.enterJoint(region, request.Changes)
+	}
+	if err != nil {
+		log.Error(err.Error())
+		return
+	}
+	for _, change := range changes {
+		if change.ChangeType == eraftpb.ConfChangeType_RemoveNode && change.Peer.Id == a.id {
+			// Remove ourself, we will destroy all region data later.
+			// So we need not to apply following logs.
+			a.pendingRemove = true
+		}
+	}
+
+	state := rspb.PeerState_Normal
+	if a.pendingRemove {
+		state = rspb.PeerState_Tombstone
+	}
+	meta.WriteRegionState(aCtx.wb, region, state)
+	resp = &raft_cmdpb.AdminResponse{
+		ChangePeerV2: &raft_cmdpb.ChangePeerV2Response{
+			Region: region,
+		},
+	}
+	result = applyResult{
+		tp: applyResultTypeExecResult,
+		data: &execResultChangePeer{
+			region:  region,
+			changes: changes,
+		},
+	}
+	return
+}
+
+func (a *applier) enterJoint(region *metapb.Region, requests []*raft_cmdpb.ChangePeerRequest) ([]*raft_cmdpb.ChangePeerRequest, error) {
+	if util.IsInJointState(region) {
+		return nil, fmt.Errorf("%s can't enter joint state from joint state, region %s", a.tag, a.region)
+	}
+	var changes []*raft_cmdpb.ChangePeerRequest
+	for _, request := range requests {
+		peer := request.Peer
+		switch request.ChangeType {
+		case eraftpb.ConfChangeType_AddNode:
+			if p := util.FindPeer(region, peer.StoreId); p != nil {
+				if !p.IsLearner || p.Id != peer.Id {
+					return nil, fmt.Errorf("%s can't add duplicated peer, peer %s, region %s", a.tag, p, a.region)
+				}
+				// Promote the learner to a voter of the incoming configuration.
+				p.IsLearner = false
+				p.JointState = metapb.JointState_IncomingVoter
+			} else {
+				peer.JointState = metapb.JointState_IncomingVoter
+				region.Peers = append(region.Peers, peer)
+			}
+			changes = append(changes, request)
+		case eraftpb.ConfChangeType_AddLearnerNode:
+			if p := util.FindPeer(region, peer.StoreId); p != nil {
+				return nil, fmt.Errorf("%s can't add duplicated learner, peer %s, region %s", a.tag, p, a.region)
+			}
+			peer.IsLearner = true
+			region.Peers = append(region.Peers, peer)
+			changes = append(changes, request)
+		case eraftpb.ConfChangeType_RemoveNode:
+			p := util.FindPeer(region, peer.StoreId)
+			if p == nil || !util.PeerEqual(p, peer) {
+				return nil, fmt.Errorf("%s can't remove missing or unmatched peer, peer %s, region %s", a.tag, peer, a.region)
+			}
+			if !p.IsLearner {
+				// The voter is still a member of the outgoing configuration.
+				p.JointState = metapb.JointState_OutgoingVoter
+				continue
+			}
+			util.RemovePeer(region, peer.StoreId)
+			changes = append(changes, request)
+		}
+	}
+	if !util.IsInJointState(region) {
+		return nil, fmt.Errorf("%s ChangePeerV2 must add or remove at least one voter, region %s", a.tag, a.region)
+	}
+	region.RegionEpoch.ConfVer += uint64(len(requests))
+	log.Info(fmt.Sprintf("%s enter joint state successfully, region %s", a.tag, region))
+	return changes, nil
+}
+
+func (a *applier) leaveJoint(region *metapb.Region) ([]*raft_cmdpb.ChangePeerRequest, error) {
+	if !util.IsInJointState(region) {
+		return nil, fmt.Errorf("%s can't leave joint state when not in joint state, region %s", a.tag, a.region)
+	}
+	var changes []*raft_cmdpb.ChangePeerRequest
+	peers := region.Peers[:0]
+	for _, p := range region.Peers {
+		switch p.JointState {
+		case metapb.JointState_OutgoingVoter:
+			p.JointState = metapb.JointState_Stable
+			changes = append(changes, &raft_cmdpb.ChangePeerRequest{ChangeType: eraftpb.ConfChangeType_RemoveNode, Peer: p})
+			continue
+		case metapb.JointState_IncomingVoter:
+			p.JointState = metapb.JointState_Stable
+		}
+		peers = append(peers, p)
+	}
+	region.Peers = peers
+	region.RegionEpoch.ConfVer++
+	log.Info(fmt.Sprintf("%s leave joint state successfully, region %s", a.tag, region))
+	return changes, nil
+}
+
 func (a *applier) execSplit(aCtx *applyContext, req *raft_cmdpb.AdminRequest) (
 	resp *raft_cmdpb.AdminResponse, result applyResult, err error) {
 	splitReq := req.Split
@@ -891,3 +1238,180 @@ func (a *applier) execCompactLog(aCtx *applyContext, req *raft_cmdpb.AdminReques
 	}}
 	return
 }
+
+func (a *applier) execPrepareMerge(aCtx *applyContext, req *raft_cmdpb.AdminRequest) (
+	resp *raft_cmdpb.AdminResponse, result applyResult, err error) {
+	prepareMerge := req.PrepareMerge
+	// The logs after min index are needed by the lagging peers to catch up.
+	truncatedIndex := aCtx.execCtx.applyState.TruncatedState.Index
+	if prepareMerge.MinIndex < truncatedIndex {
+		err = errors.Errorf("logs after min index %d have been compacted to %d", prepareMerge.MinIndex, truncatedIndex)
+		return
+	}
+	region := new(metapb.Region)
+	if err := util.CloneMsg(a.region, region); err != nil {
+		panic(err)
+	}
+	region.RegionEpoch.Version++
+	region.RegionEpoch.ConfVer++
+	state := &rspb.MergeState{
+		MinIndex: prepareMerge.MinIndex,
+		Target:   prepareMerge.Target,
+		Commit:   aCtx.execCtx.index,
+	}
+	meta.WriteRegionMergeState(aCtx.wb, region, rspb.PeerState_Merging, state)
+	log.Info(fmt.Sprintf("%s prepare to merge into region %s, state %s", a.tag, prepareMerge.Target, state))
+
+	resp = &raft_cmdpb.AdminResponse{
+		PrepareMerge: &raft_cmdpb.PrepareMergeResponse{},
+	}
+	result = applyResult{tp: applyResultTypeExecResult, data: &execResultPrepareMerge{
+		region: region,
+		state:  state,
+	}}
+	return
+}
+
+func (a *applier) execCommitMerge(aCtx *applyContext, req *raft_cmdpb.AdminRequest) (
+	resp *raft_cmdpb.AdminResponse, result applyResult, err error) {
+	commitMerge := req.CommitMerge
+	if !a.mergeSourceReady(aCtx, commitMerge) {
+		result = applyResult{tp: applyResultTypeWaitMergeSource, data: commitMerge}
+		return
+	}
+	sourceState, err1 := meta.GetRegionLocalState(aCtx.engines.Kv, commitMerge.Source.Id)
+	if err1 != nil {
+		panic(err1)
+	}
+	if sourceState.State != rspb.PeerState_Merging || sourceState.MergeState.GetCommit() != commitMerge.Commit {
+		panic(fmt.Sprintf("%s unexpected state of merging region %s", a.tag, sourceState))
+	}
+	source := sourceState.Region
+
+	region := new(metapb.Region)
+	if err := util.CloneMsg(a.region, region); err != nil {
+		panic(err)
+	}
+	// The target region has been checked to be adjacent to the source region when
+	// preparing the merge, and its epoch is checked before applying.
+	if bytes.Equal(source.EndKey, region.StartKey) {
+		region.StartKey = source.StartKey
+	} else if bytes.Equal(source.StartKey, region.EndKey) {
+		region.EndKey = source.EndKey
+	} else {
+		panic(fmt.Sprintf("%s source region %s is not adjacent to region %s", a.tag, source, region))
+	}
+	version := region.RegionEpoch.Version
+	if source.RegionEpoch.Version > version {
+		version = source.RegionEpoch.Version
+	}
+	region.RegionEpoch.Version = version + 1
+	meta.WriteRegionState(aCtx.wb, region, rspb.PeerState_Normal)
+	meta.WriteRegionMergeState(aCtx.wb, source, rspb.PeerState_Tombstone, sourceState.MergeState)
+	log.Info(fmt.Sprintf("%s merge region %s, region %s", a.tag, source, region))
+
+	resp = &raft_cmdpb.AdminResponse{
+		CommitMerge: &raft_cmdpb.CommitMergeResponse{},
+	}
+	result = applyResult{tp: applyResultTypeExecResult, data: &execResultCommitMerge{
+		region: region,
+		source: source,
+	}}
+	return
+}
+
+// mergeSourceReady checks whether the source peer on this store has applied all
+// the logs up to the PrepareMerge log, so the target region takes over the
+// up-to-date data. The source peer may lag behind or not be created yet.
+func (a *applier) mergeSourceReady(aCtx *applyContext, commitMerge *raft_cmdpb.CommitMergeRequest) bool {
+	applyState, err := meta.GetApplyState(aCtx.engines.Kv, commitMerge.Source.Id)
+	if err != nil {
+		log.Info(fmt.Sprintf("%s failed to get apply state of merging region %d: %v",
+			a.tag, commitMerge.Source.Id, err))
+		return false
+	}
+	return applyState.AppliedIndex >= commitMerge.Commit
+}
+
+// handleCatchUpLogs applies all the logs up to the PrepareMerge log for the target
+// region of the merge, and tells the target peer once they are applied. The logs
+// before min index have been replicated to this peer, the others are carried by
+// the CommitMerge request. The target peer asks again if it fails.
+func (a *applier) handleCatchUpLogs(aCtx *applyContext, catchUp *MsgApplyCatchUpLogs) {
+	if a.pendingRemove || len(a.waitMergeEntries) > 0 {
+		return
+	}
+	merge := catchUp.merge
+	applyState, err := meta.GetApplyState(aCtx.engines.Kv, a.region.Id)
+	if err != nil {
+		log.Warn(fmt.Sprintf("%s failed to get apply state: %v", a.tag, err))
+		return
+	}
+	if applyState.AppliedIndex < merge.Commit {
+		carried := make(map[uint64]*eraftpb.Entry, len(merge.Entries))
+		for _, e := range merge.Entries {
+			carried[e.Index] = e
+		}
+		entries := make([]eraftpb.Entry, 0, merge.Commit-applyState.AppliedIndex)
+		for idx := applyState.AppliedIndex + 1; idx <= merge.Commit; idx++ {
+			if e, ok := carried[idx]; ok {
+				entries = append(entries, *e)
+				continue
+			}
+			e, err := meta.GetRaftEntry(aCtx.engines.Raft, a.region.Id, idx)
+			if err != nil {
+				log.Warn(fmt.Sprintf("%s failed to get log %d to catch up for merge: %v", a.tag, idx, err))
+				return
+			}
+			entries = append(entries, *e)
+		}
+		log.Info(fmt.Sprintf("%s catch up logs [%d, %d] for merging into region %d",
+			a.tag, applyState.AppliedIndex+1, merge.Commit, catchUp.targetRegionID))
+		a.handleRaftCommittedEntries(aCtx, entries)
+		if a.applyState.AppliedIndex < merge.Commit {
+			return
+		}
+	}
+	aCtx.peerMsgs = append(aCtx.peerMsgs,
+		message.NewPeerMsg(message.MsgTypeMergeLogsUpToDate, catchUp.targetRegionID, a.region.Id))
+}
+
+// handleResumeMerge applies the entries from the CommitMerge log on after the
+// source peer of the merge catches up its logs.
+func (a *applier) handleResumeMerge(aCtx *applyContext) {
+	entries := a.waitMergeEntries
+	if len(entries) == 0 || a.pendingRemove {
+		return
+	}
+	a.waitMergeEntries = nil
+	a.handleRaftCommittedEntries(aCtx, entries)
+}
+
+func (a *applier) execRollbackMerge(aCtx *applyContext, req *raft_cmdpb.AdminRequest) (
+	resp *raft_cmdpb.AdminResponse, result applyResult, err error) {
+	commit := req.RollbackMerge.Commit
+	state, err1 := meta.GetRegionLocalState(aCtx.engines.Kv, a.region.Id)
+	if err1 != nil {
+		panic(err1)
+	}
+	if state.State != rspb.PeerState_Merging || state.MergeState.GetCommit() != commit {
+		err = errors.Errorf("unexpected state %s to roll back the merge of log %d", state, commit)
+		return
+	}
+	region := new(metapb.Region)
+	if err := util.CloneMsg(a.region, region); err != nil {
+		panic(err)
+	}
+	region.RegionEpoch.Version++
+	meta.WriteRegionState(aCtx.wb, region, rspb.PeerState_Normal)
+	log.Info(fmt.Sprintf("%s roll back the merge of log %d, region %s", a.tag, commit, region))
+
+	resp = &raft_cmdpb.AdminResponse{
+		RollbackMerge: &raft_cmdpb.RollbackMergeResponse{},
+	}
+	result = applyResult{tp: applyResultTypeExecResult, data: &execResultRollbackMerge{
+		region: region,
+		commit: commit,
+	}}
+	return
+}
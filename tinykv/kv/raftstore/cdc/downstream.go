@@ -0,0 +1,48 @@
+package cdc
+
+import (
+	"sync"
+
+	"github.com/pingcap-incubator/tinykv/proto/pkg/cdcpb"
+)
+
+// Downstream is a consumer of the change events, e.g. an EventFeed stream.
+// The events are queued until they are taken so a slow consumer never blocks
+// the apply path, the queue is not bounded, the consumer is expected to take
+// the events as soon as it's notified.
+type Downstream struct {
+	mu     sync.Mutex
+	events []*cdcpb.Event
+	notify chan struct{}
+	// closed is set by Observer.DeregisterAll and guarded by the mutex of the
+	// observer, a closed downstream can't subscribe any region.
+	closed bool
+}
+
+func NewDownstream() *Downstream {
+	return &Downstream{notify: make(chan struct{}, 1)}
+}
+
+// Notify returns a channel which receives a value after events are queued.
+func (d *Downstream) Notify() <-chan struct{} {
+	return d.notify
+}
+
+// Take returns the queued events in the order they are sent.
+func (d *Downstream) Take() []*cdcpb.Event {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	events := d.events
+	d.events = nil
+	return events
+}
+
+func (d *Downstream) send(event *cdcpb.Event) {
+	d.mu.Lock()
+	d.events = append(d.events, event)
+	d.mu.Unlock()
+	select {
+	case d.notify <- struct{}{}:
+	default:
+	}
+}
@@ -0,0 +1,337 @@
+package cdc
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+
+	"github.com/pingcap-incubator/tinykv/kv/transaction/mvcc"
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/cdcpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/errorpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_cmdpb"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+)
+
+// Observer decodes the Percolator writes applied to the subscribed regions
+// into row change events and sends them to the downstreams.
+//
+// The events of a region are sent in the order the commands are applied. A
+// transaction is prewritten before it's committed, so a consumer keeps the
+// PREWRITE rows by start_ts, and outputs the rows of a transaction when the
+// resolved ts of the regions passes its commit_ts.
+type Observer struct {
+	mu sync.Mutex
+	// registrations are the subscriptions of the regions, keyed by region id.
+	registrations map[uint64][]*registration
+}
+
+type registration struct {
+	downstream *Downstream
+	// The changes of the keys in [startKey, endKey) are sent, an empty
+	// endKey means there is no upper bound.
+	startKey []byte
+	endKey   []byte
+	// The committed rows not newer than checkpointTs are not sent.
+	checkpointTs uint64
+	initialized  bool
+	// pending keeps the commands applied before the registration is
+	// initialized, they are sent after the locks of the initial scan.
+	pending [][]*raft_cmdpb.Request
+	// locks are the start ts of the prewrite locks in the region, the
+	// resolved ts doesn't pass them until they are committed or rolled back.
+	locks      map[string]uint64
+	resolvedTs uint64
+}
+
+func NewObserver() *Observer {
+	return &Observer{registrations: make(map[uint64][]*registration)}
+}
+
+// Register subscribes the changes of a range in a region for the downstream.
+// The commands applied after that are kept until Initialize is called with
+// the locks read from a snapshot taken after the registration.
+func (o *Observer) Register(regionID uint64, downstream *Downstream, startKey, endKey []byte, checkpointTs uint64) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if downstream.closed {
+		return errors.New("the downstream is closed")
+	}
+	if o.find(regionID, downstream) != nil {
+		return errors.Errorf("region %d is already subscribed", regionID)
+	}
+	o.registrations[regionID] = append(o.registrations[regionID], &registration{
+		downstream:   downstream,
+		startKey:     startKey,
+		endKey:       endKey,
+		checkpointTs: checkpointTs,
+		locks:        make(map[string]uint64),
+	})
+	return nil
+}
+
+// Initialize sends the PREWRITE rows of the locks in the region followed by
+// an INITIALIZED row, then the changes kept since the registration. The kept
+// commands may have been applied before the snapshot of the locks is taken,
+// so a consumer may receive a PREWRITE row twice.
+func (o *Observer) Initialize(regionID uint64, downstream *Downstream, lockRows []*cdcpb.Event_Row) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	r := o.find(regionID, downstream)
+	if r == nil || r.initialized {
+		// The region is deregistered in the meantime.
+		return
+	}
+	rows := append([]*cdcpb.Event_Row{}, lockRows...)
+	for _, row := range lockRows {
+		r.locks[string(row.Key)] = row.StartTs
+	}
+	rows = append(rows, &cdcpb.Event_Row{Type: cdcpb.Event_Row_INITIALIZED})
+	for _, reqs := range r.pending {
+		rows = append(rows, r.decode(reqs)...)
+	}
+	r.pending = nil
+	r.initialized = true
+	downstream.send(&cdcpb.Event{RegionId: regionID, Rows: rows})
+}
+
+// Deregister stops sending the changes of a region to the downstream, the
+// error is sent to the downstream if it's set.
+func (o *Observer) Deregister(regionID uint64, downstream *Downstream, err *errorpb.Error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	regs := o.registrations[regionID]
+	for i, r := range regs {
+		if r.downstream == downstream {
+			o.remove(regionID, i)
+			if err != nil {
+				downstream.send(&cdcpb.Event{RegionId: regionID, Error: err})
+			}
+			return
+		}
+	}
+}
+
+// DeregisterAll stops sending the changes of all the regions to the
+// downstream and closes it.
+func (o *Observer) DeregisterAll(downstream *Downstream) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	downstream.closed = true
+	for regionID, regs := range o.registrations {
+		for i, r := range regs {
+			if r.downstream == downstream {
+				o.remove(regionID, i)
+				break
+			}
+		}
+	}
+}
+
+// RegionChanged is called when the range of a region is changed or the peer
+// is destroyed, the subscriptions of the region are removed and the error is
+// sent to the downstreams, which subscribe the new regions again.
+func (o *Observer) RegionChanged(regionID uint64, err *errorpb.Error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	for _, r := range o.registrations[regionID] {
+		r.downstream.send(&cdcpb.Event{RegionId: regionID, Error: err})
+	}
+	delete(o.registrations, regionID)
+}
+
+// Observed returns whether the region is subscribed by any downstream.
+func (o *Observer) Observed(regionID uint64) bool {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return len(o.registrations[regionID]) > 0
+}
+
+// ObserveCmd is called after the requests of a normal command are applied to
+// the region.
+func (o *Observer) ObserveCmd(regionID uint64, reqs []*raft_cmdpb.Request) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	for _, r := range o.registrations[regionID] {
+		if !r.initialized {
+			r.pending = append(r.pending, reqs)
+			continue
+		}
+		if rows := r.decode(reqs); len(rows) > 0 {
+			r.downstream.send(&cdcpb.Event{RegionId: regionID, Rows: rows})
+		}
+	}
+}
+
+// Resolve advances the resolved ts of the regions subscribed by the
+// downstream to ts, a timestamp from the TSO, or the smallest start ts of the
+// locks in the region, and sends the ones which advance. No transaction
+// commits at or before the resolved ts of a region after it's sent, so ts
+// must not be less than the max ts of the storage.
+func (o *Observer) Resolve(downstream *Downstream, ts uint64) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	for regionID, regs := range o.registrations {
+		for _, r := range regs {
+			if r.downstream != downstream || !r.initialized {
+				continue
+			}
+			resolvedTs := ts
+			for _, lockTs := range r.locks {
+				if lockTs < resolvedTs {
+					resolvedTs = lockTs
+				}
+			}
+			if resolvedTs > r.resolvedTs {
+				r.resolvedTs = resolvedTs
+				downstream.send(&cdcpb.Event{RegionId: regionID, ResolvedTs: resolvedTs})
+			}
+		}
+	}
+}
+
+func (o *Observer) find(regionID uint64, downstream *Downstream) *registration {
+	for _, r := range o.registrations[regionID] {
+		if r.downstream == downstream {
+			return r
+		}
+	}
+	return nil
+}
+
+func (o *Observer) remove(regionID uint64, i int) {
+	regs := o.registrations[regionID]
+	regs = append(regs[:i:i], regs[i+1:]...)
+	if len(regs) == 0 {
+		delete(o.registrations, regionID)
+		return
+	}
+	o.registrations[regionID] = regs
+}
+
+// decode decodes the rows of the Percolator writes in a command and tracks
+// the locks. The writes of a transaction command are in one raft command, so
+// the value of a prewrite is put to the default CF together with the lock,
+// and a commit deletes the lock together with the write, a write without a
+// lock deleted is committed by one-phase commit.
+func (r *registration) decode(reqs []*raft_cmdpb.Request) []*cdcpb.Event_Row {
+	values := make(map[string][]byte)
+	unlocked := make(map[string]bool)
+	for _, req := range reqs {
+		switch req.CmdType {
+		case raft_cmdpb.CmdType_Put:
+			if req.Put.Cf == engine_util.CfDefault {
+				values[string(req.Put.Key)] = req.Put.Value
+			}
+		case raft_cmdpb.CmdType_Delete:
+			if req.Delete.Cf == engine_util.CfLock {
+				unlocked[string(req.Delete.Key)] = true
+			}
+		}
+	}
+
+	var rows []*cdcpb.Event_Row
+	for _, req := range reqs {
+		switch req.CmdType {
+		case raft_cmdpb.CmdType_Put:
+			var row *cdcpb.Event_Row
+			var err error
+			switch req.Put.Cf {
+			case engine_util.CfLock:
+				row, err = r.decodeLock(req.Put.Key, req.Put.Value, values)
+			case engine_util.CfWrite:
+				row, err = r.decodeWrite(req.Put.Key, req.Put.Value, values, unlocked)
+			}
+			if err != nil {
+				log.Warn(fmt.Sprintf("cdc failed to decode %s key %v, err %v", req.Put.Cf, req.Put.Key, err))
+				continue
+			}
+			if row != nil {
+				rows = append(rows, row)
+			}
+		case raft_cmdpb.CmdType_Delete:
+			if req.Delete.Cf == engine_util.CfLock {
+				delete(r.locks, string(req.Delete.Key))
+			}
+		}
+	}
+	return rows
+}
+
+func (r *registration) decodeLock(key, value []byte, values map[string][]byte) (*cdcpb.Event_Row, error) {
+	if !r.contains(key) {
+		return nil, nil
+	}
+	lock, err := mvcc.ParseLock(value)
+	if err != nil {
+		return nil, err
+	}
+	if lock.Kind == mvcc.WriteKindPessimisticLock {
+		// A pessimistic lock doesn't write anything until it's prewritten.
+		return nil, nil
+	}
+	r.locks[string(key)] = lock.Ts
+	return PrewriteRow(key, lock, values[string(mvcc.EncodeKey(key, lock.Ts))]), nil
+}
+
+func (r *registration) decodeWrite(encodedKey, value []byte, values map[string][]byte, unlocked map[string]bool) (*cdcpb.Event_Row, error) {
+	write, err := mvcc.ParseWrite(value)
+	if err != nil {
+		return nil, err
+	}
+	key := mvcc.DecodeUserKey(encodedKey)
+	if !r.contains(key) {
+		return nil, nil
+	}
+	if write.Kind == mvcc.WriteKindRollback {
+		return &cdcpb.Event_Row{Type: cdcpb.Event_Row_ROLLBACK, Key: key, StartTs: write.StartTS}, nil
+	}
+	commitTs := mvcc.DecodeTimestamp(encodedKey)
+	if commitTs <= r.checkpointTs {
+		return nil, nil
+	}
+	row := &cdcpb.Event_Row{
+		Type:     cdcpb.Event_Row_COMMIT,
+		OpType:   opType(write.Kind),
+		Key:      key,
+		StartTs:  write.StartTS,
+		CommitTs: commitTs,
+	}
+	if !unlocked[string(key)] {
+		row.Type = cdcpb.Event_Row_COMMITTED
+		if write.Kind == mvcc.WriteKindPut {
+			row.Value = values[string(mvcc.EncodeKey(key, write.StartTS))]
+		}
+	}
+	return row, nil
+}
+
+func (r *registration) contains(key []byte) bool {
+	return bytes.Compare(key, r.startKey) >= 0 && (len(r.endKey) == 0 || bytes.Compare(key, r.endKey) < 0)
+}
+
+// PrewriteRow returns the PREWRITE row of a lock, value is the value
+// prewritten with the lock if it's a put.
+func PrewriteRow(key []byte, lock *mvcc.Lock, value []byte) *cdcpb.Event_Row {
+	row := &cdcpb.Event_Row{
+		Type:    cdcpb.Event_Row_PREWRITE,
+		OpType:  opType(lock.Kind),
+		Key:     key,
+		StartTs: lock.Ts,
+	}
+	if lock.Kind == mvcc.WriteKindPut {
+		row.Value = value
+	}
+	return row
+}
+
+func opType(kind mvcc.WriteKind) cdcpb.Event_Row_OpType {
+	switch kind {
+	case mvcc.WriteKindPut:
+		return cdcpb.Event_Row_PUT
+	case mvcc.WriteKindDelete:
+		return cdcpb.Event_Row_DELETE
+	}
+	return cdcpb.Event_Row_UNKNOWN_OP
+}
@@ -0,0 +1,169 @@
+package cdc
+
+import (
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/kv/transaction/mvcc"
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/cdcpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/errorpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_cmdpb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testRegionID = 1
+
+func putReq(cf string, key, value []byte) *raft_cmdpb.Request {
+	return &raft_cmdpb.Request{
+		CmdType: raft_cmdpb.CmdType_Put,
+		Put:     &raft_cmdpb.PutRequest{Cf: cf, Key: key, Value: value},
+	}
+}
+
+func deleteReq(cf string, key []byte) *raft_cmdpb.Request {
+	return &raft_cmdpb.Request{
+		CmdType: raft_cmdpb.CmdType_Delete,
+		Delete:  &raft_cmdpb.DeleteRequest{Cf: cf, Key: key},
+	}
+}
+
+func prewriteReqs(key, value []byte, startTs uint64) []*raft_cmdpb.Request {
+	lock := &mvcc.Lock{Primary: key, Ts: startTs, Ttl: 10, Kind: mvcc.WriteKindPut}
+	return []*raft_cmdpb.Request{
+		putReq(engine_util.CfDefault, mvcc.EncodeKey(key, startTs), value),
+		putReq(engine_util.CfLock, key, lock.ToBytes()),
+	}
+}
+
+func commitReqs(key []byte, startTs, commitTs uint64) []*raft_cmdpb.Request {
+	write := &mvcc.Write{StartTS: startTs, Kind: mvcc.WriteKindPut}
+	return []*raft_cmdpb.Request{
+		putReq(engine_util.CfWrite, mvcc.EncodeKey(key, commitTs), write.ToBytes()),
+		deleteReq(engine_util.CfLock, key),
+	}
+}
+
+func takeRows(t *testing.T, ds *Downstream) []*cdcpb.Event_Row {
+	var rows []*cdcpb.Event_Row
+	for _, event := range ds.Take() {
+		require.Nil(t, event.Error)
+		rows = append(rows, event.Rows...)
+	}
+	return rows
+}
+
+func TestObserveTwoPhaseCommit(t *testing.T) {
+	o := NewObserver()
+	ds := NewDownstream()
+	require.Nil(t, o.Register(testRegionID, ds, nil, nil, 0))
+	o.Initialize(testRegionID, ds, nil)
+	assert.Equal(t, []*cdcpb.Event_Row{{Type: cdcpb.Event_Row_INITIALIZED}}, takeRows(t, ds))
+
+	o.ObserveCmd(testRegionID, prewriteReqs([]byte("a"), []byte("v"), 10))
+	assert.Equal(t, []*cdcpb.Event_Row{{
+		Type: cdcpb.Event_Row_PREWRITE, OpType: cdcpb.Event_Row_PUT, Key: []byte("a"), Value: []byte("v"), StartTs: 10,
+	}}, takeRows(t, ds))
+
+	// The resolved ts doesn't pass the lock.
+	o.Resolve(ds, 20)
+	events := ds.Take()
+	require.Len(t, events, 1)
+	assert.Equal(t, uint64(10), events[0].ResolvedTs)
+
+	o.ObserveCmd(testRegionID, commitReqs([]byte("a"), 10, 15))
+	assert.Equal(t, []*cdcpb.Event_Row{{
+		Type: cdcpb.Event_Row_COMMIT, OpType: cdcpb.Event_Row_PUT, Key: []byte("a"), StartTs: 10, CommitTs: 15,
+	}}, takeRows(t, ds))
+
+	o.Resolve(ds, 20)
+	events = ds.Take()
+	require.Len(t, events, 1)
+	assert.Equal(t, uint64(20), events[0].ResolvedTs)
+	// The resolved ts is only sent when it advances.
+	o.Resolve(ds, 20)
+	assert.Empty(t, ds.Take())
+}
+
+func TestObserveOnePhaseCommitAndRollback(t *testing.T) {
+	o := NewObserver()
+	ds := NewDownstream()
+	require.Nil(t, o.Register(testRegionID, ds, []byte("a"), []byte("c"), 0))
+	o.Initialize(testRegionID, ds, nil)
+	ds.Take()
+
+	write := &mvcc.Write{StartTS: 10, Kind: mvcc.WriteKindPut}
+	o.ObserveCmd(testRegionID, []*raft_cmdpb.Request{
+		putReq(engine_util.CfDefault, mvcc.EncodeKey([]byte("b"), 10), []byte("v")),
+		putReq(engine_util.CfWrite, mvcc.EncodeKey([]byte("b"), 11), write.ToBytes()),
+		// The key is out of the subscribed range.
+		putReq(engine_util.CfDefault, mvcc.EncodeKey([]byte("c"), 10), []byte("v")),
+		putReq(engine_util.CfWrite, mvcc.EncodeKey([]byte("c"), 11), write.ToBytes()),
+	})
+	assert.Equal(t, []*cdcpb.Event_Row{{
+		Type: cdcpb.Event_Row_COMMITTED, OpType: cdcpb.Event_Row_PUT, Key: []byte("b"), Value: []byte("v"),
+		StartTs: 10, CommitTs: 11,
+	}}, takeRows(t, ds))
+
+	o.ObserveCmd(testRegionID, prewriteReqs([]byte("a"), []byte("v"), 20))
+	ds.Take()
+	rollback := &mvcc.Write{StartTS: 20, Kind: mvcc.WriteKindRollback}
+	o.ObserveCmd(testRegionID, []*raft_cmdpb.Request{
+		deleteReq(engine_util.CfDefault, mvcc.EncodeKey([]byte("a"), 20)),
+		putReq(engine_util.CfWrite, mvcc.EncodeKey([]byte("a"), 20), rollback.ToBytes()),
+		deleteReq(engine_util.CfLock, []byte("a")),
+	})
+	assert.Equal(t, []*cdcpb.Event_Row{{Type: cdcpb.Event_Row_ROLLBACK, Key: []byte("a"), StartTs: 20}}, takeRows(t, ds))
+	o.Resolve(ds, 30)
+	events := ds.Take()
+	require.Len(t, events, 1)
+	assert.Equal(t, uint64(30), events[0].ResolvedTs)
+}
+
+func TestInitializeReplaysPendingCmds(t *testing.T) {
+	o := NewObserver()
+	ds := NewDownstream()
+	require.Nil(t, o.Register(testRegionID, ds, nil, nil, 12))
+	assert.NotNil(t, o.Register(testRegionID, ds, nil, nil, 12))
+
+	// Applied before the registration is initialized.
+	o.ObserveCmd(testRegionID, commitReqs([]byte("a"), 10, 11))
+	o.ObserveCmd(testRegionID, commitReqs([]byte("b"), 10, 13))
+	o.Resolve(ds, 20)
+	assert.Empty(t, ds.Take())
+
+	lock := &mvcc.Lock{Primary: []byte("c"), Ts: 14, Kind: mvcc.WriteKindDelete}
+	o.Initialize(testRegionID, ds, []*cdcpb.Event_Row{PrewriteRow([]byte("c"), lock, nil)})
+	assert.Equal(t, []*cdcpb.Event_Row{
+		{Type: cdcpb.Event_Row_PREWRITE, OpType: cdcpb.Event_Row_DELETE, Key: []byte("c"), StartTs: 14},
+		{Type: cdcpb.Event_Row_INITIALIZED},
+		// The commit of a is not newer than the checkpoint ts.
+		{Type: cdcpb.Event_Row_COMMIT, OpType: cdcpb.Event_Row_PUT, Key: []byte("b"), StartTs: 10, CommitTs: 13},
+	}, takeRows(t, ds))
+
+	o.Resolve(ds, 20)
+	events := ds.Take()
+	require.Len(t, events, 1)
+	assert.Equal(t, uint64(14), events[0].ResolvedTs)
+}
+
+func TestRegionChanged(t *testing.T) {
+	o := NewObserver()
+	ds := NewDownstream()
+	require.Nil(t, o.Register(testRegionID, ds, nil, nil, 0))
+	o.Initialize(testRegionID, ds, nil)
+	ds.Take()
+	assert.True(t, o.Observed(testRegionID))
+
+	o.RegionChanged(testRegionID, &errorpb.Error{RegionNotFound: &errorpb.RegionNotFound{RegionId: testRegionID}})
+	events := ds.Take()
+	require.Len(t, events, 1)
+	assert.NotNil(t, events[0].Error.RegionNotFound)
+	assert.False(t, o.Observed(testRegionID))
+	o.ObserveCmd(testRegionID, prewriteReqs([]byte("a"), []byte("v"), 10))
+	assert.Empty(t, ds.Take())
+
+	// A closed downstream can't subscribe again.
+	o.DeregisterAll(ds)
+	assert.NotNil(t, o.Register(testRegionID, ds, nil, nil, 0))
+}
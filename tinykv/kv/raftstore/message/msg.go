@@ -32,6 +32,18 @@ const (
 	MsgTypeGcSnap MsgType = 7
 	// message of apply result from apply worker
 	MsgTypeApplyRes MsgType = 8
+	// message to destroy the source peer of a merge
+	// it is sent by the target peer after the merge is committed
+	MsgTypeMergeResult MsgType = 9
+	// message to advance the resolved ts of the leader with a ts from the TSO
+	// it is sent by the resolved ts worker
+	MsgTypeAdvanceResolvedTs MsgType = 10
+	// message to ask the source peer of a merge to catch up its logs
+	// it is sent by the target peer whose CommitMerge log waits for the source peer
+	MsgTypeMergeCatchUpLogs MsgType = 11
+	// message to tell the target peer of a merge that the source peer has caught up its logs
+	// it is sent by the apply worker of the source peer
+	MsgTypeMergeLogsUpToDate MsgType = 12
 
 	// message wraps a raft message to the peer not existing on the Store.
 	// It is due to region split or add peer conf change
@@ -47,6 +59,10 @@ const (
 	MsgTypeApplyRefresh MsgType = 302
 	// message to send proposal's callback to apply worker
 	MsgTypeApplyProposal MsgType = 303
+	// message to apply the logs of the source peer of a merge up to the PrepareMerge log
+	MsgTypeApplyCatchUpLogs MsgType = 304
+	// message to apply the CommitMerge log again after the source peer catches up its logs
+	MsgTypeApplyResumeMerge MsgType = 305
 )
 
 type Msg struct {
@@ -72,6 +88,17 @@ type MsgRaftCmd struct {
 	Callback *Callback
 }
 
+type MsgMergeResult struct {
+	// The target region which the source region has been merged into.
+	Target *metapb.Region
+}
+
+type MsgMergeCatchUpLogs struct {
+	// The target region which waits for the source peer to catch up its logs.
+	Target uint64
+	Merge  *raft_cmdpb.CommitMergeRequest
+}
+
 type MsgSplitRegion struct {
 	RegionEpoch *metapb.RegionEpoch
 	SplitKey    []byte
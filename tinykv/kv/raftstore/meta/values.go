@@ -92,8 +92,15 @@ func InitApplyState(kvEngine *badger.DB, region *metapb.Region) (*rspb.RaftApply
 }
 
 func WriteRegionState(kvWB *engine_util.WriteBatch, region *metapb.Region, state rspb.PeerState) {
+	WriteRegionMergeState(kvWB, region, state, nil)
+}
+
+// WriteRegionMergeState writes the region state along with the state of the merge
+// the region is involved in.
+func WriteRegionMergeState(kvWB *engine_util.WriteBatch, region *metapb.Region, state rspb.PeerState, mergeState *rspb.MergeState) {
 	regionState := new(rspb.RegionLocalState)
 	regionState.State = state
 	regionState.Region = region
+	regionState.MergeState = mergeState
 	kvWB.SetMeta(RegionStateKey(region.Id), regionState)
 }
@@ -3,6 +3,7 @@ package raftstore
 import (
 	"context"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/Connor1996/badger"
@@ -33,6 +34,7 @@ func NewNode(system *Raftstore, cfg *config.Config, schedulerClient scheduler_cl
 		clusterID: schedulerClient.GetClusterID((context.TODO())),
 		store: &metapb.Store{
 			Address: cfg.StoreAddr,
+			Labels:  storeLabels(cfg.Labels),
 		},
 		cfg:             cfg,
 		system:          system,
@@ -40,6 +42,18 @@ func NewNode(system *Raftstore, cfg *config.Config, schedulerClient scheduler_cl
 	}
 }
 
+// storeLabels converts the configured labels to store labels sorted by key.
+func storeLabels(labels map[string]string) []*metapb.StoreLabel {
+	storeLabels := make([]*metapb.StoreLabel, 0, len(labels))
+	for k, v := range labels {
+		storeLabels = append(storeLabels, &metapb.StoreLabel{Key: k, Value: v})
+	}
+	sort.Slice(storeLabels, func(i, j int) bool {
+		return storeLabels[i].Key < storeLabels[j].Key
+	})
+	return storeLabels
+}
+
 func (n *Node) Start(ctx context.Context, engines *engine_util.Engines, trans Transport, snapMgr *snap.SnapManager) error {
 	storeID, err := n.checkStore(engines)
 	if err != nil {
@@ -1,6 +1,7 @@
 package raftstore
 
 import (
+	"bytes"
 	"fmt"
 	"time"
 
@@ -110,6 +111,20 @@ type peer struct {
 
 	// Index of last scheduled compacted raft log.
 	LastCompactedIdx uint64
+
+	// The lease of the leader, reads are served locally while it's valid.
+	leaderLease *Lease
+	// Record the read-only commands waiting for the read index.
+	pendingReads readIndexQueue
+	// Track the resolved ts of the peer, it's shared with the applier.
+	resolver *resolver
+
+	// The state of the merge after the PrepareMerge command is applied, the peer
+	// refuses to propose any command except RollbackMerge until the merge is done.
+	pendingMergeState *rspb.MergeState
+	// The CommitMerge request whose source peer on this store is lagging behind,
+	// it's sent to the source peer until the source peer catches up its logs.
+	waitMergeSource *raft_cmdpb.CommitMergeRequest
 }
 
 func NewPeer(storeId uint64, cfg *config.Config, engines *engine_util.Engines, region *metapb.Region, regionSched chan<- worker.Task,
@@ -132,6 +147,7 @@ func NewPeer(storeId uint64, cfg *config.Config, engines *engine_util.Engines, r
 		HeartbeatTick: cfg.RaftHeartbeatTicks,
 		Applied:       appliedIndex,
 		Storage:       ps,
+		PreVote:       cfg.RaftPreVote,
 	}
 
 	raftGroup, err := raft.NewRawNode(raftCfg)
@@ -148,6 +164,8 @@ func NewPeer(storeId uint64, cfg *config.Config, engines *engine_util.Engines, r
 		Tag:                   tag,
 		LastApplyingIdx:       appliedIndex,
 		ticker:                newTicker(region.GetId(), cfg),
+		leaderLease:           NewLease(cfg.RaftStoreMaxLeaderLease),
+		resolver:              newResolver(),
 	}
 
 	// If this region has only one peer and I am the one, campaign directly.
@@ -229,6 +247,10 @@ func (p *peer) Destroy(engine *engine_util.Engines, keepData bool) error {
 		NotifyReqRegionRemoved(region.Id, proposal.cb)
 	}
 	p.applyProposals = nil
+	for _, read := range p.pendingReads.reads {
+		NotifyReqRegionRemoved(region.Id, read.cb)
+	}
+	p.pendingReads.reads = nil
 
 	log.Info(fmt.Sprintf("%v destroy itself, takes %v", p.Tag, time.Now().Sub(start)))
 	return nil
@@ -390,6 +412,11 @@ func (p *peer) HandleRaftReady(msgs []message.Msg, pdScheduler chan<- worker.Tas
 	if ss != nil && ss.RaftState == raft.StateLeader {
 		p.HeartbeatScheduler(pdScheduler)
 	}
+	if ss != nil && ss.RaftState != raft.StateLeader {
+		p.leaderLease.Expire()
+		p.clearPendingReads()
+	}
+	p.handleReadStates(ready.ReadStates)
 
 	applySnapResult, err := p.peerStorage.SaveReadyState(&ready)
 	if err != nil {
@@ -423,6 +450,10 @@ func (p *peer) HandleRaftReady(msgs []message.Msg, pdScheduler chan<- worker.Tas
 		}
 	}
 
+	// Serve the reads whose read index has been applied already, the others
+	// are served after the committed entries are applied.
+	p.serveReads()
+
 	// YOUR CODE HERE (lab1). There are some missing code pars marked with `Hint` above, try to finish them.
 	// Hint2: Try to advance the states in the raft group of this peer after processing the raft ready.
 	//        Check about the `Advance` method in for the raft group.
@@ -458,6 +489,7 @@ func (p *peer) HeartbeatScheduler(ch chan<- worker.Task) {
 		Peer:            p.Meta,
 		PendingPeers:    p.CollectPendingPeers(),
 		ApproximateSize: p.ApproximateSize,
+		ResolvedTs:      p.resolver.state().ResolvedTs,
 	}
 }
 
@@ -492,6 +524,10 @@ func (p *peer) sendRaftMessage(msg eraftpb.Message, trans Transport) error {
 		sendMsg.StartKey = append([]byte{}, p.Region().StartKey...)
 		sendMsg.EndKey = append([]byte{}, p.Region().EndKey...)
 	}
+	if msg.MsgType == eraftpb.MessageType_MsgHeartbeat {
+		state := p.resolver.state()
+		sendMsg.ResolvedTs, sendMsg.ResolvedIndex = state.ResolvedTs, state.ResolvedIndex
+	}
 	sendMsg.Message = &msg
 	return trans.Send(sendMsg)
 }
@@ -506,6 +542,12 @@ func (p *peer) Propose(kv *badger.DB, cfg *config.Config, cb *message.Callback,
 
 	isConfChange := false
 
+	if p.pendingMergeState != nil && req.GetAdminRequest().GetCmdType() != raft_cmdpb.AdminCmdType_RollbackMerge {
+		BindRespError(errResp, &util.ErrProposalInMergingMode{RegionId: p.regionId})
+		cb.Done(errResp)
+		return false
+	}
+
 	policy, err := p.inspect(req)
 	if err != nil {
 		BindRespError(errResp, err)
@@ -552,6 +594,9 @@ func (p *peer) PostPropose(index, term uint64, isConfChange bool, cb *message.Ca
 func (p *peer) countHealthyNode(progress map[uint64]raft.Progress) int {
 	healthy := 0
 	for _, pr := range progress {
+		if pr.IsLearner {
+			continue
+		}
 		if pr.Match >= p.peerStorage.truncatedIndex() {
 			healthy += 1
 		}
@@ -571,47 +616,85 @@ func (p *peer) countHealthyNode(progress map[uint64]raft.Progress) int {
 ///    Then at least '(total - 1)/2 + 1' other nodes (the node about to be removed is excluded)
 ///    need to be up to date for now. If 'allow_remove_leader' is false then
 ///    the peer to be removed should not be the leader.
+/// 3. A `AddLearnerNode` request
+///    It's always safe as learners are not counted in the quorum.
+/// A `ChangePeerV2` request is checked the same way with all its changes applied,
+/// the configuration it checks is the incoming one, or the configuration right
+/// after the joint state is left if the request is empty.
 func (p *peer) checkConfChange(cfg *config.Config, cmd *raft_cmdpb.RaftCmdRequest) error {
-	changePeer := GetChangePeerCmd(cmd)
-	changeType := changePeer.GetChangeType()
-	peer := changePeer.GetPeer()
+	var changes []*raft_cmdpb.ChangePeerRequest
+	if changePeer := GetChangePeerCmd(cmd); changePeer != nil {
+		changes = []*raft_cmdpb.ChangePeerRequest{changePeer}
+	} else {
+		changes = GetChangePeerV2Cmd(cmd).GetChanges()
+	}
 
 	progress := p.RaftGroup.GetProgress()
-	total := len(progress)
+	total := voterCount(progress)
 	if total <= 1 {
 		// It's always safe if there is only one node in the cluster.
 		return nil
 	}
 
-	switch changeType {
-	case eraftpb.ConfChangeType_AddNode:
-		progress[peer.Id] = raft.Progress{}
-	case eraftpb.ConfChangeType_RemoveNode:
-		if _, ok := progress[peer.Id]; ok {
-			delete(progress, peer.Id)
-		} else {
+	changed := false
+	for _, changePeer := range changes {
+		peer := changePeer.GetPeer()
+		switch changePeer.GetChangeType() {
+		case eraftpb.ConfChangeType_AddLearnerNode:
+			// A learner is not counted in the quorum, it's always safe to add it.
+		case eraftpb.ConfChangeType_AddNode:
+			// Promoting a learner keeps its replication progress.
+			pr := progress[peer.Id]
+			pr.IsLearner = false
+			progress[peer.Id] = pr
+			changed = true
+		case eraftpb.ConfChangeType_RemoveNode:
 			// It's always safe to remove a not existing node.
-			return nil
+			if _, ok := progress[peer.Id]; ok {
+				delete(progress, peer.Id)
+				changed = true
+			}
 		}
 	}
+	if len(changes) == 0 {
+		for _, peer := range p.Region().Peers {
+			if peer.JointState == metapb.JointState_OutgoingVoter {
+				delete(progress, peer.Id)
+				changed = true
+			}
+		}
+	}
+	if !changed {
+		return nil
+	}
 
 	healthy := p.countHealthyNode(progress)
-	quorumAfterChange := Quorum(len(progress))
+	quorumAfterChange := Quorum(voterCount(progress))
 	if healthy >= quorumAfterChange {
 		return nil
 	}
 
 	log.Info(fmt.Sprintf("%v rejects unsafe conf chagne request %v, total %v, healthy %v quorum after change %v",
-		p.Tag, changePeer, total, healthy, quorumAfterChange))
+		p.Tag, changes, total, healthy, quorumAfterChange))
 
 	return fmt.Errorf("unsafe to perform conf change %v, total %v, healthy %v, quorum after chagne %v",
-		changePeer, total, healthy, quorumAfterChange)
+		changes, total, healthy, quorumAfterChange)
 }
 
 func Quorum(total int) int {
 	return total/2 + 1
 }
 
+func voterCount(progress map[uint64]raft.Progress) int {
+	total := 0
+	for _, pr := range progress {
+		if !pr.IsLearner {
+			total++
+		}
+	}
+	return total
+}
+
 func (p *peer) transferLeader(peer *metapb.Peer) {
 	log.Info(fmt.Sprintf("%v transfer leader to %v", p.Tag, peer))
 
@@ -619,6 +702,11 @@ func (p *peer) transferLeader(peer *metapb.Peer) {
 }
 
 func (p *peer) ProposeNormal(cfg *config.Config, req *raft_cmdpb.RaftCmdRequest) (uint64, error) {
+	if req.GetAdminRequest().GetCmdType() == raft_cmdpb.AdminCmdType_PrepareMerge {
+		if err := p.preProposePrepareMerge(req); err != nil {
+			return 0, err
+		}
+	}
 	data, err := req.Marshal()
 	if err != nil {
 		return 0, err
@@ -638,11 +726,80 @@ func (p *peer) ProposeNormal(cfg *config.Config, req *raft_cmdpb.RaftCmdRequest)
 	return proposeIndex, nil
 }
 
+// preProposePrepareMerge checks whether the region can be merged into the target
+// region, and fills the min matched index of the peers into the request.
+//
+// The logs after the min index are carried by the CommitMerge request to let the
+// lagging peers catch up, so they must not contain any command changing the region.
+func (p *peer) preProposePrepareMerge(req *raft_cmdpb.RaftCmdRequest) error {
+	region := p.Region()
+	prepareMerge := req.AdminRequest.PrepareMerge
+	if err := checkMergeTarget(region, prepareMerge.Target); err != nil {
+		return err
+	}
+	if util.IsInJointState(region) {
+		return fmt.Errorf("%s region is in joint state, can't be merged", p.Tag)
+	}
+
+	raftLog := p.RaftGroup.Raft.RaftLog
+	minIndex := raftLog.LastIndex()
+	for _, pr := range p.RaftGroup.GetProgress() {
+		if pr.Match < minIndex {
+			minIndex = pr.Match
+		}
+	}
+	entries, err := raftLog.Entries(minIndex + 1)
+	if err != nil {
+		return fmt.Errorf("%s failed to get logs after min index %d: %v", p.Tag, minIndex, err)
+	}
+	for _, entry := range entries {
+		if entry.EntryType != eraftpb.EntryType_EntryNormal {
+			return fmt.Errorf("%s log gap contains conf change, index %d", p.Tag, entry.Index)
+		}
+		if len(entry.Data) == 0 {
+			continue
+		}
+		cmd := new(raft_cmdpb.RaftCmdRequest)
+		if err := cmd.Unmarshal(entry.Data); err != nil {
+			panic(err)
+		}
+		if cmd.AdminRequest != nil && cmd.AdminRequest.CmdType != raft_cmdpb.AdminCmdType_CompactLog {
+			return fmt.Errorf("%s log gap contains admin request %s, index %d",
+				p.Tag, cmd.AdminRequest.CmdType, entry.Index)
+		}
+	}
+	prepareMerge.MinIndex = minIndex
+	return nil
+}
+
+// checkMergeTarget checks the target region is adjacent to the region, and has
+// its peers on the same stores as the region.
+func checkMergeTarget(region, target *metapb.Region) error {
+	if target == nil || target.Id == region.Id {
+		return fmt.Errorf("invalid merge target %s of region %d", target, region.Id)
+	}
+	if !bytes.Equal(target.EndKey, region.StartKey) && !bytes.Equal(target.StartKey, region.EndKey) {
+		return fmt.Errorf("target region %s is not adjacent to region %s", target, region)
+	}
+	if len(target.Peers) != len(region.Peers) {
+		return fmt.Errorf("target region %s has different peers from region %s", target, region)
+	}
+	for _, peer := range region.Peers {
+		targetPeer := util.FindPeer(target, peer.StoreId)
+		if targetPeer == nil || targetPeer.IsLearner != peer.IsLearner {
+			return fmt.Errorf("target region %s has different peers from region %s", target, region)
+		}
+	}
+	return nil
+}
+
 // Return true if the transfer leader request is accepted.
 func (p *peer) ProposeTransferLeader(cfg *config.Config, req *raft_cmdpb.RaftCmdRequest, cb *message.Callback) bool {
 	transferLeader := getTransferLeaderCmd(req)
 	peer := transferLeader.Peer
 
+	// The lease is not safe any more once the leadership may be transferred.
+	p.leaderLease.Expire()
 	p.transferLeader(peer)
 	// transfer leader command doesn't need to replicate log and apply, so we
 	// return immediately. Note that this command may fail, we can view it just as an advice
@@ -671,6 +828,13 @@ func (p *peer) ProposeConfChange(cfg *config.Config, req *raft_cmdpb.RaftCmdRequ
 		return 0, err
 	}
 
+	if changePeerV2 := GetChangePeerV2Cmd(req); changePeerV2 != nil {
+		return p.proposeConfChangeV2(changePeerV2, data)
+	}
+	if util.IsInJointState(p.Region()) {
+		return 0, fmt.Errorf("%v is in joint state, leave it before another conf change", p.Tag)
+	}
+
 	changePeer := GetChangePeerCmd(req)
 	var cc eraftpb.ConfChange
 	cc.ChangeType = changePeer.ChangeType
@@ -692,6 +856,41 @@ func (p *peer) ProposeConfChange(cfg *config.Config, req *raft_cmdpb.RaftCmdRequ
 	return proposeIndex, nil
 }
 
+func (p *peer) proposeConfChangeV2(changePeerV2 *raft_cmdpb.ChangePeerV2Request, data []byte) (uint64, error) {
+	joint := util.IsInJointState(p.Region())
+	leaveJoint := len(changePeerV2.Changes) == 0
+	if joint && !leaveJoint {
+		return 0, fmt.Errorf("%v is in joint state, leave it before another conf change", p.Tag)
+	}
+	if !joint && leaveJoint {
+		return 0, fmt.Errorf("%v is not in joint state, nothing to leave", p.Tag)
+	}
+
+	// The raftstore always leaves the joint state explicitly, so the region
+	// meta changes along with the raft configuration.
+	cc := eraftpb.ConfChangeV2{Transition: eraftpb.ConfChangeTransition_Explicit, Context: data}
+	for _, changePeer := range changePeerV2.Changes {
+		cc.Changes = append(cc.Changes, &eraftpb.ConfChangeSingle{
+			ChangeType: changePeer.ChangeType,
+			NodeId:     changePeer.Peer.Id,
+		})
+	}
+
+	log.Info(fmt.Sprintf("%v propose conf change v2 %v", p.Tag, cc.Changes))
+
+	proposeIndex := p.nextProposalIndex()
+	if err := p.RaftGroup.ProposeConfChangeV2(cc); err != nil {
+		return 0, err
+	}
+	if p.nextProposalIndex() == proposeIndex {
+		// The message is dropped silently, this usually due to leader absence
+		// or transferring leader. Both cases can be considered as NotLeader error.
+		return 0, &util.ErrNotLeader{RegionId: p.regionId}
+	}
+
+	return proposeIndex, nil
+}
+
 type RequestPolicy int
 
 const (
@@ -703,7 +902,7 @@ const (
 
 func (p *peer) inspect(req *raft_cmdpb.RaftCmdRequest) (RequestPolicy, error) {
 	if req.AdminRequest != nil {
-		if GetChangePeerCmd(req) != nil {
+		if GetChangePeerCmd(req) != nil || GetChangePeerV2Cmd(req) != nil {
 			return RequestPolicy_ProposeConfChange, nil
 		}
 		if getTransferLeaderCmd(req) != nil {
@@ -716,7 +915,7 @@ func (p *peer) inspect(req *raft_cmdpb.RaftCmdRequest) (RequestPolicy, error) {
 		switch r.CmdType {
 		case raft_cmdpb.CmdType_Get, raft_cmdpb.CmdType_Snap:
 			hasRead = true
-		case raft_cmdpb.CmdType_Delete, raft_cmdpb.CmdType_Put:
+		case raft_cmdpb.CmdType_Delete, raft_cmdpb.CmdType_Put, raft_cmdpb.CmdType_DeleteRange:
 			hasWrite = true
 		case raft_cmdpb.CmdType_Invalid:
 			return RequestPolicy_Invalid, fmt.Errorf("invalid cmd type %v, message maybe corrupted", r.CmdType)
@@ -751,3 +950,10 @@ func GetChangePeerCmd(msg *raft_cmdpb.RaftCmdRequest) *raft_cmdpb.ChangePeerRequ
 	}
 	return msg.AdminRequest.ChangePeer
 }
+
+func GetChangePeerV2Cmd(msg *raft_cmdpb.RaftCmdRequest) *raft_cmdpb.ChangePeerV2Request {
+	if msg.AdminRequest == nil || msg.AdminRequest.ChangePeerV2 == nil {
+		return nil
+	}
+	return msg.AdminRequest.ChangePeerV2
+}
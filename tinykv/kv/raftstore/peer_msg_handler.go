@@ -1,6 +1,8 @@
 package raftstore
 
 import (
+	"bytes"
+	"encoding/binary"
 	"fmt"
 	"time"
 
@@ -10,9 +12,11 @@ import (
 	"github.com/pingcap-incubator/tinykv/kv/raftstore/snap"
 	"github.com/pingcap-incubator/tinykv/kv/raftstore/util"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/eraftpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/errorpb"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_cmdpb"
 	rspb "github.com/pingcap-incubator/tinykv/proto/pkg/raft_serverpb"
+	"github.com/pingcap-incubator/tinykv/raft"
 	"github.com/pingcap-incubator/tinykv/scheduler/pkg/btree"
 	"github.com/pingcap/errors"
 	"github.com/pingcap/log"
@@ -26,6 +30,7 @@ const (
 	PeerTickRaftLogGC          PeerTick = 1
 	PeerTickSplitRegionCheck   PeerTick = 2
 	PeerTickSchedulerHeartbeat PeerTick = 3
+	PeerTickCheckMerge         PeerTick = 4
 )
 
 type peerMsgHandler struct {
@@ -51,7 +56,12 @@ func (d *peerMsgHandler) HandleMsg(msg message.Msg) {
 		}
 	case message.MsgTypeRaftCmd:
 		raftCMD := msg.Data.(*message.MsgRaftCmd)
-		d.proposeRaftCommand(raftCMD.Request, raftCMD.Callback)
+		d.recordLoad(raftCMD.Request)
+		if isReadOnlyRequest(raftCMD.Request) {
+			d.proposeReadIndex(raftCMD.Request, raftCMD.Callback)
+		} else {
+			d.proposeRaftCommand(raftCMD.Request, raftCMD.Callback)
+		}
 	case message.MsgTypeTick:
 		d.onTick()
 	case message.MsgTypeApplyRes:
@@ -66,6 +76,14 @@ func (d *peerMsgHandler) HandleMsg(msg message.Msg) {
 	case message.MsgTypeGcSnap:
 		gcSnap := msg.Data.(*message.MsgGCSnap)
 		d.onGCSnap(gcSnap.Snaps)
+	case message.MsgTypeMergeResult:
+		d.onMergeResult(msg.Data.(*message.MsgMergeResult).Target)
+	case message.MsgTypeMergeCatchUpLogs:
+		d.onMergeCatchUpLogs(msg.Data.(*message.MsgMergeCatchUpLogs))
+	case message.MsgTypeMergeLogsUpToDate:
+		d.onMergeLogsUpToDate(msg.Data.(uint64))
+	case message.MsgTypeAdvanceResolvedTs:
+		d.onAdvanceResolvedTs(msg.Data.(uint64))
 	case message.MsgTypeStart:
 		d.startTicker()
 	}
@@ -88,6 +106,9 @@ func (d *peerMsgHandler) onTick() {
 	if d.ticker.isOnTick(PeerTickSplitRegionCheck) {
 		d.onSplitRegionCheckTick()
 	}
+	if d.ticker.isOnTick(PeerTickCheckMerge) {
+		d.onCheckMergeTick()
+	}
 	d.ctx.tickDriverSender <- d.regionId
 }
 
@@ -98,6 +119,7 @@ func (d *peerMsgHandler) startTicker() {
 	d.ticker.schedule(PeerTickRaftLogGC)
 	d.ticker.schedule(PeerTickSplitRegionCheck)
 	d.ticker.schedule(PeerTickSchedulerHeartbeat)
+	d.ticker.schedule(PeerTickCheckMerge)
 }
 
 func (d *peerMsgHandler) onGCSnap(snaps []snap.SnapKeyWithSending) {
@@ -145,6 +167,13 @@ func (d *peerMsgHandler) HandleRaftReady() {
 		msgs = append(msgs, msg)
 	}
 	applySnapResult, msgs := d.peer.HandleRaftReady(msgs, d.ctx.schedulerTaskSender, d.ctx.trans)
+	if !d.IsLeader() && d.ctx.cdcObserver.Observed(d.regionId) {
+		// The resolved ts is advanced by the leader, the consumers subscribe the new leader again.
+		d.ctx.cdcObserver.RegionChanged(d.regionId, &errorpb.Error{
+			Message:   fmt.Sprintf("peer %d is not the leader of region %d", d.PeerId(), d.regionId),
+			NotLeader: &errorpb.NotLeader{RegionId: d.regionId},
+		})
+	}
 	if applySnapResult != nil {
 		prevRegion := applySnapResult.PrevRegion
 		region := applySnapResult.Region
@@ -177,6 +206,8 @@ func (d *peerMsgHandler) onRaftBaseTick() {
 	}
 	// TODO: make Tick returns bool to indicate if there is ready.
 	d.RaftGroup.Tick()
+	cfg := d.ctx.cfg
+	d.expireReplicaReads(time.Now(), cfg.RaftBaseTickInterval*time.Duration(cfg.RaftElectionTimeoutTicks))
 	d.ticker.schedule(PeerTickRaft)
 }
 
@@ -192,12 +223,23 @@ func (d *peerMsgHandler) onApplyResult(res *MsgApplyRes) {
 			d.onReadyCompactLog(x.firstIndex, x.truncatedIndex)
 		case *execResultSplitRegion:
 			d.onReadySplitRegion(x.derived, x.regions)
+		case *execResultPrepareMerge:
+			d.onReadyPrepareMerge(x.region, x.state)
+		case *execResultCommitMerge:
+			d.onReadyCommitMerge(x.region, x.source)
+		case *execResultRollbackMerge:
+			d.onReadyRollbackMerge(x.region, x.commit)
+		case *execResultWaitMergeSource:
+			d.waitMergeSource = x.merge
+			d.askMergeCatchUpLogs()
 		}
 	}
 	res.execResults = nil
 	if d.stopped {
 		return
 	}
+	d.serveReads()
+	d.maybeLeaveJoint()
 
 	diff := d.SizeDiffHint + res.sizeDiffHint
 	if diff > 0 {
@@ -241,10 +283,19 @@ func (d *peerMsgHandler) onRaftMsg(msg *rspb.RaftMessage) error {
 		return nil
 	}
 	d.insertPeerCache(msg.GetFromPeer())
+	if msg.GetMessage().GetMsgType() == eraftpb.MessageType_MsgReadIndex {
+		return d.onReplicaReadIndex(msg)
+	}
 	err = d.RaftGroup.Step(*msg.GetMessage())
 	if err != nil {
 		return err
 	}
+	// Only the resolved ts of the current leader is taken, a stale leader may
+	// miss the prewrites applied by the new one.
+	if msg.ResolvedTs != 0 && msg.Message.MsgType == eraftpb.MessageType_MsgHeartbeat &&
+		msg.Message.Term == d.Term() && msg.FromPeer.GetId() == d.LeaderId() {
+		d.resolver.updateLeader(msg.ResolvedTs, msg.ResolvedIndex)
+	}
 	if d.AnyNewPeerCatchUp(msg.FromPeer.Id) {
 		d.HeartbeatScheduler(d.ctx.schedulerTaskSender)
 	}
@@ -427,6 +478,11 @@ func (d *peerMsgHandler) destroyPeer() {
 		panic(fmt.Sprintf("%s destroy peer %v", d.Tag, err))
 	}
 	d.ctx.router.close(regionID)
+	d.ctx.loadStats.Remove(regionID)
+	d.ctx.cdcObserver.RegionChanged(regionID, &errorpb.Error{
+		Message:        fmt.Sprintf("region %d is destroyed", regionID),
+		RegionNotFound: &errorpb.RegionNotFound{RegionId: regionID},
+	})
 	d.stopped = true
 	if isInitialized && meta.regionRanges.Delete(&regionItem{region: d.Region()}) == nil {
 		panic(d.Tag + " meta corruption detected")
@@ -438,31 +494,42 @@ func (d *peerMsgHandler) destroyPeer() {
 }
 
 func (d *peerMsgHandler) onReadyChangePeer(cp *execResultChangePeer) {
-	changeType := cp.confChange.ChangeType
-	d.RaftGroup.ApplyConfChange(*cp.confChange)
-	if cp.confChange.NodeId == 0 {
-		// Apply failed, skip.
-		return
+	changes := cp.changes
+	if cp.confChangeV2 != nil {
+		d.RaftGroup.ApplyConfChangeV2(*cp.confChangeV2)
+	} else {
+		d.RaftGroup.ApplyConfChange(*cp.confChange)
+		if cp.confChange.NodeId == 0 {
+			// Apply failed, skip.
+			return
+		}
+		changes = []*raft_cmdpb.ChangePeerRequest{{ChangeType: cp.confChange.ChangeType, Peer: cp.peer}}
 	}
 	meta := d.ctx.storeMeta
 	meta.Lock()
 	meta.setRegion(cp.region, d.peer)
 	meta.Unlock()
-	peerID := cp.peer.Id
-	switch changeType {
-	case eraftpb.ConfChangeType_AddNode:
-		// Add this peer to cache and heartbeats.
-		now := time.Now()
-		if d.IsLeader() {
-			d.PeersStartPendingTime[peerID] = now
-		}
-		d.insertPeerCache(cp.peer)
-	case eraftpb.ConfChangeType_RemoveNode:
-		// Remove this peer from cache.
-		if d.IsLeader() {
-			delete(d.PeersStartPendingTime, peerID)
+	var removeSelf *metapb.Peer
+	for _, change := range changes {
+		peerID := change.Peer.Id
+		switch change.ChangeType {
+		case eraftpb.ConfChangeType_AddNode, eraftpb.ConfChangeType_AddLearnerNode:
+			// Add this peer to cache and heartbeats.
+			now := time.Now()
+			if d.IsLeader() {
+				d.PeersStartPendingTime[peerID] = now
+			}
+			d.insertPeerCache(change.Peer)
+		case eraftpb.ConfChangeType_RemoveNode:
+			// Remove this peer from cache.
+			if d.IsLeader() {
+				delete(d.PeersStartPendingTime, peerID)
+			}
+			d.removePeerCache(peerID)
+			if change.Peer.StoreId == d.storeID() {
+				removeSelf = change.Peer
+			}
 		}
-		d.removePeerCache(peerID)
 	}
 
 	// In pattern matching above, if the peer is the leader,
@@ -478,15 +545,40 @@ func (d *peerMsgHandler) onReadyChangePeer(cp *execResultChangePeer) {
 	myPeerID := d.PeerId()
 
 	// We only care remove itself now.
-	if changeType == eraftpb.ConfChangeType_RemoveNode && cp.peer.StoreId == d.storeID() {
-		if myPeerID == peerID {
+	if removeSelf != nil {
+		if myPeerID == removeSelf.Id {
 			d.destroyPeer()
 		} else {
-			panic(fmt.Sprintf("%s trying to remove unknown peer %s", d.Tag, cp.peer))
+			panic(fmt.Sprintf("%s trying to remove unknown peer %s", d.Tag, removeSelf))
 		}
 	}
 }
 
+// maybeLeaveJoint proposes to leave the joint state once the leader has applied
+// the ChangePeerV2 command entering it. A new leader leaves the joint state left
+// behind by the old one in the same way.
+func (d *peerMsgHandler) maybeLeaveJoint() {
+	if d.stopped || !d.IsLeader() || !util.IsInJointState(d.Region()) {
+		return
+	}
+	if d.RaftGroup.Raft.PendingConfIndex > d.peerStorage.AppliedIndex() {
+		return
+	}
+	req := &raft_cmdpb.RaftCmdRequest{
+		Header: &raft_cmdpb.RaftRequestHeader{
+			RegionId:    d.regionId,
+			Peer:        d.Meta,
+			RegionEpoch: d.Region().RegionEpoch,
+		},
+		AdminRequest: &raft_cmdpb.AdminRequest{
+			CmdType:      raft_cmdpb.AdminCmdType_ChangePeerV2,
+			ChangePeerV2: &raft_cmdpb.ChangePeerV2Request{},
+		},
+	}
+	log.Info(fmt.Sprintf("%s propose to leave joint state, region %s", d.Tag, d.Region()))
+	d.Propose(d.ctx.engine.Kv, d.ctx.cfg, nil, req, newCmdResp())
+}
+
 func (d *peerMsgHandler) onReadyCompactLog(firstIndex uint64, truncatedIndex uint64) {
 	raftLogGCTask := &runner.RaftLogGCTask{
 		RaftEngine: d.ctx.engine.Raft,
@@ -581,6 +673,199 @@ func (d *peerMsgHandler) onReadySplitRegion(derived *metapb.Region, regions []*m
 	}
 }
 
+func (d *peerMsgHandler) onReadyPrepareMerge(region *metapb.Region, state *rspb.MergeState) {
+	meta := d.ctx.storeMeta
+	meta.Lock()
+	meta.setRegion(region, d.peer)
+	meta.Unlock()
+	d.pendingMergeState = state
+	if d.IsLeader() {
+		d.HeartbeatScheduler(d.ctx.schedulerTaskSender)
+	}
+	d.onCheckMerge()
+}
+
+func (d *peerMsgHandler) onReadyCommitMerge(region, source *metapb.Region) {
+	meta := d.ctx.storeMeta
+	meta.Lock()
+	if meta.regionRanges.Delete(&regionItem{region: d.Region()}) == nil {
+		panic(d.Tag + " original region should exist")
+	}
+	if sourceRegion, ok := meta.regions[source.Id]; ok {
+		if meta.regionRanges.Delete(&regionItem{region: sourceRegion}) == nil {
+			panic(d.Tag + " meta corruption detected")
+		}
+		delete(meta.regions, source.Id)
+	}
+	meta.setRegion(region, d.peer)
+	if oldRegion := meta.regionRanges.ReplaceOrInsert(&regionItem{region: region}); oldRegion != nil {
+		panic(fmt.Sprintf("%s unexpected old region %+v, region %+v", d.Tag, oldRegion, region))
+	}
+	meta.Unlock()
+	// It's not correct anymore, so set it to None to let split checker update it.
+	d.ApproximateSize = nil
+	if d.IsLeader() {
+		log.Info(fmt.Sprintf("%s notify scheduler with merge region %s", d.Tag, region))
+		d.HeartbeatScheduler(d.ctx.schedulerTaskSender)
+	}
+	// The source peer has been caught up when applying the merge, it's safe to
+	// destroy it now.
+	_ = d.ctx.router.send(source.Id, message.NewPeerMsg(message.MsgTypeMergeResult, source.Id,
+		&message.MsgMergeResult{Target: region}))
+}
+
+func (d *peerMsgHandler) onReadyRollbackMerge(region *metapb.Region, commit uint64) {
+	meta := d.ctx.storeMeta
+	meta.Lock()
+	meta.setRegion(region, d.peer)
+	meta.Unlock()
+	d.pendingMergeState = nil
+	if d.IsLeader() {
+		log.Info(fmt.Sprintf("%s notify scheduler with rollback merge region %s", d.Tag, region))
+		d.HeartbeatScheduler(d.ctx.schedulerTaskSender)
+	}
+}
+
+// onMergeResult destroys the peer which has been merged into the target region.
+// The data of the peer belongs to the target region now, so it must be kept, and
+// the store meta has been updated by the target peer.
+func (d *peerMsgHandler) onMergeResult(target *metapb.Region) {
+	if d.stopped {
+		return
+	}
+	log.Info(fmt.Sprintf("%s merged into region %s, starts destroy", d.Tag, target))
+	if err := d.Destroy(d.ctx.engine, true); err != nil {
+		panic(fmt.Sprintf("%s destroy peer %v", d.Tag, err))
+	}
+	d.ctx.router.close(d.regionId)
+	d.ctx.loadStats.Remove(d.regionId)
+	d.ctx.cdcObserver.RegionChanged(d.regionId, &errorpb.Error{
+		Message:        fmt.Sprintf("region %d is merged", d.regionId),
+		RegionNotFound: &errorpb.RegionNotFound{RegionId: d.regionId},
+	})
+	d.stopped = true
+}
+
+// askMergeCatchUpLogs asks the source peer of the merge on this store to catch up
+// its logs, the CommitMerge log is applied after that. The source peer may not be
+// created yet, so it's asked again on the check merge tick until it reports back.
+func (d *peerMsgHandler) askMergeCatchUpLogs() {
+	merge := d.waitMergeSource
+	if d.stopped || merge == nil {
+		return
+	}
+	sourceID := merge.Source.Id
+	err := d.ctx.router.send(sourceID, message.NewPeerMsg(message.MsgTypeMergeCatchUpLogs, sourceID,
+		&message.MsgMergeCatchUpLogs{Target: d.regionId, Merge: merge}))
+	if err != nil {
+		log.Info(fmt.Sprintf("%s failed to ask region %d to catch up logs for merge, retry later: %v",
+			d.Tag, sourceID, err))
+	}
+}
+
+// onMergeCatchUpLogs lets the applier catch up the logs for the target region of
+// the merge, the applier tells the target peer when they are applied.
+func (d *peerMsgHandler) onMergeCatchUpLogs(catchUp *message.MsgMergeCatchUpLogs) {
+	if d.stopped || !d.isInitialized() {
+		return
+	}
+	d.applyCh <- []message.Msg{message.NewPeerMsg(message.MsgTypeApplyCatchUpLogs, d.regionId,
+		&MsgApplyCatchUpLogs{targetRegionID: catchUp.Target, merge: catchUp.Merge})}
+}
+
+// onMergeLogsUpToDate resumes applying the CommitMerge log once the source peer has
+// caught up its logs.
+func (d *peerMsgHandler) onMergeLogsUpToDate(sourceID uint64) {
+	merge := d.waitMergeSource
+	if d.stopped || merge == nil || merge.Source.Id != sourceID {
+		return
+	}
+	d.waitMergeSource = nil
+	d.applyCh <- []message.Msg{message.NewPeerMsg(message.MsgTypeApplyResumeMerge, d.regionId, nil)}
+}
+
+func (d *peerMsgHandler) onCheckMergeTick() {
+	d.ticker.schedule(PeerTickCheckMerge)
+	d.askMergeCatchUpLogs()
+	d.onCheckMerge()
+}
+
+// onCheckMerge drives the pending merge. Any peer of the merging region whose
+// target peer on the same store is the leader sends the CommitMerge request to it,
+// and the leader rolls the merge back once the target region has been changed
+// by something else.
+func (d *peerMsgHandler) onCheckMerge() {
+	state := d.pendingMergeState
+	if d.stopped || state == nil {
+		return
+	}
+	meta := d.ctx.storeMeta
+	meta.RLock()
+	target := meta.regions[state.Target.Id]
+	meta.RUnlock()
+	if target == nil {
+		return
+	}
+	if util.IsEpochStale(state.Target.RegionEpoch, target.RegionEpoch) {
+		if regionContains(target, d.Region()) {
+			// The target region has committed the merge, wait for the merge result.
+			return
+		}
+		if d.IsLeader() {
+			d.proposeRollbackMerge(state)
+		}
+		return
+	}
+	if util.IsEpochStale(target.RegionEpoch, state.Target.RegionEpoch) {
+		// The target peer on this store is lagging behind, wait for it to catch up.
+		return
+	}
+	targetPeer := d.ctx.router.get(target.Id)
+	if targetPeer == nil || !targetPeer.peer.IsLeader() {
+		return
+	}
+	entries, err := d.RaftGroup.Raft.RaftLog.Entries(state.MinIndex + 1)
+	if err != nil || uint64(len(entries)) < state.Commit-state.MinIndex {
+		log.Error(fmt.Sprintf("%s failed to get logs (%d, %d] for merge: %v", d.Tag, state.MinIndex, state.Commit, err))
+		return
+	}
+	commitMerge := &raft_cmdpb.CommitMergeRequest{
+		Source: d.Region(),
+		Commit: state.Commit,
+	}
+	for i := range entries[:state.Commit-state.MinIndex] {
+		commitMerge.Entries = append(commitMerge.Entries, &entries[i])
+	}
+	req := newAdminRequest(target.Id, targetPeer.peer.Meta)
+	req.Header.RegionEpoch = state.Target.RegionEpoch
+	req.AdminRequest = &raft_cmdpb.AdminRequest{
+		CmdType:     raft_cmdpb.AdminCmdType_CommitMerge,
+		CommitMerge: commitMerge,
+	}
+	log.Info(fmt.Sprintf("%s send commit merge to region %s", d.Tag, target))
+	_ = d.ctx.router.send(target.Id, message.NewPeerMsg(message.MsgTypeRaftCmd, target.Id,
+		&message.MsgRaftCmd{Request: req}))
+}
+
+// regionContains returns true if the key range of the outer region contains the inner one.
+func regionContains(outer, inner *metapb.Region) bool {
+	if bytes.Compare(outer.StartKey, inner.StartKey) > 0 {
+		return false
+	}
+	return len(outer.EndKey) == 0 || len(inner.EndKey) > 0 && bytes.Compare(inner.EndKey, outer.EndKey) <= 0
+}
+
+func (d *peerMsgHandler) proposeRollbackMerge(state *rspb.MergeState) {
+	req := newAdminRequest(d.regionId, d.Meta)
+	req.Header.RegionEpoch = d.Region().RegionEpoch
+	req.AdminRequest = &raft_cmdpb.AdminRequest{
+		CmdType:       raft_cmdpb.AdminCmdType_RollbackMerge,
+		RollbackMerge: &raft_cmdpb.RollbackMergeRequest{Commit: state.Commit},
+	}
+	log.Info(fmt.Sprintf("%s target region %s has been changed, propose to roll back merge", d.Tag, state.Target))
+	d.Propose(d.ctx.engine.Kv, d.ctx.cfg, nil, req, newCmdResp())
+}
+
 func (d *peerMsgHandler) preProposeRaftCommand(req *raft_cmdpb.RaftCmdRequest) error {
 	// Check store_id, make sure that the msg is dispatched to the right place.
 	if err := util.CheckStoreID(req, d.storeID()); err != nil {
@@ -590,7 +875,7 @@ func (d *peerMsgHandler) preProposeRaftCommand(req *raft_cmdpb.RaftCmdRequest) e
 	// Check whether the store has the right peer to handle the request.
 	regionID := d.regionId
 	leaderID := d.LeaderId()
-	if !d.IsLeader() {
+	if !d.IsLeader() && !isReplicaRead(req) {
 		leader := d.getPeerFromCache(leaderID)
 		return &util.ErrNotLeader{RegionId: regionID, Leader: leader}
 	}
@@ -633,6 +918,90 @@ func (d *peerMsgHandler) proposeRaftCommand(msg *raft_cmdpb.RaftCmdRequest, cb *
 	// command log entry can't be committed. There are some useful information in the `ctx` of the `peerMsgHandler`.
 }
 
+// proposeReadIndex serves a read-only command without appending it to the raft log.
+// The read is served locally if the leader lease is valid, otherwise it waits for
+// the quorum to confirm the read index and for the read index to be applied.
+// A follower asks the leader for the read index, and a stale read is served
+// locally by any peer.
+func (d *peerMsgHandler) proposeReadIndex(msg *raft_cmdpb.RaftCmdRequest, cb *message.Callback) {
+	if err := d.preProposeRaftCommand(msg); err != nil {
+		cb.Done(ErrResp(err))
+		return
+	}
+	if d.stopped {
+		NotifyReqRegionRemoved(d.regionId, cb)
+		return
+	}
+	if msg.Header.StaleRead {
+		d.execReadLocal(msg, cb)
+		return
+	}
+
+	now := time.Now()
+	if !d.IsLeader() {
+		if d.LeaderId() == raft.None {
+			cb.Done(ErrResp(&util.ErrNotLeader{RegionId: d.regionId}))
+			return
+		}
+		read := d.pendingReads.push(msg, cb, now)
+		d.RaftGroup.ReadIndex(read.replicaReadCtx(d.PeerId(), d.ctx.concurrencyManager.MaxTs()))
+		return
+	}
+	// The leader must have applied an entry of its own term, so all the
+	// entries committed by the previous leaders are visible.
+	appliedIndex := d.peerStorage.AppliedIndex()
+	appliedTerm, err := d.RaftGroup.Raft.RaftLog.Term(appliedIndex)
+	if err == nil && appliedTerm == d.Term() && d.leaderLease.Valid(now) {
+		d.execReadLocal(msg, cb)
+		return
+	}
+
+	read := d.pendingReads.push(msg, cb, now)
+	d.RaftGroup.ReadIndex(read.binaryId(d.PeerId()))
+}
+
+// onAdvanceResolvedTs advances the resolved ts of the leader with a ts from
+// the TSO after its leadership is confirmed by a ReadIndex, a peer which has
+// been replaced by another leader when the ts is fetched can't confirm it. The
+// read index is applied before that, so the entries committed by the previous
+// leaders are applied too.
+func (d *peerMsgHandler) onAdvanceResolvedTs(ts uint64) {
+	if d.stopped || !d.IsLeader() {
+		return
+	}
+	read := d.pendingReads.push(nil, nil, time.Now())
+	read.resolvedTs = ts
+	d.RaftGroup.ReadIndex(read.binaryId(d.PeerId()))
+}
+
+// onReplicaReadIndex handles the ReadIndex forwarded by a follower. The max read
+// timestamp in its context is applied to the store before the leader handles it,
+// updating the max timestamp waits for the prewrites which have read a smaller
+// one, so it's done in another goroutine and the request is sent back to the
+// peer after that. The request is dropped if the peer is not the leader, the
+// follower fails the read after a timeout.
+func (d *peerMsgHandler) onReplicaReadIndex(msg *rspb.RaftMessage) error {
+	m := msg.Message
+	if !d.IsLeader() || len(m.Entries) != 1 {
+		return nil
+	}
+	ctx := m.Entries[0].Data
+	if len(ctx) == readIndexCtxLen+8 {
+		maxTs := binary.BigEndian.Uint64(ctx[readIndexCtxLen:])
+		m.Entries[0].Data = ctx[:readIndexCtxLen]
+		manager := d.ctx.concurrencyManager
+		if maxTs > manager.MaxTs() {
+			regionID, router := d.regionId, d.ctx.router
+			go func() {
+				manager.UpdateMaxTs(maxTs)
+				_ = router.send(regionID, message.NewPeerMsg(message.MsgTypeRaftMessage, regionID, msg))
+			}()
+			return nil
+		}
+	}
+	return d.RaftGroup.Step(*m)
+}
+
 func (d *peerMsgHandler) findSiblingRegion() (result *metapb.Region) {
 	meta := d.ctx.storeMeta
 	meta.RLock()
@@ -688,18 +1057,42 @@ func (d *peerMsgHandler) onSplitRegionCheckTick() {
 		return
 	}
 
+	load := d.ctx.loadStats.Take(d.regionId)
 	if !d.IsLeader() {
 		return
 	}
-	if d.ApproximateSize != nil && d.SizeDiffHint < d.ctx.cfg.RegionSplitSize/8 {
+	threshold := d.ctx.cfg.RegionSplitQPSThreshold
+	if load != nil && (threshold == 0 || load.QPS(time.Now(), d.ctx.cfg.SplitRegionCheckTickInterval) < threshold) {
+		load = nil
+	}
+	if load == nil && d.ApproximateSize != nil && d.SizeDiffHint < d.ctx.cfg.RegionSplitSize/8 {
 		return
 	}
 	d.ctx.splitCheckTaskSender <- &runner.SplitCheckTask{
 		Region: d.Region(),
+		Load:   load,
 	}
 	d.SizeDiffHint = 0
 }
 
+// recordLoad records the keys accessed by the command for load based split,
+// the keys accessed by scans are recorded by the storage readers.
+func (d *peerMsgHandler) recordLoad(req *raft_cmdpb.RaftCmdRequest) {
+	if d.ctx.cfg.RegionSplitQPSThreshold == 0 || !d.IsLeader() {
+		return
+	}
+	for _, r := range req.Requests {
+		switch r.CmdType {
+		case raft_cmdpb.CmdType_Get:
+			d.ctx.loadStats.Record(d.regionId, r.Get.GetKey())
+		case raft_cmdpb.CmdType_Put:
+			d.ctx.loadStats.Record(d.regionId, r.Put.GetKey())
+		case raft_cmdpb.CmdType_Delete:
+			d.ctx.loadStats.Record(d.regionId, r.Delete.GetKey())
+		}
+	}
+}
+
 func (d *peerMsgHandler) onPrepareSplitRegion(regionEpoch *metapb.RegionEpoch, splitKey []byte, cb *message.Callback) {
 	if err := d.validateSplitRegion(regionEpoch, splitKey); err != nil {
 		cb.Done(ErrResp(err))
@@ -96,7 +96,7 @@ func newApplyWorker(ctx *GlobalContext, ch chan []message.Msg, pr *router) *appl
 		pr:       pr,
 		applyCh:  ch,
 		ctx:      ctx,
-		applyCtx: newApplyContext("", ctx.engine, pr.peerSender, ctx.cfg),
+		applyCtx: newApplyContext("", ctx.engine, pr, ctx.cfg, ctx.loadStats, ctx.cdcObserver),
 	}
 }
 
@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/Connor1996/badger"
 	"github.com/stretchr/testify/require"
 
 	"github.com/pingcap-incubator/tinykv/kv/config"
@@ -71,6 +72,17 @@ func (b *EntryBuilder) delete(cf string, key []byte) *EntryBuilder {
 	return b
 }
 
+func (b *EntryBuilder) deleteRange(cf string, startKey, endKey []byte) *EntryBuilder {
+	b.req.Requests = append(b.req.Requests, &raft_cmdpb.Request{
+		CmdType: raft_cmdpb.CmdType_DeleteRange,
+		DeleteRange: &raft_cmdpb.DeleteRangeRequest{
+			Cf:       cf,
+			StartKey: startKey,
+			EndKey:   endKey,
+		}})
+	return b
+}
+
 func (b *EntryBuilder) epoch(confVer, version uint64) *EntryBuilder {
 	b.req.Header = &raft_cmdpb.RaftRequestHeader{
 		RegionEpoch: &metapb.RegionEpoch{
@@ -152,8 +164,9 @@ func TestHandleRaftCommittedEntries(t *testing.T) {
 	meta.InitApplyState(engines.Kv, region)
 	newPeer := &peerState{
 		apply: &applier{
-			id:     3,
-			region: region,
+			id:       3,
+			region:   region,
+			resolver: newResolver(),
 		},
 	}
 	router.peers.Store(uint64(1), newPeer)
@@ -280,6 +293,34 @@ func TestHandleRaftCommittedEntries(t *testing.T) {
 	require.Equal(t, len(resp.GetResponses()), 1)
 	require.True(t, bytes.Equal(resp.GetResponses()[0].GetGet().Value, []byte("v10")))
 
+	cb = message.NewCallback()
+	entry = NewEntryBuilder(15, 3).
+		deleteRange(engine_util.CfDefault, []byte("k1"), []byte("k3")).
+		epoch(1, 3).
+		build(applyCh, 3, 1, cb)
+	commit(applyCh, []eraftpb.Entry{*entry}, 1)
+	resp = cb.WaitResp()
+	require.True(t, resp.GetHeader().GetError() == nil)
+	fetchApplyRes(router.peerSender)
+	checkApplyIndex(t, engines, uint64(15))
+	_, err = engine_util.GetCF(engines.Kv, engine_util.CfDefault, []byte("k10"))
+	require.Equal(t, badger.ErrKeyNotFound, err)
+	val, err = engine_util.GetCF(engines.Kv, engine_util.CfDefault, []byte("k3"))
+	require.Nil(t, err)
+	require.True(t, bytes.Equal(val, []byte("v3")))
+
+	// The range must be in the region.
+	cb = message.NewCallback()
+	entry = NewEntryBuilder(16, 3).
+		deleteRange(engine_util.CfDefault, []byte("k3"), nil).
+		epoch(1, 3).
+		build(applyCh, 3, 1, cb)
+	commit(applyCh, []eraftpb.Entry{*entry}, 1)
+	resp = cb.WaitResp()
+	require.True(t, resp.GetHeader().GetError().GetKeyNotInRegion() != nil)
+	fetchApplyRes(router.peerSender)
+	checkApplyIndex(t, engines, uint64(16))
+
 	applyCh <- nil
 }
 
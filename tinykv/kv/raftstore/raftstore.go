@@ -9,11 +9,14 @@ import (
 	"github.com/Connor1996/badger"
 	"github.com/Connor1996/badger/y"
 	"github.com/pingcap-incubator/tinykv/kv/config"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/cdc"
 	"github.com/pingcap-incubator/tinykv/kv/raftstore/message"
 	"github.com/pingcap-incubator/tinykv/kv/raftstore/meta"
 	"github.com/pingcap-incubator/tinykv/kv/raftstore/runner"
 	"github.com/pingcap-incubator/tinykv/kv/raftstore/scheduler_client"
 	"github.com/pingcap-incubator/tinykv/kv/raftstore/snap"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/util"
+	"github.com/pingcap-incubator/tinykv/kv/transaction/concurrency"
 	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
 	"github.com/pingcap-incubator/tinykv/kv/util/worker"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
@@ -97,6 +100,11 @@ type GlobalContext struct {
 	regionTaskSender     chan<- worker.Task
 	raftLogGCTaskSender  chan<- worker.Task
 	splitCheckTaskSender chan<- worker.Task
+	gcTaskSender         chan<- worker.Task
+	resolvedTsTaskSender chan<- worker.Task
+	loadStats            *runner.LoadStats
+	cdcObserver          *cdc.Observer
+	concurrencyManager   *concurrency.Manager
 	schedulerClient      scheduler_client.Client
 	tickDriverSender     chan uint64
 }
@@ -158,6 +166,10 @@ func (bs *Raftstore) loadPeers() ([]*peer, error) {
 			if err != nil {
 				return err
 			}
+			if localState.State == rspb.PeerState_Merging {
+				log.Info(fmt.Sprintf("region %d is merging, merge state %s", regionID, localState.MergeState))
+				peer.pendingMergeState = localState.MergeState
+			}
 			ctx.storeMeta.regionRanges.ReplaceOrInsert(&regionItem{region: region})
 			ctx.storeMeta.regions[regionID] = region
 			// No need to check duplicated here, because we use region id as the key
@@ -198,17 +210,22 @@ type workers struct {
 	schedulerWorker  *worker.Worker
 	splitCheckWorker *worker.Worker
 	regionWorker     *worker.Worker
+	gcWorker         *worker.Worker
+	resolvedTsWorker *worker.Worker
 	wg               *sync.WaitGroup
 }
 
 type Raftstore struct {
-	ctx        *GlobalContext
-	storeState *storeState
-	router     *router
-	workers    *workers
-	tickDriver *tickDriver
-	closeCh    chan struct{}
-	wg         *sync.WaitGroup
+	ctx                *GlobalContext
+	storeState         *storeState
+	router             *router
+	workers            *workers
+	tickDriver         *tickDriver
+	loadStats          *runner.LoadStats
+	cdcObserver        *cdc.Observer
+	concurrencyManager *concurrency.Manager
+	closeCh            chan struct{}
+	wg                 *sync.WaitGroup
 }
 
 func (bs *Raftstore) start(
@@ -233,6 +250,8 @@ func (bs *Raftstore) start(
 		regionWorker:     worker.NewWorker("snapshot-worker", wg),
 		raftLogGCWorker:  worker.NewWorker("raft-gc-worker", wg),
 		schedulerWorker:  worker.NewWorker("scheduler-worker", wg),
+		gcWorker:         worker.NewWorker("gc-worker", wg),
+		resolvedTsWorker: worker.NewWorker("resolved-ts-worker", wg),
 		wg:               wg,
 	}
 	bs.ctx = &GlobalContext{
@@ -247,6 +266,11 @@ func (bs *Raftstore) start(
 		regionTaskSender:     bs.workers.regionWorker.Sender(),
 		splitCheckTaskSender: bs.workers.splitCheckWorker.Sender(),
 		raftLogGCTaskSender:  bs.workers.raftLogGCWorker.Sender(),
+		gcTaskSender:         bs.workers.gcWorker.Sender(),
+		resolvedTsTaskSender: bs.workers.resolvedTsWorker.Sender(),
+		loadStats:            bs.loadStats,
+		cdcObserver:          bs.cdcObserver,
+		concurrencyManager:   bs.concurrencyManager,
 		schedulerClient:      schedulerClient,
 		tickDriverSender:     bs.tickDriver.newRegionCh,
 	}
@@ -283,7 +307,9 @@ func (bs *Raftstore) startWorkers(peers []*peer) {
 	workers.splitCheckWorker.Start(runner.NewSplitCheckHandler(engines.Kv, NewRaftstoreRouter(router), cfg))
 	workers.regionWorker.Start(runner.NewRegionTaskHandler(engines, ctx.snapMgr))
 	workers.raftLogGCWorker.Start(runner.NewRaftLogGCTaskHandler())
-	workers.schedulerWorker.Start(runner.NewSchedulerTaskHandler(ctx.store.Id, ctx.schedulerClient, NewRaftstoreRouter(router)))
+	workers.schedulerWorker.Start(runner.NewSchedulerTaskHandler(ctx.store.Id, ctx.schedulerClient, NewRaftstoreRouter(router), bs.loadStats))
+	workers.gcWorker.Start(runner.NewGCTaskHandler(ctx.store.Id, ctx.schedulerClient, NewRaftstoreRouter(router)))
+	workers.resolvedTsWorker.Start(runner.NewResolvedTsTaskHandler(ctx.schedulerClient, ctx.concurrencyManager, NewRaftstoreRouter(router)))
 	go bs.tickDriver.run()
 }
 
@@ -300,18 +326,52 @@ func (bs *Raftstore) shutDown() {
 	workers.regionWorker.Stop()
 	workers.raftLogGCWorker.Stop()
 	workers.schedulerWorker.Stop()
+	workers.gcWorker.Stop()
+	workers.resolvedTsWorker.Stop()
 	workers.wg.Wait()
 }
 
+// LoadStats returns the load of the regions in this store, the storage readers
+// record the keys accessed by scans and the read flow into it.
+func (bs *Raftstore) LoadStats() *runner.LoadStats {
+	return bs.loadStats
+}
+
+// CdcObserver returns the observer of the changes applied to the regions in
+// this store.
+func (bs *Raftstore) CdcObserver() *cdc.Observer {
+	return bs.cdcObserver
+}
+
+// ConcurrencyManager returns the max read timestamp of this store, a follower
+// sends it with the read index request, so the leader's max timestamp is never
+// less than the timestamps of the reads served by the followers.
+func (bs *Raftstore) ConcurrencyManager() *concurrency.Manager {
+	return bs.concurrencyManager
+}
+
+// ResolvedTs returns the state of the resolved ts of the peer of the region in
+// this store.
+func (bs *Raftstore) ResolvedTs(regionID uint64) (ResolvedTsState, error) {
+	ps := bs.router.get(regionID)
+	if ps == nil {
+		return ResolvedTsState{}, &util.ErrRegionNotFound{RegionId: regionID}
+	}
+	return ps.peer.resolver.state(), nil
+}
+
 func CreateRaftstore(cfg *config.Config) (*RaftstoreRouter, *Raftstore) {
 	storeSender, storeState := newStoreState(cfg)
 	router := newRouter(storeSender)
 	raftstore := &Raftstore{
-		router:     router,
-		storeState: storeState,
-		tickDriver: newTickDriver(cfg.RaftBaseTickInterval, router, storeState.ticker),
-		closeCh:    make(chan struct{}),
-		wg:         new(sync.WaitGroup),
+		router:             router,
+		storeState:         storeState,
+		tickDriver:         newTickDriver(cfg.RaftBaseTickInterval, router, storeState.ticker),
+		loadStats:          runner.NewLoadStats(cfg.RegionSplitQPSThreshold > 0),
+		cdcObserver:        cdc.NewObserver(),
+		concurrencyManager: concurrency.NewManager(),
+		closeCh:            make(chan struct{}),
+		wg:                 new(sync.WaitGroup),
 	}
 	return NewRaftstoreRouter(router), raftstore
 }
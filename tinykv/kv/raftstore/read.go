@@ -0,0 +1,260 @@
+package raftstore
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	"github.com/Connor1996/badger"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/message"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/util"
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_cmdpb"
+	"github.com/pingcap-incubator/tinykv/raft"
+	"github.com/pingcap/log"
+)
+
+// Lease records an expired time, before which the leader is sure that no other
+// peer can be elected, so read requests can be served locally without asking
+// the quorum to confirm the leadership again.
+//
+// The lease is renewed with the time at which a ReadIndex request is proposed,
+// after the quorum has acknowledged the request. As a follower will not grant a
+// pre-vote until it has not heard from the leader for an election timeout, the
+// max lease must be less than the election timeout.
+type Lease struct {
+	maxLease time.Duration
+	bound    time.Time
+}
+
+func NewLease(maxLease time.Duration) *Lease {
+	return &Lease{maxLease: maxLease}
+}
+
+// Renew extends the lease bound with the given time, `ts` must be a time
+// before the leadership is confirmed by the quorum.
+func (l *Lease) Renew(ts time.Time) {
+	if l.maxLease == 0 {
+		return
+	}
+	bound := ts.Add(l.maxLease)
+	if bound.After(l.bound) {
+		l.bound = bound
+	}
+}
+
+// Expire makes the lease invalid immediately.
+func (l *Lease) Expire() {
+	l.bound = time.Time{}
+}
+
+// Valid returns true if the lease is still valid at `ts`.
+func (l *Lease) Valid(ts time.Time) bool {
+	return l.maxLease > 0 && ts.Before(l.bound)
+}
+
+// readIndexRequest is a read-only command waiting for its read index to be
+// confirmed by the quorum and then applied.
+type readIndexRequest struct {
+	id  uint64
+	req *raft_cmdpb.RaftCmdRequest
+	cb  *message.Callback
+	// The time at which the ReadIndex is proposed, used to renew the lease, or
+	// to fail a replica read whose read index is not confirmed in time.
+	renewLeaseTime time.Time
+	// Zero means the read index is not confirmed yet.
+	readIndex uint64
+	// The ts to advance the resolved ts of the leader with, the request has no
+	// command if it's set.
+	resolvedTs uint64
+}
+
+// readIndexCtxLen is the length of the context of a ReadIndex, which is made of
+// the peer id and the request id.
+const readIndexCtxLen = 16
+
+// binaryId returns the context of the ReadIndex. The ids are only unique in a
+// peer, the peer id is prepended, so the ReadIndex forwarded by a follower never
+// collides with a pending one of the leader, which would be dropped by raft.
+func (r *readIndexRequest) binaryId(peerId uint64) []byte {
+	ctx := make([]byte, readIndexCtxLen)
+	binary.BigEndian.PutUint64(ctx, peerId)
+	binary.BigEndian.PutUint64(ctx[8:], r.id)
+	return ctx
+}
+
+// replicaReadCtx appends the max read timestamp of the store to the context, it's
+// the context of a follower's ReadIndex. The leader updates its max timestamp
+// with it and strips it before handling the request, so the read index covers
+// the locks of the async commit transactions which may commit before the read.
+func (r *readIndexRequest) replicaReadCtx(peerId, maxTs uint64) []byte {
+	ctx := make([]byte, readIndexCtxLen+8)
+	binary.BigEndian.PutUint64(ctx, peerId)
+	binary.BigEndian.PutUint64(ctx[8:], r.id)
+	binary.BigEndian.PutUint64(ctx[readIndexCtxLen:], maxTs)
+	return ctx
+}
+
+type readIndexQueue struct {
+	nextId uint64
+	reads  []*readIndexRequest
+}
+
+func (q *readIndexQueue) push(req *raft_cmdpb.RaftCmdRequest, cb *message.Callback, now time.Time) *readIndexRequest {
+	q.nextId++
+	read := &readIndexRequest{
+		id:             q.nextId,
+		req:            req,
+		cb:             cb,
+		renewLeaseTime: now,
+	}
+	q.reads = append(q.reads, read)
+	return read
+}
+
+// isReadOnlyRequest returns true if the command only contains Get or Snap requests.
+func isReadOnlyRequest(req *raft_cmdpb.RaftCmdRequest) bool {
+	if req.AdminRequest != nil || len(req.Requests) == 0 {
+		return false
+	}
+	for _, r := range req.Requests {
+		if r.CmdType != raft_cmdpb.CmdType_Get && r.CmdType != raft_cmdpb.CmdType_Snap {
+			return false
+		}
+	}
+	return true
+}
+
+// isReplicaRead returns true if the command can be served by a follower.
+func isReplicaRead(req *raft_cmdpb.RaftCmdRequest) bool {
+	header := req.GetHeader()
+	return (header.GetReplicaRead() || header.GetStaleRead()) && isReadOnlyRequest(req)
+}
+
+// handleReadStates binds the read indexes confirmed by the raft group to the
+// pending reads, and renews the lease if the peer is the leader.
+func (p *peer) handleReadStates(readStates []raft.ReadState) {
+	for _, rs := range readStates {
+		if len(rs.RequestCtx) != readIndexCtxLen || binary.BigEndian.Uint64(rs.RequestCtx) != p.PeerId() {
+			continue
+		}
+		id := binary.BigEndian.Uint64(rs.RequestCtx[8:])
+		for _, read := range p.pendingReads.reads {
+			if read.id == id {
+				read.readIndex = rs.Index
+				if p.IsLeader() {
+					p.leaderLease.Renew(read.renewLeaseTime)
+				}
+				break
+			}
+		}
+	}
+}
+
+// serveReads responds to the pending reads whose read index has been applied.
+func (p *peer) serveReads() {
+	if len(p.pendingReads.reads) == 0 {
+		return
+	}
+	appliedIndex := p.peerStorage.AppliedIndex()
+	remains := p.pendingReads.reads[:0]
+	for _, read := range p.pendingReads.reads {
+		if read.readIndex == 0 || read.readIndex > appliedIndex {
+			remains = append(remains, read)
+			continue
+		}
+		if read.resolvedTs != 0 {
+			p.resolver.advance(read.resolvedTs)
+			continue
+		}
+		p.execReadLocal(read.req, read.cb)
+	}
+	p.pendingReads.reads = remains
+}
+
+// clearPendingReads fails the reads whose read index is not confirmed yet,
+// it's called when the peer is not the leader any more, or the leader of a
+// follower changes, as the read index requests may be dropped.
+func (p *peer) clearPendingReads() {
+	p.failUnconfirmedReads(func(*readIndexRequest) bool { return true })
+}
+
+// expireReplicaReads fails the reads of a follower whose read index is not
+// confirmed before the timeout, the request or the response may be lost.
+func (p *peer) expireReplicaReads(now time.Time, timeout time.Duration) {
+	if p.IsLeader() || len(p.pendingReads.reads) == 0 {
+		return
+	}
+	p.failUnconfirmedReads(func(read *readIndexRequest) bool {
+		return now.Sub(read.renewLeaseTime) >= timeout
+	})
+}
+
+func (p *peer) failUnconfirmedReads(shouldFail func(read *readIndexRequest) bool) {
+	remains := p.pendingReads.reads[:0]
+	for _, read := range p.pendingReads.reads {
+		if read.readIndex != 0 || !shouldFail(read) {
+			remains = append(remains, read)
+			continue
+		}
+		leader := p.getPeerFromCache(p.LeaderId())
+		read.cb.Done(ErrRespWithTerm(&util.ErrNotLeader{RegionId: p.regionId, Leader: leader}, p.Term()))
+	}
+	p.pendingReads.reads = remains
+}
+
+// execReadLocal executes the read-only command on the kv engine directly, the
+// caller must ensure the data the command needs to see has been applied.
+func (p *peer) execReadLocal(req *raft_cmdpb.RaftCmdRequest, cb *message.Callback) {
+	region := p.Region()
+	// The region may be changed while the read is waiting to be served.
+	if err := util.CheckRegionEpoch(req, region, true); err != nil {
+		cb.Done(ErrRespWithTerm(err, p.Term()))
+		return
+	}
+	kv := p.peerStorage.Engines.Kv
+	resps := make([]*raft_cmdpb.Response, 0, len(req.Requests))
+	var txn *badger.Txn
+	for _, r := range req.Requests {
+		switch r.CmdType {
+		case raft_cmdpb.CmdType_Get:
+			key := r.Get.GetKey()
+			if err := util.CheckKeyInRegion(key, region); err != nil {
+				cb.Done(ErrRespWithTerm(err, p.Term()))
+				return
+			}
+			cf := r.Get.GetCf()
+			if len(cf) == 0 {
+				cf = engine_util.CfDefault
+			}
+			val, err := engine_util.GetCF(kv, cf, key)
+			if err == badger.ErrKeyNotFound {
+				err = nil
+				val = nil
+			}
+			if err != nil {
+				cb.Done(ErrRespWithTerm(err, p.Term()))
+				return
+			}
+			resps = append(resps, &raft_cmdpb.Response{
+				CmdType: raft_cmdpb.CmdType_Get,
+				Get:     &raft_cmdpb.GetResponse{Value: val},
+			})
+		case raft_cmdpb.CmdType_Snap:
+			resps = append(resps, &raft_cmdpb.Response{
+				CmdType: raft_cmdpb.CmdType_Snap,
+				Snap:    &raft_cmdpb.SnapResponse{Region: region},
+			})
+			txn = kv.NewTransaction(false)
+		default:
+			log.Fatal(fmt.Sprintf("%v invalid read cmd type=%v", p.Tag, r.CmdType))
+		}
+	}
+	resp := newCmdResp()
+	resp.Responses = resps
+	BindRespTerm(resp, p.Term())
+	if cb != nil {
+		cb.Txn = txn
+	}
+	cb.Done(resp)
+}
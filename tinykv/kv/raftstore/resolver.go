@@ -0,0 +1,213 @@
+package raftstore
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+
+	"github.com/Connor1996/badger"
+	"github.com/pingcap-incubator/tinykv/kv/transaction/mvcc"
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_cmdpb"
+	"github.com/pingcap/log"
+)
+
+// resolver tracks the resolved ts of a peer, the largest ts at or before which no transaction of the region commits
+// later, so the stale reads at or before it can be served from the applied state of the peer.
+//
+// The applier tracks the prewrite locks applied to the region, the locks are scanned from the engine when the
+// applier applies the first entries after the peer is created or the range of the region is changed. The leader
+// advances the resolved ts to a ts from the TSO, or the smallest start ts of the locks, after its leadership is
+// confirmed by the quorum, and sends it with the applied index to the followers in the heartbeats. A follower may
+// not have applied the prewrites applied by the leader, so it takes the resolved ts of the leader after it has
+// applied the index.
+//
+// The resolver is shared by the peer and the applier, it's safe for concurrent use.
+type resolver struct {
+	mu sync.Mutex
+	// initialized is false until the locks are scanned, the resolved ts is not advanced before that.
+	initialized bool
+	// locks are the start ts of the prewrite locks keyed by the key.
+	locks map[string]uint64
+	// appliedIndex is the index of the last entry written to the engine, the locks are tracked up to it.
+	appliedIndex uint64
+	// minIndex is the applied index at which the locks are scanned, the resolved ts computed before it may not
+	// cover the range of the region.
+	minIndex uint64
+
+	resolvedTs uint64
+	// resolvedIndex is the applied index of the leader at which the resolved ts is computed.
+	resolvedIndex uint64
+	// The resolved ts of the leader received before the follower applies its index.
+	pendingTs    uint64
+	pendingIndex uint64
+}
+
+func newResolver() *resolver {
+	return &resolver{}
+}
+
+// reset clears the locks and the resolved ts, it's called when the range of the region is changed.
+func (r *resolver) reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.initialized = false
+	r.locks = nil
+	r.appliedIndex, r.minIndex = 0, 0
+	r.resolvedTs, r.resolvedIndex = 0, 0
+	r.pendingTs, r.pendingIndex = 0, 0
+}
+
+// maybeInitialize scans the locks of the region from the engine if they are not tracked yet, the applied state of the
+// region must have been written to the engine.
+func (r *resolver) maybeInitialize(db *badger.DB, region *metapb.Region, appliedIndex uint64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.initialized {
+		return
+	}
+	locks := make(map[string]uint64)
+	err := db.View(func(txn *badger.Txn) error {
+		it := engine_util.NewCFIterator(engine_util.CfLock, txn)
+		defer it.Close()
+		for it.Seek(region.GetStartKey()); it.Valid(); it.Next() {
+			item := it.Item()
+			if engine_util.ExceedEndKey(item.Key(), region.GetEndKey()) {
+				break
+			}
+			value, err := item.Value()
+			if err != nil {
+				return err
+			}
+			lock, err := mvcc.ParseLock(value)
+			if err != nil {
+				return err
+			}
+			if lock.Kind != mvcc.WriteKindPessimisticLock {
+				locks[string(item.Key())] = lock.Ts
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error(fmt.Sprintf("[region %d] scan locks failed, err %v", region.GetId(), err))
+		return
+	}
+	r.initialized = true
+	r.locks = locks
+	r.appliedIndex = appliedIndex
+	r.minIndex = appliedIndex
+}
+
+// track tracks the prewrite locks put or deleted by the requests of an applied command. A pessimistic lock doesn't
+// write anything until it's prewritten, the transaction gets its commit ts after that.
+func (r *resolver) track(reqs []*raft_cmdpb.Request) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.initialized {
+		return
+	}
+	for _, req := range reqs {
+		switch req.CmdType {
+		case raft_cmdpb.CmdType_Put:
+			if req.Put.Cf != engine_util.CfLock {
+				continue
+			}
+			lock, err := mvcc.ParseLock(req.Put.Value)
+			if err != nil {
+				log.Warn(fmt.Sprintf("resolver failed to parse lock %v, err %v", req.Put.Key, err))
+				continue
+			}
+			if lock.Kind != mvcc.WriteKindPessimisticLock {
+				r.locks[string(req.Put.Key)] = lock.Ts
+			}
+		case raft_cmdpb.CmdType_Delete:
+			if req.Delete.Cf == engine_util.CfLock {
+				delete(r.locks, string(req.Delete.Key))
+			}
+		case raft_cmdpb.CmdType_DeleteRange:
+			if req.DeleteRange.Cf != engine_util.CfLock {
+				continue
+			}
+			for key := range r.locks {
+				if bytes.Compare([]byte(key), req.DeleteRange.StartKey) >= 0 &&
+					!engine_util.ExceedEndKey([]byte(key), req.DeleteRange.EndKey) {
+					delete(r.locks, key)
+				}
+			}
+		}
+	}
+}
+
+// setAppliedIndex is called after the entry at index is written to the engine.
+func (r *resolver) setAppliedIndex(index uint64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.initialized {
+		return
+	}
+	r.appliedIndex = index
+	if r.pendingIndex != 0 && r.pendingIndex <= index {
+		r.update(r.pendingTs, r.pendingIndex)
+		r.pendingTs, r.pendingIndex = 0, 0
+	}
+}
+
+// advance is called by the leader with a ts fetched from the TSO before its leadership is confirmed. The transactions
+// prewritten after that get their commit ts from the TSO after the ts, and the async commit ones get their commit ts
+// after the max ts of the store, which has been updated with the ts.
+func (r *resolver) advance(ts uint64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.initialized {
+		return
+	}
+	for _, lockTs := range r.locks {
+		if lockTs < ts {
+			ts = lockTs
+		}
+	}
+	r.update(ts, r.appliedIndex)
+}
+
+// updateLeader is called by a follower with the resolved ts received from the leader.
+func (r *resolver) updateLeader(ts, index uint64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.initialized || index < r.minIndex || ts <= r.resolvedTs {
+		return
+	}
+	if index <= r.appliedIndex {
+		r.update(ts, index)
+		return
+	}
+	if ts > r.pendingTs {
+		r.pendingTs, r.pendingIndex = ts, index
+	}
+}
+
+func (r *resolver) update(ts, index uint64) {
+	if ts > r.resolvedTs {
+		r.resolvedTs, r.resolvedIndex = ts, index
+	}
+}
+
+// ResolvedTsState is the state of the resolved ts of a peer.
+type ResolvedTsState struct {
+	ResolvedTs    uint64
+	ResolvedIndex uint64
+	AppliedIndex  uint64
+	LockCount     int
+}
+
+func (r *resolver) state() ResolvedTsState {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return ResolvedTsState{
+		ResolvedTs:    r.resolvedTs,
+		ResolvedIndex: r.resolvedIndex,
+		AppliedIndex:  r.appliedIndex,
+		LockCount:     len(r.locks),
+	}
+}
@@ -0,0 +1,117 @@
+package raftstore
+
+import (
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/util"
+	"github.com/pingcap-incubator/tinykv/kv/transaction/mvcc"
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_cmdpb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func lockValue(ts uint64, kind mvcc.WriteKind) []byte {
+	return (&mvcc.Lock{Primary: []byte("a"), Ts: ts, Ttl: 100, Kind: kind}).ToBytes()
+}
+
+func putLockReq(key string, ts uint64, kind mvcc.WriteKind) *raft_cmdpb.Request {
+	return &raft_cmdpb.Request{
+		CmdType: raft_cmdpb.CmdType_Put,
+		Put:     &raft_cmdpb.PutRequest{Cf: engine_util.CfLock, Key: []byte(key), Value: lockValue(ts, kind)},
+	}
+}
+
+func deleteLockReq(key string) *raft_cmdpb.Request {
+	return &raft_cmdpb.Request{
+		CmdType: raft_cmdpb.CmdType_Delete,
+		Delete:  &raft_cmdpb.DeleteRequest{Cf: engine_util.CfLock, Key: []byte(key)},
+	}
+}
+
+func TestResolverInitialize(t *testing.T) {
+	engines := util.NewTestEngines()
+	defer engines.Destroy()
+	require.Nil(t, engine_util.PutCF(engines.Kv, engine_util.CfLock, []byte("a"), lockValue(20, mvcc.WriteKindPut)))
+	require.Nil(t, engine_util.PutCF(engines.Kv, engine_util.CfLock, []byte("b"), lockValue(10, mvcc.WriteKindPessimisticLock)))
+	require.Nil(t, engine_util.PutCF(engines.Kv, engine_util.CfLock, []byte("c"), lockValue(5, mvcc.WriteKindPut)))
+
+	r := newResolver()
+	// The resolved ts is not advanced before the locks are scanned.
+	r.advance(100)
+	assert.Equal(t, uint64(0), r.state().ResolvedTs)
+
+	r.maybeInitialize(engines.Kv, &metapb.Region{Id: 1, EndKey: []byte("c")}, 10)
+	state := r.state()
+	assert.Equal(t, 1, state.LockCount)
+	assert.Equal(t, uint64(10), state.AppliedIndex)
+	r.advance(100)
+	assert.Equal(t, uint64(20), r.state().ResolvedTs)
+}
+
+func TestResolverTrack(t *testing.T) {
+	engines := util.NewTestEngines()
+	defer engines.Destroy()
+	r := newResolver()
+	r.maybeInitialize(engines.Kv, &metapb.Region{Id: 1}, 5)
+
+	r.track([]*raft_cmdpb.Request{putLockReq("a", 30, mvcc.WriteKindPut), putLockReq("b", 40, mvcc.WriteKindDelete)})
+	r.track([]*raft_cmdpb.Request{putLockReq("c", 10, mvcc.WriteKindPessimisticLock)})
+	r.setAppliedIndex(6)
+	r.advance(100)
+	state := r.state()
+	assert.Equal(t, uint64(30), state.ResolvedTs)
+	assert.Equal(t, uint64(6), state.ResolvedIndex)
+	assert.Equal(t, 2, state.LockCount)
+
+	r.track([]*raft_cmdpb.Request{deleteLockReq("a")})
+	r.setAppliedIndex(7)
+	r.advance(100)
+	assert.Equal(t, uint64(40), r.state().ResolvedTs)
+
+	r.track([]*raft_cmdpb.Request{{
+		CmdType:     raft_cmdpb.CmdType_DeleteRange,
+		DeleteRange: &raft_cmdpb.DeleteRangeRequest{Cf: engine_util.CfLock, StartKey: []byte("a"), EndKey: []byte("c")},
+	}})
+	r.setAppliedIndex(8)
+	r.advance(100)
+	state = r.state()
+	assert.Equal(t, uint64(100), state.ResolvedTs)
+	assert.Equal(t, 0, state.LockCount)
+	// The resolved ts never goes back.
+	r.advance(50)
+	assert.Equal(t, uint64(100), r.state().ResolvedTs)
+}
+
+func TestResolverUpdateLeader(t *testing.T) {
+	engines := util.NewTestEngines()
+	defer engines.Destroy()
+	r := newResolver()
+	r.maybeInitialize(engines.Kv, &metapb.Region{Id: 1}, 10)
+
+	// The resolved ts computed before the locks are scanned is ignored.
+	r.updateLeader(50, 9)
+	assert.Equal(t, uint64(0), r.state().ResolvedTs)
+
+	r.updateLeader(50, 10)
+	assert.Equal(t, uint64(50), r.state().ResolvedTs)
+
+	// The follower takes the resolved ts after it applies the index of the leader.
+	r.updateLeader(80, 12)
+	assert.Equal(t, uint64(50), r.state().ResolvedTs)
+	r.setAppliedIndex(11)
+	assert.Equal(t, uint64(50), r.state().ResolvedTs)
+	r.setAppliedIndex(12)
+	state := r.state()
+	assert.Equal(t, uint64(80), state.ResolvedTs)
+	assert.Equal(t, uint64(12), state.ResolvedIndex)
+
+	r.reset()
+	state = r.state()
+	assert.Equal(t, uint64(0), state.ResolvedTs)
+	assert.Equal(t, 0, state.LockCount)
+	r.updateLeader(100, 13)
+	r.setAppliedIndex(13)
+	assert.Equal(t, uint64(0), r.state().ResolvedTs)
+}
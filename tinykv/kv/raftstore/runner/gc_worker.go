@@ -0,0 +1,236 @@
+package runner
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Connor1996/badger"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/message"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/scheduler_client"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/util"
+	"github.com/pingcap-incubator/tinykv/kv/storage"
+	"github.com/pingcap-incubator/tinykv/kv/transaction/mvcc"
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap-incubator/tinykv/kv/util/worker"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_cmdpb"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+)
+
+// gcMaxBatchKeys is the max number of keys collected by one raft command.
+const gcMaxBatchKeys = 256
+
+// gcRequestTimeout is the timeout of the raft commands sent by the GC worker,
+// so the worker doesn't block the shutdown of the store.
+const gcRequestTimeout = 10 * time.Second
+
+// GCTask asks the GC worker to collect the regions with the newest safe point
+// of the cluster.
+type GCTask struct {
+	Regions []*metapb.Region
+}
+
+type gcTaskHandler struct {
+	storeID         uint64
+	schedulerClient scheduler_client.Client
+	router          message.RaftRouter
+	// safePoint is the safe point of the last round which collected all the
+	// regions, a round is skipped if the safe point doesn't advance.
+	safePoint uint64
+}
+
+// NewGCTaskHandler creates a handler which pulls the safe point from the
+// scheduler and deletes the versions invisible at the safe point. Only the
+// regions led by the store are collected, and the deletes are proposed
+// through raft so all the replicas are collected.
+func NewGCTaskHandler(storeID uint64, schedulerClient scheduler_client.Client, router message.RaftRouter) *gcTaskHandler {
+	return &gcTaskHandler{
+		storeID:         storeID,
+		schedulerClient: schedulerClient,
+		router:          router,
+	}
+}
+
+func (h *gcTaskHandler) Handle(t worker.Task) {
+	gcTask, ok := t.(*GCTask)
+	if !ok {
+		log.Error(fmt.Sprintf("unsupported worker.Task: %+v", t))
+		return
+	}
+	safePoint, err := h.schedulerClient.GetGCSafePoint(context.TODO())
+	if err != nil {
+		log.Error("get gc safe point failed", zap.Error(err))
+		return
+	}
+	if safePoint <= h.safePoint {
+		return
+	}
+	log.Info("start gc", zap.Uint64("store-id", h.storeID), zap.Uint64("safe-point", safePoint), zap.Int("regions", len(gcTask.Regions)))
+	failed := 0
+	for _, region := range gcTask.Regions {
+		collected, err := h.gcRegion(region, safePoint)
+		if err != nil {
+			log.Error("gc region failed", zap.Uint64("region-id", region.GetId()), zap.Error(err))
+			failed++
+			continue
+		}
+		if collected > 0 {
+			log.Debug("gc region", zap.Uint64("region-id", region.GetId()), zap.Int("keys", collected))
+		}
+	}
+	if failed > 0 {
+		// The safe point is kept, so the next round collects the regions again.
+		log.Warn("gc regions failed", zap.Uint64("safe-point", safePoint), zap.Int("failed", failed))
+		return
+	}
+	h.safePoint = safePoint
+}
+
+// gcRegion collects the region and returns the number of the collected keys.
+// It does nothing if the peer of the store is not the leader.
+func (h *gcTaskHandler) gcRegion(region *metapb.Region, safePoint uint64) (int, error) {
+	peer := util.FindPeer(region, h.storeID)
+	if peer == nil {
+		return 0, nil
+	}
+	header := &raft_cmdpb.RaftRequestHeader{
+		RegionId:    region.GetId(),
+		Peer:        peer,
+		RegionEpoch: region.GetRegionEpoch(),
+	}
+	reader, err := h.snapshot(header)
+	if reader == nil || err != nil {
+		return 0, err
+	}
+	defer reader.Close()
+
+	snapTxn := mvcc.RoTxn{Reader: reader, StartTS: safePoint}
+	keys, err := mvcc.KeysToGC(&snapTxn, nil, nil)
+	if err != nil {
+		return 0, err
+	}
+	collected := 0
+	for len(keys) > collected {
+		batch := keys[collected:]
+		if len(batch) > gcMaxBatchKeys {
+			batch = batch[:gcMaxBatchKeys]
+		}
+		txn := mvcc.NewTxn(reader, safePoint)
+		for _, key := range batch {
+			if err := txn.GC(key); err != nil {
+				return collected, err
+			}
+		}
+		if err := h.write(header, txn.Writes()); err != nil {
+			return collected, err
+		}
+		collected += len(batch)
+	}
+	return collected, nil
+}
+
+// snapshot takes a snapshot of the region on the leader, so the versions to
+// collect are consistent with the region. It returns nil if the peer is not
+// the leader or the region has changed.
+func (h *gcTaskHandler) snapshot(header *raft_cmdpb.RaftRequestHeader) (*regionSnapshot, error) {
+	cb := message.NewCallback()
+	err := h.router.SendRaftCommand(&raft_cmdpb.RaftCmdRequest{
+		Header: header,
+		Requests: []*raft_cmdpb.Request{{
+			CmdType: raft_cmdpb.CmdType_Snap,
+			Snap:    &raft_cmdpb.SnapRequest{},
+		}},
+	}, cb)
+	if err != nil {
+		return nil, err
+	}
+	resp := cb.WaitRespWithTimeout(gcRequestTimeout)
+	if resp == nil {
+		return nil, errors.New("take snapshot timeout")
+	}
+	if resp.GetHeader().GetError() != nil || len(resp.GetResponses()) != 1 || cb.Txn == nil {
+		if cb.Txn != nil {
+			cb.Txn.Discard()
+		}
+		log.Debug("skip gc region", zap.Uint64("region-id", header.GetRegionId()), zap.Stringer("error", resp.GetHeader().GetError()))
+		return nil, nil
+	}
+	return &regionSnapshot{txn: cb.Txn, region: resp.Responses[0].GetSnap().GetRegion()}, nil
+}
+
+func (h *gcTaskHandler) write(header *raft_cmdpb.RaftRequestHeader, modifies []storage.Modify) error {
+	var reqs []*raft_cmdpb.Request
+	for _, m := range modifies {
+		reqs = append(reqs, &raft_cmdpb.Request{
+			CmdType: raft_cmdpb.CmdType_Delete,
+			Delete: &raft_cmdpb.DeleteRequest{
+				Cf:  m.Cf(),
+				Key: m.Key(),
+			},
+		})
+	}
+	if len(reqs) == 0 {
+		return nil
+	}
+	cb := message.NewCallback()
+	if err := h.router.SendRaftCommand(&raft_cmdpb.RaftCmdRequest{Header: header, Requests: reqs}, cb); err != nil {
+		return err
+	}
+	resp := cb.WaitRespWithTimeout(gcRequestTimeout)
+	if resp == nil {
+		return errors.New("write gc timeout")
+	}
+	if resp.GetHeader().GetError() != nil {
+		return errors.New(resp.GetHeader().GetError().String())
+	}
+	return nil
+}
+
+// regionSnapshot reads a region from the snapshot taken on the leader, the
+// reads outside the region are clamped to the region.
+type regionSnapshot struct {
+	txn    *badger.Txn
+	region *metapb.Region
+}
+
+func (r *regionSnapshot) GetCF(cf string, key []byte) ([]byte, error) {
+	if err := util.CheckKeyInRegion(key, r.region); err != nil {
+		return nil, err
+	}
+	val, err := engine_util.GetCFFromTxn(r.txn, cf, key)
+	if err == badger.ErrKeyNotFound {
+		return nil, nil
+	}
+	return val, err
+}
+
+func (r *regionSnapshot) IterCF(cf string) engine_util.DBIterator {
+	return &regionSnapshotIterator{
+		BadgerIterator: engine_util.NewCFIterator(cf, r.txn),
+		region:         r.region,
+	}
+}
+
+func (r *regionSnapshot) Close() {
+	r.txn.Discard()
+}
+
+type regionSnapshotIterator struct {
+	*engine_util.BadgerIterator
+	region *metapb.Region
+}
+
+func (it *regionSnapshotIterator) Valid() bool {
+	return it.BadgerIterator.Valid() && !engine_util.ExceedEndKey(it.Item().Key(), it.region.GetEndKey())
+}
+
+func (it *regionSnapshotIterator) Seek(key []byte) {
+	if bytes.Compare(key, it.region.GetStartKey()) < 0 {
+		key = it.region.GetStartKey()
+	}
+	it.BadgerIterator.Seek(key)
+}
@@ -0,0 +1,227 @@
+package runner
+
+import (
+	"bytes"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/util"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+)
+
+const (
+	// The max number of keys sampled for a region in a split check period.
+	loadSampleNum = 64
+	// The load split checker doesn't split a region with fewer samples, as
+	// the samples can't represent the load of the region well.
+	minLoadSampleNum = 16
+	// The max tolerable difference between the sampled accesses of the two
+	// split regions, in proportion to all the sampled accesses.
+	loadBalanceScore = 0.25
+)
+
+// RegionLoad records the keys accessed in a region since a time, the keys
+// are sampled by reservoir sampling so every access has the same chance to
+// be sampled.
+type RegionLoad struct {
+	Since   time.Time
+	Count   uint64
+	Samples [][]byte
+}
+
+// QPS returns the accesses per second of the region, `minPeriod` avoids a
+// burst of accesses in a short period being regarded as a high QPS.
+func (l *RegionLoad) QPS(now time.Time, minPeriod time.Duration) uint64 {
+	period := now.Sub(l.Since)
+	if period < minPeriod {
+		period = minPeriod
+	}
+	if period <= 0 {
+		return l.Count
+	}
+	return uint64(float64(l.Count) / period.Seconds())
+}
+
+func (l *RegionLoad) record(key []byte) {
+	l.Count++
+	if len(l.Samples) < loadSampleNum {
+		l.Samples = append(l.Samples, util.SafeCopy(key))
+		return
+	}
+	if i := rand.Int63n(int64(l.Count)); i < loadSampleNum {
+		l.Samples[i] = util.SafeCopy(key)
+	}
+}
+
+// FlowStats records the bytes and keys read and written since a time.
+type FlowStats struct {
+	Since        time.Time
+	WrittenBytes uint64
+	WrittenKeys  uint64
+	ReadBytes    uint64
+	ReadKeys     uint64
+}
+
+// LoadStats collects the load of the regions in a store. The keys accessed
+// by gets and writes are recorded by the raftstore, and the keys accessed by
+// scans are recorded by the storage readers, so it's safe for concurrent use.
+//
+// Besides the sampled keys for load based split, it collects the read and
+// written flow of the regions and the store, which are reported to the
+// scheduler by heartbeats.
+type LoadStats struct {
+	sync.Mutex
+	sampleKeys bool
+	regions    map[uint64]*RegionLoad
+	flows      map[uint64]*FlowStats
+	storeFlow  *FlowStats
+}
+
+// NewLoadStats creates a LoadStats, the accessed keys are sampled only if
+// `sampleKeys` is true.
+func NewLoadStats(sampleKeys bool) *LoadStats {
+	return &LoadStats{
+		sampleKeys: sampleKeys,
+		regions:    make(map[uint64]*RegionLoad),
+		flows:      make(map[uint64]*FlowStats),
+		storeFlow:  &FlowStats{Since: time.Now()},
+	}
+}
+
+// Record records an access to the key of the region.
+func (s *LoadStats) Record(regionID uint64, key []byte) {
+	if !s.sampleKeys {
+		return
+	}
+	s.Lock()
+	defer s.Unlock()
+	load, ok := s.regions[regionID]
+	if !ok {
+		load = &RegionLoad{Since: time.Now()}
+		s.regions[regionID] = load
+	}
+	load.record(key)
+}
+
+// Take returns the load of the region recorded so far, and starts a new
+// record period for the region. It returns nil if the region is not accessed.
+func (s *LoadStats) Take(regionID uint64) *RegionLoad {
+	s.Lock()
+	defer s.Unlock()
+	load, ok := s.regions[regionID]
+	if !ok {
+		return nil
+	}
+	if load.Count == 0 {
+		delete(s.regions, regionID)
+		return nil
+	}
+	s.regions[regionID] = &RegionLoad{Since: time.Now()}
+	return load
+}
+
+func (s *LoadStats) regionFlow(regionID uint64) *FlowStats {
+	flow, ok := s.flows[regionID]
+	if !ok {
+		flow = &FlowStats{Since: time.Now()}
+		s.flows[regionID] = flow
+	}
+	return flow
+}
+
+// RecordRead records the bytes and keys read from the region.
+func (s *LoadStats) RecordRead(regionID uint64, bytes, keys uint64) {
+	s.Lock()
+	defer s.Unlock()
+	flow := s.regionFlow(regionID)
+	flow.ReadBytes += bytes
+	flow.ReadKeys += keys
+	s.storeFlow.ReadBytes += bytes
+	s.storeFlow.ReadKeys += keys
+}
+
+// RecordWrite records the bytes and keys written to the region.
+func (s *LoadStats) RecordWrite(regionID uint64, bytes, keys uint64) {
+	s.Lock()
+	defer s.Unlock()
+	flow := s.regionFlow(regionID)
+	flow.WrittenBytes += bytes
+	flow.WrittenKeys += keys
+	s.storeFlow.WrittenBytes += bytes
+	s.storeFlow.WrittenKeys += keys
+}
+
+// TakeRegionFlow returns the flow of the region since the last time it's
+// taken, or since the region is accessed for the first time.
+func (s *LoadStats) TakeRegionFlow(regionID uint64) *FlowStats {
+	s.Lock()
+	defer s.Unlock()
+	now := time.Now()
+	flow, ok := s.flows[regionID]
+	if !ok {
+		return &FlowStats{Since: now}
+	}
+	s.flows[regionID] = &FlowStats{Since: now}
+	return flow
+}
+
+// TakeStoreFlow returns the flow of the store since the last time it's taken.
+func (s *LoadStats) TakeStoreFlow() *FlowStats {
+	s.Lock()
+	defer s.Unlock()
+	flow := s.storeFlow
+	s.storeFlow = &FlowStats{Since: time.Now()}
+	return flow
+}
+
+// Remove removes the load record of the region.
+func (s *LoadStats) Remove(regionID uint64) {
+	s.Lock()
+	defer s.Unlock()
+	delete(s.regions, regionID)
+	delete(s.flows, regionID)
+}
+
+// loadSplitChecker picks a split key which splits the sampled accesses of a
+// region into two balanced parts.
+type loadSplitChecker struct{}
+
+func newLoadSplitChecker() *loadSplitChecker {
+	return &loadSplitChecker{}
+}
+
+func (checker *loadSplitChecker) getSplitKey(region *metapb.Region, load *RegionLoad) []byte {
+	samples := make([][]byte, 0, len(load.Samples))
+	for _, key := range load.Samples {
+		if util.CheckKeyInRegion(key, region) == nil {
+			samples = append(samples, key)
+		}
+	}
+	if len(samples) < minLoadSampleNum {
+		return nil
+	}
+	sort.Slice(samples, func(i, j int) bool {
+		return bytes.Compare(samples[i], samples[j]) < 0
+	})
+
+	splitKey := truncateSplitKey(samples[len(samples)/2])
+	// The keys equal to the split key belong to the right region.
+	left := sort.Search(len(samples), func(i int) bool {
+		return bytes.Compare(samples[i], splitKey) >= 0
+	})
+	right := len(samples) - left
+	if left == 0 || right == 0 {
+		// All the accesses are on a single key, no way to split the load.
+		return nil
+	}
+	diff := left - right
+	if diff < 0 {
+		diff = -diff
+	}
+	if float64(diff)/float64(len(samples)) > loadBalanceScore {
+		return nil
+	}
+	return splitKey
+}
@@ -0,0 +1,58 @@
+package runner
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/message"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/scheduler_client"
+	"github.com/pingcap-incubator/tinykv/kv/transaction/concurrency"
+	"github.com/pingcap-incubator/tinykv/kv/util/worker"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+)
+
+// ResolvedTsTask asks the resolved ts worker to advance the resolved ts of
+// the regions.
+type ResolvedTsTask struct {
+	RegionIDs []uint64
+}
+
+type resolvedTsTaskHandler struct {
+	schedulerClient    scheduler_client.Client
+	concurrencyManager *concurrency.Manager
+	router             message.RaftRouter
+}
+
+// NewResolvedTsTaskHandler creates a handler which fetches a ts from the TSO
+// and sends it to the peers of the regions, the leaders advance their
+// resolved ts with it. Fetching the ts blocks the worker, so it doesn't run
+// in the raft worker.
+func NewResolvedTsTaskHandler(schedulerClient scheduler_client.Client, concurrencyManager *concurrency.Manager,
+	router message.RaftRouter) *resolvedTsTaskHandler {
+	return &resolvedTsTaskHandler{
+		schedulerClient:    schedulerClient,
+		concurrencyManager: concurrencyManager,
+		router:             router,
+	}
+}
+
+func (h *resolvedTsTaskHandler) Handle(t worker.Task) {
+	task, ok := t.(*ResolvedTsTask)
+	if !ok {
+		log.Error(fmt.Sprintf("unsupported worker.Task: %+v", t))
+		return
+	}
+	ts, err := h.schedulerClient.GetTS(context.TODO())
+	if err != nil {
+		log.Error("get ts failed", zap.Error(err))
+		return
+	}
+	// The async commit and 1PC transactions prewritten after this commit
+	// after ts, and UpdateMaxTs waits for the ones which have read a smaller
+	// max ts, whose locks are applied when it returns.
+	h.concurrencyManager.UpdateMaxTs(ts)
+	for _, regionID := range task.RegionIDs {
+		_ = h.router.Send(regionID, message.NewPeerMsg(message.MsgTypeAdvanceResolvedTs, regionID, ts))
+	}
+}
@@ -2,6 +2,7 @@ package runner
 
 import (
 	"encoding/binary"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"testing"
@@ -276,3 +277,69 @@ func TestSplitCheck(t *testing.T) {
 	assert.True(t, ok)
 	assert.Equal(t, codec.EncodeBytes([]byte("k2")), split.SplitKey)
 }
+
+func TestLoadSplitCheck(t *testing.T) {
+	engines := util.NewTestEngines()
+	defer cleanUpTestEngineData(engines)
+	taskResCh := make(chan message.Msg, 1)
+
+	runner := &splitCheckHandler{
+		engine:      engines.Kv,
+		router:      &TaskResRouter{ch: taskResCh},
+		checker:     newSizeSplitChecker(100, 50),
+		loadChecker: newLoadSplitChecker(),
+	}
+	region := &metapb.Region{
+		StartKey: []byte(""),
+		EndKey:   []byte(""),
+	}
+
+	load := &RegionLoad{}
+	for i := 0; i < 32; i++ {
+		load.record(encodeKey([]byte(fmt.Sprintf("k%02d", i)), 1))
+	}
+	runner.Handle(&SplitCheckTask{Region: region, Load: load})
+	msg := <-taskResCh
+	split, ok := msg.Data.(*message.MsgSplitRegion)
+	assert.True(t, ok)
+	assert.Equal(t, codec.EncodeBytes([]byte("k16")), split.SplitKey)
+
+	// The accesses on a single key can't be split.
+	load = &RegionLoad{}
+	for i := 0; i < 32; i++ {
+		load.record(encodeKey([]byte("k1"), uint64(i)))
+	}
+	runner.Handle(&SplitCheckTask{Region: region, Load: load})
+	select {
+	case msg := <-taskResCh:
+		t.Fatalf("unexpected split message %v", msg)
+	default:
+	}
+}
+
+func TestLoadStatsFlow(t *testing.T) {
+	stats := NewLoadStats(false)
+	stats.Record(1, []byte("k1"))
+	assert.Nil(t, stats.Take(1))
+
+	stats.RecordWrite(1, 10, 1)
+	stats.RecordWrite(2, 20, 2)
+	stats.RecordRead(1, 30, 3)
+	flow := stats.TakeRegionFlow(1)
+	assert.Equal(t, uint64(10), flow.WrittenBytes)
+	assert.Equal(t, uint64(1), flow.WrittenKeys)
+	assert.Equal(t, uint64(30), flow.ReadBytes)
+	assert.Equal(t, uint64(3), flow.ReadKeys)
+	// The flow is reset once it's taken.
+	flow = stats.TakeRegionFlow(1)
+	assert.Equal(t, uint64(0), flow.WrittenBytes)
+	assert.Equal(t, uint64(0), flow.ReadBytes)
+
+	storeFlow := stats.TakeStoreFlow()
+	assert.Equal(t, uint64(30), storeFlow.WrittenBytes)
+	assert.Equal(t, uint64(3), storeFlow.WrittenKeys)
+	assert.Equal(t, uint64(30), storeFlow.ReadBytes)
+
+	stats.Remove(2)
+	assert.Equal(t, uint64(0), stats.TakeRegionFlow(2).WrittenBytes)
+}
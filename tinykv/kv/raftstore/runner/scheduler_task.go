@@ -3,6 +3,7 @@ package runner
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/Connor1996/badger"
 	"github.com/pingcap-incubator/tinykv/kv/raftstore/message"
@@ -28,6 +29,7 @@ type SchedulerRegionHeartbeatTask struct {
 	Peer            *metapb.Peer
 	PendingPeers    []*metapb.Peer
 	ApproximateSize *uint64
+	ResolvedTs      uint64
 }
 
 type SchedulerStoreHeartbeatTask struct {
@@ -40,13 +42,15 @@ type SchedulerTaskHandler struct {
 	storeID         uint64
 	SchedulerClient scheduler_client.Client
 	router          message.RaftRouter
+	loadStats       *LoadStats
 }
 
-func NewSchedulerTaskHandler(storeID uint64, SchedulerClient scheduler_client.Client, router message.RaftRouter) *SchedulerTaskHandler {
+func NewSchedulerTaskHandler(storeID uint64, SchedulerClient scheduler_client.Client, router message.RaftRouter, loadStats *LoadStats) *SchedulerTaskHandler {
 	return &SchedulerTaskHandler{
 		storeID:         storeID,
 		SchedulerClient: SchedulerClient,
 		router:          router,
+		loadStats:       loadStats,
 	}
 }
 
@@ -83,6 +87,13 @@ func (r *SchedulerTaskHandler) onRegionHeartbeatResponse(resp *schedulerpb.Regio
 				Peer: transferLeader.Peer,
 			},
 		}, message.NewCallback())
+	} else if merge := resp.GetMerge(); merge != nil {
+		r.sendAdminRequest(resp.RegionId, resp.RegionEpoch, resp.TargetPeer, &raft_cmdpb.AdminRequest{
+			CmdType: raft_cmdpb.AdminCmdType_PrepareMerge,
+			PrepareMerge: &raft_cmdpb.PrepareMergeRequest{
+				Target: merge.Target,
+			},
+		}, message.NewCallback())
 	}
 }
 
@@ -115,6 +126,15 @@ func (r *SchedulerTaskHandler) onHeartbeat(t *SchedulerRegionHeartbeatTask) {
 		Leader:          t.Peer,
 		PendingPeers:    t.PendingPeers,
 		ApproximateSize: uint64(size),
+		ResolvedTs:      t.ResolvedTs,
+	}
+	if r.loadStats != nil {
+		flow := r.loadStats.TakeRegionFlow(t.Region.GetId())
+		req.BytesWritten = flow.WrittenBytes
+		req.KeysWritten = flow.WrittenKeys
+		req.BytesRead = flow.ReadBytes
+		req.KeysRead = flow.ReadKeys
+		req.Interval = flowInterval(flow)
 	}
 	r.SchedulerClient.RegionHeartbeat(req)
 }
@@ -138,9 +158,26 @@ func (r *SchedulerTaskHandler) onStoreHeartbeat(t *SchedulerStoreHeartbeatTask)
 	t.Stats.UsedSize = usedSize
 	t.Stats.Available = available
 
+	if r.loadStats != nil {
+		flow := r.loadStats.TakeStoreFlow()
+		t.Stats.BytesWritten = flow.WrittenBytes
+		t.Stats.KeysWritten = flow.WrittenKeys
+		t.Stats.BytesRead = flow.ReadBytes
+		t.Stats.KeysRead = flow.ReadKeys
+		t.Stats.Interval = flowInterval(flow)
+	}
+
 	r.SchedulerClient.StoreHeartbeat(context.TODO(), t.Stats)
 }
 
+// flowInterval returns the period in which the flow is collected.
+func flowInterval(flow *FlowStats) *schedulerpb.TimeInterval {
+	return &schedulerpb.TimeInterval{
+		StartTimestamp: uint64(flow.Since.Unix()),
+		EndTimestamp:   uint64(time.Now().Unix()),
+	}
+}
+
 func (r *SchedulerTaskHandler) sendAdminRequest(regionID uint64, epoch *metapb.RegionEpoch, peer *metapb.Peer, req *raft_cmdpb.AdminRequest, callback *message.Callback) {
 	cmd := &raft_cmdpb.RaftCmdRequest{
 		Header: &raft_cmdpb.RaftRequestHeader{
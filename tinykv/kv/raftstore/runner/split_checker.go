@@ -17,19 +17,24 @@ import (
 
 type SplitCheckTask struct {
 	Region *metapb.Region
+	// Load is set if the QPS of the region exceeds the threshold, so the
+	// region should be split by load.
+	Load *RegionLoad
 }
 
 type splitCheckHandler struct {
-	engine  *badger.DB
-	router  message.RaftRouter
-	checker *sizeSplitChecker
+	engine      *badger.DB
+	router      message.RaftRouter
+	checker     *sizeSplitChecker
+	loadChecker *loadSplitChecker
 }
 
 func NewSplitCheckHandler(engine *badger.DB, router message.RaftRouter, conf *config.Config) *splitCheckHandler {
 	runner := &splitCheckHandler{
-		engine:  engine,
-		router:  router,
-		checker: newSizeSplitChecker(conf.RegionMaxSize, conf.RegionSplitSize),
+		engine:      engine,
+		router:      router,
+		checker:     newSizeSplitChecker(conf.RegionMaxSize, conf.RegionSplitSize),
+		loadChecker: newLoadSplitChecker(),
 	}
 	return runner
 }
@@ -45,14 +50,18 @@ func (r *splitCheckHandler) Handle(t worker.Task) {
 	regionId := region.Id
 	log.Debug(fmt.Sprintf("executing split check worker.Task: [regionId: %d, startKey: %s, endKey: %s]", regionId,
 		hex.EncodeToString(region.StartKey), hex.EncodeToString(region.EndKey)))
-	key := r.splitCheck(regionId, region.StartKey, region.EndKey)
-	if key != nil {
-		_, userKey, err := codec.DecodeBytes(key)
-		if err == nil {
-			// It's not a raw key.
-			// To make sure the keys of same user key locate in one Region, decode and then encode to truncate the timestamp
-			key = codec.EncodeBytes(userKey)
+	var key []byte
+	if spCheckTask.Load != nil {
+		key = r.loadChecker.getSplitKey(region, spCheckTask.Load)
+		if key != nil {
+			log.Info(fmt.Sprintf("split region by load: [regionId: %d, splitKey: %s]", regionId, hex.EncodeToString(key)))
 		}
+	}
+	if key == nil {
+		key = r.splitCheck(regionId, region.StartKey, region.EndKey)
+	}
+	if key != nil {
+		key = truncateSplitKey(key)
 		msg := message.Msg{
 			Type:     message.MsgTypeSplitRegion,
 			RegionID: regionId,
@@ -61,7 +70,7 @@ func (r *splitCheckHandler) Handle(t worker.Task) {
 				SplitKey:    key,
 			},
 		}
-		err = r.router.Send(regionId, msg)
+		err := r.router.Send(regionId, msg)
 		if err != nil {
 			log.Warn(fmt.Sprintf("failed to send check result: [regionId: %d, err: %v]", regionId, err))
 		}
@@ -70,6 +79,17 @@ func (r *splitCheckHandler) Handle(t worker.Task) {
 	}
 }
 
+// truncateSplitKey makes sure the keys of the same user key locate in one region.
+func truncateSplitKey(key []byte) []byte {
+	_, userKey, err := codec.DecodeBytes(key)
+	if err == nil {
+		// It's not a raw key.
+		// To make sure the keys of same user key locate in one Region, decode and then encode to truncate the timestamp
+		return codec.EncodeBytes(userKey)
+	}
+	return key
+}
+
 /// SplitCheck gets the split keys by scanning the range.
 func (r *splitCheckHandler) splitCheck(regionID uint64, startKey, endKey []byte) []byte {
 	txn := r.engine.NewTransaction(false)
@@ -40,14 +40,23 @@ type Client interface {
 	GetStore(ctx context.Context, storeID uint64) (*metapb.Store, error)
 	GetRegion(ctx context.Context, key []byte) (*metapb.Region, *metapb.Peer, error)
 	GetRegionByID(ctx context.Context, regionID uint64) (*metapb.Region, *metapb.Peer, error)
+	// ScanRegions gets the regions in [startKey, endKey) and their leaders in the key order, an empty endKey means
+	// there is no upper bound, and at most limit regions are returned if limit > 0.
+	ScanRegions(ctx context.Context, startKey, endKey []byte, limit int) ([]*metapb.Region, []*metapb.Peer, error)
 	AskSplit(ctx context.Context, region *metapb.Region) (*schedulerpb.AskSplitResponse, error)
 	StoreHeartbeat(ctx context.Context, stats *schedulerpb.StoreStats) error
 	RegionHeartbeat(*schedulerpb.RegionHeartbeatRequest) error
 	SetRegionHeartbeatResponseHandler(storeID uint64, h func(*schedulerpb.RegionHeartbeatResponse))
+	GetGCSafePoint(ctx context.Context) (uint64, error)
+	// GetTS gets a timestamp from the TSO of the scheduler.
+	GetTS(ctx context.Context) (uint64, error)
 	Close()
 }
 
 const (
+	// physicalShiftBits is the number of the bits of the logical part of a timestamp.
+	physicalShiftBits = 18
+
 	schedulerTimeout      = time.Second
 	retryInterval         = time.Second
 	maxInitClusterRetries = 100
@@ -499,6 +508,27 @@ func (c *client) GetRegionByID(ctx context.Context, regionID uint64) (*metapb.Re
 	return resp.Region, resp.Leader, nil
 }
 
+func (c *client) ScanRegions(ctx context.Context, startKey, endKey []byte, limit int) ([]*metapb.Region, []*metapb.Peer, error) {
+	var resp *schedulerpb.ScanRegionsResponse
+	err := c.doRequest(ctx, func(ctx context.Context, client schedulerpb.SchedulerClient) error {
+		var err1 error
+		resp, err1 = client.ScanRegions(ctx, &schedulerpb.ScanRegionsRequest{
+			Header:   c.requestHeader(),
+			StartKey: startKey,
+			EndKey:   endKey,
+			Limit:    int32(limit),
+		})
+		return err1
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if herr := resp.Header.GetError(); herr != nil {
+		return nil, nil, errors.New(herr.String())
+	}
+	return resp.Regions, resp.Leaders, nil
+}
+
 func (c *client) AskSplit(ctx context.Context, region *metapb.Region) (resp *schedulerpb.AskSplitResponse, err error) {
 	err = c.doRequest(ctx, func(ctx context.Context, client schedulerpb.SchedulerClient) error {
 		var err1 error
@@ -548,6 +578,48 @@ func (c *client) SetRegionHeartbeatResponseHandler(_ uint64, h func(*schedulerpb
 	c.heartbeatHandler.Store(h)
 }
 
+func (c *client) GetGCSafePoint(ctx context.Context) (uint64, error) {
+	var resp *schedulerpb.GetGCSafePointResponse
+	err := c.doRequest(ctx, func(ctx context.Context, client schedulerpb.SchedulerClient) error {
+		var err1 error
+		resp, err1 = client.GetGCSafePoint(ctx, &schedulerpb.GetGCSafePointRequest{
+			Header: c.requestHeader(),
+		})
+		return err1
+	})
+	if err != nil {
+		return 0, err
+	}
+	if herr := resp.Header.GetError(); herr != nil {
+		return 0, errors.New(herr.String())
+	}
+	return resp.SafePoint, nil
+}
+
+func (c *client) GetTS(ctx context.Context) (uint64, error) {
+	var resp *schedulerpb.TsoResponse
+	err := c.doRequest(ctx, func(ctx context.Context, client schedulerpb.SchedulerClient) error {
+		stream, err1 := client.Tso(ctx)
+		if err1 != nil {
+			return err1
+		}
+		defer stream.CloseSend()
+		if err1 = stream.Send(&schedulerpb.TsoRequest{Header: c.requestHeader(), Count: 1}); err1 != nil {
+			return err1
+		}
+		resp, err1 = stream.Recv()
+		return err1
+	})
+	if err != nil {
+		return 0, err
+	}
+	if herr := resp.Header.GetError(); herr != nil {
+		return 0, errors.New(herr.String())
+	}
+	ts := resp.GetTimestamp()
+	return uint64(ts.GetPhysical())<<physicalShiftBits + uint64(ts.GetLogical()), nil
+}
+
 func (c *client) requestHeader() *schedulerpb.RequestHeader {
 	return &schedulerpb.RequestHeader{
 		ClusterId: c.clusterID,
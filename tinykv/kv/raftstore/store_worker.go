@@ -24,6 +24,8 @@ type StoreTick int
 const (
 	StoreTickSchedulerStoreHeartbeat StoreTick = 1
 	StoreTickSnapGC                  StoreTick = 2
+	StoreTickGC                      StoreTick = 3
+	StoreTickResolvedTs              StoreTick = 4
 )
 
 type storeState struct {
@@ -73,6 +75,10 @@ func (d *storeWorker) onTick(tick StoreTick) {
 		d.onSchedulerStoreHearbeatTick()
 	case StoreTickSnapGC:
 		d.onSnapMgrGC()
+	case StoreTickGC:
+		d.onGCTick()
+	case StoreTickResolvedTs:
+		d.onResolvedTsTick()
 	}
 }
 
@@ -93,6 +99,8 @@ func (d *storeWorker) start(store *metapb.Store) {
 	d.id = store.Id
 	d.ticker.scheduleStore(StoreTickSchedulerStoreHeartbeat)
 	d.ticker.scheduleStore(StoreTickSnapGC)
+	d.ticker.scheduleStore(StoreTickGC)
+	d.ticker.scheduleStore(StoreTickResolvedTs)
 }
 
 /// Checks if the message is targeting a stale peer.
@@ -250,6 +258,34 @@ func (d *storeWorker) onSchedulerStoreHearbeatTick() {
 	d.ticker.scheduleStore(StoreTickSchedulerStoreHeartbeat)
 }
 
+// onGCTick asks the GC worker to collect the regions of the store, the worker
+// skips the regions which are not led by the store.
+func (d *storeWorker) onGCTick() {
+	meta := d.ctx.storeMeta
+	meta.RLock()
+	regions := make([]*metapb.Region, 0, len(meta.regions))
+	for _, region := range meta.regions {
+		regions = append(regions, region)
+	}
+	meta.RUnlock()
+	d.ctx.gcTaskSender <- &runner.GCTask{Regions: regions}
+	d.ticker.scheduleStore(StoreTickGC)
+}
+
+// onResolvedTsTick asks the resolved ts worker to advance the resolved ts of
+// the regions, the peers which are not the leaders ignore it.
+func (d *storeWorker) onResolvedTsTick() {
+	meta := d.ctx.storeMeta
+	meta.RLock()
+	regionIDs := make([]uint64, 0, len(meta.regions))
+	for regionID := range meta.regions {
+		regionIDs = append(regionIDs, regionID)
+	}
+	meta.RUnlock()
+	d.ctx.resolvedTsTaskSender <- &runner.ResolvedTsTask{RegionIDs: regionIDs}
+	d.ticker.scheduleStore(StoreTickResolvedTs)
+}
+
 func (d *storeWorker) handleSnapMgrGC() error {
 	mgr := d.ctx.snapMgr
 	snapKeys, err := mgr.ListIdleSnap()
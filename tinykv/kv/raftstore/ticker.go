@@ -28,6 +28,7 @@ func newTicker(regionID uint64, cfg *config.Config) *ticker {
 	t.schedules[int(PeerTickRaftLogGC)].interval = int64(cfg.RaftLogGCTickInterval / baseInterval)
 	t.schedules[int(PeerTickSplitRegionCheck)].interval = int64(cfg.SplitRegionCheckTickInterval / baseInterval)
 	t.schedules[int(PeerTickSchedulerHeartbeat)].interval = int64(cfg.SchedulerHeartbeatTickInterval / baseInterval)
+	t.schedules[int(PeerTickCheckMerge)].interval = int64(cfg.MergeCheckTickInterval / baseInterval)
 	return t
 }
 
@@ -36,10 +37,12 @@ const SnapMgrGcTickInterval = 1 * time.Minute
 func newStoreTicker(cfg *config.Config) *ticker {
 	baseInterval := cfg.RaftBaseTickInterval
 	t := &ticker{
-		schedules: make([]tickSchedule, 4),
+		schedules: make([]tickSchedule, 5),
 	}
 	t.schedules[int(StoreTickSchedulerStoreHeartbeat)].interval = int64(cfg.SchedulerStoreHeartbeatTickInterval / baseInterval)
 	t.schedules[int(StoreTickSnapGC)].interval = int64(SnapMgrGcTickInterval / baseInterval)
+	t.schedules[int(StoreTickGC)].interval = int64(cfg.GCTickInterval / baseInterval)
+	t.schedules[int(StoreTickResolvedTs)].interval = int64(cfg.ResolvedTsTickInterval / baseInterval)
 	return t
 }
 
@@ -58,6 +58,14 @@ func (e *ErrStoreNotMatch) Error() string {
 	return fmt.Sprintf("store not match, request store id is %v, but actual store id is %v", e.RequestStoreId, e.ActualStoreId)
 }
 
+type ErrProposalInMergingMode struct {
+	RegionId uint64
+}
+
+func (e *ErrProposalInMergingMode) Error() string {
+	return fmt.Sprintf("region %v is in merging mode", e.RegionId)
+}
+
 func RaftstoreErrToPbError(e error) *errorpb.Error {
 	ret := new(errorpb.Error)
 	switch err := errors.Cause(e).(type) {
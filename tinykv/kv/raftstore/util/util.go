@@ -27,6 +27,7 @@ const InvalidID uint64 = 0
 // later.
 func IsInitialMsg(msg *eraftpb.Message) bool {
 	return msg.MsgType == eraftpb.MessageType_MsgRequestVote ||
+		msg.MsgType == eraftpb.MessageType_MsgRequestPreVote ||
 		// the peer has not been known to this leader, it may exist or not.
 		(msg.MsgType == eraftpb.MessageType_MsgHeartbeat && msg.Commit == RaftInvalidIndex)
 }
@@ -65,7 +66,7 @@ func IsEpochStale(epoch *metapb.RegionEpoch, checkEpoch *metapb.RegionEpoch) boo
 
 func IsVoteMessage(msg *eraftpb.Message) bool {
 	tp := msg.GetMsgType()
-	return tp == eraftpb.MessageType_MsgRequestVote
+	return tp == eraftpb.MessageType_MsgRequestVote || tp == eraftpb.MessageType_MsgRequestPreVote
 }
 
 /// `is_first_vote_msg` checks `msg` is the first vote message or not. It's used for
@@ -83,9 +84,11 @@ func CheckRegionEpoch(req *raft_cmdpb.RaftCmdRequest, region *metapb.Region, inc
 	} else {
 		switch req.AdminRequest.CmdType {
 		case raft_cmdpb.AdminCmdType_CompactLog, raft_cmdpb.AdminCmdType_InvalidAdmin:
-		case raft_cmdpb.AdminCmdType_ChangePeer:
+		case raft_cmdpb.AdminCmdType_ChangePeer, raft_cmdpb.AdminCmdType_ChangePeerV2:
 			checkConfVer = true
-		case raft_cmdpb.AdminCmdType_Split, raft_cmdpb.AdminCmdType_TransferLeader:
+		case raft_cmdpb.AdminCmdType_Split, raft_cmdpb.AdminCmdType_TransferLeader,
+			raft_cmdpb.AdminCmdType_PrepareMerge, raft_cmdpb.AdminCmdType_CommitMerge,
+			raft_cmdpb.AdminCmdType_RollbackMerge:
 			checkVer = true
 			checkConfVer = true
 		}
@@ -151,12 +154,33 @@ func RemovePeer(region *metapb.Region, storeID uint64) *metapb.Peer {
 }
 
 func ConfStateFromRegion(region *metapb.Region) (confState eraftpb.ConfState) {
+	joint := IsInJointState(region)
 	for _, p := range region.Peers {
-		confState.Nodes = append(confState.Nodes, p.GetId())
+		if p.GetIsLearner() {
+			confState.Learners = append(confState.Learners, p.GetId())
+			continue
+		}
+		if p.GetJointState() != metapb.JointState_OutgoingVoter {
+			confState.Nodes = append(confState.Nodes, p.GetId())
+		}
+		if joint && p.GetJointState() != metapb.JointState_IncomingVoter {
+			confState.VotersOutgoing = append(confState.VotersOutgoing, p.GetId())
+		}
 	}
 	return
 }
 
+// IsInJointState returns true if the region is in a joint configuration, that
+// is some voters are being added or removed by a ChangePeerV2 command.
+func IsInJointState(region *metapb.Region) bool {
+	for _, p := range region.Peers {
+		if p.GetJointState() != metapb.JointState_Stable {
+			return true
+		}
+	}
+	return false
+}
+
 func CheckStoreID(req *raft_cmdpb.RaftCmdRequest, storeID uint64) error {
 	peer := req.Header.Peer
 	if peer.StoreId == storeID {
@@ -0,0 +1,120 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/pingcap-incubator/tinykv/kv/backup"
+	"github.com/pingcap-incubator/tinykv/kv/storage"
+	"github.com/pingcap-incubator/tinykv/kv/transaction/mvcc"
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+)
+
+// restoreBatchKeys is the max number of keys restored by one write.
+const restoreBatchKeys = 1024
+
+// Backup writes the values of the range visible at the version to a backup file. The file is named by the region id
+// and the region version, so a retried request overwrites the file it has written before, while the requests sent
+// after the region is split or merged don't.
+func (server *Server) Backup(_ context.Context, req *kvrpcpb.BackupRequest) (*kvrpcpb.BackupResponse, error) {
+	server.concurrencyManager.UpdateMaxTs(req.Version)
+	resp := new(kvrpcpb.BackupResponse)
+	reader, err := server.storage.Reader(req.Context)
+	if err != nil {
+		resp, err := regionError(err, resp)
+		if err != nil {
+			return nil, err
+		}
+		return resp.(*kvrpcpb.BackupResponse), nil
+	}
+	defer reader.Close()
+
+	fileName := fmt.Sprintf("%d_%d.sst", req.Context.GetRegionId(), req.Context.GetRegionEpoch().GetVersion())
+	count, err := backupRange(reader, req, filepath.Join(req.Path, fileName))
+	if err != nil {
+		if keyErr, ok := err.(*mvcc.KeyError); ok {
+			resp.Error = &keyErr.KeyError
+			return resp, nil
+		}
+		return nil, err
+	}
+	if count > 0 {
+		resp.FileName = fileName
+		resp.KvCount = count
+	}
+	return resp, nil
+}
+
+// backupRange writes the values in the range to the file and returns the number of them, the file is not written if
+// there is no value.
+func backupRange(reader storage.StorageReader, req *kvrpcpb.BackupRequest, path string) (uint64, error) {
+	scanner := mvcc.NewScanner(req.StartKey, &mvcc.RoTxn{Reader: reader, StartTS: req.Version})
+	defer scanner.Close()
+	var w *backup.Writer
+	abort := func(err error) (uint64, error) {
+		if w != nil {
+			w.Abort()
+		}
+		return 0, err
+	}
+	for {
+		key, value, err := scanner.Next()
+		if err != nil {
+			return abort(err)
+		}
+		if key == nil || (len(req.EndKey) > 0 && bytes.Compare(key, req.EndKey) >= 0) {
+			break
+		}
+		if w == nil {
+			if w, err = backup.NewWriter(path); err != nil {
+				return 0, err
+			}
+		}
+		if err = w.Add(key, value); err != nil {
+			return abort(err)
+		}
+	}
+	if w == nil {
+		return 0, nil
+	}
+	return w.Count(), w.Finish()
+}
+
+// Restore writes the keys of the range in a backup file as the values committed at the version.
+func (server *Server) Restore(_ context.Context, req *kvrpcpb.RestoreRequest) (*kvrpcpb.RestoreResponse, error) {
+	resp := new(kvrpcpb.RestoreResponse)
+	write := (&mvcc.Write{StartTS: req.Version, Kind: mvcc.WriteKindPut}).ToBytes()
+	var batch []storage.Modify
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		err := server.storage.Write(req.Context, batch)
+		batch = nil
+		return err
+	}
+	err := backup.ReadFile(filepath.Join(req.Path, req.FileName), func(key, value []byte) error {
+		if bytes.Compare(key, req.StartKey) < 0 || (len(req.EndKey) > 0 && bytes.Compare(key, req.EndKey) >= 0) {
+			return nil
+		}
+		encodedKey := mvcc.EncodeKey(key, req.Version)
+		batch = append(batch,
+			storage.Modify{Data: storage.Put{Cf: engine_util.CfDefault, Key: encodedKey, Value: value}},
+			storage.Modify{Data: storage.Put{Cf: engine_util.CfWrite, Key: encodedKey, Value: write}})
+		resp.KvCount++
+		if len(batch) >= 2*restoreBatchKeys {
+			return flush()
+		}
+		return nil
+	})
+	if err == nil {
+		err = flush()
+	}
+	if rawRegionError(err, resp) {
+		resp.KvCount = 0
+	}
+	return resp, nil
+}
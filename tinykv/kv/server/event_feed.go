@@ -0,0 +1,125 @@
+package server
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/cdc"
+	"github.com/pingcap-incubator/tinykv/kv/storage/raft_storage"
+	"github.com/pingcap-incubator/tinykv/kv/transaction/mvcc"
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/cdcpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/errorpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/tinykvpb"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+)
+
+// ResolvedTsInterval is the interval to advance the resolved ts of the regions subscribed by an EventFeed stream.
+var ResolvedTsInterval = time.Second
+
+// EventFeed streams the changes of the regions subscribed by the requests received from the stream.
+//
+// For each subscribed region the stream sends the PREWRITE rows of the locks in the range followed by an INITIALIZED
+// row, then the rows decoded from the writes applied to the region, and the resolved ts of the region periodically.
+// An error event means the region is not subscribed any more, e.g. it's split or its leader is transferred, the
+// consumer subscribes the new regions again from its checkpoint.
+func (server *Server) EventFeed(stream tinykvpb.TinyKv_EventFeedServer) error {
+	rs, ok := server.storage.(*raft_storage.RaftStorage)
+	if !ok {
+		return errors.New("event feed requires the raft storage")
+	}
+	observer := rs.CdcObserver()
+	downstream := cdc.NewDownstream()
+	defer observer.DeregisterAll(downstream)
+
+	errCh := make(chan error, 1)
+	go func() {
+		for {
+			req, err := stream.Recv()
+			if err != nil {
+				errCh <- err
+				return
+			}
+			server.subscribe(observer, downstream, req)
+		}
+	}()
+
+	ticker := time.NewTicker(ResolvedTsInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-downstream.Notify():
+			if err := stream.Send(&cdcpb.ChangeDataEvent{Events: downstream.Take()}); err != nil {
+				return err
+			}
+		case <-ticker.C:
+			ts, err := rs.Client().GetTS(stream.Context())
+			if err != nil {
+				log.Warn(fmt.Sprintf("event feed failed to get ts, err %v", err))
+				continue
+			}
+			// The async commit and 1PC transactions prewritten after this commit after ts, and UpdateMaxTs waits
+			// for the ones which have read a smaller max ts, whose locks are observed when it returns.
+			server.concurrencyManager.UpdateMaxTs(ts)
+			observer.Resolve(downstream, ts)
+		case err := <-errCh:
+			if err != io.EOF {
+				return err
+			}
+			// The consumer doesn't subscribe any more regions, but it still receives the events.
+			errCh = nil
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+	}
+}
+
+// subscribe registers the range of the region for the downstream, then initializes it with the locks in the range.
+func (server *Server) subscribe(observer *cdc.Observer, downstream *cdc.Downstream, req *cdcpb.ChangeDataRequest) {
+	regionID := req.Context.GetRegionId()
+	if err := observer.Register(regionID, downstream, req.StartKey, req.EndKey, req.CheckpointTs); err != nil {
+		log.Warn(fmt.Sprintf("event feed failed to subscribe region %d, err %v", regionID, err))
+		return
+	}
+	rows, err := server.lockRows(req)
+	if err != nil {
+		regionErr, ok := err.(*raft_storage.RegionError)
+		if !ok {
+			regionErr = &raft_storage.RegionError{RequestErr: &errorpb.Error{Message: err.Error()}}
+		}
+		observer.Deregister(regionID, downstream, regionErr.RequestErr)
+		return
+	}
+	observer.Initialize(regionID, downstream, rows)
+}
+
+// lockRows returns the PREWRITE rows of the locks in the range of the request. The snapshot is taken after the
+// registration, so the commands applied after it are kept by the registration.
+func (server *Server) lockRows(req *cdcpb.ChangeDataRequest) ([]*cdcpb.Event_Row, error) {
+	reader, err := server.storage.Reader(req.Context)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	locks, err := mvcc.LocksInRange(&mvcc.RoTxn{Reader: reader}, req.StartKey, req.EndKey, mvcc.TsMax, 0)
+	if err != nil {
+		return nil, err
+	}
+	var rows []*cdcpb.Event_Row
+	for _, pair := range locks {
+		var value []byte
+		switch pair.Lock.Kind {
+		case mvcc.WriteKindPessimisticLock:
+			continue
+		case mvcc.WriteKindPut:
+			value, err = reader.GetCF(engine_util.CfDefault, mvcc.EncodeKey(pair.Key, pair.Lock.Ts))
+			if err != nil {
+				return nil, err
+			}
+		}
+		rows = append(rows, cdc.PrewriteRow(pair.Key, pair.Lock, value))
+	}
+	return rows, nil
+}
@@ -0,0 +1,72 @@
+package server
+
+import (
+	"encoding/binary"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/kv/storage"
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap/errors"
+)
+
+// If the raw TTL is enabled, every raw value carries its expire time, a unix timestamp in seconds appended to the
+// value in big endian, 0 means the value never expires. The expired values are not removed when they expire, the
+// reads treat them as deleted and they are overwritten or deleted later.
+
+const rawExpireTsLen = 8
+
+// rawNow returns the current unix timestamp in seconds, tests replace it to expire the values.
+var rawNow = func() uint64 {
+	return uint64(time.Now().Unix())
+}
+
+// rawCF returns the column family of a raw request, the default column family is used if it's not set.
+func rawCF(cf string) string {
+	if cf == "" {
+		return engine_util.CfDefault
+	}
+	return cf
+}
+
+// encodeRawValue returns the value to store for a raw write with the ttl in seconds.
+func (server *Server) encodeRawValue(value []byte, ttl uint64) ([]byte, error) {
+	if !server.rawTTL {
+		if ttl != 0 {
+			return nil, errors.New("raw ttl is not enabled")
+		}
+		return value, nil
+	}
+	var expireTs uint64
+	if ttl != 0 {
+		expireTs = rawNow() + ttl
+	}
+	encoded := make([]byte, len(value)+rawExpireTsLen)
+	copy(encoded, value)
+	binary.BigEndian.PutUint64(encoded[len(value):], expireTs)
+	return encoded, nil
+}
+
+// decodeRawValue returns the value of a stored raw value, or nil if it's expired.
+func (server *Server) decodeRawValue(stored []byte) ([]byte, error) {
+	if !server.rawTTL || stored == nil {
+		return stored, nil
+	}
+	if len(stored) < rawExpireTsLen {
+		return nil, errors.Errorf("raw value %v is too short to carry the expire time", stored)
+	}
+	end := len(stored) - rawExpireTsLen
+	expireTs := binary.BigEndian.Uint64(stored[end:])
+	if expireTs != 0 && expireTs <= rawNow() {
+		return nil, nil
+	}
+	return stored[:end], nil
+}
+
+// getRawValue reads a raw value, it returns nil if the key doesn't exist or the value is expired.
+func (server *Server) getRawValue(reader storage.StorageReader, cf string, key []byte) ([]byte, error) {
+	stored, err := reader.GetCF(cf, key)
+	if err != nil {
+		return nil, err
+	}
+	return server.decodeRawValue(stored)
+}
@@ -0,0 +1,41 @@
+package server
+
+import (
+	"context"
+
+	"github.com/pingcap-incubator/tinykv/kv/storage/raft_storage"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap/errors"
+)
+
+// GetResolvedTs returns the resolved ts of the peer of the region on this store, it's a debug command.
+func (server *Server) GetResolvedTs(_ context.Context, req *kvrpcpb.GetResolvedTsRequest) (*kvrpcpb.GetResolvedTsResponse, error) {
+	rs, ok := server.storage.(*raft_storage.RaftStorage)
+	if !ok {
+		return nil, errors.New("resolved ts requires the raft storage")
+	}
+	resp := new(kvrpcpb.GetResolvedTsResponse)
+	state, err := rs.ResolvedTs(req.Context)
+	if err != nil {
+		resp, err := regionError(err, resp)
+		if err != nil {
+			return nil, err
+		}
+		return resp.(*kvrpcpb.GetResolvedTsResponse), nil
+	}
+	resp.ResolvedTs = state.ResolvedTs
+	resp.ResolvedIndex = state.ResolvedIndex
+	resp.AppliedIndex = state.AppliedIndex
+	resp.LockCount = uint64(state.LockCount)
+	return resp, nil
+}
+
+// checkStaleRead returns a region error if a stale read at ts can't be served by the peer yet, the client retries it
+// on the leader. A standalone storage has no other replica, it serves the reads from the latest data.
+func (server *Server) checkStaleRead(ctx *kvrpcpb.Context, ts uint64) error {
+	rs, ok := server.storage.(*raft_storage.RaftStorage)
+	if !ok {
+		return nil
+	}
+	return rs.CheckStaleRead(ctx, ts)
+}
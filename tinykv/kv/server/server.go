@@ -1,6 +1,7 @@
 package server
 
 import (
+	"bytes"
 	"context"
 	"reflect"
 
@@ -8,7 +9,10 @@ import (
 	"github.com/pingcap-incubator/tinykv/kv/storage"
 	"github.com/pingcap-incubator/tinykv/kv/storage/raft_storage"
 	"github.com/pingcap-incubator/tinykv/kv/transaction/commands"
+	"github.com/pingcap-incubator/tinykv/kv/transaction/concurrency"
+	"github.com/pingcap-incubator/tinykv/kv/transaction/deadlock"
 	"github.com/pingcap-incubator/tinykv/kv/transaction/latches"
+	"github.com/pingcap-incubator/tinykv/kv/transaction/mvcc"
 	coppb "github.com/pingcap-incubator/tinykv/proto/pkg/coprocessor"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/tinykvpb"
@@ -26,15 +30,34 @@ type Server struct {
 	storage    storage.Storage
 	Latches    *latches.Latches
 	copHandler *coprocessor.CopHandler
+	// detector finds the deadlocks of the pessimistic transactions waiting for the locks on this store.
+	detector *deadlock.Detector
+	// concurrencyManager keeps the max read timestamp for the commit timestamps of async commit and 1PC transactions.
+	concurrencyManager *concurrency.Manager
+	// rawTTL is set if the raw values carry their expire time.
+	rawTTL bool
 }
 
+// NewServer creates a server on the storage, a raft storage must be started before.
 func NewServer(storage storage.Storage) *Server {
+	// The raftstore updates the max timestamp for the reads served by the followers.
+	concurrencyManager := concurrency.NewManager()
+	if rs, ok := storage.(*raft_storage.RaftStorage); ok {
+		concurrencyManager = rs.ConcurrencyManager()
+	}
 	return &Server{
-		storage: storage,
-		Latches: latches.NewLatches(),
+		storage:            storage,
+		Latches:            latches.NewLatches(),
+		detector:           deadlock.NewDetector(deadlock.DefaultEntryTTL),
+		concurrencyManager: concurrencyManager,
 	}
 }
 
+// EnableRawTTL makes the server store the expire time with the raw values, so the raw writes can carry a TTL.
+func (server *Server) EnableRawTTL() {
+	server.rawTTL = true
+}
+
 // Run runs a transactional command.
 func (server *Server) Run(cmd commands.Command) (interface{}, error) {
 	return commands.RunCommand(cmd, server.storage, server.Latches)
@@ -47,8 +70,13 @@ func (server *Server) Run(cmd commands.Command) (interface{}, error) {
 
 // KvGet returns the value of the key, the visibility is judged by the `Version` field of `GetRequest`.
 func (server *Server) KvGet(_ context.Context, req *kvrpcpb.GetRequest) (*kvrpcpb.GetResponse, error) {
+	server.concurrencyManager.UpdateMaxTs(req.Version)
 	cmd := commands.NewGet(req)
-	resp, err := server.Run(&cmd)
+	var resp interface{}
+	err := server.checkStaleRead(req.Context, req.Version)
+	if err == nil {
+		resp, err = server.Run(&cmd)
+	}
 	if err != nil {
 		resp, err = regionError(err, new(kvrpcpb.GetResponse))
 		if err != nil {
@@ -61,9 +89,13 @@ func (server *Server) KvGet(_ context.Context, req *kvrpcpb.GetRequest) (*kvrpcp
 // KvScan returns the valuee of all the keys in a specific range defined by the `startKey` of the ScanRequest.
 // The visibility is judged by the `Version` field of `ScanRequest`.
 func (server *Server) KvScan(_ context.Context, req *kvrpcpb.ScanRequest) (*kvrpcpb.ScanResponse, error) {
-
+	server.concurrencyManager.UpdateMaxTs(req.Version)
 	cmd := commands.NewScan(req)
-	resp, err := server.Run(&cmd)
+	var resp interface{}
+	err := server.checkStaleRead(req.Context, req.Version)
+	if err == nil {
+		resp, err = server.Run(&cmd)
+	}
 	if err != nil {
 		resp, err = regionError(err, new(kvrpcpb.ScanResponse))
 		if err != nil {
@@ -74,7 +106,16 @@ func (server *Server) KvScan(_ context.Context, req *kvrpcpb.ScanRequest) (*kvrp
 }
 
 // KvPrewrite is the main entry of transactional write, the first stage of 2PC.
+// The commit timestamp of an async commit or 1PC transaction is decided by prewrite, it must be greater than the
+// timestamps of the reads which have happened.
 func (server *Server) KvPrewrite(_ context.Context, req *kvrpcpb.PrewriteRequest) (*kvrpcpb.PrewriteResponse, error) {
+	if req.UseAsyncCommit || req.TryOnePc {
+		maxTs, release := server.concurrencyManager.ReadMaxTs()
+		defer release()
+		if req.MinCommitTs <= maxTs {
+			req.MinCommitTs = maxTs + 1
+		}
+	}
 	cmd := commands.NewPrewrite(req)
 	resp, err := server.Run(&cmd)
 	if err != nil {
@@ -89,6 +130,7 @@ func (server *Server) KvPrewrite(_ context.Context, req *kvrpcpb.PrewriteRequest
 // KvCommit is the main entry of transactional write, the second stage of 2PC.
 func (server *Server) KvCommit(_ context.Context, req *kvrpcpb.CommitRequest) (*kvrpcpb.CommitResponse, error) {
 	cmd := commands.NewCommit(req)
+	server.detector.CleanUp(req.StartVersion)
 	resp, err := server.Run(&cmd)
 	if err != nil {
 		resp, err = regionError(err, new(kvrpcpb.CommitResponse))
@@ -118,6 +160,7 @@ func (server *Server) KvCheckTxnStatus(_ context.Context, req *kvrpcpb.CheckTxnS
 // KvBatchRollback is used rollback the transaction lock keys if the transaction will NOT commit.
 func (server *Server) KvBatchRollback(_ context.Context, req *kvrpcpb.BatchRollbackRequest) (*kvrpcpb.BatchRollbackResponse, error) {
 	cmd := commands.NewRollback(req)
+	server.detector.CleanUp(req.StartVersion)
 	resp, err := server.Run(&cmd)
 	if err != nil {
 		resp, err = regionError(err, new(kvrpcpb.BatchRollbackResponse))
@@ -131,6 +174,7 @@ func (server *Server) KvBatchRollback(_ context.Context, req *kvrpcpb.BatchRollb
 // KvResolveLock is used to resolve the prewrite lock if the related transaction status is decided(commit/rollback).
 func (server *Server) KvResolveLock(_ context.Context, req *kvrpcpb.ResolveLockRequest) (*kvrpcpb.ResolveLockResponse, error) {
 	cmd := commands.NewResolveLock(req)
+	server.detector.CleanUp(req.StartVersion)
 	resp, err := server.Run(&cmd)
 	if err != nil {
 		resp, err = regionError(err, new(kvrpcpb.ResolveLockResponse))
@@ -141,6 +185,126 @@ func (server *Server) KvResolveLock(_ context.Context, req *kvrpcpb.ResolveLockR
 	return resp.(*kvrpcpb.ResolveLockResponse), err
 }
 
+// KvGc deletes the versions which are invisible to any transaction started after the safe point, so the versions
+// visible at the safe point are kept.
+func (server *Server) KvGc(_ context.Context, req *kvrpcpb.GcRequest) (*kvrpcpb.GcResponse, error) {
+	cmd := commands.NewGc(req)
+	resp, err := server.Run(&cmd)
+	if err != nil {
+		resp, err = regionError(err, new(kvrpcpb.GcResponse))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resp.(*kvrpcpb.GcResponse), err
+}
+
+// KvScanLock returns the locks in a range left by the transactions started before the max version, they must be
+// resolved before GC collects the versions at the safe point.
+func (server *Server) KvScanLock(_ context.Context, req *kvrpcpb.ScanLockRequest) (*kvrpcpb.ScanLockResponse, error) {
+	cmd := commands.NewScanLock(req)
+	resp, err := server.Run(&cmd)
+	if err != nil {
+		resp, err = regionError(err, new(kvrpcpb.ScanLockResponse))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resp.(*kvrpcpb.ScanLockResponse), err
+}
+
+// KvDeleteRange deletes all the data in a range of the region through the raft log, the versions are not kept, so the
+// range must not be read or written any more, e.g. the range of a dropped table after the GC safe point.
+func (server *Server) KvDeleteRange(_ context.Context, req *kvrpcpb.DeleteRangeRequest) (*kvrpcpb.DeleteRangeResponse, error) {
+	response := new(kvrpcpb.DeleteRangeResponse)
+	err := server.storage.Write(req.Context, mvcc.DeleteRange(req.StartKey, req.EndKey))
+	rawRegionError(err, response)
+	return response, nil
+}
+
+// KvPessimisticLock locks the keys of a pessimistic transaction before they are prewritten. If a key is locked by
+// another transaction, the client retries until the lock is released, unless waiting for the lock would form a
+// deadlock, then the locked error is replaced by a deadlock error.
+func (server *Server) KvPessimisticLock(_ context.Context, req *kvrpcpb.PessimisticLockRequest) (*kvrpcpb.PessimisticLockResponse, error) {
+	cmd := commands.NewPessimisticLock(req)
+	resp, err := server.Run(&cmd)
+	if err != nil {
+		resp, err = regionError(err, new(kvrpcpb.PessimisticLockResponse))
+		if err != nil {
+			return nil, err
+		}
+	}
+	lockResp := resp.(*kvrpcpb.PessimisticLockResponse)
+	if lockResp.RegionError == nil {
+		server.detectDeadlock(req.StartVersion, lockResp.Errors)
+	}
+	return lockResp, nil
+}
+
+// KvPessimisticRollback unlocks the keys locked by a pessimistic transaction which are not prewritten.
+func (server *Server) KvPessimisticRollback(_ context.Context, req *kvrpcpb.PessimisticRollbackRequest) (*kvrpcpb.PessimisticRollbackResponse, error) {
+	cmd := commands.NewPessimisticRollback(req)
+	server.detector.CleanUp(req.StartVersion)
+	resp, err := server.Run(&cmd)
+	if err != nil {
+		resp, err = regionError(err, new(kvrpcpb.PessimisticRollbackResponse))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resp.(*kvrpcpb.PessimisticRollbackResponse), err
+}
+
+// KvCheckSecondaryLocks checks the secondary locks of an async commit transaction, to decide whether it's committed
+// when its primary lock has expired.
+func (server *Server) KvCheckSecondaryLocks(_ context.Context, req *kvrpcpb.CheckSecondaryLocksRequest) (*kvrpcpb.CheckSecondaryLocksResponse, error) {
+	cmd := commands.NewCheckSecondaryLocks(req)
+	resp, err := server.Run(&cmd)
+	if err != nil {
+		resp, err = regionError(err, new(kvrpcpb.CheckSecondaryLocksResponse))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resp.(*kvrpcpb.CheckSecondaryLocksResponse), err
+}
+
+func (server *Server) KvTxnHeartBeat(_ context.Context, req *kvrpcpb.TxnHeartBeatRequest) (*kvrpcpb.TxnHeartBeatResponse, error) {
+	cmd := commands.NewTxnHeartBeat(req)
+	resp, err := server.Run(&cmd)
+	if err != nil {
+		resp, err = regionError(err, new(kvrpcpb.TxnHeartBeatResponse))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resp.(*kvrpcpb.TxnHeartBeatResponse), err
+}
+
+// detectDeadlock reports the locks the transaction is waiting for to the deadlock detector. A locked error is
+// replaced by a deadlock error if waiting for the lock would form a deadlock. The transaction isn't waiting for any
+// lock if all the keys are locked.
+func (server *Server) detectDeadlock(startTs uint64, keyErrors []*kvrpcpb.KeyError) {
+	if len(keyErrors) == 0 {
+		server.detector.CleanUp(startTs)
+		return
+	}
+	for _, keyErr := range keyErrors {
+		locked := keyErr.GetLocked()
+		if locked == nil {
+			continue
+		}
+		if err := server.detector.Detect(startTs, locked.LockVersion, deadlock.KeyHash(locked.Key)); err != nil {
+			keyErr.Locked = nil
+			keyErr.Deadlock = &kvrpcpb.Deadlock{
+				LockTs:          locked.LockVersion,
+				LockKey:         locked.Key,
+				DeadlockKeyHash: err.KeyHash,
+			}
+		}
+	}
+}
+
 // Raw API. These commands are handled inline rather than by using Run and am implementation of the Commands interface.
 // This is because these commands are fairly straightforward and do not share a lot of code with the transactional
 // commands.
@@ -148,7 +312,8 @@ func (server *Server) RawGet(_ context.Context, req *kvrpcpb.RawGetRequest) (*kv
 	response := new(kvrpcpb.RawGetResponse)
 	reader, err := server.storage.Reader(req.Context)
 	if !rawRegionError(err, response) {
-		val, err := reader.GetCF(req.Cf, req.Key)
+		defer reader.Close()
+		val, err := server.getRawValue(reader, rawCF(req.Cf), req.Key)
 		if err != nil {
 			rawRegionError(err, response)
 		} else if val == nil {
@@ -163,13 +328,16 @@ func (server *Server) RawGet(_ context.Context, req *kvrpcpb.RawGetRequest) (*kv
 
 func (server *Server) RawPut(_ context.Context, req *kvrpcpb.RawPutRequest) (*kvrpcpb.RawPutResponse, error) {
 	response := new(kvrpcpb.RawPutResponse)
-	err := server.storage.Write(req.Context, []storage.Modify{{
-		Data: storage.Put{
-			Key:   req.Key,
-			Value: req.Value,
-			Cf:    req.Cf,
-		}}})
-	rawRegionError(err, response)
+	value, err := server.encodeRawValue(req.Value, req.Ttl)
+	if !rawRegionError(err, response) {
+		err = server.storage.Write(req.Context, []storage.Modify{{
+			Data: storage.Put{
+				Key:   req.Key,
+				Value: value,
+				Cf:    rawCF(req.Cf),
+			}}})
+		rawRegionError(err, response)
+	}
 	return response, nil
 }
 
@@ -178,7 +346,7 @@ func (server *Server) RawDelete(_ context.Context, req *kvrpcpb.RawDeleteRequest
 	err := server.storage.Write(req.Context, []storage.Modify{{
 		Data: storage.Delete{
 			Key: req.Key,
-			Cf:  req.Cf,
+			Cf:  rawCF(req.Cf),
 		}}})
 	rawRegionError(err, response)
 	return response, nil
@@ -189,8 +357,9 @@ func (server *Server) RawScan(_ context.Context, req *kvrpcpb.RawScanRequest) (*
 
 	reader, err := server.storage.Reader(req.Context)
 	if !rawRegionError(err, response) {
+		defer reader.Close()
 		// To scan, we need to get an iterator for the underlying storage.
-		it := reader.IterCF(req.Cf)
+		it := reader.IterCF(rawCF(req.Cf))
 		defer it.Close()
 		// Initialize the iterator. Termination condition is that the iterator is still valid (i.e.
 		// we have not reached the end of the DB) and we haven't exceeded the client-specified limit.
@@ -198,10 +367,13 @@ func (server *Server) RawScan(_ context.Context, req *kvrpcpb.RawScanRequest) (*
 			item := it.Item()
 			key := item.KeyCopy(nil)
 			value, err := item.ValueCopy(nil)
+			if err == nil {
+				value, err = server.decodeRawValue(value)
+			}
 			if err != nil {
 				rawRegionError(err, response)
 				break
-			} else {
+			} else if value != nil {
 				response.Kvs = append(response.Kvs, &kvrpcpb.KvPair{
 					Key:   key,
 					Value: value,
@@ -213,6 +385,106 @@ func (server *Server) RawScan(_ context.Context, req *kvrpcpb.RawScanRequest) (*
 	return response, nil
 }
 
+func (server *Server) RawBatchGet(_ context.Context, req *kvrpcpb.RawBatchGetRequest) (*kvrpcpb.RawBatchGetResponse, error) {
+	response := new(kvrpcpb.RawBatchGetResponse)
+	reader, err := server.storage.Reader(req.Context)
+	if rawRegionError(err, response) {
+		return response, nil
+	}
+	defer reader.Close()
+	for _, key := range req.Keys {
+		val, err := server.getRawValue(reader, rawCF(req.Cf), key)
+		if rawRegionError(err, response) {
+			response.Pairs = nil
+			break
+		}
+		if val != nil {
+			response.Pairs = append(response.Pairs, &kvrpcpb.KvPair{Key: key, Value: val})
+		}
+	}
+	return response, nil
+}
+
+func (server *Server) RawBatchPut(_ context.Context, req *kvrpcpb.RawBatchPutRequest) (*kvrpcpb.RawBatchPutResponse, error) {
+	response := new(kvrpcpb.RawBatchPutResponse)
+	modifies := make([]storage.Modify, 0, len(req.Pairs))
+	for _, pair := range req.Pairs {
+		value, err := server.encodeRawValue(pair.Value, req.Ttl)
+		if rawRegionError(err, response) {
+			return response, nil
+		}
+		modifies = append(modifies, storage.Modify{Data: storage.Put{Key: pair.Key, Value: value, Cf: rawCF(req.Cf)}})
+	}
+	err := server.storage.Write(req.Context, modifies)
+	rawRegionError(err, response)
+	return response, nil
+}
+
+func (server *Server) RawBatchDelete(_ context.Context, req *kvrpcpb.RawBatchDeleteRequest) (*kvrpcpb.RawBatchDeleteResponse, error) {
+	response := new(kvrpcpb.RawBatchDeleteResponse)
+	modifies := make([]storage.Modify, 0, len(req.Keys))
+	for _, key := range req.Keys {
+		modifies = append(modifies, storage.Modify{Data: storage.Delete{Key: key, Cf: rawCF(req.Cf)}})
+	}
+	err := server.storage.Write(req.Context, modifies)
+	rawRegionError(err, response)
+	return response, nil
+}
+
+func (server *Server) RawDeleteRange(_ context.Context, req *kvrpcpb.RawDeleteRangeRequest) (*kvrpcpb.RawDeleteRangeResponse, error) {
+	response := new(kvrpcpb.RawDeleteRangeResponse)
+	if len(req.EndKey) != 0 && bytes.Compare(req.StartKey, req.EndKey) >= 0 {
+		response.Error = "invalid range for delete range"
+		return response, nil
+	}
+	err := server.storage.Write(req.Context, []storage.Modify{{
+		Data: storage.DeleteRange{
+			StartKey: req.StartKey,
+			EndKey:   req.EndKey,
+			Cf:       rawCF(req.Cf),
+		}}})
+	rawRegionError(err, response)
+	return response, nil
+}
+
+// RawCompareAndSwap holds the latch of the key from reading the previous value until the new value is written, so
+// the compare-and-swaps of the key are serialized. The other raw writes don't take the latches, they are not
+// atomic with a compare-and-swap of the same key.
+func (server *Server) RawCompareAndSwap(_ context.Context, req *kvrpcpb.RawCASRequest) (*kvrpcpb.RawCASResponse, error) {
+	response := new(kvrpcpb.RawCASResponse)
+	value, err := server.encodeRawValue(req.Value, req.Ttl)
+	if rawRegionError(err, response) {
+		return response, nil
+	}
+	keys := [][]byte{req.Key}
+	server.Latches.WaitForLatches(keys)
+	defer server.Latches.ReleaseLatches(keys)
+
+	reader, err := server.storage.Reader(req.Context)
+	if rawRegionError(err, response) {
+		return response, nil
+	}
+	previous, err := server.getRawValue(reader, rawCF(req.Cf), req.Key)
+	reader.Close()
+	if rawRegionError(err, response) {
+		return response, nil
+	}
+	response.PreviousNotExist = previous == nil
+	response.PreviousValue = previous
+	if req.PreviousNotExist != response.PreviousNotExist ||
+		(!req.PreviousNotExist && !bytes.Equal(previous, req.PreviousValue)) {
+		return response, nil
+	}
+	err = server.storage.Write(req.Context, []storage.Modify{{
+		Data: storage.Put{
+			Key:   req.Key,
+			Value: value,
+			Cf:    rawCF(req.Cf),
+		}}})
+	response.Succeed = !rawRegionError(err, response)
+	return response, nil
+}
+
 // Raft commands (tinykv <-> tinykv); these are trivially forwarded to storage.
 func (server *Server) Raft(stream tinykvpb.TinyKv_RaftServer) error {
 	return server.storage.(*raft_storage.RaftStorage).Raft(stream)
@@ -224,8 +496,13 @@ func (server *Server) Snapshot(stream tinykvpb.TinyKv_SnapshotServer) error {
 
 // SQL push down commands.
 func (server *Server) Coprocessor(_ context.Context, req *coppb.Request) (*coppb.Response, error) {
+	server.concurrencyManager.UpdateMaxTs(req.StartTs)
 	resp := new(coppb.Response)
-	reader, err := server.storage.Reader(req.Context)
+	var reader storage.StorageReader
+	err := server.checkStaleRead(req.Context, req.StartTs)
+	if err == nil {
+		reader, err = server.storage.Reader(req.Context)
+	}
 	if err != nil {
 		resp, err := regionError(err, resp)
 		if err != nil {
@@ -367,3 +367,121 @@ func TestRegionError(t *testing.T) {
 		assert.NotNil(t, newResp)
 	}
 }
+
+func TestRawBatch(t *testing.T) {
+	server := NewServer(storage.NewMemStorage())
+	cf := engine_util.CfDefault
+
+	putResp, err := server.RawBatchPut(nil, &kvrpcpb.RawBatchPutRequest{
+		Pairs: []*kvrpcpb.KvPair{{Key: []byte{1}, Value: []byte{42}}, {Key: []byte{3}, Value: []byte{43}}},
+		Cf:    cf,
+	})
+	assert.Nil(t, err)
+	assert.Empty(t, putResp.Error)
+
+	getReq := &kvrpcpb.RawBatchGetRequest{Keys: [][]byte{{1}, {2}, {3}}, Cf: cf}
+	getResp, err := server.RawBatchGet(nil, getReq)
+	assert.Nil(t, err)
+	assert.Equal(t, []*kvrpcpb.KvPair{{Key: []byte{1}, Value: []byte{42}}, {Key: []byte{3}, Value: []byte{43}}}, getResp.Pairs)
+
+	deleteResp, err := server.RawBatchDelete(nil, &kvrpcpb.RawBatchDeleteRequest{Keys: [][]byte{{1}, {2}}, Cf: cf})
+	assert.Nil(t, err)
+	assert.Empty(t, deleteResp.Error)
+	getResp, err = server.RawBatchGet(nil, getReq)
+	assert.Nil(t, err)
+	assert.Equal(t, []*kvrpcpb.KvPair{{Key: []byte{3}, Value: []byte{43}}}, getResp.Pairs)
+}
+
+func TestRawDeleteRange(t *testing.T) {
+	mem := storage.NewMemStorage()
+	server := NewServer(mem)
+	for i := byte(1); i <= 4; i++ {
+		mem.Set(engine_util.CfDefault, []byte{i}, []byte{42})
+	}
+
+	resp, err := server.RawDeleteRange(nil, &kvrpcpb.RawDeleteRangeRequest{StartKey: []byte{2}, EndKey: []byte{4}})
+	assert.Nil(t, err)
+	assert.Empty(t, resp.Error)
+	assert.Equal(t, 2, mem.Len(engine_util.CfDefault))
+	assert.NotNil(t, mem.Get(engine_util.CfDefault, []byte{1}))
+	assert.NotNil(t, mem.Get(engine_util.CfDefault, []byte{4}))
+
+	resp, err = server.RawDeleteRange(nil, &kvrpcpb.RawDeleteRangeRequest{StartKey: []byte{4}, EndKey: []byte{1}})
+	assert.Nil(t, err)
+	assert.NotEmpty(t, resp.Error)
+	assert.Equal(t, 2, mem.Len(engine_util.CfDefault))
+}
+
+func TestRawCompareAndSwap(t *testing.T) {
+	server := NewServer(storage.NewMemStorage())
+	cas := func(previousNotExist bool, previous, value []byte) *kvrpcpb.RawCASResponse {
+		resp, err := server.RawCompareAndSwap(nil, &kvrpcpb.RawCASRequest{
+			Key:              []byte{1},
+			Value:            value,
+			PreviousNotExist: previousNotExist,
+			PreviousValue:    previous,
+		})
+		assert.Nil(t, err)
+		assert.Empty(t, resp.Error)
+		return resp
+	}
+
+	resp := cas(true, nil, []byte{42})
+	assert.True(t, resp.Succeed)
+	assert.True(t, resp.PreviousNotExist)
+
+	// The key exists now.
+	resp = cas(true, nil, []byte{43})
+	assert.False(t, resp.Succeed)
+	assert.Equal(t, []byte{42}, resp.PreviousValue)
+
+	resp = cas(false, []byte{41}, []byte{43})
+	assert.False(t, resp.Succeed)
+	assert.False(t, resp.PreviousNotExist)
+	assert.Equal(t, []byte{42}, resp.PreviousValue)
+
+	resp = cas(false, []byte{42}, []byte{43})
+	assert.True(t, resp.Succeed)
+	getResp, err := server.RawGet(nil, &kvrpcpb.RawGetRequest{Key: []byte{1}})
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{43}, getResp.Value)
+}
+
+func TestRawTTL(t *testing.T) {
+	server := NewServer(storage.NewMemStorage())
+	resp, err := server.RawPut(nil, &kvrpcpb.RawPutRequest{Key: []byte{1}, Value: []byte{42}, Ttl: 10})
+	assert.Nil(t, err)
+	assert.NotEmpty(t, resp.Error)
+
+	server.EnableRawTTL()
+	now := uint64(1000)
+	oldRawNow := rawNow
+	rawNow = func() uint64 { return now }
+	defer func() { rawNow = oldRawNow }()
+
+	for i, ttl := range []uint64{10, 0, 20} {
+		resp, err = server.RawPut(nil, &kvrpcpb.RawPutRequest{Key: []byte{byte(i + 1)}, Value: []byte{42}, Ttl: ttl})
+		assert.Nil(t, err)
+		assert.Empty(t, resp.Error)
+	}
+	getResp, err := server.RawGet(nil, &kvrpcpb.RawGetRequest{Key: []byte{1}})
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{42}, getResp.Value)
+
+	// Key 1 expires, the value without TTL never expires.
+	now = 1010
+	getResp, err = server.RawGet(nil, &kvrpcpb.RawGetRequest{Key: []byte{1}})
+	assert.Nil(t, err)
+	assert.True(t, getResp.NotFound)
+	scanResp, err := server.RawScan(nil, &kvrpcpb.RawScanRequest{StartKey: []byte{1}, Limit: 10})
+	assert.Nil(t, err)
+	assert.Equal(t, []*kvrpcpb.KvPair{{Key: []byte{2}, Value: []byte{42}}, {Key: []byte{3}, Value: []byte{42}}}, scanResp.Kvs)
+
+	// An expired key doesn't exist for compare-and-swap.
+	casResp, err := server.RawCompareAndSwap(nil, &kvrpcpb.RawCASRequest{Key: []byte{1}, Value: []byte{43}, PreviousNotExist: true})
+	assert.Nil(t, err)
+	assert.True(t, casResp.Succeed)
+	batchResp, err := server.RawBatchGet(nil, &kvrpcpb.RawBatchGetRequest{Keys: [][]byte{{1}, {3}}})
+	assert.Nil(t, err)
+	assert.Equal(t, []*kvrpcpb.KvPair{{Key: []byte{1}, Value: []byte{43}}, {Key: []byte{3}, Value: []byte{42}}}, batchResp.Pairs)
+}
@@ -62,12 +62,35 @@ func (s *MemStorage) Write(ctx *kvrpcpb.Context, batch []Modify) error {
 			case engine_util.CfWrite:
 				s.CfWrite.Delete(item)
 			}
+		case DeleteRange:
+			switch data.Cf {
+			case engine_util.CfDefault:
+				deleteRange(s.CfDefault, data.StartKey, data.EndKey)
+			case engine_util.CfLock:
+				deleteRange(s.CfLock, data.StartKey, data.EndKey)
+			case engine_util.CfWrite:
+				deleteRange(s.CfWrite, data.StartKey, data.EndKey)
+			}
 		}
 	}
 
 	return nil
 }
 
+func deleteRange(tree *llrb.LLRB, startKey, endKey []byte) {
+	var items []llrb.Item
+	tree.AscendGreaterOrEqual(memItem{key: startKey}, func(item llrb.Item) bool {
+		if engine_util.ExceedEndKey(item.(memItem).key, endKey) {
+			return false
+		}
+		items = append(items, item)
+		return true
+	})
+	for _, item := range items {
+		tree.Delete(item)
+	}
+}
+
 func (s *MemStorage) Get(cf string, key []byte) []byte {
 	item := memItem{key: key}
 	var result llrb.Item
@@ -16,6 +16,13 @@ type Delete struct {
 	Cf  string
 }
 
+// DeleteRange deletes the keys in [StartKey, EndKey) of the column family.
+type DeleteRange struct {
+	StartKey []byte
+	EndKey   []byte
+	Cf       string
+}
+
 func (m *Modify) Key() []byte {
 	switch m.Data.(type) {
 	case Put:
@@ -40,6 +47,8 @@ func (m *Modify) Cf() string {
 		return m.Data.(Put).Cf
 	case Delete:
 		return m.Data.(Delete).Cf
+	case DeleteRange:
+		return m.Data.(DeleteRange).Cf
 	}
 	return ""
 }
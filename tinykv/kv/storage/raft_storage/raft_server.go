@@ -2,16 +2,20 @@ package raft_storage
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"path/filepath"
 	"sync"
 
 	"github.com/pingcap-incubator/tinykv/kv/config"
 	"github.com/pingcap-incubator/tinykv/kv/raftstore"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/cdc"
 	"github.com/pingcap-incubator/tinykv/kv/raftstore/message"
 	"github.com/pingcap-incubator/tinykv/kv/raftstore/scheduler_client"
 	"github.com/pingcap-incubator/tinykv/kv/raftstore/snap"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/util"
 	"github.com/pingcap-incubator/tinykv/kv/storage"
+	"github.com/pingcap-incubator/tinykv/kv/transaction/concurrency"
 	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
 	"github.com/pingcap-incubator/tinykv/kv/util/worker"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/errorpb"
@@ -102,6 +106,15 @@ func (rs *RaftStorage) Write(ctx *kvrpcpb.Context, batch []storage.Modify) error
 					Cf:  delete.Cf,
 					Key: delete.Key,
 				}})
+		case storage.DeleteRange:
+			deleteRange := m.Data.(storage.DeleteRange)
+			reqs = append(reqs, &raft_cmdpb.Request{
+				CmdType: raft_cmdpb.CmdType_DeleteRange,
+				DeleteRange: &raft_cmdpb.DeleteRangeRequest{
+					Cf:       deleteRange.Cf,
+					StartKey: deleteRange.StartKey,
+					EndKey:   deleteRange.EndKey,
+				}})
 		}
 	}
 
@@ -125,15 +138,21 @@ func (rs *RaftStorage) Write(ctx *kvrpcpb.Context, batch []storage.Modify) error
 
 // Reader is main entrance to get a snapshot of current state machine for read. Only
 // the raft group or region leader could process read requests, to ensure this another
-// raft instance is used and the snapshot is generated by the applier. There are also
-// better ways to reduce the cost of read request processing, more information about
-// this could be found in the raft paper 6.4.
+// raft instance is used. The snapshot command is not appended to the raft log, the leader
+// confirms its leadership by ReadIndex, or by the leader lease if it's enabled, and
+// generates the snapshot once the read index is applied, see the raft paper 6.4.
+//
+// A replica read is served by a follower in the same way, except the read index is got
+// from the leader. A stale read is served from the applied state of the peer directly,
+// the caller must check it with CheckStaleRead before.
 func (rs *RaftStorage) Reader(ctx *kvrpcpb.Context) (storage.StorageReader, error) {
 	header := &raft_cmdpb.RaftRequestHeader{
 		RegionId:    ctx.RegionId,
 		Peer:        ctx.Peer,
 		RegionEpoch: ctx.RegionEpoch,
 		Term:        ctx.Term,
+		ReplicaRead: ctx.ReplicaRead,
+		StaleRead:   ctx.StaleRead,
 	}
 	request := &raft_cmdpb.RaftCmdRequest{
 		Header: header,
@@ -160,7 +179,9 @@ func (rs *RaftStorage) Reader(ctx *kvrpcpb.Context) (storage.StorageReader, erro
 	if len(resp.Responses) != 1 {
 		panic("wrong response count for snap cmd")
 	}
-	return NewRegionReader(cb.Txn, *resp.Responses[0].GetSnap().Region), nil
+	reader := NewRegionReader(cb.Txn, *resp.Responses[0].GetSnap().Region)
+	reader.loadStats = rs.raftSystem.LoadStats()
+	return reader, nil
 }
 
 func (rs *RaftStorage) Raft(stream tinykvpb.TinyKv_RaftServer) error {
@@ -234,3 +255,47 @@ func (rs *RaftStorage) Stop() error {
 func (rs *RaftStorage) Client() scheduler_client.Client {
 	return rs.client
 }
+
+// CdcObserver returns the observer of the changes applied to the regions in this store.
+func (rs *RaftStorage) CdcObserver() *cdc.Observer {
+	return rs.raftSystem.CdcObserver()
+}
+
+// ConcurrencyManager returns the max read timestamp of this store, it's shared by the server and the raftstore.
+func (rs *RaftStorage) ConcurrencyManager() *concurrency.Manager {
+	return rs.raftSystem.ConcurrencyManager()
+}
+
+// ResolvedTs returns the state of the resolved ts of the peer of the region on this store.
+func (rs *RaftStorage) ResolvedTs(ctx *kvrpcpb.Context) (raftstore.ResolvedTsState, error) {
+	state, err := rs.raftSystem.ResolvedTs(ctx.GetRegionId())
+	if err != nil {
+		return state, &RegionError{RequestErr: util.RaftstoreErrToPbError(err)}
+	}
+	return state, nil
+}
+
+// CheckStaleRead returns a region error if the peer of the region on this store can't serve a stale read at ts yet.
+// The resolved ts only advances after the peer has applied the transactions committed before it, so it's checked
+// before the snapshot of the read is taken.
+func (rs *RaftStorage) CheckStaleRead(ctx *kvrpcpb.Context, ts uint64) error {
+	if !ctx.GetStaleRead() {
+		return nil
+	}
+	state, err := rs.ResolvedTs(ctx)
+	if err != nil {
+		return err
+	}
+	if state.ResolvedTs < ts {
+		return &RegionError{RequestErr: &errorpb.Error{
+			Message: fmt.Sprintf("stale read at %d is not ready, the safe ts of region %d is %d",
+				ts, ctx.GetRegionId(), state.ResolvedTs),
+			DataIsNotReady: &errorpb.DataIsNotReady{
+				RegionId: ctx.GetRegionId(),
+				PeerId:   ctx.GetPeer().GetId(),
+				SafeTs:   state.ResolvedTs,
+			},
+		}}
+	}
+	return nil
+}
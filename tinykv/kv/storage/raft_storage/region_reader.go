@@ -2,6 +2,7 @@ package raft_storage
 
 import (
 	"github.com/Connor1996/badger"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/runner"
 	"github.com/pingcap-incubator/tinykv/kv/raftstore/util"
 	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
@@ -10,6 +11,9 @@ import (
 type RegionReader struct {
 	txn    *badger.Txn
 	region *metapb.Region
+	// loadStats records the accessed keys for load based split and the read
+	// flow if it's set.
+	loadStats *runner.LoadStats
 }
 
 func NewRegionReader(txn *badger.Txn, region metapb.Region) *RegionReader {
@@ -23,15 +27,25 @@ func (r *RegionReader) GetCF(cf string, key []byte) ([]byte, error) {
 	if err := util.CheckKeyInRegion(key, r.region); err != nil {
 		return nil, err
 	}
+	// A transactional read always accesses the write CF too, skip the lock CF
+	// to avoid counting an access twice.
+	if r.loadStats != nil && cf != engine_util.CfLock {
+		r.loadStats.Record(r.region.GetId(), key)
+	}
 	val, err := engine_util.GetCFFromTxn(r.txn, cf, key)
 	if err == badger.ErrKeyNotFound {
 		return nil, nil
 	}
+	if err == nil && r.loadStats != nil {
+		r.loadStats.RecordRead(r.region.GetId(), uint64(len(key)+len(val)), 1)
+	}
 	return val, err
 }
 
 func (r *RegionReader) IterCF(cf string) engine_util.DBIterator {
-	return NewRegionIterator(engine_util.NewCFIterator(cf, r.txn), r.region)
+	it := NewRegionIterator(engine_util.NewCFIterator(cf, r.txn), r.region)
+	it.loadStats = r.loadStats
+	return it
 }
 
 func (r *RegionReader) Close() {
@@ -41,8 +55,9 @@ func (r *RegionReader) Close() {
 // RegionIterator wraps a db iterator and only allow it to iterate in the region. It behaves as if underlying
 // db only contains one region.
 type RegionIterator struct {
-	iter   *engine_util.BadgerIterator
-	region *metapb.Region
+	iter      *engine_util.BadgerIterator
+	region    *metapb.Region
+	loadStats *runner.LoadStats
 }
 
 func NewRegionIterator(iter *engine_util.BadgerIterator, region *metapb.Region) *RegionIterator {
@@ -75,6 +90,10 @@ func (it *RegionIterator) Close() {
 }
 
 func (it *RegionIterator) Next() {
+	if it.loadStats != nil && it.iter.Valid() {
+		item := it.iter.Item()
+		it.loadStats.RecordRead(it.region.GetId(), uint64(len(item.Key())+item.ValueSize()), 1)
+	}
 	it.iter.Next()
 }
 
@@ -82,6 +101,9 @@ func (it *RegionIterator) Seek(key []byte) {
 	if err := util.CheckKeyInRegion(key, it.region); err != nil {
 		panic(err)
 	}
+	if it.loadStats != nil {
+		it.loadStats.Record(it.region.GetId(), key)
+	}
 	it.iter.Seek(key)
 }
 
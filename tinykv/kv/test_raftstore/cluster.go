@@ -470,6 +470,25 @@ func (c *Cluster) MustRemovePeer(regionID uint64, peer *metapb.Peer) {
 	c.MustNonePeer(regionID, peer)
 }
 
+func (c *Cluster) MustMergeRegion(source, target uint64) {
+	targetRegion, _, err := c.schedulerClient.GetRegionByID(context.TODO(), target)
+	if err != nil || targetRegion.GetId() != target {
+		log.Fatal(fmt.Sprintf("MustMergeRegion get region by id=%v err=%v", target, err))
+	}
+	c.schedulerClient.MergeRegion(source, targetRegion)
+	for i := 0; i < 200; i++ {
+		region, _, err := c.schedulerClient.GetRegionByID(context.TODO(), source)
+		if err != nil {
+			log.Fatal(fmt.Sprintf("MustMergeRegion get region by id=%v err=%v", source, err))
+		}
+		if region.GetId() != source {
+			return
+		}
+		SleepMS(100)
+	}
+	log.Fatal(fmt.Sprintf("region %d is not merged into region %d", source, target))
+}
+
 func (c *Cluster) MustHavePeer(regionID uint64, peer *metapb.Peer) {
 	for i := 0; i < 200; i++ {
 		region, _, err := c.schedulerClient.GetRegionByID(context.TODO(), regionID)
@@ -478,7 +497,7 @@ func (c *Cluster) MustHavePeer(regionID uint64, peer *metapb.Peer) {
 		}
 		if region != nil {
 			if p := FindPeer(region, peer.GetStoreId()); p != nil {
-				if p.GetId() == peer.GetId() {
+				if p.GetId() == peer.GetId() && p.GetIsLearner() == peer.GetIsLearner() {
 					return
 				}
 			}
@@ -40,6 +40,7 @@ const (
 	OperatorTypeAddPeer        = 1
 	OperatorTypeRemovePeer     = 2
 	OperatorTypeTransferLeader = 3
+	OperatorTypeMerge          = 4
 )
 
 type Operator struct {
@@ -60,6 +61,10 @@ type OpTransferLeader struct {
 	peer *metapb.Peer
 }
 
+type OpMerge struct {
+	target *metapb.Region
+}
+
 type Store struct {
 	store                    metapb.Store
 	heartbeatResponseHandler func(*schedulerpb.RegionHeartbeatResponse)
@@ -89,6 +94,8 @@ type MockSchedulerClient struct {
 	pendingPeers map[uint64]*metapb.Peer // peerID -> peer
 
 	bootstrapped bool
+	gcSafePoint  uint64
+	tso          uint64
 }
 
 func NewMockSchedulerClient(clusterID uint64, baseID uint64) *MockSchedulerClient {
@@ -218,6 +225,30 @@ func (m *MockSchedulerClient) getRegionByIDLocked(regionID uint64) (*metapb.Regi
 	return region, leader, nil
 }
 
+func (m *MockSchedulerClient) ScanRegions(ctx context.Context, startKey, endKey []byte, limit int) ([]*metapb.Region, []*metapb.Peer, error) {
+	if err := m.checkBootstrap(); err != nil {
+		return nil, nil, err
+	}
+	m.RLock()
+	defer m.RUnlock()
+	var regions []*metapb.Region
+	var leaders []*metapb.Peer
+	item := &regionItem{region: metapb.Region{StartKey: startKey}}
+	if first := m.findRegion(startKey); first != nil {
+		item = first
+	}
+	m.regionsRange.AscendGreaterOrEqual(item, func(i btree.Item) bool {
+		r := i.(*regionItem).region
+		if len(endKey) > 0 && bytes.Compare(r.GetStartKey(), endKey) >= 0 {
+			return false
+		}
+		regions = append(regions, &r)
+		leaders = append(leaders, m.leaders[r.GetId()])
+		return limit <= 0 || len(regions) < limit
+	})
+	return regions, leaders, nil
+}
+
 func (m *MockSchedulerClient) AskSplit(ctx context.Context, region *metapb.Region) (*schedulerpb.AskSplitResponse, error) {
 	resp := new(schedulerpb.AskSplitResponse)
 	resp.Header = &schedulerpb.ResponseHeader{ClusterId: m.clusterID}
@@ -297,6 +328,9 @@ func (m *MockSchedulerClient) handleHeartbeatVersion(region *metapb.Region) erro
 		panic("start key > end key")
 	}
 
+	// A merged region covers the regions merged into it.
+	m.removeCoveredRegionsLocked(region)
+
 	for {
 		searchRegion, _ := m.getRegionLocked(region.GetStartKey())
 		if searchRegion == nil {
@@ -333,6 +367,25 @@ func (m *MockSchedulerClient) handleHeartbeatVersion(region *metapb.Region) erro
 	}
 }
 
+func (m *MockSchedulerClient) removeCoveredRegionsLocked(region *metapb.Region) {
+	var covered []*metapb.Region
+	item := &regionItem{region: metapb.Region{StartKey: region.GetStartKey()}}
+	m.regionsRange.AscendGreaterOrEqual(item, func(i btree.Item) bool {
+		r := &i.(*regionItem).region
+		if engine_util.ExceedEndKey(r.GetStartKey(), region.GetEndKey()) {
+			return false
+		}
+		if bytes.Compare(r.GetStartKey(), region.GetStartKey()) > 0 &&
+			r.GetRegionEpoch().GetVersion() < region.GetRegionEpoch().GetVersion() {
+			covered = append(covered, r)
+		}
+		return true
+	})
+	for _, r := range covered {
+		m.removeRegionLocked(r)
+	}
+}
+
 func (m *MockSchedulerClient) handleHeartbeatConfVersion(region *metapb.Region) error {
 	searchRegion, _ := m.getRegionLocked(region.GetStartKey())
 	if util.IsEpochStale(region.RegionEpoch, searchRegion.RegionEpoch) {
@@ -345,34 +398,35 @@ func (m *MockSchedulerClient) handleHeartbeatConfVersion(region *metapb.Region)
 	if region.RegionEpoch.ConfVer > searchRegion.RegionEpoch.ConfVer {
 		// If ConfVer changed, TinyKV has added/removed one peer already.
 		// So scheduler and TinyKV can't have same peer count and can only have
-		// only one different peer.
-		if searchRegionPeerLen > regionPeerLen {
-			if searchRegionPeerLen-regionPeerLen != 1 {
-				panic("should only one conf change")
-			}
-			if len(GetDiffPeers(searchRegion, region)) != 1 {
-				panic("should only one different peer")
-			}
-			if len(GetDiffPeers(region, searchRegion)) != 0 {
-				panic("should include all peers")
-			}
-		} else if searchRegionPeerLen < regionPeerLen {
-			if regionPeerLen-searchRegionPeerLen != 1 {
-				panic("should only one conf change")
-			}
-			if len(GetDiffPeers(region, searchRegion)) != 1 {
-				panic("should only one different peer")
-			}
-			if len(GetDiffPeers(searchRegion, region)) != 0 {
-				panic("should include all peers")
-			}
-		} else {
-			MustSamePeers(searchRegion, region)
-			if searchRegion.RegionEpoch.ConfVer+1 != region.RegionEpoch.ConfVer {
-				panic("unmatched conf version")
-			}
-			if searchRegion.RegionEpoch.Version+1 != region.RegionEpoch.Version {
-				panic("unmatched version")
+		// only one different peer, unless a learner is promoted. A region
+		// entering or leaving the joint state may change several peers at once.
+		joint := util.IsInJointState(region) || util.IsInJointState(searchRegion)
+		if !joint {
+			if searchRegionPeerLen > regionPeerLen {
+				if searchRegionPeerLen-regionPeerLen != 1 {
+					panic("should only one conf change")
+				}
+				if len(GetDiffPeers(searchRegion, region)) != 1 {
+					panic("should only one different peer")
+				}
+				if len(GetDiffPeers(region, searchRegion)) != 0 {
+					panic("should include all peers")
+				}
+			} else if searchRegionPeerLen < regionPeerLen {
+				if regionPeerLen-searchRegionPeerLen != 1 {
+					panic("should only one conf change")
+				}
+				if len(GetDiffPeers(region, searchRegion)) != 1 {
+					panic("should only one different peer")
+				}
+				if len(GetDiffPeers(searchRegion, region)) != 0 {
+					panic("should include all peers")
+				}
+			} else {
+				MustSamePeers(searchRegion, region)
+				if searchRegion.RegionEpoch.ConfVer+1 != region.RegionEpoch.ConfVer {
+					panic("unmatched conf version")
+				}
 			}
 		}
 
@@ -392,7 +446,7 @@ func (m *MockSchedulerClient) tryFinished(op *Operator, region *metapb.Region, l
 		add := op.Data.(*OpAddPeer)
 		if !add.pending {
 			for _, p := range region.GetPeers() {
-				if add.peer.GetId() == p.GetId() {
+				if add.peer.GetId() == p.GetId() && add.peer.GetIsLearner() == p.GetIsLearner() {
 					add.pending = true
 					return false
 				}
@@ -414,6 +468,9 @@ func (m *MockSchedulerClient) tryFinished(op *Operator, region *metapb.Region, l
 	case OperatorTypeTransferLeader:
 		transfer := op.Data.(*OpTransferLeader)
 		return leader.GetId() == transfer.peer.GetId()
+	case OperatorTypeMerge:
+		// The merged region never reports heartbeats again.
+		return false
 	}
 	panic("unreachable")
 }
@@ -423,8 +480,12 @@ func (m *MockSchedulerClient) makeRegionHeartbeatResponse(op *Operator, resp *sc
 	case OperatorTypeAddPeer:
 		add := op.Data.(*OpAddPeer)
 		if !add.pending {
+			changeType := eraftpb.ConfChangeType_AddNode
+			if add.peer.GetIsLearner() {
+				changeType = eraftpb.ConfChangeType_AddLearnerNode
+			}
 			resp.ChangePeer = &schedulerpb.ChangePeer{
-				ChangeType: eraftpb.ConfChangeType_AddNode,
+				ChangeType: changeType,
 				Peer:       add.peer,
 			}
 		}
@@ -439,6 +500,11 @@ func (m *MockSchedulerClient) makeRegionHeartbeatResponse(op *Operator, resp *sc
 		resp.TransferLeader = &schedulerpb.TransferLeader{
 			Peer: transfer.peer,
 		}
+	case OperatorTypeMerge:
+		merge := op.Data.(*OpMerge)
+		resp.Merge = &schedulerpb.Merge{
+			Target: merge.target,
+		}
 	}
 }
 
@@ -452,6 +518,19 @@ func (m *MockSchedulerClient) SetRegionHeartbeatResponseHandler(storeID uint64,
 	store.heartbeatResponseHandler = h
 }
 
+func (m *MockSchedulerClient) GetGCSafePoint(ctx context.Context) (uint64, error) {
+	m.RLock()
+	defer m.RUnlock()
+	return m.gcSafePoint, nil
+}
+
+func (m *MockSchedulerClient) GetTS(ctx context.Context) (uint64, error) {
+	m.Lock()
+	defer m.Unlock()
+	m.tso++
+	return m.tso, nil
+}
+
 func (m *MockSchedulerClient) Close() {
 	// do nothing
 }
@@ -515,6 +594,15 @@ func (m *MockSchedulerClient) TransferLeader(regionID uint64, peer *metapb.Peer)
 	})
 }
 
+func (m *MockSchedulerClient) MergeRegion(source uint64, target *metapb.Region) {
+	m.scheduleOperator(source, &Operator{
+		Type: OperatorTypeMerge,
+		Data: &OpMerge{
+			target: target,
+		},
+	})
+}
+
 func (m *MockSchedulerClient) getRandomRegion() *metapb.Region {
 	m.RLock()
 	defer m.RUnlock()
@@ -532,6 +620,14 @@ func (m *MockSchedulerClient) scheduleOperator(regionID uint64, op *Operator) {
 	m.operators[regionID] = op
 }
 
+func (m *MockSchedulerClient) UpdateGCSafePoint(safePoint uint64) {
+	m.Lock()
+	defer m.Unlock()
+	if safePoint > m.gcSafePoint {
+		m.gcSafePoint = safePoint
+	}
+}
+
 // Utilities
 func MustSamePeers(left *metapb.Region, right *metapb.Region) {
 	if len(left.GetPeers()) != len(right.GetPeers()) {
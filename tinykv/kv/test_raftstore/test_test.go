@@ -14,7 +14,10 @@ import (
 	"github.com/Connor1996/badger"
 	"github.com/pingcap-incubator/tinykv/kv/config"
 	"github.com/pingcap-incubator/tinykv/kv/raftstore/meta"
+	"github.com/pingcap-incubator/tinykv/kv/raftstore/util"
 	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/eraftpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_cmdpb"
 	"github.com/pingcap/log"
 	"github.com/stretchr/testify/assert"
@@ -347,7 +350,18 @@ func TestUnreliable2BLab1P1b(t *testing.T) {
 // doesn't go through until the partition heals.  The leader in the original
 // network ends up in the minority partition.
 func TestOnePartition2Blab1P1b(t *testing.T) {
+	testOnePartition(t, config.NewTestConfig())
+}
+
+// TestOnePartitionPreVote2B is the same as TestOnePartition2Blab1P1b but
+// with the raft PreVote phase enabled.
+func TestOnePartitionPreVote2B(t *testing.T) {
 	cfg := config.NewTestConfig()
+	cfg.RaftPreVote = true
+	testOnePartition(t, cfg)
+}
+
+func testOnePartition(t *testing.T, cfg *config.Config) {
 	cluster := NewTestCluster(5, cfg)
 	cluster.Start()
 	defer cluster.Shutdown()
@@ -397,6 +411,67 @@ func TestOnePartition2Blab1P1b(t *testing.T) {
 	MustGetEqual(cluster.engines[s1[0]], []byte("k1"), []byte("changed"))
 }
 
+// An isolated follower keeps timing out while the partition lasts. With
+// PreVote enabled it must not increase its term, so the leader in the
+// majority is not forced to step down when the partition heals.
+func TestPartitionedFollowerPreVote2B(t *testing.T) {
+	cfg := config.NewTestConfig()
+	cfg.RaftPreVote = true
+	cluster := NewTestCluster(5, cfg)
+	cluster.Start()
+	defer cluster.Shutdown()
+
+	electionTimeout := cfg.RaftBaseTickInterval * time.Duration(cfg.RaftElectionTimeoutTicks)
+	cluster.MustPut([]byte("k1"), []byte("v1"))
+
+	region := cluster.GetRegion([]byte(""))
+	leader := cluster.LeaderOfRegion(region.GetId())
+	var isolated uint64
+	others := []uint64{}
+	for _, p := range region.GetPeers() {
+		if p.GetId() != leader.GetId() && isolated == 0 {
+			isolated = p.GetStoreId()
+		} else {
+			others = append(others, p.GetStoreId())
+		}
+	}
+	MustGetEqual(cluster.engines[isolated], []byte("k1"), []byte("v1"))
+	raftState, err := meta.GetRaftLocalState(cluster.engines[leader.GetStoreId()].Raft, region.GetId())
+	if err != nil {
+		t.Fatal(err)
+	}
+	term := raftState.GetHardState().GetTerm()
+
+	cluster.AddFilter(&PartitionFilter{
+		s1: []uint64{isolated},
+		s2: others,
+	})
+	time.Sleep(5 * electionTimeout)
+	cluster.MustPut([]byte("k2"), []byte("v2"))
+	MustGetNone(cluster.engines[isolated], []byte("k2"))
+
+	raftState, err = meta.GetRaftLocalState(cluster.engines[isolated].Raft, region.GetId())
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, term, raftState.GetHardState().GetTerm())
+
+	// when partition heals, the isolated follower catches up without
+	// disturbing the leader
+	cluster.ClearFilters()
+	MustGetEqual(cluster.engines[isolated], []byte("k2"), []byte("v2"))
+	time.Sleep(2 * electionTimeout)
+	cluster.MustPut([]byte("k3"), []byte("v3"))
+	MustGetEqual(cluster.engines[isolated], []byte("k3"), []byte("v3"))
+
+	raftState, err = meta.GetRaftLocalState(cluster.engines[leader.GetStoreId()].Raft, region.GetId())
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, term, raftState.GetHardState().GetTerm())
+	assert.Equal(t, leader.GetStoreId(), cluster.LeaderOfRegion(region.GetId()).GetStoreId())
+}
+
 func TestManyPartitionsOneClient2BLab1P1b(t *testing.T) {
 	// Test: partitions, one client (2B) ...
 	GenericTest(t, "2B", 1, false, false, true, -1, false, false)
@@ -596,6 +671,89 @@ func TestBasicConfChange3BLab1P4a(t *testing.T) {
 	MustGetNone(cluster.engines[3], []byte("k4"))
 }
 
+func TestLearnerConfChange3B(t *testing.T) {
+	cfg := config.NewTestConfig()
+	cluster := NewTestCluster(3, cfg)
+	cluster.Start()
+	defer cluster.Shutdown()
+
+	cluster.MustTransferLeader(1, NewPeer(1, 1))
+	cluster.MustRemovePeer(1, NewPeer(2, 2))
+	cluster.MustRemovePeer(1, NewPeer(3, 3))
+
+	// add learner (2, 2) to region 1, it receives the data but doesn't vote
+	cluster.MustAddPeer(1, NewLearnerPeer(2, 2))
+	cluster.MustPut([]byte("k1"), []byte("v1"))
+	MustGetEqual(cluster.engines[2], []byte("k1"), []byte("v1"))
+
+	// the learner is not in the quorum, an isolated learner doesn't block writes
+	cluster.AddFilter(&PartitionFilter{
+		s1: []uint64{1, 3},
+		s2: []uint64{2},
+	})
+	cluster.MustPut([]byte("k2"), []byte("v2"))
+	MustGetNone(cluster.engines[2], []byte("k2"))
+	cluster.ClearFilters()
+	MustGetEqual(cluster.engines[2], []byte("k2"), []byte("v2"))
+
+	// promote the learner to a voter
+	cluster.MustAddPeer(1, NewPeer(2, 2))
+	region := cluster.GetRegion([]byte("k1"))
+	assert.Equal(t, 2, len(region.GetPeers()))
+	for _, p := range region.GetPeers() {
+		assert.False(t, p.GetIsLearner())
+	}
+	cluster.MustPut([]byte("k3"), []byte("v3"))
+	MustGetEqual(cluster.engines[2], []byte("k3"), []byte("v3"))
+}
+
+func TestJointConfChange3B(t *testing.T) {
+	cfg := config.NewTestConfig()
+	cluster := NewTestCluster(5, cfg)
+	cluster.Start()
+	defer cluster.Shutdown()
+
+	cluster.MustTransferLeader(1, NewPeer(1, 1))
+	cluster.MustRemovePeer(1, NewPeer(4, 4))
+	cluster.MustRemovePeer(1, NewPeer(5, 5))
+	cluster.MustPut([]byte("k1"), []byte("v1"))
+
+	// swap (2, 2) and (3, 3) for two new peers on store 4 and 5 at once
+	peer4, peer5 := cluster.AllocPeer(4), cluster.AllocPeer(5)
+	region := cluster.GetRegion([]byte("k1"))
+	req := NewAdminRequest(region.GetId(), region.GetRegionEpoch(), NewChangePeerV2Cmd([]*raft_cmdpb.ChangePeerRequest{
+		{ChangeType: eraftpb.ConfChangeType_RemoveNode, Peer: NewPeer(2, 2)},
+		{ChangeType: eraftpb.ConfChangeType_RemoveNode, Peer: NewPeer(3, 3)},
+		{ChangeType: eraftpb.ConfChangeType_AddNode, Peer: peer4},
+		{ChangeType: eraftpb.ConfChangeType_AddNode, Peer: peer5},
+	}))
+	resp, _, err := cluster.CallCommandOnLeader(req, 5*time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.GetHeader().GetError() != nil {
+		t.Fatalf("resp: %v", resp)
+	}
+
+	// the leader leaves the joint state by itself
+	cluster.MustNonePeer(1, NewPeer(2, 2))
+	cluster.MustNonePeer(1, NewPeer(3, 3))
+	cluster.MustHavePeer(1, peer4)
+	cluster.MustHavePeer(1, peer5)
+	region = cluster.GetRegion([]byte("k1"))
+	assert.Equal(t, 3, len(region.GetPeers()))
+	assert.False(t, util.IsInJointState(region))
+
+	cluster.MustPut([]byte("k2"), []byte("v2"))
+	for _, storeID := range []uint64{4, 5} {
+		MustGetEqual(cluster.engines[storeID], []byte("k1"), []byte("v1"))
+		MustGetEqual(cluster.engines[storeID], []byte("k2"), []byte("v2"))
+	}
+	for _, storeID := range []uint64{2, 3} {
+		MustGetNone(cluster.engines[storeID], []byte("k1"))
+	}
+}
+
 func TestConfChangeRecover3B(t *testing.T) {
 	// Test: restarts, snapshots, conf change, one client (3B) ...
 	GenericTest(t, "3B", 1, false, true, false, -1, true, false)
@@ -673,6 +831,82 @@ func TestOneSplit3BLab1P4a(t *testing.T) {
 	MustGetEqual(cluster.engines[5], []byte("k100"), []byte("v100"))
 }
 
+func TestOneMerge3B(t *testing.T) {
+	cfg := config.NewTestConfig()
+	cfg.RegionMaxSize = 800
+	cfg.RegionSplitSize = 500
+	cluster := NewTestCluster(3, cfg)
+	cluster.Start()
+	defer cluster.Shutdown()
+
+	cluster.MustPut([]byte("k1"), []byte("v1"))
+	cluster.MustPut([]byte("k2"), []byte("v2"))
+	region := cluster.GetRegion([]byte("k1"))
+
+	// write some data to trigger split
+	for i := 100; i < 200; i++ {
+		cluster.MustPut([]byte(fmt.Sprintf("k%d", i)), []byte(fmt.Sprintf("v%d", i)))
+	}
+
+	left := cluster.GetRegion([]byte("k1"))
+	right := cluster.GetRegion([]byte("k2"))
+	for i := 0; i < 20 && left.GetId() == right.GetId(); i++ {
+		time.Sleep(100 * time.Millisecond)
+		left = cluster.GetRegion([]byte("k1"))
+		right = cluster.GetRegion([]byte("k2"))
+	}
+	assert.NotEqual(t, left.GetId(), right.GetId())
+
+	cluster.MustMergeRegion(right.GetId(), left.GetId())
+
+	merged := cluster.GetRegion([]byte("k2"))
+	assert.Equal(t, left.GetId(), merged.GetId())
+	assert.True(t, bytes.Equal(region.GetStartKey(), merged.GetStartKey()))
+	assert.True(t, bytes.Equal(region.GetEndKey(), merged.GetEndKey()))
+
+	cluster.MustGet([]byte("k2"), []byte("v2"))
+	cluster.MustPut([]byte("k3"), []byte("v3"))
+	for i := 100; i < 200; i++ {
+		cluster.MustGet([]byte(fmt.Sprintf("k%d", i)), []byte(fmt.Sprintf("v%d", i)))
+	}
+	for storeID := uint64(1); storeID <= 3; storeID++ {
+		MustGetEqual(cluster.engines[storeID], []byte("k3"), []byte("v3"))
+	}
+}
+
+func TestLoadSplit3B(t *testing.T) {
+	cfg := config.NewTestConfig()
+	cfg.RegionSplitQPSThreshold = 50
+	cluster := NewTestCluster(3, cfg)
+	cluster.Start()
+	defer cluster.Shutdown()
+
+	for i := 0; i < 32; i++ {
+		cluster.MustPut([]byte(fmt.Sprintf("k%02d", i)), []byte(fmt.Sprintf("v%02d", i)))
+	}
+	region := cluster.GetRegion([]byte("k00"))
+
+	// the region is small, but it's split because of the heavy load
+	start := time.Now()
+	for cluster.GetRegion([]byte("k00")).GetId() == cluster.GetRegion([]byte("k31")).GetId() {
+		if time.Since(start) > 10*time.Second {
+			t.Fatalf("region %d is not split by load", region.GetId())
+		}
+		for i := 0; i < 32; i++ {
+			cluster.MustGet([]byte(fmt.Sprintf("k%02d", i)), []byte(fmt.Sprintf("v%02d", i)))
+		}
+	}
+
+	left := cluster.GetRegion([]byte("k00"))
+	right := cluster.GetRegion([]byte("k31"))
+	assert.True(t, bytes.Equal(region.GetStartKey(), left.GetStartKey()))
+	assert.True(t, bytes.Equal(left.GetEndKey(), right.GetStartKey()))
+	assert.True(t, bytes.Equal(right.GetEndKey(), region.GetEndKey()))
+	for i := 0; i < 32; i++ {
+		cluster.MustGet([]byte(fmt.Sprintf("k%02d", i)), []byte(fmt.Sprintf("v%02d", i)))
+	}
+}
+
 func TestSplitRecover3B(t *testing.T) {
 	// Test: restarts, snapshots, conf change, one client (3B) ...
 	GenericTest(t, "3B", 1, false, true, false, -1, false, true)
@@ -702,3 +936,121 @@ func TestSplitConfChangeSnapshotUnreliableRecoverConcurrentPartition3BLab1P4b(t
 	// Test: unreliable net, restarts, partitions, snapshots, conf change, many clients (3B) ...
 	GenericTest(t, "3B", 5, true, true, true, 100, true, true)
 }
+
+// Reads are served by ReadIndex, so they must not append any entry to the raft log.
+func TestReadIndex2B(t *testing.T) {
+	cfg := config.NewTestConfig()
+	testReadWithoutLog(t, cfg)
+}
+
+func TestLeaderLease2B(t *testing.T) {
+	cfg := config.NewTestConfig()
+	cfg.RaftPreVote = true
+	cfg.RaftStoreMaxLeaderLease = cfg.RaftBaseTickInterval * time.Duration(cfg.RaftElectionTimeoutTicks/2)
+	testReadWithoutLog(t, cfg)
+}
+
+func TestOnePartitionLeaderLease2B(t *testing.T) {
+	cfg := config.NewTestConfig()
+	cfg.RaftPreVote = true
+	cfg.RaftStoreMaxLeaderLease = cfg.RaftBaseTickInterval * time.Duration(cfg.RaftElectionTimeoutTicks/2)
+	testOnePartition(t, cfg)
+}
+
+func testReadWithoutLog(t *testing.T, cfg *config.Config) {
+	cluster := NewTestCluster(3, cfg)
+	cluster.Start()
+	defer cluster.Shutdown()
+
+	for i := 0; i < 10; i++ {
+		key := []byte(fmt.Sprintf("k%d", i))
+		value := []byte(fmt.Sprintf("v%d", i))
+		cluster.MustPut(key, value)
+		cluster.MustGet(key, value)
+	}
+
+	region := cluster.GetRegion([]byte(""))
+	leader := cluster.LeaderOfRegion(region.GetId())
+	raftState, err := meta.GetRaftLocalState(cluster.engines[leader.GetStoreId()].Raft, region.GetId())
+	if err != nil {
+		t.Fatal(err)
+	}
+	lastIndex := raftState.GetLastIndex()
+
+	for i := 0; i < 10; i++ {
+		cluster.MustGet([]byte(fmt.Sprintf("k%d", i)), []byte(fmt.Sprintf("v%d", i)))
+	}
+	raftState, err = meta.GetRaftLocalState(cluster.engines[leader.GetStoreId()].Raft, region.GetId())
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, lastIndex, raftState.GetLastIndex())
+	assert.Equal(t, leader.GetStoreId(), cluster.LeaderOfRegion(region.GetId()).GetStoreId())
+}
+
+// A follower serves the replica reads after the leader confirms the read index,
+// and the stale reads from its applied state even if it's isolated.
+func TestReplicaRead2B(t *testing.T) {
+	cfg := config.NewTestConfig()
+	cluster := NewTestCluster(3, cfg)
+	cluster.Start()
+	defer cluster.Shutdown()
+
+	electionTimeout := cfg.RaftBaseTickInterval * time.Duration(cfg.RaftElectionTimeoutTicks)
+	cluster.MustPut([]byte("k1"), []byte("v1"))
+	region := cluster.GetRegion([]byte("k1"))
+	leader := cluster.LeaderOfRegion(region.GetId())
+	var follower *metapb.Peer
+	var others []uint64
+	for _, p := range region.GetPeers() {
+		if p.GetId() == leader.GetId() || follower != nil {
+			others = append(others, p.GetStoreId())
+		} else {
+			follower = p
+		}
+	}
+	read := func(replicaRead, staleRead bool) *raft_cmdpb.RaftCmdResponse {
+		req := NewRequest(region.GetId(), region.GetRegionEpoch(), []*raft_cmdpb.Request{NewGetCfCmd(engine_util.CfDefault, []byte("k1"))})
+		req.Header.Peer = follower
+		req.Header.ReplicaRead = replicaRead
+		req.Header.StaleRead = staleRead
+		resp, _, err := cluster.CallCommand(&req, 3*electionTimeout)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp == nil {
+			t.Fatal("the read is not responded")
+		}
+		return resp
+	}
+
+	assert.NotNil(t, read(false, false).GetHeader().GetError().GetNotLeader())
+	resp := read(true, false)
+	assert.Nil(t, resp.GetHeader().GetError())
+	assert.Equal(t, []byte("v1"), resp.GetResponses()[0].GetGet().GetValue())
+
+	cluster.AddFilter(&PartitionFilter{
+		s1: []uint64{follower.GetStoreId()},
+		s2: others,
+	})
+	cluster.MustPut([]byte("k1"), []byte("v2"))
+	resp = read(false, true)
+	assert.Nil(t, resp.GetHeader().GetError())
+	assert.Equal(t, []byte("v1"), resp.GetResponses()[0].GetGet().GetValue())
+	// The read index can't be confirmed by the leader.
+	assert.NotNil(t, read(true, false).GetHeader().GetError())
+	cluster.ClearFilters()
+
+	// The follower may not know the leader until it receives a heartbeat.
+	for i := 0; ; i++ {
+		resp = read(true, false)
+		if resp.GetHeader().GetError() == nil {
+			break
+		}
+		if i >= 10 {
+			t.Fatalf("replica read error %v", resp.GetHeader().GetError())
+		}
+		time.Sleep(electionTimeout)
+	}
+	assert.Equal(t, []byte("v2"), resp.GetResponses()[0].GetGet().GetValue())
+}
@@ -26,6 +26,12 @@ func NewPeer(storeID, peerID uint64) *metapb.Peer {
 	return peer
 }
 
+func NewLearnerPeer(storeID, peerID uint64) *metapb.Peer {
+	peer := NewPeer(storeID, peerID)
+	peer.IsLearner = true
+	return peer
+}
+
 func NewBaseRequest(regionID uint64, epoch *metapb.RegionEpoch) raft_cmdpb.RaftCmdRequest {
 	req := raft_cmdpb.RaftCmdRequest{}
 	req.Header = &raft_cmdpb.RaftRequestHeader{RegionId: regionID, RegionEpoch: epoch}
@@ -92,6 +98,14 @@ func NewTransferLeaderCmd(peer *metapb.Peer) *raft_cmdpb.AdminRequest {
 	return cmd
 }
 
+func NewChangePeerV2Cmd(changes []*raft_cmdpb.ChangePeerRequest) *raft_cmdpb.AdminRequest {
+	cmd := &raft_cmdpb.AdminRequest{
+		CmdType:      raft_cmdpb.AdminCmdType_ChangePeerV2,
+		ChangePeerV2: &raft_cmdpb.ChangePeerV2Request{Changes: changes},
+	}
+	return cmd
+}
+
 func MustGetCf(engine *engine_util.Engines, cf string, key []byte, value []byte) {
 	for i := 0; i < 300; i++ {
 		val, err := engine_util.GetCF(engine.Kv, cf, key)
@@ -0,0 +1,142 @@
+package transaction
+
+import (
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/kv/transaction/mvcc"
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/stretchr/testify/assert"
+)
+
+func asyncCommitPrewriteRequest(startTs uint64, muts ...*kvrpcpb.Mutation) *kvrpcpb.PrewriteRequest {
+	var req kvrpcpb.PrewriteRequest
+	req.PrimaryLock = muts[0].Key
+	req.StartVersion = startTs
+	req.LockTtl = 1000
+	req.Mutations = muts
+	req.UseAsyncCommit = true
+	for _, m := range muts[1:] {
+		req.Secondaries = append(req.Secondaries, m.Key)
+	}
+	return &req
+}
+
+func (builder *testBuilder) getLock(key []byte) *mvcc.Lock {
+	lock, err := mvcc.ParseLock(builder.mem.Get(engine_util.CfLock, key))
+	assert.Nil(builder.t, err)
+	return lock
+}
+
+// TestPrewriteAsyncCommit tests that the locks of an async commit transaction carry the min commit ts, which is
+// greater than the max read ts, and the primary lock records the secondary keys.
+func TestPrewriteAsyncCommit(t *testing.T) {
+	builder := newBuilder(t)
+	builder.runOneRequest(&kvrpcpb.ScanRequest{StartKey: []byte{3}, Limit: 1, Version: 120})
+
+	cmd := asyncCommitPrewriteRequest(100, mutation(3, []byte{42}, kvrpcpb.Op_Put), mutation(5, nil, kvrpcpb.Op_Del))
+	resp := builder.runOneRequest(cmd).(*kvrpcpb.PrewriteResponse)
+
+	assert.Empty(t, resp.Errors)
+	assert.Nil(t, resp.RegionError)
+	assert.Equal(t, uint64(121), resp.MinCommitTs)
+	builder.assertLens(1, 2, 0)
+	primary := builder.getLock([]byte{3})
+	assert.True(t, primary.UseAsyncCommit)
+	assert.Equal(t, uint64(121), primary.MinCommitTs)
+	assert.Equal(t, [][]byte{{5}}, primary.Secondaries)
+	secondary := builder.getLock([]byte{5})
+	assert.True(t, secondary.UseAsyncCommit)
+	assert.Equal(t, uint64(121), secondary.MinCommitTs)
+	assert.Empty(t, secondary.Secondaries)
+
+	// The transaction can't be committed before the min commit ts.
+	commit := kvrpcpb.CommitRequest{StartVersion: 100, CommitVersion: 110, Keys: [][]byte{{3}}}
+	commitResp := builder.runOneRequest(&commit).(*kvrpcpb.CommitResponse)
+	assert.NotEmpty(t, commitResp.Error.Abort)
+	builder.assertLens(1, 2, 0)
+}
+
+// TestPrewriteAsyncCommitConflict tests that no key is prewritten if any key fails.
+func TestPrewriteAsyncCommitConflict(t *testing.T) {
+	builder := newBuilder(t)
+	builder.init([]kv{
+		{cf: engine_util.CfDefault, key: []byte{5}, ts: 101, value: []byte{42}},
+		{cf: engine_util.CfWrite, key: []byte{5}, ts: 105, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 101}},
+	})
+	cmd := asyncCommitPrewriteRequest(100, mutation(3, []byte{43}, kvrpcpb.Op_Put), mutation(5, []byte{44}, kvrpcpb.Op_Put))
+	resp := builder.runOneRequest(cmd).(*kvrpcpb.PrewriteResponse)
+
+	assert.Len(t, resp.Errors, 1)
+	assert.Equal(t, uint64(101), resp.Errors[0].Conflict.ConflictTs)
+	assert.Zero(t, resp.MinCommitTs)
+	builder.assertLens(1, 0, 1)
+}
+
+// TestPrewriteOnePC tests that a 1PC prewrite commits the keys without locks.
+func TestPrewriteOnePC(t *testing.T) {
+	builder := newBuilder(t)
+	cmd := kvrpcpb.PrewriteRequest{
+		PrimaryLock:  []byte{3},
+		StartVersion: 100,
+		Mutations:    []*kvrpcpb.Mutation{mutation(3, []byte{42}, kvrpcpb.Op_Put), mutation(5, nil, kvrpcpb.Op_Del)},
+		TryOnePc:     true,
+	}
+	resp := builder.runOneRequest(&cmd).(*kvrpcpb.PrewriteResponse)
+
+	assert.Empty(t, resp.Errors)
+	assert.Equal(t, uint64(101), resp.OnePcCommitTs)
+	builder.assertLens(1, 0, 2)
+	builder.assert([]kv{
+		{cf: engine_util.CfDefault, key: []byte{3}, ts: 100, value: []byte{42}},
+		{cf: engine_util.CfWrite, key: []byte{3}, ts: 101, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 100}},
+		{cf: engine_util.CfWrite, key: []byte{5}, ts: 101, value: []byte{2, 0, 0, 0, 0, 0, 0, 0, 100}},
+	})
+}
+
+// TestCheckTxnStatusAsyncCommit tests that the expired primary lock of an async commit transaction is not rolled back.
+func TestCheckTxnStatusAsyncCommit(t *testing.T) {
+	builder := newBuilder(t)
+	cmd := asyncCommitPrewriteRequest(100, mutation(3, []byte{42}, kvrpcpb.Op_Put), mutation(5, []byte{43}, kvrpcpb.Op_Put))
+	builder.runOneRequest(cmd)
+
+	check := kvrpcpb.CheckTxnStatusRequest{PrimaryKey: []byte{3}, LockTs: 100, CurrentTs: 100 + (10000 << 18)}
+	resp := builder.runOneRequest(&check).(*kvrpcpb.CheckTxnStatusResponse)
+
+	assert.Equal(t, kvrpcpb.Action_NoAction, resp.Action)
+	assert.True(t, resp.LockInfo.UseAsyncCommit)
+	assert.Equal(t, uint64(101), resp.LockInfo.MinCommitTs)
+	assert.Equal(t, [][]byte{{5}}, resp.LockInfo.Secondaries)
+	builder.assertLens(2, 2, 0)
+}
+
+// TestCheckSecondaryLocks tests that all the secondary locks are returned if they are prewritten, otherwise the
+// missing lock is rolled back.
+func TestCheckSecondaryLocks(t *testing.T) {
+	builder := newBuilder(t)
+	cmd := asyncCommitPrewriteRequest(100, mutation(3, []byte{42}, kvrpcpb.Op_Put), mutation(5, []byte{43}, kvrpcpb.Op_Put))
+	builder.runOneRequest(cmd)
+
+	check := kvrpcpb.CheckSecondaryLocksRequest{StartVersion: 100, Keys: [][]byte{{5}}}
+	resp := builder.runOneRequest(&check).(*kvrpcpb.CheckSecondaryLocksResponse)
+	assert.Nil(t, resp.Error)
+	assert.Len(t, resp.Locks, 1)
+	assert.Equal(t, uint64(101), resp.Locks[0].MinCommitTs)
+	assert.Zero(t, resp.CommitTs)
+	builder.assertLens(2, 2, 0)
+
+	// Key 7 isn't prewritten, so the transaction isn't committed and key 7 is rolled back.
+	check = kvrpcpb.CheckSecondaryLocksRequest{StartVersion: 100, Keys: [][]byte{{5}, {7}}}
+	resp = builder.runOneRequest(&check).(*kvrpcpb.CheckSecondaryLocksResponse)
+	assert.Empty(t, resp.Locks)
+	assert.Zero(t, resp.CommitTs)
+	builder.assertLens(2, 2, 1)
+	builder.assert([]kv{
+		{cf: engine_util.CfWrite, key: []byte{7}, ts: 100, value: []byte{3, 0, 0, 0, 0, 0, 0, 0, 100}},
+	})
+
+	// A stale prewrite of key 7 fails.
+	cmd = asyncCommitPrewriteRequest(100, mutation(7, []byte{44}, kvrpcpb.Op_Put))
+	prewriteResp := builder.runOneRequest(cmd).(*kvrpcpb.PrewriteResponse)
+	assert.Len(t, prewriteResp.Errors, 1)
+}
@@ -0,0 +1,96 @@
+package transaction
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestBackupRestore tests that the values visible at the backup version are backed up, and restored as the values
+// committed at the version.
+func TestBackupRestore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "backup")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	builder := newBuilder(t)
+	builder.init([]kv{
+		{cf: engine_util.CfDefault, key: []byte{3}, ts: 100, value: []byte{42}},
+		{cf: engine_util.CfWrite, key: []byte{3}, ts: 110, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 100}},
+		// Deleted before the backup.
+		{cf: engine_util.CfDefault, key: []byte{5}, ts: 100, value: []byte{43}},
+		{cf: engine_util.CfWrite, key: []byte{5}, ts: 110, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 100}},
+		{cf: engine_util.CfWrite, key: []byte{5}, ts: 120, value: []byte{2, 0, 0, 0, 0, 0, 0, 0, 115}},
+		// Committed after the backup.
+		{cf: engine_util.CfDefault, key: []byte{7}, ts: 100, value: []byte{44}},
+		{cf: engine_util.CfWrite, key: []byte{7}, ts: 150, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 100}},
+		// Out of the range.
+		{cf: engine_util.CfDefault, key: []byte{9}, ts: 100, value: []byte{45}},
+		{cf: engine_util.CfWrite, key: []byte{9}, ts: 110, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 100}},
+	})
+	resp, err := builder.server.Backup(context.Background(), &kvrpcpb.BackupRequest{
+		Context:  &kvrpcpb.Context{RegionId: 1},
+		StartKey: []byte{1},
+		EndKey:   []byte{9},
+		Version:  140,
+		Path:     dir,
+	})
+	require.Nil(t, err)
+	assert.Nil(t, resp.RegionError)
+	assert.Nil(t, resp.Error)
+	assert.Equal(t, uint64(1), resp.KvCount)
+	_, err = os.Stat(filepath.Join(dir, resp.FileName))
+	assert.Nil(t, err)
+
+	restored := newBuilder(t)
+	restoreResp, err := restored.server.Restore(context.Background(), &kvrpcpb.RestoreRequest{
+		Context:  &kvrpcpb.Context{RegionId: 1},
+		StartKey: []byte{1},
+		EndKey:   []byte{9},
+		Version:  140,
+		Path:     dir,
+		FileName: resp.FileName,
+	})
+	require.Nil(t, err)
+	assert.Nil(t, restoreResp.RegionError)
+	assert.Empty(t, restoreResp.Error)
+	assert.Equal(t, uint64(1), restoreResp.KvCount)
+	restored.assertLens(1, 0, 1)
+	restored.assert([]kv{
+		{cf: engine_util.CfDefault, key: []byte{3}, ts: 140, value: []byte{42}},
+		{cf: engine_util.CfWrite, key: []byte{3}, ts: 140, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 140}},
+	})
+}
+
+// TestBackupLocked tests that a backup fails if a key is locked by a transaction started before the version.
+func TestBackupLocked(t *testing.T) {
+	dir, err := ioutil.TempDir("", "backup")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	builder := newBuilder(t)
+	builder.init([]kv{
+		{cf: engine_util.CfDefault, key: []byte{3}, ts: 100, value: []byte{42}},
+		{cf: engine_util.CfWrite, key: []byte{3}, ts: 110, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 100}},
+		{cf: engine_util.CfLock, key: []byte{3}, value: []byte{99, 1, 0, 0, 0, 0, 0, 0, 0, 120, 0, 0, 0, 0, 0, 0, 0, 0}},
+	})
+	resp, err := builder.server.Backup(context.Background(), &kvrpcpb.BackupRequest{
+		Context: &kvrpcpb.Context{RegionId: 1},
+		Version: 140,
+		Path:    dir,
+	})
+	require.Nil(t, err)
+	require.NotNil(t, resp.Error)
+	assert.NotNil(t, resp.Error.Locked)
+	assert.Empty(t, resp.FileName)
+	files, err := ioutil.ReadDir(dir)
+	require.Nil(t, err)
+	assert.Empty(t, files)
+}
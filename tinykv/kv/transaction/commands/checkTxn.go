@@ -31,6 +31,14 @@ func (c *CheckTxnStatus) PrepareWrites(txn *mvcc.MvccTxn) (interface{}, error) {
 	if err != nil {
 		return nil, err
 	}
+	if lock != nil && lock.Ts == txn.StartTS && lock.UseAsyncCommit {
+		// The primary lock of an async commit transaction can't be rolled back alone, the transaction may have been
+		// committed once all its keys are prewritten. The caller decides the status by checking the secondary locks.
+		response.Action = kvrpcpb.Action_NoAction
+		response.LockTtl = lock.Ttl
+		response.LockInfo = lock.Info(key)
+		return response, nil
+	}
 	panic("CheckTxnStatus is not implemented yet")
 	if lock != nil && lock.Ts == txn.StartTS {
 		if physical(lock.Ts)+lock.Ttl < physical(c.request.CurrentTs) {
@@ -0,0 +1,67 @@
+package commands
+
+import (
+	"github.com/pingcap-incubator/tinykv/kv/transaction/mvcc"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+)
+
+// CheckSecondaryLocks checks the secondary keys of an async commit transaction whose primary lock has expired. The
+// transaction is committed if all its keys are prewritten, so the locks are returned if all of them are found.
+// Otherwise the transaction can't be committed any more, a missing lock is rolled back, so that a stale prewrite on the
+// key fails, and the locks are not returned.
+type CheckSecondaryLocks struct {
+	CommandBase
+	request *kvrpcpb.CheckSecondaryLocksRequest
+}
+
+func NewCheckSecondaryLocks(request *kvrpcpb.CheckSecondaryLocksRequest) CheckSecondaryLocks {
+	return CheckSecondaryLocks{
+		CommandBase: CommandBase{
+			context: request.Context,
+			startTs: request.StartVersion,
+		},
+		request: request,
+	}
+}
+
+func (c *CheckSecondaryLocks) PrepareWrites(txn *mvcc.MvccTxn) (interface{}, error) {
+	response := new(kvrpcpb.CheckSecondaryLocksResponse)
+
+	for _, key := range c.request.Keys {
+		lock, err := txn.GetLock(key)
+		if err != nil {
+			return nil, err
+		}
+		if lock != nil && lock.Ts == txn.StartTS {
+			if lock.Kind != mvcc.WriteKindPessimisticLock {
+				response.Locks = append(response.Locks, lock.Info(key))
+				continue
+			}
+			// The key is locked but not prewritten yet.
+			txn.DeleteLock(key)
+			txn.PutWrite(key, txn.StartTS, &mvcc.Write{StartTS: txn.StartTS, Kind: mvcc.WriteKindRollback})
+			response.Locks = nil
+			return response, nil
+		}
+
+		write, commitTs, err := txn.CurrentWrite(key)
+		if err != nil {
+			return nil, err
+		}
+		response.Locks = nil
+		if write == nil {
+			// The key isn't prewritten yet, roll it back.
+			txn.PutWrite(key, txn.StartTS, &mvcc.Write{StartTS: txn.StartTS, Kind: mvcc.WriteKindRollback})
+		} else if write.Kind != mvcc.WriteKindRollback {
+			// The key has been committed, so has the transaction.
+			response.CommitTs = commitTs
+		}
+		return response, nil
+	}
+
+	return response, nil
+}
+
+func (c *CheckSecondaryLocks) WillWrite() [][]byte {
+	return c.request.Keys
+}
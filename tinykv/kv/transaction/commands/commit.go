@@ -28,6 +28,15 @@ func NewCommit(request *kvrpcpb.CommitRequest) Commit {
 
 func (c *Commit) PrepareWrites(txn *mvcc.MvccTxn) (interface{}, error) {
 	commitTs := c.request.CommitVersion
+	for _, k := range c.request.Keys {
+		lock, err := txn.GetLock(k)
+		if err != nil {
+			return nil, err
+		}
+		if keyError := checkCommitTs(k, lock, txn.StartTS, commitTs); keyError != nil {
+			return &kvrpcpb.CommitResponse{Error: keyError}, nil
+		}
+	}
 	// YOUR CODE HERE (lab2).
 	// Check if the commitTs is invalid, the commitTs must be greater than the transaction startTs. If not
 	// report unexpected error.
@@ -78,6 +87,17 @@ func commitKey(key []byte, commitTs uint64, txn *mvcc.MvccTxn, response interfac
 	return nil, nil
 }
 
+// checkCommitTs checks if the lock on key can be committed at commitTs. An async commit transaction may have been
+// regarded as committed at the min commit ts of its locks, it must not be committed before that.
+func checkCommitTs(key []byte, lock *mvcc.Lock, startTs uint64, commitTs uint64) *kvrpcpb.KeyError {
+	if lock == nil || lock.Ts != startTs || !lock.UseAsyncCommit || commitTs >= lock.MinCommitTs {
+		return nil
+	}
+	return &kvrpcpb.KeyError{
+		Abort: fmt.Sprintf("commit ts %d is less than the min commit ts %d of the lock on key %v", commitTs, lock.MinCommitTs, key),
+	}
+}
+
 func (c *Commit) WillWrite() [][]byte {
 	return c.request.Keys
 }
@@ -0,0 +1,49 @@
+package commands
+
+import (
+	"github.com/pingcap-incubator/tinykv/kv/transaction/mvcc"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+)
+
+// Gc deletes the versions of the keys in a range which are invisible to any transaction started after the safe point.
+// The start timestamp of the command is the safe point.
+type Gc struct {
+	CommandBase
+	request *kvrpcpb.GcRequest
+	keys    [][]byte
+}
+
+func NewGc(request *kvrpcpb.GcRequest) Gc {
+	return Gc{
+		CommandBase: CommandBase{
+			context: request.Context,
+			startTs: request.SafePoint,
+		},
+		request: request,
+	}
+}
+
+func (gc *Gc) WillWrite() [][]byte {
+	return nil
+}
+
+func (gc *Gc) Read(txn *mvcc.RoTxn) (interface{}, [][]byte, error) {
+	keys, err := mvcc.KeysToGC(txn, gc.request.StartKey, gc.request.EndKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(keys) == 0 {
+		return new(kvrpcpb.GcResponse), nil, nil
+	}
+	gc.keys = keys
+	return nil, keys, nil
+}
+
+func (gc *Gc) PrepareWrites(txn *mvcc.MvccTxn) (interface{}, error) {
+	for _, key := range gc.keys {
+		if err := txn.GC(key); err != nil {
+			return nil, err
+		}
+	}
+	return new(kvrpcpb.GcResponse), nil
+}
@@ -0,0 +1,111 @@
+package commands
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/pingcap-incubator/tinykv/kv/transaction/mvcc"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+)
+
+// PessimisticLock locks the keys of a pessimistic transaction when its statements execute. The keys are locked all or
+// nothing, if any key can't be locked then no lock is written.
+type PessimisticLock struct {
+	CommandBase
+	request *kvrpcpb.PessimisticLockRequest
+}
+
+func NewPessimisticLock(request *kvrpcpb.PessimisticLockRequest) PessimisticLock {
+	return PessimisticLock{
+		CommandBase: CommandBase{
+			context: request.Context,
+			startTs: request.StartVersion,
+		},
+		request: request,
+	}
+}
+
+func (pl *PessimisticLock) PrepareWrites(txn *mvcc.MvccTxn) (interface{}, error) {
+	response := new(kvrpcpb.PessimisticLockResponse)
+
+	var keys [][]byte
+	for _, m := range pl.request.Mutations {
+		locked, keyError, err := pl.checkKey(txn, m.Key)
+		if err != nil {
+			return nil, err
+		}
+		if keyError != nil {
+			response.Errors = append(response.Errors, keyError)
+		} else if !locked {
+			keys = append(keys, m.Key)
+		}
+	}
+	if len(response.Errors) > 0 {
+		return response, nil
+	}
+
+	for _, key := range keys {
+		txn.PutLock(key, &mvcc.Lock{
+			Primary: pl.request.PrimaryLock,
+			Ts:      txn.StartTS,
+			Ttl:     pl.request.LockTtl,
+			Kind:    mvcc.WriteKindPessimisticLock,
+		})
+	}
+	return response, nil
+}
+
+// checkKey checks if key can be locked by the transaction. It returns true if the key is already locked by the
+// transaction, and a key error if the key is locked by another transaction or written after the for_update_ts.
+func (pl *PessimisticLock) checkKey(txn *mvcc.MvccTxn, key []byte) (bool, *kvrpcpb.KeyError, error) {
+	log.Debug("pessimistic lock key", zap.Uint64("start_ts", txn.StartTS),
+		zap.Uint64("for_update_ts", pl.request.ForUpdateTs),
+		zap.String("key", hex.EncodeToString(key)))
+	lock, err := txn.GetLock(key)
+	if err != nil {
+		return false, nil, err
+	}
+	if lock != nil {
+		if lock.Ts != txn.StartTS {
+			return false, &kvrpcpb.KeyError{Locked: lock.Info(key)}, nil
+		}
+		// The key is locked by the transaction already, by a pessimistic lock or prewrite.
+		return true, nil, nil
+	}
+
+	write, commitTs, err := txn.MostRecentWrite(key)
+	if err != nil {
+		return false, nil, err
+	}
+	if write != nil && commitTs > pl.request.ForUpdateTs {
+		return false, &kvrpcpb.KeyError{Conflict: &kvrpcpb.WriteConflict{
+			StartTs:    txn.StartTS,
+			ConflictTs: write.StartTS,
+			Key:        key,
+			Primary:    pl.request.PrimaryLock,
+		}}, nil
+	}
+
+	// The transaction may have been rolled back by another transaction resolving its locks, the key must not be locked
+	// again then.
+	write, _, err = txn.CurrentWrite(key)
+	if err != nil {
+		return false, nil, err
+	}
+	if write != nil {
+		return false, &kvrpcpb.KeyError{
+			Abort: fmt.Sprintf("transaction %d has been committed or rolled back on key %v", txn.StartTS, key),
+		}, nil
+	}
+	return false, nil, nil
+}
+
+func (pl *PessimisticLock) WillWrite() [][]byte {
+	result := [][]byte{}
+	for _, m := range pl.request.Mutations {
+		result = append(result, m.Key)
+	}
+	return result
+}
@@ -0,0 +1,41 @@
+package commands
+
+import (
+	"github.com/pingcap-incubator/tinykv/kv/transaction/mvcc"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+)
+
+// PessimisticRollback unlocks the keys which are locked by a pessimistic transaction but not prewritten, e.g. a
+// statement fails after locking some keys, or the transaction is rolled back.
+type PessimisticRollback struct {
+	CommandBase
+	request *kvrpcpb.PessimisticRollbackRequest
+}
+
+func NewPessimisticRollback(request *kvrpcpb.PessimisticRollbackRequest) PessimisticRollback {
+	return PessimisticRollback{
+		CommandBase: CommandBase{
+			context: request.Context,
+			startTs: request.StartVersion,
+		},
+		request: request,
+	}
+}
+
+func (pr *PessimisticRollback) PrepareWrites(txn *mvcc.MvccTxn) (interface{}, error) {
+	for _, key := range pr.request.Keys {
+		lock, err := txn.GetLock(key)
+		if err != nil {
+			return nil, err
+		}
+		// The prewritten keys are committed or rolled back with the transaction.
+		if lock != nil && lock.Ts == txn.StartTS && lock.Kind == mvcc.WriteKindPessimisticLock {
+			txn.DeleteLock(key)
+		}
+	}
+	return new(kvrpcpb.PessimisticRollbackResponse), nil
+}
+
+func (pr *PessimisticRollback) WillWrite() [][]byte {
+	return pr.request.Keys
+}
@@ -1,7 +1,9 @@
 package commands
 
 import (
+	"bytes"
 	"encoding/hex"
+	"fmt"
 
 	"github.com/pingcap-incubator/tinykv/kv/transaction/mvcc"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
@@ -44,11 +46,20 @@ func NewPrewrite(request *kvrpcpb.PrewriteRequest) Prewrite {
 //		apply worker -> apply the correspond requests to storage(the state machine) -> callback
 //		callback -> signal the response action -> response to kv client
 func (p *Prewrite) PrepareWrites(txn *mvcc.MvccTxn) (interface{}, error) {
+	if p.request.UseAsyncCommit || p.request.TryOnePc {
+		return p.prewriteAsyncCommit(txn)
+	}
 	response := new(kvrpcpb.PrewriteResponse)
 
 	// Prewrite all mutations in the request.
-	for _, m := range p.request.Mutations {
-		keyError, err := p.prewriteMutation(txn, m)
+	for i, m := range p.request.Mutations {
+		var keyError *kvrpcpb.KeyError
+		var err error
+		if len(p.request.IsPessimisticLock) > 0 {
+			keyError, err = p.prewritePessimisticMutation(txn, m, p.request.IsPessimisticLock[i])
+		} else {
+			keyError, err = p.prewriteMutation(txn, m)
+		}
 		if keyError != nil {
 			response.Errors = append(response.Errors, keyError)
 		} else if err != nil {
@@ -85,6 +96,157 @@ func (p *Prewrite) prewriteMutation(txn *mvcc.MvccTxn, mut *kvrpcpb.Mutation) (*
 	return nil, nil
 }
 
+// prewritePessimisticMutation prewrites mut of a pessimistic transaction to txn, it returns like prewriteMutation.
+// If isPessimisticLock is true, the key must have been locked by the transaction, and the pessimistic lock is
+// replaced. Otherwise the key is checked for write conflicts with the for_update_ts of the request.
+func (p *Prewrite) prewritePessimisticMutation(txn *mvcc.MvccTxn, mut *kvrpcpb.Mutation, isPessimisticLock bool) (*kvrpcpb.KeyError, error) {
+	log.Debug("prewrite pessimistic key", zap.Uint64("start_ts", txn.StartTS),
+		zap.Bool("is_pessimistic_lock", isPessimisticLock),
+		zap.String("key", hex.EncodeToString(mut.Key)))
+	lock, keyError, err := p.checkMutation(txn, mut, isPessimisticLock)
+	if keyError != nil || err != nil {
+		return keyError, err
+	}
+	if lock != nil && lock.Kind != mvcc.WriteKindPessimisticLock {
+		// The key has been prewritten, the request is stale.
+		return nil, nil
+	}
+	p.writeLock(txn, mut, 0)
+	return nil, nil
+}
+
+// prewriteAsyncCommit prewrites all mutations of an async commit or 1PC request, or none of them if any key fails.
+//
+// The locks of an async commit transaction carry a min commit ts, and the primary lock records the secondary keys,
+// so the transaction is committed once all the keys are prewritten, at the max min commit ts of the locks, which is
+// returned in the response. A 1PC request holds all the keys of the transaction, they are committed directly.
+func (p *Prewrite) prewriteAsyncCommit(txn *mvcc.MvccTxn) (interface{}, error) {
+	response := new(kvrpcpb.PrewriteResponse)
+	minCommitTs := p.minCommitTs()
+
+	locks := make([]*mvcc.Lock, len(p.request.Mutations))
+	for i, m := range p.request.Mutations {
+		isPessimisticLock := len(p.request.IsPessimisticLock) > 0 && p.request.IsPessimisticLock[i]
+		lock, keyError, err := p.checkMutation(txn, m, isPessimisticLock)
+		if err != nil {
+			return nil, err
+		}
+		if keyError != nil {
+			response.Errors = append(response.Errors, keyError)
+			continue
+		}
+		locks[i] = lock
+		// A stale request may find the lock it has written, the commit ts must not be less than its min commit ts.
+		if lock != nil && lock.MinCommitTs > minCommitTs {
+			minCommitTs = lock.MinCommitTs
+		}
+	}
+	if len(response.Errors) > 0 {
+		return response, nil
+	}
+
+	if p.request.TryOnePc {
+		for i, m := range p.request.Mutations {
+			kind := mvcc.WriteKindFromProto(m.Op)
+			if kind == mvcc.WriteKindPut {
+				txn.PutValue(m.Key, m.Value)
+			}
+			txn.PutWrite(m.Key, minCommitTs, &mvcc.Write{StartTS: txn.StartTS, Kind: kind})
+			if locks[i] != nil {
+				txn.DeleteLock(m.Key)
+			}
+		}
+		response.OnePcCommitTs = minCommitTs
+		return response, nil
+	}
+
+	for i, m := range p.request.Mutations {
+		if locks[i] != nil && locks[i].Kind != mvcc.WriteKindPessimisticLock {
+			continue
+		}
+		p.writeLock(txn, m, minCommitTs)
+	}
+	response.MinCommitTs = minCommitTs
+	return response, nil
+}
+
+// checkMutation checks if mut can be prewritten by the transaction. It returns the lock of the transaction on the key
+// if there is one, a key error if the key is locked by another transaction or written after the transaction started,
+// or an internal error. The keys of a pessimistic transaction are checked like prewritePessimisticMutation.
+func (p *Prewrite) checkMutation(txn *mvcc.MvccTxn, mut *kvrpcpb.Mutation, isPessimisticLock bool) (*mvcc.Lock, *kvrpcpb.KeyError, error) {
+	key := mut.Key
+	lock, err := txn.GetLock(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	if isPessimisticLock {
+		if lock == nil || lock.Ts != txn.StartTS {
+			return nil, &kvrpcpb.KeyError{Abort: fmt.Sprintf("pessimistic lock not found for key %v", key)}, nil
+		}
+		return lock, nil, nil
+	}
+	if lock != nil {
+		if lock.Ts != txn.StartTS {
+			return nil, &kvrpcpb.KeyError{Locked: lock.Info(key)}, nil
+		}
+		return lock, nil, nil
+	}
+
+	write, commitTs, err := txn.MostRecentWrite(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	conflict := commitTs >= txn.StartTS
+	if len(p.request.IsPessimisticLock) > 0 {
+		conflict = commitTs > p.request.ForUpdateTs
+	}
+	if write != nil && conflict {
+		return nil, &kvrpcpb.KeyError{Conflict: &kvrpcpb.WriteConflict{
+			StartTs:    txn.StartTS,
+			ConflictTs: write.StartTS,
+			Key:        key,
+			Primary:    p.request.PrimaryLock,
+		}}, nil
+	}
+	return nil, nil, nil
+}
+
+// writeLock writes the value and the lock of mut. The lock of an async commit request carries minCommitTs, and the
+// primary lock records the secondary keys as well.
+func (p *Prewrite) writeLock(txn *mvcc.MvccTxn, mut *kvrpcpb.Mutation, minCommitTs uint64) {
+	kind := mvcc.WriteKindFromProto(mut.Op)
+	if kind == mvcc.WriteKindPut {
+		txn.PutValue(mut.Key, mut.Value)
+	}
+	lock := &mvcc.Lock{
+		Primary: p.request.PrimaryLock,
+		Ts:      txn.StartTS,
+		Ttl:     p.request.LockTtl,
+		Kind:    kind,
+	}
+	if p.request.UseAsyncCommit {
+		lock.UseAsyncCommit = true
+		lock.MinCommitTs = minCommitTs
+		if bytes.Equal(mut.Key, p.request.PrimaryLock) {
+			lock.Secondaries = p.request.Secondaries
+		}
+	}
+	txn.PutLock(mut.Key, lock)
+}
+
+// minCommitTs returns the least commit ts of the transaction, it's greater than the start ts and for_update_ts, and
+// not less than the min_commit_ts of the request, which is set by the server after the max read ts.
+func (p *Prewrite) minCommitTs() uint64 {
+	minCommitTs := p.request.MinCommitTs
+	if minCommitTs <= p.request.StartVersion {
+		minCommitTs = p.request.StartVersion + 1
+	}
+	if minCommitTs <= p.request.ForUpdateTs {
+		minCommitTs = p.request.ForUpdateTs + 1
+	}
+	return minCommitTs
+}
+
 func (p *Prewrite) WillWrite() [][]byte {
 	result := [][]byte{}
 	for _, m := range p.request.Mutations {
@@ -34,6 +34,14 @@ func (rl *ResolveLock) PrepareWrites(txn *mvcc.MvccTxn) (interface{}, error) {
 		zap.Uint64("lockTS", txn.StartTS),
 		zap.Int("number", len(rl.keyLocks)),
 		zap.Uint64("commit_ts", commitTs))
+	if commitTs > 0 {
+		for _, kl := range rl.keyLocks {
+			if keyError := checkCommitTs(kl.Key, kl.Lock, txn.StartTS, commitTs); keyError != nil {
+				response.Error = keyError
+				return response, nil
+			}
+		}
+	}
 	panic("ResolveLock is not implemented yet")
 	for _, kl := range rl.keyLocks {
 		// YOUR CODE HERE (lab2).
@@ -0,0 +1,37 @@
+package commands
+
+import (
+	"github.com/pingcap-incubator/tinykv/kv/transaction/mvcc"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+)
+
+// ScanLock returns the locks in a key range which are left by the transactions started before the max version, so
+// that they can be resolved before the versions are collected by GC.
+type ScanLock struct {
+	ReadOnly
+	CommandBase
+	request *kvrpcpb.ScanLockRequest
+}
+
+func NewScanLock(request *kvrpcpb.ScanLockRequest) ScanLock {
+	return ScanLock{
+		CommandBase: CommandBase{
+			context: request.Context,
+			startTs: request.MaxVersion,
+		},
+		request: request,
+	}
+}
+
+func (sl *ScanLock) Read(txn *mvcc.RoTxn) (interface{}, [][]byte, error) {
+	response := new(kvrpcpb.ScanLockResponse)
+
+	keyLocks, err := mvcc.LocksInRange(txn, sl.request.StartKey, sl.request.EndKey, sl.request.MaxVersion, int(sl.request.Limit))
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, kl := range keyLocks {
+		response.Locks = append(response.Locks, kl.Lock.Info(kl.Key))
+	}
+	return response, nil, nil
+}
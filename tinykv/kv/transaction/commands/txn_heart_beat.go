@@ -0,0 +1,52 @@
+package commands
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/pingcap-incubator/tinykv/kv/transaction/mvcc"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+)
+
+// TxnHeartBeat extends the TTL of the primary lock of a transaction which is still running, so that other
+// transactions don't roll it back as expired. The TTL is never decreased.
+type TxnHeartBeat struct {
+	CommandBase
+	request *kvrpcpb.TxnHeartBeatRequest
+}
+
+func NewTxnHeartBeat(request *kvrpcpb.TxnHeartBeatRequest) TxnHeartBeat {
+	return TxnHeartBeat{
+		CommandBase: CommandBase{
+			context: request.Context,
+			startTs: request.StartVersion,
+		},
+		request: request,
+	}
+}
+
+func (h *TxnHeartBeat) PrepareWrites(txn *mvcc.MvccTxn) (interface{}, error) {
+	key := h.request.PrimaryLock
+	response := new(kvrpcpb.TxnHeartBeatResponse)
+
+	lock, err := txn.GetLock(key)
+	if err != nil {
+		return nil, err
+	}
+	if lock == nil || lock.Ts != txn.StartTS || !bytes.Equal(lock.Primary, key) {
+		// The transaction has been committed or rolled back, or the key isn't its primary key.
+		response.Error = &kvrpcpb.KeyError{Abort: fmt.Sprintf("txn %d has no primary lock on key %v", txn.StartTS, key)}
+		return response, nil
+	}
+
+	if h.request.AdviseLockTtl > lock.Ttl {
+		lock.Ttl = h.request.AdviseLockTtl
+		txn.PutLock(key, lock)
+	}
+	response.LockTtl = lock.Ttl
+	return response, nil
+}
+
+func (h *TxnHeartBeat) WillWrite() [][]byte {
+	return [][]byte{h.request.PrimaryLock}
+}
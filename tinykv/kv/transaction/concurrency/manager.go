@@ -0,0 +1,53 @@
+package concurrency
+
+import (
+	"math"
+	"sync"
+	"sync/atomic"
+)
+
+// The concurrency manager keeps the max timestamp of the reads on the store, it's used to calculate the commit
+// timestamp of async commit and 1PC transactions.
+//
+// The commit timestamp of such a transaction is decided when the keys are prewritten, rather than fetched from the
+// placement driver after that. It must be greater than the timestamp of any read which has missed the locks,
+// otherwise the read would see the data committed before it partially, so the min commit timestamp of the locks is
+// larger than the max read timestamp.
+//
+// A prewrite holds the manager from reading the max timestamp until the locks are written, a read with a larger
+// timestamp waits for it before reading, so the read either sees the locks or updates the max timestamp first.
+
+// Manager is safe for concurrent use.
+type Manager struct {
+	mu    sync.RWMutex
+	maxTs uint64
+}
+
+// NewManager creates a manager with max timestamp 0.
+func NewManager() *Manager {
+	return &Manager{}
+}
+
+// UpdateMaxTs is called before reading at ts. It waits for the prewrites which have read a smaller max timestamp.
+// A read at math.MaxUint64 reads the latest data rather than a snapshot, it's ignored.
+func (m *Manager) UpdateMaxTs(ts uint64) {
+	if ts == math.MaxUint64 || ts <= atomic.LoadUint64(&m.maxTs) {
+		return
+	}
+	m.mu.Lock()
+	if ts > m.maxTs {
+		atomic.StoreUint64(&m.maxTs, ts)
+	}
+	m.mu.Unlock()
+}
+
+// ReadMaxTs returns the max timestamp, the reads with larger timestamps wait until release is called.
+func (m *Manager) ReadMaxTs() (maxTs uint64, release func()) {
+	m.mu.RLock()
+	return atomic.LoadUint64(&m.maxTs), m.mu.RUnlock
+}
+
+// MaxTs returns the max timestamp without waiting for the prewrites, it's not blocked by a pending UpdateMaxTs.
+func (m *Manager) MaxTs() uint64 {
+	return atomic.LoadUint64(&m.maxTs)
+}
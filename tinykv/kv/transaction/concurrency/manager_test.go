@@ -0,0 +1,40 @@
+package concurrency
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateMaxTs(t *testing.T) {
+	m := NewManager()
+	m.UpdateMaxTs(100)
+	m.UpdateMaxTs(50)
+	maxTs, release := m.ReadMaxTs()
+	release()
+	assert.Equal(t, uint64(100), maxTs)
+}
+
+func TestReadWaitsForPrewrite(t *testing.T) {
+	m := NewManager()
+	maxTs, release := m.ReadMaxTs()
+	assert.Equal(t, uint64(0), maxTs)
+
+	done := make(chan struct{})
+	go func() {
+		m.UpdateMaxTs(100)
+		close(done)
+	}()
+	select {
+	case <-done:
+		t.Fatal("the read doesn't wait for the prewrite")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	release()
+	<-done
+	maxTs, release = m.ReadMaxTs()
+	release()
+	assert.Equal(t, uint64(100), maxTs)
+}
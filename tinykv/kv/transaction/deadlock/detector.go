@@ -0,0 +1,142 @@
+package deadlock
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sync"
+	"time"
+)
+
+// The deadlock detector finds deadlocks among pessimistic transactions. A transaction blocked by the pessimistic lock
+// of another transaction retries to lock the key until the lock is released, if two transactions are waiting for the
+// locks of each other then neither of them can make progress.
+//
+// The detector keeps a wait-for graph, an edge from txn to waitForTxn means txn is waiting for a lock held by
+// waitForTxn. Before an edge is added, the detector checks if txn is reachable from waitForTxn, in which case the
+// edge would form a cycle and the wait is reported as a deadlock, so the client can abort the statement instead of
+// waiting.
+//
+// The detector only knows the waits reported to the local store. A deadlock of the transactions waiting on different
+// stores isn't found, it's broken when the TTL of the locks expires.
+
+// DefaultEntryTTL is how long an edge of the wait-for graph is kept if it isn't reported again. The client retries a
+// blocked lock request much more frequently, so an edge expires only if the waiting transaction has given up.
+const DefaultEntryTTL = 3 * time.Second
+
+// ErrDeadlock is returned by Detect if waiting for the lock would form a deadlock.
+type ErrDeadlock struct {
+	// KeyHash is the hash of the key held by the transaction detecting the deadlock, which is waited for by another
+	// transaction in the cycle.
+	KeyHash uint64
+}
+
+func (e *ErrDeadlock) Error() string {
+	return fmt.Sprintf("deadlock(%d)", e.KeyHash)
+}
+
+// Detector is the wait-for graph of the transactions, it is safe for concurrent use.
+type Detector struct {
+	ttl time.Duration
+
+	mu sync.Mutex
+	// waitForMap maps the start timestamp of a transaction to the transactions it's waiting for.
+	waitForMap map[uint64][]*waitForEntry
+}
+
+type waitForEntry struct {
+	txn        uint64
+	keyHash    uint64
+	createTime time.Time
+}
+
+// NewDetector creates a detector whose edges expire after ttl.
+func NewDetector(ttl time.Duration) *Detector {
+	return &Detector{
+		ttl:        ttl,
+		waitForMap: make(map[uint64][]*waitForEntry),
+	}
+}
+
+// KeyHash returns the hash of key used by the detector.
+func KeyHash(key []byte) uint64 {
+	h := fnv.New64a()
+	h.Write(key)
+	return h.Sum64()
+}
+
+// Detect records that txn is waiting for the lock of waitForTxn on the key with keyHash. It returns an error and
+// records nothing if the wait would form a deadlock.
+func (d *Detector) Detect(txn, waitForTxn, keyHash uint64) *ErrDeadlock {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := time.Now()
+	if err := d.doDetect(now, txn, waitForTxn); err != nil {
+		return err
+	}
+	d.register(now, txn, waitForTxn, keyHash)
+	return nil
+}
+
+// doDetect searches the graph from waitForTxn, it returns an error if txn is reachable. The expired edges found on
+// the way are removed.
+func (d *Detector) doDetect(now time.Time, txn, waitForTxn uint64) *ErrDeadlock {
+	visited := map[uint64]struct{}{}
+	stack := []uint64{waitForTxn}
+	for len(stack) > 0 {
+		cur := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if _, ok := visited[cur]; ok {
+			continue
+		}
+		visited[cur] = struct{}{}
+
+		entries := d.removeExpired(now, cur)
+		for _, e := range entries {
+			if e.txn == txn {
+				return &ErrDeadlock{KeyHash: e.keyHash}
+			}
+			stack = append(stack, e.txn)
+		}
+	}
+	return nil
+}
+
+// removeExpired removes the expired edges from txn and returns the rest.
+func (d *Detector) removeExpired(now time.Time, txn uint64) []*waitForEntry {
+	entries := d.waitForMap[txn]
+	alive := make([]*waitForEntry, 0, len(entries))
+	for _, e := range entries {
+		if now.Sub(e.createTime) < d.ttl {
+			alive = append(alive, e)
+		}
+	}
+	if len(alive) == 0 {
+		delete(d.waitForMap, txn)
+		return nil
+	}
+	d.waitForMap[txn] = alive
+	return alive
+}
+
+func (d *Detector) register(now time.Time, txn, waitForTxn, keyHash uint64) {
+	for _, e := range d.waitForMap[txn] {
+		if e.txn == waitForTxn && e.keyHash == keyHash {
+			e.createTime = now
+			return
+		}
+	}
+	d.waitForMap[txn] = append(d.waitForMap[txn], &waitForEntry{
+		txn:        waitForTxn,
+		keyHash:    keyHash,
+		createTime: now,
+	})
+}
+
+// CleanUp removes the edges from txn, it's called when txn is no longer waiting for any lock, e.g. it has acquired
+// the locks, or it's committed or rolled back.
+func (d *Detector) CleanUp(txn uint64) {
+	d.mu.Lock()
+	delete(d.waitForMap, txn)
+	d.mu.Unlock()
+}
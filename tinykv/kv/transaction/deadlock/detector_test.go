@@ -0,0 +1,57 @@
+package deadlock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetect(t *testing.T) {
+	d := NewDetector(DefaultEntryTTL)
+
+	// 1 -> 2 -> 3, no cycle.
+	assert.Nil(t, d.Detect(1, 2, 100))
+	assert.Nil(t, d.Detect(2, 3, 200))
+	// Reporting the same wait again is ok.
+	assert.Nil(t, d.Detect(1, 2, 100))
+
+	// 3 -> 1 forms a cycle, the key 200 held by 3 is waited for by 2.
+	err := d.Detect(3, 1, 300)
+	assert.NotNil(t, err)
+	assert.Equal(t, uint64(200), err.KeyHash)
+	// The edge of a deadlock is not recorded.
+	assert.Len(t, d.waitForMap[3], 0)
+
+	// A direct cycle.
+	err = d.Detect(2, 1, 400)
+	assert.NotNil(t, err)
+	assert.Equal(t, uint64(100), err.KeyHash)
+}
+
+func TestCleanUp(t *testing.T) {
+	d := NewDetector(DefaultEntryTTL)
+
+	assert.Nil(t, d.Detect(1, 2, 100))
+	assert.Nil(t, d.Detect(2, 3, 200))
+	// 2 has acquired the lock of 3.
+	d.CleanUp(2)
+	assert.Nil(t, d.Detect(3, 1, 300))
+	assert.NotNil(t, d.Detect(2, 3, 200))
+}
+
+func TestExpire(t *testing.T) {
+	d := NewDetector(50 * time.Millisecond)
+
+	assert.Nil(t, d.Detect(1, 2, 100))
+	time.Sleep(100 * time.Millisecond)
+	// 1 has given up waiting for 2.
+	assert.Nil(t, d.Detect(2, 1, 200))
+	assert.Len(t, d.waitForMap[1], 0)
+	assert.Len(t, d.waitForMap[2], 1)
+}
+
+func TestKeyHash(t *testing.T) {
+	assert.Equal(t, KeyHash([]byte("k1")), KeyHash([]byte("k1")))
+	assert.NotEqual(t, KeyHash([]byte("k1")), KeyHash([]byte("k2")))
+}
@@ -0,0 +1,138 @@
+package transaction
+
+import (
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestGcEmpty tests that GC on an empty DB is a no-op.
+func TestGcEmpty(t *testing.T) {
+	builder := newBuilder(t)
+	cmd := kvrpcpb.GcRequest{SafePoint: 200}
+	resp := builder.runOneRequest(&cmd).(*kvrpcpb.GcResponse)
+
+	assert.Nil(t, resp.Error)
+	assert.Nil(t, resp.RegionError)
+	builder.assertLens(0, 0, 0)
+}
+
+// TestGc tests that GC keeps the version visible at the safe point and the versions after it, and deletes the others.
+func TestGc(t *testing.T) {
+	builder := newBuilder(t)
+	cmd := kvrpcpb.GcRequest{SafePoint: 140}
+	builder.init([]kv{
+		// An old put shadowed by a newer put.
+		{cf: engine_util.CfDefault, key: []byte{3}, ts: 100, value: []byte{42}},
+		{cf: engine_util.CfWrite, key: []byte{3}, ts: 110, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 100}},
+		{cf: engine_util.CfDefault, key: []byte{3}, ts: 120, value: []byte{43}},
+		{cf: engine_util.CfWrite, key: []byte{3}, ts: 130, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 120}},
+		// A put shadowed by a delete.
+		{cf: engine_util.CfDefault, key: []byte{7}, ts: 100, value: []byte{44}},
+		{cf: engine_util.CfWrite, key: []byte{7}, ts: 110, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 100}},
+		{cf: engine_util.CfWrite, key: []byte{7}, ts: 130, value: []byte{2, 0, 0, 0, 0, 0, 0, 0, 120}},
+		// A single put before the safe point and a put after it.
+		{cf: engine_util.CfDefault, key: []byte{9}, ts: 100, value: []byte{45}},
+		{cf: engine_util.CfWrite, key: []byte{9}, ts: 110, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 100}},
+		{cf: engine_util.CfDefault, key: []byte{9}, ts: 145, value: []byte{46}},
+		{cf: engine_util.CfWrite, key: []byte{9}, ts: 150, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 145}},
+		// A rollback doesn't shadow the put before it.
+		{cf: engine_util.CfDefault, key: []byte{11}, ts: 100, value: []byte{47}},
+		{cf: engine_util.CfWrite, key: []byte{11}, ts: 110, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 100}},
+		{cf: engine_util.CfWrite, key: []byte{11}, ts: 130, value: []byte{3, 0, 0, 0, 0, 0, 0, 0, 130}},
+	})
+	resp := builder.runOneRequest(&cmd).(*kvrpcpb.GcResponse)
+
+	assert.Nil(t, resp.Error)
+	assert.Nil(t, resp.RegionError)
+	builder.assertLens(4, 0, 4)
+	builder.assert([]kv{
+		{cf: engine_util.CfDefault, key: []byte{3}, ts: 120},
+		{cf: engine_util.CfWrite, key: []byte{3}, ts: 130},
+		{cf: engine_util.CfDefault, key: []byte{9}, ts: 100},
+		{cf: engine_util.CfWrite, key: []byte{9}, ts: 110},
+		{cf: engine_util.CfDefault, key: []byte{9}, ts: 145},
+		{cf: engine_util.CfWrite, key: []byte{9}, ts: 150},
+		{cf: engine_util.CfDefault, key: []byte{11}, ts: 100},
+		{cf: engine_util.CfWrite, key: []byte{11}, ts: 110},
+	})
+}
+
+// TestGcRange tests that GC only collects the keys in the range of the request.
+func TestGcRange(t *testing.T) {
+	builder := newBuilder(t)
+	cmd := kvrpcpb.GcRequest{SafePoint: 140, StartKey: []byte{5}, EndKey: []byte{9}}
+	builder.init([]kv{
+		{cf: engine_util.CfWrite, key: []byte{3}, ts: 110, value: []byte{3, 0, 0, 0, 0, 0, 0, 0, 110}},
+		{cf: engine_util.CfWrite, key: []byte{7}, ts: 110, value: []byte{3, 0, 0, 0, 0, 0, 0, 0, 110}},
+		{cf: engine_util.CfWrite, key: []byte{9}, ts: 110, value: []byte{3, 0, 0, 0, 0, 0, 0, 0, 110}},
+	})
+	resp := builder.runOneRequest(&cmd).(*kvrpcpb.GcResponse)
+
+	assert.Nil(t, resp.Error)
+	assert.Nil(t, resp.RegionError)
+	builder.assertLens(0, 0, 2)
+	builder.assert([]kv{
+		{cf: engine_util.CfWrite, key: []byte{3}, ts: 110},
+		{cf: engine_util.CfWrite, key: []byte{9}, ts: 110},
+	})
+}
+
+// TestScanLock tests that only the locks in the range started before the max version are returned, up to the limit.
+func TestScanLock(t *testing.T) {
+	builder := newBuilder(t)
+	builder.init([]kv{
+		{cf: engine_util.CfLock, key: []byte{3}, value: []byte{3, 1, 0, 0, 0, 0, 0, 0, 0, 100, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{cf: engine_util.CfLock, key: []byte{5}, value: []byte{5, 1, 0, 0, 0, 0, 0, 0, 0, 150, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{cf: engine_util.CfLock, key: []byte{7}, value: []byte{3, 2, 0, 0, 0, 0, 0, 0, 0, 100, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{cf: engine_util.CfLock, key: []byte{9}, value: []byte{9, 1, 0, 0, 0, 0, 0, 0, 0, 120, 0, 0, 0, 0, 0, 0, 0, 0}},
+	})
+	cmd := kvrpcpb.ScanLockRequest{MaxVersion: 140, StartKey: []byte{3}, EndKey: []byte{9}}
+	resp := builder.runOneRequest(&cmd).(*kvrpcpb.ScanLockResponse)
+
+	assert.Nil(t, resp.Error)
+	assert.Nil(t, resp.RegionError)
+	assert.Len(t, resp.Locks, 2)
+	assert.Equal(t, []byte{3}, resp.Locks[0].Key)
+	assert.Equal(t, []byte{3}, resp.Locks[0].PrimaryLock)
+	assert.Equal(t, uint64(100), resp.Locks[0].LockVersion)
+	assert.Equal(t, []byte{7}, resp.Locks[1].Key)
+	assert.Equal(t, []byte{3}, resp.Locks[1].PrimaryLock)
+	builder.assertLens(0, 4, 0)
+
+	cmd = kvrpcpb.ScanLockRequest{MaxVersion: 140, StartKey: []byte{5}, Limit: 2}
+	resp = builder.runOneRequest(&cmd).(*kvrpcpb.ScanLockResponse)
+	assert.Len(t, resp.Locks, 2)
+	assert.Equal(t, []byte{7}, resp.Locks[0].Key)
+	assert.Equal(t, []byte{9}, resp.Locks[1].Key)
+}
+
+// TestDeleteRange tests that all the locks, values and writes of the keys in the range are deleted.
+func TestDeleteRange(t *testing.T) {
+	builder := newBuilder(t)
+	builder.init([]kv{
+		{cf: engine_util.CfDefault, key: []byte{3}, ts: 100, value: []byte{42}},
+		{cf: engine_util.CfWrite, key: []byte{3}, ts: 110, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 100}},
+		{cf: engine_util.CfLock, key: []byte{5}, value: []byte{5, 1, 0, 0, 0, 0, 0, 0, 0, 120, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{cf: engine_util.CfDefault, key: []byte{5}, ts: 120, value: []byte{43}},
+		{cf: engine_util.CfDefault, key: []byte{9}, ts: 100, value: []byte{44}},
+		{cf: engine_util.CfWrite, key: []byte{9}, ts: 110, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 100}},
+	})
+	cmd := kvrpcpb.DeleteRangeRequest{StartKey: []byte{3}, EndKey: []byte{9}}
+	resp := builder.runOneRequest(&cmd).(*kvrpcpb.DeleteRangeResponse)
+
+	assert.Empty(t, resp.Error)
+	assert.Nil(t, resp.RegionError)
+	builder.assertLens(1, 0, 1)
+	builder.assert([]kv{
+		{cf: engine_util.CfDefault, key: []byte{9}, ts: 100, value: []byte{44}},
+		{cf: engine_util.CfWrite, key: []byte{9}, ts: 110, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 100}},
+	})
+
+	// An empty end key deletes the rest.
+	cmd = kvrpcpb.DeleteRangeRequest{StartKey: []byte{5}}
+	builder.runOneRequest(&cmd)
+	builder.assertLens(0, 0, 0)
+}
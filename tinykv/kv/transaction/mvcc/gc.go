@@ -0,0 +1,97 @@
+package mvcc
+
+import (
+	"bytes"
+
+	"github.com/pingcap-incubator/tinykv/kv/storage"
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+)
+
+// KeysToGC returns the keys in [startKey, endKey) which have versions to be collected by GC with the safe point, which
+// is the start timestamp of txn. An empty endKey means there is no upper bound.
+func KeysToGC(txn *RoTxn, startKey, endKey []byte) ([][]byte, error) {
+	var keys [][]byte
+	iter := txn.Reader.IterCF(engine_util.CfWrite)
+	defer iter.Close()
+
+	var lastKey []byte
+	// The number of the versions of lastKey committed before or at the safe point.
+	versions := 0
+	for iter.Seek(EncodeKey(startKey, TsMax)); iter.Valid(); iter.Next() {
+		item := iter.Item()
+		userKey := DecodeUserKey(item.Key())
+		if len(endKey) > 0 && bytes.Compare(userKey, endKey) >= 0 {
+			break
+		}
+		if !bytes.Equal(userKey, lastKey) {
+			lastKey, versions = userKey, 0
+		}
+		commitTs := DecodeTimestamp(item.Key())
+		if commitTs > txn.StartTS {
+			continue
+		}
+		versions++
+		if versions > 2 {
+			// The key has been collected.
+			continue
+		}
+		value, err := item.Value()
+		if err != nil {
+			return nil, err
+		}
+		write, err := ParseWrite(value)
+		if err != nil {
+			return nil, err
+		}
+		// There is nothing to collect only if the single version before the safe point is a put.
+		if versions == 2 || write.Kind != WriteKindPut {
+			keys = append(keys, userKey)
+			versions = 2
+		}
+	}
+	return keys, nil
+}
+
+// GC deletes the versions of key which are invisible to any transaction started after the safe point, which is the
+// start timestamp of this transaction. The visible version at the safe point is kept if it's a put, so reads after the
+// safe point still find its value. All the other writes before the safe point, and the values of the deleted puts,
+// are deleted.
+func (txn *MvccTxn) GC(key []byte) error {
+	iter := txn.Reader.IterCF(engine_util.CfWrite)
+	defer iter.Close()
+
+	// The visible version is the newest put or delete before the safe point, rollbacks are skipped by reads.
+	visible := false
+	for iter.Seek(EncodeKey(key, txn.StartTS)); iter.Valid(); iter.Next() {
+		item := iter.Item()
+		if !bytes.Equal(DecodeUserKey(item.Key()), key) {
+			break
+		}
+		value, err := item.Value()
+		if err != nil {
+			return err
+		}
+		write, err := ParseWrite(value)
+		if err != nil {
+			return err
+		}
+		switch {
+		case visible:
+		case write.Kind == WriteKindPut:
+			visible = true
+			continue
+		case write.Kind == WriteKindDelete:
+			visible = true
+		}
+		txn.DeleteWrite(key, DecodeTimestamp(item.Key()))
+		if write.Kind == WriteKindPut {
+			txn.writes = append(txn.writes, storage.Modify{
+				Data: storage.Delete{
+					Key: EncodeKey(key, write.StartTS),
+					Cf:  engine_util.CfDefault,
+				},
+			})
+		}
+	}
+	return nil
+}
@@ -17,8 +17,17 @@ type Lock struct {
 	Ts      uint64
 	Ttl     uint64
 	Kind    WriteKind
+	// The fields of an async commit transaction. The commit timestamp is not less than MinCommitTs, the primary lock
+	// records the secondary keys so the status of the transaction can be decided from the locks.
+	UseAsyncCommit bool
+	MinCommitTs    uint64
+	Secondaries    [][]byte
 }
 
+// asyncCommitFlag is set in the kind byte of an async commit lock, whose async commit fields are encoded between the
+// primary key and the kind byte.
+const asyncCommitFlag byte = 0x80
+
 type KlPair struct {
 	Key  []byte
 	Lock *Lock
@@ -31,17 +40,41 @@ func (lock *Lock) Info(key []byte) *kvrpcpb.LockInfo {
 	info.LockVersion = lock.Ts
 	info.PrimaryLock = lock.Primary
 	info.LockTtl = lock.Ttl
+	info.UseAsyncCommit = lock.UseAsyncCommit
+	info.MinCommitTs = lock.MinCommitTs
+	info.Secondaries = lock.Secondaries
 	return &info
 }
 
+// ToBytes encodes the lock as the primary key, the kind, the start ts and the ttl. The lock of an async commit
+// transaction has the async commit fields and their length between the primary key and the kind.
 func (lock *Lock) ToBytes() []byte {
-	buf := append(lock.Primary, byte(lock.Kind))
+	buf := append([]byte{}, lock.Primary...)
+	kind := byte(lock.Kind)
+	if lock.UseAsyncCommit {
+		ext := make([]byte, 8, 8+4*len(lock.Secondaries))
+		binary.BigEndian.PutUint64(ext, lock.MinCommitTs)
+		for _, key := range lock.Secondaries {
+			ext = appendUint32(ext, uint32(len(key)))
+			ext = append(ext, key...)
+		}
+		buf = append(buf, ext...)
+		buf = appendUint32(buf, uint32(len(ext)))
+		kind |= asyncCommitFlag
+	}
+	buf = append(buf, kind)
 	buf = append(buf, make([]byte, 16)...)
-	binary.BigEndian.PutUint64(buf[len(lock.Primary)+1:], lock.Ts)
-	binary.BigEndian.PutUint64(buf[len(lock.Primary)+9:], lock.Ttl)
+	binary.BigEndian.PutUint64(buf[len(buf)-16:], lock.Ts)
+	binary.BigEndian.PutUint64(buf[len(buf)-8:], lock.Ttl)
 	return buf
 }
 
+func appendUint32(buf []byte, v uint32) []byte {
+	var b [4]byte
+	binary.BigEndian.PutUint32(b[:], v)
+	return append(buf, b[:]...)
+}
+
 // ParseLock attempts to parse a byte string into a Lock object.
 func ParseLock(input []byte) (*Lock, error) {
 	if len(input) <= 16 {
@@ -49,12 +82,37 @@ func ParseLock(input []byte) (*Lock, error) {
 	}
 
 	primaryLen := len(input) - 17
-	primary := input[:primaryLen]
-	kind := WriteKind(input[primaryLen])
+	kind := input[primaryLen]
 	ts := binary.BigEndian.Uint64(input[primaryLen+1:])
 	ttl := binary.BigEndian.Uint64(input[primaryLen+9:])
+	lock := &Lock{Ts: ts, Ttl: ttl, Kind: WriteKind(kind &^ asyncCommitFlag)}
 
-	return &Lock{Primary: primary, Ts: ts, Ttl: ttl, Kind: kind}, nil
+	if kind&asyncCommitFlag != 0 {
+		if primaryLen < 4 {
+			return nil, fmt.Errorf("mvcc: error parsing async commit lock, not enough input, found %d bytes", len(input))
+		}
+		extLen := int(binary.BigEndian.Uint32(input[primaryLen-4:]))
+		if extLen < 8 || primaryLen-4 < extLen {
+			return nil, fmt.Errorf("mvcc: error parsing async commit lock, invalid length %d", extLen)
+		}
+		ext := input[primaryLen-4-extLen : primaryLen-4]
+		primaryLen -= 4 + extLen
+		lock.UseAsyncCommit = true
+		lock.MinCommitTs = binary.BigEndian.Uint64(ext)
+		for ext = ext[8:]; len(ext) > 0; {
+			if len(ext) < 4 {
+				return nil, fmt.Errorf("mvcc: error parsing async commit lock, invalid secondary key")
+			}
+			keyLen := int(binary.BigEndian.Uint32(ext))
+			if len(ext)-4 < keyLen {
+				return nil, fmt.Errorf("mvcc: error parsing async commit lock, invalid secondary key")
+			}
+			lock.Secondaries = append(lock.Secondaries, ext[4:4+keyLen])
+			ext = ext[4+keyLen:]
+		}
+	}
+	lock.Primary = input[:primaryLen]
+	return lock, nil
 }
 
 // IsLockedFor checks if lock locks key at txnStartTs.
@@ -62,6 +120,10 @@ func (lock *Lock) IsLockedFor(key []byte, txnStartTs uint64, resp interface{}) b
 	if lock == nil {
 		return false
 	}
+	// A pessimistic lock has no value to read, the reads see the versions before it.
+	if lock.Kind == WriteKindPessimisticLock {
+		return false
+	}
 	// If the point get read is from a single statement auto commit transaction, the version
 	// will be set to `TsMax` which may save one round trip to fetch version from the the
 	// placement driver tso service.
@@ -102,3 +164,34 @@ func AllLocksForTxn(txn *RoTxn) ([]KlPair, error) {
 	}
 	return result, nil
 }
+
+// LocksInRange returns the locks in [startKey, endKey) whose start timestamps are not greater than maxTs, at most
+// limit locks are returned. An empty endKey means there is no upper bound, and a limit of 0 means there is no limit.
+func LocksInRange(txn *RoTxn, startKey, endKey []byte, maxTs uint64, limit int) ([]KlPair, error) {
+	var result []KlPair
+	iter := txn.Reader.IterCF(engine_util.CfLock)
+	defer iter.Close()
+
+	for iter.Seek(startKey); iter.Valid(); iter.Next() {
+		item := iter.Item()
+		if len(endKey) > 0 && bytes.Compare(item.Key(), endKey) >= 0 {
+			break
+		}
+		val, err := item.Value()
+		if err != nil {
+			return nil, err
+		}
+		lock, err := ParseLock(val)
+		if err != nil {
+			return nil, err
+		}
+		if lock.Ts > maxTs {
+			continue
+		}
+		result = append(result, KlPair{item.KeyCopy(nil), lock})
+		if limit > 0 && len(result) >= limit {
+			break
+		}
+	}
+	return result, nil
+}
@@ -38,7 +38,7 @@ func (scan *Scanner) Next() ([]byte, []byte, error) {
 
 		item := scan.writeIter.Item()
 		userKey := DecodeUserKey(item.Key())
-		commitTs := decodeTimestamp(item.Key())
+		commitTs := DecodeTimestamp(item.Key())
 
 		if commitTs >= scan.txn.StartTS {
 			// The key was not committed before our transaction started, find an earlier key.
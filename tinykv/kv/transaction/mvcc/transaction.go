@@ -81,8 +81,8 @@ func DecodeUserKey(key []byte) []byte {
 	return userKey
 }
 
-// decodeTimestamp takes a key + timestamp and returns the timestamp part.
-func decodeTimestamp(key []byte) uint64 {
+// DecodeTimestamp takes a key + timestamp and returns the timestamp part.
+func DecodeTimestamp(key []byte) uint64 {
 	left, _, err := codec.DecodeBytes(key)
 	if err != nil {
 		panic(err)
@@ -132,7 +132,7 @@ func (txn *RoTxn) mostRecentWriteBefore(key []byte, ts uint64) (*Write, uint64,
 		return nil, 0, nil
 	}
 	item := iter.Item()
-	commitTs := decodeTimestamp(item.Key())
+	commitTs := DecodeTimestamp(item.Key())
 	if bytes.Compare(DecodeUserKey(item.Key()), key) != 0 {
 		return nil, 0, nil
 	}
@@ -217,6 +217,16 @@ func (txn *MvccTxn) PutWrite(key []byte, ts uint64, write *Write) {
 	})
 }
 
+// DeleteWrite removes the write at key and ts.
+func (txn *MvccTxn) DeleteWrite(key []byte, ts uint64) {
+	txn.writes = append(txn.writes, storage.Modify{
+		Data: storage.Delete{
+			Key: EncodeKey(key, ts),
+			Cf:  engine_util.CfWrite,
+		},
+	})
+}
+
 // GetLock returns a lock if key is locked. It will return (nil, nil) if there is no lock on key, and (nil, err)
 // if an error occurs during lookup.
 func (txn *RoTxn) GetLock(key []byte) (*Lock, error) {
@@ -282,3 +292,18 @@ func (txn *MvccTxn) DeleteValue(key []byte) {
 		},
 	})
 }
+
+// DeleteRange returns the modifies which delete all the locks, values and writes of the keys in [startKey, endKey),
+// an empty endKey means there is no upper bound. The values and writes are stored with the encoded keys, so their
+// ranges are encoded.
+func DeleteRange(startKey, endKey []byte) []storage.Modify {
+	encodedStart, encodedEnd := EncodeKey(startKey, TsMax), []byte(nil)
+	if len(endKey) > 0 {
+		encodedEnd = EncodeKey(endKey, TsMax)
+	}
+	return []storage.Modify{
+		{Data: storage.DeleteRange{StartKey: startKey, EndKey: endKey, Cf: engine_util.CfLock}},
+		{Data: storage.DeleteRange{StartKey: encodedStart, EndKey: encodedEnd, Cf: engine_util.CfDefault}},
+		{Data: storage.DeleteRange{StartKey: encodedStart, EndKey: encodedEnd, Cf: engine_util.CfWrite}},
+	}
+}
@@ -294,3 +294,26 @@ func TestMostRecentWrite4A(t *testing.T) {
 	}, *write)
 	assert.Equal(t, uint64(52), ts)
 }
+
+func TestAsyncCommitLock(t *testing.T) {
+	lock := Lock{
+		Primary:        []byte{16},
+		Ts:             100,
+		Ttl:            100000,
+		Kind:           WriteKindPut,
+		UseAsyncCommit: true,
+		MinCommitTs:    101,
+		Secondaries:    [][]byte{{1}, {2, 3}, {}},
+	}
+	txn := testTxn(42, func(m *storage.MemStorage) {
+		m.Set(engine_util.CfLock, []byte{16}, lock.ToBytes())
+	})
+
+	gotLock, err := txn.GetLock([]byte{16})
+	assert.Nil(t, err)
+	assert.Equal(t, lock, *gotLock)
+
+	// The lock without the async commit fields is encoded as before.
+	lock = Lock{Primary: []byte{16}, Ts: 100, Kind: WriteKindDelete}
+	assert.Equal(t, []byte{16, 2, 0, 0, 0, 0, 0, 0, 0, 100, 0, 0, 0, 0, 0, 0, 0, 0}, lock.ToBytes())
+}
@@ -40,6 +40,9 @@ const (
 	WriteKindPut      WriteKind = 1
 	WriteKindDelete   WriteKind = 2
 	WriteKindRollback WriteKind = 3
+	// WriteKindPessimisticLock is only the kind of a lock acquired by a pessimistic transaction before prewrite, it's
+	// never written to the write CF.
+	WriteKindPessimisticLock WriteKind = 4
 )
 
 func (wk WriteKind) ToProto() kvrpcpb.Op {
@@ -50,6 +53,8 @@ func (wk WriteKind) ToProto() kvrpcpb.Op {
 		return kvrpcpb.Op_Del
 	case WriteKindRollback:
 		return kvrpcpb.Op_Rollback
+	case WriteKindPessimisticLock:
+		return kvrpcpb.Op_PessimisticLock
 	}
 
 	return -1
@@ -63,6 +68,8 @@ func WriteKindFromProto(op kvrpcpb.Op) WriteKind {
 		return WriteKindDelete
 	case kvrpcpb.Op_Rollback:
 		return WriteKindRollback
+	case kvrpcpb.Op_PessimisticLock:
+		return WriteKindPessimisticLock
 	default:
 		panic("unsupported type")
 	}
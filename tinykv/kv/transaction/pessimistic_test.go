@@ -0,0 +1,168 @@
+package transaction
+
+import (
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/stretchr/testify/assert"
+)
+
+func pessimisticLockRequest(startTs uint64, forUpdateTs uint64, keys ...byte) *kvrpcpb.PessimisticLockRequest {
+	var req kvrpcpb.PessimisticLockRequest
+	req.PrimaryLock = []byte{keys[0]}
+	req.StartVersion = startTs
+	req.ForUpdateTs = forUpdateTs
+	for _, key := range keys {
+		req.Mutations = append(req.Mutations, mutation(key, nil, kvrpcpb.Op_PessimisticLock))
+	}
+	return &req
+}
+
+// TestPessimisticLock tests that pessimistic locks are written without values and don't block reads.
+func TestPessimisticLock(t *testing.T) {
+	builder := newBuilder(t)
+	cmd := pessimisticLockRequest(100, 100, 3, 5)
+	builder.init([]kv{
+		{cf: engine_util.CfDefault, key: []byte{3}, ts: 50, value: []byte{42}},
+		{cf: engine_util.CfWrite, key: []byte{3}, ts: 60, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 50}},
+	})
+	resp := builder.runOneRequest(cmd).(*kvrpcpb.PessimisticLockResponse)
+
+	assert.Empty(t, resp.Errors)
+	assert.Nil(t, resp.RegionError)
+	builder.assertLens(1, 2, 1)
+	builder.assert([]kv{
+		{cf: engine_util.CfLock, key: []byte{3}, value: []byte{3, 4, 0, 0, 0, 0, 0, 0, 0, 100, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{cf: engine_util.CfLock, key: []byte{5}, value: []byte{3, 4, 0, 0, 0, 0, 0, 0, 0, 100, 0, 0, 0, 0, 0, 0, 0, 0}},
+	})
+
+	// Locking the keys again is a no-op.
+	resp = builder.runOneRequest(pessimisticLockRequest(100, 110, 3)).(*kvrpcpb.PessimisticLockResponse)
+	assert.Empty(t, resp.Errors)
+	builder.assertLens(1, 2, 1)
+}
+
+// TestPessimisticLockConflict tests that the keys locked by other transactions, or written after the for_update_ts,
+// are not locked, and no key is locked if any key fails.
+func TestPessimisticLockConflict(t *testing.T) {
+	builder := newBuilder(t)
+	builder.init([]kv{
+		{cf: engine_util.CfLock, key: []byte{3}, value: []byte{3, 4, 0, 0, 0, 0, 0, 0, 0, 90, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{cf: engine_util.CfDefault, key: []byte{5}, ts: 100, value: []byte{42}},
+		{cf: engine_util.CfWrite, key: []byte{5}, ts: 120, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 100}},
+	})
+	resp := builder.runOneRequest(pessimisticLockRequest(110, 110, 3, 5, 7)).(*kvrpcpb.PessimisticLockResponse)
+
+	assert.Len(t, resp.Errors, 2)
+	assert.Equal(t, uint64(90), resp.Errors[0].Locked.LockVersion)
+	assert.Equal(t, uint64(100), resp.Errors[1].Conflict.ConflictTs)
+	builder.assertLens(1, 1, 1)
+
+	// Retry with a newer for_update_ts.
+	resp = builder.runOneRequest(pessimisticLockRequest(110, 130, 5, 7)).(*kvrpcpb.PessimisticLockResponse)
+	assert.Empty(t, resp.Errors)
+	builder.assertLens(1, 3, 1)
+}
+
+// TestPessimisticLockRolledBack tests that a rolled back transaction can't lock the key again.
+func TestPessimisticLockRolledBack(t *testing.T) {
+	builder := newBuilder(t)
+	builder.init([]kv{
+		{cf: engine_util.CfWrite, key: []byte{3}, ts: 100, value: []byte{3, 0, 0, 0, 0, 0, 0, 0, 100}},
+	})
+	resp := builder.runOneRequest(pessimisticLockRequest(100, 110, 3)).(*kvrpcpb.PessimisticLockResponse)
+
+	assert.Len(t, resp.Errors, 1)
+	assert.NotEmpty(t, resp.Errors[0].Abort)
+	builder.assertLens(0, 0, 1)
+}
+
+// TestPessimisticRollback tests that only the pessimistic locks of the transaction are removed.
+func TestPessimisticRollback(t *testing.T) {
+	builder := newBuilder(t)
+	builder.init([]kv{
+		{cf: engine_util.CfLock, key: []byte{3}, value: []byte{3, 4, 0, 0, 0, 0, 0, 0, 0, 100, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{cf: engine_util.CfDefault, key: []byte{5}, ts: 100, value: []byte{42}},
+		{cf: engine_util.CfLock, key: []byte{5}, value: []byte{3, 1, 0, 0, 0, 0, 0, 0, 0, 100, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{cf: engine_util.CfLock, key: []byte{7}, value: []byte{7, 4, 0, 0, 0, 0, 0, 0, 0, 90, 0, 0, 0, 0, 0, 0, 0, 0}},
+	})
+	cmd := kvrpcpb.PessimisticRollbackRequest{StartVersion: 100, Keys: [][]byte{{3}, {5}, {7}, {9}}}
+	resp := builder.runOneRequest(&cmd).(*kvrpcpb.PessimisticRollbackResponse)
+
+	assert.Empty(t, resp.Errors)
+	assert.Nil(t, resp.RegionError)
+	builder.assertLens(1, 2, 0)
+	builder.assert([]kv{
+		{cf: engine_util.CfLock, key: []byte{5}},
+		{cf: engine_util.CfLock, key: []byte{7}},
+	})
+}
+
+// TestPrewritePessimistic tests that prewrite replaces the pessimistic locks, and checks the other keys for write
+// conflicts with the for_update_ts.
+func TestPrewritePessimistic(t *testing.T) {
+	builder := newBuilder(t)
+	builder.init([]kv{
+		{cf: engine_util.CfLock, key: []byte{3}, value: []byte{3, 4, 0, 0, 0, 0, 0, 0, 0, 100, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{cf: engine_util.CfDefault, key: []byte{5}, ts: 101, value: []byte{42}},
+		{cf: engine_util.CfWrite, key: []byte{5}, ts: 105, value: []byte{1, 0, 0, 0, 0, 0, 0, 0, 101}},
+	})
+	cmd := kvrpcpb.PrewriteRequest{
+		PrimaryLock:       []byte{3},
+		StartVersion:      100,
+		ForUpdateTs:       110,
+		Mutations:         []*kvrpcpb.Mutation{mutation(3, []byte{43}, kvrpcpb.Op_Put), mutation(5, nil, kvrpcpb.Op_Del)},
+		IsPessimisticLock: []bool{true, false},
+	}
+	resp := builder.runOneRequest(&cmd).(*kvrpcpb.PrewriteResponse)
+
+	assert.Empty(t, resp.Errors)
+	assert.Nil(t, resp.RegionError)
+	builder.assertLens(2, 2, 1)
+	builder.assert([]kv{
+		{cf: engine_util.CfDefault, key: []byte{3}, ts: 100, value: []byte{43}},
+		{cf: engine_util.CfLock, key: []byte{3}, value: []byte{3, 1, 0, 0, 0, 0, 0, 0, 0, 100, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{cf: engine_util.CfLock, key: []byte{5}, value: []byte{3, 2, 0, 0, 0, 0, 0, 0, 0, 100, 0, 0, 0, 0, 0, 0, 0, 0}},
+	})
+
+	// The pessimistic lock of key 7 is missing.
+	cmd = kvrpcpb.PrewriteRequest{
+		PrimaryLock:       []byte{3},
+		StartVersion:      100,
+		ForUpdateTs:       110,
+		Mutations:         []*kvrpcpb.Mutation{mutation(7, []byte{44}, kvrpcpb.Op_Put)},
+		IsPessimisticLock: []bool{true},
+	}
+	resp = builder.runOneRequest(&cmd).(*kvrpcpb.PrewriteResponse)
+	assert.Len(t, resp.Errors, 1)
+	assert.NotEmpty(t, resp.Errors[0].Abort)
+	builder.assertLens(2, 2, 1)
+}
+
+// TestPessimisticLockDeadlock tests that a transaction waiting for another transaction which is waiting for it gets
+// a deadlock error.
+func TestPessimisticLockDeadlock(t *testing.T) {
+	builder := newBuilder(t)
+	resps := builder.runRequests(pessimisticLockRequest(100, 100, 3), pessimisticLockRequest(110, 110, 5))
+	assert.Empty(t, resps[0].(*kvrpcpb.PessimisticLockResponse).Errors)
+	assert.Empty(t, resps[1].(*kvrpcpb.PessimisticLockResponse).Errors)
+
+	// 100 waits for 110.
+	resp := builder.runOneRequest(pessimisticLockRequest(100, 120, 5)).(*kvrpcpb.PessimisticLockResponse)
+	assert.Len(t, resp.Errors, 1)
+	assert.NotNil(t, resp.Errors[0].Locked)
+
+	// 110 waits for 100, which is a deadlock.
+	resp = builder.runOneRequest(pessimisticLockRequest(110, 130, 3)).(*kvrpcpb.PessimisticLockResponse)
+	assert.Len(t, resp.Errors, 1)
+	assert.Nil(t, resp.Errors[0].Locked)
+	assert.Equal(t, uint64(100), resp.Errors[0].Deadlock.LockTs)
+	assert.Equal(t, []byte{3}, resp.Errors[0].Deadlock.LockKey)
+
+	// 100 gives up the lock, then 110 can lock the key.
+	builder.runOneRequest(&kvrpcpb.PessimisticRollbackRequest{StartVersion: 100, Keys: [][]byte{{3}}})
+	resp = builder.runOneRequest(pessimisticLockRequest(110, 130, 3)).(*kvrpcpb.PessimisticLockResponse)
+	assert.Empty(t, resp.Errors)
+	builder.assertLens(0, 2, 0)
+}
@@ -0,0 +1,48 @@
+package transaction
+
+import (
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/kv/util/engine_util"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestTxnHeartBeat tests that the TTL of the primary lock is extended but never decreased.
+func TestTxnHeartBeat(t *testing.T) {
+	builder := newBuilder(t)
+	builder.init([]kv{
+		{cf: engine_util.CfDefault, key: []byte{3}, ts: 100, value: []byte{42}},
+		{cf: engine_util.CfLock, key: []byte{3}, value: []byte{3, 1, 0, 0, 0, 0, 0, 0, 0, 100, 0, 0, 0, 0, 0, 0, 0, 10}},
+	})
+	cmd := kvrpcpb.TxnHeartBeatRequest{PrimaryLock: []byte{3}, StartVersion: 100, AdviseLockTtl: 20}
+	resp := builder.runOneRequest(&cmd).(*kvrpcpb.TxnHeartBeatResponse)
+
+	assert.Nil(t, resp.Error)
+	assert.Nil(t, resp.RegionError)
+	assert.Equal(t, uint64(20), resp.LockTtl)
+	builder.assertLens(1, 1, 0)
+	builder.assert([]kv{
+		{cf: engine_util.CfLock, key: []byte{3}, value: []byte{3, 1, 0, 0, 0, 0, 0, 0, 0, 100, 0, 0, 0, 0, 0, 0, 0, 20}},
+	})
+
+	cmd.AdviseLockTtl = 15
+	resp = builder.runOneRequest(&cmd).(*kvrpcpb.TxnHeartBeatResponse)
+	assert.Nil(t, resp.Error)
+	assert.Equal(t, uint64(20), resp.LockTtl)
+}
+
+// TestTxnHeartBeatNoLock tests that the heartbeat fails if the transaction has no primary lock on the key.
+func TestTxnHeartBeatNoLock(t *testing.T) {
+	builder := newBuilder(t)
+	builder.init([]kv{
+		{cf: engine_util.CfLock, key: []byte{5}, value: []byte{3, 1, 0, 0, 0, 0, 0, 0, 0, 100, 0, 0, 0, 0, 0, 0, 0, 10}},
+	})
+	resp := builder.runOneRequest(&kvrpcpb.TxnHeartBeatRequest{PrimaryLock: []byte{3}, StartVersion: 100, AdviseLockTtl: 20}).(*kvrpcpb.TxnHeartBeatResponse)
+	assert.NotEmpty(t, resp.Error.Abort)
+
+	// Key 5 is not the primary key.
+	resp = builder.runOneRequest(&kvrpcpb.TxnHeartBeatRequest{PrimaryLock: []byte{5}, StartVersion: 100, AdviseLockTtl: 20}).(*kvrpcpb.TxnHeartBeatResponse)
+	assert.NotEmpty(t, resp.Error.Abort)
+	builder.assertLens(0, 1, 0)
+}
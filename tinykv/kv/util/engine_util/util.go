@@ -84,13 +84,15 @@ func DeleteRange(db *badger.DB, startKey, endKey []byte) error {
 	txn := db.NewTransaction(false)
 	defer txn.Discard()
 	for _, cf := range CFs {
-		deleteRangeCF(txn, batch, cf, startKey, endKey)
+		DeleteRangeCF(txn, batch, cf, startKey, endKey)
 	}
 
 	return batch.WriteToDB(db)
 }
 
-func deleteRangeCF(txn *badger.Txn, batch *WriteBatch, cf string, startKey, endKey []byte) {
+// DeleteRangeCF adds the deletes of the keys in [startKey, endKey) of the column family read by txn to batch, an empty
+// endKey means there is no upper bound.
+func DeleteRangeCF(txn *badger.Txn, batch *WriteBatch, cf string, startKey, endKey []byte) {
 	it := NewCFIterator(cf, txn)
 	for it.Seek(startKey); it.Valid(); it.Next() {
 		item := it.Item()
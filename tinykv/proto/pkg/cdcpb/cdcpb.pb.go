@@ -0,0 +1,1421 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cdcpb.proto
+
+package cdcpb
+
+import (
+	"fmt"
+	"io"
+	"math"
+
+	proto "github.com/golang/protobuf/proto"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+
+	errorpb "github.com/pingcap-incubator/tinykv/proto/pkg/errorpb"
+
+	kvrpcpb "github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type Event_Row_Type int32
+
+const (
+	Event_Row_UNKNOWN Event_Row_Type = 0
+	// The key is prewritten, the row carries the value.
+	Event_Row_PREWRITE Event_Row_Type = 1
+	// The prewritten key is committed at commit_ts, the value is in the prewrite row of start_ts.
+	Event_Row_COMMIT Event_Row_Type = 2
+	// The prewritten key is rolled back.
+	Event_Row_ROLLBACK Event_Row_Type = 3
+	// The key is committed without a prewrite, e.g. by one-phase commit, the row carries the value.
+	Event_Row_COMMITTED Event_Row_Type = 4
+	// The locks of the region when it's subscribed are all sent.
+	Event_Row_INITIALIZED Event_Row_Type = 5
+)
+
+var Event_Row_Type_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "PREWRITE",
+	2: "COMMIT",
+	3: "ROLLBACK",
+	4: "COMMITTED",
+	5: "INITIALIZED",
+}
+var Event_Row_Type_value = map[string]int32{
+	"UNKNOWN":     0,
+	"PREWRITE":    1,
+	"COMMIT":      2,
+	"ROLLBACK":    3,
+	"COMMITTED":   4,
+	"INITIALIZED": 5,
+}
+
+func (x Event_Row_Type) String() string {
+	return proto.EnumName(Event_Row_Type_name, int32(x))
+}
+func (Event_Row_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_cdcpb_06e77f0f7f4d5fa2, []int{1, 0, 0}
+}
+
+type Event_Row_OpType int32
+
+const (
+	Event_Row_UNKNOWN_OP Event_Row_OpType = 0
+	Event_Row_PUT        Event_Row_OpType = 1
+	Event_Row_DELETE     Event_Row_OpType = 2
+)
+
+var Event_Row_OpType_name = map[int32]string{
+	0: "UNKNOWN_OP",
+	1: "PUT",
+	2: "DELETE",
+}
+var Event_Row_OpType_value = map[string]int32{
+	"UNKNOWN_OP": 0,
+	"PUT":        1,
+	"DELETE":     2,
+}
+
+func (x Event_Row_OpType) String() string {
+	return proto.EnumName(Event_Row_OpType_name, int32(x))
+}
+func (Event_Row_OpType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_cdcpb_06e77f0f7f4d5fa2, []int{1, 0, 1}
+}
+
+// Subscribe the changes of a region. The request is sent to the leader of the region, the locks in the region are
+// sent as prewrite rows first, then the changes applied after the subscription are sent in the order they are
+// applied.
+type ChangeDataRequest struct {
+	Context *kvrpcpb.Context `protobuf:"bytes,1,opt,name=context" json:"context,omitempty"`
+	// The range of the region.
+	StartKey []byte `protobuf:"bytes,2,opt,name=start_key,json=startKey,proto3" json:"start_key,omitempty"`
+	EndKey   []byte `protobuf:"bytes,3,opt,name=end_key,json=endKey,proto3" json:"end_key,omitempty"`
+	// The commits at or before checkpoint_ts are not sent.
+	CheckpointTs         uint64   `protobuf:"varint,4,opt,name=checkpoint_ts,json=checkpointTs,proto3" json:"checkpoint_ts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChangeDataRequest) Reset()         { *m = ChangeDataRequest{} }
+func (m *ChangeDataRequest) String() string { return proto.CompactTextString(m) }
+func (*ChangeDataRequest) ProtoMessage()    {}
+func (*ChangeDataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cdcpb_06e77f0f7f4d5fa2, []int{0}
+}
+func (m *ChangeDataRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChangeDataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChangeDataRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ChangeDataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangeDataRequest.Merge(dst, src)
+}
+func (m *ChangeDataRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChangeDataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangeDataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChangeDataRequest proto.InternalMessageInfo
+
+func (m *ChangeDataRequest) GetContext() *kvrpcpb.Context {
+	if m != nil {
+		return m.Context
+	}
+	return nil
+}
+
+func (m *ChangeDataRequest) GetStartKey() []byte {
+	if m != nil {
+		return m.StartKey
+	}
+	return nil
+}
+
+func (m *ChangeDataRequest) GetEndKey() []byte {
+	if m != nil {
+		return m.EndKey
+	}
+	return nil
+}
+
+func (m *ChangeDataRequest) GetCheckpointTs() uint64 {
+	if m != nil {
+		return m.CheckpointTs
+	}
+	return 0
+}
+
+type Event struct {
+	RegionId uint64 `protobuf:"varint,1,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"`
+	// Only one of rows, error and resolved_ts is set. After an error, no event of the region is sent any more.
+	Rows  []*Event_Row   `protobuf:"bytes,2,rep,name=rows" json:"rows,omitempty"`
+	Error *errorpb.Error `protobuf:"bytes,3,opt,name=error" json:"error,omitempty"`
+	// All the commits at or before resolved_ts have been sent.
+	ResolvedTs           uint64   `protobuf:"varint,4,opt,name=resolved_ts,json=resolvedTs,proto3" json:"resolved_ts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Event) Reset()         { *m = Event{} }
+func (m *Event) String() string { return proto.CompactTextString(m) }
+func (*Event) ProtoMessage()    {}
+func (*Event) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cdcpb_06e77f0f7f4d5fa2, []int{1}
+}
+func (m *Event) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Event.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *Event) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Event.Merge(dst, src)
+}
+func (m *Event) XXX_Size() int {
+	return m.Size()
+}
+func (m *Event) XXX_DiscardUnknown() {
+	xxx_messageInfo_Event.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Event proto.InternalMessageInfo
+
+func (m *Event) GetRegionId() uint64 {
+	if m != nil {
+		return m.RegionId
+	}
+	return 0
+}
+
+func (m *Event) GetRows() []*Event_Row {
+	if m != nil {
+		return m.Rows
+	}
+	return nil
+}
+
+func (m *Event) GetError() *errorpb.Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *Event) GetResolvedTs() uint64 {
+	if m != nil {
+		return m.ResolvedTs
+	}
+	return 0
+}
+
+type Event_Row struct {
+	Type                 Event_Row_Type   `protobuf:"varint,1,opt,name=type,proto3,enum=cdcpb.Event_Row_Type" json:"type,omitempty"`
+	OpType               Event_Row_OpType `protobuf:"varint,2,opt,name=op_type,json=opType,proto3,enum=cdcpb.Event_Row_OpType" json:"op_type,omitempty"`
+	Key                  []byte           `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	Value                []byte           `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	StartTs              uint64           `protobuf:"varint,5,opt,name=start_ts,json=startTs,proto3" json:"start_ts,omitempty"`
+	CommitTs             uint64           `protobuf:"varint,6,opt,name=commit_ts,json=commitTs,proto3" json:"commit_ts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *Event_Row) Reset()         { *m = Event_Row{} }
+func (m *Event_Row) String() string { return proto.CompactTextString(m) }
+func (*Event_Row) ProtoMessage()    {}
+func (*Event_Row) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cdcpb_06e77f0f7f4d5fa2, []int{1, 0}
+}
+func (m *Event_Row) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Event_Row) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Event_Row.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *Event_Row) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Event_Row.Merge(dst, src)
+}
+func (m *Event_Row) XXX_Size() int {
+	return m.Size()
+}
+func (m *Event_Row) XXX_DiscardUnknown() {
+	xxx_messageInfo_Event_Row.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Event_Row proto.InternalMessageInfo
+
+func (m *Event_Row) GetType() Event_Row_Type {
+	if m != nil {
+		return m.Type
+	}
+	return Event_Row_UNKNOWN
+}
+
+func (m *Event_Row) GetOpType() Event_Row_OpType {
+	if m != nil {
+		return m.OpType
+	}
+	return Event_Row_UNKNOWN_OP
+}
+
+func (m *Event_Row) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *Event_Row) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *Event_Row) GetStartTs() uint64 {
+	if m != nil {
+		return m.StartTs
+	}
+	return 0
+}
+
+func (m *Event_Row) GetCommitTs() uint64 {
+	if m != nil {
+		return m.CommitTs
+	}
+	return 0
+}
+
+type ChangeDataEvent struct {
+	Events               []*Event `protobuf:"bytes,1,rep,name=events" json:"events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChangeDataEvent) Reset()         { *m = ChangeDataEvent{} }
+func (m *ChangeDataEvent) String() string { return proto.CompactTextString(m) }
+func (*ChangeDataEvent) ProtoMessage()    {}
+func (*ChangeDataEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cdcpb_06e77f0f7f4d5fa2, []int{2}
+}
+func (m *ChangeDataEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChangeDataEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChangeDataEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ChangeDataEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangeDataEvent.Merge(dst, src)
+}
+func (m *ChangeDataEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChangeDataEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangeDataEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChangeDataEvent proto.InternalMessageInfo
+
+func (m *ChangeDataEvent) GetEvents() []*Event {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ChangeDataRequest)(nil), "cdcpb.ChangeDataRequest")
+	proto.RegisterType((*Event)(nil), "cdcpb.Event")
+	proto.RegisterType((*Event_Row)(nil), "cdcpb.Event.Row")
+	proto.RegisterType((*ChangeDataEvent)(nil), "cdcpb.ChangeDataEvent")
+	proto.RegisterEnum("cdcpb.Event_Row_Type", Event_Row_Type_name, Event_Row_Type_value)
+	proto.RegisterEnum("cdcpb.Event_Row_OpType", Event_Row_OpType_name, Event_Row_OpType_value)
+}
+func (m *ChangeDataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChangeDataRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Context != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCdcpb(dAtA, i, uint64(m.Context.Size()))
+		n1, err := m.Context.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if len(m.StartKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCdcpb(dAtA, i, uint64(len(m.StartKey)))
+		i += copy(dAtA[i:], m.StartKey)
+	}
+	if len(m.EndKey) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCdcpb(dAtA, i, uint64(len(m.EndKey)))
+		i += copy(dAtA[i:], m.EndKey)
+	}
+	if m.CheckpointTs != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCdcpb(dAtA, i, uint64(m.CheckpointTs))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Event) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Event) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.RegionId != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintCdcpb(dAtA, i, uint64(m.RegionId))
+	}
+	if len(m.Rows) > 0 {
+		for _, msg := range m.Rows {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintCdcpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Error != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCdcpb(dAtA, i, uint64(m.Error.Size()))
+		n2, err := m.Error.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if m.ResolvedTs != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCdcpb(dAtA, i, uint64(m.ResolvedTs))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Event_Row) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Event_Row) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Type != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintCdcpb(dAtA, i, uint64(m.Type))
+	}
+	if m.OpType != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCdcpb(dAtA, i, uint64(m.OpType))
+	}
+	if len(m.Key) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCdcpb(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if len(m.Value) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCdcpb(dAtA, i, uint64(len(m.Value)))
+		i += copy(dAtA[i:], m.Value)
+	}
+	if m.StartTs != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintCdcpb(dAtA, i, uint64(m.StartTs))
+	}
+	if m.CommitTs != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintCdcpb(dAtA, i, uint64(m.CommitTs))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ChangeDataEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChangeDataEvent) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for _, msg := range m.Events {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintCdcpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func encodeVarintCdcpb(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *ChangeDataRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Context != nil {
+		l = m.Context.Size()
+		n += 1 + l + sovCdcpb(uint64(l))
+	}
+	l = len(m.StartKey)
+	if l > 0 {
+		n += 1 + l + sovCdcpb(uint64(l))
+	}
+	l = len(m.EndKey)
+	if l > 0 {
+		n += 1 + l + sovCdcpb(uint64(l))
+	}
+	if m.CheckpointTs != 0 {
+		n += 1 + sovCdcpb(uint64(m.CheckpointTs))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Event) Size() (n int) {
+	var l int
+	_ = l
+	if m.RegionId != 0 {
+		n += 1 + sovCdcpb(uint64(m.RegionId))
+	}
+	if len(m.Rows) > 0 {
+		for _, e := range m.Rows {
+			l = e.Size()
+			n += 1 + l + sovCdcpb(uint64(l))
+		}
+	}
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovCdcpb(uint64(l))
+	}
+	if m.ResolvedTs != 0 {
+		n += 1 + sovCdcpb(uint64(m.ResolvedTs))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Event_Row) Size() (n int) {
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovCdcpb(uint64(m.Type))
+	}
+	if m.OpType != 0 {
+		n += 1 + sovCdcpb(uint64(m.OpType))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovCdcpb(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovCdcpb(uint64(l))
+	}
+	if m.StartTs != 0 {
+		n += 1 + sovCdcpb(uint64(m.StartTs))
+	}
+	if m.CommitTs != 0 {
+		n += 1 + sovCdcpb(uint64(m.CommitTs))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChangeDataEvent) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovCdcpb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovCdcpb(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozCdcpb(x uint64) (n int) {
+	return sovCdcpb(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ChangeDataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCdcpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChangeDataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChangeDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCdcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Context == nil {
+				m.Context = &kvrpcpb.Context{}
+			}
+			if err := m.Context.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCdcpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartKey = append(m.StartKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.StartKey == nil {
+				m.StartKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCdcpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndKey = append(m.EndKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.EndKey == nil {
+				m.EndKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointTs", wireType)
+			}
+			m.CheckpointTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CheckpointTs |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCdcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCdcpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Event) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCdcpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Event: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegionId", wireType)
+			}
+			m.RegionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegionId |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCdcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rows = append(m.Rows, &Event_Row{})
+			if err := m.Rows[len(m.Rows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCdcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &errorpb.Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolvedTs", wireType)
+			}
+			m.ResolvedTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResolvedTs |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCdcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCdcpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Event_Row) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCdcpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Row: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Row: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= (Event_Row_Type(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpType", wireType)
+			}
+			m.OpType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OpType |= (Event_Row_OpType(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCdcpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCdcpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTs", wireType)
+			}
+			m.StartTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTs |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitTs", wireType)
+			}
+			m.CommitTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitTs |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCdcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCdcpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChangeDataEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCdcpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChangeDataEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChangeDataEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCdcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, &Event{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCdcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCdcpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCdcpb(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCdcpb
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCdcpb
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCdcpb
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			iNdEx += length
+			if length < 0 {
+				return 0, ErrInvalidLengthCdcpb
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowCdcpb
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipCdcpb(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthCdcpb = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCdcpb   = fmt.Errorf("proto: integer overflow")
+)
+
+func init() { proto.RegisterFile("cdcpb.proto", fileDescriptor_cdcpb_06e77f0f7f4d5fa2) }
+
+var fileDescriptor_cdcpb_06e77f0f7f4d5fa2 = []byte{
+	// 515 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x52, 0x5d, 0x6f, 0x12, 0x41,
+	0x14, 0x65, 0xbf, 0xe9, 0x5d, 0xa0, 0xe3, 0xa4, 0xa6, 0x88, 0x09, 0x12, 0xe4, 0x01, 0x4d, 0x44,
+	0x83, 0x0f, 0x3e, 0xb7, 0xb0, 0x0f, 0x1b, 0x28, 0x90, 0xc9, 0x34, 0x4d, 0xf4, 0x81, 0xd0, 0xdd,
+	0x09, 0x25, 0xb4, 0x3b, 0xeb, 0xee, 0x16, 0xe4, 0x9f, 0x18, 0x7f, 0x91, 0x4f, 0xc6, 0x9f, 0x60,
+	0xf0, 0x8f, 0x98, 0xbd, 0xd3, 0x15, 0x63, 0x9f, 0xe6, 0xde, 0x73, 0xce, 0xde, 0x7b, 0xf6, 0xcc,
+	0x80, 0x1b, 0x84, 0x41, 0x7c, 0xdd, 0x8b, 0x13, 0x99, 0x49, 0x6a, 0x61, 0xd3, 0xa8, 0x8a, 0x24,
+	0x91, 0x49, 0x81, 0x36, 0xaa, 0xeb, 0x4d, 0x12, 0xff, 0x15, 0x35, 0x4e, 0x96, 0x72, 0x29, 0xb1,
+	0x7c, 0x9b, 0x57, 0x0a, 0x6d, 0x7f, 0xd3, 0xe0, 0xc9, 0xe0, 0x66, 0x11, 0x2d, 0xc5, 0x70, 0x91,
+	0x2d, 0x98, 0xf8, 0x7c, 0x2f, 0xd2, 0x8c, 0xbe, 0x06, 0x27, 0x90, 0x51, 0x26, 0xbe, 0x64, 0x75,
+	0xad, 0xa5, 0x75, 0xdd, 0x3e, 0xe9, 0x15, 0xc3, 0x06, 0x0a, 0x67, 0x85, 0x80, 0x3e, 0x87, 0xa3,
+	0x34, 0x5b, 0x24, 0xd9, 0x7c, 0x2d, 0x76, 0x75, 0xbd, 0xa5, 0x75, 0x2b, 0xac, 0x8c, 0xc0, 0x48,
+	0xec, 0xe8, 0x29, 0x38, 0x22, 0x0a, 0x91, 0x32, 0x90, 0xb2, 0x45, 0x14, 0xe6, 0xc4, 0x4b, 0xa8,
+	0x06, 0x37, 0x22, 0x58, 0xc7, 0x72, 0x15, 0x65, 0xf3, 0x2c, 0xad, 0x9b, 0x2d, 0xad, 0x6b, 0xb2,
+	0xca, 0x01, 0xe4, 0x69, 0x7b, 0x6f, 0x80, 0xe5, 0x6d, 0x44, 0x84, 0x4b, 0x12, 0xb1, 0x5c, 0xc9,
+	0x68, 0xbe, 0x0a, 0xd1, 0x92, 0xc9, 0xca, 0x0a, 0xf0, 0x43, 0xda, 0x01, 0x33, 0x91, 0xdb, 0xb4,
+	0xae, 0xb7, 0x0c, 0xb4, 0xaa, 0xa2, 0xc1, 0x0f, 0x7b, 0x4c, 0x6e, 0x19, 0xb2, 0xb4, 0x03, 0x16,
+	0xe6, 0x83, 0x46, 0xdc, 0x7e, 0xad, 0x57, 0xa4, 0xe5, 0xe5, 0x27, 0x53, 0x24, 0x7d, 0x01, 0x6e,
+	0x22, 0x52, 0x79, 0xbb, 0x11, 0xe1, 0xc1, 0x15, 0x14, 0x10, 0x4f, 0x1b, 0x3f, 0x74, 0x30, 0x98,
+	0xdc, 0xd2, 0x57, 0x60, 0x66, 0xbb, 0x58, 0xa0, 0x99, 0x5a, 0xff, 0xe9, 0xff, 0x4b, 0x7b, 0x7c,
+	0x17, 0x0b, 0x86, 0x12, 0xfa, 0x0e, 0x1c, 0x19, 0xcf, 0x51, 0xad, 0xa3, 0xfa, 0xf4, 0x91, 0x7a,
+	0x1a, 0xa3, 0xde, 0x96, 0x78, 0x52, 0x02, 0xc6, 0x21, 0xb2, 0xbc, 0xa4, 0x27, 0x60, 0x6d, 0x16,
+	0xb7, 0xf7, 0x02, 0x1d, 0x55, 0x98, 0x6a, 0xe8, 0x33, 0x50, 0x51, 0xe7, 0x56, 0x2d, 0xb4, 0xea,
+	0x60, 0xcf, 0xd3, 0x3c, 0xb1, 0x40, 0xde, 0xdd, 0xad, 0x90, 0xb3, 0x55, 0x62, 0x0a, 0xe0, 0x69,
+	0xfb, 0x13, 0x98, 0xb8, 0xc7, 0x05, 0xe7, 0x72, 0x32, 0x9a, 0x4c, 0xaf, 0x26, 0xa4, 0x44, 0x2b,
+	0x50, 0x9e, 0x31, 0xef, 0x8a, 0xf9, 0xdc, 0x23, 0x1a, 0x05, 0xb0, 0x07, 0xd3, 0x8b, 0x0b, 0x9f,
+	0x13, 0x3d, 0x67, 0xd8, 0x74, 0x3c, 0x3e, 0x3f, 0x1b, 0x8c, 0x88, 0x41, 0xab, 0x70, 0xa4, 0x18,
+	0xee, 0x0d, 0x89, 0x49, 0x8f, 0xc1, 0xf5, 0x27, 0x3e, 0xf7, 0xcf, 0xc6, 0xfe, 0x47, 0x6f, 0x48,
+	0xac, 0xf6, 0x1b, 0xb0, 0xd5, 0xef, 0xd0, 0x1a, 0xc0, 0xc3, 0xf8, 0xf9, 0x74, 0x46, 0x4a, 0xd4,
+	0x01, 0x63, 0x76, 0xc9, 0xd5, 0xf0, 0xa1, 0x37, 0xf6, 0xb8, 0x47, 0xf4, 0xf6, 0x07, 0x38, 0x3e,
+	0x3c, 0x40, 0x75, 0xdb, 0x1d, 0xb0, 0x45, 0x5e, 0xa4, 0x75, 0x0d, 0xaf, 0xb4, 0xf2, 0x6f, 0x5e,
+	0xec, 0x81, 0x3b, 0x27, 0xdf, 0xf7, 0x4d, 0xed, 0xe7, 0xbe, 0xa9, 0xfd, 0xda, 0x37, 0xb5, 0xaf,
+	0xbf, 0x9b, 0xa5, 0x6b, 0x1b, 0xdf, 0xf4, 0xfb, 0x3f, 0x03, 0x00, 0x77, 0xf2, 0x3a, 0x05, 0x1d,
+	0x03, 0x00, 0x00,
+}
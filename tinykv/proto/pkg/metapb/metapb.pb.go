@@ -47,7 +47,38 @@ func (x StoreState) String() string {
 	return proto.EnumName(StoreState_name, int32(x))
 }
 func (StoreState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_metapb_33de520265e54ab4, []int{0}
+	return fileDescriptor_metapb_8231ac6c90707745, []int{0}
+}
+
+// JointState marks the voters whose membership differs between the incoming
+// and the outgoing configuration of a region in a joint configuration.
+type JointState int32
+
+const (
+	// A voter of both configurations, or the region is not in a joint configuration.
+	JointState_Stable JointState = 0
+	// A voter of the incoming configuration only, it's being added.
+	JointState_IncomingVoter JointState = 1
+	// A voter of the outgoing configuration only, it's being removed.
+	JointState_OutgoingVoter JointState = 2
+)
+
+var JointState_name = map[int32]string{
+	0: "Stable",
+	1: "IncomingVoter",
+	2: "OutgoingVoter",
+}
+var JointState_value = map[string]int32{
+	"Stable":        0,
+	"IncomingVoter": 1,
+	"OutgoingVoter": 2,
+}
+
+func (x JointState) String() string {
+	return proto.EnumName(JointState_name, int32(x))
+}
+func (JointState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_metapb_8231ac6c90707745, []int{1}
 }
 
 type Cluster struct {
@@ -64,7 +95,7 @@ func (m *Cluster) Reset()         { *m = Cluster{} }
 func (m *Cluster) String() string { return proto.CompactTextString(m) }
 func (*Cluster) ProtoMessage()    {}
 func (*Cluster) Descriptor() ([]byte, []int) {
-	return fileDescriptor_metapb_33de520265e54ab4, []int{0}
+	return fileDescriptor_metapb_8231ac6c90707745, []int{0}
 }
 func (m *Cluster) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -107,21 +138,80 @@ func (m *Cluster) GetMaxPeerCount() uint32 {
 	return 0
 }
 
+// StoreLabel is a key/value attribute of a store, such as its zone, rack or
+// host. The scheduler spreads the replicas of a region across stores with
+// different labels.
+type StoreLabel struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StoreLabel) Reset()         { *m = StoreLabel{} }
+func (m *StoreLabel) String() string { return proto.CompactTextString(m) }
+func (*StoreLabel) ProtoMessage()    {}
+func (*StoreLabel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_metapb_8231ac6c90707745, []int{1}
+}
+func (m *StoreLabel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StoreLabel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StoreLabel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *StoreLabel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreLabel.Merge(dst, src)
+}
+func (m *StoreLabel) XXX_Size() int {
+	return m.Size()
+}
+func (m *StoreLabel) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreLabel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoreLabel proto.InternalMessageInfo
+
+func (m *StoreLabel) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *StoreLabel) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
 type Store struct {
 	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	// Address to handle client requests (kv, cop, etc.)
-	Address              string     `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	State                StoreState `protobuf:"varint,3,opt,name=state,proto3,enum=metapb.StoreState" json:"state,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+	Address              string        `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	State                StoreState    `protobuf:"varint,3,opt,name=state,proto3,enum=metapb.StoreState" json:"state,omitempty"`
+	Labels               []*StoreLabel `protobuf:"bytes,4,rep,name=labels" json:"labels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *Store) Reset()         { *m = Store{} }
 func (m *Store) String() string { return proto.CompactTextString(m) }
 func (*Store) ProtoMessage()    {}
 func (*Store) Descriptor() ([]byte, []int) {
-	return fileDescriptor_metapb_33de520265e54ab4, []int{1}
+	return fileDescriptor_metapb_8231ac6c90707745, []int{2}
 }
 func (m *Store) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -171,6 +261,13 @@ func (m *Store) GetState() StoreState {
 	return StoreState_Up
 }
 
+func (m *Store) GetLabels() []*StoreLabel {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
 type RegionEpoch struct {
 	// Conf change version, auto increment when add or remove peer
 	ConfVer uint64 `protobuf:"varint,1,opt,name=conf_ver,json=confVer,proto3" json:"conf_ver,omitempty"`
@@ -185,7 +282,7 @@ func (m *RegionEpoch) Reset()         { *m = RegionEpoch{} }
 func (m *RegionEpoch) String() string { return proto.CompactTextString(m) }
 func (*RegionEpoch) ProtoMessage()    {}
 func (*RegionEpoch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_metapb_33de520265e54ab4, []int{2}
+	return fileDescriptor_metapb_8231ac6c90707745, []int{3}
 }
 func (m *RegionEpoch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -244,7 +341,7 @@ func (m *Region) Reset()         { *m = Region{} }
 func (m *Region) String() string { return proto.CompactTextString(m) }
 func (*Region) ProtoMessage()    {}
 func (*Region) Descriptor() ([]byte, []int) {
-	return fileDescriptor_metapb_33de520265e54ab4, []int{3}
+	return fileDescriptor_metapb_8231ac6c90707745, []int{4}
 }
 func (m *Region) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -309,18 +406,22 @@ func (m *Region) GetPeers() []*Peer {
 }
 
 type Peer struct {
-	Id                   uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	StoreId              uint64   `protobuf:"varint,2,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Id      uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	StoreId uint64 `protobuf:"varint,2,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
+	// A learner doesn't vote and is not counted in the quorum.
+	IsLearner bool `protobuf:"varint,3,opt,name=is_learner,json=isLearner,proto3" json:"is_learner,omitempty"`
+	// Only set while the region is in a joint configuration.
+	JointState           JointState `protobuf:"varint,4,opt,name=joint_state,json=jointState,proto3,enum=metapb.JointState" json:"joint_state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *Peer) Reset()         { *m = Peer{} }
 func (m *Peer) String() string { return proto.CompactTextString(m) }
 func (*Peer) ProtoMessage()    {}
 func (*Peer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_metapb_33de520265e54ab4, []int{4}
+	return fileDescriptor_metapb_8231ac6c90707745, []int{5}
 }
 func (m *Peer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -363,13 +464,29 @@ func (m *Peer) GetStoreId() uint64 {
 	return 0
 }
 
+func (m *Peer) GetIsLearner() bool {
+	if m != nil {
+		return m.IsLearner
+	}
+	return false
+}
+
+func (m *Peer) GetJointState() JointState {
+	if m != nil {
+		return m.JointState
+	}
+	return JointState_Stable
+}
+
 func init() {
 	proto.RegisterType((*Cluster)(nil), "metapb.Cluster")
+	proto.RegisterType((*StoreLabel)(nil), "metapb.StoreLabel")
 	proto.RegisterType((*Store)(nil), "metapb.Store")
 	proto.RegisterType((*RegionEpoch)(nil), "metapb.RegionEpoch")
 	proto.RegisterType((*Region)(nil), "metapb.Region")
 	proto.RegisterType((*Peer)(nil), "metapb.Peer")
 	proto.RegisterEnum("metapb.StoreState", StoreState_name, StoreState_value)
+	proto.RegisterEnum("metapb.JointState", JointState_name, JointState_value)
 }
 func (m *Cluster) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -402,6 +519,39 @@ func (m *Cluster) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *StoreLabel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StoreLabel) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if len(m.Value) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(len(m.Value)))
+		i += copy(dAtA[i:], m.Value)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
 func (m *Store) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -433,6 +583,18 @@ func (m *Store) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintMetapb(dAtA, i, uint64(m.State))
 	}
+	if len(m.Labels) > 0 {
+		for _, msg := range m.Labels {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintMetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -555,6 +717,21 @@ func (m *Peer) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintMetapb(dAtA, i, uint64(m.StoreId))
 	}
+	if m.IsLearner {
+		dAtA[i] = 0x18
+		i++
+		if m.IsLearner {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.JointState != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.JointState))
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -585,6 +762,23 @@ func (m *Cluster) Size() (n int) {
 	return n
 }
 
+func (m *StoreLabel) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovMetapb(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovMetapb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *Store) Size() (n int) {
 	var l int
 	_ = l
@@ -598,6 +792,12 @@ func (m *Store) Size() (n int) {
 	if m.State != 0 {
 		n += 1 + sovMetapb(uint64(m.State))
 	}
+	if len(m.Labels) > 0 {
+		for _, e := range m.Labels {
+			l = e.Size()
+			n += 1 + l + sovMetapb(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -658,6 +858,12 @@ func (m *Peer) Size() (n int) {
 	if m.StoreId != 0 {
 		n += 1 + sovMetapb(uint64(m.StoreId))
 	}
+	if m.IsLearner {
+		n += 2
+	}
+	if m.JointState != 0 {
+		n += 1 + sovMetapb(uint64(m.JointState))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -766,6 +972,115 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *StoreLabel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StoreLabel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StoreLabel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Store) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -862,6 +1177,37 @@ func (m *Store) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Labels = append(m.Labels, &StoreLabel{})
+			if err := m.Labels[len(m.Labels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetapb(dAtA[iNdEx:])
@@ -1236,6 +1582,45 @@ func (m *Peer) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsLearner", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsLearner = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JointState", wireType)
+			}
+			m.JointState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JointState |= (JointState(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetapb(dAtA[iNdEx:])
@@ -1363,33 +1748,40 @@ var (
 	ErrIntOverflowMetapb   = fmt.Errorf("proto: integer overflow")
 )
 
-func init() { proto.RegisterFile("metapb.proto", fileDescriptor_metapb_33de520265e54ab4) }
-
-var fileDescriptor_metapb_33de520265e54ab4 = []byte{
-	// 390 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x52, 0xdd, 0x8a, 0xd3, 0x40,
-	0x18, 0xdd, 0x49, 0xf3, 0xd3, 0x7e, 0xc9, 0x96, 0x30, 0x0a, 0x66, 0x15, 0x42, 0x08, 0x5e, 0x04,
-	0x2f, 0x56, 0xad, 0xe0, 0xad, 0xb0, 0x8b, 0x17, 0xe2, 0x85, 0x32, 0xab, 0xde, 0x78, 0x11, 0xd2,
-	0xce, 0xd7, 0x1a, 0xdc, 0xcc, 0x84, 0x99, 0xd9, 0xa5, 0x7d, 0x13, 0x9f, 0xc1, 0x27, 0xf1, 0xd2,
-	0x47, 0x90, 0xfa, 0x22, 0x32, 0x93, 0x06, 0x0b, 0xbd, 0xcb, 0xf9, 0x4e, 0xce, 0x77, 0xce, 0x77,
-	0x18, 0x48, 0x3a, 0x34, 0x4d, 0xbf, 0xbc, 0xec, 0x95, 0x34, 0x92, 0x86, 0x03, 0x7a, 0xfc, 0x70,
-	0x23, 0x37, 0xd2, 0x8d, 0x9e, 0xdb, 0xaf, 0x81, 0x2d, 0xdf, 0x40, 0x74, 0x7d, 0x7b, 0xa7, 0x0d,
-	0x2a, 0x3a, 0x07, 0xaf, 0xe5, 0x19, 0x29, 0x48, 0xe5, 0x33, 0xaf, 0xe5, 0xf4, 0x29, 0xcc, 0xbb,
-	0x66, 0x5b, 0xf7, 0x88, 0xaa, 0x5e, 0xc9, 0x3b, 0x61, 0x32, 0xaf, 0x20, 0xd5, 0x39, 0x4b, 0xba,
-	0x66, 0xfb, 0x11, 0x51, 0x5d, 0xdb, 0x59, 0xf9, 0x15, 0x82, 0x1b, 0x23, 0x15, 0x9e, 0xc8, 0x33,
-	0x88, 0x1a, 0xce, 0x15, 0x6a, 0xed, 0x74, 0x33, 0x36, 0x42, 0x5a, 0x41, 0xa0, 0x4d, 0x63, 0x30,
-	0x9b, 0x14, 0xa4, 0x9a, 0x2f, 0xe8, 0xe5, 0x21, 0xaf, 0xdb, 0x73, 0x63, 0x19, 0x36, 0xfc, 0x50,
-	0x5e, 0x41, 0xcc, 0x70, 0xd3, 0x4a, 0xf1, 0xb6, 0x97, 0xab, 0x6f, 0xf4, 0x02, 0xa6, 0x2b, 0x29,
-	0xd6, 0xf5, 0x3d, 0xaa, 0x83, 0x51, 0x64, 0xf1, 0x17, 0x54, 0xd6, 0xed, 0x1e, 0x95, 0x6e, 0xa5,
-	0x70, 0x6e, 0x3e, 0x1b, 0x61, 0xf9, 0x93, 0x40, 0x38, 0x2c, 0x39, 0x89, 0xf8, 0x04, 0x66, 0xda,
-	0x34, 0xca, 0xd4, 0xdf, 0x71, 0xe7, 0x64, 0x09, 0x9b, 0xba, 0xc1, 0x7b, 0xdc, 0xd1, 0x47, 0x10,
-	0xa1, 0xe0, 0x8e, 0x9a, 0x38, 0x2a, 0x44, 0xc1, 0x2d, 0xf1, 0x1a, 0x12, 0xe5, 0xf6, 0xd5, 0x68,
-	0x53, 0x65, 0x7e, 0x41, 0xaa, 0x78, 0xf1, 0x60, 0xbc, 0xe2, 0x28, 0x30, 0x8b, 0xd5, 0x51, 0xfa,
-	0x12, 0x02, 0xdb, 0xa5, 0xce, 0x82, 0x62, 0x52, 0xc5, 0x8b, 0x64, 0x14, 0xd8, 0x2e, 0xd9, 0x40,
-	0x95, 0x2f, 0xc1, 0xb7, 0xf0, 0x24, 0xe9, 0x05, 0x4c, 0xb5, 0x6d, 0xa7, 0x6e, 0xf9, 0x78, 0x9f,
-	0xc3, 0xef, 0xf8, 0xb3, 0x17, 0x00, 0xff, 0x8b, 0xa3, 0x21, 0x78, 0x9f, 0xfb, 0xf4, 0x8c, 0xc6,
-	0x10, 0x7d, 0x58, 0xaf, 0x6f, 0x5b, 0x81, 0x29, 0xa1, 0xe7, 0x30, 0xfb, 0x24, 0xbb, 0xa5, 0x36,
-	0x52, 0x60, 0xea, 0x5d, 0xa5, 0xbf, 0xf6, 0x39, 0xf9, 0xbd, 0xcf, 0xc9, 0x9f, 0x7d, 0x4e, 0x7e,
-	0xfc, 0xcd, 0xcf, 0x96, 0xa1, 0x7b, 0x0c, 0xaf, 0xfe, 0x05, 0x00, 0x00, 0xff, 0xff, 0x77, 0xf7,
-	0x52, 0x80, 0x3a, 0x02, 0x00, 0x00,
+func init() { proto.RegisterFile("metapb.proto", fileDescriptor_metapb_8231ac6c90707745) }
+
+var fileDescriptor_metapb_8231ac6c90707745 = []byte{
+	// 512 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x53, 0xc1, 0x6e, 0xd3, 0x4c,
+	0x10, 0xce, 0x3a, 0x8e, 0x93, 0x8c, 0x93, 0xc8, 0xff, 0xfe, 0x95, 0x70, 0x41, 0x44, 0x91, 0xc5,
+	0xc1, 0xca, 0xa1, 0xa0, 0x16, 0x71, 0x04, 0xa9, 0x15, 0x87, 0x42, 0xa5, 0xa2, 0x0d, 0xf4, 0x6a,
+	0x39, 0xf1, 0x24, 0x6c, 0x71, 0x76, 0xa3, 0xdd, 0x4d, 0xd4, 0x3e, 0x00, 0xe2, 0x15, 0x78, 0x06,
+	0x9e, 0x84, 0x23, 0x8f, 0x80, 0xc2, 0x8b, 0xa0, 0x5d, 0xc7, 0x2d, 0x90, 0xdb, 0x7c, 0xdf, 0x37,
+	0x33, 0xf9, 0x66, 0xbf, 0x18, 0x7a, 0x4b, 0x34, 0xf9, 0x6a, 0x7a, 0xb4, 0x52, 0xd2, 0x48, 0x1a,
+	0x54, 0xe8, 0xe1, 0xc1, 0x42, 0x2e, 0xa4, 0xa3, 0x9e, 0xda, 0xaa, 0x52, 0x93, 0x57, 0xd0, 0x3e,
+	0x2b, 0xd7, 0xda, 0xa0, 0xa2, 0x03, 0xf0, 0x78, 0x11, 0x93, 0x11, 0x49, 0x7d, 0xe6, 0xf1, 0x82,
+	0x3e, 0x81, 0xc1, 0x32, 0xbf, 0xc9, 0x56, 0x88, 0x2a, 0x9b, 0xc9, 0xb5, 0x30, 0xb1, 0x37, 0x22,
+	0x69, 0x9f, 0xf5, 0x96, 0xf9, 0xcd, 0x3b, 0x44, 0x75, 0x66, 0xb9, 0xe4, 0x39, 0xc0, 0xc4, 0x48,
+	0x85, 0x17, 0xf9, 0x14, 0x4b, 0x1a, 0x41, 0xf3, 0x13, 0xde, 0xba, 0x25, 0x5d, 0x66, 0x4b, 0x7a,
+	0x00, 0xad, 0x4d, 0x5e, 0xae, 0xd1, 0x0d, 0x77, 0x59, 0x05, 0x92, 0x2f, 0x04, 0x5a, 0x6e, 0x6c,
+	0xef, 0x57, 0x63, 0x68, 0xe7, 0x45, 0xa1, 0x50, 0xeb, 0xdd, 0x44, 0x0d, 0x69, 0x0a, 0x2d, 0x6d,
+	0x72, 0x83, 0x71, 0x73, 0x44, 0xd2, 0xc1, 0x31, 0x3d, 0xda, 0x9d, 0xe9, 0xf6, 0x4c, 0xac, 0xc2,
+	0xaa, 0x06, 0x3a, 0x86, 0xa0, 0xb4, 0x76, 0x74, 0xec, 0x8f, 0x9a, 0x69, 0xf8, 0x4f, 0xab, 0x73,
+	0xca, 0x76, 0x1d, 0xc9, 0x29, 0x84, 0x0c, 0x17, 0x5c, 0x8a, 0xd7, 0x2b, 0x39, 0xfb, 0x48, 0x0f,
+	0xa1, 0x33, 0x93, 0x62, 0x9e, 0x6d, 0x50, 0xed, 0x4c, 0xb5, 0x2d, 0xbe, 0x42, 0x65, 0x9d, 0x6d,
+	0x50, 0x69, 0x2e, 0x85, 0x73, 0xe6, 0xb3, 0x1a, 0x26, 0xdf, 0x08, 0x04, 0xd5, 0x92, 0xbd, 0x73,
+	0x1e, 0x41, 0x57, 0x9b, 0x5c, 0x99, 0xcc, 0x3e, 0x8b, 0x1d, 0xeb, 0xb1, 0x8e, 0x23, 0xde, 0xe2,
+	0x2d, 0x7d, 0x00, 0x6d, 0x14, 0x85, 0x93, 0x9a, 0x4e, 0x0a, 0x50, 0x14, 0x56, 0x78, 0x01, 0x3d,
+	0xe5, 0xf6, 0x65, 0x68, 0x5d, 0xc5, 0xfe, 0x88, 0xa4, 0xe1, 0xf1, 0xff, 0xf5, 0x19, 0x7f, 0x18,
+	0x66, 0xa1, 0xba, 0x07, 0x34, 0x81, 0x96, 0x8d, 0x4b, 0xc7, 0x2d, 0x77, 0x77, 0xaf, 0x1e, 0xb0,
+	0x71, 0xb1, 0x4a, 0x4a, 0x3e, 0x13, 0xf0, 0x2d, 0xde, 0xb3, 0x7a, 0x08, 0x1d, 0x6d, 0xdf, 0x27,
+	0xe3, 0x45, 0x7d, 0xa0, 0xc3, 0xe7, 0x05, 0x7d, 0x0c, 0xc0, 0x75, 0x56, 0x62, 0xae, 0x04, 0x2a,
+	0xe7, 0xb5, 0xc3, 0xba, 0x5c, 0x5f, 0x54, 0x04, 0x3d, 0x81, 0xf0, 0x5a, 0x72, 0x61, 0xb2, 0x2a,
+	0x1f, 0xff, 0xef, 0x7c, 0xde, 0x58, 0xa9, 0xca, 0x07, 0xae, 0xef, 0xea, 0xf1, 0xb3, 0xdd, 0x1f,
+	0xc7, 0x21, 0x1a, 0x80, 0xf7, 0x61, 0x15, 0x35, 0x68, 0x08, 0xed, 0xcb, 0xf9, 0xbc, 0xe4, 0x02,
+	0x23, 0x42, 0xfb, 0xd0, 0x7d, 0x2f, 0x97, 0x53, 0x6d, 0xa4, 0xc0, 0xc8, 0x1b, 0xbf, 0x04, 0xb8,
+	0xdf, 0x45, 0x01, 0x82, 0x89, 0xc9, 0xa7, 0x25, 0x46, 0x0d, 0xfa, 0x1f, 0xf4, 0xcf, 0xc5, 0x4c,
+	0x2e, 0xb9, 0x58, 0x5c, 0x49, 0x83, 0x2a, 0x22, 0x96, 0xba, 0x5c, 0x9b, 0x85, 0xbc, 0xa3, 0xbc,
+	0xd3, 0xe8, 0xfb, 0x76, 0x48, 0x7e, 0x6c, 0x87, 0xe4, 0xe7, 0x76, 0x48, 0xbe, 0xfe, 0x1a, 0x36,
+	0xa6, 0x81, 0xfb, 0x08, 0x4e, 0x7e, 0x0f, 0x00, 0x87, 0x50, 0xcc, 0xfe, 0x32, 0x03, 0x00, 0x00,
 }
@@ -30,11 +30,12 @@ const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 type CmdType int32
 
 const (
-	CmdType_Invalid CmdType = 0
-	CmdType_Get     CmdType = 1
-	CmdType_Put     CmdType = 3
-	CmdType_Delete  CmdType = 4
-	CmdType_Snap    CmdType = 5
+	CmdType_Invalid     CmdType = 0
+	CmdType_Get         CmdType = 1
+	CmdType_Put         CmdType = 3
+	CmdType_Delete      CmdType = 4
+	CmdType_Snap        CmdType = 5
+	CmdType_DeleteRange CmdType = 6
 )
 
 var CmdType_name = map[int32]string{
@@ -43,20 +44,22 @@ var CmdType_name = map[int32]string{
 	3: "Put",
 	4: "Delete",
 	5: "Snap",
+	6: "DeleteRange",
 }
 var CmdType_value = map[string]int32{
-	"Invalid": 0,
-	"Get":     1,
-	"Put":     3,
-	"Delete":  4,
-	"Snap":    5,
+	"Invalid":     0,
+	"Get":         1,
+	"Put":         3,
+	"Delete":      4,
+	"Snap":        5,
+	"DeleteRange": 6,
 }
 
 func (x CmdType) String() string {
 	return proto.EnumName(CmdType_name, int32(x))
 }
 func (CmdType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_409ff26e8ae8c248, []int{0}
+	return fileDescriptor_raft_cmdpb_a73b112e07bde3d6, []int{0}
 }
 
 type AdminCmdType int32
@@ -66,7 +69,11 @@ const (
 	AdminCmdType_ChangePeer     AdminCmdType = 1
 	AdminCmdType_CompactLog     AdminCmdType = 3
 	AdminCmdType_TransferLeader AdminCmdType = 4
+	AdminCmdType_PrepareMerge   AdminCmdType = 7
+	AdminCmdType_CommitMerge    AdminCmdType = 8
+	AdminCmdType_RollbackMerge  AdminCmdType = 9
 	AdminCmdType_Split          AdminCmdType = 10
+	AdminCmdType_ChangePeerV2   AdminCmdType = 11
 )
 
 var AdminCmdType_name = map[int32]string{
@@ -74,21 +81,29 @@ var AdminCmdType_name = map[int32]string{
 	1:  "ChangePeer",
 	3:  "CompactLog",
 	4:  "TransferLeader",
+	7:  "PrepareMerge",
+	8:  "CommitMerge",
+	9:  "RollbackMerge",
 	10: "Split",
+	11: "ChangePeerV2",
 }
 var AdminCmdType_value = map[string]int32{
 	"InvalidAdmin":   0,
 	"ChangePeer":     1,
 	"CompactLog":     3,
 	"TransferLeader": 4,
+	"PrepareMerge":   7,
+	"CommitMerge":    8,
+	"RollbackMerge":  9,
 	"Split":          10,
+	"ChangePeerV2":   11,
 }
 
 func (x AdminCmdType) String() string {
 	return proto.EnumName(AdminCmdType_name, int32(x))
 }
 func (AdminCmdType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_409ff26e8ae8c248, []int{1}
+	return fileDescriptor_raft_cmdpb_a73b112e07bde3d6, []int{1}
 }
 
 type GetRequest struct {
@@ -103,7 +118,7 @@ func (m *GetRequest) Reset()         { *m = GetRequest{} }
 func (m *GetRequest) String() string { return proto.CompactTextString(m) }
 func (*GetRequest) ProtoMessage()    {}
 func (*GetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_409ff26e8ae8c248, []int{0}
+	return fileDescriptor_raft_cmdpb_a73b112e07bde3d6, []int{0}
 }
 func (m *GetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -157,7 +172,7 @@ func (m *GetResponse) Reset()         { *m = GetResponse{} }
 func (m *GetResponse) String() string { return proto.CompactTextString(m) }
 func (*GetResponse) ProtoMessage()    {}
 func (*GetResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_409ff26e8ae8c248, []int{1}
+	return fileDescriptor_raft_cmdpb_a73b112e07bde3d6, []int{1}
 }
 func (m *GetResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -206,7 +221,7 @@ func (m *PutRequest) Reset()         { *m = PutRequest{} }
 func (m *PutRequest) String() string { return proto.CompactTextString(m) }
 func (*PutRequest) ProtoMessage()    {}
 func (*PutRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_409ff26e8ae8c248, []int{2}
+	return fileDescriptor_raft_cmdpb_a73b112e07bde3d6, []int{2}
 }
 func (m *PutRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -266,7 +281,7 @@ func (m *PutResponse) Reset()         { *m = PutResponse{} }
 func (m *PutResponse) String() string { return proto.CompactTextString(m) }
 func (*PutResponse) ProtoMessage()    {}
 func (*PutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_409ff26e8ae8c248, []int{3}
+	return fileDescriptor_raft_cmdpb_a73b112e07bde3d6, []int{3}
 }
 func (m *PutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -307,7 +322,7 @@ func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
 func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteRequest) ProtoMessage()    {}
 func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_409ff26e8ae8c248, []int{4}
+	return fileDescriptor_raft_cmdpb_a73b112e07bde3d6, []int{4}
 }
 func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -360,7 +375,7 @@ func (m *DeleteResponse) Reset()         { *m = DeleteResponse{} }
 func (m *DeleteResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteResponse) ProtoMessage()    {}
 func (*DeleteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_409ff26e8ae8c248, []int{5}
+	return fileDescriptor_raft_cmdpb_a73b112e07bde3d6, []int{5}
 }
 func (m *DeleteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -389,6 +404,109 @@ func (m *DeleteResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DeleteResponse proto.InternalMessageInfo
 
+type DeleteRangeRequest struct {
+	// Deletes the keys in [start_key, end_key) of the column family.
+	Cf                   string   `protobuf:"bytes,1,opt,name=cf,proto3" json:"cf,omitempty"`
+	StartKey             []byte   `protobuf:"bytes,2,opt,name=start_key,json=startKey,proto3" json:"start_key,omitempty"`
+	EndKey               []byte   `protobuf:"bytes,3,opt,name=end_key,json=endKey,proto3" json:"end_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteRangeRequest) Reset()         { *m = DeleteRangeRequest{} }
+func (m *DeleteRangeRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteRangeRequest) ProtoMessage()    {}
+func (*DeleteRangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_raft_cmdpb_a73b112e07bde3d6, []int{6}
+}
+func (m *DeleteRangeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteRangeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *DeleteRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteRangeRequest.Merge(dst, src)
+}
+func (m *DeleteRangeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteRangeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteRangeRequest proto.InternalMessageInfo
+
+func (m *DeleteRangeRequest) GetCf() string {
+	if m != nil {
+		return m.Cf
+	}
+	return ""
+}
+
+func (m *DeleteRangeRequest) GetStartKey() []byte {
+	if m != nil {
+		return m.StartKey
+	}
+	return nil
+}
+
+func (m *DeleteRangeRequest) GetEndKey() []byte {
+	if m != nil {
+		return m.EndKey
+	}
+	return nil
+}
+
+type DeleteRangeResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteRangeResponse) Reset()         { *m = DeleteRangeResponse{} }
+func (m *DeleteRangeResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteRangeResponse) ProtoMessage()    {}
+func (*DeleteRangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_raft_cmdpb_a73b112e07bde3d6, []int{7}
+}
+func (m *DeleteRangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteRangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteRangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *DeleteRangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteRangeResponse.Merge(dst, src)
+}
+func (m *DeleteRangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteRangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteRangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteRangeResponse proto.InternalMessageInfo
+
 type SnapRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -399,7 +517,7 @@ func (m *SnapRequest) Reset()         { *m = SnapRequest{} }
 func (m *SnapRequest) String() string { return proto.CompactTextString(m) }
 func (*SnapRequest) ProtoMessage()    {}
 func (*SnapRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_409ff26e8ae8c248, []int{6}
+	return fileDescriptor_raft_cmdpb_a73b112e07bde3d6, []int{8}
 }
 func (m *SnapRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -439,7 +557,7 @@ func (m *SnapResponse) Reset()         { *m = SnapResponse{} }
 func (m *SnapResponse) String() string { return proto.CompactTextString(m) }
 func (*SnapResponse) ProtoMessage()    {}
 func (*SnapResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_409ff26e8ae8c248, []int{7}
+	return fileDescriptor_raft_cmdpb_a73b112e07bde3d6, []int{9}
 }
 func (m *SnapResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -476,21 +594,22 @@ func (m *SnapResponse) GetRegion() *metapb.Region {
 }
 
 type Request struct {
-	CmdType              CmdType        `protobuf:"varint,1,opt,name=cmd_type,json=cmdType,proto3,enum=raft_cmdpb.CmdType" json:"cmd_type,omitempty"`
-	Get                  *GetRequest    `protobuf:"bytes,2,opt,name=get" json:"get,omitempty"`
-	Put                  *PutRequest    `protobuf:"bytes,4,opt,name=put" json:"put,omitempty"`
-	Delete               *DeleteRequest `protobuf:"bytes,5,opt,name=delete" json:"delete,omitempty"`
-	Snap                 *SnapRequest   `protobuf:"bytes,6,opt,name=snap" json:"snap,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	CmdType              CmdType             `protobuf:"varint,1,opt,name=cmd_type,json=cmdType,proto3,enum=raft_cmdpb.CmdType" json:"cmd_type,omitempty"`
+	Get                  *GetRequest         `protobuf:"bytes,2,opt,name=get" json:"get,omitempty"`
+	Put                  *PutRequest         `protobuf:"bytes,4,opt,name=put" json:"put,omitempty"`
+	Delete               *DeleteRequest      `protobuf:"bytes,5,opt,name=delete" json:"delete,omitempty"`
+	Snap                 *SnapRequest        `protobuf:"bytes,6,opt,name=snap" json:"snap,omitempty"`
+	DeleteRange          *DeleteRangeRequest `protobuf:"bytes,7,opt,name=delete_range,json=deleteRange" json:"delete_range,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *Request) Reset()         { *m = Request{} }
 func (m *Request) String() string { return proto.CompactTextString(m) }
 func (*Request) ProtoMessage()    {}
 func (*Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_409ff26e8ae8c248, []int{8}
+	return fileDescriptor_raft_cmdpb_a73b112e07bde3d6, []int{10}
 }
 func (m *Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -554,22 +673,30 @@ func (m *Request) GetSnap() *SnapRequest {
 	return nil
 }
 
+func (m *Request) GetDeleteRange() *DeleteRangeRequest {
+	if m != nil {
+		return m.DeleteRange
+	}
+	return nil
+}
+
 type Response struct {
-	CmdType              CmdType         `protobuf:"varint,1,opt,name=cmd_type,json=cmdType,proto3,enum=raft_cmdpb.CmdType" json:"cmd_type,omitempty"`
-	Get                  *GetResponse    `protobuf:"bytes,2,opt,name=get" json:"get,omitempty"`
-	Put                  *PutResponse    `protobuf:"bytes,4,opt,name=put" json:"put,omitempty"`
-	Delete               *DeleteResponse `protobuf:"bytes,5,opt,name=delete" json:"delete,omitempty"`
-	Snap                 *SnapResponse   `protobuf:"bytes,6,opt,name=snap" json:"snap,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	CmdType              CmdType              `protobuf:"varint,1,opt,name=cmd_type,json=cmdType,proto3,enum=raft_cmdpb.CmdType" json:"cmd_type,omitempty"`
+	Get                  *GetResponse         `protobuf:"bytes,2,opt,name=get" json:"get,omitempty"`
+	Put                  *PutResponse         `protobuf:"bytes,4,opt,name=put" json:"put,omitempty"`
+	Delete               *DeleteResponse      `protobuf:"bytes,5,opt,name=delete" json:"delete,omitempty"`
+	Snap                 *SnapResponse        `protobuf:"bytes,6,opt,name=snap" json:"snap,omitempty"`
+	DeleteRange          *DeleteRangeResponse `protobuf:"bytes,7,opt,name=delete_range,json=deleteRange" json:"delete_range,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *Response) Reset()         { *m = Response{} }
 func (m *Response) String() string { return proto.CompactTextString(m) }
 func (*Response) ProtoMessage()    {}
 func (*Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_409ff26e8ae8c248, []int{9}
+	return fileDescriptor_raft_cmdpb_a73b112e07bde3d6, []int{11}
 }
 func (m *Response) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -633,6 +760,13 @@ func (m *Response) GetSnap() *SnapResponse {
 	return nil
 }
 
+func (m *Response) GetDeleteRange() *DeleteRangeResponse {
+	if m != nil {
+		return m.DeleteRange
+	}
+	return nil
+}
+
 type ChangePeerRequest struct {
 	// This can be only called in internal Raftstore now.
 	ChangeType           eraftpb.ConfChangeType `protobuf:"varint,1,opt,name=change_type,json=changeType,proto3,enum=eraftpb.ConfChangeType" json:"change_type,omitempty"`
@@ -646,7 +780,7 @@ func (m *ChangePeerRequest) Reset()         { *m = ChangePeerRequest{} }
 func (m *ChangePeerRequest) String() string { return proto.CompactTextString(m) }
 func (*ChangePeerRequest) ProtoMessage()    {}
 func (*ChangePeerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_409ff26e8ae8c248, []int{10}
+	return fileDescriptor_raft_cmdpb_a73b112e07bde3d6, []int{12}
 }
 func (m *ChangePeerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -700,7 +834,7 @@ func (m *ChangePeerResponse) Reset()         { *m = ChangePeerResponse{} }
 func (m *ChangePeerResponse) String() string { return proto.CompactTextString(m) }
 func (*ChangePeerResponse) ProtoMessage()    {}
 func (*ChangePeerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_409ff26e8ae8c248, []int{11}
+	return fileDescriptor_raft_cmdpb_a73b112e07bde3d6, []int{13}
 }
 func (m *ChangePeerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -736,6 +870,102 @@ func (m *ChangePeerResponse) GetRegion() *metapb.Region {
 	return nil
 }
 
+type ChangePeerV2Request struct {
+	// All the changes are applied at once through a joint configuration, an
+	// empty list of changes leaves the joint configuration.
+	Changes              []*ChangePeerRequest `protobuf:"bytes,1,rep,name=changes" json:"changes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ChangePeerV2Request) Reset()         { *m = ChangePeerV2Request{} }
+func (m *ChangePeerV2Request) String() string { return proto.CompactTextString(m) }
+func (*ChangePeerV2Request) ProtoMessage()    {}
+func (*ChangePeerV2Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_raft_cmdpb_a73b112e07bde3d6, []int{14}
+}
+func (m *ChangePeerV2Request) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChangePeerV2Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChangePeerV2Request.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ChangePeerV2Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangePeerV2Request.Merge(dst, src)
+}
+func (m *ChangePeerV2Request) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChangePeerV2Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangePeerV2Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChangePeerV2Request proto.InternalMessageInfo
+
+func (m *ChangePeerV2Request) GetChanges() []*ChangePeerRequest {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+type ChangePeerV2Response struct {
+	Region               *metapb.Region `protobuf:"bytes,1,opt,name=region" json:"region,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ChangePeerV2Response) Reset()         { *m = ChangePeerV2Response{} }
+func (m *ChangePeerV2Response) String() string { return proto.CompactTextString(m) }
+func (*ChangePeerV2Response) ProtoMessage()    {}
+func (*ChangePeerV2Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_raft_cmdpb_a73b112e07bde3d6, []int{15}
+}
+func (m *ChangePeerV2Response) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChangePeerV2Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChangePeerV2Response.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ChangePeerV2Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangePeerV2Response.Merge(dst, src)
+}
+func (m *ChangePeerV2Response) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChangePeerV2Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangePeerV2Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChangePeerV2Response proto.InternalMessageInfo
+
+func (m *ChangePeerV2Response) GetRegion() *metapb.Region {
+	if m != nil {
+		return m.Region
+	}
+	return nil
+}
+
 type SplitRequest struct {
 	// This can be only called in internal Raftstore now.
 	// The split_key has to exist in the splitting region.
@@ -755,7 +985,7 @@ func (m *SplitRequest) Reset()         { *m = SplitRequest{} }
 func (m *SplitRequest) String() string { return proto.CompactTextString(m) }
 func (*SplitRequest) ProtoMessage()    {}
 func (*SplitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_409ff26e8ae8c248, []int{12}
+	return fileDescriptor_raft_cmdpb_a73b112e07bde3d6, []int{16}
 }
 func (m *SplitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -817,7 +1047,7 @@ func (m *SplitResponse) Reset()         { *m = SplitResponse{} }
 func (m *SplitResponse) String() string { return proto.CompactTextString(m) }
 func (*SplitResponse) ProtoMessage()    {}
 func (*SplitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_409ff26e8ae8c248, []int{13}
+	return fileDescriptor_raft_cmdpb_a73b112e07bde3d6, []int{17}
 }
 func (m *SplitResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -865,7 +1095,7 @@ func (m *CompactLogRequest) Reset()         { *m = CompactLogRequest{} }
 func (m *CompactLogRequest) String() string { return proto.CompactTextString(m) }
 func (*CompactLogRequest) ProtoMessage()    {}
 func (*CompactLogRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_409ff26e8ae8c248, []int{14}
+	return fileDescriptor_raft_cmdpb_a73b112e07bde3d6, []int{18}
 }
 func (m *CompactLogRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -918,7 +1148,7 @@ func (m *CompactLogResponse) Reset()         { *m = CompactLogResponse{} }
 func (m *CompactLogResponse) String() string { return proto.CompactTextString(m) }
 func (*CompactLogResponse) ProtoMessage()    {}
 func (*CompactLogResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_409ff26e8ae8c248, []int{15}
+	return fileDescriptor_raft_cmdpb_a73b112e07bde3d6, []int{19}
 }
 func (m *CompactLogResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -958,7 +1188,7 @@ func (m *TransferLeaderRequest) Reset()         { *m = TransferLeaderRequest{} }
 func (m *TransferLeaderRequest) String() string { return proto.CompactTextString(m) }
 func (*TransferLeaderRequest) ProtoMessage()    {}
 func (*TransferLeaderRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_409ff26e8ae8c248, []int{16}
+	return fileDescriptor_raft_cmdpb_a73b112e07bde3d6, []int{20}
 }
 func (m *TransferLeaderRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1004,7 +1234,7 @@ func (m *TransferLeaderResponse) Reset()         { *m = TransferLeaderResponse{}
 func (m *TransferLeaderResponse) String() string { return proto.CompactTextString(m) }
 func (*TransferLeaderResponse) ProtoMessage()    {}
 func (*TransferLeaderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_409ff26e8ae8c248, []int{17}
+	return fileDescriptor_raft_cmdpb_a73b112e07bde3d6, []int{21}
 }
 func (m *TransferLeaderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1033,29 +1263,28 @@ func (m *TransferLeaderResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TransferLeaderResponse proto.InternalMessageInfo
 
-type AdminRequest struct {
-	CmdType              AdminCmdType           `protobuf:"varint,1,opt,name=cmd_type,json=cmdType,proto3,enum=raft_cmdpb.AdminCmdType" json:"cmd_type,omitempty"`
-	ChangePeer           *ChangePeerRequest     `protobuf:"bytes,2,opt,name=change_peer,json=changePeer" json:"change_peer,omitempty"`
-	CompactLog           *CompactLogRequest     `protobuf:"bytes,4,opt,name=compact_log,json=compactLog" json:"compact_log,omitempty"`
-	TransferLeader       *TransferLeaderRequest `protobuf:"bytes,5,opt,name=transfer_leader,json=transferLeader" json:"transfer_leader,omitempty"`
-	Split                *SplitRequest          `protobuf:"bytes,10,opt,name=split" json:"split,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
-	XXX_unrecognized     []byte                 `json:"-"`
-	XXX_sizecache        int32                  `json:"-"`
+type PrepareMergeRequest struct {
+	// The min matched index of all the peers, the logs after it are carried
+	// by CommitMerge to let the lagging peers of the source region catch up.
+	MinIndex             uint64         `protobuf:"varint,1,opt,name=min_index,json=minIndex,proto3" json:"min_index,omitempty"`
+	Target               *metapb.Region `protobuf:"bytes,2,opt,name=target" json:"target,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func (m *AdminRequest) Reset()         { *m = AdminRequest{} }
-func (m *AdminRequest) String() string { return proto.CompactTextString(m) }
-func (*AdminRequest) ProtoMessage()    {}
-func (*AdminRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_409ff26e8ae8c248, []int{18}
+func (m *PrepareMergeRequest) Reset()         { *m = PrepareMergeRequest{} }
+func (m *PrepareMergeRequest) String() string { return proto.CompactTextString(m) }
+func (*PrepareMergeRequest) ProtoMessage()    {}
+func (*PrepareMergeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_raft_cmdpb_a73b112e07bde3d6, []int{22}
 }
-func (m *AdminRequest) XXX_Unmarshal(b []byte) error {
+func (m *PrepareMergeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AdminRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PrepareMergeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AdminRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PrepareMergeRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1065,76 +1294,93 @@ func (m *AdminRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (dst *AdminRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AdminRequest.Merge(dst, src)
+func (dst *PrepareMergeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrepareMergeRequest.Merge(dst, src)
 }
-func (m *AdminRequest) XXX_Size() int {
+func (m *PrepareMergeRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *AdminRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AdminRequest.DiscardUnknown(m)
+func (m *PrepareMergeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrepareMergeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AdminRequest proto.InternalMessageInfo
+var xxx_messageInfo_PrepareMergeRequest proto.InternalMessageInfo
 
-func (m *AdminRequest) GetCmdType() AdminCmdType {
+func (m *PrepareMergeRequest) GetMinIndex() uint64 {
 	if m != nil {
-		return m.CmdType
+		return m.MinIndex
 	}
-	return AdminCmdType_InvalidAdmin
+	return 0
 }
 
-func (m *AdminRequest) GetChangePeer() *ChangePeerRequest {
+func (m *PrepareMergeRequest) GetTarget() *metapb.Region {
 	if m != nil {
-		return m.ChangePeer
+		return m.Target
 	}
 	return nil
 }
 
-func (m *AdminRequest) GetCompactLog() *CompactLogRequest {
-	if m != nil {
-		return m.CompactLog
-	}
-	return nil
+type PrepareMergeResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AdminRequest) GetTransferLeader() *TransferLeaderRequest {
-	if m != nil {
-		return m.TransferLeader
-	}
-	return nil
+func (m *PrepareMergeResponse) Reset()         { *m = PrepareMergeResponse{} }
+func (m *PrepareMergeResponse) String() string { return proto.CompactTextString(m) }
+func (*PrepareMergeResponse) ProtoMessage()    {}
+func (*PrepareMergeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_raft_cmdpb_a73b112e07bde3d6, []int{23}
 }
-
-func (m *AdminRequest) GetSplit() *SplitRequest {
-	if m != nil {
-		return m.Split
+func (m *PrepareMergeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrepareMergeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrepareMergeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (dst *PrepareMergeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrepareMergeResponse.Merge(dst, src)
+}
+func (m *PrepareMergeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrepareMergeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrepareMergeResponse.DiscardUnknown(m)
 }
 
-type AdminResponse struct {
-	CmdType              AdminCmdType            `protobuf:"varint,1,opt,name=cmd_type,json=cmdType,proto3,enum=raft_cmdpb.AdminCmdType" json:"cmd_type,omitempty"`
-	ChangePeer           *ChangePeerResponse     `protobuf:"bytes,2,opt,name=change_peer,json=changePeer" json:"change_peer,omitempty"`
-	CompactLog           *CompactLogResponse     `protobuf:"bytes,4,opt,name=compact_log,json=compactLog" json:"compact_log,omitempty"`
-	TransferLeader       *TransferLeaderResponse `protobuf:"bytes,5,opt,name=transfer_leader,json=transferLeader" json:"transfer_leader,omitempty"`
-	Split                *SplitResponse          `protobuf:"bytes,10,opt,name=split" json:"split,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
-	XXX_unrecognized     []byte                  `json:"-"`
-	XXX_sizecache        int32                   `json:"-"`
+var xxx_messageInfo_PrepareMergeResponse proto.InternalMessageInfo
+
+type CommitMergeRequest struct {
+	Source *metapb.Region `protobuf:"bytes,1,opt,name=source" json:"source,omitempty"`
+	// The index of the PrepareMerge log of the source region.
+	Commit               uint64           `protobuf:"varint,2,opt,name=commit,proto3" json:"commit,omitempty"`
+	Entries              []*eraftpb.Entry `protobuf:"bytes,3,rep,name=entries" json:"entries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *AdminResponse) Reset()         { *m = AdminResponse{} }
-func (m *AdminResponse) String() string { return proto.CompactTextString(m) }
-func (*AdminResponse) ProtoMessage()    {}
-func (*AdminResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_409ff26e8ae8c248, []int{19}
+func (m *CommitMergeRequest) Reset()         { *m = CommitMergeRequest{} }
+func (m *CommitMergeRequest) String() string { return proto.CompactTextString(m) }
+func (*CommitMergeRequest) ProtoMessage()    {}
+func (*CommitMergeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_raft_cmdpb_a73b112e07bde3d6, []int{24}
 }
-func (m *AdminResponse) XXX_Unmarshal(b []byte) error {
+func (m *CommitMergeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AdminResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CommitMergeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AdminResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CommitMergeRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1144,75 +1390,57 @@ func (m *AdminResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (dst *AdminResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AdminResponse.Merge(dst, src)
+func (dst *CommitMergeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitMergeRequest.Merge(dst, src)
 }
-func (m *AdminResponse) XXX_Size() int {
+func (m *CommitMergeRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *AdminResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_AdminResponse.DiscardUnknown(m)
+func (m *CommitMergeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitMergeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AdminResponse proto.InternalMessageInfo
-
-func (m *AdminResponse) GetCmdType() AdminCmdType {
-	if m != nil {
-		return m.CmdType
-	}
-	return AdminCmdType_InvalidAdmin
-}
+var xxx_messageInfo_CommitMergeRequest proto.InternalMessageInfo
 
-func (m *AdminResponse) GetChangePeer() *ChangePeerResponse {
+func (m *CommitMergeRequest) GetSource() *metapb.Region {
 	if m != nil {
-		return m.ChangePeer
+		return m.Source
 	}
 	return nil
 }
 
-func (m *AdminResponse) GetCompactLog() *CompactLogResponse {
+func (m *CommitMergeRequest) GetCommit() uint64 {
 	if m != nil {
-		return m.CompactLog
+		return m.Commit
 	}
-	return nil
+	return 0
 }
 
-func (m *AdminResponse) GetTransferLeader() *TransferLeaderResponse {
+func (m *CommitMergeRequest) GetEntries() []*eraftpb.Entry {
 	if m != nil {
-		return m.TransferLeader
+		return m.Entries
 	}
 	return nil
 }
 
-func (m *AdminResponse) GetSplit() *SplitResponse {
-	if m != nil {
-		return m.Split
-	}
-	return nil
+type CommitMergeResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type RaftRequestHeader struct {
-	RegionId             uint64              `protobuf:"varint,1,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"`
-	Peer                 *metapb.Peer        `protobuf:"bytes,2,opt,name=peer" json:"peer,omitempty"`
-	RegionEpoch          *metapb.RegionEpoch `protobuf:"bytes,4,opt,name=region_epoch,json=regionEpoch" json:"region_epoch,omitempty"`
-	Term                 uint64              `protobuf:"varint,5,opt,name=term,proto3" json:"term,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+func (m *CommitMergeResponse) Reset()         { *m = CommitMergeResponse{} }
+func (m *CommitMergeResponse) String() string { return proto.CompactTextString(m) }
+func (*CommitMergeResponse) ProtoMessage()    {}
+func (*CommitMergeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_raft_cmdpb_a73b112e07bde3d6, []int{25}
 }
-
-func (m *RaftRequestHeader) Reset()         { *m = RaftRequestHeader{} }
-func (m *RaftRequestHeader) String() string { return proto.CompactTextString(m) }
-func (*RaftRequestHeader) ProtoMessage()    {}
-func (*RaftRequestHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_409ff26e8ae8c248, []int{20}
-}
-func (m *RaftRequestHeader) XXX_Unmarshal(b []byte) error {
+func (m *CommitMergeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RaftRequestHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CommitMergeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RaftRequestHeader.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CommitMergeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1222,67 +1450,84 @@ func (m *RaftRequestHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (dst *RaftRequestHeader) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RaftRequestHeader.Merge(dst, src)
+func (dst *CommitMergeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitMergeResponse.Merge(dst, src)
 }
-func (m *RaftRequestHeader) XXX_Size() int {
+func (m *CommitMergeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *RaftRequestHeader) XXX_DiscardUnknown() {
-	xxx_messageInfo_RaftRequestHeader.DiscardUnknown(m)
+func (m *CommitMergeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitMergeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RaftRequestHeader proto.InternalMessageInfo
+var xxx_messageInfo_CommitMergeResponse proto.InternalMessageInfo
 
-func (m *RaftRequestHeader) GetRegionId() uint64 {
-	if m != nil {
-		return m.RegionId
-	}
-	return 0
+type RollbackMergeRequest struct {
+	// The index of the PrepareMerge log to roll back.
+	Commit               uint64   `protobuf:"varint,1,opt,name=commit,proto3" json:"commit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RaftRequestHeader) GetPeer() *metapb.Peer {
-	if m != nil {
-		return m.Peer
-	}
-	return nil
+func (m *RollbackMergeRequest) Reset()         { *m = RollbackMergeRequest{} }
+func (m *RollbackMergeRequest) String() string { return proto.CompactTextString(m) }
+func (*RollbackMergeRequest) ProtoMessage()    {}
+func (*RollbackMergeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_raft_cmdpb_a73b112e07bde3d6, []int{26}
 }
-
-func (m *RaftRequestHeader) GetRegionEpoch() *metapb.RegionEpoch {
-	if m != nil {
-		return m.RegionEpoch
+func (m *RollbackMergeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RollbackMergeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RollbackMergeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (dst *RollbackMergeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollbackMergeRequest.Merge(dst, src)
+}
+func (m *RollbackMergeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RollbackMergeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollbackMergeRequest.DiscardUnknown(m)
 }
 
-func (m *RaftRequestHeader) GetTerm() uint64 {
+var xxx_messageInfo_RollbackMergeRequest proto.InternalMessageInfo
+
+func (m *RollbackMergeRequest) GetCommit() uint64 {
 	if m != nil {
-		return m.Term
+		return m.Commit
 	}
 	return 0
 }
 
-type RaftResponseHeader struct {
-	Error                *errorpb.Error `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
-	Uuid                 []byte         `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	CurrentTerm          uint64         `protobuf:"varint,3,opt,name=current_term,json=currentTerm,proto3" json:"current_term,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+type RollbackMergeResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RaftResponseHeader) Reset()         { *m = RaftResponseHeader{} }
-func (m *RaftResponseHeader) String() string { return proto.CompactTextString(m) }
-func (*RaftResponseHeader) ProtoMessage()    {}
-func (*RaftResponseHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_409ff26e8ae8c248, []int{21}
+func (m *RollbackMergeResponse) Reset()         { *m = RollbackMergeResponse{} }
+func (m *RollbackMergeResponse) String() string { return proto.CompactTextString(m) }
+func (*RollbackMergeResponse) ProtoMessage()    {}
+func (*RollbackMergeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_raft_cmdpb_a73b112e07bde3d6, []int{27}
 }
-func (m *RaftResponseHeader) XXX_Unmarshal(b []byte) error {
+func (m *RollbackMergeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RaftResponseHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RollbackMergeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RaftResponseHeader.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RollbackMergeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1292,62 +1537,45 @@ func (m *RaftResponseHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (dst *RaftResponseHeader) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RaftResponseHeader.Merge(dst, src)
+func (dst *RollbackMergeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollbackMergeResponse.Merge(dst, src)
 }
-func (m *RaftResponseHeader) XXX_Size() int {
+func (m *RollbackMergeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *RaftResponseHeader) XXX_DiscardUnknown() {
-	xxx_messageInfo_RaftResponseHeader.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_RaftResponseHeader proto.InternalMessageInfo
-
-func (m *RaftResponseHeader) GetError() *errorpb.Error {
-	if m != nil {
-		return m.Error
-	}
-	return nil
-}
-
-func (m *RaftResponseHeader) GetUuid() []byte {
-	if m != nil {
-		return m.Uuid
-	}
-	return nil
+func (m *RollbackMergeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollbackMergeResponse.DiscardUnknown(m)
 }
 
-func (m *RaftResponseHeader) GetCurrentTerm() uint64 {
-	if m != nil {
-		return m.CurrentTerm
-	}
-	return 0
-}
+var xxx_messageInfo_RollbackMergeResponse proto.InternalMessageInfo
 
-type RaftCmdRequest struct {
-	Header *RaftRequestHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
-	// We can't enclose normal requests and administrator request
-	// at same time.
-	Requests             []*Request    `protobuf:"bytes,2,rep,name=requests" json:"requests,omitempty"`
-	AdminRequest         *AdminRequest `protobuf:"bytes,3,opt,name=admin_request,json=adminRequest" json:"admin_request,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+type AdminRequest struct {
+	CmdType              AdminCmdType           `protobuf:"varint,1,opt,name=cmd_type,json=cmdType,proto3,enum=raft_cmdpb.AdminCmdType" json:"cmd_type,omitempty"`
+	ChangePeer           *ChangePeerRequest     `protobuf:"bytes,2,opt,name=change_peer,json=changePeer" json:"change_peer,omitempty"`
+	CompactLog           *CompactLogRequest     `protobuf:"bytes,4,opt,name=compact_log,json=compactLog" json:"compact_log,omitempty"`
+	TransferLeader       *TransferLeaderRequest `protobuf:"bytes,5,opt,name=transfer_leader,json=transferLeader" json:"transfer_leader,omitempty"`
+	PrepareMerge         *PrepareMergeRequest   `protobuf:"bytes,7,opt,name=prepare_merge,json=prepareMerge" json:"prepare_merge,omitempty"`
+	CommitMerge          *CommitMergeRequest    `protobuf:"bytes,8,opt,name=commit_merge,json=commitMerge" json:"commit_merge,omitempty"`
+	RollbackMerge        *RollbackMergeRequest  `protobuf:"bytes,9,opt,name=rollback_merge,json=rollbackMerge" json:"rollback_merge,omitempty"`
+	Split                *SplitRequest          `protobuf:"bytes,10,opt,name=split" json:"split,omitempty"`
+	ChangePeerV2         *ChangePeerV2Request   `protobuf:"bytes,11,opt,name=change_peer_v2,json=changePeerV2" json:"change_peer_v2,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
 }
 
-func (m *RaftCmdRequest) Reset()         { *m = RaftCmdRequest{} }
-func (m *RaftCmdRequest) String() string { return proto.CompactTextString(m) }
-func (*RaftCmdRequest) ProtoMessage()    {}
-func (*RaftCmdRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_409ff26e8ae8c248, []int{22}
+func (m *AdminRequest) Reset()         { *m = AdminRequest{} }
+func (m *AdminRequest) String() string { return proto.CompactTextString(m) }
+func (*AdminRequest) ProtoMessage()    {}
+func (*AdminRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_raft_cmdpb_a73b112e07bde3d6, []int{28}
 }
-func (m *RaftCmdRequest) XXX_Unmarshal(b []byte) error {
+func (m *AdminRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RaftCmdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AdminRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RaftCmdRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AdminRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1357,60 +1585,108 @@ func (m *RaftCmdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (dst *RaftCmdRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RaftCmdRequest.Merge(dst, src)
+func (dst *AdminRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminRequest.Merge(dst, src)
 }
-func (m *RaftCmdRequest) XXX_Size() int {
+func (m *AdminRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RaftCmdRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RaftCmdRequest.DiscardUnknown(m)
+func (m *AdminRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RaftCmdRequest proto.InternalMessageInfo
+var xxx_messageInfo_AdminRequest proto.InternalMessageInfo
 
-func (m *RaftCmdRequest) GetHeader() *RaftRequestHeader {
+func (m *AdminRequest) GetCmdType() AdminCmdType {
 	if m != nil {
-		return m.Header
+		return m.CmdType
+	}
+	return AdminCmdType_InvalidAdmin
+}
+
+func (m *AdminRequest) GetChangePeer() *ChangePeerRequest {
+	if m != nil {
+		return m.ChangePeer
 	}
 	return nil
 }
 
-func (m *RaftCmdRequest) GetRequests() []*Request {
+func (m *AdminRequest) GetCompactLog() *CompactLogRequest {
 	if m != nil {
-		return m.Requests
+		return m.CompactLog
 	}
 	return nil
 }
 
-func (m *RaftCmdRequest) GetAdminRequest() *AdminRequest {
+func (m *AdminRequest) GetTransferLeader() *TransferLeaderRequest {
 	if m != nil {
-		return m.AdminRequest
+		return m.TransferLeader
 	}
 	return nil
 }
 
-type RaftCmdResponse struct {
-	Header               *RaftResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
-	Responses            []*Response         `protobuf:"bytes,2,rep,name=responses" json:"responses,omitempty"`
-	AdminResponse        *AdminResponse      `protobuf:"bytes,3,opt,name=admin_response,json=adminResponse" json:"admin_response,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+func (m *AdminRequest) GetPrepareMerge() *PrepareMergeRequest {
+	if m != nil {
+		return m.PrepareMerge
+	}
+	return nil
 }
 
-func (m *RaftCmdResponse) Reset()         { *m = RaftCmdResponse{} }
-func (m *RaftCmdResponse) String() string { return proto.CompactTextString(m) }
-func (*RaftCmdResponse) ProtoMessage()    {}
-func (*RaftCmdResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_409ff26e8ae8c248, []int{23}
+func (m *AdminRequest) GetCommitMerge() *CommitMergeRequest {
+	if m != nil {
+		return m.CommitMerge
+	}
+	return nil
 }
-func (m *RaftCmdResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *AdminRequest) GetRollbackMerge() *RollbackMergeRequest {
+	if m != nil {
+		return m.RollbackMerge
+	}
+	return nil
+}
+
+func (m *AdminRequest) GetSplit() *SplitRequest {
+	if m != nil {
+		return m.Split
+	}
+	return nil
+}
+
+func (m *AdminRequest) GetChangePeerV2() *ChangePeerV2Request {
+	if m != nil {
+		return m.ChangePeerV2
+	}
+	return nil
+}
+
+type AdminResponse struct {
+	CmdType              AdminCmdType            `protobuf:"varint,1,opt,name=cmd_type,json=cmdType,proto3,enum=raft_cmdpb.AdminCmdType" json:"cmd_type,omitempty"`
+	ChangePeer           *ChangePeerResponse     `protobuf:"bytes,2,opt,name=change_peer,json=changePeer" json:"change_peer,omitempty"`
+	CompactLog           *CompactLogResponse     `protobuf:"bytes,4,opt,name=compact_log,json=compactLog" json:"compact_log,omitempty"`
+	TransferLeader       *TransferLeaderResponse `protobuf:"bytes,5,opt,name=transfer_leader,json=transferLeader" json:"transfer_leader,omitempty"`
+	PrepareMerge         *PrepareMergeResponse   `protobuf:"bytes,7,opt,name=prepare_merge,json=prepareMerge" json:"prepare_merge,omitempty"`
+	CommitMerge          *CommitMergeResponse    `protobuf:"bytes,8,opt,name=commit_merge,json=commitMerge" json:"commit_merge,omitempty"`
+	RollbackMerge        *RollbackMergeResponse  `protobuf:"bytes,9,opt,name=rollback_merge,json=rollbackMerge" json:"rollback_merge,omitempty"`
+	Split                *SplitResponse          `protobuf:"bytes,10,opt,name=split" json:"split,omitempty"`
+	ChangePeerV2         *ChangePeerV2Response   `protobuf:"bytes,11,opt,name=change_peer_v2,json=changePeerV2" json:"change_peer_v2,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *AdminResponse) Reset()         { *m = AdminResponse{} }
+func (m *AdminResponse) String() string { return proto.CompactTextString(m) }
+func (*AdminResponse) ProtoMessage()    {}
+func (*AdminResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_raft_cmdpb_a73b112e07bde3d6, []int{29}
+}
+func (m *AdminResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RaftCmdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AdminResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RaftCmdResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AdminResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1420,360 +1696,399 @@ func (m *RaftCmdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (dst *RaftCmdResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RaftCmdResponse.Merge(dst, src)
+func (dst *AdminResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminResponse.Merge(dst, src)
 }
-func (m *RaftCmdResponse) XXX_Size() int {
+func (m *AdminResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *RaftCmdResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RaftCmdResponse.DiscardUnknown(m)
+func (m *AdminResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RaftCmdResponse proto.InternalMessageInfo
+var xxx_messageInfo_AdminResponse proto.InternalMessageInfo
 
-func (m *RaftCmdResponse) GetHeader() *RaftResponseHeader {
+func (m *AdminResponse) GetCmdType() AdminCmdType {
 	if m != nil {
-		return m.Header
+		return m.CmdType
+	}
+	return AdminCmdType_InvalidAdmin
+}
+
+func (m *AdminResponse) GetChangePeer() *ChangePeerResponse {
+	if m != nil {
+		return m.ChangePeer
 	}
 	return nil
 }
 
-func (m *RaftCmdResponse) GetResponses() []*Response {
+func (m *AdminResponse) GetCompactLog() *CompactLogResponse {
 	if m != nil {
-		return m.Responses
+		return m.CompactLog
 	}
 	return nil
 }
 
-func (m *RaftCmdResponse) GetAdminResponse() *AdminResponse {
+func (m *AdminResponse) GetTransferLeader() *TransferLeaderResponse {
 	if m != nil {
-		return m.AdminResponse
+		return m.TransferLeader
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*GetRequest)(nil), "raft_cmdpb.GetRequest")
-	proto.RegisterType((*GetResponse)(nil), "raft_cmdpb.GetResponse")
-	proto.RegisterType((*PutRequest)(nil), "raft_cmdpb.PutRequest")
-	proto.RegisterType((*PutResponse)(nil), "raft_cmdpb.PutResponse")
-	proto.RegisterType((*DeleteRequest)(nil), "raft_cmdpb.DeleteRequest")
-	proto.RegisterType((*DeleteResponse)(nil), "raft_cmdpb.DeleteResponse")
-	proto.RegisterType((*SnapRequest)(nil), "raft_cmdpb.SnapRequest")
-	proto.RegisterType((*SnapResponse)(nil), "raft_cmdpb.SnapResponse")
-	proto.RegisterType((*Request)(nil), "raft_cmdpb.Request")
-	proto.RegisterType((*Response)(nil), "raft_cmdpb.Response")
-	proto.RegisterType((*ChangePeerRequest)(nil), "raft_cmdpb.ChangePeerRequest")
-	proto.RegisterType((*ChangePeerResponse)(nil), "raft_cmdpb.ChangePeerResponse")
-	proto.RegisterType((*SplitRequest)(nil), "raft_cmdpb.SplitRequest")
-	proto.RegisterType((*SplitResponse)(nil), "raft_cmdpb.SplitResponse")
-	proto.RegisterType((*CompactLogRequest)(nil), "raft_cmdpb.CompactLogRequest")
-	proto.RegisterType((*CompactLogResponse)(nil), "raft_cmdpb.CompactLogResponse")
-	proto.RegisterType((*TransferLeaderRequest)(nil), "raft_cmdpb.TransferLeaderRequest")
-	proto.RegisterType((*TransferLeaderResponse)(nil), "raft_cmdpb.TransferLeaderResponse")
-	proto.RegisterType((*AdminRequest)(nil), "raft_cmdpb.AdminRequest")
-	proto.RegisterType((*AdminResponse)(nil), "raft_cmdpb.AdminResponse")
-	proto.RegisterType((*RaftRequestHeader)(nil), "raft_cmdpb.RaftRequestHeader")
-	proto.RegisterType((*RaftResponseHeader)(nil), "raft_cmdpb.RaftResponseHeader")
-	proto.RegisterType((*RaftCmdRequest)(nil), "raft_cmdpb.RaftCmdRequest")
-	proto.RegisterType((*RaftCmdResponse)(nil), "raft_cmdpb.RaftCmdResponse")
-	proto.RegisterEnum("raft_cmdpb.CmdType", CmdType_name, CmdType_value)
-	proto.RegisterEnum("raft_cmdpb.AdminCmdType", AdminCmdType_name, AdminCmdType_value)
-}
-func (m *GetRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *AdminResponse) GetPrepareMerge() *PrepareMergeResponse {
+	if m != nil {
+		return m.PrepareMerge
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GetRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Cf) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(len(m.Cf)))
-		i += copy(dAtA[i:], m.Cf)
-	}
-	if len(m.Key) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(len(m.Key)))
-		i += copy(dAtA[i:], m.Key)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *AdminResponse) GetCommitMerge() *CommitMergeResponse {
+	if m != nil {
+		return m.CommitMerge
 	}
-	return i, nil
+	return nil
 }
 
-func (m *GetResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *AdminResponse) GetRollbackMerge() *RollbackMergeResponse {
+	if m != nil {
+		return m.RollbackMerge
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GetResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Value) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(len(m.Value)))
-		i += copy(dAtA[i:], m.Value)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *AdminResponse) GetSplit() *SplitResponse {
+	if m != nil {
+		return m.Split
 	}
-	return i, nil
+	return nil
 }
 
-func (m *PutRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *AdminResponse) GetChangePeerV2() *ChangePeerV2Response {
+	if m != nil {
+		return m.ChangePeerV2
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *PutRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Cf) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(len(m.Cf)))
-		i += copy(dAtA[i:], m.Cf)
-	}
-	if len(m.Key) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(len(m.Key)))
-		i += copy(dAtA[i:], m.Key)
-	}
-	if len(m.Value) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(len(m.Value)))
-		i += copy(dAtA[i:], m.Value)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+type RaftRequestHeader struct {
+	RegionId    uint64              `protobuf:"varint,1,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"`
+	Peer        *metapb.Peer        `protobuf:"bytes,2,opt,name=peer" json:"peer,omitempty"`
+	RegionEpoch *metapb.RegionEpoch `protobuf:"bytes,4,opt,name=region_epoch,json=regionEpoch" json:"region_epoch,omitempty"`
+	Term        uint64              `protobuf:"varint,5,opt,name=term,proto3" json:"term,omitempty"`
+	// A read-only command which can be served by a follower, see kvrpcpb.Context.
+	ReplicaRead          bool     `protobuf:"varint,6,opt,name=replica_read,json=replicaRead,proto3" json:"replica_read,omitempty"`
+	StaleRead            bool     `protobuf:"varint,7,opt,name=stale_read,json=staleRead,proto3" json:"stale_read,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PutResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *RaftRequestHeader) Reset()         { *m = RaftRequestHeader{} }
+func (m *RaftRequestHeader) String() string { return proto.CompactTextString(m) }
+func (*RaftRequestHeader) ProtoMessage()    {}
+func (*RaftRequestHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_raft_cmdpb_a73b112e07bde3d6, []int{30}
 }
-
-func (m *PutResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *RaftRequestHeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RaftRequestHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RaftRequestHeader.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (dst *RaftRequestHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RaftRequestHeader.Merge(dst, src)
+}
+func (m *RaftRequestHeader) XXX_Size() int {
+	return m.Size()
+}
+func (m *RaftRequestHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_RaftRequestHeader.DiscardUnknown(m)
 }
 
-func (m *DeleteRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RaftRequestHeader proto.InternalMessageInfo
+
+func (m *RaftRequestHeader) GetRegionId() uint64 {
+	if m != nil {
+		return m.RegionId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *DeleteRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Cf) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(len(m.Cf)))
-		i += copy(dAtA[i:], m.Cf)
-	}
-	if len(m.Key) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(len(m.Key)))
-		i += copy(dAtA[i:], m.Key)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *RaftRequestHeader) GetPeer() *metapb.Peer {
+	if m != nil {
+		return m.Peer
 	}
-	return i, nil
+	return nil
 }
 
-func (m *DeleteResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *RaftRequestHeader) GetRegionEpoch() *metapb.RegionEpoch {
+	if m != nil {
+		return m.RegionEpoch
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *DeleteResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *RaftRequestHeader) GetTerm() uint64 {
+	if m != nil {
+		return m.Term
 	}
-	return i, nil
+	return 0
 }
 
-func (m *SnapRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *RaftRequestHeader) GetReplicaRead() bool {
+	if m != nil {
+		return m.ReplicaRead
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *SnapRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *RaftRequestHeader) GetStaleRead() bool {
+	if m != nil {
+		return m.StaleRead
 	}
-	return i, nil
+	return false
 }
 
-func (m *SnapResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type RaftResponseHeader struct {
+	Error                *errorpb.Error `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+	Uuid                 []byte         `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	CurrentTerm          uint64         `protobuf:"varint,3,opt,name=current_term,json=currentTerm,proto3" json:"current_term,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func (m *SnapResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Region != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Region.Size()))
-		n1, err := m.Region.MarshalTo(dAtA[i:])
+func (m *RaftResponseHeader) Reset()         { *m = RaftResponseHeader{} }
+func (m *RaftResponseHeader) String() string { return proto.CompactTextString(m) }
+func (*RaftResponseHeader) ProtoMessage()    {}
+func (*RaftResponseHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_raft_cmdpb_a73b112e07bde3d6, []int{31}
+}
+func (m *RaftResponseHeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RaftResponseHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RaftResponseHeader.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n1
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+}
+func (dst *RaftResponseHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RaftResponseHeader.Merge(dst, src)
+}
+func (m *RaftResponseHeader) XXX_Size() int {
+	return m.Size()
+}
+func (m *RaftResponseHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_RaftResponseHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RaftResponseHeader proto.InternalMessageInfo
+
+func (m *RaftResponseHeader) GetError() *errorpb.Error {
+	if m != nil {
+		return m.Error
 	}
-	return i, nil
+	return nil
 }
 
-func (m *Request) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *RaftResponseHeader) GetUuid() []byte {
+	if m != nil {
+		return m.Uuid
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Request) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.CmdType != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.CmdType))
+func (m *RaftResponseHeader) GetCurrentTerm() uint64 {
+	if m != nil {
+		return m.CurrentTerm
 	}
-	if m.Get != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Get.Size()))
-		n2, err := m.Get.MarshalTo(dAtA[i:])
+	return 0
+}
+
+type RaftCmdRequest struct {
+	Header *RaftRequestHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	// We can't enclose normal requests and administrator request
+	// at same time.
+	Requests             []*Request    `protobuf:"bytes,2,rep,name=requests" json:"requests,omitempty"`
+	AdminRequest         *AdminRequest `protobuf:"bytes,3,opt,name=admin_request,json=adminRequest" json:"admin_request,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *RaftCmdRequest) Reset()         { *m = RaftCmdRequest{} }
+func (m *RaftCmdRequest) String() string { return proto.CompactTextString(m) }
+func (*RaftCmdRequest) ProtoMessage()    {}
+func (*RaftCmdRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_raft_cmdpb_a73b112e07bde3d6, []int{32}
+}
+func (m *RaftCmdRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RaftCmdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RaftCmdRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n2
+		return b[:n], nil
 	}
-	if m.Put != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Put.Size()))
-		n3, err := m.Put.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n3
+}
+func (dst *RaftCmdRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RaftCmdRequest.Merge(dst, src)
+}
+func (m *RaftCmdRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RaftCmdRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RaftCmdRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RaftCmdRequest proto.InternalMessageInfo
+
+func (m *RaftCmdRequest) GetHeader() *RaftRequestHeader {
+	if m != nil {
+		return m.Header
 	}
-	if m.Delete != nil {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Delete.Size()))
-		n4, err := m.Delete.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n4
+	return nil
+}
+
+func (m *RaftCmdRequest) GetRequests() []*Request {
+	if m != nil {
+		return m.Requests
 	}
-	if m.Snap != nil {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Snap.Size()))
-		n5, err := m.Snap.MarshalTo(dAtA[i:])
+	return nil
+}
+
+func (m *RaftCmdRequest) GetAdminRequest() *AdminRequest {
+	if m != nil {
+		return m.AdminRequest
+	}
+	return nil
+}
+
+type RaftCmdResponse struct {
+	Header               *RaftResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	Responses            []*Response         `protobuf:"bytes,2,rep,name=responses" json:"responses,omitempty"`
+	AdminResponse        *AdminResponse      `protobuf:"bytes,3,opt,name=admin_response,json=adminResponse" json:"admin_response,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *RaftCmdResponse) Reset()         { *m = RaftCmdResponse{} }
+func (m *RaftCmdResponse) String() string { return proto.CompactTextString(m) }
+func (*RaftCmdResponse) ProtoMessage()    {}
+func (*RaftCmdResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_raft_cmdpb_a73b112e07bde3d6, []int{33}
+}
+func (m *RaftCmdResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RaftCmdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RaftCmdResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n5
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+}
+func (dst *RaftCmdResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RaftCmdResponse.Merge(dst, src)
+}
+func (m *RaftCmdResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RaftCmdResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RaftCmdResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RaftCmdResponse proto.InternalMessageInfo
+
+func (m *RaftCmdResponse) GetHeader() *RaftResponseHeader {
+	if m != nil {
+		return m.Header
 	}
-	return i, nil
+	return nil
 }
 
-func (m *Response) Marshal() (dAtA []byte, err error) {
+func (m *RaftCmdResponse) GetResponses() []*Response {
+	if m != nil {
+		return m.Responses
+	}
+	return nil
+}
+
+func (m *RaftCmdResponse) GetAdminResponse() *AdminResponse {
+	if m != nil {
+		return m.AdminResponse
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GetRequest)(nil), "raft_cmdpb.GetRequest")
+	proto.RegisterType((*GetResponse)(nil), "raft_cmdpb.GetResponse")
+	proto.RegisterType((*PutRequest)(nil), "raft_cmdpb.PutRequest")
+	proto.RegisterType((*PutResponse)(nil), "raft_cmdpb.PutResponse")
+	proto.RegisterType((*DeleteRequest)(nil), "raft_cmdpb.DeleteRequest")
+	proto.RegisterType((*DeleteResponse)(nil), "raft_cmdpb.DeleteResponse")
+	proto.RegisterType((*DeleteRangeRequest)(nil), "raft_cmdpb.DeleteRangeRequest")
+	proto.RegisterType((*DeleteRangeResponse)(nil), "raft_cmdpb.DeleteRangeResponse")
+	proto.RegisterType((*SnapRequest)(nil), "raft_cmdpb.SnapRequest")
+	proto.RegisterType((*SnapResponse)(nil), "raft_cmdpb.SnapResponse")
+	proto.RegisterType((*Request)(nil), "raft_cmdpb.Request")
+	proto.RegisterType((*Response)(nil), "raft_cmdpb.Response")
+	proto.RegisterType((*ChangePeerRequest)(nil), "raft_cmdpb.ChangePeerRequest")
+	proto.RegisterType((*ChangePeerResponse)(nil), "raft_cmdpb.ChangePeerResponse")
+	proto.RegisterType((*ChangePeerV2Request)(nil), "raft_cmdpb.ChangePeerV2Request")
+	proto.RegisterType((*ChangePeerV2Response)(nil), "raft_cmdpb.ChangePeerV2Response")
+	proto.RegisterType((*SplitRequest)(nil), "raft_cmdpb.SplitRequest")
+	proto.RegisterType((*SplitResponse)(nil), "raft_cmdpb.SplitResponse")
+	proto.RegisterType((*CompactLogRequest)(nil), "raft_cmdpb.CompactLogRequest")
+	proto.RegisterType((*CompactLogResponse)(nil), "raft_cmdpb.CompactLogResponse")
+	proto.RegisterType((*TransferLeaderRequest)(nil), "raft_cmdpb.TransferLeaderRequest")
+	proto.RegisterType((*TransferLeaderResponse)(nil), "raft_cmdpb.TransferLeaderResponse")
+	proto.RegisterType((*PrepareMergeRequest)(nil), "raft_cmdpb.PrepareMergeRequest")
+	proto.RegisterType((*PrepareMergeResponse)(nil), "raft_cmdpb.PrepareMergeResponse")
+	proto.RegisterType((*CommitMergeRequest)(nil), "raft_cmdpb.CommitMergeRequest")
+	proto.RegisterType((*CommitMergeResponse)(nil), "raft_cmdpb.CommitMergeResponse")
+	proto.RegisterType((*RollbackMergeRequest)(nil), "raft_cmdpb.RollbackMergeRequest")
+	proto.RegisterType((*RollbackMergeResponse)(nil), "raft_cmdpb.RollbackMergeResponse")
+	proto.RegisterType((*AdminRequest)(nil), "raft_cmdpb.AdminRequest")
+	proto.RegisterType((*AdminResponse)(nil), "raft_cmdpb.AdminResponse")
+	proto.RegisterType((*RaftRequestHeader)(nil), "raft_cmdpb.RaftRequestHeader")
+	proto.RegisterType((*RaftResponseHeader)(nil), "raft_cmdpb.RaftResponseHeader")
+	proto.RegisterType((*RaftCmdRequest)(nil), "raft_cmdpb.RaftCmdRequest")
+	proto.RegisterType((*RaftCmdResponse)(nil), "raft_cmdpb.RaftCmdResponse")
+	proto.RegisterEnum("raft_cmdpb.CmdType", CmdType_name, CmdType_value)
+	proto.RegisterEnum("raft_cmdpb.AdminCmdType", AdminCmdType_name, AdminCmdType_value)
+}
+func (m *GetRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1783,55 +2098,49 @@ func (m *Response) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Response) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.CmdType != 0 {
-		dAtA[i] = 0x8
+	if len(m.Cf) > 0 {
+		dAtA[i] = 0xa
 		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.CmdType))
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(len(m.Cf)))
+		i += copy(dAtA[i:], m.Cf)
 	}
-	if m.Get != nil {
+	if len(m.Key) > 0 {
 		dAtA[i] = 0x12
 		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Get.Size()))
-		n6, err := m.Get.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n6
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
 	}
-	if m.Put != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Put.Size()))
-		n7, err := m.Put.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n7
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Delete != nil {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Delete.Size()))
-		n8, err := m.Delete.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n8
+	return i, nil
+}
+
+func (m *GetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if m.Snap != nil {
-		dAtA[i] = 0x32
+	return dAtA[:n], nil
+}
+
+func (m *GetResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		dAtA[i] = 0xa
 		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Snap.Size()))
-		n9, err := m.Snap.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n9
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(len(m.Value)))
+		i += copy(dAtA[i:], m.Value)
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -1839,7 +2148,7 @@ func (m *Response) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *ChangePeerRequest) Marshal() (dAtA []byte, err error) {
+func (m *PutRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1849,25 +2158,28 @@ func (m *ChangePeerRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ChangePeerRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *PutRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.ChangeType != 0 {
-		dAtA[i] = 0x8
+	if len(m.Cf) > 0 {
+		dAtA[i] = 0xa
 		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.ChangeType))
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(len(m.Cf)))
+		i += copy(dAtA[i:], m.Cf)
 	}
-	if m.Peer != nil {
+	if len(m.Key) > 0 {
 		dAtA[i] = 0x12
 		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Peer.Size()))
-		n10, err := m.Peer.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n10
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if len(m.Value) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(len(m.Value)))
+		i += copy(dAtA[i:], m.Value)
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -1875,7 +2187,7 @@ func (m *ChangePeerRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *ChangePeerResponse) Marshal() (dAtA []byte, err error) {
+func (m *PutResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1885,28 +2197,18 @@ func (m *ChangePeerResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ChangePeerResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *PutResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Region != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Region.Size()))
-		n11, err := m.Region.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n11
-	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *SplitRequest) Marshal() (dAtA []byte, err error) {
+func (m *DeleteRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1916,38 +2218,22 @@ func (m *SplitRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SplitRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *DeleteRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.SplitKey) > 0 {
+	if len(m.Cf) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(len(m.SplitKey)))
-		i += copy(dAtA[i:], m.SplitKey)
-	}
-	if m.NewRegionId != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.NewRegionId))
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(len(m.Cf)))
+		i += copy(dAtA[i:], m.Cf)
 	}
-	if len(m.NewPeerIds) > 0 {
-		dAtA13 := make([]byte, len(m.NewPeerIds)*10)
-		var j12 int
-		for _, num := range m.NewPeerIds {
-			for num >= 1<<7 {
-				dAtA13[j12] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j12++
-			}
-			dAtA13[j12] = uint8(num)
-			j12++
-		}
-		dAtA[i] = 0x1a
+	if len(m.Key) > 0 {
+		dAtA[i] = 0x12
 		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(j12))
-		i += copy(dAtA[i:], dAtA13[:j12])
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -1955,7 +2241,7 @@ func (m *SplitRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *SplitResponse) Marshal() (dAtA []byte, err error) {
+func (m *DeleteResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1965,30 +2251,18 @@ func (m *SplitResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SplitResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *DeleteResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Regions) > 0 {
-		for _, msg := range m.Regions {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintRaftCmdpb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *CompactLogRequest) Marshal() (dAtA []byte, err error) {
+func (m *DeleteRangeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1998,20 +2272,28 @@ func (m *CompactLogRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CompactLogRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *DeleteRangeRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.CompactIndex != 0 {
-		dAtA[i] = 0x8
+	if len(m.Cf) > 0 {
+		dAtA[i] = 0xa
 		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.CompactIndex))
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(len(m.Cf)))
+		i += copy(dAtA[i:], m.Cf)
 	}
-	if m.CompactTerm != 0 {
-		dAtA[i] = 0x10
+	if len(m.StartKey) > 0 {
+		dAtA[i] = 0x12
 		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.CompactTerm))
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(len(m.StartKey)))
+		i += copy(dAtA[i:], m.StartKey)
+	}
+	if len(m.EndKey) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(len(m.EndKey)))
+		i += copy(dAtA[i:], m.EndKey)
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -2019,7 +2301,7 @@ func (m *CompactLogRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *CompactLogResponse) Marshal() (dAtA []byte, err error) {
+func (m *DeleteRangeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2029,7 +2311,7 @@ func (m *CompactLogResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CompactLogResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *DeleteRangeResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -2040,7 +2322,7 @@ func (m *CompactLogResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *TransferLeaderRequest) Marshal() (dAtA []byte, err error) {
+func (m *SnapRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2050,28 +2332,18 @@ func (m *TransferLeaderRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TransferLeaderRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SnapRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Peer != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Peer.Size()))
-		n14, err := m.Peer.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n14
-	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *TransferLeaderResponse) Marshal() (dAtA []byte, err error) {
+func (m *SnapResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2081,18 +2353,28 @@ func (m *TransferLeaderResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TransferLeaderResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SnapResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
+	if m.Region != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Region.Size()))
+		n1, err := m.Region.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *AdminRequest) Marshal() (dAtA []byte, err error) {
+func (m *Request) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2102,7 +2384,7 @@ func (m *AdminRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AdminRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *Request) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -2112,45 +2394,55 @@ func (m *AdminRequest) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.CmdType))
 	}
-	if m.ChangePeer != nil {
+	if m.Get != nil {
 		dAtA[i] = 0x12
 		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.ChangePeer.Size()))
-		n15, err := m.ChangePeer.MarshalTo(dAtA[i:])
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Get.Size()))
+		n2, err := m.Get.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n15
+		i += n2
 	}
-	if m.CompactLog != nil {
+	if m.Put != nil {
 		dAtA[i] = 0x22
 		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.CompactLog.Size()))
-		n16, err := m.CompactLog.MarshalTo(dAtA[i:])
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Put.Size()))
+		n3, err := m.Put.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n16
+		i += n3
 	}
-	if m.TransferLeader != nil {
+	if m.Delete != nil {
 		dAtA[i] = 0x2a
 		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.TransferLeader.Size()))
-		n17, err := m.TransferLeader.MarshalTo(dAtA[i:])
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Delete.Size()))
+		n4, err := m.Delete.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n17
+		i += n4
 	}
-	if m.Split != nil {
-		dAtA[i] = 0x52
+	if m.Snap != nil {
+		dAtA[i] = 0x32
 		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Split.Size()))
-		n18, err := m.Split.MarshalTo(dAtA[i:])
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Snap.Size()))
+		n5, err := m.Snap.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n18
+		i += n5
+	}
+	if m.DeleteRange != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.DeleteRange.Size()))
+		n6, err := m.DeleteRange.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -2158,7 +2450,7 @@ func (m *AdminRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *AdminResponse) Marshal() (dAtA []byte, err error) {
+func (m *Response) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2168,7 +2460,7 @@ func (m *AdminResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AdminResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *Response) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -2178,45 +2470,55 @@ func (m *AdminResponse) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.CmdType))
 	}
-	if m.ChangePeer != nil {
+	if m.Get != nil {
 		dAtA[i] = 0x12
 		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.ChangePeer.Size()))
-		n19, err := m.ChangePeer.MarshalTo(dAtA[i:])
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Get.Size()))
+		n7, err := m.Get.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n19
+		i += n7
 	}
-	if m.CompactLog != nil {
+	if m.Put != nil {
 		dAtA[i] = 0x22
 		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.CompactLog.Size()))
-		n20, err := m.CompactLog.MarshalTo(dAtA[i:])
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Put.Size()))
+		n8, err := m.Put.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n20
+		i += n8
 	}
-	if m.TransferLeader != nil {
+	if m.Delete != nil {
 		dAtA[i] = 0x2a
 		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.TransferLeader.Size()))
-		n21, err := m.TransferLeader.MarshalTo(dAtA[i:])
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Delete.Size()))
+		n9, err := m.Delete.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n21
+		i += n9
 	}
-	if m.Split != nil {
-		dAtA[i] = 0x52
+	if m.Snap != nil {
+		dAtA[i] = 0x32
 		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Split.Size()))
-		n22, err := m.Split.MarshalTo(dAtA[i:])
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Snap.Size()))
+		n10, err := m.Snap.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n22
+		i += n10
+	}
+	if m.DeleteRange != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.DeleteRange.Size()))
+		n11, err := m.DeleteRange.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n11
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -2224,7 +2526,7 @@ func (m *AdminResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *RaftRequestHeader) Marshal() (dAtA []byte, err error) {
+func (m *ChangePeerRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2234,40 +2536,25 @@ func (m *RaftRequestHeader) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RaftRequestHeader) MarshalTo(dAtA []byte) (int, error) {
+func (m *ChangePeerRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.RegionId != 0 {
+	if m.ChangeType != 0 {
 		dAtA[i] = 0x8
 		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.RegionId))
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.ChangeType))
 	}
 	if m.Peer != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Peer.Size()))
-		n23, err := m.Peer.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n23
-	}
-	if m.RegionEpoch != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.RegionEpoch.Size()))
-		n24, err := m.RegionEpoch.MarshalTo(dAtA[i:])
+		n12, err := m.Peer.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n24
-	}
-	if m.Term != 0 {
-		dAtA[i] = 0x28
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Term))
+		i += n12
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -2275,7 +2562,7 @@ func (m *RaftRequestHeader) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *RaftResponseHeader) Marshal() (dAtA []byte, err error) {
+func (m *ChangePeerResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2285,31 +2572,20 @@ func (m *RaftResponseHeader) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RaftResponseHeader) MarshalTo(dAtA []byte) (int, error) {
+func (m *ChangePeerResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Error != nil {
+	if m.Region != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Error.Size()))
-		n25, err := m.Error.MarshalTo(dAtA[i:])
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Region.Size()))
+		n13, err := m.Region.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n25
-	}
-	if len(m.Uuid) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(len(m.Uuid)))
-		i += copy(dAtA[i:], m.Uuid)
-	}
-	if m.CurrentTerm != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.CurrentTerm))
+		i += n13
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -2317,7 +2593,7 @@ func (m *RaftResponseHeader) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *RaftCmdRequest) Marshal() (dAtA []byte, err error) {
+func (m *ChangePeerV2Request) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2327,24 +2603,14 @@ func (m *RaftCmdRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RaftCmdRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ChangePeerV2Request) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Header != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Header.Size()))
-		n26, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n26
-	}
-	if len(m.Requests) > 0 {
-		for _, msg := range m.Requests {
-			dAtA[i] = 0x12
+	if len(m.Changes) > 0 {
+		for _, msg := range m.Changes {
+			dAtA[i] = 0xa
 			i++
 			i = encodeVarintRaftCmdpb(dAtA, i, uint64(msg.Size()))
 			n, err := msg.MarshalTo(dAtA[i:])
@@ -2354,23 +2620,13 @@ func (m *RaftCmdRequest) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
-	if m.AdminRequest != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.AdminRequest.Size()))
-		n27, err := m.AdminRequest.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n27
-	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *RaftCmdResponse) Marshal() (dAtA []byte, err error) {
+func (m *ChangePeerV2Response) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2380,42 +2636,20 @@ func (m *RaftCmdResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RaftCmdResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ChangePeerV2Response) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Header != nil {
+	if m.Region != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Header.Size()))
-		n28, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n28
-	}
-	if len(m.Responses) > 0 {
-		for _, msg := range m.Responses {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintRaftCmdpb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if m.AdminResponse != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.AdminResponse.Size()))
-		n29, err := m.AdminResponse.MarshalTo(dAtA[i:])
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Region.Size()))
+		n14, err := m.Region.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n29
+		i += n14
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -2423,451 +2657,2298 @@ func (m *RaftCmdResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func encodeVarintRaftCmdpb(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *SplitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return dAtA[:n], nil
 }
-func (m *GetRequest) Size() (n int) {
+
+func (m *SplitRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Cf)
-	if l > 0 {
-		n += 1 + l + sovRaftCmdpb(uint64(l))
+	if len(m.SplitKey) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(len(m.SplitKey)))
+		i += copy(dAtA[i:], m.SplitKey)
 	}
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovRaftCmdpb(uint64(l))
+	if m.NewRegionId != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.NewRegionId))
+	}
+	if len(m.NewPeerIds) > 0 {
+		dAtA16 := make([]byte, len(m.NewPeerIds)*10)
+		var j15 int
+		for _, num := range m.NewPeerIds {
+			for num >= 1<<7 {
+				dAtA16[j15] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j15++
+			}
+			dAtA16[j15] = uint8(num)
+			j15++
+		}
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(j15))
+		i += copy(dAtA[i:], dAtA16[:j15])
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *GetResponse) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovRaftCmdpb(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *SplitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *PutRequest) Size() (n int) {
+func (m *SplitResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Cf)
-	if l > 0 {
-		n += 1 + l + sovRaftCmdpb(uint64(l))
-	}
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovRaftCmdpb(uint64(l))
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovRaftCmdpb(uint64(l))
+	if len(m.Regions) > 0 {
+		for _, msg := range m.Regions {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintRaftCmdpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *PutResponse) Size() (n int) {
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *CompactLogRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DeleteRequest) Size() (n int) {
+func (m *CompactLogRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Cf)
-	if l > 0 {
-		n += 1 + l + sovRaftCmdpb(uint64(l))
+	if m.CompactIndex != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.CompactIndex))
 	}
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovRaftCmdpb(uint64(l))
+	if m.CompactTerm != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.CompactTerm))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *DeleteResponse) Size() (n int) {
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *CompactLogResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SnapRequest) Size() (n int) {
+func (m *CompactLogResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *SnapResponse) Size() (n int) {
-	var l int
-	_ = l
-	if m.Region != nil {
-		l = m.Region.Size()
-		n += 1 + l + sovRaftCmdpb(uint64(l))
+func (m *TransferLeaderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Request) Size() (n int) {
+func (m *TransferLeaderRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.CmdType != 0 {
-		n += 1 + sovRaftCmdpb(uint64(m.CmdType))
-	}
-	if m.Get != nil {
-		l = m.Get.Size()
-		n += 1 + l + sovRaftCmdpb(uint64(l))
-	}
-	if m.Put != nil {
-		l = m.Put.Size()
-		n += 1 + l + sovRaftCmdpb(uint64(l))
-	}
-	if m.Delete != nil {
-		l = m.Delete.Size()
-		n += 1 + l + sovRaftCmdpb(uint64(l))
-	}
-	if m.Snap != nil {
-		l = m.Snap.Size()
-		n += 1 + l + sovRaftCmdpb(uint64(l))
+	if m.Peer != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Peer.Size()))
+		n17, err := m.Peer.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n17
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *Response) Size() (n int) {
+func (m *TransferLeaderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferLeaderResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.CmdType != 0 {
-		n += 1 + sovRaftCmdpb(uint64(m.CmdType))
-	}
-	if m.Get != nil {
-		l = m.Get.Size()
-		n += 1 + l + sovRaftCmdpb(uint64(l))
-	}
-	if m.Put != nil {
-		l = m.Put.Size()
-		n += 1 + l + sovRaftCmdpb(uint64(l))
-	}
-	if m.Delete != nil {
-		l = m.Delete.Size()
-		n += 1 + l + sovRaftCmdpb(uint64(l))
-	}
-	if m.Snap != nil {
-		l = m.Snap.Size()
-		n += 1 + l + sovRaftCmdpb(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ChangePeerRequest) Size() (n int) {
+func (m *PrepareMergeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrepareMergeRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.ChangeType != 0 {
-		n += 1 + sovRaftCmdpb(uint64(m.ChangeType))
+	if m.MinIndex != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.MinIndex))
 	}
-	if m.Peer != nil {
-		l = m.Peer.Size()
-		n += 1 + l + sovRaftCmdpb(uint64(l))
+	if m.Target != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Target.Size()))
+		n18, err := m.Target.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n18
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ChangePeerResponse) Size() (n int) {
+func (m *PrepareMergeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrepareMergeResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Region != nil {
-		l = m.Region.Size()
-		n += 1 + l + sovRaftCmdpb(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *SplitRequest) Size() (n int) {
+func (m *CommitMergeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitMergeRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.SplitKey)
-	if l > 0 {
-		n += 1 + l + sovRaftCmdpb(uint64(l))
+	if m.Source != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Source.Size()))
+		n19, err := m.Source.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n19
 	}
-	if m.NewRegionId != 0 {
-		n += 1 + sovRaftCmdpb(uint64(m.NewRegionId))
+	if m.Commit != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Commit))
 	}
-	if len(m.NewPeerIds) > 0 {
-		l = 0
-		for _, e := range m.NewPeerIds {
-			l += sovRaftCmdpb(uint64(e))
+	if len(m.Entries) > 0 {
+		for _, msg := range m.Entries {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintRaftCmdpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
-		n += 1 + sovRaftCmdpb(uint64(l)) + l
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *SplitResponse) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.Regions) > 0 {
-		for _, e := range m.Regions {
-			l = e.Size()
-			n += 1 + l + sovRaftCmdpb(uint64(l))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *CommitMergeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *CompactLogRequest) Size() (n int) {
+func (m *CommitMergeResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.CompactIndex != 0 {
-		n += 1 + sovRaftCmdpb(uint64(m.CompactIndex))
-	}
-	if m.CompactTerm != 0 {
-		n += 1 + sovRaftCmdpb(uint64(m.CompactTerm))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *CompactLogResponse) Size() (n int) {
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *RollbackMergeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *TransferLeaderRequest) Size() (n int) {
+func (m *RollbackMergeRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Peer != nil {
-		l = m.Peer.Size()
-		n += 1 + l + sovRaftCmdpb(uint64(l))
+	if m.Commit != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Commit))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *TransferLeaderResponse) Size() (n int) {
+func (m *RollbackMergeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RollbackMergeResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *AdminRequest) Size() (n int) {
+func (m *AdminRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.CmdType != 0 {
-		n += 1 + sovRaftCmdpb(uint64(m.CmdType))
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.CmdType))
 	}
 	if m.ChangePeer != nil {
-		l = m.ChangePeer.Size()
-		n += 1 + l + sovRaftCmdpb(uint64(l))
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.ChangePeer.Size()))
+		n20, err := m.ChangePeer.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n20
 	}
 	if m.CompactLog != nil {
-		l = m.CompactLog.Size()
-		n += 1 + l + sovRaftCmdpb(uint64(l))
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.CompactLog.Size()))
+		n21, err := m.CompactLog.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n21
 	}
 	if m.TransferLeader != nil {
-		l = m.TransferLeader.Size()
-		n += 1 + l + sovRaftCmdpb(uint64(l))
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.TransferLeader.Size()))
+		n22, err := m.TransferLeader.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n22
+	}
+	if m.PrepareMerge != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.PrepareMerge.Size()))
+		n23, err := m.PrepareMerge.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n23
+	}
+	if m.CommitMerge != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.CommitMerge.Size()))
+		n24, err := m.CommitMerge.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n24
+	}
+	if m.RollbackMerge != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.RollbackMerge.Size()))
+		n25, err := m.RollbackMerge.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n25
 	}
 	if m.Split != nil {
-		l = m.Split.Size()
-		n += 1 + l + sovRaftCmdpb(uint64(l))
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Split.Size()))
+		n26, err := m.Split.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n26
+	}
+	if m.ChangePeerV2 != nil {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.ChangePeerV2.Size()))
+		n27, err := m.ChangePeerV2.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n27
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *AdminResponse) Size() (n int) {
+func (m *AdminResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.CmdType != 0 {
-		n += 1 + sovRaftCmdpb(uint64(m.CmdType))
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.CmdType))
 	}
 	if m.ChangePeer != nil {
-		l = m.ChangePeer.Size()
-		n += 1 + l + sovRaftCmdpb(uint64(l))
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.ChangePeer.Size()))
+		n28, err := m.ChangePeer.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n28
 	}
 	if m.CompactLog != nil {
-		l = m.CompactLog.Size()
-		n += 1 + l + sovRaftCmdpb(uint64(l))
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.CompactLog.Size()))
+		n29, err := m.CompactLog.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n29
 	}
 	if m.TransferLeader != nil {
-		l = m.TransferLeader.Size()
-		n += 1 + l + sovRaftCmdpb(uint64(l))
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.TransferLeader.Size()))
+		n30, err := m.TransferLeader.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n30
+	}
+	if m.PrepareMerge != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.PrepareMerge.Size()))
+		n31, err := m.PrepareMerge.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n31
+	}
+	if m.CommitMerge != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.CommitMerge.Size()))
+		n32, err := m.CommitMerge.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n32
+	}
+	if m.RollbackMerge != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.RollbackMerge.Size()))
+		n33, err := m.RollbackMerge.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n33
 	}
 	if m.Split != nil {
-		l = m.Split.Size()
-		n += 1 + l + sovRaftCmdpb(uint64(l))
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Split.Size()))
+		n34, err := m.Split.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n34
+	}
+	if m.ChangePeerV2 != nil {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.ChangePeerV2.Size()))
+		n35, err := m.ChangePeerV2.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n35
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *RaftRequestHeader) Size() (n int) {
+func (m *RaftRequestHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RaftRequestHeader) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.RegionId != 0 {
-		n += 1 + sovRaftCmdpb(uint64(m.RegionId))
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.RegionId))
 	}
 	if m.Peer != nil {
-		l = m.Peer.Size()
-		n += 1 + l + sovRaftCmdpb(uint64(l))
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Peer.Size()))
+		n36, err := m.Peer.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n36
 	}
 	if m.RegionEpoch != nil {
-		l = m.RegionEpoch.Size()
-		n += 1 + l + sovRaftCmdpb(uint64(l))
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.RegionEpoch.Size()))
+		n37, err := m.RegionEpoch.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n37
 	}
 	if m.Term != 0 {
-		n += 1 + sovRaftCmdpb(uint64(m.Term))
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Term))
+	}
+	if m.ReplicaRead {
+		dAtA[i] = 0x30
+		i++
+		if m.ReplicaRead {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.StaleRead {
+		dAtA[i] = 0x38
+		i++
+		if m.StaleRead {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *RaftResponseHeader) Size() (n int) {
+func (m *RaftResponseHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RaftResponseHeader) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Error != nil {
-		l = m.Error.Size()
-		n += 1 + l + sovRaftCmdpb(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Error.Size()))
+		n38, err := m.Error.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n38
 	}
-	l = len(m.Uuid)
-	if l > 0 {
-		n += 1 + l + sovRaftCmdpb(uint64(l))
+	if len(m.Uuid) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(len(m.Uuid)))
+		i += copy(dAtA[i:], m.Uuid)
 	}
 	if m.CurrentTerm != 0 {
-		n += 1 + sovRaftCmdpb(uint64(m.CurrentTerm))
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.CurrentTerm))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *RaftCmdRequest) Size() (n int) {
+func (m *RaftCmdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RaftCmdRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovRaftCmdpb(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Header.Size()))
+		n39, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n39
 	}
 	if len(m.Requests) > 0 {
-		for _, e := range m.Requests {
-			l = e.Size()
-			n += 1 + l + sovRaftCmdpb(uint64(l))
+		for _, msg := range m.Requests {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintRaftCmdpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.AdminRequest != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.AdminRequest.Size()))
+		n40, err := m.AdminRequest.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n40
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *RaftCmdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RaftCmdResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Header != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Header.Size()))
+		n41, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n41
+	}
+	if len(m.Responses) > 0 {
+		for _, msg := range m.Responses {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintRaftCmdpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.AdminResponse != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.AdminResponse.Size()))
+		n42, err := m.AdminResponse.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n42
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func encodeVarintRaftCmdpb(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *GetRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Cf)
+	if l > 0 {
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PutRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Cf)
+	if l > 0 {
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PutResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Cf)
+	if l > 0 {
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteRangeRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Cf)
+	if l > 0 {
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	l = len(m.StartKey)
+	if l > 0 {
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	l = len(m.EndKey)
+	if l > 0 {
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteRangeResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SnapRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SnapResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Region != nil {
+		l = m.Region.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Request) Size() (n int) {
+	var l int
+	_ = l
+	if m.CmdType != 0 {
+		n += 1 + sovRaftCmdpb(uint64(m.CmdType))
+	}
+	if m.Get != nil {
+		l = m.Get.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.Put != nil {
+		l = m.Put.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.Delete != nil {
+		l = m.Delete.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.Snap != nil {
+		l = m.Snap.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.DeleteRange != nil {
+		l = m.DeleteRange.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Response) Size() (n int) {
+	var l int
+	_ = l
+	if m.CmdType != 0 {
+		n += 1 + sovRaftCmdpb(uint64(m.CmdType))
+	}
+	if m.Get != nil {
+		l = m.Get.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.Put != nil {
+		l = m.Put.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.Delete != nil {
+		l = m.Delete.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.Snap != nil {
+		l = m.Snap.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.DeleteRange != nil {
+		l = m.DeleteRange.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChangePeerRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.ChangeType != 0 {
+		n += 1 + sovRaftCmdpb(uint64(m.ChangeType))
+	}
+	if m.Peer != nil {
+		l = m.Peer.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChangePeerResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Region != nil {
+		l = m.Region.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChangePeerV2Request) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovRaftCmdpb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChangePeerV2Response) Size() (n int) {
+	var l int
+	_ = l
+	if m.Region != nil {
+		l = m.Region.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SplitRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.SplitKey)
+	if l > 0 {
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.NewRegionId != 0 {
+		n += 1 + sovRaftCmdpb(uint64(m.NewRegionId))
+	}
+	if len(m.NewPeerIds) > 0 {
+		l = 0
+		for _, e := range m.NewPeerIds {
+			l += sovRaftCmdpb(uint64(e))
+		}
+		n += 1 + sovRaftCmdpb(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SplitResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Regions) > 0 {
+		for _, e := range m.Regions {
+			l = e.Size()
+			n += 1 + l + sovRaftCmdpb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CompactLogRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.CompactIndex != 0 {
+		n += 1 + sovRaftCmdpb(uint64(m.CompactIndex))
+	}
+	if m.CompactTerm != 0 {
+		n += 1 + sovRaftCmdpb(uint64(m.CompactTerm))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CompactLogResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TransferLeaderRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Peer != nil {
+		l = m.Peer.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TransferLeaderResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PrepareMergeRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.MinIndex != 0 {
+		n += 1 + sovRaftCmdpb(uint64(m.MinIndex))
+	}
+	if m.Target != nil {
+		l = m.Target.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PrepareMergeResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CommitMergeRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Source != nil {
+		l = m.Source.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.Commit != 0 {
+		n += 1 + sovRaftCmdpb(uint64(m.Commit))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovRaftCmdpb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CommitMergeResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RollbackMergeRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Commit != 0 {
+		n += 1 + sovRaftCmdpb(uint64(m.Commit))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RollbackMergeResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AdminRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.CmdType != 0 {
+		n += 1 + sovRaftCmdpb(uint64(m.CmdType))
+	}
+	if m.ChangePeer != nil {
+		l = m.ChangePeer.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.CompactLog != nil {
+		l = m.CompactLog.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.TransferLeader != nil {
+		l = m.TransferLeader.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.PrepareMerge != nil {
+		l = m.PrepareMerge.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.CommitMerge != nil {
+		l = m.CommitMerge.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.RollbackMerge != nil {
+		l = m.RollbackMerge.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.Split != nil {
+		l = m.Split.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.ChangePeerV2 != nil {
+		l = m.ChangePeerV2.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AdminResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.CmdType != 0 {
+		n += 1 + sovRaftCmdpb(uint64(m.CmdType))
+	}
+	if m.ChangePeer != nil {
+		l = m.ChangePeer.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.CompactLog != nil {
+		l = m.CompactLog.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.TransferLeader != nil {
+		l = m.TransferLeader.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.PrepareMerge != nil {
+		l = m.PrepareMerge.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.CommitMerge != nil {
+		l = m.CommitMerge.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.RollbackMerge != nil {
+		l = m.RollbackMerge.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.Split != nil {
+		l = m.Split.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.ChangePeerV2 != nil {
+		l = m.ChangePeerV2.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RaftRequestHeader) Size() (n int) {
+	var l int
+	_ = l
+	if m.RegionId != 0 {
+		n += 1 + sovRaftCmdpb(uint64(m.RegionId))
+	}
+	if m.Peer != nil {
+		l = m.Peer.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.RegionEpoch != nil {
+		l = m.RegionEpoch.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.Term != 0 {
+		n += 1 + sovRaftCmdpb(uint64(m.Term))
+	}
+	if m.ReplicaRead {
+		n += 2
+	}
+	if m.StaleRead {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RaftResponseHeader) Size() (n int) {
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	l = len(m.Uuid)
+	if l > 0 {
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.CurrentTerm != 0 {
+		n += 1 + sovRaftCmdpb(uint64(m.CurrentTerm))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RaftCmdRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovRaftCmdpb(uint64(l))
+		}
+	}
+	if m.AdminRequest != nil {
+		l = m.AdminRequest.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RaftCmdResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if len(m.Responses) > 0 {
+		for _, e := range m.Responses {
+			l = e.Size()
+			n += 1 + l + sovRaftCmdpb(uint64(l))
+		}
+	}
+	if m.AdminResponse != nil {
+		l = m.AdminResponse.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovRaftCmdpb(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozRaftCmdpb(x uint64) (n int) {
+	return sovRaftCmdpb(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftCmdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cf", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cf = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftCmdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PutRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftCmdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PutRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cf", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cf = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PutResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftCmdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PutResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftCmdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cf", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cf = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftCmdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteRangeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftCmdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteRangeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cf", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cf = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartKey = append(m.StartKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.StartKey == nil {
+				m.StartKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndKey = append(m.EndKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.EndKey == nil {
+				m.EndKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteRangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftCmdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteRangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SnapRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftCmdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
 		}
 	}
-	if m.AdminRequest != nil {
-		l = m.AdminRequest.Size()
-		n += 1 + l + sovRaftCmdpb(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
 
-func (m *RaftCmdResponse) Size() (n int) {
-	var l int
-	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovRaftCmdpb(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	if len(m.Responses) > 0 {
-		for _, e := range m.Responses {
-			l = e.Size()
-			n += 1 + l + sovRaftCmdpb(uint64(l))
+	return nil
+}
+func (m *SnapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftCmdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Region == nil {
+				m.Region = &metapb.Region{}
+			}
+			if err := m.Region.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
 		}
 	}
-	if m.AdminResponse != nil {
-		l = m.AdminResponse.Size()
-		n += 1 + l + sovRaftCmdpb(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
 
-func sovRaftCmdpb(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-func sozRaftCmdpb(x uint64) (n int) {
-	return sovRaftCmdpb(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *GetRequest) Unmarshal(dAtA []byte) error {
+func (m *Request) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2890,17 +4971,135 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CmdType", wireType)
+			}
+			m.CmdType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CmdType |= (CmdType(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Get", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Get == nil {
+				m.Get = &GetRequest{}
+			}
+			if err := m.Get.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Put", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Put == nil {
+				m.Put = &PutRequest{}
+			}
+			if err := m.Put.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Delete == nil {
+				m.Delete = &DeleteRequest{}
+			}
+			if err := m.Delete.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cf", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Snap", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftCmdpb
@@ -2910,26 +5109,30 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRaftCmdpb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cf = string(dAtA[iNdEx:postIndex])
+			if m.Snap == nil {
+				m.Snap = &SnapRequest{}
+			}
+			if err := m.Snap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteRange", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftCmdpb
@@ -2939,21 +5142,23 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRaftCmdpb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
-			if m.Key == nil {
-				m.Key = []byte{}
+			if m.DeleteRange == nil {
+				m.DeleteRange = &DeleteRangeRequest{}
+			}
+			if err := m.DeleteRange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -2978,7 +5183,7 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetResponse) Unmarshal(dAtA []byte) error {
+func (m *Response) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3001,17 +5206,36 @@ func (m *GetResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CmdType", wireType)
+			}
+			m.CmdType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CmdType |= (CmdType(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Get", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftCmdpb
@@ -3021,79 +5245,30 @@ func (m *GetResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRaftCmdpb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
+			if m.Get == nil {
+				m.Get = &GetResponse{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Get.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthRaftCmdpb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PutRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRaftCmdpb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PutRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cf", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Put", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftCmdpb
@@ -3103,26 +5278,30 @@ func (m *PutRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRaftCmdpb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cf = string(dAtA[iNdEx:postIndex])
+			if m.Put == nil {
+				m.Put = &PutResponse{}
+			}
+			if err := m.Put.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftCmdpb
@@ -3132,28 +5311,30 @@ func (m *PutRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRaftCmdpb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
-			if m.Key == nil {
-				m.Key = []byte{}
+			if m.Delete == nil {
+				m.Delete = &DeleteResponse{}
+			}
+			if err := m.Delete.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Snap", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftCmdpb
@@ -3163,74 +5344,58 @@ func (m *PutRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRaftCmdpb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
+			if m.Snap == nil {
+				m.Snap = &SnapResponse{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Snap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthRaftCmdpb
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteRange", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PutResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRaftCmdpb
+			if msglen < 0 {
+				return ErrInvalidLengthRaftCmdpb
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.DeleteRange == nil {
+				m.DeleteRange = &DeleteRangeResponse{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PutResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if err := m.DeleteRange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
@@ -3253,7 +5418,7 @@ func (m *PutResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
+func (m *ChangePeerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3276,17 +5441,17 @@ func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChangePeerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChangePeerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cf", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeType", wireType)
 			}
-			var stringLen uint64
+			m.ChangeType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftCmdpb
@@ -3296,26 +5461,16 @@ func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.ChangeType |= (eraftpb.ConfChangeType(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRaftCmdpb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Cf = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftCmdpb
@@ -3325,21 +5480,23 @@ func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRaftCmdpb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
-			if m.Key == nil {
-				m.Key = []byte{}
+			if m.Peer == nil {
+				m.Peer = &metapb.Peer{}
+			}
+			if err := m.Peer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -3364,7 +5521,7 @@ func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteResponse) Unmarshal(dAtA []byte) error {
+func (m *ChangePeerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3387,12 +5544,45 @@ func (m *DeleteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChangePeerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChangePeerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Region == nil {
+				m.Region = &metapb.Region{}
+			}
+			if err := m.Region.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
@@ -3415,7 +5605,7 @@ func (m *DeleteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SnapRequest) Unmarshal(dAtA []byte) error {
+func (m *ChangePeerV2Request) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3438,12 +5628,43 @@ func (m *SnapRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SnapRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChangePeerV2Request: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SnapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChangePeerV2Request: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changes = append(m.Changes, &ChangePeerRequest{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
@@ -3466,7 +5687,7 @@ func (m *SnapRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SnapResponse) Unmarshal(dAtA []byte) error {
+func (m *ChangePeerV2Response) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3489,10 +5710,10 @@ func (m *SnapResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SnapResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChangePeerV2Response: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SnapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChangePeerV2Response: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3550,7 +5771,7 @@ func (m *SnapResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Request) Unmarshal(dAtA []byte) error {
+func (m *SplitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3573,36 +5794,17 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+			return fmt.Errorf("proto: SplitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SplitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CmdType", wireType)
-			}
-			m.CmdType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRaftCmdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CmdType |= (CmdType(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Get", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SplitKey", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftCmdpb
@@ -3612,30 +5814,28 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRaftCmdpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Get == nil {
-				m.Get = &GetRequest{}
-			}
-			if err := m.Get.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.SplitKey = append(m.SplitKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.SplitKey == nil {
+				m.SplitKey = []byte{}
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Put", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewRegionId", wireType)
 			}
-			var msglen int
+			m.NewRegionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftCmdpb
@@ -3645,61 +5845,127 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.NewRegionId |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRaftCmdpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Put == nil {
-				m.Put = &PutRequest{}
-			}
-			if err := m.Put.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRaftCmdpb
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRaftCmdpb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.NewPeerIds = append(m.NewPeerIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRaftCmdpb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthRaftCmdpb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRaftCmdpb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.NewPeerIds = append(m.NewPeerIds, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPeerIds", wireType)
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthRaftCmdpb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Delete == nil {
-				m.Delete = &DeleteRequest{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SplitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftCmdpb
 			}
-			if err := m.Delete.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 6:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SplitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SplitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Snap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Regions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3723,10 +5989,8 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Snap == nil {
-				m.Snap = &SnapRequest{}
-			}
-			if err := m.Snap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Regions = append(m.Regions, &metapb.Region{})
+			if err := m.Regions[len(m.Regions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3752,7 +6016,7 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Response) Unmarshal(dAtA []byte) error {
+func (m *CompactLogRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3775,17 +6039,17 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+			return fmt.Errorf("proto: CompactLogRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CompactLogRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CmdType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompactIndex", wireType)
 			}
-			m.CmdType = 0
+			m.CompactIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftCmdpb
@@ -3795,49 +6059,16 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CmdType |= (CmdType(b) & 0x7F) << shift
+				m.CompactIndex |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Get", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRaftCmdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRaftCmdpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Get == nil {
-				m.Get = &GetResponse{}
-			}
-			if err := m.Get.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Put", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompactTerm", wireType)
 			}
-			var msglen int
+			m.CompactTerm = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftCmdpb
@@ -3847,91 +6078,62 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.CompactTerm |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRaftCmdpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Put == nil {
-				m.Put = &PutResponse{}
-			}
-			if err := m.Put.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRaftCmdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthRaftCmdpb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Delete == nil {
-				m.Delete = &DeleteResponse{}
-			}
-			if err := m.Delete.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Snap", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRaftCmdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRaftCmdpb
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CompactLogResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftCmdpb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Snap == nil {
-				m.Snap = &SnapResponse{}
-			}
-			if err := m.Snap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompactLogResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompactLogResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
@@ -3954,7 +6156,7 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChangePeerRequest) Unmarshal(dAtA []byte) error {
+func (m *TransferLeaderRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3977,32 +6179,13 @@ func (m *ChangePeerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChangePeerRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChangePeerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChangeType", wireType)
-			}
-			m.ChangeType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRaftCmdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ChangeType |= (eraftpb.ConfChangeType(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
+			return fmt.Errorf("proto: TransferLeaderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferLeaderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
 			}
@@ -4057,7 +6240,7 @@ func (m *ChangePeerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChangePeerResponse) Unmarshal(dAtA []byte) error {
+func (m *TransferLeaderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4080,45 +6263,12 @@ func (m *ChangePeerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChangePeerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TransferLeaderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChangePeerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TransferLeaderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRaftCmdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRaftCmdpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Region == nil {
-				m.Region = &metapb.Region{}
-			}
-			if err := m.Region.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
@@ -4141,7 +6291,7 @@ func (m *ChangePeerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SplitRequest) Unmarshal(dAtA []byte) error {
+func (m *PrepareMergeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4164,17 +6314,17 @@ func (m *SplitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SplitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PrepareMergeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SplitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PrepareMergeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SplitKey", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinIndex", wireType)
 			}
-			var byteLen int
+			m.MinIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftCmdpb
@@ -4184,28 +6334,16 @@ func (m *SplitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				m.MinIndex |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthRaftCmdpb
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SplitKey = append(m.SplitKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.SplitKey == nil {
-				m.SplitKey = []byte{}
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewRegionId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
 			}
-			m.NewRegionId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftCmdpb
@@ -4215,73 +6353,25 @@ func (m *SplitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NewRegionId |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRaftCmdpb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.NewPeerIds = append(m.NewPeerIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRaftCmdpb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthRaftCmdpb
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRaftCmdpb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.NewPeerIds = append(m.NewPeerIds, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewPeerIds", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Target == nil {
+				m.Target = &metapb.Region{}
 			}
+			if err := m.Target.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
@@ -4304,7 +6394,7 @@ func (m *SplitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SplitResponse) Unmarshal(dAtA []byte) error {
+func (m *PrepareMergeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4327,43 +6417,12 @@ func (m *SplitResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SplitResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PrepareMergeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SplitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PrepareMergeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Regions", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRaftCmdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRaftCmdpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Regions = append(m.Regions, &metapb.Region{})
-			if err := m.Regions[len(m.Regions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
@@ -4386,7 +6445,7 @@ func (m *SplitResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CompactLogRequest) Unmarshal(dAtA []byte) error {
+func (m *CommitMergeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4409,17 +6468,17 @@ func (m *CompactLogRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CompactLogRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommitMergeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CompactLogRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommitMergeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompactIndex", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			m.CompactIndex = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftCmdpb
@@ -4429,16 +6488,30 @@ func (m *CompactLogRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CompactIndex |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Source == nil {
+				m.Source = &metapb.Region{}
+			}
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompactTerm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
-			m.CompactTerm = 0
+			m.Commit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftCmdpb
@@ -4448,11 +6521,42 @@ func (m *CompactLogRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CompactTerm |= (uint64(b) & 0x7F) << shift
+				m.Commit |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, &eraftpb.Entry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
@@ -4475,7 +6579,7 @@ func (m *CompactLogRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CompactLogResponse) Unmarshal(dAtA []byte) error {
+func (m *CommitMergeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4498,10 +6602,10 @@ func (m *CompactLogResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CompactLogResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommitMergeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CompactLogResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommitMergeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4526,7 +6630,7 @@ func (m *CompactLogResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TransferLeaderRequest) Unmarshal(dAtA []byte) error {
+func (m *RollbackMergeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4549,17 +6653,17 @@ func (m *TransferLeaderRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TransferLeaderRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RollbackMergeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransferLeaderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RollbackMergeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
-			var msglen int
+			m.Commit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftCmdpb
@@ -4569,25 +6673,11 @@ func (m *TransferLeaderRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Commit |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRaftCmdpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Peer == nil {
-				m.Peer = &metapb.Peer{}
-			}
-			if err := m.Peer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
@@ -4610,7 +6700,7 @@ func (m *TransferLeaderRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TransferLeaderResponse) Unmarshal(dAtA []byte) error {
+func (m *RollbackMergeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4633,10 +6723,10 @@ func (m *TransferLeaderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TransferLeaderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RollbackMergeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransferLeaderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RollbackMergeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4808,6 +6898,105 @@ func (m *AdminRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrepareMerge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PrepareMerge == nil {
+				m.PrepareMerge = &PrepareMergeRequest{}
+			}
+			if err := m.PrepareMerge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitMerge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CommitMerge == nil {
+				m.CommitMerge = &CommitMergeRequest{}
+			}
+			if err := m.CommitMerge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollbackMerge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RollbackMerge == nil {
+				m.RollbackMerge = &RollbackMergeRequest{}
+			}
+			if err := m.RollbackMerge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 10:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Split", wireType)
@@ -4841,6 +7030,39 @@ func (m *AdminRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangePeerV2", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChangePeerV2 == nil {
+				m.ChangePeerV2 = &ChangePeerV2Request{}
+			}
+			if err := m.ChangePeerV2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
@@ -5010,6 +7232,105 @@ func (m *AdminResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrepareMerge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PrepareMerge == nil {
+				m.PrepareMerge = &PrepareMergeResponse{}
+			}
+			if err := m.PrepareMerge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitMerge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CommitMerge == nil {
+				m.CommitMerge = &CommitMergeResponse{}
+			}
+			if err := m.CommitMerge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollbackMerge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RollbackMerge == nil {
+				m.RollbackMerge = &RollbackMergeResponse{}
+			}
+			if err := m.RollbackMerge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 10:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Split", wireType)
@@ -5043,6 +7364,39 @@ func (m *AdminResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangePeerV2", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChangePeerV2 == nil {
+				m.ChangePeerV2 = &ChangePeerV2Response{}
+			}
+			if err := m.ChangePeerV2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
@@ -5198,6 +7552,46 @@ func (m *RaftRequestHeader) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaRead", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReplicaRead = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StaleRead", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StaleRead = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
@@ -5755,75 +8149,101 @@ var (
 	ErrIntOverflowRaftCmdpb   = fmt.Errorf("proto: integer overflow")
 )
 
-func init() { proto.RegisterFile("raft_cmdpb.proto", fileDescriptor_raft_cmdpb_409ff26e8ae8c248) }
-
-var fileDescriptor_raft_cmdpb_409ff26e8ae8c248 = []byte{
-	// 1068 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
-	0x10, 0x0e, 0x45, 0xea, 0xc7, 0x43, 0x52, 0xa1, 0x37, 0x6e, 0x4c, 0x3b, 0xa8, 0xa0, 0x30, 0x45,
-	0xe1, 0xa4, 0x85, 0x82, 0x38, 0xa8, 0xd1, 0x00, 0x6d, 0xd2, 0xd6, 0x09, 0x52, 0x37, 0x39, 0x18,
-	0x1b, 0xdf, 0x7a, 0x20, 0x18, 0x72, 0x25, 0x0b, 0x95, 0x48, 0x9a, 0xa4, 0xe2, 0xfa, 0x4d, 0x7a,
-	0xea, 0x6b, 0xf4, 0x98, 0x4b, 0x0f, 0x3d, 0xf6, 0x11, 0x0a, 0xf7, 0xdc, 0x4b, 0x9f, 0xa0, 0xd8,
-	0xdd, 0x59, 0xfe, 0x48, 0x72, 0x9b, 0xf4, 0x24, 0xee, 0xec, 0xcc, 0xc7, 0x6f, 0xbe, 0x9d, 0x6f,
-	0x45, 0x70, 0xb2, 0x60, 0x5c, 0xf8, 0xe1, 0x3c, 0x4a, 0x5f, 0x8f, 0xd2, 0x2c, 0x29, 0x12, 0x02,
-	0x55, 0x64, 0xd7, 0x9a, 0xb3, 0x22, 0x50, 0x3b, 0xbb, 0x36, 0xcb, 0xb2, 0x24, 0xab, 0x2f, 0x83,
-	0x71, 0xa1, 0x96, 0xde, 0x08, 0xe0, 0x39, 0x2b, 0x28, 0x3b, 0x5b, 0xb0, 0xbc, 0x20, 0x7d, 0x68,
-	0x85, 0x63, 0x57, 0x1b, 0x6a, 0x7b, 0x1b, 0xb4, 0x15, 0x8e, 0x89, 0x03, 0xfa, 0x0f, 0xec, 0xc2,
-	0x6d, 0x0d, 0xb5, 0x3d, 0x8b, 0xf2, 0x47, 0xef, 0x0e, 0x98, 0x22, 0x3f, 0x4f, 0x93, 0x38, 0x67,
-	0x64, 0x0b, 0xda, 0x6f, 0x82, 0xd9, 0x82, 0x89, 0x1a, 0x8b, 0xca, 0x85, 0xf7, 0x14, 0xe0, 0x78,
-	0xf1, 0xee, 0xa0, 0x15, 0x8a, 0x5e, 0x47, 0xb1, 0xc1, 0x14, 0x28, 0xf2, 0x55, 0xde, 0x03, 0xb0,
-	0x9f, 0xb2, 0x19, 0x2b, 0xd8, 0xbb, 0x93, 0x75, 0xa0, 0xaf, 0x4a, 0x10, 0xc4, 0x06, 0xf3, 0x55,
-	0x1c, 0xa4, 0x08, 0xe1, 0x1d, 0x80, 0x25, 0x97, 0xd8, 0xce, 0xc7, 0xd0, 0xc9, 0xd8, 0x64, 0x9a,
-	0xc4, 0x02, 0xd6, 0xdc, 0xef, 0x8f, 0x50, 0x4a, 0x2a, 0xa2, 0x14, 0x77, 0xbd, 0xbf, 0x34, 0xe8,
-	0x2a, 0x1a, 0x23, 0xe8, 0x85, 0xf3, 0xc8, 0x2f, 0x2e, 0x52, 0xa9, 0x42, 0x7f, 0xff, 0xc6, 0xa8,
-	0x76, 0x3c, 0x87, 0xf3, 0xe8, 0xe4, 0x22, 0x65, 0xb4, 0x1b, 0xca, 0x07, 0xb2, 0x07, 0xfa, 0x84,
-	0x15, 0x82, 0xa6, 0xb9, 0x7f, 0xb3, 0x9e, 0x5a, 0x1d, 0x04, 0xe5, 0x29, 0x3c, 0x33, 0x5d, 0x14,
-	0xae, 0xb1, 0x9a, 0x59, 0xa9, 0x4b, 0x79, 0x0a, 0x79, 0x00, 0x9d, 0x48, 0x34, 0xea, 0xb6, 0x45,
-	0xf2, 0x4e, 0x3d, 0xb9, 0xa1, 0x1a, 0xc5, 0x44, 0xf2, 0x09, 0x18, 0x79, 0x1c, 0xa4, 0x6e, 0x47,
-	0x14, 0x6c, 0xd7, 0x0b, 0x6a, 0x0a, 0x51, 0x91, 0xe4, 0xfd, 0xad, 0x41, 0xaf, 0x14, 0xe9, 0x7d,
-	0x1b, 0xbe, 0x5b, 0x6f, 0x78, 0x7b, 0xa5, 0x61, 0x89, 0x2a, 0x3b, 0xbe, 0x5b, 0xef, 0x78, 0x7b,
-	0xa5, 0x63, 0x95, 0xca, 0x5b, 0xde, 0x5f, 0x6a, 0x79, 0x77, 0x5d, 0xcb, 0x58, 0xa0, 0x7a, 0xfe,
-	0xb4, 0xd1, 0xb3, 0xbb, 0xda, 0x33, 0xe6, 0xcb, 0xa6, 0x13, 0xd8, 0x3c, 0x3c, 0x0d, 0xe2, 0x09,
-	0x3b, 0x66, 0x2c, 0x53, 0xa7, 0xfd, 0x39, 0x98, 0xa1, 0x08, 0xd6, 0xfb, 0xdf, 0x1e, 0x29, 0x53,
-	0x1d, 0x26, 0xf1, 0x58, 0x16, 0x09, 0x0d, 0x20, 0x2c, 0x9f, 0xc9, 0x10, 0x8c, 0x94, 0xb1, 0x0c,
-	0x75, 0xb0, 0xd4, 0x64, 0x09, 0x70, 0xb1, 0xe3, 0x7d, 0x01, 0xa4, 0xfe, 0xc2, 0xf7, 0x9c, 0xc9,
-	0x33, 0xb0, 0x5e, 0xa5, 0xb3, 0x69, 0x69, 0xbb, 0x5b, 0xb0, 0x91, 0xf3, 0xb5, 0xcf, 0x4d, 0x21,
-	0xed, 0xd9, 0x13, 0x81, 0x17, 0xec, 0x82, 0x78, 0x60, 0xc7, 0xec, 0xdc, 0x97, 0xa5, 0xfe, 0x34,
-	0x12, 0xac, 0x0c, 0x6a, 0xc6, 0xec, 0x5c, 0xc2, 0x1e, 0x45, 0x64, 0x08, 0x16, 0xcf, 0xe1, 0xd4,
-	0xfc, 0x69, 0x94, 0xbb, 0xfa, 0x50, 0xdf, 0x33, 0x28, 0xc4, 0xec, 0x9c, 0xf3, 0x3b, 0x8a, 0x72,
-	0xef, 0x11, 0xd8, 0xf8, 0x4a, 0xe4, 0xba, 0x07, 0x5d, 0x09, 0x99, 0xbb, 0xda, 0x50, 0x5f, 0x43,
-	0x56, 0x6d, 0x7b, 0xdf, 0xc3, 0xe6, 0x61, 0x32, 0x4f, 0x83, 0xb0, 0x78, 0x99, 0x4c, 0x14, 0xe5,
-	0x3b, 0x60, 0x87, 0x32, 0xe8, 0x4f, 0xe3, 0x88, 0xfd, 0x28, 0x68, 0x1b, 0xd4, 0xc2, 0xe0, 0x11,
-	0x8f, 0x91, 0xdb, 0xa0, 0xd6, 0x7e, 0xc1, 0xb2, 0xb9, 0x62, 0x8e, 0xb1, 0x13, 0x96, 0xcd, 0xbd,
-	0x2d, 0x20, 0x75, 0x70, 0xf4, 0xfe, 0x23, 0xf8, 0xe0, 0x24, 0x0b, 0xe2, 0x7c, 0xcc, 0xb2, 0x97,
-	0x2c, 0x88, 0xaa, 0x33, 0x55, 0x27, 0xa3, 0x5d, 0x79, 0x32, 0x2e, 0xdc, 0x5c, 0x2e, 0x45, 0xd0,
-	0xb7, 0x2d, 0xb0, 0xbe, 0x8e, 0xe6, 0xd3, 0x58, 0x81, 0x3d, 0x5c, 0x71, 0x47, 0x63, 0xce, 0x44,
-	0xee, 0x8a, 0x45, 0x1e, 0x97, 0x53, 0x55, 0x1b, 0x91, 0x0f, 0x1b, 0xae, 0x5a, 0x9e, 0x44, 0x35,
-	0x5b, 0x3c, 0x24, 0xea, 0x51, 0x93, 0x59, 0x32, 0x41, 0xff, 0x34, 0xeb, 0x97, 0xc5, 0xa6, 0x10,
-	0x96, 0x21, 0xf2, 0x1d, 0x5c, 0x2f, 0xb0, 0x3f, 0x7f, 0x26, 0x1a, 0x44, 0x57, 0xdd, 0xae, 0x63,
-	0xac, 0x55, 0x8f, 0xf6, 0x8b, 0x46, 0x98, 0x8c, 0xa0, 0x2d, 0xc6, 0xcc, 0x85, 0x35, 0x2e, 0xab,
-	0x0d, 0x28, 0x95, 0x69, 0xde, 0xaf, 0x2d, 0xb0, 0x51, 0x41, 0x9c, 0xa2, 0xff, 0x25, 0xe1, 0x93,
-	0x75, 0x12, 0x0e, 0xae, 0x92, 0x10, 0x8d, 0x5e, 0xd7, 0xf0, 0xc9, 0x3a, 0x0d, 0x07, 0x57, 0x69,
-	0x58, 0x02, 0x54, 0x22, 0xbe, 0xb8, 0x4a, 0x44, 0xef, 0xdf, 0x44, 0x44, 0xa0, 0x65, 0x15, 0xef,
-	0x37, 0x55, 0xdc, 0x59, 0xa3, 0x22, 0x56, 0xa2, 0x8c, 0x3f, 0x6b, 0xb0, 0x49, 0x83, 0xb1, 0x52,
-	0xf7, 0x5b, 0x09, 0x73, 0x0b, 0x36, 0x2a, 0x8f, 0x4b, 0x37, 0xf5, 0xb2, 0xca, 0xe0, 0xff, 0x71,
-	0x23, 0x91, 0x03, 0xb0, 0xb0, 0x9c, 0xa5, 0x49, 0x78, 0x8a, 0xa2, 0xdc, 0x68, 0x9a, 0xfa, 0x19,
-	0xdf, 0xa2, 0x66, 0x56, 0x2d, 0x08, 0x01, 0x43, 0x78, 0xb3, 0x2d, 0xde, 0x28, 0x9e, 0xbd, 0x33,
-	0x20, 0x92, 0x9f, 0xe4, 0x8d, 0x04, 0x3f, 0x82, 0xb6, 0xf8, 0x3e, 0x29, 0x2f, 0x37, 0xf5, 0xb5,
-	0xf2, 0x8c, 0xff, 0x52, 0xb9, 0xc9, 0xf1, 0x16, 0x0b, 0xbc, 0xa5, 0x2c, 0x2a, 0x9e, 0xc5, 0x3d,
-	0xb0, 0xc8, 0x32, 0x16, 0xe3, 0x3d, 0xa0, 0xe3, 0x3d, 0x20, 0x63, 0xe2, 0x1e, 0xf8, 0x45, 0x83,
-	0x3e, 0x7f, 0xe7, 0xe1, 0x3c, 0x52, 0xf6, 0xfc, 0x0c, 0x3a, 0xa7, 0xf2, 0x6c, 0xb4, 0x55, 0x93,
-	0xac, 0xe8, 0x47, 0x31, 0x99, 0xdc, 0x87, 0x5e, 0x26, 0x37, 0x72, 0xb7, 0x25, 0x6e, 0xb6, 0xc6,
-	0x7f, 0x9e, 0x1a, 0xe9, 0x32, 0x89, 0x7c, 0x09, 0x76, 0xc0, 0xe7, 0xd4, 0xc7, 0x88, 0xa0, 0x67,
-	0xae, 0x19, 0x64, 0x55, 0x6a, 0x05, 0xb5, 0x95, 0xf7, 0x56, 0x83, 0xeb, 0x25, 0x73, 0xb4, 0xc5,
-	0xc1, 0x12, 0xf5, 0xc1, 0x2a, 0xf5, 0xba, 0xb4, 0x25, 0xf7, 0x7d, 0x3e, 0x03, 0x72, 0x47, 0x91,
-	0xdf, 0x6a, 0x92, 0xc7, 0x49, 0xaa, 0xd2, 0xc8, 0x57, 0xd0, 0x57, 0xf4, 0x65, 0x08, 0xf9, 0xef,
-	0xac, 0xe1, 0x8f, 0xd5, 0x76, 0x50, 0x5f, 0xde, 0x7b, 0x0c, 0x5d, 0xf4, 0x28, 0x31, 0xa1, 0x7b,
-	0x14, 0xbf, 0x09, 0x66, 0xd3, 0xc8, 0xb9, 0x46, 0xba, 0xa0, 0x3f, 0x67, 0x85, 0xa3, 0xf1, 0x87,
-	0xe3, 0x45, 0xe1, 0xe8, 0x04, 0xa0, 0x23, 0xff, 0xaf, 0x1d, 0x83, 0xf4, 0xc0, 0xe0, 0xff, 0xc4,
-	0x4e, 0xfb, 0x9e, 0x8f, 0xf7, 0xaa, 0x02, 0x71, 0xc0, 0x42, 0x10, 0x11, 0x76, 0xae, 0x91, 0x3e,
-	0x40, 0x65, 0x69, 0x47, 0x13, 0xeb, 0xd2, 0x8d, 0x8e, 0x4e, 0x08, 0xf4, 0x9b, 0x66, 0x73, 0x0c,
-	0xb2, 0x01, 0x6d, 0xe1, 0x1e, 0x07, 0xbe, 0x71, 0x7e, 0xbb, 0x1c, 0x68, 0xbf, 0x5f, 0x0e, 0xb4,
-	0x3f, 0x2e, 0x07, 0xda, 0x4f, 0x7f, 0x0e, 0xae, 0xbd, 0xee, 0x88, 0x4f, 0xe2, 0x87, 0xff, 0x04,
-	0x00, 0x00, 0xff, 0xff, 0x26, 0xb1, 0x93, 0xfc, 0x5e, 0x0b, 0x00, 0x00,
+func init() { proto.RegisterFile("raft_cmdpb.proto", fileDescriptor_raft_cmdpb_a73b112e07bde3d6) }
+
+var fileDescriptor_raft_cmdpb_a73b112e07bde3d6 = []byte{
+	// 1479 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0x4d, 0x93, 0xd4, 0x44,
+	0x18, 0x26, 0x3b, 0xdf, 0x6f, 0x32, 0x43, 0xb6, 0x77, 0xd9, 0x0d, 0x50, 0x0c, 0x43, 0xb0, 0xa8,
+	0x05, 0xad, 0xa1, 0x18, 0x4a, 0x94, 0x2a, 0x05, 0x61, 0x59, 0x61, 0x05, 0x75, 0xab, 0xa1, 0x3c,
+	0xe8, 0x21, 0x15, 0x92, 0x9e, 0x65, 0x8a, 0x99, 0x24, 0xf4, 0x64, 0xc0, 0xbd, 0xf8, 0x3b, 0xfc,
+	0x07, 0x5e, 0x3d, 0xe9, 0xd1, 0xab, 0x47, 0x8f, 0x1e, 0x11, 0xff, 0x88, 0xd5, 0x5f, 0x49, 0x67,
+	0x92, 0x01, 0xd6, 0xd3, 0xa6, 0xdf, 0x7e, 0xbf, 0xfa, 0xe9, 0x27, 0xcf, 0x9b, 0x59, 0xb0, 0xa9,
+	0x3f, 0x4e, 0xbd, 0x60, 0x16, 0x26, 0x4f, 0x87, 0x09, 0x8d, 0xd3, 0x18, 0x41, 0x6e, 0x39, 0x63,
+	0xcd, 0x48, 0xea, 0xab, 0x9d, 0x33, 0x5d, 0x42, 0x69, 0x4c, 0xf5, 0xa5, 0x3f, 0x4e, 0xd5, 0xd2,
+	0x1d, 0x02, 0xdc, 0x27, 0x29, 0x26, 0x2f, 0x16, 0x64, 0x9e, 0xa2, 0x1e, 0xac, 0x05, 0x63, 0xc7,
+	0x18, 0x18, 0x3b, 0x1d, 0xbc, 0x16, 0x8c, 0x91, 0x0d, 0xb5, 0xe7, 0xe4, 0xc8, 0x59, 0x1b, 0x18,
+	0x3b, 0x16, 0x66, 0x8f, 0xee, 0x45, 0x30, 0xb9, 0xff, 0x3c, 0x89, 0xa3, 0x39, 0x41, 0x9b, 0xd0,
+	0x78, 0xe9, 0x4f, 0x17, 0x84, 0xc7, 0x58, 0x58, 0x2c, 0xdc, 0x7b, 0x00, 0x07, 0x8b, 0xf7, 0x4f,
+	0x9a, 0x67, 0xa9, 0xe9, 0x59, 0xba, 0x60, 0x1e, 0x2c, 0xb2, 0x52, 0xee, 0x35, 0xe8, 0xde, 0x23,
+	0x53, 0x92, 0x92, 0xf7, 0x6f, 0xd6, 0x86, 0x9e, 0x0a, 0x91, 0x49, 0xbe, 0x07, 0x24, 0x2d, 0x7e,
+	0x74, 0xb8, 0x32, 0xd3, 0x59, 0xe8, 0xcc, 0x53, 0x9f, 0xa6, 0x5e, 0x9e, 0xaf, 0xcd, 0x0d, 0x0f,
+	0xc9, 0x11, 0xda, 0x86, 0x16, 0x89, 0x42, 0xbe, 0x25, 0xda, 0x6d, 0x92, 0x28, 0x7c, 0x48, 0x8e,
+	0xdc, 0x53, 0xb0, 0x51, 0xc8, 0x2d, 0x4b, 0x76, 0xc1, 0x7c, 0x1c, 0xf9, 0x89, 0xac, 0xe5, 0xde,
+	0x00, 0x4b, 0x2c, 0x25, 0x82, 0x97, 0xa0, 0x49, 0xc9, 0xe1, 0x24, 0x8e, 0x78, 0x7d, 0x73, 0xd4,
+	0x1b, 0xca, 0xdb, 0xc3, 0xdc, 0x8a, 0xe5, 0xae, 0xfb, 0xeb, 0x1a, 0xb4, 0x54, 0xbf, 0x43, 0x68,
+	0x07, 0xb3, 0xd0, 0x4b, 0x8f, 0x12, 0x01, 0x7c, 0x6f, 0xb4, 0x31, 0xd4, 0x18, 0xb1, 0x3b, 0x0b,
+	0x9f, 0x1c, 0x25, 0x04, 0xb7, 0x02, 0xf1, 0x80, 0x76, 0xa0, 0x76, 0x48, 0x52, 0x7e, 0x12, 0x73,
+	0xb4, 0xa5, 0xbb, 0xe6, 0x77, 0x8f, 0x99, 0x0b, 0xf3, 0x4c, 0x16, 0xa9, 0x53, 0x2f, 0x7b, 0xe6,
+	0x17, 0x8a, 0x99, 0x0b, 0xba, 0x06, 0xcd, 0x90, 0x9f, 0xd6, 0x69, 0x70, 0xe7, 0xd3, 0xba, 0x73,
+	0xe1, 0xa2, 0xb0, 0x74, 0x44, 0x1f, 0x42, 0x7d, 0x1e, 0xf9, 0x89, 0xd3, 0xe4, 0x01, 0xdb, 0x7a,
+	0x80, 0x86, 0x10, 0xe6, 0x4e, 0xe8, 0x0e, 0x58, 0x22, 0xcc, 0xa3, 0x0c, 0x4e, 0xa7, 0xc5, 0x83,
+	0xfa, 0x15, 0x55, 0xb4, 0x9b, 0xc4, 0x66, 0x98, 0xdb, 0xdc, 0xdf, 0xd6, 0xa0, 0x9d, 0xe1, 0x7c,
+	0x5c, 0xcc, 0x2e, 0xeb, 0x98, 0x6d, 0x97, 0x30, 0x13, 0x59, 0x05, 0x68, 0x97, 0x75, 0xd0, 0xb6,
+	0x4b, 0xa0, 0x29, 0x57, 0x86, 0xda, 0x68, 0x09, 0xb5, 0x33, 0x55, 0xa8, 0xc9, 0x00, 0x05, 0xdb,
+	0x47, 0x05, 0xd8, 0x9c, 0x32, 0x6c, 0xd2, 0x5f, 0xe0, 0x76, 0xb7, 0x12, 0xb7, 0xf3, 0x2b, 0x71,
+	0x93, 0xc1, 0x05, 0xe0, 0x62, 0x58, 0xdf, 0x7d, 0xc6, 0x9e, 0x0e, 0x08, 0xa1, 0x8a, 0x74, 0x9f,
+	0x82, 0x19, 0x70, 0xa3, 0x8e, 0xe1, 0xf6, 0x50, 0xc9, 0xc9, 0x6e, 0x1c, 0x8d, 0x45, 0x10, 0xc7,
+	0x11, 0x82, 0xec, 0x19, 0x0d, 0xa0, 0x9e, 0x10, 0x42, 0x25, 0x96, 0x96, 0x22, 0x38, 0x4f, 0xce,
+	0x77, 0xdc, 0xcf, 0x00, 0xe9, 0x05, 0x8f, 0xf9, 0x6a, 0x7c, 0x03, 0x1b, 0x79, 0xf4, 0x77, 0x23,
+	0xd5, 0xf0, 0x27, 0xd0, 0x12, 0x4d, 0xcc, 0x1d, 0x63, 0x50, 0xdb, 0x31, 0x47, 0xe7, 0x0a, 0x17,
+	0xbe, 0x7c, 0x40, 0xac, 0xbc, 0xdd, 0x5b, 0xb0, 0x59, 0xcc, 0x77, 0xcc, 0x7e, 0x5e, 0x80, 0xf5,
+	0x38, 0x99, 0x4e, 0x32, 0x01, 0x64, 0x72, 0xc2, 0xd6, 0x5c, 0x33, 0x0c, 0x29, 0x27, 0xcc, 0xc0,
+	0xe4, 0xc4, 0x85, 0x6e, 0x44, 0x5e, 0x79, 0x22, 0xd4, 0x9b, 0x84, 0x1c, 0xa5, 0x3a, 0x36, 0x23,
+	0xf2, 0x4a, 0xa4, 0xdd, 0x0f, 0xd1, 0x00, 0x2c, 0xe6, 0xc3, 0xa0, 0xf2, 0x26, 0xe1, 0xdc, 0xa9,
+	0x0d, 0x6a, 0x3b, 0x75, 0x0c, 0x11, 0x79, 0xc5, 0x3a, 0xdc, 0x0f, 0xe7, 0xee, 0x4d, 0xe8, 0xca,
+	0x92, 0xb2, 0xd7, 0x1d, 0x68, 0x89, 0x94, 0xea, 0xf0, 0xcb, 0xcd, 0xaa, 0x6d, 0xf7, 0x07, 0x58,
+	0xdf, 0x8d, 0x67, 0x89, 0x1f, 0xa4, 0x8f, 0xe2, 0x43, 0xd5, 0xf2, 0x45, 0xe8, 0x06, 0xc2, 0xe8,
+	0x4d, 0xa2, 0x90, 0xfc, 0xc8, 0xdb, 0xae, 0x63, 0x4b, 0x1a, 0xf7, 0x99, 0x0d, 0x5d, 0x00, 0xb5,
+	0xf6, 0x52, 0x42, 0x67, 0xaa, 0x73, 0x69, 0x7b, 0x42, 0xe8, 0xcc, 0xdd, 0x04, 0xa4, 0x27, 0x97,
+	0x92, 0x78, 0x13, 0x4e, 0x3d, 0xa1, 0x7e, 0x34, 0x1f, 0x13, 0xfa, 0x88, 0xf8, 0x61, 0xce, 0x31,
+	0xc5, 0x14, 0x63, 0x25, 0x53, 0x1c, 0xd8, 0x5a, 0x0e, 0xcd, 0xa4, 0x7d, 0xe3, 0x80, 0x92, 0xc4,
+	0xa7, 0xe4, 0x6b, 0x42, 0x73, 0x6d, 0x3f, 0x0b, 0x9d, 0xd9, 0x24, 0x2a, 0x9c, 0xa2, 0x3d, 0x9b,
+	0x44, 0xe2, 0x04, 0x97, 0xa0, 0x99, 0xfa, 0x34, 0x7f, 0xcf, 0x4b, 0x37, 0x2a, 0x76, 0xdd, 0x2d,
+	0xd8, 0x2c, 0xe6, 0x96, 0x35, 0x7f, 0xe2, 0xc7, 0x9b, 0x4d, 0xd2, 0x42, 0xc9, 0x4b, 0xd0, 0x9c,
+	0xc7, 0x0b, 0x1a, 0x90, 0x55, 0x3c, 0x11, 0xbb, 0x68, 0x0b, 0x9a, 0x01, 0x8f, 0x96, 0xc8, 0xc9,
+	0x15, 0xbb, 0x3b, 0x12, 0xa5, 0x74, 0x42, 0xc4, 0x4d, 0xb3, 0x04, 0xea, 0x2d, 0xdb, 0x8b, 0x52,
+	0x7a, 0x84, 0xd5, 0x36, 0x1b, 0x39, 0x85, 0xfa, 0xb2, 0xad, 0x21, 0x6c, 0xe2, 0x78, 0x3a, 0x7d,
+	0xea, 0x07, 0xcf, 0x0b, 0x8d, 0xe5, 0x05, 0x0d, 0xbd, 0xa0, 0xbb, 0x0d, 0xa7, 0x96, 0xfc, 0x65,
+	0xa2, 0xbf, 0xeb, 0x60, 0xdd, 0x09, 0x67, 0x93, 0x48, 0x65, 0xb8, 0x5e, 0x52, 0xd1, 0x82, 0x1e,
+	0x71, 0xdf, 0x92, 0x94, 0xde, 0xca, 0x94, 0x43, 0x93, 0x81, 0x77, 0xbc, 0x8c, 0x10, 0x64, 0x26,
+	0x1e, 0x2f, 0x79, 0x36, 0x8d, 0x0f, 0x9d, 0x7a, 0x45, 0xfc, 0x32, 0x81, 0x31, 0x04, 0x99, 0x09,
+	0x7d, 0x05, 0x27, 0x53, 0xc9, 0x19, 0x6f, 0xca, 0x49, 0x23, 0xd5, 0xf7, 0x82, 0x9e, 0xa3, 0x92,
+	0x91, 0xb8, 0x97, 0x16, 0xcc, 0xe8, 0x1e, 0x74, 0x13, 0xc1, 0x04, 0x6f, 0x46, 0x68, 0xb5, 0xbe,
+	0x56, 0xd0, 0x10, 0x5b, 0x89, 0x66, 0x64, 0xc3, 0x4d, 0x40, 0x2f, 0x93, 0xb4, 0xcb, 0xc3, 0xad,
+	0xcc, 0x2b, 0xfe, 0x66, 0x29, 0x1b, 0xba, 0x0f, 0x3d, 0x2a, 0xef, 0x4c, 0x26, 0xe9, 0xf0, 0x24,
+	0x03, 0x3d, 0x49, 0x15, 0x0b, 0x70, 0x97, 0xea, 0x56, 0x34, 0x84, 0x06, 0x17, 0x23, 0x07, 0x2a,
+	0xe6, 0x8b, 0x26, 0x63, 0x58, 0xb8, 0xa1, 0x3d, 0xe8, 0x69, 0xb7, 0xe9, 0xbd, 0x1c, 0x39, 0x66,
+	0x19, 0x82, 0x0a, 0x3d, 0xc6, 0x56, 0xa0, 0x19, 0xdd, 0x7f, 0xea, 0xd0, 0x95, 0xd4, 0x92, 0x92,
+	0xf5, 0xbf, 0xb8, 0x75, 0xbb, 0x8a, 0x5b, 0xfd, 0x55, 0xdc, 0x92, 0xc3, 0x4e, 0x27, 0xd7, 0xed,
+	0x2a, 0x72, 0xf5, 0x57, 0x91, 0x2b, 0x4b, 0x90, 0xb3, 0xeb, 0xe1, 0x2a, 0x76, 0xb9, 0x6f, 0x63,
+	0x97, 0x4c, 0xb4, 0x4c, 0xaf, 0xbd, 0x6a, 0x7a, 0x0d, 0x56, 0xd3, 0x4b, 0x26, 0x2a, 0xf2, 0xeb,
+	0x6e, 0x25, 0xbf, 0xce, 0xaf, 0xe4, 0x97, 0xfa, 0x08, 0xd0, 0x09, 0xf6, 0x60, 0x05, 0xc1, 0x2e,
+	0xbc, 0x85, 0x60, 0x32, 0xcf, 0x12, 0xc3, 0xae, 0x16, 0x19, 0x76, 0xba, 0x82, 0x61, 0x32, 0x50,
+	0x52, 0xec, 0xcb, 0x15, 0x14, 0x1b, 0xac, 0xa6, 0x98, 0x82, 0xa1, 0xc0, 0xb1, 0xd7, 0x06, 0xac,
+	0x63, 0x7f, 0xac, 0x18, 0xfc, 0x40, 0x60, 0x7c, 0x16, 0x3a, 0xf9, 0xb4, 0x95, 0x13, 0x81, 0xe6,
+	0xa3, 0xf6, 0x1d, 0xdf, 0x2a, 0xe8, 0x06, 0x58, 0x32, 0x9c, 0x24, 0x71, 0xf0, 0x4c, 0x32, 0x66,
+	0xa3, 0xa8, 0xf1, 0x7b, 0x6c, 0x0b, 0x9b, 0x34, 0x5f, 0x20, 0x04, 0x75, 0x3e, 0x25, 0x1b, 0xbc,
+	0x22, 0x7f, 0x66, 0x13, 0x94, 0x92, 0x64, 0x3a, 0x09, 0x7c, 0x8f, 0x12, 0x3f, 0xe4, 0x9f, 0x78,
+	0x6d, 0x6c, 0x4a, 0x1b, 0x26, 0x7e, 0x88, 0xce, 0x01, 0xcc, 0x53, 0x7f, 0x4a, 0x84, 0x43, 0x8b,
+	0x3b, 0x74, 0xb8, 0x85, 0x6d, 0xbb, 0x2f, 0x00, 0x89, 0x13, 0x0a, 0x00, 0xe4, 0x11, 0x3f, 0x80,
+	0x06, 0xff, 0xd5, 0x97, 0x0d, 0x20, 0xf5, 0x1b, 0x70, 0x8f, 0xfd, 0xc5, 0x62, 0x93, 0x75, 0xb4,
+	0x58, 0xc8, 0x2f, 0x0e, 0x0b, 0xf3, 0x67, 0x3e, 0xd3, 0x17, 0x94, 0x92, 0x48, 0xce, 0xf4, 0x9a,
+	0x9c, 0xe9, 0xc2, 0xc6, 0x67, 0xfa, 0xef, 0x06, 0xf4, 0x58, 0xcd, 0xdd, 0x59, 0xa8, 0xc6, 0xc2,
+	0xc7, 0xd0, 0x7c, 0x26, 0xa8, 0x6f, 0x94, 0xc5, 0xb9, 0x74, 0x03, 0x58, 0x3a, 0xa3, 0xab, 0xd0,
+	0xa6, 0x62, 0x63, 0xee, 0xac, 0xf1, 0x49, 0x57, 0xf8, 0x26, 0x97, 0x41, 0x38, 0x73, 0x42, 0x9f,
+	0x43, 0xd7, 0x67, 0x32, 0xe0, 0x49, 0x8b, 0x53, 0x2b, 0x6b, 0x96, 0x3e, 0xaf, 0xb0, 0xe5, 0x6b,
+	0x2b, 0xf7, 0x0f, 0x03, 0x4e, 0x66, 0x9d, 0x4b, 0xd5, 0xb9, 0xb1, 0xd4, 0x7a, 0xbf, 0xdc, 0xba,
+	0x0e, 0x6d, 0xd6, 0xfb, 0x88, 0xb1, 0x48, 0xec, 0xa8, 0xe6, 0x37, 0x8b, 0xcd, 0x8b, 0x4d, 0x9c,
+	0xbb, 0xa1, 0x2f, 0xa0, 0xa7, 0xda, 0x17, 0x26, 0xa7, 0x56, 0x7e, 0x23, 0x0a, 0xa2, 0x88, 0xbb,
+	0xbe, 0xbe, 0xbc, 0xf2, 0x2d, 0xb4, 0xa4, 0x04, 0x22, 0x13, 0x5a, 0xfb, 0xd1, 0x4b, 0x7f, 0x3a,
+	0x09, 0xed, 0x13, 0xa8, 0x05, 0xb5, 0xfb, 0x24, 0xb5, 0x0d, 0xf6, 0x70, 0xb0, 0x48, 0xed, 0x1a,
+	0x02, 0x68, 0x8a, 0xef, 0x7c, 0xbb, 0x8e, 0xda, 0x50, 0x67, 0xbf, 0x14, 0xec, 0x06, 0x3a, 0x09,
+	0xa6, 0xf6, 0xf5, 0x6f, 0x37, 0xaf, 0xfc, 0x62, 0xc8, 0x09, 0xaf, 0xd2, 0xda, 0x60, 0xc9, 0xb4,
+	0xdc, 0x6c, 0x9f, 0x40, 0x3d, 0x80, 0xfc, 0x5d, 0xb3, 0x0d, 0xbe, 0xce, 0xe4, 0xcf, 0xae, 0x21,
+	0x04, 0xbd, 0xa2, 0xba, 0xd9, 0x75, 0x96, 0x45, 0x97, 0x29, 0xbb, 0xc5, 0x2a, 0x6b, 0x92, 0x63,
+	0xb7, 0xd1, 0x3a, 0x74, 0x0b, 0xea, 0x61, 0x77, 0x50, 0x07, 0x1a, 0x5c, 0x0f, 0x6c, 0x60, 0x09,
+	0xf4, 0x17, 0xdc, 0x36, 0xef, 0xda, 0x7f, 0xbe, 0xe9, 0x1b, 0x7f, 0xbd, 0xe9, 0x1b, 0xaf, 0xdf,
+	0xf4, 0x8d, 0x9f, 0xff, 0xed, 0x9f, 0x78, 0xda, 0xe4, 0xff, 0xc2, 0xb8, 0xfe, 0xdf, 0x00, 0x0c,
+	0x5f, 0xe8, 0xcf, 0x0e, 0x11, 0x00, 0x00,
 }
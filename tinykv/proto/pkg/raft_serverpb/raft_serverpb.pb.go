@@ -26,20 +26,24 @@ var _ = math.Inf
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
 // Normal indicates that this Peer is normal;
+// Merging shows that this Peer is being merged into the target region and refuses writes;
 // Tombstone shows that this Peer has been removed from Region and cannot join in Raft Group.
 type PeerState int32
 
 const (
 	PeerState_Normal    PeerState = 0
+	PeerState_Merging   PeerState = 1
 	PeerState_Tombstone PeerState = 2
 )
 
 var PeerState_name = map[int32]string{
 	0: "Normal",
+	1: "Merging",
 	2: "Tombstone",
 }
 var PeerState_value = map[string]int32{
 	"Normal":    0,
+	"Merging":   1,
 	"Tombstone": 2,
 }
 
@@ -47,7 +51,7 @@ func (x PeerState) String() string {
 	return proto.EnumName(PeerState_name, int32(x))
 }
 func (PeerState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_raft_serverpb_9d4bf28a94e26664, []int{0}
+	return fileDescriptor_raft_serverpb_3d8547360c494a22, []int{0}
 }
 
 // The message sent between Raft peer, it wraps the raft meessage with some meta information.
@@ -60,8 +64,12 @@ type RaftMessage struct {
 	// true means to_peer is a tombstone peer and it should remove itself.
 	IsTombstone bool `protobuf:"varint,6,opt,name=is_tombstone,json=isTombstone,proto3" json:"is_tombstone,omitempty"`
 	// Region key range [start_key, end_key). (Used in 3B)
-	StartKey             []byte   `protobuf:"bytes,7,opt,name=start_key,json=startKey,proto3" json:"start_key,omitempty"`
-	EndKey               []byte   `protobuf:"bytes,8,opt,name=end_key,json=endKey,proto3" json:"end_key,omitempty"`
+	StartKey []byte `protobuf:"bytes,7,opt,name=start_key,json=startKey,proto3" json:"start_key,omitempty"`
+	EndKey   []byte `protobuf:"bytes,8,opt,name=end_key,json=endKey,proto3" json:"end_key,omitempty"`
+	// The resolved ts of the leader and the applied index it's computed at, attached to heartbeats. A follower
+	// serves the stale reads at or before the ts once it has applied the index.
+	ResolvedTs           uint64   `protobuf:"varint,9,opt,name=resolved_ts,json=resolvedTs,proto3" json:"resolved_ts,omitempty"`
+	ResolvedIndex        uint64   `protobuf:"varint,10,opt,name=resolved_index,json=resolvedIndex,proto3" json:"resolved_index,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -71,7 +79,7 @@ func (m *RaftMessage) Reset()         { *m = RaftMessage{} }
 func (m *RaftMessage) String() string { return proto.CompactTextString(m) }
 func (*RaftMessage) ProtoMessage()    {}
 func (*RaftMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_serverpb_9d4bf28a94e26664, []int{0}
+	return fileDescriptor_raft_serverpb_3d8547360c494a22, []int{0}
 }
 func (m *RaftMessage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -156,6 +164,20 @@ func (m *RaftMessage) GetEndKey() []byte {
 	return nil
 }
 
+func (m *RaftMessage) GetResolvedTs() uint64 {
+	if m != nil {
+		return m.ResolvedTs
+	}
+	return 0
+}
+
+func (m *RaftMessage) GetResolvedIndex() uint64 {
+	if m != nil {
+		return m.ResolvedIndex
+	}
+	return 0
+}
+
 // Used to store the persistent state for Raft, including the hard state for raft and the last index of the raft log.
 type RaftLocalState struct {
 	HardState            *eraftpb.HardState `protobuf:"bytes,1,opt,name=hard_state,json=hardState" json:"hard_state,omitempty"`
@@ -170,7 +192,7 @@ func (m *RaftLocalState) Reset()         { *m = RaftLocalState{} }
 func (m *RaftLocalState) String() string { return proto.CompactTextString(m) }
 func (*RaftLocalState) ProtoMessage()    {}
 func (*RaftLocalState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_serverpb_9d4bf28a94e26664, []int{1}
+	return fileDescriptor_raft_serverpb_3d8547360c494a22, []int{1}
 }
 func (m *RaftLocalState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -236,7 +258,7 @@ func (m *RaftApplyState) Reset()         { *m = RaftApplyState{} }
 func (m *RaftApplyState) String() string { return proto.CompactTextString(m) }
 func (*RaftApplyState) ProtoMessage()    {}
 func (*RaftApplyState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_serverpb_9d4bf28a94e26664, []int{2}
+	return fileDescriptor_raft_serverpb_3d8547360c494a22, []int{2}
 }
 func (m *RaftApplyState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -292,7 +314,7 @@ func (m *RaftTruncatedState) Reset()         { *m = RaftTruncatedState{} }
 func (m *RaftTruncatedState) String() string { return proto.CompactTextString(m) }
 func (*RaftTruncatedState) ProtoMessage()    {}
 func (*RaftTruncatedState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_serverpb_9d4bf28a94e26664, []int{3}
+	return fileDescriptor_raft_serverpb_3d8547360c494a22, []int{3}
 }
 func (m *RaftTruncatedState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -339,6 +361,7 @@ func (m *RaftTruncatedState) GetTerm() uint64 {
 type RegionLocalState struct {
 	State                PeerState      `protobuf:"varint,1,opt,name=state,proto3,enum=raft_serverpb.PeerState" json:"state,omitempty"`
 	Region               *metapb.Region `protobuf:"bytes,2,opt,name=region" json:"region,omitempty"`
+	MergeState           *MergeState    `protobuf:"bytes,3,opt,name=merge_state,json=mergeState" json:"merge_state,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
@@ -348,7 +371,7 @@ func (m *RegionLocalState) Reset()         { *m = RegionLocalState{} }
 func (m *RegionLocalState) String() string { return proto.CompactTextString(m) }
 func (*RegionLocalState) ProtoMessage()    {}
 func (*RegionLocalState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_serverpb_9d4bf28a94e26664, []int{4}
+	return fileDescriptor_raft_serverpb_3d8547360c494a22, []int{4}
 }
 func (m *RegionLocalState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -391,6 +414,78 @@ func (m *RegionLocalState) GetRegion() *metapb.Region {
 	return nil
 }
 
+func (m *RegionLocalState) GetMergeState() *MergeState {
+	if m != nil {
+		return m.MergeState
+	}
+	return nil
+}
+
+// The state of a region that is being merged into the target region.
+type MergeState struct {
+	MinIndex uint64         `protobuf:"varint,1,opt,name=min_index,json=minIndex,proto3" json:"min_index,omitempty"`
+	Target   *metapb.Region `protobuf:"bytes,2,opt,name=target" json:"target,omitempty"`
+	// The index of the PrepareMerge log.
+	Commit               uint64   `protobuf:"varint,3,opt,name=commit,proto3" json:"commit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MergeState) Reset()         { *m = MergeState{} }
+func (m *MergeState) String() string { return proto.CompactTextString(m) }
+func (*MergeState) ProtoMessage()    {}
+func (*MergeState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_raft_serverpb_3d8547360c494a22, []int{5}
+}
+func (m *MergeState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MergeState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MergeState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *MergeState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MergeState.Merge(dst, src)
+}
+func (m *MergeState) XXX_Size() int {
+	return m.Size()
+}
+func (m *MergeState) XXX_DiscardUnknown() {
+	xxx_messageInfo_MergeState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MergeState proto.InternalMessageInfo
+
+func (m *MergeState) GetMinIndex() uint64 {
+	if m != nil {
+		return m.MinIndex
+	}
+	return 0
+}
+
+func (m *MergeState) GetTarget() *metapb.Region {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
+func (m *MergeState) GetCommit() uint64 {
+	if m != nil {
+		return m.Commit
+	}
+	return 0
+}
+
 // The persistent identification for Store.
 // It used to recover the store id after restart.
 type StoreIdent struct {
@@ -405,7 +500,7 @@ func (m *StoreIdent) Reset()         { *m = StoreIdent{} }
 func (m *StoreIdent) String() string { return proto.CompactTextString(m) }
 func (*StoreIdent) ProtoMessage()    {}
 func (*StoreIdent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_serverpb_9d4bf28a94e26664, []int{5}
+	return fileDescriptor_raft_serverpb_3d8547360c494a22, []int{6}
 }
 func (m *StoreIdent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -462,7 +557,7 @@ func (m *KeyValue) Reset()         { *m = KeyValue{} }
 func (m *KeyValue) String() string { return proto.CompactTextString(m) }
 func (*KeyValue) ProtoMessage()    {}
 func (*KeyValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_serverpb_9d4bf28a94e26664, []int{6}
+	return fileDescriptor_raft_serverpb_3d8547360c494a22, []int{7}
 }
 func (m *KeyValue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -519,7 +614,7 @@ func (m *RaftSnapshotData) Reset()         { *m = RaftSnapshotData{} }
 func (m *RaftSnapshotData) String() string { return proto.CompactTextString(m) }
 func (*RaftSnapshotData) ProtoMessage()    {}
 func (*RaftSnapshotData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_serverpb_9d4bf28a94e26664, []int{7}
+	return fileDescriptor_raft_serverpb_3d8547360c494a22, []int{8}
 }
 func (m *RaftSnapshotData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -589,7 +684,7 @@ func (m *SnapshotCFFile) Reset()         { *m = SnapshotCFFile{} }
 func (m *SnapshotCFFile) String() string { return proto.CompactTextString(m) }
 func (*SnapshotCFFile) ProtoMessage()    {}
 func (*SnapshotCFFile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_serverpb_9d4bf28a94e26664, []int{8}
+	return fileDescriptor_raft_serverpb_3d8547360c494a22, []int{9}
 }
 func (m *SnapshotCFFile) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -650,7 +745,7 @@ func (m *SnapshotMeta) Reset()         { *m = SnapshotMeta{} }
 func (m *SnapshotMeta) String() string { return proto.CompactTextString(m) }
 func (*SnapshotMeta) ProtoMessage()    {}
 func (*SnapshotMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_serverpb_9d4bf28a94e26664, []int{9}
+	return fileDescriptor_raft_serverpb_3d8547360c494a22, []int{10}
 }
 func (m *SnapshotMeta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -698,7 +793,7 @@ func (m *SnapshotChunk) Reset()         { *m = SnapshotChunk{} }
 func (m *SnapshotChunk) String() string { return proto.CompactTextString(m) }
 func (*SnapshotChunk) ProtoMessage()    {}
 func (*SnapshotChunk) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_serverpb_9d4bf28a94e26664, []int{10}
+	return fileDescriptor_raft_serverpb_3d8547360c494a22, []int{11}
 }
 func (m *SnapshotChunk) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -751,7 +846,7 @@ func (m *Done) Reset()         { *m = Done{} }
 func (m *Done) String() string { return proto.CompactTextString(m) }
 func (*Done) ProtoMessage()    {}
 func (*Done) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_serverpb_9d4bf28a94e26664, []int{11}
+	return fileDescriptor_raft_serverpb_3d8547360c494a22, []int{12}
 }
 func (m *Done) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -786,6 +881,7 @@ func init() {
 	proto.RegisterType((*RaftApplyState)(nil), "raft_serverpb.RaftApplyState")
 	proto.RegisterType((*RaftTruncatedState)(nil), "raft_serverpb.RaftTruncatedState")
 	proto.RegisterType((*RegionLocalState)(nil), "raft_serverpb.RegionLocalState")
+	proto.RegisterType((*MergeState)(nil), "raft_serverpb.MergeState")
 	proto.RegisterType((*StoreIdent)(nil), "raft_serverpb.StoreIdent")
 	proto.RegisterType((*KeyValue)(nil), "raft_serverpb.KeyValue")
 	proto.RegisterType((*RaftSnapshotData)(nil), "raft_serverpb.RaftSnapshotData")
@@ -877,6 +973,16 @@ func (m *RaftMessage) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintRaftServerpb(dAtA, i, uint64(len(m.EndKey)))
 		i += copy(dAtA[i:], m.EndKey)
 	}
+	if m.ResolvedTs != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintRaftServerpb(dAtA, i, uint64(m.ResolvedTs))
+	}
+	if m.ResolvedIndex != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintRaftServerpb(dAtA, i, uint64(m.ResolvedIndex))
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -1021,6 +1127,57 @@ func (m *RegionLocalState) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n7
 	}
+	if m.MergeState != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintRaftServerpb(dAtA, i, uint64(m.MergeState.Size()))
+		n8, err := m.MergeState.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *MergeState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MergeState) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.MinIndex != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintRaftServerpb(dAtA, i, uint64(m.MinIndex))
+	}
+	if m.Target != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRaftServerpb(dAtA, i, uint64(m.Target.Size()))
+		n9, err := m.Target.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	if m.Commit != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintRaftServerpb(dAtA, i, uint64(m.Commit))
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -1110,11 +1267,11 @@ func (m *RaftSnapshotData) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRaftServerpb(dAtA, i, uint64(m.Region.Size()))
-		n8, err := m.Region.MarshalTo(dAtA[i:])
+		n10, err := m.Region.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n8
+		i += n10
 	}
 	if m.FileSize != 0 {
 		dAtA[i] = 0x10
@@ -1137,11 +1294,11 @@ func (m *RaftSnapshotData) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintRaftServerpb(dAtA, i, uint64(m.Meta.Size()))
-		n9, err := m.Meta.MarshalTo(dAtA[i:])
+		n11, err := m.Meta.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n9
+		i += n11
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -1238,11 +1395,11 @@ func (m *SnapshotChunk) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRaftServerpb(dAtA, i, uint64(m.Message.Size()))
-		n10, err := m.Message.MarshalTo(dAtA[i:])
+		n12, err := m.Message.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n10
+		i += n12
 	}
 	if len(m.Data) > 0 {
 		dAtA[i] = 0x12
@@ -1319,6 +1476,12 @@ func (m *RaftMessage) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRaftServerpb(uint64(l))
 	}
+	if m.ResolvedTs != 0 {
+		n += 1 + sovRaftServerpb(uint64(m.ResolvedTs))
+	}
+	if m.ResolvedIndex != 0 {
+		n += 1 + sovRaftServerpb(uint64(m.ResolvedIndex))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1385,6 +1548,29 @@ func (m *RegionLocalState) Size() (n int) {
 		l = m.Region.Size()
 		n += 1 + l + sovRaftServerpb(uint64(l))
 	}
+	if m.MergeState != nil {
+		l = m.MergeState.Size()
+		n += 1 + l + sovRaftServerpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MergeState) Size() (n int) {
+	var l int
+	_ = l
+	if m.MinIndex != 0 {
+		n += 1 + sovRaftServerpb(uint64(m.MinIndex))
+	}
+	if m.Target != nil {
+		l = m.Target.Size()
+		n += 1 + l + sovRaftServerpb(uint64(l))
+	}
+	if m.Commit != 0 {
+		n += 1 + sovRaftServerpb(uint64(m.Commit))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1784,6 +1970,44 @@ func (m *RaftMessage) Unmarshal(dAtA []byte) error {
 				m.EndKey = []byte{}
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolvedTs", wireType)
+			}
+			m.ResolvedTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftServerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResolvedTs |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolvedIndex", wireType)
+			}
+			m.ResolvedIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftServerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResolvedIndex |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftServerpb(dAtA[iNdEx:])
@@ -2201,6 +2425,161 @@ func (m *RegionLocalState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MergeState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftServerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftServerpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MergeState == nil {
+				m.MergeState = &MergeState{}
+			}
+			if err := m.MergeState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftServerpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRaftServerpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MergeState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftServerpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MergeState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MergeState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinIndex", wireType)
+			}
+			m.MinIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftServerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinIndex |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftServerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftServerpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Target == nil {
+				m.Target = &metapb.Region{}
+			}
+			if err := m.Target.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			m.Commit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftServerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Commit |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftServerpb(dAtA[iNdEx:])
@@ -3063,55 +3442,62 @@ var (
 	ErrIntOverflowRaftServerpb   = fmt.Errorf("proto: integer overflow")
 )
 
-func init() { proto.RegisterFile("raft_serverpb.proto", fileDescriptor_raft_serverpb_9d4bf28a94e26664) }
-
-var fileDescriptor_raft_serverpb_9d4bf28a94e26664 = []byte{
-	// 751 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xdd, 0x6e, 0xeb, 0x44,
-	0x10, 0x3e, 0x4e, 0xdc, 0xc4, 0x9e, 0x38, 0x21, 0xda, 0x83, 0x74, 0x4c, 0x8e, 0x4e, 0x94, 0x1a,
-	0x51, 0x85, 0x22, 0x05, 0x11, 0x10, 0xe2, 0x0a, 0x09, 0x28, 0x55, 0x43, 0x29, 0xaa, 0x36, 0x15,
-	0x12, 0x57, 0xd6, 0xd6, 0x1e, 0x37, 0xa6, 0x8e, 0x6d, 0xed, 0x6e, 0x2a, 0xd2, 0x1b, 0xc4, 0x5b,
-	0xf0, 0x22, 0x5c, 0xf0, 0x06, 0x5c, 0xf2, 0x08, 0xa8, 0xbc, 0x08, 0xda, 0x5d, 0x3b, 0x3f, 0x6d,
-	0x39, 0x57, 0xd9, 0x99, 0xef, 0xf3, 0xec, 0x37, 0xdf, 0x4c, 0x16, 0x5e, 0x72, 0x96, 0xc8, 0x50,
-	0x20, 0xbf, 0x43, 0x5e, 0x5e, 0x4f, 0x4a, 0x5e, 0xc8, 0x82, 0x74, 0xf7, 0x92, 0x83, 0x2e, 0xaa,
-	0xb8, 0x46, 0x07, 0xde, 0x12, 0x25, 0xab, 0xa3, 0xe0, 0xcf, 0x06, 0x74, 0x28, 0x4b, 0xe4, 0x05,
-	0x0a, 0xc1, 0x6e, 0x90, 0xbc, 0x06, 0x97, 0xe3, 0x4d, 0x5a, 0xe4, 0x61, 0x1a, 0xfb, 0xd6, 0xc8,
-	0x1a, 0xdb, 0xd4, 0x31, 0x89, 0x59, 0x4c, 0x3e, 0x04, 0x37, 0xe1, 0xc5, 0x32, 0x2c, 0x11, 0xb9,
-	0xdf, 0x18, 0x59, 0xe3, 0xce, 0xd4, 0x9b, 0x54, 0xe5, 0x2e, 0x11, 0x39, 0x75, 0x14, 0xac, 0x4e,
-	0xe4, 0x03, 0x68, 0xcb, 0xc2, 0x10, 0x9b, 0xcf, 0x10, 0x5b, 0xb2, 0xd0, 0xb4, 0x63, 0x68, 0x2f,
-	0xcd, 0xcd, 0xbe, 0xad, 0x69, 0xfd, 0x49, 0xad, 0xb6, 0x52, 0x44, 0x6b, 0x02, 0xf9, 0x1c, 0xbc,
-	0x4a, 0x1a, 0x96, 0x45, 0xb4, 0xf0, 0x0f, 0xf4, 0x07, 0x2f, 0xeb, 0xba, 0x54, 0x63, 0xdf, 0x2a,
-	0x88, 0x76, 0xf8, 0x36, 0x20, 0x87, 0xe0, 0xa5, 0x22, 0x94, 0xc5, 0xf2, 0x5a, 0xc8, 0x22, 0x47,
-	0xbf, 0x35, 0xb2, 0xc6, 0x0e, 0xed, 0xa4, 0xe2, 0xaa, 0x4e, 0xa9, 0xae, 0x85, 0x64, 0x5c, 0x86,
-	0xb7, 0xb8, 0xf6, 0xdb, 0x23, 0x6b, 0xec, 0x51, 0x47, 0x27, 0xce, 0x71, 0x4d, 0x5e, 0x41, 0x1b,
-	0xf3, 0x58, 0x43, 0x8e, 0x86, 0x5a, 0x98, 0xc7, 0xe7, 0xb8, 0x0e, 0x7e, 0x85, 0x9e, 0xb2, 0xee,
-	0xfb, 0x22, 0x62, 0xd9, 0x5c, 0x32, 0x89, 0xe4, 0x13, 0x80, 0x05, 0xe3, 0x71, 0x28, 0x54, 0xa4,
-	0xed, 0xeb, 0x4c, 0xc9, 0xa6, 0xa3, 0x33, 0xc6, 0x63, 0xcd, 0xa3, 0xee, 0xa2, 0x3e, 0x92, 0x37,
-	0x00, 0x19, 0x13, 0x32, 0x4c, 0xf3, 0x18, 0x7f, 0xd1, 0xa6, 0xda, 0xd4, 0x55, 0x99, 0x99, 0x4a,
-	0x28, 0x65, 0x1a, 0x96, 0xc8, 0x97, 0xda, 0x49, 0x9b, 0x3a, 0x2a, 0x71, 0x85, 0x7c, 0x19, 0xfc,
-	0x66, 0x19, 0x05, 0x5f, 0x95, 0x65, 0xb6, 0x36, 0xe5, 0xde, 0x87, 0x2e, 0x2b, 0xcb, 0x2c, 0xc5,
-	0xb8, 0xaa, 0x68, 0x66, 0xe8, 0x55, 0x49, 0x53, 0xf4, 0x3b, 0x78, 0x47, 0xf2, 0x55, 0x1e, 0x31,
-	0x89, 0xb5, 0x56, 0x33, 0xcd, 0xc3, 0xc9, 0xfe, 0x3e, 0xa9, 0xe2, 0x57, 0x35, 0xd3, 0x48, 0xef,
-	0xc9, 0xbd, 0x38, 0xf8, 0x12, 0xc8, 0x53, 0x16, 0x79, 0x17, 0x0e, 0x76, 0xaf, 0x37, 0x01, 0x21,
-	0x60, 0xeb, 0x3e, 0x4c, 0x97, 0xfa, 0x1c, 0xfc, 0x0c, 0x7d, 0x33, 0xb9, 0x1d, 0x1b, 0x27, 0x70,
-	0xb0, 0x75, 0xb0, 0x37, 0xf5, 0x1f, 0xa9, 0x52, 0x9b, 0x63, 0xc4, 0x18, 0x1a, 0x39, 0x82, 0x96,
-	0x19, 0x78, 0xd5, 0x46, 0x6f, 0x7f, 0x27, 0x68, 0x85, 0x06, 0xa7, 0x00, 0x73, 0x59, 0x70, 0x9c,
-	0xc5, 0x98, 0x4b, 0xe5, 0x7c, 0x94, 0xad, 0x84, 0x44, 0xbe, 0xdd, 0x75, 0xb7, 0xca, 0xcc, 0x62,
-	0xf2, 0x1e, 0x38, 0x42, 0x91, 0x15, 0x68, 0x04, 0xb7, 0x85, 0xf9, 0x38, 0x98, 0x82, 0x73, 0x8e,
-	0xeb, 0x1f, 0x59, 0xb6, 0x42, 0xd2, 0x87, 0xa6, 0xda, 0x0c, 0x4b, 0x6f, 0x86, 0x3a, 0xaa, 0xde,
-	0xef, 0x14, 0xa4, 0xbf, 0xf2, 0xa8, 0x09, 0x82, 0x3f, 0x2c, 0xe8, 0x2b, 0xa3, 0xe6, 0x39, 0x2b,
-	0xc5, 0xa2, 0x90, 0x27, 0x4c, 0xb2, 0x1d, 0xe1, 0xd6, 0xdb, 0x84, 0xab, 0x2d, 0x48, 0xd2, 0x0c,
-	0x43, 0x91, 0xde, 0x63, 0x25, 0xc6, 0x51, 0x89, 0x79, 0x7a, 0x8f, 0xe4, 0x23, 0xb0, 0x63, 0x26,
-	0x99, 0xdf, 0x1c, 0x35, 0xc7, 0x9d, 0xe9, 0xab, 0x47, 0x66, 0xd5, 0x42, 0xa9, 0x26, 0x91, 0x8f,
-	0xc1, 0x56, 0x57, 0x54, 0x7f, 0x9e, 0xd7, 0x8f, 0xc8, 0xb5, 0xb8, 0x0b, 0x94, 0x8c, 0x6a, 0x62,
-	0x70, 0x09, 0xbd, 0x3a, 0xfb, 0xcd, 0xe9, 0x69, 0x9a, 0x21, 0xe9, 0x41, 0x23, 0x4a, 0xb4, 0x60,
-	0x97, 0x36, 0xa2, 0x44, 0x4d, 0x75, 0x47, 0x97, 0x3e, 0x93, 0x01, 0x38, 0xd1, 0x02, 0xa3, 0x5b,
-	0xb1, 0x32, 0x5b, 0xdb, 0xa5, 0x9b, 0x38, 0x38, 0x03, 0x6f, 0xf7, 0x1e, 0xf2, 0x05, 0x38, 0x51,
-	0x12, 0xaa, 0x76, 0x84, 0x6f, 0xe9, 0x1e, 0xde, 0xfc, 0x8f, 0x2c, 0x23, 0x80, 0xb6, 0xa3, 0x44,
-	0xfd, 0x8a, 0xe0, 0x27, 0xe8, 0x6e, 0xa0, 0xc5, 0x2a, 0xbf, 0x25, 0x9f, 0x6d, 0x9f, 0x13, 0x63,
-	0xe8, 0xe0, 0x99, 0x85, 0x7e, 0xf2, 0xb0, 0x90, 0xca, 0x40, 0x33, 0x2f, 0x7d, 0x0e, 0x5a, 0x60,
-	0x9f, 0x14, 0x39, 0x1e, 0x1f, 0x81, 0xbb, 0x59, 0x37, 0x02, 0xd0, 0xfa, 0xa1, 0xe0, 0x4b, 0x96,
-	0xf5, 0x5f, 0x90, 0x2e, 0xb8, 0x9b, 0xf7, 0xa3, 0xdf, 0xf8, 0xba, 0xff, 0xd7, 0xc3, 0xd0, 0xfa,
-	0xfb, 0x61, 0x68, 0xfd, 0xf3, 0x30, 0xb4, 0x7e, 0xff, 0x77, 0xf8, 0xe2, 0xba, 0xa5, 0x1f, 0xd8,
-	0x4f, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x79, 0xcc, 0xfd, 0xcf, 0xa3, 0x05, 0x00, 0x00,
+func init() { proto.RegisterFile("raft_serverpb.proto", fileDescriptor_raft_serverpb_3d8547360c494a22) }
+
+var fileDescriptor_raft_serverpb_3d8547360c494a22 = []byte{
+	// 850 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x55, 0xdd, 0x8e, 0xdb, 0x44,
+	0x14, 0xae, 0x93, 0xac, 0x63, 0x1f, 0x27, 0x21, 0x9a, 0x22, 0xea, 0xee, 0xaa, 0x4b, 0x6a, 0xd4,
+	0x2a, 0x14, 0x29, 0x88, 0x14, 0x21, 0xc4, 0x05, 0x12, 0x50, 0x56, 0x5d, 0xca, 0xa2, 0x6a, 0x76,
+	0x85, 0xc4, 0x95, 0x35, 0x6b, 0x1f, 0x27, 0xd6, 0xda, 0x1e, 0x6b, 0x66, 0xb2, 0x62, 0x7b, 0x83,
+	0xfa, 0x16, 0x3c, 0x01, 0x6f, 0xc0, 0x3b, 0x70, 0xc9, 0x23, 0xa0, 0xe5, 0x45, 0xd0, 0xcc, 0xd8,
+	0xf9, 0x6b, 0xcb, 0x55, 0xe6, 0x9c, 0xef, 0xf3, 0x39, 0xdf, 0xf9, 0x99, 0x09, 0xdc, 0x15, 0x2c,
+	0x53, 0xb1, 0x44, 0x71, 0x8d, 0xa2, 0xbe, 0x9c, 0xd5, 0x82, 0x2b, 0x4e, 0x86, 0x3b, 0xce, 0xc3,
+	0x21, 0x6a, 0xbb, 0x45, 0x0f, 0x07, 0x25, 0x2a, 0xd6, 0x5a, 0xd1, 0xeb, 0x2e, 0x04, 0x94, 0x65,
+	0xea, 0x0c, 0xa5, 0x64, 0x0b, 0x24, 0x47, 0xe0, 0x0b, 0x5c, 0xe4, 0xbc, 0x8a, 0xf3, 0x34, 0x74,
+	0x26, 0xce, 0xb4, 0x47, 0x3d, 0xeb, 0x38, 0x4d, 0xc9, 0xc7, 0xe0, 0x67, 0x82, 0x97, 0x71, 0x8d,
+	0x28, 0xc2, 0xce, 0xc4, 0x99, 0x06, 0xf3, 0xc1, 0xac, 0x09, 0xf7, 0x12, 0x51, 0x50, 0x4f, 0xc3,
+	0xfa, 0x44, 0x1e, 0x41, 0x5f, 0x71, 0x4b, 0xec, 0xbe, 0x85, 0xe8, 0x2a, 0x6e, 0x68, 0x4f, 0xa0,
+	0x5f, 0xda, 0xcc, 0x61, 0xcf, 0xd0, 0xc6, 0xb3, 0x56, 0x6d, 0xa3, 0x88, 0xb6, 0x04, 0xf2, 0x05,
+	0x0c, 0x1a, 0x69, 0x58, 0xf3, 0x64, 0x19, 0x1e, 0x98, 0x0f, 0xee, 0xb6, 0x71, 0xa9, 0xc1, 0xbe,
+	0xd7, 0x10, 0x0d, 0xc4, 0xc6, 0x20, 0x0f, 0x61, 0x90, 0xcb, 0x58, 0xf1, 0xf2, 0x52, 0x2a, 0x5e,
+	0x61, 0xe8, 0x4e, 0x9c, 0xa9, 0x47, 0x83, 0x5c, 0x5e, 0xb4, 0x2e, 0x5d, 0xb5, 0x54, 0x4c, 0xa8,
+	0xf8, 0x0a, 0x6f, 0xc2, 0xfe, 0xc4, 0x99, 0x0e, 0xa8, 0x67, 0x1c, 0x2f, 0xf0, 0x86, 0xdc, 0x83,
+	0x3e, 0x56, 0xa9, 0x81, 0x3c, 0x03, 0xb9, 0x58, 0xa5, 0x1a, 0xf8, 0x10, 0x02, 0x81, 0x92, 0x17,
+	0xd7, 0x98, 0xc6, 0x4a, 0x86, 0xbe, 0xe9, 0x16, 0xb4, 0xae, 0x0b, 0x49, 0x1e, 0xc1, 0x68, 0x4d,
+	0xc8, 0xab, 0x14, 0x7f, 0x0d, 0xc1, 0x70, 0x86, 0xad, 0xf7, 0x54, 0x3b, 0xa3, 0xdf, 0x60, 0xa4,
+	0x47, 0xf0, 0x23, 0x4f, 0x58, 0x71, 0xae, 0x98, 0x42, 0xf2, 0x19, 0xc0, 0x92, 0x89, 0x34, 0x96,
+	0xda, 0x32, 0x63, 0x08, 0xe6, 0x64, 0xdd, 0x99, 0xe7, 0x4c, 0xa4, 0x86, 0x47, 0xfd, 0x65, 0x7b,
+	0x24, 0x0f, 0x00, 0x0a, 0x26, 0x55, 0x93, 0xa7, 0x63, 0xf2, 0xf8, 0xda, 0x63, 0x72, 0xe8, 0x0a,
+	0x0d, 0xac, 0x50, 0x94, 0x66, 0x22, 0x3d, 0xea, 0x69, 0xc7, 0x05, 0x8a, 0x32, 0x7a, 0xed, 0x58,
+	0x05, 0xdf, 0xd4, 0x75, 0x71, 0x63, 0xc3, 0x7d, 0x04, 0x43, 0x56, 0xd7, 0x45, 0xbe, 0x56, 0x6e,
+	0x77, 0x61, 0xd0, 0x38, 0x6d, 0xd0, 0x1f, 0xe0, 0x3d, 0x25, 0x56, 0x55, 0xc2, 0x14, 0xb6, 0x5a,
+	0xed, 0x56, 0x3c, 0x9c, 0xed, 0xee, 0xa5, 0x0e, 0x7e, 0xd1, 0x32, 0xad, 0xf4, 0x91, 0xda, 0xb1,
+	0xa3, 0xaf, 0x81, 0xbc, 0xc9, 0x22, 0xef, 0xc3, 0xc1, 0x76, 0x7a, 0x6b, 0x10, 0x02, 0x3d, 0x53,
+	0x87, 0xad, 0xd2, 0x9c, 0xa3, 0x3f, 0x1c, 0x18, 0xdb, 0x15, 0xd8, 0xea, 0xe3, 0x0c, 0x0e, 0x36,
+	0x2d, 0x1c, 0xcd, 0xc3, 0x3d, 0x59, 0x7a, 0x05, 0xad, 0x1a, 0x4b, 0x23, 0x8f, 0xc1, 0xb5, 0x9b,
+	0xd3, 0xd4, 0x31, 0xda, 0x5d, 0x2e, 0xda, 0xa0, 0xe4, 0x2b, 0x08, 0x4a, 0x14, 0x0b, 0x6c, 0x8a,
+	0xb6, 0x1b, 0x7e, 0x7f, 0x2f, 0xfa, 0x99, 0x66, 0xd8, 0xf0, 0x50, 0xae, 0xcf, 0x51, 0x0e, 0xb0,
+	0x41, 0xf4, 0x5c, 0xca, 0xbc, 0xda, 0xe9, 0xb1, 0x57, 0xe6, 0x95, 0xed, 0xef, 0x63, 0x70, 0x15,
+	0x13, 0x0b, 0x54, 0xef, 0x92, 0x63, 0x51, 0xf2, 0x01, 0xb8, 0x09, 0x2f, 0xcb, 0x5c, 0x35, 0x93,
+	0x6d, 0xac, 0xe8, 0x04, 0xe0, 0x5c, 0x71, 0x81, 0xa7, 0x29, 0x56, 0x4a, 0x6f, 0x48, 0x52, 0xac,
+	0xa4, 0x42, 0xb1, 0xb9, 0xdb, 0x7e, 0xe3, 0x39, 0x4d, 0xc9, 0x7d, 0xf0, 0xa4, 0x26, 0x6b, 0xd0,
+	0x36, 0xb6, 0x2f, 0xed, 0xc7, 0xd1, 0x1c, 0xbc, 0x17, 0x78, 0xf3, 0x33, 0x2b, 0x56, 0x48, 0xc6,
+	0xd0, 0xd5, 0x37, 0xc1, 0x31, 0x37, 0x41, 0x1f, 0xf5, 0x8c, 0xae, 0x35, 0x64, 0xbe, 0x1a, 0x50,
+	0x6b, 0x44, 0x7f, 0xea, 0x79, 0xb0, 0x4c, 0x9d, 0x57, 0xac, 0x96, 0x4b, 0xae, 0x9e, 0x31, 0xc5,
+	0xb6, 0xfa, 0xeb, 0xfc, 0x6f, 0x7f, 0x8f, 0xc0, 0xcf, 0xf2, 0x02, 0x63, 0x99, 0xbf, 0xc2, 0x46,
+	0x8c, 0xa7, 0x1d, 0xe7, 0xf9, 0x2b, 0x24, 0x9f, 0x40, 0x2f, 0x65, 0x8a, 0x85, 0xdd, 0x49, 0x77,
+	0x1a, 0xcc, 0xef, 0xed, 0x75, 0xbd, 0x15, 0x4a, 0x0d, 0x89, 0x7c, 0x0a, 0x3d, 0x9d, 0xa2, 0x79,
+	0x2c, 0x8e, 0xf6, 0xc8, 0xad, 0xb8, 0x33, 0x54, 0x8c, 0x1a, 0x62, 0xf4, 0x12, 0x46, 0xad, 0xf7,
+	0xbb, 0x93, 0x93, 0xbc, 0x40, 0x32, 0x82, 0x4e, 0x92, 0x19, 0xc1, 0x3e, 0xed, 0x24, 0x99, 0xde,
+	0xbe, 0x2d, 0x5d, 0xe6, 0x4c, 0x0e, 0xc1, 0x4b, 0x96, 0x98, 0x5c, 0xc9, 0x95, 0xbd, 0x5d, 0x43,
+	0xba, 0xb6, 0xa3, 0xe7, 0x30, 0xd8, 0xce, 0x43, 0xbe, 0x04, 0x2f, 0xc9, 0x62, 0x5d, 0x8e, 0x0c,
+	0x1d, 0x53, 0xc3, 0x83, 0x77, 0xc8, 0xb2, 0x02, 0x68, 0x3f, 0xc9, 0xf4, 0xaf, 0x8c, 0x7e, 0x81,
+	0xe1, 0x1a, 0x5a, 0xae, 0xaa, 0x2b, 0xf2, 0xf9, 0xe6, 0xf9, 0xb4, 0x0d, 0x3d, 0x7c, 0xcb, 0xc5,
+	0x7b, 0xe3, 0x21, 0x25, 0x4d, 0x03, 0xed, 0xbc, 0xcc, 0x39, 0x72, 0xa1, 0xf7, 0x8c, 0x57, 0xf8,
+	0xe4, 0x29, 0xf8, 0xeb, 0x5b, 0x41, 0x00, 0xdc, 0x9f, 0xb8, 0x28, 0x59, 0x31, 0xbe, 0x43, 0x02,
+	0xe8, 0xeb, 0xb5, 0xcd, 0xab, 0xc5, 0xd8, 0x21, 0x43, 0xf0, 0xd7, 0x8f, 0xe7, 0xb8, 0xf3, 0xed,
+	0xf8, 0xaf, 0xdb, 0x63, 0xe7, 0xef, 0xdb, 0x63, 0xe7, 0x9f, 0xdb, 0x63, 0xe7, 0xf7, 0x7f, 0x8f,
+	0xef, 0x5c, 0xba, 0xe6, 0xdf, 0xe5, 0xe9, 0x7f, 0x03, 0x00, 0xa9, 0x81, 0x31, 0x4e, 0xa0, 0x06,
+	0x00, 0x00,
 }
@@ -67,7 +67,7 @@ func (x ErrorType) String() string {
 	return proto.EnumName(ErrorType_name, int32(x))
 }
 func (ErrorType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_schedulerpb_4e333137f5959f12, []int{0}
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{0}
 }
 
 type OperatorStatus int32
@@ -99,7 +99,40 @@ func (x OperatorStatus) String() string {
 	return proto.EnumName(OperatorStatus_name, int32(x))
 }
 func (OperatorStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_schedulerpb_4e333137f5959f12, []int{1}
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{1}
+}
+
+type LabelConstraintOp int32
+
+const (
+	// The label value of the store is one of the values.
+	LabelConstraintOp_In LabelConstraintOp = 0
+	// The label value of the store is none of the values.
+	LabelConstraintOp_NotIn LabelConstraintOp = 1
+	// The store has the label.
+	LabelConstraintOp_Exists LabelConstraintOp = 2
+	// The store doesn't have the label.
+	LabelConstraintOp_NotExists LabelConstraintOp = 3
+)
+
+var LabelConstraintOp_name = map[int32]string{
+	0: "In",
+	1: "NotIn",
+	2: "Exists",
+	3: "NotExists",
+}
+var LabelConstraintOp_value = map[string]int32{
+	"In":        0,
+	"NotIn":     1,
+	"Exists":    2,
+	"NotExists": 3,
+}
+
+func (x LabelConstraintOp) String() string {
+	return proto.EnumName(LabelConstraintOp_name, int32(x))
+}
+func (LabelConstraintOp) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{2}
 }
 
 type RequestHeader struct {
@@ -114,7 +147,7 @@ func (m *RequestHeader) Reset()         { *m = RequestHeader{} }
 func (m *RequestHeader) String() string { return proto.CompactTextString(m) }
 func (*RequestHeader) ProtoMessage()    {}
 func (*RequestHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_schedulerpb_4e333137f5959f12, []int{0}
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{0}
 }
 func (m *RequestHeader) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -163,7 +196,7 @@ func (m *ResponseHeader) Reset()         { *m = ResponseHeader{} }
 func (m *ResponseHeader) String() string { return proto.CompactTextString(m) }
 func (*ResponseHeader) ProtoMessage()    {}
 func (*ResponseHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_schedulerpb_4e333137f5959f12, []int{1}
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{1}
 }
 func (m *ResponseHeader) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -218,7 +251,7 @@ func (m *Error) Reset()         { *m = Error{} }
 func (m *Error) String() string { return proto.CompactTextString(m) }
 func (*Error) ProtoMessage()    {}
 func (*Error) Descriptor() ([]byte, []int) {
-	return fileDescriptor_schedulerpb_4e333137f5959f12, []int{2}
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{2}
 }
 func (m *Error) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -273,7 +306,7 @@ func (m *TsoRequest) Reset()         { *m = TsoRequest{} }
 func (m *TsoRequest) String() string { return proto.CompactTextString(m) }
 func (*TsoRequest) ProtoMessage()    {}
 func (*TsoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_schedulerpb_4e333137f5959f12, []int{3}
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{3}
 }
 func (m *TsoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -328,7 +361,7 @@ func (m *Timestamp) Reset()         { *m = Timestamp{} }
 func (m *Timestamp) String() string { return proto.CompactTextString(m) }
 func (*Timestamp) ProtoMessage()    {}
 func (*Timestamp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_schedulerpb_4e333137f5959f12, []int{4}
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{4}
 }
 func (m *Timestamp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -384,7 +417,7 @@ func (m *TsoResponse) Reset()         { *m = TsoResponse{} }
 func (m *TsoResponse) String() string { return proto.CompactTextString(m) }
 func (*TsoResponse) ProtoMessage()    {}
 func (*TsoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_schedulerpb_4e333137f5959f12, []int{5}
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{5}
 }
 func (m *TsoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -446,7 +479,7 @@ func (m *BootstrapRequest) Reset()         { *m = BootstrapRequest{} }
 func (m *BootstrapRequest) String() string { return proto.CompactTextString(m) }
 func (*BootstrapRequest) ProtoMessage()    {}
 func (*BootstrapRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_schedulerpb_4e333137f5959f12, []int{6}
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{6}
 }
 func (m *BootstrapRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -500,7 +533,7 @@ func (m *BootstrapResponse) Reset()         { *m = BootstrapResponse{} }
 func (m *BootstrapResponse) String() string { return proto.CompactTextString(m) }
 func (*BootstrapResponse) ProtoMessage()    {}
 func (*BootstrapResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_schedulerpb_4e333137f5959f12, []int{7}
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{7}
 }
 func (m *BootstrapResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -547,7 +580,7 @@ func (m *IsBootstrappedRequest) Reset()         { *m = IsBootstrappedRequest{} }
 func (m *IsBootstrappedRequest) String() string { return proto.CompactTextString(m) }
 func (*IsBootstrappedRequest) ProtoMessage()    {}
 func (*IsBootstrappedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_schedulerpb_4e333137f5959f12, []int{8}
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{8}
 }
 func (m *IsBootstrappedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -595,7 +628,7 @@ func (m *IsBootstrappedResponse) Reset()         { *m = IsBootstrappedResponse{}
 func (m *IsBootstrappedResponse) String() string { return proto.CompactTextString(m) }
 func (*IsBootstrappedResponse) ProtoMessage()    {}
 func (*IsBootstrappedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_schedulerpb_4e333137f5959f12, []int{9}
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{9}
 }
 func (m *IsBootstrappedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -649,7 +682,7 @@ func (m *AllocIDRequest) Reset()         { *m = AllocIDRequest{} }
 func (m *AllocIDRequest) String() string { return proto.CompactTextString(m) }
 func (*AllocIDRequest) ProtoMessage()    {}
 func (*AllocIDRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_schedulerpb_4e333137f5959f12, []int{10}
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{10}
 }
 func (m *AllocIDRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -697,7 +730,7 @@ func (m *AllocIDResponse) Reset()         { *m = AllocIDResponse{} }
 func (m *AllocIDResponse) String() string { return proto.CompactTextString(m) }
 func (*AllocIDResponse) ProtoMessage()    {}
 func (*AllocIDResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_schedulerpb_4e333137f5959f12, []int{11}
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{11}
 }
 func (m *AllocIDResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -752,7 +785,7 @@ func (m *GetStoreRequest) Reset()         { *m = GetStoreRequest{} }
 func (m *GetStoreRequest) String() string { return proto.CompactTextString(m) }
 func (*GetStoreRequest) ProtoMessage()    {}
 func (*GetStoreRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_schedulerpb_4e333137f5959f12, []int{12}
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{12}
 }
 func (m *GetStoreRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -808,7 +841,7 @@ func (m *GetStoreResponse) Reset()         { *m = GetStoreResponse{} }
 func (m *GetStoreResponse) String() string { return proto.CompactTextString(m) }
 func (*GetStoreResponse) ProtoMessage()    {}
 func (*GetStoreResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_schedulerpb_4e333137f5959f12, []int{13}
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{13}
 }
 func (m *GetStoreResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -870,7 +903,7 @@ func (m *PutStoreRequest) Reset()         { *m = PutStoreRequest{} }
 func (m *PutStoreRequest) String() string { return proto.CompactTextString(m) }
 func (*PutStoreRequest) ProtoMessage()    {}
 func (*PutStoreRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_schedulerpb_4e333137f5959f12, []int{14}
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{14}
 }
 func (m *PutStoreRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -924,7 +957,7 @@ func (m *PutStoreResponse) Reset()         { *m = PutStoreResponse{} }
 func (m *PutStoreResponse) String() string { return proto.CompactTextString(m) }
 func (*PutStoreResponse) ProtoMessage()    {}
 func (*PutStoreResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_schedulerpb_4e333137f5959f12, []int{15}
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{15}
 }
 func (m *PutStoreResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -973,7 +1006,7 @@ func (m *GetAllStoresRequest) Reset()         { *m = GetAllStoresRequest{} }
 func (m *GetAllStoresRequest) String() string { return proto.CompactTextString(m) }
 func (*GetAllStoresRequest) ProtoMessage()    {}
 func (*GetAllStoresRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_schedulerpb_4e333137f5959f12, []int{16}
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{16}
 }
 func (m *GetAllStoresRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1028,7 +1061,7 @@ func (m *GetAllStoresResponse) Reset()         { *m = GetAllStoresResponse{} }
 func (m *GetAllStoresResponse) String() string { return proto.CompactTextString(m) }
 func (*GetAllStoresResponse) ProtoMessage()    {}
 func (*GetAllStoresResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_schedulerpb_4e333137f5959f12, []int{17}
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{17}
 }
 func (m *GetAllStoresResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1083,7 +1116,7 @@ func (m *GetRegionRequest) Reset()         { *m = GetRegionRequest{} }
 func (m *GetRegionRequest) String() string { return proto.CompactTextString(m) }
 func (*GetRegionRequest) ProtoMessage()    {}
 func (*GetRegionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_schedulerpb_4e333137f5959f12, []int{18}
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{18}
 }
 func (m *GetRegionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1140,7 +1173,7 @@ func (m *GetRegionResponse) Reset()         { *m = GetRegionResponse{} }
 func (m *GetRegionResponse) String() string { return proto.CompactTextString(m) }
 func (*GetRegionResponse) ProtoMessage()    {}
 func (*GetRegionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_schedulerpb_4e333137f5959f12, []int{19}
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{19}
 }
 func (m *GetRegionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1209,7 +1242,7 @@ func (m *GetRegionByIDRequest) Reset()         { *m = GetRegionByIDRequest{} }
 func (m *GetRegionByIDRequest) String() string { return proto.CompactTextString(m) }
 func (*GetRegionByIDRequest) ProtoMessage()    {}
 func (*GetRegionByIDRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_schedulerpb_4e333137f5959f12, []int{20}
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{20}
 }
 func (m *GetRegionByIDRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1266,7 +1299,7 @@ func (m *ScanRegionsRequest) Reset()         { *m = ScanRegionsRequest{} }
 func (m *ScanRegionsRequest) String() string { return proto.CompactTextString(m) }
 func (*ScanRegionsRequest) ProtoMessage()    {}
 func (*ScanRegionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_schedulerpb_4e333137f5959f12, []int{21}
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{21}
 }
 func (m *ScanRegionsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1336,7 +1369,7 @@ func (m *ScanRegionsResponse) Reset()         { *m = ScanRegionsResponse{} }
 func (m *ScanRegionsResponse) String() string { return proto.CompactTextString(m) }
 func (*ScanRegionsResponse) ProtoMessage()    {}
 func (*ScanRegionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_schedulerpb_4e333137f5959f12, []int{22}
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{22}
 }
 func (m *ScanRegionsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1397,7 +1430,7 @@ func (m *GetClusterConfigRequest) Reset()         { *m = GetClusterConfigRequest
 func (m *GetClusterConfigRequest) String() string { return proto.CompactTextString(m) }
 func (*GetClusterConfigRequest) ProtoMessage()    {}
 func (*GetClusterConfigRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_schedulerpb_4e333137f5959f12, []int{23}
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{23}
 }
 func (m *GetClusterConfigRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1445,7 +1478,7 @@ func (m *GetClusterConfigResponse) Reset()         { *m = GetClusterConfigRespon
 func (m *GetClusterConfigResponse) String() string { return proto.CompactTextString(m) }
 func (*GetClusterConfigResponse) ProtoMessage()    {}
 func (*GetClusterConfigResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_schedulerpb_4e333137f5959f12, []int{24}
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{24}
 }
 func (m *GetClusterConfigResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1500,7 +1533,7 @@ func (m *PutClusterConfigRequest) Reset()         { *m = PutClusterConfigRequest
 func (m *PutClusterConfigRequest) String() string { return proto.CompactTextString(m) }
 func (*PutClusterConfigRequest) ProtoMessage()    {}
 func (*PutClusterConfigRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_schedulerpb_4e333137f5959f12, []int{25}
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{25}
 }
 func (m *PutClusterConfigRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1554,7 +1587,7 @@ func (m *PutClusterConfigResponse) Reset()         { *m = PutClusterConfigRespon
 func (m *PutClusterConfigResponse) String() string { return proto.CompactTextString(m) }
 func (*PutClusterConfigResponse) ProtoMessage()    {}
 func (*PutClusterConfigResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_schedulerpb_4e333137f5959f12, []int{26}
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{26}
 }
 func (m *PutClusterConfigResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1607,7 +1640,7 @@ func (m *Member) Reset()         { *m = Member{} }
 func (m *Member) String() string { return proto.CompactTextString(m) }
 func (*Member) ProtoMessage()    {}
 func (*Member) Descriptor() ([]byte, []int) {
-	return fileDescriptor_schedulerpb_4e333137f5959f12, []int{27}
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{27}
 }
 func (m *Member) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1682,7 +1715,7 @@ func (m *GetMembersRequest) Reset()         { *m = GetMembersRequest{} }
 func (m *GetMembersRequest) String() string { return proto.CompactTextString(m) }
 func (*GetMembersRequest) ProtoMessage()    {}
 func (*GetMembersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_schedulerpb_4e333137f5959f12, []int{28}
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{28}
 }
 func (m *GetMembersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1732,7 +1765,7 @@ func (m *GetMembersResponse) Reset()         { *m = GetMembersResponse{} }
 func (m *GetMembersResponse) String() string { return proto.CompactTextString(m) }
 func (*GetMembersResponse) ProtoMessage()    {}
 func (*GetMembersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_schedulerpb_4e333137f5959f12, []int{29}
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{29}
 }
 func (m *GetMembersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1797,8 +1830,18 @@ type RegionHeartbeatRequest struct {
 	// Pending peers are the peers that the leader can't consider as
 	// working followers.
 	PendingPeers []*metapb.Peer `protobuf:"bytes,5,rep,name=pending_peers,json=pendingPeers" json:"pending_peers,omitempty"`
+	// Bytes read/written during this period.
+	BytesWritten uint64 `protobuf:"varint,6,opt,name=bytes_written,json=bytesWritten,proto3" json:"bytes_written,omitempty"`
+	BytesRead    uint64 `protobuf:"varint,7,opt,name=bytes_read,json=bytesRead,proto3" json:"bytes_read,omitempty"`
+	// Keys read/written during this period.
+	KeysWritten uint64 `protobuf:"varint,8,opt,name=keys_written,json=keysWritten,proto3" json:"keys_written,omitempty"`
+	KeysRead    uint64 `protobuf:"varint,9,opt,name=keys_read,json=keysRead,proto3" json:"keys_read,omitempty"`
 	// Approximate region size.
-	ApproximateSize      uint64   `protobuf:"varint,10,opt,name=approximate_size,json=approximateSize,proto3" json:"approximate_size,omitempty"`
+	ApproximateSize uint64 `protobuf:"varint,10,opt,name=approximate_size,json=approximateSize,proto3" json:"approximate_size,omitempty"`
+	// Actually reported time interval
+	Interval *TimeInterval `protobuf:"bytes,12,opt,name=interval" json:"interval,omitempty"`
+	// No transaction commits at or before the resolved ts in the region later.
+	ResolvedTs           uint64   `protobuf:"varint,13,opt,name=resolved_ts,json=resolvedTs,proto3" json:"resolved_ts,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1808,7 +1851,7 @@ func (m *RegionHeartbeatRequest) Reset()         { *m = RegionHeartbeatRequest{}
 func (m *RegionHeartbeatRequest) String() string { return proto.CompactTextString(m) }
 func (*RegionHeartbeatRequest) ProtoMessage()    {}
 func (*RegionHeartbeatRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_schedulerpb_4e333137f5959f12, []int{30}
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{30}
 }
 func (m *RegionHeartbeatRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1865,6 +1908,34 @@ func (m *RegionHeartbeatRequest) GetPendingPeers() []*metapb.Peer {
 	return nil
 }
 
+func (m *RegionHeartbeatRequest) GetBytesWritten() uint64 {
+	if m != nil {
+		return m.BytesWritten
+	}
+	return 0
+}
+
+func (m *RegionHeartbeatRequest) GetBytesRead() uint64 {
+	if m != nil {
+		return m.BytesRead
+	}
+	return 0
+}
+
+func (m *RegionHeartbeatRequest) GetKeysWritten() uint64 {
+	if m != nil {
+		return m.KeysWritten
+	}
+	return 0
+}
+
+func (m *RegionHeartbeatRequest) GetKeysRead() uint64 {
+	if m != nil {
+		return m.KeysRead
+	}
+	return 0
+}
+
 func (m *RegionHeartbeatRequest) GetApproximateSize() uint64 {
 	if m != nil {
 		return m.ApproximateSize
@@ -1872,6 +1943,20 @@ func (m *RegionHeartbeatRequest) GetApproximateSize() uint64 {
 	return 0
 }
 
+func (m *RegionHeartbeatRequest) GetInterval() *TimeInterval {
+	if m != nil {
+		return m.Interval
+	}
+	return nil
+}
+
+func (m *RegionHeartbeatRequest) GetResolvedTs() uint64 {
+	if m != nil {
+		return m.ResolvedTs
+	}
+	return 0
+}
+
 type ChangePeer struct {
 	Peer                 *metapb.Peer           `protobuf:"bytes,1,opt,name=peer" json:"peer,omitempty"`
 	ChangeType           eraftpb.ConfChangeType `protobuf:"varint,2,opt,name=change_type,json=changeType,proto3,enum=eraftpb.ConfChangeType" json:"change_type,omitempty"`
@@ -1884,7 +1969,7 @@ func (m *ChangePeer) Reset()         { *m = ChangePeer{} }
 func (m *ChangePeer) String() string { return proto.CompactTextString(m) }
 func (*ChangePeer) ProtoMessage()    {}
 func (*ChangePeer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_schedulerpb_4e333137f5959f12, []int{31}
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{31}
 }
 func (m *ChangePeer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1938,7 +2023,7 @@ func (m *TransferLeader) Reset()         { *m = TransferLeader{} }
 func (m *TransferLeader) String() string { return proto.CompactTextString(m) }
 func (*TransferLeader) ProtoMessage()    {}
 func (*TransferLeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_schedulerpb_4e333137f5959f12, []int{32}
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{32}
 }
 func (m *TransferLeader) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1974,6 +2059,53 @@ func (m *TransferLeader) GetPeer() *metapb.Peer {
 	return nil
 }
 
+type Merge struct {
+	Target               *metapb.Region `protobuf:"bytes,1,opt,name=target" json:"target,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *Merge) Reset()         { *m = Merge{} }
+func (m *Merge) String() string { return proto.CompactTextString(m) }
+func (*Merge) ProtoMessage()    {}
+func (*Merge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{33}
+}
+func (m *Merge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Merge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Merge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *Merge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Merge.Merge(dst, src)
+}
+func (m *Merge) XXX_Size() int {
+	return m.Size()
+}
+func (m *Merge) XXX_DiscardUnknown() {
+	xxx_messageInfo_Merge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Merge proto.InternalMessageInfo
+
+func (m *Merge) GetTarget() *metapb.Region {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
 type RegionHeartbeatResponse struct {
 	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
 	// Notice, Scheduleeer only allows handling reported epoch >= current scheduler's.
@@ -1997,17 +2129,19 @@ type RegionHeartbeatResponse struct {
 	RegionId    uint64              `protobuf:"varint,4,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"`
 	RegionEpoch *metapb.RegionEpoch `protobuf:"bytes,5,opt,name=region_epoch,json=regionEpoch" json:"region_epoch,omitempty"`
 	// Leader of the region at the moment of the corresponding request was made.
-	TargetPeer           *metapb.Peer `protobuf:"bytes,6,opt,name=target_peer,json=targetPeer" json:"target_peer,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	TargetPeer *metapb.Peer `protobuf:"bytes,6,opt,name=target_peer,json=targetPeer" json:"target_peer,omitempty"`
+	// Scheduler can return merge to let the region be merged into the target region.
+	Merge                *Merge   `protobuf:"bytes,7,opt,name=merge" json:"merge,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *RegionHeartbeatResponse) Reset()         { *m = RegionHeartbeatResponse{} }
 func (m *RegionHeartbeatResponse) String() string { return proto.CompactTextString(m) }
 func (*RegionHeartbeatResponse) ProtoMessage()    {}
 func (*RegionHeartbeatResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_schedulerpb_4e333137f5959f12, []int{33}
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{34}
 }
 func (m *RegionHeartbeatResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2078,6 +2212,13 @@ func (m *RegionHeartbeatResponse) GetTargetPeer() *metapb.Peer {
 	return nil
 }
 
+func (m *RegionHeartbeatResponse) GetMerge() *Merge {
+	if m != nil {
+		return m.Merge
+	}
+	return nil
+}
+
 type AskSplitRequest struct {
 	Header               *RequestHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
 	Region               *metapb.Region `protobuf:"bytes,2,opt,name=region" json:"region,omitempty"`
@@ -2090,7 +2231,7 @@ func (m *AskSplitRequest) Reset()         { *m = AskSplitRequest{} }
 func (m *AskSplitRequest) String() string { return proto.CompactTextString(m) }
 func (*AskSplitRequest) ProtoMessage()    {}
 func (*AskSplitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_schedulerpb_4e333137f5959f12, []int{34}
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{35}
 }
 func (m *AskSplitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2150,7 +2291,7 @@ func (m *AskSplitResponse) Reset()         { *m = AskSplitResponse{} }
 func (m *AskSplitResponse) String() string { return proto.CompactTextString(m) }
 func (*AskSplitResponse) ProtoMessage()    {}
 func (*AskSplitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_schedulerpb_4e333137f5959f12, []int{35}
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{36}
 }
 func (m *AskSplitResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2213,7 +2354,7 @@ func (m *ReportSplitRequest) Reset()         { *m = ReportSplitRequest{} }
 func (m *ReportSplitRequest) String() string { return proto.CompactTextString(m) }
 func (*ReportSplitRequest) ProtoMessage()    {}
 func (*ReportSplitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_schedulerpb_4e333137f5959f12, []int{36}
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{37}
 }
 func (m *ReportSplitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2274,7 +2415,7 @@ func (m *ReportSplitResponse) Reset()         { *m = ReportSplitResponse{} }
 func (m *ReportSplitResponse) String() string { return proto.CompactTextString(m) }
 func (*ReportSplitResponse) ProtoMessage()    {}
 func (*ReportSplitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_schedulerpb_4e333137f5959f12, []int{37}
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{38}
 }
 func (m *ReportSplitResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2322,7 +2463,7 @@ func (m *SplitID) Reset()         { *m = SplitID{} }
 func (m *SplitID) String() string { return proto.CompactTextString(m) }
 func (*SplitID) ProtoMessage()    {}
 func (*SplitID) Descriptor() ([]byte, []int) {
-	return fileDescriptor_schedulerpb_4e333137f5959f12, []int{38}
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{39}
 }
 func (m *SplitID) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2379,7 +2520,7 @@ func (m *TimeInterval) Reset()         { *m = TimeInterval{} }
 func (m *TimeInterval) String() string { return proto.CompactTextString(m) }
 func (*TimeInterval) ProtoMessage()    {}
 func (*TimeInterval) Descriptor() ([]byte, []int) {
-	return fileDescriptor_schedulerpb_4e333137f5959f12, []int{39}
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{40}
 }
 func (m *TimeInterval) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2434,7 +2575,7 @@ func (m *RecordPair) Reset()         { *m = RecordPair{} }
 func (m *RecordPair) String() string { return proto.CompactTextString(m) }
 func (*RecordPair) ProtoMessage()    {}
 func (*RecordPair) Descriptor() ([]byte, []int) {
-	return fileDescriptor_schedulerpb_4e333137f5959f12, []int{40}
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{41}
 }
 func (m *RecordPair) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2497,6 +2638,14 @@ type StoreStats struct {
 	IsBusy bool `protobuf:"varint,9,opt,name=is_busy,json=isBusy,proto3" json:"is_busy,omitempty"`
 	// Actually used space by db
 	UsedSize uint64 `protobuf:"varint,10,opt,name=used_size,json=usedSize,proto3" json:"used_size,omitempty"`
+	// Bytes written for the store during this period.
+	BytesWritten uint64 `protobuf:"varint,11,opt,name=bytes_written,json=bytesWritten,proto3" json:"bytes_written,omitempty"`
+	// Keys written for the store during this period.
+	KeysWritten uint64 `protobuf:"varint,12,opt,name=keys_written,json=keysWritten,proto3" json:"keys_written,omitempty"`
+	// Bytes read for the store during this period.
+	BytesRead uint64 `protobuf:"varint,13,opt,name=bytes_read,json=bytesRead,proto3" json:"bytes_read,omitempty"`
+	// Keys read for the store during this period.
+	KeysRead uint64 `protobuf:"varint,14,opt,name=keys_read,json=keysRead,proto3" json:"keys_read,omitempty"`
 	// Actually reported time interval
 	Interval *TimeInterval `protobuf:"bytes,15,opt,name=interval" json:"interval,omitempty"`
 	// Threads' CPU usages in the store
@@ -2516,7 +2665,7 @@ func (m *StoreStats) Reset()         { *m = StoreStats{} }
 func (m *StoreStats) String() string { return proto.CompactTextString(m) }
 func (*StoreStats) ProtoMessage()    {}
 func (*StoreStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_schedulerpb_4e333137f5959f12, []int{41}
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{42}
 }
 func (m *StoreStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2615,6 +2764,34 @@ func (m *StoreStats) GetUsedSize() uint64 {
 	return 0
 }
 
+func (m *StoreStats) GetBytesWritten() uint64 {
+	if m != nil {
+		return m.BytesWritten
+	}
+	return 0
+}
+
+func (m *StoreStats) GetKeysWritten() uint64 {
+	if m != nil {
+		return m.KeysWritten
+	}
+	return 0
+}
+
+func (m *StoreStats) GetBytesRead() uint64 {
+	if m != nil {
+		return m.BytesRead
+	}
+	return 0
+}
+
+func (m *StoreStats) GetKeysRead() uint64 {
+	if m != nil {
+		return m.KeysRead
+	}
+	return 0
+}
+
 func (m *StoreStats) GetInterval() *TimeInterval {
 	if m != nil {
 		return m.Interval
@@ -2662,7 +2839,7 @@ func (m *StoreHeartbeatRequest) Reset()         { *m = StoreHeartbeatRequest{} }
 func (m *StoreHeartbeatRequest) String() string { return proto.CompactTextString(m) }
 func (*StoreHeartbeatRequest) ProtoMessage()    {}
 func (*StoreHeartbeatRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_schedulerpb_4e333137f5959f12, []int{42}
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{43}
 }
 func (m *StoreHeartbeatRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2716,7 +2893,7 @@ func (m *StoreHeartbeatResponse) Reset()         { *m = StoreHeartbeatResponse{}
 func (m *StoreHeartbeatResponse) String() string { return proto.CompactTextString(m) }
 func (*StoreHeartbeatResponse) ProtoMessage()    {}
 func (*StoreHeartbeatResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_schedulerpb_4e333137f5959f12, []int{43}
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{44}
 }
 func (m *StoreHeartbeatResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2768,7 +2945,7 @@ func (m *ScatterRegionRequest) Reset()         { *m = ScatterRegionRequest{} }
 func (m *ScatterRegionRequest) String() string { return proto.CompactTextString(m) }
 func (*ScatterRegionRequest) ProtoMessage()    {}
 func (*ScatterRegionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_schedulerpb_4e333137f5959f12, []int{44}
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{45}
 }
 func (m *ScatterRegionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2836,7 +3013,7 @@ func (m *ScatterRegionResponse) Reset()         { *m = ScatterRegionResponse{} }
 func (m *ScatterRegionResponse) String() string { return proto.CompactTextString(m) }
 func (*ScatterRegionResponse) ProtoMessage()    {}
 func (*ScatterRegionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_schedulerpb_4e333137f5959f12, []int{45}
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{46}
 }
 func (m *ScatterRegionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2883,7 +3060,7 @@ func (m *GetGCSafePointRequest) Reset()         { *m = GetGCSafePointRequest{} }
 func (m *GetGCSafePointRequest) String() string { return proto.CompactTextString(m) }
 func (*GetGCSafePointRequest) ProtoMessage()    {}
 func (*GetGCSafePointRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_schedulerpb_4e333137f5959f12, []int{46}
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{47}
 }
 func (m *GetGCSafePointRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2931,7 +3108,7 @@ func (m *GetGCSafePointResponse) Reset()         { *m = GetGCSafePointResponse{}
 func (m *GetGCSafePointResponse) String() string { return proto.CompactTextString(m) }
 func (*GetGCSafePointResponse) ProtoMessage()    {}
 func (*GetGCSafePointResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_schedulerpb_4e333137f5959f12, []int{47}
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{48}
 }
 func (m *GetGCSafePointResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2986,7 +3163,7 @@ func (m *UpdateGCSafePointRequest) Reset()         { *m = UpdateGCSafePointReque
 func (m *UpdateGCSafePointRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateGCSafePointRequest) ProtoMessage()    {}
 func (*UpdateGCSafePointRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_schedulerpb_4e333137f5959f12, []int{48}
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{49}
 }
 func (m *UpdateGCSafePointRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3041,7 +3218,7 @@ func (m *UpdateGCSafePointResponse) Reset()         { *m = UpdateGCSafePointResp
 func (m *UpdateGCSafePointResponse) String() string { return proto.CompactTextString(m) }
 func (*UpdateGCSafePointResponse) ProtoMessage()    {}
 func (*UpdateGCSafePointResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_schedulerpb_4e333137f5959f12, []int{49}
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{50}
 }
 func (m *UpdateGCSafePointResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3096,7 +3273,7 @@ func (m *GetOperatorRequest) Reset()         { *m = GetOperatorRequest{} }
 func (m *GetOperatorRequest) String() string { return proto.CompactTextString(m) }
 func (*GetOperatorRequest) ProtoMessage()    {}
 func (*GetOperatorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_schedulerpb_4e333137f5959f12, []int{50}
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{51}
 }
 func (m *GetOperatorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3154,7 +3331,7 @@ func (m *GetOperatorResponse) Reset()         { *m = GetOperatorResponse{} }
 func (m *GetOperatorResponse) String() string { return proto.CompactTextString(m) }
 func (*GetOperatorResponse) ProtoMessage()    {}
 func (*GetOperatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_schedulerpb_4e333137f5959f12, []int{51}
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{52}
 }
 func (m *GetOperatorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3218,1870 +3395,1483 @@ func (m *GetOperatorResponse) GetKind() []byte {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*RequestHeader)(nil), "schedulerpb.RequestHeader")
-	proto.RegisterType((*ResponseHeader)(nil), "schedulerpb.ResponseHeader")
-	proto.RegisterType((*Error)(nil), "schedulerpb.Error")
-	proto.RegisterType((*TsoRequest)(nil), "schedulerpb.TsoRequest")
-	proto.RegisterType((*Timestamp)(nil), "schedulerpb.Timestamp")
-	proto.RegisterType((*TsoResponse)(nil), "schedulerpb.TsoResponse")
-	proto.RegisterType((*BootstrapRequest)(nil), "schedulerpb.BootstrapRequest")
-	proto.RegisterType((*BootstrapResponse)(nil), "schedulerpb.BootstrapResponse")
-	proto.RegisterType((*IsBootstrappedRequest)(nil), "schedulerpb.IsBootstrappedRequest")
-	proto.RegisterType((*IsBootstrappedResponse)(nil), "schedulerpb.IsBootstrappedResponse")
-	proto.RegisterType((*AllocIDRequest)(nil), "schedulerpb.AllocIDRequest")
-	proto.RegisterType((*AllocIDResponse)(nil), "schedulerpb.AllocIDResponse")
-	proto.RegisterType((*GetStoreRequest)(nil), "schedulerpb.GetStoreRequest")
-	proto.RegisterType((*GetStoreResponse)(nil), "schedulerpb.GetStoreResponse")
-	proto.RegisterType((*PutStoreRequest)(nil), "schedulerpb.PutStoreRequest")
-	proto.RegisterType((*PutStoreResponse)(nil), "schedulerpb.PutStoreResponse")
-	proto.RegisterType((*GetAllStoresRequest)(nil), "schedulerpb.GetAllStoresRequest")
-	proto.RegisterType((*GetAllStoresResponse)(nil), "schedulerpb.GetAllStoresResponse")
-	proto.RegisterType((*GetRegionRequest)(nil), "schedulerpb.GetRegionRequest")
-	proto.RegisterType((*GetRegionResponse)(nil), "schedulerpb.GetRegionResponse")
-	proto.RegisterType((*GetRegionByIDRequest)(nil), "schedulerpb.GetRegionByIDRequest")
-	proto.RegisterType((*ScanRegionsRequest)(nil), "schedulerpb.ScanRegionsRequest")
-	proto.RegisterType((*ScanRegionsResponse)(nil), "schedulerpb.ScanRegionsResponse")
-	proto.RegisterType((*GetClusterConfigRequest)(nil), "schedulerpb.GetClusterConfigRequest")
-	proto.RegisterType((*GetClusterConfigResponse)(nil), "schedulerpb.GetClusterConfigResponse")
-	proto.RegisterType((*PutClusterConfigRequest)(nil), "schedulerpb.PutClusterConfigRequest")
-	proto.RegisterType((*PutClusterConfigResponse)(nil), "schedulerpb.PutClusterConfigResponse")
-	proto.RegisterType((*Member)(nil), "schedulerpb.Member")
-	proto.RegisterType((*GetMembersRequest)(nil), "schedulerpb.GetMembersRequest")
-	proto.RegisterType((*GetMembersResponse)(nil), "schedulerpb.GetMembersResponse")
-	proto.RegisterType((*RegionHeartbeatRequest)(nil), "schedulerpb.RegionHeartbeatRequest")
-	proto.RegisterType((*ChangePeer)(nil), "schedulerpb.ChangePeer")
-	proto.RegisterType((*TransferLeader)(nil), "schedulerpb.TransferLeader")
-	proto.RegisterType((*RegionHeartbeatResponse)(nil), "schedulerpb.RegionHeartbeatResponse")
-	proto.RegisterType((*AskSplitRequest)(nil), "schedulerpb.AskSplitRequest")
-	proto.RegisterType((*AskSplitResponse)(nil), "schedulerpb.AskSplitResponse")
-	proto.RegisterType((*ReportSplitRequest)(nil), "schedulerpb.ReportSplitRequest")
-	proto.RegisterType((*ReportSplitResponse)(nil), "schedulerpb.ReportSplitResponse")
-	proto.RegisterType((*SplitID)(nil), "schedulerpb.SplitID")
-	proto.RegisterType((*TimeInterval)(nil), "schedulerpb.TimeInterval")
-	proto.RegisterType((*RecordPair)(nil), "schedulerpb.RecordPair")
-	proto.RegisterType((*StoreStats)(nil), "schedulerpb.StoreStats")
-	proto.RegisterType((*StoreHeartbeatRequest)(nil), "schedulerpb.StoreHeartbeatRequest")
-	proto.RegisterType((*StoreHeartbeatResponse)(nil), "schedulerpb.StoreHeartbeatResponse")
-	proto.RegisterType((*ScatterRegionRequest)(nil), "schedulerpb.ScatterRegionRequest")
-	proto.RegisterType((*ScatterRegionResponse)(nil), "schedulerpb.ScatterRegionResponse")
-	proto.RegisterType((*GetGCSafePointRequest)(nil), "schedulerpb.GetGCSafePointRequest")
-	proto.RegisterType((*GetGCSafePointResponse)(nil), "schedulerpb.GetGCSafePointResponse")
-	proto.RegisterType((*UpdateGCSafePointRequest)(nil), "schedulerpb.UpdateGCSafePointRequest")
-	proto.RegisterType((*UpdateGCSafePointResponse)(nil), "schedulerpb.UpdateGCSafePointResponse")
-	proto.RegisterType((*GetOperatorRequest)(nil), "schedulerpb.GetOperatorRequest")
-	proto.RegisterType((*GetOperatorResponse)(nil), "schedulerpb.GetOperatorResponse")
-	proto.RegisterEnum("schedulerpb.ErrorType", ErrorType_name, ErrorType_value)
-	proto.RegisterEnum("schedulerpb.OperatorStatus", OperatorStatus_name, OperatorStatus_value)
-}
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// Client API for Scheduler service
-
-type SchedulerClient interface {
-	// GetMembers get the member list of this cluster. It does not require
-	// the cluster_id in request matchs the id of this cluster.
-	GetMembers(ctx context.Context, in *GetMembersRequest, opts ...grpc.CallOption) (*GetMembersResponse, error)
-	Tso(ctx context.Context, opts ...grpc.CallOption) (Scheduler_TsoClient, error)
-	Bootstrap(ctx context.Context, in *BootstrapRequest, opts ...grpc.CallOption) (*BootstrapResponse, error)
-	IsBootstrapped(ctx context.Context, in *IsBootstrappedRequest, opts ...grpc.CallOption) (*IsBootstrappedResponse, error)
-	AllocID(ctx context.Context, in *AllocIDRequest, opts ...grpc.CallOption) (*AllocIDResponse, error)
-	GetStore(ctx context.Context, in *GetStoreRequest, opts ...grpc.CallOption) (*GetStoreResponse, error)
-	PutStore(ctx context.Context, in *PutStoreRequest, opts ...grpc.CallOption) (*PutStoreResponse, error)
-	GetAllStores(ctx context.Context, in *GetAllStoresRequest, opts ...grpc.CallOption) (*GetAllStoresResponse, error)
-	StoreHeartbeat(ctx context.Context, in *StoreHeartbeatRequest, opts ...grpc.CallOption) (*StoreHeartbeatResponse, error)
-	RegionHeartbeat(ctx context.Context, opts ...grpc.CallOption) (Scheduler_RegionHeartbeatClient, error)
-	GetRegion(ctx context.Context, in *GetRegionRequest, opts ...grpc.CallOption) (*GetRegionResponse, error)
-	GetPrevRegion(ctx context.Context, in *GetRegionRequest, opts ...grpc.CallOption) (*GetRegionResponse, error)
-	GetRegionByID(ctx context.Context, in *GetRegionByIDRequest, opts ...grpc.CallOption) (*GetRegionResponse, error)
-	ScanRegions(ctx context.Context, in *ScanRegionsRequest, opts ...grpc.CallOption) (*ScanRegionsResponse, error)
-	AskSplit(ctx context.Context, in *AskSplitRequest, opts ...grpc.CallOption) (*AskSplitResponse, error)
-	GetClusterConfig(ctx context.Context, in *GetClusterConfigRequest, opts ...grpc.CallOption) (*GetClusterConfigResponse, error)
-	PutClusterConfig(ctx context.Context, in *PutClusterConfigRequest, opts ...grpc.CallOption) (*PutClusterConfigResponse, error)
-	ScatterRegion(ctx context.Context, in *ScatterRegionRequest, opts ...grpc.CallOption) (*ScatterRegionResponse, error)
-	GetGCSafePoint(ctx context.Context, in *GetGCSafePointRequest, opts ...grpc.CallOption) (*GetGCSafePointResponse, error)
-	UpdateGCSafePoint(ctx context.Context, in *UpdateGCSafePointRequest, opts ...grpc.CallOption) (*UpdateGCSafePointResponse, error)
-	GetOperator(ctx context.Context, in *GetOperatorRequest, opts ...grpc.CallOption) (*GetOperatorResponse, error)
+// LabelConstraint restricts the stores a replica can be placed on by their
+// labels.
+type LabelConstraint struct {
+	Key                  string            `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Op                   LabelConstraintOp `protobuf:"varint,2,opt,name=op,proto3,enum=schedulerpb.LabelConstraintOp" json:"op,omitempty"`
+	Values               []string          `protobuf:"bytes,3,rep,name=values" json:"values,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-type schedulerClient struct {
-	cc *grpc.ClientConn
+func (m *LabelConstraint) Reset()         { *m = LabelConstraint{} }
+func (m *LabelConstraint) String() string { return proto.CompactTextString(m) }
+func (*LabelConstraint) ProtoMessage()    {}
+func (*LabelConstraint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{53}
 }
-
-func NewSchedulerClient(cc *grpc.ClientConn) SchedulerClient {
-	return &schedulerClient{cc}
+func (m *LabelConstraint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *schedulerClient) GetMembers(ctx context.Context, in *GetMembersRequest, opts ...grpc.CallOption) (*GetMembersResponse, error) {
-	out := new(GetMembersResponse)
-	err := c.cc.Invoke(ctx, "/schedulerpb.Scheduler/GetMembers", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *LabelConstraint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LabelConstraint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *schedulerClient) Tso(ctx context.Context, opts ...grpc.CallOption) (Scheduler_TsoClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Scheduler_serviceDesc.Streams[0], "/schedulerpb.Scheduler/Tso", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &schedulerTsoClient{stream}
-	return x, nil
+func (dst *LabelConstraint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LabelConstraint.Merge(dst, src)
 }
-
-type Scheduler_TsoClient interface {
-	Send(*TsoRequest) error
-	Recv() (*TsoResponse, error)
-	grpc.ClientStream
+func (m *LabelConstraint) XXX_Size() int {
+	return m.Size()
 }
-
-type schedulerTsoClient struct {
-	grpc.ClientStream
+func (m *LabelConstraint) XXX_DiscardUnknown() {
+	xxx_messageInfo_LabelConstraint.DiscardUnknown(m)
 }
 
-func (x *schedulerTsoClient) Send(m *TsoRequest) error {
-	return x.ClientStream.SendMsg(m)
-}
+var xxx_messageInfo_LabelConstraint proto.InternalMessageInfo
 
-func (x *schedulerTsoClient) Recv() (*TsoResponse, error) {
-	m := new(TsoResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *LabelConstraint) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	return m, nil
+	return ""
 }
 
-func (c *schedulerClient) Bootstrap(ctx context.Context, in *BootstrapRequest, opts ...grpc.CallOption) (*BootstrapResponse, error) {
-	out := new(BootstrapResponse)
-	err := c.cc.Invoke(ctx, "/schedulerpb.Scheduler/Bootstrap", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *LabelConstraint) GetOp() LabelConstraintOp {
+	if m != nil {
+		return m.Op
 	}
-	return out, nil
+	return LabelConstraintOp_In
 }
 
-func (c *schedulerClient) IsBootstrapped(ctx context.Context, in *IsBootstrappedRequest, opts ...grpc.CallOption) (*IsBootstrappedResponse, error) {
-	out := new(IsBootstrappedResponse)
-	err := c.cc.Invoke(ctx, "/schedulerpb.Scheduler/IsBootstrapped", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *LabelConstraint) GetValues() []string {
+	if m != nil {
+		return m.Values
 	}
-	return out, nil
+	return nil
 }
 
-func (c *schedulerClient) AllocID(ctx context.Context, in *AllocIDRequest, opts ...grpc.CallOption) (*AllocIDResponse, error) {
-	out := new(AllocIDResponse)
-	err := c.cc.Invoke(ctx, "/schedulerpb.Scheduler/AllocID", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// PlacementRule describes how the replicas of the regions in a key range are
+// placed. A region follows the rule with the highest index among the rules
+// covering its key range.
+type PlacementRule struct {
+	GroupId string `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Id      string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Index   int32  `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	// Key range [start_key, end_key) of the rule, an empty end_key means
+	// the end of the key space.
+	StartKey []byte `protobuf:"bytes,4,opt,name=start_key,json=startKey,proto3" json:"start_key,omitempty"`
+	EndKey   []byte `protobuf:"bytes,5,opt,name=end_key,json=endKey,proto3" json:"end_key,omitempty"`
+	// The number of replicas.
+	Count int32 `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
+	// All the replicas are placed on the stores matching the constraints.
+	LabelConstraints []*LabelConstraint `protobuf:"bytes,7,rep,name=label_constraints,json=labelConstraints" json:"label_constraints,omitempty"`
+	// The replicas are spread across the stores with different location
+	// labels, from the upper level to the lower level.
+	LocationLabels       []string `protobuf:"bytes,8,rep,name=location_labels,json=locationLabels" json:"location_labels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *schedulerClient) GetStore(ctx context.Context, in *GetStoreRequest, opts ...grpc.CallOption) (*GetStoreResponse, error) {
-	out := new(GetStoreResponse)
-	err := c.cc.Invoke(ctx, "/schedulerpb.Scheduler/GetStore", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *PlacementRule) Reset()         { *m = PlacementRule{} }
+func (m *PlacementRule) String() string { return proto.CompactTextString(m) }
+func (*PlacementRule) ProtoMessage()    {}
+func (*PlacementRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{54}
+}
+func (m *PlacementRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PlacementRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PlacementRule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (dst *PlacementRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlacementRule.Merge(dst, src)
+}
+func (m *PlacementRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *PlacementRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlacementRule.DiscardUnknown(m)
 }
 
-func (c *schedulerClient) PutStore(ctx context.Context, in *PutStoreRequest, opts ...grpc.CallOption) (*PutStoreResponse, error) {
-	out := new(PutStoreResponse)
-	err := c.cc.Invoke(ctx, "/schedulerpb.Scheduler/PutStore", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PlacementRule proto.InternalMessageInfo
+
+func (m *PlacementRule) GetGroupId() string {
+	if m != nil {
+		return m.GroupId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *schedulerClient) GetAllStores(ctx context.Context, in *GetAllStoresRequest, opts ...grpc.CallOption) (*GetAllStoresResponse, error) {
-	out := new(GetAllStoresResponse)
-	err := c.cc.Invoke(ctx, "/schedulerpb.Scheduler/GetAllStores", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *PlacementRule) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return ""
 }
 
-func (c *schedulerClient) StoreHeartbeat(ctx context.Context, in *StoreHeartbeatRequest, opts ...grpc.CallOption) (*StoreHeartbeatResponse, error) {
-	out := new(StoreHeartbeatResponse)
-	err := c.cc.Invoke(ctx, "/schedulerpb.Scheduler/StoreHeartbeat", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *PlacementRule) GetIndex() int32 {
+	if m != nil {
+		return m.Index
 	}
-	return out, nil
+	return 0
 }
 
-func (c *schedulerClient) RegionHeartbeat(ctx context.Context, opts ...grpc.CallOption) (Scheduler_RegionHeartbeatClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Scheduler_serviceDesc.Streams[1], "/schedulerpb.Scheduler/RegionHeartbeat", opts...)
-	if err != nil {
-		return nil, err
+func (m *PlacementRule) GetStartKey() []byte {
+	if m != nil {
+		return m.StartKey
 	}
-	x := &schedulerRegionHeartbeatClient{stream}
-	return x, nil
+	return nil
 }
 
-type Scheduler_RegionHeartbeatClient interface {
-	Send(*RegionHeartbeatRequest) error
-	Recv() (*RegionHeartbeatResponse, error)
-	grpc.ClientStream
+func (m *PlacementRule) GetEndKey() []byte {
+	if m != nil {
+		return m.EndKey
+	}
+	return nil
 }
 
-type schedulerRegionHeartbeatClient struct {
-	grpc.ClientStream
+func (m *PlacementRule) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
 }
 
-func (x *schedulerRegionHeartbeatClient) Send(m *RegionHeartbeatRequest) error {
-	return x.ClientStream.SendMsg(m)
+func (m *PlacementRule) GetLabelConstraints() []*LabelConstraint {
+	if m != nil {
+		return m.LabelConstraints
+	}
+	return nil
 }
 
-func (x *schedulerRegionHeartbeatClient) Recv() (*RegionHeartbeatResponse, error) {
-	m := new(RegionHeartbeatResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *PlacementRule) GetLocationLabels() []string {
+	if m != nil {
+		return m.LocationLabels
 	}
-	return m, nil
+	return nil
 }
 
-func (c *schedulerClient) GetRegion(ctx context.Context, in *GetRegionRequest, opts ...grpc.CallOption) (*GetRegionResponse, error) {
-	out := new(GetRegionResponse)
-	err := c.cc.Invoke(ctx, "/schedulerpb.Scheduler/GetRegion", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type GetPlacementRulesRequest struct {
+	Header               *RequestHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func (c *schedulerClient) GetPrevRegion(ctx context.Context, in *GetRegionRequest, opts ...grpc.CallOption) (*GetRegionResponse, error) {
-	out := new(GetRegionResponse)
-	err := c.cc.Invoke(ctx, "/schedulerpb.Scheduler/GetPrevRegion", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetPlacementRulesRequest) Reset()         { *m = GetPlacementRulesRequest{} }
+func (m *GetPlacementRulesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPlacementRulesRequest) ProtoMessage()    {}
+func (*GetPlacementRulesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{55}
+}
+func (m *GetPlacementRulesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetPlacementRulesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetPlacementRulesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (dst *GetPlacementRulesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPlacementRulesRequest.Merge(dst, src)
+}
+func (m *GetPlacementRulesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetPlacementRulesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPlacementRulesRequest.DiscardUnknown(m)
 }
 
-func (c *schedulerClient) GetRegionByID(ctx context.Context, in *GetRegionByIDRequest, opts ...grpc.CallOption) (*GetRegionResponse, error) {
-	out := new(GetRegionResponse)
-	err := c.cc.Invoke(ctx, "/schedulerpb.Scheduler/GetRegionByID", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetPlacementRulesRequest proto.InternalMessageInfo
+
+func (m *GetPlacementRulesRequest) GetHeader() *RequestHeader {
+	if m != nil {
+		return m.Header
 	}
-	return out, nil
+	return nil
 }
 
-func (c *schedulerClient) ScanRegions(ctx context.Context, in *ScanRegionsRequest, opts ...grpc.CallOption) (*ScanRegionsResponse, error) {
-	out := new(ScanRegionsResponse)
-	err := c.cc.Invoke(ctx, "/schedulerpb.Scheduler/ScanRegions", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type GetPlacementRulesResponse struct {
+	Header               *ResponseHeader  `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	Rules                []*PlacementRule `protobuf:"bytes,2,rep,name=rules" json:"rules,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (c *schedulerClient) AskSplit(ctx context.Context, in *AskSplitRequest, opts ...grpc.CallOption) (*AskSplitResponse, error) {
-	out := new(AskSplitResponse)
-	err := c.cc.Invoke(ctx, "/schedulerpb.Scheduler/AskSplit", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetPlacementRulesResponse) Reset()         { *m = GetPlacementRulesResponse{} }
+func (m *GetPlacementRulesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPlacementRulesResponse) ProtoMessage()    {}
+func (*GetPlacementRulesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{56}
+}
+func (m *GetPlacementRulesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetPlacementRulesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetPlacementRulesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (dst *GetPlacementRulesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPlacementRulesResponse.Merge(dst, src)
+}
+func (m *GetPlacementRulesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetPlacementRulesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPlacementRulesResponse.DiscardUnknown(m)
 }
 
-func (c *schedulerClient) GetClusterConfig(ctx context.Context, in *GetClusterConfigRequest, opts ...grpc.CallOption) (*GetClusterConfigResponse, error) {
-	out := new(GetClusterConfigResponse)
-	err := c.cc.Invoke(ctx, "/schedulerpb.Scheduler/GetClusterConfig", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetPlacementRulesResponse proto.InternalMessageInfo
+
+func (m *GetPlacementRulesResponse) GetHeader() *ResponseHeader {
+	if m != nil {
+		return m.Header
 	}
-	return out, nil
+	return nil
 }
 
-func (c *schedulerClient) PutClusterConfig(ctx context.Context, in *PutClusterConfigRequest, opts ...grpc.CallOption) (*PutClusterConfigResponse, error) {
-	out := new(PutClusterConfigResponse)
-	err := c.cc.Invoke(ctx, "/schedulerpb.Scheduler/PutClusterConfig", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetPlacementRulesResponse) GetRules() []*PlacementRule {
+	if m != nil {
+		return m.Rules
 	}
-	return out, nil
+	return nil
 }
 
-func (c *schedulerClient) ScatterRegion(ctx context.Context, in *ScatterRegionRequest, opts ...grpc.CallOption) (*ScatterRegionResponse, error) {
-	out := new(ScatterRegionResponse)
-	err := c.cc.Invoke(ctx, "/schedulerpb.Scheduler/ScatterRegion", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type SetPlacementRuleRequest struct {
+	Header               *RequestHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	Rule                 *PlacementRule `protobuf:"bytes,2,opt,name=rule" json:"rule,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func (c *schedulerClient) GetGCSafePoint(ctx context.Context, in *GetGCSafePointRequest, opts ...grpc.CallOption) (*GetGCSafePointResponse, error) {
-	out := new(GetGCSafePointResponse)
-	err := c.cc.Invoke(ctx, "/schedulerpb.Scheduler/GetGCSafePoint", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SetPlacementRuleRequest) Reset()         { *m = SetPlacementRuleRequest{} }
+func (m *SetPlacementRuleRequest) String() string { return proto.CompactTextString(m) }
+func (*SetPlacementRuleRequest) ProtoMessage()    {}
+func (*SetPlacementRuleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{57}
+}
+func (m *SetPlacementRuleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetPlacementRuleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetPlacementRuleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (dst *SetPlacementRuleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetPlacementRuleRequest.Merge(dst, src)
+}
+func (m *SetPlacementRuleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetPlacementRuleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetPlacementRuleRequest.DiscardUnknown(m)
 }
 
-func (c *schedulerClient) UpdateGCSafePoint(ctx context.Context, in *UpdateGCSafePointRequest, opts ...grpc.CallOption) (*UpdateGCSafePointResponse, error) {
-	out := new(UpdateGCSafePointResponse)
-	err := c.cc.Invoke(ctx, "/schedulerpb.Scheduler/UpdateGCSafePoint", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SetPlacementRuleRequest proto.InternalMessageInfo
+
+func (m *SetPlacementRuleRequest) GetHeader() *RequestHeader {
+	if m != nil {
+		return m.Header
 	}
-	return out, nil
+	return nil
 }
 
-func (c *schedulerClient) GetOperator(ctx context.Context, in *GetOperatorRequest, opts ...grpc.CallOption) (*GetOperatorResponse, error) {
-	out := new(GetOperatorResponse)
-	err := c.cc.Invoke(ctx, "/schedulerpb.Scheduler/GetOperator", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SetPlacementRuleRequest) GetRule() *PlacementRule {
+	if m != nil {
+		return m.Rule
 	}
-	return out, nil
+	return nil
 }
 
-// Server API for Scheduler service
+type SetPlacementRuleResponse struct {
+	Header               *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
 
-type SchedulerServer interface {
-	// GetMembers get the member list of this cluster. It does not require
-	// the cluster_id in request matchs the id of this cluster.
-	GetMembers(context.Context, *GetMembersRequest) (*GetMembersResponse, error)
-	Tso(Scheduler_TsoServer) error
-	Bootstrap(context.Context, *BootstrapRequest) (*BootstrapResponse, error)
-	IsBootstrapped(context.Context, *IsBootstrappedRequest) (*IsBootstrappedResponse, error)
-	AllocID(context.Context, *AllocIDRequest) (*AllocIDResponse, error)
-	GetStore(context.Context, *GetStoreRequest) (*GetStoreResponse, error)
-	PutStore(context.Context, *PutStoreRequest) (*PutStoreResponse, error)
-	GetAllStores(context.Context, *GetAllStoresRequest) (*GetAllStoresResponse, error)
-	StoreHeartbeat(context.Context, *StoreHeartbeatRequest) (*StoreHeartbeatResponse, error)
-	RegionHeartbeat(Scheduler_RegionHeartbeatServer) error
-	GetRegion(context.Context, *GetRegionRequest) (*GetRegionResponse, error)
-	GetPrevRegion(context.Context, *GetRegionRequest) (*GetRegionResponse, error)
-	GetRegionByID(context.Context, *GetRegionByIDRequest) (*GetRegionResponse, error)
-	ScanRegions(context.Context, *ScanRegionsRequest) (*ScanRegionsResponse, error)
-	AskSplit(context.Context, *AskSplitRequest) (*AskSplitResponse, error)
-	GetClusterConfig(context.Context, *GetClusterConfigRequest) (*GetClusterConfigResponse, error)
-	PutClusterConfig(context.Context, *PutClusterConfigRequest) (*PutClusterConfigResponse, error)
-	ScatterRegion(context.Context, *ScatterRegionRequest) (*ScatterRegionResponse, error)
-	GetGCSafePoint(context.Context, *GetGCSafePointRequest) (*GetGCSafePointResponse, error)
-	UpdateGCSafePoint(context.Context, *UpdateGCSafePointRequest) (*UpdateGCSafePointResponse, error)
-	GetOperator(context.Context, *GetOperatorRequest) (*GetOperatorResponse, error)
+func (m *SetPlacementRuleResponse) Reset()         { *m = SetPlacementRuleResponse{} }
+func (m *SetPlacementRuleResponse) String() string { return proto.CompactTextString(m) }
+func (*SetPlacementRuleResponse) ProtoMessage()    {}
+func (*SetPlacementRuleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{58}
 }
-
-func RegisterSchedulerServer(s *grpc.Server, srv SchedulerServer) {
-	s.RegisterService(&_Scheduler_serviceDesc, srv)
+func (m *SetPlacementRuleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Scheduler_GetMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMembersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(SchedulerServer).GetMembers(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/schedulerpb.Scheduler/GetMembers",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SchedulerServer).GetMembers(ctx, req.(*GetMembersRequest))
+func (m *SetPlacementRuleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetPlacementRuleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-func _Scheduler_Tso_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(SchedulerServer).Tso(&schedulerTsoServer{stream})
+func (dst *SetPlacementRuleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetPlacementRuleResponse.Merge(dst, src)
 }
-
-type Scheduler_TsoServer interface {
-	Send(*TsoResponse) error
-	Recv() (*TsoRequest, error)
-	grpc.ServerStream
+func (m *SetPlacementRuleResponse) XXX_Size() int {
+	return m.Size()
 }
-
-type schedulerTsoServer struct {
-	grpc.ServerStream
+func (m *SetPlacementRuleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetPlacementRuleResponse.DiscardUnknown(m)
 }
 
-func (x *schedulerTsoServer) Send(m *TsoResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
+var xxx_messageInfo_SetPlacementRuleResponse proto.InternalMessageInfo
 
-func (x *schedulerTsoServer) Recv() (*TsoRequest, error) {
-	m := new(TsoRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *SetPlacementRuleResponse) GetHeader() *ResponseHeader {
+	if m != nil {
+		return m.Header
 	}
-	return m, nil
+	return nil
 }
 
-func _Scheduler_Bootstrap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BootstrapRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(SchedulerServer).Bootstrap(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/schedulerpb.Scheduler/Bootstrap",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SchedulerServer).Bootstrap(ctx, req.(*BootstrapRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type DeletePlacementRuleRequest struct {
+	Header               *RequestHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	GroupId              string         `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Id                   string         `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func _Scheduler_IsBootstrapped_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IsBootstrappedRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(SchedulerServer).IsBootstrapped(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/schedulerpb.Scheduler/IsBootstrapped",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SchedulerServer).IsBootstrapped(ctx, req.(*IsBootstrappedRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *DeletePlacementRuleRequest) Reset()         { *m = DeletePlacementRuleRequest{} }
+func (m *DeletePlacementRuleRequest) String() string { return proto.CompactTextString(m) }
+func (*DeletePlacementRuleRequest) ProtoMessage()    {}
+func (*DeletePlacementRuleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{59}
 }
-
-func _Scheduler_AllocID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AllocIDRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(SchedulerServer).AllocID(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/schedulerpb.Scheduler/AllocID",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SchedulerServer).AllocID(ctx, req.(*AllocIDRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *DeletePlacementRuleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Scheduler_GetStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetStoreRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(SchedulerServer).GetStore(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/schedulerpb.Scheduler/GetStore",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SchedulerServer).GetStore(ctx, req.(*GetStoreRequest))
+func (m *DeletePlacementRuleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeletePlacementRuleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-func _Scheduler_PutStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PutStoreRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(SchedulerServer).PutStore(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/schedulerpb.Scheduler/PutStore",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SchedulerServer).PutStore(ctx, req.(*PutStoreRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (dst *DeletePlacementRuleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeletePlacementRuleRequest.Merge(dst, src)
+}
+func (m *DeletePlacementRuleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeletePlacementRuleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeletePlacementRuleRequest.DiscardUnknown(m)
 }
 
-func _Scheduler_GetAllStores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllStoresRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(SchedulerServer).GetAllStores(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/schedulerpb.Scheduler/GetAllStores",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SchedulerServer).GetAllStores(ctx, req.(*GetAllStoresRequest))
+var xxx_messageInfo_DeletePlacementRuleRequest proto.InternalMessageInfo
+
+func (m *DeletePlacementRuleRequest) GetHeader() *RequestHeader {
+	if m != nil {
+		return m.Header
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Scheduler_StoreHeartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StoreHeartbeatRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(SchedulerServer).StoreHeartbeat(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/schedulerpb.Scheduler/StoreHeartbeat",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SchedulerServer).StoreHeartbeat(ctx, req.(*StoreHeartbeatRequest))
+func (m *DeletePlacementRuleRequest) GetGroupId() string {
+	if m != nil {
+		return m.GroupId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Scheduler_RegionHeartbeat_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(SchedulerServer).RegionHeartbeat(&schedulerRegionHeartbeatServer{stream})
+func (m *DeletePlacementRuleRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
 }
 
-type Scheduler_RegionHeartbeatServer interface {
-	Send(*RegionHeartbeatResponse) error
-	Recv() (*RegionHeartbeatRequest, error)
-	grpc.ServerStream
+type DeletePlacementRuleResponse struct {
+	Header               *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-type schedulerRegionHeartbeatServer struct {
-	grpc.ServerStream
+func (m *DeletePlacementRuleResponse) Reset()         { *m = DeletePlacementRuleResponse{} }
+func (m *DeletePlacementRuleResponse) String() string { return proto.CompactTextString(m) }
+func (*DeletePlacementRuleResponse) ProtoMessage()    {}
+func (*DeletePlacementRuleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_schedulerpb_ef051728427fc498, []int{60}
 }
-
-func (x *schedulerRegionHeartbeatServer) Send(m *RegionHeartbeatResponse) error {
-	return x.ServerStream.SendMsg(m)
+func (m *DeletePlacementRuleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (x *schedulerRegionHeartbeatServer) Recv() (*RegionHeartbeatRequest, error) {
-	m := new(RegionHeartbeatRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *DeletePlacementRuleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeletePlacementRuleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return m, nil
+}
+func (dst *DeletePlacementRuleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeletePlacementRuleResponse.Merge(dst, src)
+}
+func (m *DeletePlacementRuleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeletePlacementRuleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeletePlacementRuleResponse.DiscardUnknown(m)
 }
 
-func _Scheduler_GetRegion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRegionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(SchedulerServer).GetRegion(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/schedulerpb.Scheduler/GetRegion",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SchedulerServer).GetRegion(ctx, req.(*GetRegionRequest))
+var xxx_messageInfo_DeletePlacementRuleResponse proto.InternalMessageInfo
+
+func (m *DeletePlacementRuleResponse) GetHeader() *ResponseHeader {
+	if m != nil {
+		return m.Header
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Scheduler_GetPrevRegion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRegionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(SchedulerServer).GetPrevRegion(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/schedulerpb.Scheduler/GetPrevRegion",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SchedulerServer).GetPrevRegion(ctx, req.(*GetRegionRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func init() {
+	proto.RegisterType((*RequestHeader)(nil), "schedulerpb.RequestHeader")
+	proto.RegisterType((*ResponseHeader)(nil), "schedulerpb.ResponseHeader")
+	proto.RegisterType((*Error)(nil), "schedulerpb.Error")
+	proto.RegisterType((*TsoRequest)(nil), "schedulerpb.TsoRequest")
+	proto.RegisterType((*Timestamp)(nil), "schedulerpb.Timestamp")
+	proto.RegisterType((*TsoResponse)(nil), "schedulerpb.TsoResponse")
+	proto.RegisterType((*BootstrapRequest)(nil), "schedulerpb.BootstrapRequest")
+	proto.RegisterType((*BootstrapResponse)(nil), "schedulerpb.BootstrapResponse")
+	proto.RegisterType((*IsBootstrappedRequest)(nil), "schedulerpb.IsBootstrappedRequest")
+	proto.RegisterType((*IsBootstrappedResponse)(nil), "schedulerpb.IsBootstrappedResponse")
+	proto.RegisterType((*AllocIDRequest)(nil), "schedulerpb.AllocIDRequest")
+	proto.RegisterType((*AllocIDResponse)(nil), "schedulerpb.AllocIDResponse")
+	proto.RegisterType((*GetStoreRequest)(nil), "schedulerpb.GetStoreRequest")
+	proto.RegisterType((*GetStoreResponse)(nil), "schedulerpb.GetStoreResponse")
+	proto.RegisterType((*PutStoreRequest)(nil), "schedulerpb.PutStoreRequest")
+	proto.RegisterType((*PutStoreResponse)(nil), "schedulerpb.PutStoreResponse")
+	proto.RegisterType((*GetAllStoresRequest)(nil), "schedulerpb.GetAllStoresRequest")
+	proto.RegisterType((*GetAllStoresResponse)(nil), "schedulerpb.GetAllStoresResponse")
+	proto.RegisterType((*GetRegionRequest)(nil), "schedulerpb.GetRegionRequest")
+	proto.RegisterType((*GetRegionResponse)(nil), "schedulerpb.GetRegionResponse")
+	proto.RegisterType((*GetRegionByIDRequest)(nil), "schedulerpb.GetRegionByIDRequest")
+	proto.RegisterType((*ScanRegionsRequest)(nil), "schedulerpb.ScanRegionsRequest")
+	proto.RegisterType((*ScanRegionsResponse)(nil), "schedulerpb.ScanRegionsResponse")
+	proto.RegisterType((*GetClusterConfigRequest)(nil), "schedulerpb.GetClusterConfigRequest")
+	proto.RegisterType((*GetClusterConfigResponse)(nil), "schedulerpb.GetClusterConfigResponse")
+	proto.RegisterType((*PutClusterConfigRequest)(nil), "schedulerpb.PutClusterConfigRequest")
+	proto.RegisterType((*PutClusterConfigResponse)(nil), "schedulerpb.PutClusterConfigResponse")
+	proto.RegisterType((*Member)(nil), "schedulerpb.Member")
+	proto.RegisterType((*GetMembersRequest)(nil), "schedulerpb.GetMembersRequest")
+	proto.RegisterType((*GetMembersResponse)(nil), "schedulerpb.GetMembersResponse")
+	proto.RegisterType((*RegionHeartbeatRequest)(nil), "schedulerpb.RegionHeartbeatRequest")
+	proto.RegisterType((*ChangePeer)(nil), "schedulerpb.ChangePeer")
+	proto.RegisterType((*TransferLeader)(nil), "schedulerpb.TransferLeader")
+	proto.RegisterType((*Merge)(nil), "schedulerpb.Merge")
+	proto.RegisterType((*RegionHeartbeatResponse)(nil), "schedulerpb.RegionHeartbeatResponse")
+	proto.RegisterType((*AskSplitRequest)(nil), "schedulerpb.AskSplitRequest")
+	proto.RegisterType((*AskSplitResponse)(nil), "schedulerpb.AskSplitResponse")
+	proto.RegisterType((*ReportSplitRequest)(nil), "schedulerpb.ReportSplitRequest")
+	proto.RegisterType((*ReportSplitResponse)(nil), "schedulerpb.ReportSplitResponse")
+	proto.RegisterType((*SplitID)(nil), "schedulerpb.SplitID")
+	proto.RegisterType((*TimeInterval)(nil), "schedulerpb.TimeInterval")
+	proto.RegisterType((*RecordPair)(nil), "schedulerpb.RecordPair")
+	proto.RegisterType((*StoreStats)(nil), "schedulerpb.StoreStats")
+	proto.RegisterType((*StoreHeartbeatRequest)(nil), "schedulerpb.StoreHeartbeatRequest")
+	proto.RegisterType((*StoreHeartbeatResponse)(nil), "schedulerpb.StoreHeartbeatResponse")
+	proto.RegisterType((*ScatterRegionRequest)(nil), "schedulerpb.ScatterRegionRequest")
+	proto.RegisterType((*ScatterRegionResponse)(nil), "schedulerpb.ScatterRegionResponse")
+	proto.RegisterType((*GetGCSafePointRequest)(nil), "schedulerpb.GetGCSafePointRequest")
+	proto.RegisterType((*GetGCSafePointResponse)(nil), "schedulerpb.GetGCSafePointResponse")
+	proto.RegisterType((*UpdateGCSafePointRequest)(nil), "schedulerpb.UpdateGCSafePointRequest")
+	proto.RegisterType((*UpdateGCSafePointResponse)(nil), "schedulerpb.UpdateGCSafePointResponse")
+	proto.RegisterType((*GetOperatorRequest)(nil), "schedulerpb.GetOperatorRequest")
+	proto.RegisterType((*GetOperatorResponse)(nil), "schedulerpb.GetOperatorResponse")
+	proto.RegisterType((*LabelConstraint)(nil), "schedulerpb.LabelConstraint")
+	proto.RegisterType((*PlacementRule)(nil), "schedulerpb.PlacementRule")
+	proto.RegisterType((*GetPlacementRulesRequest)(nil), "schedulerpb.GetPlacementRulesRequest")
+	proto.RegisterType((*GetPlacementRulesResponse)(nil), "schedulerpb.GetPlacementRulesResponse")
+	proto.RegisterType((*SetPlacementRuleRequest)(nil), "schedulerpb.SetPlacementRuleRequest")
+	proto.RegisterType((*SetPlacementRuleResponse)(nil), "schedulerpb.SetPlacementRuleResponse")
+	proto.RegisterType((*DeletePlacementRuleRequest)(nil), "schedulerpb.DeletePlacementRuleRequest")
+	proto.RegisterType((*DeletePlacementRuleResponse)(nil), "schedulerpb.DeletePlacementRuleResponse")
+	proto.RegisterEnum("schedulerpb.ErrorType", ErrorType_name, ErrorType_value)
+	proto.RegisterEnum("schedulerpb.OperatorStatus", OperatorStatus_name, OperatorStatus_value)
+	proto.RegisterEnum("schedulerpb.LabelConstraintOp", LabelConstraintOp_name, LabelConstraintOp_value)
 }
 
-func _Scheduler_GetRegionByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRegionByIDRequest)
-	if err := dec(in); err != nil {
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for Scheduler service
+
+type SchedulerClient interface {
+	// GetMembers get the member list of this cluster. It does not require
+	// the cluster_id in request matchs the id of this cluster.
+	GetMembers(ctx context.Context, in *GetMembersRequest, opts ...grpc.CallOption) (*GetMembersResponse, error)
+	Tso(ctx context.Context, opts ...grpc.CallOption) (Scheduler_TsoClient, error)
+	Bootstrap(ctx context.Context, in *BootstrapRequest, opts ...grpc.CallOption) (*BootstrapResponse, error)
+	IsBootstrapped(ctx context.Context, in *IsBootstrappedRequest, opts ...grpc.CallOption) (*IsBootstrappedResponse, error)
+	AllocID(ctx context.Context, in *AllocIDRequest, opts ...grpc.CallOption) (*AllocIDResponse, error)
+	GetStore(ctx context.Context, in *GetStoreRequest, opts ...grpc.CallOption) (*GetStoreResponse, error)
+	PutStore(ctx context.Context, in *PutStoreRequest, opts ...grpc.CallOption) (*PutStoreResponse, error)
+	GetAllStores(ctx context.Context, in *GetAllStoresRequest, opts ...grpc.CallOption) (*GetAllStoresResponse, error)
+	StoreHeartbeat(ctx context.Context, in *StoreHeartbeatRequest, opts ...grpc.CallOption) (*StoreHeartbeatResponse, error)
+	RegionHeartbeat(ctx context.Context, opts ...grpc.CallOption) (Scheduler_RegionHeartbeatClient, error)
+	GetRegion(ctx context.Context, in *GetRegionRequest, opts ...grpc.CallOption) (*GetRegionResponse, error)
+	GetPrevRegion(ctx context.Context, in *GetRegionRequest, opts ...grpc.CallOption) (*GetRegionResponse, error)
+	GetRegionByID(ctx context.Context, in *GetRegionByIDRequest, opts ...grpc.CallOption) (*GetRegionResponse, error)
+	ScanRegions(ctx context.Context, in *ScanRegionsRequest, opts ...grpc.CallOption) (*ScanRegionsResponse, error)
+	AskSplit(ctx context.Context, in *AskSplitRequest, opts ...grpc.CallOption) (*AskSplitResponse, error)
+	GetClusterConfig(ctx context.Context, in *GetClusterConfigRequest, opts ...grpc.CallOption) (*GetClusterConfigResponse, error)
+	PutClusterConfig(ctx context.Context, in *PutClusterConfigRequest, opts ...grpc.CallOption) (*PutClusterConfigResponse, error)
+	ScatterRegion(ctx context.Context, in *ScatterRegionRequest, opts ...grpc.CallOption) (*ScatterRegionResponse, error)
+	GetGCSafePoint(ctx context.Context, in *GetGCSafePointRequest, opts ...grpc.CallOption) (*GetGCSafePointResponse, error)
+	UpdateGCSafePoint(ctx context.Context, in *UpdateGCSafePointRequest, opts ...grpc.CallOption) (*UpdateGCSafePointResponse, error)
+	GetOperator(ctx context.Context, in *GetOperatorRequest, opts ...grpc.CallOption) (*GetOperatorResponse, error)
+	GetPlacementRules(ctx context.Context, in *GetPlacementRulesRequest, opts ...grpc.CallOption) (*GetPlacementRulesResponse, error)
+	SetPlacementRule(ctx context.Context, in *SetPlacementRuleRequest, opts ...grpc.CallOption) (*SetPlacementRuleResponse, error)
+	DeletePlacementRule(ctx context.Context, in *DeletePlacementRuleRequest, opts ...grpc.CallOption) (*DeletePlacementRuleResponse, error)
+}
+
+type schedulerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewSchedulerClient(cc *grpc.ClientConn) SchedulerClient {
+	return &schedulerClient{cc}
+}
+
+func (c *schedulerClient) GetMembers(ctx context.Context, in *GetMembersRequest, opts ...grpc.CallOption) (*GetMembersResponse, error) {
+	out := new(GetMembersResponse)
+	err := c.cc.Invoke(ctx, "/schedulerpb.Scheduler/GetMembers", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(SchedulerServer).GetRegionByID(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/schedulerpb.Scheduler/GetRegionByID",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SchedulerServer).GetRegionByID(ctx, req.(*GetRegionByIDRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Scheduler_ScanRegions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ScanRegionsRequest)
-	if err := dec(in); err != nil {
+func (c *schedulerClient) Tso(ctx context.Context, opts ...grpc.CallOption) (Scheduler_TsoClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Scheduler_serviceDesc.Streams[0], "/schedulerpb.Scheduler/Tso", opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(SchedulerServer).ScanRegions(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/schedulerpb.Scheduler/ScanRegions",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SchedulerServer).ScanRegions(ctx, req.(*ScanRegionsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	x := &schedulerTsoClient{stream}
+	return x, nil
 }
 
-func _Scheduler_AskSplit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AskSplitRequest)
-	if err := dec(in); err != nil {
+type Scheduler_TsoClient interface {
+	Send(*TsoRequest) error
+	Recv() (*TsoResponse, error)
+	grpc.ClientStream
+}
+
+type schedulerTsoClient struct {
+	grpc.ClientStream
+}
+
+func (x *schedulerTsoClient) Send(m *TsoRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *schedulerTsoClient) Recv() (*TsoResponse, error) {
+	m := new(TsoResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(SchedulerServer).AskSplit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/schedulerpb.Scheduler/AskSplit",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SchedulerServer).AskSplit(ctx, req.(*AskSplitRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return m, nil
 }
 
-func _Scheduler_GetClusterConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetClusterConfigRequest)
-	if err := dec(in); err != nil {
+func (c *schedulerClient) Bootstrap(ctx context.Context, in *BootstrapRequest, opts ...grpc.CallOption) (*BootstrapResponse, error) {
+	out := new(BootstrapResponse)
+	err := c.cc.Invoke(ctx, "/schedulerpb.Scheduler/Bootstrap", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(SchedulerServer).GetClusterConfig(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/schedulerpb.Scheduler/GetClusterConfig",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SchedulerServer).GetClusterConfig(ctx, req.(*GetClusterConfigRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Scheduler_PutClusterConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PutClusterConfigRequest)
-	if err := dec(in); err != nil {
+func (c *schedulerClient) IsBootstrapped(ctx context.Context, in *IsBootstrappedRequest, opts ...grpc.CallOption) (*IsBootstrappedResponse, error) {
+	out := new(IsBootstrappedResponse)
+	err := c.cc.Invoke(ctx, "/schedulerpb.Scheduler/IsBootstrapped", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(SchedulerServer).PutClusterConfig(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/schedulerpb.Scheduler/PutClusterConfig",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SchedulerServer).PutClusterConfig(ctx, req.(*PutClusterConfigRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Scheduler_ScatterRegion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ScatterRegionRequest)
-	if err := dec(in); err != nil {
+func (c *schedulerClient) AllocID(ctx context.Context, in *AllocIDRequest, opts ...grpc.CallOption) (*AllocIDResponse, error) {
+	out := new(AllocIDResponse)
+	err := c.cc.Invoke(ctx, "/schedulerpb.Scheduler/AllocID", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(SchedulerServer).ScatterRegion(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/schedulerpb.Scheduler/ScatterRegion",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SchedulerServer).ScatterRegion(ctx, req.(*ScatterRegionRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Scheduler_GetGCSafePoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetGCSafePointRequest)
-	if err := dec(in); err != nil {
+func (c *schedulerClient) GetStore(ctx context.Context, in *GetStoreRequest, opts ...grpc.CallOption) (*GetStoreResponse, error) {
+	out := new(GetStoreResponse)
+	err := c.cc.Invoke(ctx, "/schedulerpb.Scheduler/GetStore", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(SchedulerServer).GetGCSafePoint(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/schedulerpb.Scheduler/GetGCSafePoint",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SchedulerServer).GetGCSafePoint(ctx, req.(*GetGCSafePointRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Scheduler_UpdateGCSafePoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateGCSafePointRequest)
-	if err := dec(in); err != nil {
+func (c *schedulerClient) PutStore(ctx context.Context, in *PutStoreRequest, opts ...grpc.CallOption) (*PutStoreResponse, error) {
+	out := new(PutStoreResponse)
+	err := c.cc.Invoke(ctx, "/schedulerpb.Scheduler/PutStore", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(SchedulerServer).UpdateGCSafePoint(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/schedulerpb.Scheduler/UpdateGCSafePoint",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SchedulerServer).UpdateGCSafePoint(ctx, req.(*UpdateGCSafePointRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Scheduler_GetOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetOperatorRequest)
-	if err := dec(in); err != nil {
+func (c *schedulerClient) GetAllStores(ctx context.Context, in *GetAllStoresRequest, opts ...grpc.CallOption) (*GetAllStoresResponse, error) {
+	out := new(GetAllStoresResponse)
+	err := c.cc.Invoke(ctx, "/schedulerpb.Scheduler/GetAllStores", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(SchedulerServer).GetOperator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/schedulerpb.Scheduler/GetOperator",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SchedulerServer).GetOperator(ctx, req.(*GetOperatorRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-var _Scheduler_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "schedulerpb.Scheduler",
-	HandlerType: (*SchedulerServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetMembers",
-			Handler:    _Scheduler_GetMembers_Handler,
-		},
-		{
-			MethodName: "Bootstrap",
-			Handler:    _Scheduler_Bootstrap_Handler,
-		},
-		{
-			MethodName: "IsBootstrapped",
-			Handler:    _Scheduler_IsBootstrapped_Handler,
-		},
-		{
-			MethodName: "AllocID",
-			Handler:    _Scheduler_AllocID_Handler,
-		},
-		{
-			MethodName: "GetStore",
-			Handler:    _Scheduler_GetStore_Handler,
-		},
-		{
-			MethodName: "PutStore",
-			Handler:    _Scheduler_PutStore_Handler,
-		},
-		{
-			MethodName: "GetAllStores",
-			Handler:    _Scheduler_GetAllStores_Handler,
-		},
-		{
-			MethodName: "StoreHeartbeat",
-			Handler:    _Scheduler_StoreHeartbeat_Handler,
-		},
-		{
-			MethodName: "GetRegion",
-			Handler:    _Scheduler_GetRegion_Handler,
-		},
-		{
-			MethodName: "GetPrevRegion",
-			Handler:    _Scheduler_GetPrevRegion_Handler,
-		},
-		{
-			MethodName: "GetRegionByID",
-			Handler:    _Scheduler_GetRegionByID_Handler,
-		},
-		{
-			MethodName: "ScanRegions",
-			Handler:    _Scheduler_ScanRegions_Handler,
-		},
-		{
-			MethodName: "AskSplit",
-			Handler:    _Scheduler_AskSplit_Handler,
-		},
-		{
-			MethodName: "GetClusterConfig",
-			Handler:    _Scheduler_GetClusterConfig_Handler,
-		},
-		{
-			MethodName: "PutClusterConfig",
-			Handler:    _Scheduler_PutClusterConfig_Handler,
-		},
-		{
-			MethodName: "ScatterRegion",
-			Handler:    _Scheduler_ScatterRegion_Handler,
-		},
-		{
-			MethodName: "GetGCSafePoint",
-			Handler:    _Scheduler_GetGCSafePoint_Handler,
-		},
-		{
-			MethodName: "UpdateGCSafePoint",
-			Handler:    _Scheduler_UpdateGCSafePoint_Handler,
-		},
-		{
-			MethodName: "GetOperator",
-			Handler:    _Scheduler_GetOperator_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "Tso",
-			Handler:       _Scheduler_Tso_Handler,
-			ServerStreams: true,
-			ClientStreams: true,
-		},
-		{
-			StreamName:    "RegionHeartbeat",
-			Handler:       _Scheduler_RegionHeartbeat_Handler,
-			ServerStreams: true,
-			ClientStreams: true,
-		},
-	},
-	Metadata: "schedulerpb.proto",
+func (c *schedulerClient) StoreHeartbeat(ctx context.Context, in *StoreHeartbeatRequest, opts ...grpc.CallOption) (*StoreHeartbeatResponse, error) {
+	out := new(StoreHeartbeatResponse)
+	err := c.cc.Invoke(ctx, "/schedulerpb.Scheduler/StoreHeartbeat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *RequestHeader) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *schedulerClient) RegionHeartbeat(ctx context.Context, opts ...grpc.CallOption) (Scheduler_RegionHeartbeatClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Scheduler_serviceDesc.Streams[1], "/schedulerpb.Scheduler/RegionHeartbeat", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	x := &schedulerRegionHeartbeatClient{stream}
+	return x, nil
 }
 
-func (m *RequestHeader) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.ClusterId != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.ClusterId))
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+type Scheduler_RegionHeartbeatClient interface {
+	Send(*RegionHeartbeatRequest) error
+	Recv() (*RegionHeartbeatResponse, error)
+	grpc.ClientStream
 }
 
-func (m *ResponseHeader) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type schedulerRegionHeartbeatClient struct {
+	grpc.ClientStream
 }
 
-func (m *ResponseHeader) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.ClusterId != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.ClusterId))
-	}
-	if m.Error != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Error.Size()))
-		n1, err := m.Error.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n1
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (x *schedulerRegionHeartbeatClient) Send(m *RegionHeartbeatRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *schedulerRegionHeartbeatClient) Recv() (*RegionHeartbeatResponse, error) {
+	m := new(RegionHeartbeatResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return i, nil
+	return m, nil
 }
 
-func (m *Error) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *schedulerClient) GetRegion(ctx context.Context, in *GetRegionRequest, opts ...grpc.CallOption) (*GetRegionResponse, error) {
+	out := new(GetRegionResponse)
+	err := c.cc.Invoke(ctx, "/schedulerpb.Scheduler/GetRegion", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *Error) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Type != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Type))
-	}
-	if len(m.Message) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(len(m.Message)))
-		i += copy(dAtA[i:], m.Message)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (c *schedulerClient) GetPrevRegion(ctx context.Context, in *GetRegionRequest, opts ...grpc.CallOption) (*GetRegionResponse, error) {
+	out := new(GetRegionResponse)
+	err := c.cc.Invoke(ctx, "/schedulerpb.Scheduler/GetPrevRegion", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *TsoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *schedulerClient) GetRegionByID(ctx context.Context, in *GetRegionByIDRequest, opts ...grpc.CallOption) (*GetRegionResponse, error) {
+	out := new(GetRegionResponse)
+	err := c.cc.Invoke(ctx, "/schedulerpb.Scheduler/GetRegionByID", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *TsoRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Header != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
-		n2, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n2
-	}
-	if m.Count != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Count))
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
-}
-
-func (m *Timestamp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *schedulerClient) ScanRegions(ctx context.Context, in *ScanRegionsRequest, opts ...grpc.CallOption) (*ScanRegionsResponse, error) {
+	out := new(ScanRegionsResponse)
+	err := c.cc.Invoke(ctx, "/schedulerpb.Scheduler/ScanRegions", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Timestamp) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Physical != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Physical))
-	}
-	if m.Logical != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Logical))
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+	return out, nil
 }
 
-func (m *TsoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *schedulerClient) AskSplit(ctx context.Context, in *AskSplitRequest, opts ...grpc.CallOption) (*AskSplitResponse, error) {
+	out := new(AskSplitResponse)
+	err := c.cc.Invoke(ctx, "/schedulerpb.Scheduler/AskSplit", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TsoResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Header != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
-		n3, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n3
-	}
-	if m.Count != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Count))
-	}
-	if m.Timestamp != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Timestamp.Size()))
-		n4, err := m.Timestamp.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n4
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+	return out, nil
 }
 
-func (m *BootstrapRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *schedulerClient) GetClusterConfig(ctx context.Context, in *GetClusterConfigRequest, opts ...grpc.CallOption) (*GetClusterConfigResponse, error) {
+	out := new(GetClusterConfigResponse)
+	err := c.cc.Invoke(ctx, "/schedulerpb.Scheduler/GetClusterConfig", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *BootstrapRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Header != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
-		n5, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n5
-	}
-	if m.Store != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Store.Size()))
-		n6, err := m.Store.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n6
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (c *schedulerClient) PutClusterConfig(ctx context.Context, in *PutClusterConfigRequest, opts ...grpc.CallOption) (*PutClusterConfigResponse, error) {
+	out := new(PutClusterConfigResponse)
+	err := c.cc.Invoke(ctx, "/schedulerpb.Scheduler/PutClusterConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *BootstrapResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *schedulerClient) ScatterRegion(ctx context.Context, in *ScatterRegionRequest, opts ...grpc.CallOption) (*ScatterRegionResponse, error) {
+	out := new(ScatterRegionResponse)
+	err := c.cc.Invoke(ctx, "/schedulerpb.Scheduler/ScatterRegion", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *BootstrapResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Header != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
-		n7, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n7
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (c *schedulerClient) GetGCSafePoint(ctx context.Context, in *GetGCSafePointRequest, opts ...grpc.CallOption) (*GetGCSafePointResponse, error) {
+	out := new(GetGCSafePointResponse)
+	err := c.cc.Invoke(ctx, "/schedulerpb.Scheduler/GetGCSafePoint", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *IsBootstrappedRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *schedulerClient) UpdateGCSafePoint(ctx context.Context, in *UpdateGCSafePointRequest, opts ...grpc.CallOption) (*UpdateGCSafePointResponse, error) {
+	out := new(UpdateGCSafePointResponse)
+	err := c.cc.Invoke(ctx, "/schedulerpb.Scheduler/UpdateGCSafePoint", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *IsBootstrappedRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Header != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
-		n8, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n8
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (c *schedulerClient) GetOperator(ctx context.Context, in *GetOperatorRequest, opts ...grpc.CallOption) (*GetOperatorResponse, error) {
+	out := new(GetOperatorResponse)
+	err := c.cc.Invoke(ctx, "/schedulerpb.Scheduler/GetOperator", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *IsBootstrappedResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *schedulerClient) GetPlacementRules(ctx context.Context, in *GetPlacementRulesRequest, opts ...grpc.CallOption) (*GetPlacementRulesResponse, error) {
+	out := new(GetPlacementRulesResponse)
+	err := c.cc.Invoke(ctx, "/schedulerpb.Scheduler/GetPlacementRules", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *IsBootstrappedResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Header != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
-		n9, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n9
-	}
-	if m.Bootstrapped {
-		dAtA[i] = 0x10
-		i++
-		if m.Bootstrapped {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (c *schedulerClient) SetPlacementRule(ctx context.Context, in *SetPlacementRuleRequest, opts ...grpc.CallOption) (*SetPlacementRuleResponse, error) {
+	out := new(SetPlacementRuleResponse)
+	err := c.cc.Invoke(ctx, "/schedulerpb.Scheduler/SetPlacementRule", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *AllocIDRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *schedulerClient) DeletePlacementRule(ctx context.Context, in *DeletePlacementRuleRequest, opts ...grpc.CallOption) (*DeletePlacementRuleResponse, error) {
+	out := new(DeletePlacementRuleResponse)
+	err := c.cc.Invoke(ctx, "/schedulerpb.Scheduler/DeletePlacementRule", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *AllocIDRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Header != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
-		n10, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n10
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+// Server API for Scheduler service
+
+type SchedulerServer interface {
+	// GetMembers get the member list of this cluster. It does not require
+	// the cluster_id in request matchs the id of this cluster.
+	GetMembers(context.Context, *GetMembersRequest) (*GetMembersResponse, error)
+	Tso(Scheduler_TsoServer) error
+	Bootstrap(context.Context, *BootstrapRequest) (*BootstrapResponse, error)
+	IsBootstrapped(context.Context, *IsBootstrappedRequest) (*IsBootstrappedResponse, error)
+	AllocID(context.Context, *AllocIDRequest) (*AllocIDResponse, error)
+	GetStore(context.Context, *GetStoreRequest) (*GetStoreResponse, error)
+	PutStore(context.Context, *PutStoreRequest) (*PutStoreResponse, error)
+	GetAllStores(context.Context, *GetAllStoresRequest) (*GetAllStoresResponse, error)
+	StoreHeartbeat(context.Context, *StoreHeartbeatRequest) (*StoreHeartbeatResponse, error)
+	RegionHeartbeat(Scheduler_RegionHeartbeatServer) error
+	GetRegion(context.Context, *GetRegionRequest) (*GetRegionResponse, error)
+	GetPrevRegion(context.Context, *GetRegionRequest) (*GetRegionResponse, error)
+	GetRegionByID(context.Context, *GetRegionByIDRequest) (*GetRegionResponse, error)
+	ScanRegions(context.Context, *ScanRegionsRequest) (*ScanRegionsResponse, error)
+	AskSplit(context.Context, *AskSplitRequest) (*AskSplitResponse, error)
+	GetClusterConfig(context.Context, *GetClusterConfigRequest) (*GetClusterConfigResponse, error)
+	PutClusterConfig(context.Context, *PutClusterConfigRequest) (*PutClusterConfigResponse, error)
+	ScatterRegion(context.Context, *ScatterRegionRequest) (*ScatterRegionResponse, error)
+	GetGCSafePoint(context.Context, *GetGCSafePointRequest) (*GetGCSafePointResponse, error)
+	UpdateGCSafePoint(context.Context, *UpdateGCSafePointRequest) (*UpdateGCSafePointResponse, error)
+	GetOperator(context.Context, *GetOperatorRequest) (*GetOperatorResponse, error)
+	GetPlacementRules(context.Context, *GetPlacementRulesRequest) (*GetPlacementRulesResponse, error)
+	SetPlacementRule(context.Context, *SetPlacementRuleRequest) (*SetPlacementRuleResponse, error)
+	DeletePlacementRule(context.Context, *DeletePlacementRuleRequest) (*DeletePlacementRuleResponse, error)
 }
 
-func (m *AllocIDResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func RegisterSchedulerServer(s *grpc.Server, srv SchedulerServer) {
+	s.RegisterService(&_Scheduler_serviceDesc, srv)
 }
 
-func (m *AllocIDResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Header != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
-		n11, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n11
+func _Scheduler_GetMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Id != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Id))
+	if interceptor == nil {
+		return srv.(SchedulerServer).GetMembers(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schedulerpb.Scheduler/GetMembers",
 	}
-	return i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).GetMembers(ctx, req.(*GetMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetStoreRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func _Scheduler_Tso_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(SchedulerServer).Tso(&schedulerTsoServer{stream})
 }
 
-func (m *GetStoreRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Header != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
-		n12, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n12
-	}
-	if m.StoreId != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.StoreId))
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+type Scheduler_TsoServer interface {
+	Send(*TsoResponse) error
+	Recv() (*TsoRequest, error)
+	grpc.ServerStream
 }
 
-func (m *GetStoreResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+type schedulerTsoServer struct {
+	grpc.ServerStream
+}
+
+func (x *schedulerTsoServer) Send(m *TsoResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *schedulerTsoServer) Recv() (*TsoRequest, error) {
+	m := new(TsoRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return m, nil
 }
 
-func (m *GetStoreResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Header != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
-		n13, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n13
+func _Scheduler_Bootstrap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BootstrapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Store != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Store.Size()))
-		n14, err := m.Store.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n14
+	if interceptor == nil {
+		return srv.(SchedulerServer).Bootstrap(ctx, in)
 	}
-	if m.Stats != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Stats.Size()))
-		n15, err := m.Stats.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n15
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schedulerpb.Scheduler/Bootstrap",
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).Bootstrap(ctx, req.(*BootstrapRequest))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PutStoreRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _Scheduler_IsBootstrapped_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsBootstrappedRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *PutStoreRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Header != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
-		n16, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n16
+	if interceptor == nil {
+		return srv.(SchedulerServer).IsBootstrapped(ctx, in)
 	}
-	if m.Store != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Store.Size()))
-		n17, err := m.Store.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n17
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schedulerpb.Scheduler/IsBootstrapped",
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).IsBootstrapped(ctx, req.(*IsBootstrappedRequest))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PutStoreResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _Scheduler_AllocID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AllocIDRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(SchedulerServer).AllocID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schedulerpb.Scheduler/AllocID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).AllocID(ctx, req.(*AllocIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PutStoreResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Header != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
-		n18, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n18
+func _Scheduler_GetStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(SchedulerServer).GetStore(ctx, in)
 	}
-	return i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schedulerpb.Scheduler/GetStore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).GetStore(ctx, req.(*GetStoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetAllStoresRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _Scheduler_PutStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutStoreRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetAllStoresRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Header != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
-		n19, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n19
+	if interceptor == nil {
+		return srv.(SchedulerServer).PutStore(ctx, in)
 	}
-	if m.ExcludeTombstoneStores {
-		dAtA[i] = 0x10
-		i++
-		if m.ExcludeTombstoneStores {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schedulerpb.Scheduler/PutStore",
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).PutStore(ctx, req.(*PutStoreRequest))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetAllStoresResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _Scheduler_GetAllStores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllStoresRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetAllStoresResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Header != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
-		n20, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n20
+	if interceptor == nil {
+		return srv.(SchedulerServer).GetAllStores(ctx, in)
 	}
-	if len(m.Stores) > 0 {
-		for _, msg := range m.Stores {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintSchedulerpb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schedulerpb.Scheduler/GetAllStores",
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).GetAllStores(ctx, req.(*GetAllStoresRequest))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetRegionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _Scheduler_StoreHeartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StoreHeartbeatRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetRegionRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Header != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
-		n21, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n21
+	if interceptor == nil {
+		return srv.(SchedulerServer).StoreHeartbeat(ctx, in)
 	}
-	if len(m.RegionKey) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(len(m.RegionKey)))
-		i += copy(dAtA[i:], m.RegionKey)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schedulerpb.Scheduler/StoreHeartbeat",
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).StoreHeartbeat(ctx, req.(*StoreHeartbeatRequest))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetRegionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _Scheduler_RegionHeartbeat_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(SchedulerServer).RegionHeartbeat(&schedulerRegionHeartbeatServer{stream})
+}
+
+type Scheduler_RegionHeartbeatServer interface {
+	Send(*RegionHeartbeatResponse) error
+	Recv() (*RegionHeartbeatRequest, error)
+	grpc.ServerStream
+}
+
+type schedulerRegionHeartbeatServer struct {
+	grpc.ServerStream
+}
+
+func (x *schedulerRegionHeartbeatServer) Send(m *RegionHeartbeatResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *schedulerRegionHeartbeatServer) Recv() (*RegionHeartbeatRequest, error) {
+	m := new(RegionHeartbeatRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return m, nil
 }
 
-func (m *GetRegionResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Header != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
-		n22, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n22
-	}
-	if m.Region != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Region.Size()))
-		n23, err := m.Region.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n23
+func _Scheduler_GetRegion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRegionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Leader != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Leader.Size()))
-		n24, err := m.Leader.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n24
+	if interceptor == nil {
+		return srv.(SchedulerServer).GetRegion(ctx, in)
 	}
-	if len(m.Slaves) > 0 {
-		for _, msg := range m.Slaves {
-			dAtA[i] = 0x22
-			i++
-			i = encodeVarintSchedulerpb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schedulerpb.Scheduler/GetRegion",
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).GetRegion(ctx, req.(*GetRegionRequest))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetRegionByIDRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _Scheduler_GetPrevRegion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRegionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetRegionByIDRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Header != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
-		n25, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n25
+	if interceptor == nil {
+		return srv.(SchedulerServer).GetPrevRegion(ctx, in)
 	}
-	if m.RegionId != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.RegionId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schedulerpb.Scheduler/GetPrevRegion",
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).GetPrevRegion(ctx, req.(*GetRegionRequest))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ScanRegionsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _Scheduler_GetRegionByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRegionByIDRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(SchedulerServer).GetRegionByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schedulerpb.Scheduler/GetRegionByID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).GetRegionByID(ctx, req.(*GetRegionByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ScanRegionsRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Header != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
-		n26, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n26
-	}
-	if len(m.StartKey) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(len(m.StartKey)))
-		i += copy(dAtA[i:], m.StartKey)
+func _Scheduler_ScanRegions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScanRegionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Limit != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Limit))
+	if interceptor == nil {
+		return srv.(SchedulerServer).ScanRegions(ctx, in)
 	}
-	if len(m.EndKey) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(len(m.EndKey)))
-		i += copy(dAtA[i:], m.EndKey)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schedulerpb.Scheduler/ScanRegions",
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).ScanRegions(ctx, req.(*ScanRegionsRequest))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ScanRegionsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _Scheduler_AskSplit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AskSplitRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(SchedulerServer).AskSplit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schedulerpb.Scheduler/AskSplit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).AskSplit(ctx, req.(*AskSplitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ScanRegionsResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Header != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
-		n27, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n27
+func _Scheduler_GetClusterConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClusterConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Regions) > 0 {
-		for _, msg := range m.Regions {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintSchedulerpb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	if interceptor == nil {
+		return srv.(SchedulerServer).GetClusterConfig(ctx, in)
 	}
-	if len(m.Leaders) > 0 {
-		for _, msg := range m.Leaders {
-			dAtA[i] = 0x1a
-			i++
-			i = encodeVarintSchedulerpb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schedulerpb.Scheduler/GetClusterConfig",
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).GetClusterConfig(ctx, req.(*GetClusterConfigRequest))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetClusterConfigRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _Scheduler_PutClusterConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutClusterConfigRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetClusterConfigRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Header != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
-		n28, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n28
+	if interceptor == nil {
+		return srv.(SchedulerServer).PutClusterConfig(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schedulerpb.Scheduler/PutClusterConfig",
 	}
-	return i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).PutClusterConfig(ctx, req.(*PutClusterConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetClusterConfigResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _Scheduler_ScatterRegion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScatterRegionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetClusterConfigResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Header != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
-		n29, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n29
+	if interceptor == nil {
+		return srv.(SchedulerServer).ScatterRegion(ctx, in)
 	}
-	if m.Cluster != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Cluster.Size()))
-		n30, err := m.Cluster.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n30
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schedulerpb.Scheduler/ScatterRegion",
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).ScatterRegion(ctx, req.(*ScatterRegionRequest))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PutClusterConfigRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _Scheduler_GetGCSafePoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGCSafePointRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(SchedulerServer).GetGCSafePoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schedulerpb.Scheduler/GetGCSafePoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).GetGCSafePoint(ctx, req.(*GetGCSafePointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PutClusterConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+func _Scheduler_UpdateGCSafePoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGCSafePointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).UpdateGCSafePoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schedulerpb.Scheduler/UpdateGCSafePoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).UpdateGCSafePoint(ctx, req.(*UpdateGCSafePointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scheduler_GetOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOperatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).GetOperator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schedulerpb.Scheduler/GetOperator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).GetOperator(ctx, req.(*GetOperatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scheduler_GetPlacementRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlacementRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).GetPlacementRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schedulerpb.Scheduler/GetPlacementRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).GetPlacementRules(ctx, req.(*GetPlacementRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scheduler_SetPlacementRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPlacementRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).SetPlacementRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schedulerpb.Scheduler/SetPlacementRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).SetPlacementRule(ctx, req.(*SetPlacementRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scheduler_DeletePlacementRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePlacementRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).DeletePlacementRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schedulerpb.Scheduler/DeletePlacementRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).DeletePlacementRule(ctx, req.(*DeletePlacementRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Scheduler_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "schedulerpb.Scheduler",
+	HandlerType: (*SchedulerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetMembers",
+			Handler:    _Scheduler_GetMembers_Handler,
+		},
+		{
+			MethodName: "Bootstrap",
+			Handler:    _Scheduler_Bootstrap_Handler,
+		},
+		{
+			MethodName: "IsBootstrapped",
+			Handler:    _Scheduler_IsBootstrapped_Handler,
+		},
+		{
+			MethodName: "AllocID",
+			Handler:    _Scheduler_AllocID_Handler,
+		},
+		{
+			MethodName: "GetStore",
+			Handler:    _Scheduler_GetStore_Handler,
+		},
+		{
+			MethodName: "PutStore",
+			Handler:    _Scheduler_PutStore_Handler,
+		},
+		{
+			MethodName: "GetAllStores",
+			Handler:    _Scheduler_GetAllStores_Handler,
+		},
+		{
+			MethodName: "StoreHeartbeat",
+			Handler:    _Scheduler_StoreHeartbeat_Handler,
+		},
+		{
+			MethodName: "GetRegion",
+			Handler:    _Scheduler_GetRegion_Handler,
+		},
+		{
+			MethodName: "GetPrevRegion",
+			Handler:    _Scheduler_GetPrevRegion_Handler,
+		},
+		{
+			MethodName: "GetRegionByID",
+			Handler:    _Scheduler_GetRegionByID_Handler,
+		},
+		{
+			MethodName: "ScanRegions",
+			Handler:    _Scheduler_ScanRegions_Handler,
+		},
+		{
+			MethodName: "AskSplit",
+			Handler:    _Scheduler_AskSplit_Handler,
+		},
+		{
+			MethodName: "GetClusterConfig",
+			Handler:    _Scheduler_GetClusterConfig_Handler,
+		},
+		{
+			MethodName: "PutClusterConfig",
+			Handler:    _Scheduler_PutClusterConfig_Handler,
+		},
+		{
+			MethodName: "ScatterRegion",
+			Handler:    _Scheduler_ScatterRegion_Handler,
+		},
+		{
+			MethodName: "GetGCSafePoint",
+			Handler:    _Scheduler_GetGCSafePoint_Handler,
+		},
+		{
+			MethodName: "UpdateGCSafePoint",
+			Handler:    _Scheduler_UpdateGCSafePoint_Handler,
+		},
+		{
+			MethodName: "GetOperator",
+			Handler:    _Scheduler_GetOperator_Handler,
+		},
+		{
+			MethodName: "GetPlacementRules",
+			Handler:    _Scheduler_GetPlacementRules_Handler,
+		},
+		{
+			MethodName: "SetPlacementRule",
+			Handler:    _Scheduler_SetPlacementRule_Handler,
+		},
+		{
+			MethodName: "DeletePlacementRule",
+			Handler:    _Scheduler_DeletePlacementRule_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Tso",
+			Handler:       _Scheduler_Tso_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "RegionHeartbeat",
+			Handler:       _Scheduler_RegionHeartbeat_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "schedulerpb.proto",
+}
+
+func (m *RequestHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestHeader) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Header != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
-		n31, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n31
-	}
-	if m.Cluster != nil {
-		dAtA[i] = 0x12
+	if m.ClusterId != 0 {
+		dAtA[i] = 0x8
 		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Cluster.Size()))
-		n32, err := m.Cluster.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n32
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.ClusterId))
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5089,7 +4879,7 @@ func (m *PutClusterConfigRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *PutClusterConfigResponse) Marshal() (dAtA []byte, err error) {
+func (m *ResponseHeader) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5099,20 +4889,25 @@ func (m *PutClusterConfigResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PutClusterConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ResponseHeader) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Header != nil {
-		dAtA[i] = 0xa
+	if m.ClusterId != 0 {
+		dAtA[i] = 0x8
 		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
-		n33, err := m.Header.MarshalTo(dAtA[i:])
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.ClusterId))
+	}
+	if m.Error != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Error.Size()))
+		n1, err := m.Error.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n33
+		i += n1
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5120,7 +4915,7 @@ func (m *PutClusterConfigResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *Member) Marshal() (dAtA []byte, err error) {
+func (m *Error) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5130,56 +4925,21 @@ func (m *Member) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Member) MarshalTo(dAtA []byte) (int, error) {
+func (m *Error) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Name) > 0 {
-		dAtA[i] = 0xa
+	if m.Type != 0 {
+		dAtA[i] = 0x8
 		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Type))
 	}
-	if m.MemberId != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.MemberId))
-	}
-	if len(m.PeerUrls) > 0 {
-		for _, s := range m.PeerUrls {
-			dAtA[i] = 0x1a
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
-	}
-	if len(m.ClientUrls) > 0 {
-		for _, s := range m.ClientUrls {
-			dAtA[i] = 0x22
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
-	}
-	if m.LeaderPriority != 0 {
-		dAtA[i] = 0x28
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x12
 		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.LeaderPriority))
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5187,7 +4947,7 @@ func (m *Member) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *GetMembersRequest) Marshal() (dAtA []byte, err error) {
+func (m *TsoRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5197,7 +4957,7 @@ func (m *GetMembersRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetMembersRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *TsoRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -5206,11 +4966,16 @@ func (m *GetMembersRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
-		n34, err := m.Header.MarshalTo(dAtA[i:])
+		n2, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n34
+		i += n2
+	}
+	if m.Count != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Count))
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5218,7 +4983,7 @@ func (m *GetMembersRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *GetMembersResponse) Marshal() (dAtA []byte, err error) {
+func (m *Timestamp) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5228,52 +4993,20 @@ func (m *GetMembersResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetMembersResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *Timestamp) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Header != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
-		n35, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n35
-	}
-	if len(m.Members) > 0 {
-		for _, msg := range m.Members {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintSchedulerpb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if m.Leader != nil {
-		dAtA[i] = 0x1a
+	if m.Physical != 0 {
+		dAtA[i] = 0x8
 		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Leader.Size()))
-		n36, err := m.Leader.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n36
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Physical))
 	}
-	if m.EtcdLeader != nil {
-		dAtA[i] = 0x22
+	if m.Logical != 0 {
+		dAtA[i] = 0x10
 		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.EtcdLeader.Size()))
-		n37, err := m.EtcdLeader.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n37
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Logical))
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5281,7 +5014,7 @@ func (m *GetMembersResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *RegionHeartbeatRequest) Marshal() (dAtA []byte, err error) {
+func (m *TsoResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5291,7 +5024,7 @@ func (m *RegionHeartbeatRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RegionHeartbeatRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *TsoResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -5300,48 +5033,26 @@ func (m *RegionHeartbeatRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
-		n38, err := m.Header.MarshalTo(dAtA[i:])
+		n3, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n38
+		i += n3
 	}
-	if m.Region != nil {
-		dAtA[i] = 0x12
+	if m.Count != 0 {
+		dAtA[i] = 0x10
 		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Region.Size()))
-		n39, err := m.Region.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n39
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Count))
 	}
-	if m.Leader != nil {
+	if m.Timestamp != nil {
 		dAtA[i] = 0x1a
 		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Leader.Size()))
-		n40, err := m.Leader.MarshalTo(dAtA[i:])
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Timestamp.Size()))
+		n4, err := m.Timestamp.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n40
-	}
-	if len(m.PendingPeers) > 0 {
-		for _, msg := range m.PendingPeers {
-			dAtA[i] = 0x2a
-			i++
-			i = encodeVarintSchedulerpb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if m.ApproximateSize != 0 {
-		dAtA[i] = 0x50
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.ApproximateSize))
+		i += n4
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5349,7 +5060,7 @@ func (m *RegionHeartbeatRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *ChangePeer) Marshal() (dAtA []byte, err error) {
+func (m *BootstrapRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5359,25 +5070,30 @@ func (m *ChangePeer) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ChangePeer) MarshalTo(dAtA []byte) (int, error) {
+func (m *BootstrapRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Peer != nil {
+	if m.Header != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Peer.Size()))
-		n41, err := m.Peer.MarshalTo(dAtA[i:])
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
+		n5, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n41
+		i += n5
 	}
-	if m.ChangeType != 0 {
-		dAtA[i] = 0x10
+	if m.Store != nil {
+		dAtA[i] = 0x12
 		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.ChangeType))
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Store.Size()))
+		n6, err := m.Store.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5385,7 +5101,7 @@ func (m *ChangePeer) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *TransferLeader) Marshal() (dAtA []byte, err error) {
+func (m *BootstrapResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5395,20 +5111,20 @@ func (m *TransferLeader) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TransferLeader) MarshalTo(dAtA []byte) (int, error) {
+func (m *BootstrapResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Peer != nil {
+	if m.Header != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Peer.Size()))
-		n42, err := m.Peer.MarshalTo(dAtA[i:])
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
+		n7, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n42
+		i += n7
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5416,7 +5132,7 @@ func (m *TransferLeader) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *RegionHeartbeatResponse) Marshal() (dAtA []byte, err error) {
+func (m *IsBootstrappedRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5426,7 +5142,7 @@ func (m *RegionHeartbeatResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RegionHeartbeatResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *IsBootstrappedRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -5435,56 +5151,11 @@ func (m *RegionHeartbeatResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
-		n43, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n43
-	}
-	if m.ChangePeer != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.ChangePeer.Size()))
-		n44, err := m.ChangePeer.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n44
-	}
-	if m.TransferLeader != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.TransferLeader.Size()))
-		n45, err := m.TransferLeader.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n45
-	}
-	if m.RegionId != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.RegionId))
-	}
-	if m.RegionEpoch != nil {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.RegionEpoch.Size()))
-		n46, err := m.RegionEpoch.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n46
-	}
-	if m.TargetPeer != nil {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.TargetPeer.Size()))
-		n47, err := m.TargetPeer.MarshalTo(dAtA[i:])
+		n8, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n47
+		i += n8
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5492,7 +5163,7 @@ func (m *RegionHeartbeatResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *AskSplitRequest) Marshal() (dAtA []byte, err error) {
+func (m *IsBootstrappedResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5502,7 +5173,7 @@ func (m *AskSplitRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AskSplitRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *IsBootstrappedResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -5511,21 +5182,21 @@ func (m *AskSplitRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
-		n48, err := m.Header.MarshalTo(dAtA[i:])
+		n9, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n48
+		i += n9
 	}
-	if m.Region != nil {
-		dAtA[i] = 0x12
+	if m.Bootstrapped {
+		dAtA[i] = 0x10
 		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Region.Size()))
-		n49, err := m.Region.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+		if m.Bootstrapped {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i += n49
+		i++
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5533,7 +5204,7 @@ func (m *AskSplitRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *AskSplitResponse) Marshal() (dAtA []byte, err error) {
+func (m *AllocIDRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5543,7 +5214,7 @@ func (m *AskSplitResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AskSplitResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *AllocIDRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -5552,33 +5223,11 @@ func (m *AskSplitResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
-		n50, err := m.Header.MarshalTo(dAtA[i:])
+		n10, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n50
-	}
-	if m.NewRegionId != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.NewRegionId))
-	}
-	if len(m.NewPeerIds) > 0 {
-		dAtA52 := make([]byte, len(m.NewPeerIds)*10)
-		var j51 int
-		for _, num := range m.NewPeerIds {
-			for num >= 1<<7 {
-				dAtA52[j51] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j51++
-			}
-			dAtA52[j51] = uint8(num)
-			j51++
-		}
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(j51))
-		i += copy(dAtA[i:], dAtA52[:j51])
+		i += n10
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5586,7 +5235,7 @@ func (m *AskSplitResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *ReportSplitRequest) Marshal() (dAtA []byte, err error) {
+func (m *AllocIDResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5596,7 +5245,7 @@ func (m *ReportSplitRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ReportSplitRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *AllocIDResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -5605,31 +5254,16 @@ func (m *ReportSplitRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
-		n53, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n53
-	}
-	if m.Left != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Left.Size()))
-		n54, err := m.Left.MarshalTo(dAtA[i:])
+		n11, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n54
+		i += n11
 	}
-	if m.Right != nil {
-		dAtA[i] = 0x1a
+	if m.Id != 0 {
+		dAtA[i] = 0x10
 		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Right.Size()))
-		n55, err := m.Right.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n55
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Id))
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5637,7 +5271,7 @@ func (m *ReportSplitRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *ReportSplitResponse) Marshal() (dAtA []byte, err error) {
+func (m *GetStoreRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5647,7 +5281,7 @@ func (m *ReportSplitResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ReportSplitResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetStoreRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -5656,11 +5290,16 @@ func (m *ReportSplitResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
-		n56, err := m.Header.MarshalTo(dAtA[i:])
+		n12, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n56
+		i += n12
+	}
+	if m.StoreId != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.StoreId))
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5668,7 +5307,7 @@ func (m *ReportSplitResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *SplitID) Marshal() (dAtA []byte, err error) {
+func (m *GetStoreResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5678,32 +5317,40 @@ func (m *SplitID) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SplitID) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetStoreResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.NewRegionId != 0 {
-		dAtA[i] = 0x8
+	if m.Header != nil {
+		dAtA[i] = 0xa
 		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.NewRegionId))
-	}
-	if len(m.NewPeerIds) > 0 {
-		dAtA58 := make([]byte, len(m.NewPeerIds)*10)
-		var j57 int
-		for _, num := range m.NewPeerIds {
-			for num >= 1<<7 {
-				dAtA58[j57] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j57++
-			}
-			dAtA58[j57] = uint8(num)
-			j57++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
+		n13, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n13
+	}
+	if m.Store != nil {
 		dAtA[i] = 0x12
 		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(j57))
-		i += copy(dAtA[i:], dAtA58[:j57])
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Store.Size()))
+		n14, err := m.Store.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n14
+	}
+	if m.Stats != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Stats.Size()))
+		n15, err := m.Stats.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n15
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5711,7 +5358,7 @@ func (m *SplitID) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *TimeInterval) Marshal() (dAtA []byte, err error) {
+func (m *PutStoreRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5721,20 +5368,30 @@ func (m *TimeInterval) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TimeInterval) MarshalTo(dAtA []byte) (int, error) {
+func (m *PutStoreRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.StartTimestamp != 0 {
-		dAtA[i] = 0x8
+	if m.Header != nil {
+		dAtA[i] = 0xa
 		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.StartTimestamp))
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
+		n16, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n16
 	}
-	if m.EndTimestamp != 0 {
-		dAtA[i] = 0x10
+	if m.Store != nil {
+		dAtA[i] = 0x12
 		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.EndTimestamp))
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Store.Size()))
+		n17, err := m.Store.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n17
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5742,7 +5399,7 @@ func (m *TimeInterval) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *RecordPair) Marshal() (dAtA []byte, err error) {
+func (m *PutStoreResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5752,21 +5409,20 @@ func (m *RecordPair) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RecordPair) MarshalTo(dAtA []byte) (int, error) {
+func (m *PutStoreResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Key) > 0 {
+	if m.Header != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(len(m.Key)))
-		i += copy(dAtA[i:], m.Key)
-	}
-	if m.Value != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Value))
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
+		n18, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n18
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5774,7 +5430,7 @@ func (m *RecordPair) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *StoreStats) Marshal() (dAtA []byte, err error) {
+func (m *GetAllStoresRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5784,95 +5440,65 @@ func (m *StoreStats) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StoreStats) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetAllStoresRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.StoreId != 0 {
-		dAtA[i] = 0x8
+	if m.Header != nil {
+		dAtA[i] = 0xa
 		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.StoreId))
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
+		n19, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n19
 	}
-	if m.Capacity != 0 {
+	if m.ExcludeTombstoneStores {
 		dAtA[i] = 0x10
 		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Capacity))
-	}
-	if m.Available != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Available))
-	}
-	if m.RegionCount != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.RegionCount))
-	}
-	if m.SendingSnapCount != 0 {
-		dAtA[i] = 0x28
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.SendingSnapCount))
-	}
-	if m.ReceivingSnapCount != 0 {
-		dAtA[i] = 0x30
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.ReceivingSnapCount))
-	}
-	if m.StartTime != 0 {
-		dAtA[i] = 0x38
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.StartTime))
-	}
-	if m.ApplyingSnapCount != 0 {
-		dAtA[i] = 0x40
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.ApplyingSnapCount))
-	}
-	if m.IsBusy {
-		dAtA[i] = 0x48
-		i++
-		if m.IsBusy {
+		if m.ExcludeTombstoneStores {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i++
 	}
-	if m.UsedSize != 0 {
-		dAtA[i] = 0x50
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.UsedSize))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Interval != nil {
-		dAtA[i] = 0x7a
+	return i, nil
+}
+
+func (m *GetAllStoresResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAllStoresResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Header != nil {
+		dAtA[i] = 0xa
 		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Interval.Size()))
-		n59, err := m.Interval.MarshalTo(dAtA[i:])
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
+		n20, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n59
-	}
-	if len(m.CpuUsages) > 0 {
-		for _, msg := range m.CpuUsages {
-			dAtA[i] = 0x82
-			i++
-			dAtA[i] = 0x1
-			i++
-			i = encodeVarintSchedulerpb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+		i += n20
 	}
-	if len(m.ReadIoRates) > 0 {
-		for _, msg := range m.ReadIoRates {
-			dAtA[i] = 0x8a
-			i++
-			dAtA[i] = 0x1
+	if len(m.Stores) > 0 {
+		for _, msg := range m.Stores {
+			dAtA[i] = 0x12
 			i++
 			i = encodeVarintSchedulerpb(dAtA, i, uint64(msg.Size()))
 			n, err := msg.MarshalTo(dAtA[i:])
@@ -5882,41 +5508,13 @@ func (m *StoreStats) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
-	if len(m.WriteIoRates) > 0 {
-		for _, msg := range m.WriteIoRates {
-			dAtA[i] = 0x92
-			i++
-			dAtA[i] = 0x1
-			i++
-			i = encodeVarintSchedulerpb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if len(m.OpLatencies) > 0 {
-		for _, msg := range m.OpLatencies {
-			dAtA[i] = 0x9a
-			i++
-			dAtA[i] = 0x1
-			i++
-			i = encodeVarintSchedulerpb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *StoreHeartbeatRequest) Marshal() (dAtA []byte, err error) {
+func (m *GetRegionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5926,7 +5524,7 @@ func (m *StoreHeartbeatRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StoreHeartbeatRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetRegionRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -5935,21 +5533,17 @@ func (m *StoreHeartbeatRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
-		n60, err := m.Header.MarshalTo(dAtA[i:])
+		n21, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n60
+		i += n21
 	}
-	if m.Stats != nil {
+	if len(m.RegionKey) > 0 {
 		dAtA[i] = 0x12
 		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Stats.Size()))
-		n61, err := m.Stats.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n61
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(len(m.RegionKey)))
+		i += copy(dAtA[i:], m.RegionKey)
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5957,7 +5551,7 @@ func (m *StoreHeartbeatRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *StoreHeartbeatResponse) Marshal() (dAtA []byte, err error) {
+func (m *GetRegionResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5967,7 +5561,7 @@ func (m *StoreHeartbeatResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StoreHeartbeatResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetRegionResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -5976,11 +5570,43 @@ func (m *StoreHeartbeatResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
-		n62, err := m.Header.MarshalTo(dAtA[i:])
+		n22, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n62
+		i += n22
+	}
+	if m.Region != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Region.Size()))
+		n23, err := m.Region.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n23
+	}
+	if m.Leader != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Leader.Size()))
+		n24, err := m.Leader.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n24
+	}
+	if len(m.Slaves) > 0 {
+		for _, msg := range m.Slaves {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintSchedulerpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5988,7 +5614,7 @@ func (m *StoreHeartbeatResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *ScatterRegionRequest) Marshal() (dAtA []byte, err error) {
+func (m *GetRegionByIDRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5998,7 +5624,7 @@ func (m *ScatterRegionRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ScatterRegionRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetRegionByIDRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -6007,44 +5633,24 @@ func (m *ScatterRegionRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
-		n63, err := m.Header.MarshalTo(dAtA[i:])
+		n25, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n63
+		i += n25
 	}
 	if m.RegionId != 0 {
 		dAtA[i] = 0x10
 		i++
 		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.RegionId))
 	}
-	if m.Region != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Region.Size()))
-		n64, err := m.Region.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n64
-	}
-	if m.Leader != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Leader.Size()))
-		n65, err := m.Leader.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n65
-	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *ScatterRegionResponse) Marshal() (dAtA []byte, err error) {
+func (m *ScanRegionsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6054,7 +5660,7 @@ func (m *ScatterRegionResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ScatterRegionResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ScanRegionsRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -6063,11 +5669,28 @@ func (m *ScatterRegionResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
-		n66, err := m.Header.MarshalTo(dAtA[i:])
+		n26, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n66
+		i += n26
+	}
+	if len(m.StartKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(len(m.StartKey)))
+		i += copy(dAtA[i:], m.StartKey)
+	}
+	if m.Limit != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Limit))
+	}
+	if len(m.EndKey) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(len(m.EndKey)))
+		i += copy(dAtA[i:], m.EndKey)
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -6075,7 +5698,7 @@ func (m *ScatterRegionResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *GetGCSafePointRequest) Marshal() (dAtA []byte, err error) {
+func (m *ScanRegionsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6085,7 +5708,7 @@ func (m *GetGCSafePointRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetGCSafePointRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ScanRegionsResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -6094,11 +5717,35 @@ func (m *GetGCSafePointRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
-		n67, err := m.Header.MarshalTo(dAtA[i:])
+		n27, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n67
+		i += n27
+	}
+	if len(m.Regions) > 0 {
+		for _, msg := range m.Regions {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintSchedulerpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Leaders) > 0 {
+		for _, msg := range m.Leaders {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintSchedulerpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -6106,7 +5753,7 @@ func (m *GetGCSafePointRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *GetGCSafePointResponse) Marshal() (dAtA []byte, err error) {
+func (m *GetClusterConfigRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6116,7 +5763,7 @@ func (m *GetGCSafePointResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetGCSafePointResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetClusterConfigRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -6125,16 +5772,11 @@ func (m *GetGCSafePointResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
-		n68, err := m.Header.MarshalTo(dAtA[i:])
+		n28, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n68
-	}
-	if m.SafePoint != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.SafePoint))
+		i += n28
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -6142,7 +5784,7 @@ func (m *GetGCSafePointResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *UpdateGCSafePointRequest) Marshal() (dAtA []byte, err error) {
+func (m *GetClusterConfigResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6152,7 +5794,7 @@ func (m *UpdateGCSafePointRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UpdateGCSafePointRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetClusterConfigResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -6161,16 +5803,21 @@ func (m *UpdateGCSafePointRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
-		n69, err := m.Header.MarshalTo(dAtA[i:])
+		n29, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n69
+		i += n29
 	}
-	if m.SafePoint != 0 {
-		dAtA[i] = 0x10
+	if m.Cluster != nil {
+		dAtA[i] = 0x12
 		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.SafePoint))
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Cluster.Size()))
+		n30, err := m.Cluster.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n30
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -6178,7 +5825,7 @@ func (m *UpdateGCSafePointRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *UpdateGCSafePointResponse) Marshal() (dAtA []byte, err error) {
+func (m *PutClusterConfigRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6188,7 +5835,7 @@ func (m *UpdateGCSafePointResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UpdateGCSafePointResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *PutClusterConfigRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -6197,16 +5844,21 @@ func (m *UpdateGCSafePointResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
-		n70, err := m.Header.MarshalTo(dAtA[i:])
+		n31, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n70
+		i += n31
 	}
-	if m.NewSafePoint != 0 {
-		dAtA[i] = 0x10
+	if m.Cluster != nil {
+		dAtA[i] = 0x12
 		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.NewSafePoint))
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Cluster.Size()))
+		n32, err := m.Cluster.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n32
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -6214,7 +5866,7 @@ func (m *UpdateGCSafePointResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *GetOperatorRequest) Marshal() (dAtA []byte, err error) {
+func (m *PutClusterConfigResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6224,7 +5876,7 @@ func (m *GetOperatorRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetOperatorRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *PutClusterConfigResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -6233,16 +5885,11 @@ func (m *GetOperatorRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
-		n71, err := m.Header.MarshalTo(dAtA[i:])
+		n33, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n71
-	}
-	if m.RegionId != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.RegionId))
+		i += n33
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -6250,7 +5897,7 @@ func (m *GetOperatorRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *GetOperatorResponse) Marshal() (dAtA []byte, err error) {
+func (m *Member) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6260,42 +5907,56 @@ func (m *GetOperatorResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *Member) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Header != nil {
+	if len(m.Name) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
-		n72, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n72
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
-	if m.RegionId != 0 {
+	if m.MemberId != 0 {
 		dAtA[i] = 0x10
 		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.RegionId))
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.MemberId))
 	}
-	if len(m.Desc) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(len(m.Desc)))
-		i += copy(dAtA[i:], m.Desc)
+	if len(m.PeerUrls) > 0 {
+		for _, s := range m.PeerUrls {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
 	}
-	if m.Status != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Status))
+	if len(m.ClientUrls) > 0 {
+		for _, s := range m.ClientUrls {
+			dAtA[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
 	}
-	if len(m.Kind) > 0 {
-		dAtA[i] = 0x2a
+	if m.LeaderPriority != 0 {
+		dAtA[i] = 0x28
 		i++
-		i = encodeVarintSchedulerpb(dAtA, i, uint64(len(m.Kind)))
-		i += copy(dAtA[i:], m.Kind)
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.LeaderPriority))
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -6303,1007 +5964,4056 @@ func (m *GetOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func encodeVarintSchedulerpb(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
-}
-func (m *RequestHeader) Size() (n int) {
-	var l int
-	_ = l
-	if m.ClusterId != 0 {
-		n += 1 + sovSchedulerpb(uint64(m.ClusterId))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *GetMembersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ResponseHeader) Size() (n int) {
+func (m *GetMembersRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.ClusterId != 0 {
-		n += 1 + sovSchedulerpb(uint64(m.ClusterId))
-	}
-	if m.Error != nil {
-		l = m.Error.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
+	if m.Header != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
+		n34, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n34
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *Error) Size() (n int) {
-	var l int
-	_ = l
-	if m.Type != 0 {
-		n += 1 + sovSchedulerpb(uint64(m.Type))
-	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovSchedulerpb(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *GetMembersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *TsoRequest) Size() (n int) {
+func (m *GetMembersResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
+		n35, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n35
 	}
-	if m.Count != 0 {
-		n += 1 + sovSchedulerpb(uint64(m.Count))
+	if len(m.Members) > 0 {
+		for _, msg := range m.Members {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintSchedulerpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Leader != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Leader.Size()))
+		n36, err := m.Leader.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n36
+	}
+	if m.EtcdLeader != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.EtcdLeader.Size()))
+		n37, err := m.EtcdLeader.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n37
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *Timestamp) Size() (n int) {
-	var l int
-	_ = l
-	if m.Physical != 0 {
-		n += 1 + sovSchedulerpb(uint64(m.Physical))
-	}
-	if m.Logical != 0 {
-		n += 1 + sovSchedulerpb(uint64(m.Logical))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *RegionHeartbeatRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *TsoResponse) Size() (n int) {
+func (m *RegionHeartbeatRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
+		n38, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n38
 	}
-	if m.Count != 0 {
-		n += 1 + sovSchedulerpb(uint64(m.Count))
+	if m.Region != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Region.Size()))
+		n39, err := m.Region.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n39
 	}
-	if m.Timestamp != nil {
-		l = m.Timestamp.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
+	if m.Leader != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Leader.Size()))
+		n40, err := m.Leader.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n40
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.PendingPeers) > 0 {
+		for _, msg := range m.PendingPeers {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintSchedulerpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	return n
-}
-
-func (m *BootstrapRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
+	if m.BytesWritten != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.BytesWritten))
 	}
-	if m.Store != nil {
-		l = m.Store.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
+	if m.BytesRead != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.BytesRead))
+	}
+	if m.KeysWritten != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.KeysWritten))
+	}
+	if m.KeysRead != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.KeysRead))
+	}
+	if m.ApproximateSize != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.ApproximateSize))
+	}
+	if m.Interval != nil {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Interval.Size()))
+		n41, err := m.Interval.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n41
+	}
+	if m.ResolvedTs != 0 {
+		dAtA[i] = 0x68
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.ResolvedTs))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *BootstrapResponse) Size() (n int) {
-	var l int
-	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *ChangePeer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *IsBootstrappedRequest) Size() (n int) {
+func (m *ChangePeer) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
+	if m.Peer != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Peer.Size()))
+		n42, err := m.Peer.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n42
+	}
+	if m.ChangeType != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.ChangeType))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *IsBootstrappedResponse) Size() (n int) {
-	var l int
-	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
-	}
-	if m.Bootstrapped {
-		n += 2
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *TransferLeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *AllocIDRequest) Size() (n int) {
+func (m *TransferLeader) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
+	if m.Peer != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Peer.Size()))
+		n43, err := m.Peer.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n43
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *AllocIDResponse) Size() (n int) {
-	var l int
-	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
-	}
-	if m.Id != 0 {
-		n += 1 + sovSchedulerpb(uint64(m.Id))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *Merge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetStoreRequest) Size() (n int) {
+func (m *Merge) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
-	}
-	if m.StoreId != 0 {
-		n += 1 + sovSchedulerpb(uint64(m.StoreId))
+	if m.Target != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Target.Size()))
+		n44, err := m.Target.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n44
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *GetStoreResponse) Size() (n int) {
+func (m *RegionHeartbeatResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegionHeartbeatResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
+		n45, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n45
 	}
-	if m.Store != nil {
-		l = m.Store.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
+	if m.ChangePeer != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.ChangePeer.Size()))
+		n46, err := m.ChangePeer.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n46
 	}
-	if m.Stats != nil {
-		l = m.Stats.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
+	if m.TransferLeader != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.TransferLeader.Size()))
+		n47, err := m.TransferLeader.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n47
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.RegionId != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.RegionId))
 	}
-	return n
-}
-
-func (m *PutStoreRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
+	if m.RegionEpoch != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.RegionEpoch.Size()))
+		n48, err := m.RegionEpoch.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n48
 	}
-	if m.Store != nil {
-		l = m.Store.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
+	if m.TargetPeer != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.TargetPeer.Size()))
+		n49, err := m.TargetPeer.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n49
+	}
+	if m.Merge != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Merge.Size()))
+		n50, err := m.Merge.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n50
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *PutStoreResponse) Size() (n int) {
-	var l int
-	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *AskSplitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetAllStoresRequest) Size() (n int) {
+func (m *AskSplitRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
+		n51, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n51
 	}
-	if m.ExcludeTombstoneStores {
-		n += 2
+	if m.Region != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Region.Size()))
+		n52, err := m.Region.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n52
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *GetAllStoresResponse) Size() (n int) {
-	var l int
-	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
+func (m *AskSplitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Stores) > 0 {
-		for _, e := range m.Stores {
-			l = e.Size()
-			n += 1 + l + sovSchedulerpb(uint64(l))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetRegionRequest) Size() (n int) {
+func (m *AskSplitResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
+		n53, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n53
 	}
-	l = len(m.RegionKey)
-	if l > 0 {
-		n += 1 + l + sovSchedulerpb(uint64(l))
+	if m.NewRegionId != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.NewRegionId))
+	}
+	if len(m.NewPeerIds) > 0 {
+		dAtA55 := make([]byte, len(m.NewPeerIds)*10)
+		var j54 int
+		for _, num := range m.NewPeerIds {
+			for num >= 1<<7 {
+				dAtA55[j54] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j54++
+			}
+			dAtA55[j54] = uint8(num)
+			j54++
+		}
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(j54))
+		i += copy(dAtA[i:], dAtA55[:j54])
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *GetRegionResponse) Size() (n int) {
+func (m *ReportSplitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReportSplitRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
-	}
-	if m.Region != nil {
-		l = m.Region.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
+		n56, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n56
 	}
-	if m.Leader != nil {
-		l = m.Leader.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
+	if m.Left != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Left.Size()))
+		n57, err := m.Left.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n57
 	}
-	if len(m.Slaves) > 0 {
-		for _, e := range m.Slaves {
-			l = e.Size()
-			n += 1 + l + sovSchedulerpb(uint64(l))
+	if m.Right != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Right.Size()))
+		n58, err := m.Right.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n58
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *GetRegionByIDRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
-	}
-	if m.RegionId != 0 {
-		n += 1 + sovSchedulerpb(uint64(m.RegionId))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *ReportSplitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ScanRegionsRequest) Size() (n int) {
+func (m *ReportSplitResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
-	}
-	l = len(m.StartKey)
-	if l > 0 {
-		n += 1 + l + sovSchedulerpb(uint64(l))
-	}
-	if m.Limit != 0 {
-		n += 1 + sovSchedulerpb(uint64(m.Limit))
-	}
-	l = len(m.EndKey)
-	if l > 0 {
-		n += 1 + l + sovSchedulerpb(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
+		n59, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n59
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ScanRegionsResponse) Size() (n int) {
+func (m *SplitID) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SplitID) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
-	}
-	if len(m.Regions) > 0 {
-		for _, e := range m.Regions {
-			l = e.Size()
-			n += 1 + l + sovSchedulerpb(uint64(l))
-		}
+	if m.NewRegionId != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.NewRegionId))
 	}
-	if len(m.Leaders) > 0 {
-		for _, e := range m.Leaders {
-			l = e.Size()
-			n += 1 + l + sovSchedulerpb(uint64(l))
+	if len(m.NewPeerIds) > 0 {
+		dAtA61 := make([]byte, len(m.NewPeerIds)*10)
+		var j60 int
+		for _, num := range m.NewPeerIds {
+			for num >= 1<<7 {
+				dAtA61[j60] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j60++
+			}
+			dAtA61[j60] = uint8(num)
+			j60++
 		}
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(j60))
+		i += copy(dAtA[i:], dAtA61[:j60])
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *GetClusterConfigRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *TimeInterval) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetClusterConfigResponse) Size() (n int) {
+func (m *TimeInterval) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
+	if m.StartTimestamp != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.StartTimestamp))
 	}
-	if m.Cluster != nil {
-		l = m.Cluster.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
+	if m.EndTimestamp != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.EndTimestamp))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *PutClusterConfigRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
-	}
-	if m.Cluster != nil {
-		l = m.Cluster.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *RecordPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *PutClusterConfigResponse) Size() (n int) {
+func (m *RecordPair) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
 	}
-	return n
+	if m.Value != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Value))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
 }
 
-func (m *Member) Size() (n int) {
+func (m *StoreStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StoreStats) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovSchedulerpb(uint64(l))
-	}
-	if m.MemberId != 0 {
-		n += 1 + sovSchedulerpb(uint64(m.MemberId))
+	if m.StoreId != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.StoreId))
 	}
-	if len(m.PeerUrls) > 0 {
-		for _, s := range m.PeerUrls {
-			l = len(s)
-			n += 1 + l + sovSchedulerpb(uint64(l))
-		}
+	if m.Capacity != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Capacity))
 	}
-	if len(m.ClientUrls) > 0 {
-		for _, s := range m.ClientUrls {
-			l = len(s)
-			n += 1 + l + sovSchedulerpb(uint64(l))
-		}
+	if m.Available != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Available))
 	}
-	if m.LeaderPriority != 0 {
-		n += 1 + sovSchedulerpb(uint64(m.LeaderPriority))
+	if m.RegionCount != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.RegionCount))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.SendingSnapCount != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.SendingSnapCount))
 	}
-	return n
-}
-
-func (m *GetMembersRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
+	if m.ReceivingSnapCount != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.ReceivingSnapCount))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.StartTime != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.StartTime))
 	}
-	return n
-}
-
-func (m *GetMembersResponse) Size() (n int) {
-	var l int
-	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
+	if m.ApplyingSnapCount != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.ApplyingSnapCount))
 	}
-	if len(m.Members) > 0 {
-		for _, e := range m.Members {
-			l = e.Size()
-			n += 1 + l + sovSchedulerpb(uint64(l))
+	if m.IsBusy {
+		dAtA[i] = 0x48
+		i++
+		if m.IsBusy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i++
 	}
-	if m.Leader != nil {
-		l = m.Leader.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
-	}
-	if m.EtcdLeader != nil {
-		l = m.EtcdLeader.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
+	if m.UsedSize != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.UsedSize))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.BytesWritten != 0 {
+		dAtA[i] = 0x58
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.BytesWritten))
 	}
-	return n
-}
-
-func (m *RegionHeartbeatRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
+	if m.KeysWritten != 0 {
+		dAtA[i] = 0x60
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.KeysWritten))
 	}
-	if m.Region != nil {
-		l = m.Region.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
+	if m.BytesRead != 0 {
+		dAtA[i] = 0x68
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.BytesRead))
 	}
-	if m.Leader != nil {
-		l = m.Leader.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
+	if m.KeysRead != 0 {
+		dAtA[i] = 0x70
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.KeysRead))
 	}
-	if len(m.PendingPeers) > 0 {
-		for _, e := range m.PendingPeers {
-			l = e.Size()
-			n += 1 + l + sovSchedulerpb(uint64(l))
+	if m.Interval != nil {
+		dAtA[i] = 0x7a
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Interval.Size()))
+		n62, err := m.Interval.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n62
 	}
-	if m.ApproximateSize != 0 {
-		n += 1 + sovSchedulerpb(uint64(m.ApproximateSize))
+	if len(m.CpuUsages) > 0 {
+		for _, msg := range m.CpuUsages {
+			dAtA[i] = 0x82
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintSchedulerpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.ReadIoRates) > 0 {
+		for _, msg := range m.ReadIoRates {
+			dAtA[i] = 0x8a
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintSchedulerpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	return n
-}
-
-func (m *ChangePeer) Size() (n int) {
-	var l int
-	_ = l
-	if m.Peer != nil {
-		l = m.Peer.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
+	if len(m.WriteIoRates) > 0 {
+		for _, msg := range m.WriteIoRates {
+			dAtA[i] = 0x92
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintSchedulerpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	if m.ChangeType != 0 {
-		n += 1 + sovSchedulerpb(uint64(m.ChangeType))
+	if len(m.OpLatencies) > 0 {
+		for _, msg := range m.OpLatencies {
+			dAtA[i] = 0x9a
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintSchedulerpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *TransferLeader) Size() (n int) {
-	var l int
-	_ = l
-	if m.Peer != nil {
-		l = m.Peer.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *StoreHeartbeatRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RegionHeartbeatResponse) Size() (n int) {
+func (m *StoreHeartbeatRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
-	}
-	if m.ChangePeer != nil {
-		l = m.ChangePeer.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
-	}
-	if m.TransferLeader != nil {
-		l = m.TransferLeader.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
-	}
-	if m.RegionId != 0 {
-		n += 1 + sovSchedulerpb(uint64(m.RegionId))
-	}
-	if m.RegionEpoch != nil {
-		l = m.RegionEpoch.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
+		n63, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n63
 	}
-	if m.TargetPeer != nil {
-		l = m.TargetPeer.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
+	if m.Stats != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Stats.Size()))
+		n64, err := m.Stats.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n64
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *AskSplitRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
-	}
-	if m.Region != nil {
-		l = m.Region.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *StoreHeartbeatResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *AskSplitResponse) Size() (n int) {
+func (m *StoreHeartbeatResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
-	}
-	if m.NewRegionId != 0 {
-		n += 1 + sovSchedulerpb(uint64(m.NewRegionId))
-	}
-	if len(m.NewPeerIds) > 0 {
-		l = 0
-		for _, e := range m.NewPeerIds {
-			l += sovSchedulerpb(uint64(e))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
+		n65, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
-		n += 1 + sovSchedulerpb(uint64(l)) + l
+		i += n65
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ReportSplitRequest) Size() (n int) {
+func (m *ScatterRegionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScatterRegionRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
+		n66, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n66
 	}
-	if m.Left != nil {
-		l = m.Left.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
+	if m.RegionId != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.RegionId))
 	}
-	if m.Right != nil {
-		l = m.Right.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
+	if m.Region != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Region.Size()))
+		n67, err := m.Region.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n67
+	}
+	if m.Leader != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Leader.Size()))
+		n68, err := m.Leader.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n68
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ReportSplitResponse) Size() (n int) {
+func (m *ScatterRegionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScatterRegionResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
+		n69, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n69
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *SplitID) Size() (n int) {
+func (m *GetGCSafePointRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetGCSafePointRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.NewRegionId != 0 {
-		n += 1 + sovSchedulerpb(uint64(m.NewRegionId))
-	}
-	if len(m.NewPeerIds) > 0 {
-		l = 0
-		for _, e := range m.NewPeerIds {
-			l += sovSchedulerpb(uint64(e))
+	if m.Header != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
+		n70, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
-		n += 1 + sovSchedulerpb(uint64(l)) + l
+		i += n70
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *TimeInterval) Size() (n int) {
-	var l int
-	_ = l
-	if m.StartTimestamp != 0 {
-		n += 1 + sovSchedulerpb(uint64(m.StartTimestamp))
-	}
-	if m.EndTimestamp != 0 {
-		n += 1 + sovSchedulerpb(uint64(m.EndTimestamp))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *GetGCSafePointResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RecordPair) Size() (n int) {
+func (m *GetGCSafePointResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovSchedulerpb(uint64(l))
+	if m.Header != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
+		n71, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n71
 	}
-	if m.Value != 0 {
-		n += 1 + sovSchedulerpb(uint64(m.Value))
+	if m.SafePoint != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.SafePoint))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *StoreStats) Size() (n int) {
+func (m *UpdateGCSafePointRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateGCSafePointRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.StoreId != 0 {
-		n += 1 + sovSchedulerpb(uint64(m.StoreId))
-	}
-	if m.Capacity != 0 {
-		n += 1 + sovSchedulerpb(uint64(m.Capacity))
-	}
-	if m.Available != 0 {
-		n += 1 + sovSchedulerpb(uint64(m.Available))
-	}
-	if m.RegionCount != 0 {
-		n += 1 + sovSchedulerpb(uint64(m.RegionCount))
-	}
-	if m.SendingSnapCount != 0 {
-		n += 1 + sovSchedulerpb(uint64(m.SendingSnapCount))
-	}
-	if m.ReceivingSnapCount != 0 {
-		n += 1 + sovSchedulerpb(uint64(m.ReceivingSnapCount))
-	}
-	if m.StartTime != 0 {
-		n += 1 + sovSchedulerpb(uint64(m.StartTime))
-	}
-	if m.ApplyingSnapCount != 0 {
-		n += 1 + sovSchedulerpb(uint64(m.ApplyingSnapCount))
-	}
-	if m.IsBusy {
-		n += 2
-	}
-	if m.UsedSize != 0 {
-		n += 1 + sovSchedulerpb(uint64(m.UsedSize))
-	}
-	if m.Interval != nil {
-		l = m.Interval.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
-	}
-	if len(m.CpuUsages) > 0 {
-		for _, e := range m.CpuUsages {
-			l = e.Size()
-			n += 2 + l + sovSchedulerpb(uint64(l))
-		}
-	}
-	if len(m.ReadIoRates) > 0 {
-		for _, e := range m.ReadIoRates {
-			l = e.Size()
-			n += 2 + l + sovSchedulerpb(uint64(l))
-		}
-	}
-	if len(m.WriteIoRates) > 0 {
-		for _, e := range m.WriteIoRates {
-			l = e.Size()
-			n += 2 + l + sovSchedulerpb(uint64(l))
+	if m.Header != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
+		n72, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n72
 	}
-	if len(m.OpLatencies) > 0 {
-		for _, e := range m.OpLatencies {
-			l = e.Size()
-			n += 2 + l + sovSchedulerpb(uint64(l))
-		}
+	if m.SafePoint != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.SafePoint))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *StoreHeartbeatRequest) Size() (n int) {
+func (m *UpdateGCSafePointResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateGCSafePointResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
+		n73, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n73
 	}
-	if m.Stats != nil {
-		l = m.Stats.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
+	if m.NewSafePoint != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.NewSafePoint))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *StoreHeartbeatResponse) Size() (n int) {
-	var l int
-	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *GetOperatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ScatterRegionRequest) Size() (n int) {
+func (m *GetOperatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
+		n74, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n74
 	}
 	if m.RegionId != 0 {
-		n += 1 + sovSchedulerpb(uint64(m.RegionId))
-	}
-	if m.Region != nil {
-		l = m.Region.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
-	}
-	if m.Leader != nil {
-		l = m.Leader.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.RegionId))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ScatterRegionResponse) Size() (n int) {
-	var l int
-	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *GetOperatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetGCSafePointRequest) Size() (n int) {
+func (m *GetOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
+		n75, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n75
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.RegionId != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.RegionId))
 	}
-	return n
-}
-
-func (m *GetGCSafePointResponse) Size() (n int) {
-	var l int
-	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
+	if len(m.Desc) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(len(m.Desc)))
+		i += copy(dAtA[i:], m.Desc)
 	}
-	if m.SafePoint != 0 {
-		n += 1 + sovSchedulerpb(uint64(m.SafePoint))
+	if m.Status != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Status))
+	}
+	if len(m.Kind) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(len(m.Kind)))
+		i += copy(dAtA[i:], m.Kind)
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *UpdateGCSafePointRequest) Size() (n int) {
+func (m *LabelConstraint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LabelConstraint) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
 	}
-	if m.SafePoint != 0 {
-		n += 1 + sovSchedulerpb(uint64(m.SafePoint))
+	if m.Op != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Op))
+	}
+	if len(m.Values) > 0 {
+		for _, s := range m.Values {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *UpdateGCSafePointResponse) Size() (n int) {
-	var l int
+func (m *PlacementRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PlacementRule) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.GroupId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(len(m.GroupId)))
+		i += copy(dAtA[i:], m.GroupId)
+	}
+	if len(m.Id) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if m.Index != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Index))
+	}
+	if len(m.StartKey) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(len(m.StartKey)))
+		i += copy(dAtA[i:], m.StartKey)
+	}
+	if len(m.EndKey) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(len(m.EndKey)))
+		i += copy(dAtA[i:], m.EndKey)
+	}
+	if m.Count != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Count))
+	}
+	if len(m.LabelConstraints) > 0 {
+		for _, msg := range m.LabelConstraints {
+			dAtA[i] = 0x3a
+			i++
+			i = encodeVarintSchedulerpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.LocationLabels) > 0 {
+		for _, s := range m.LocationLabels {
+			dAtA[i] = 0x42
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *GetPlacementRulesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPlacementRulesRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
 	_ = l
 	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
+		n76, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n76
 	}
-	if m.NewSafePoint != 0 {
-		n += 1 + sovSchedulerpb(uint64(m.NewSafePoint))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *GetPlacementRulesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPlacementRulesResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Header != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
+		n77, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n77
+	}
+	if len(m.Rules) > 0 {
+		for _, msg := range m.Rules {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintSchedulerpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *SetPlacementRuleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetPlacementRuleRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Header != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
+		n78, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n78
+	}
+	if m.Rule != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Rule.Size()))
+		n79, err := m.Rule.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n79
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *SetPlacementRuleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetPlacementRuleResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Header != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
+		n80, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n80
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *DeletePlacementRuleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeletePlacementRuleRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Header != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
+		n81, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n81
+	}
+	if len(m.GroupId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(len(m.GroupId)))
+		i += copy(dAtA[i:], m.GroupId)
+	}
+	if len(m.Id) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *DeletePlacementRuleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeletePlacementRuleResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Header != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintSchedulerpb(dAtA, i, uint64(m.Header.Size()))
+		n82, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n82
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func encodeVarintSchedulerpb(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *RequestHeader) Size() (n int) {
+	var l int
+	_ = l
+	if m.ClusterId != 0 {
+		n += 1 + sovSchedulerpb(uint64(m.ClusterId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResponseHeader) Size() (n int) {
+	var l int
+	_ = l
+	if m.ClusterId != 0 {
+		n += 1 + sovSchedulerpb(uint64(m.ClusterId))
+	}
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Error) Size() (n int) {
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovSchedulerpb(uint64(m.Type))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TsoRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovSchedulerpb(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Timestamp) Size() (n int) {
+	var l int
+	_ = l
+	if m.Physical != 0 {
+		n += 1 + sovSchedulerpb(uint64(m.Physical))
+	}
+	if m.Logical != 0 {
+		n += 1 + sovSchedulerpb(uint64(m.Logical))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TsoResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovSchedulerpb(uint64(m.Count))
+	}
+	if m.Timestamp != nil {
+		l = m.Timestamp.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BootstrapRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.Store != nil {
+		l = m.Store.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BootstrapResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *IsBootstrappedRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *IsBootstrappedResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.Bootstrapped {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AllocIDRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AllocIDResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovSchedulerpb(uint64(m.Id))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetStoreRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.StoreId != 0 {
+		n += 1 + sovSchedulerpb(uint64(m.StoreId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetStoreResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.Store != nil {
+		l = m.Store.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.Stats != nil {
+		l = m.Stats.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PutStoreRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.Store != nil {
+		l = m.Store.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PutStoreResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetAllStoresRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.ExcludeTombstoneStores {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetAllStoresResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if len(m.Stores) > 0 {
+		for _, e := range m.Stores {
+			l = e.Size()
+			n += 1 + l + sovSchedulerpb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetRegionRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	l = len(m.RegionKey)
+	if l > 0 {
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetRegionResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.Region != nil {
+		l = m.Region.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.Leader != nil {
+		l = m.Leader.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if len(m.Slaves) > 0 {
+		for _, e := range m.Slaves {
+			l = e.Size()
+			n += 1 + l + sovSchedulerpb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetRegionByIDRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.RegionId != 0 {
+		n += 1 + sovSchedulerpb(uint64(m.RegionId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ScanRegionsRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	l = len(m.StartKey)
+	if l > 0 {
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovSchedulerpb(uint64(m.Limit))
+	}
+	l = len(m.EndKey)
+	if l > 0 {
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ScanRegionsResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if len(m.Regions) > 0 {
+		for _, e := range m.Regions {
+			l = e.Size()
+			n += 1 + l + sovSchedulerpb(uint64(l))
+		}
+	}
+	if len(m.Leaders) > 0 {
+		for _, e := range m.Leaders {
+			l = e.Size()
+			n += 1 + l + sovSchedulerpb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetClusterConfigRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetClusterConfigResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.Cluster != nil {
+		l = m.Cluster.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PutClusterConfigRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.Cluster != nil {
+		l = m.Cluster.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PutClusterConfigResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Member) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.MemberId != 0 {
+		n += 1 + sovSchedulerpb(uint64(m.MemberId))
+	}
+	if len(m.PeerUrls) > 0 {
+		for _, s := range m.PeerUrls {
+			l = len(s)
+			n += 1 + l + sovSchedulerpb(uint64(l))
+		}
+	}
+	if len(m.ClientUrls) > 0 {
+		for _, s := range m.ClientUrls {
+			l = len(s)
+			n += 1 + l + sovSchedulerpb(uint64(l))
+		}
+	}
+	if m.LeaderPriority != 0 {
+		n += 1 + sovSchedulerpb(uint64(m.LeaderPriority))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetMembersRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetMembersResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovSchedulerpb(uint64(l))
+		}
+	}
+	if m.Leader != nil {
+		l = m.Leader.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.EtcdLeader != nil {
+		l = m.EtcdLeader.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RegionHeartbeatRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.Region != nil {
+		l = m.Region.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.Leader != nil {
+		l = m.Leader.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if len(m.PendingPeers) > 0 {
+		for _, e := range m.PendingPeers {
+			l = e.Size()
+			n += 1 + l + sovSchedulerpb(uint64(l))
+		}
+	}
+	if m.BytesWritten != 0 {
+		n += 1 + sovSchedulerpb(uint64(m.BytesWritten))
+	}
+	if m.BytesRead != 0 {
+		n += 1 + sovSchedulerpb(uint64(m.BytesRead))
+	}
+	if m.KeysWritten != 0 {
+		n += 1 + sovSchedulerpb(uint64(m.KeysWritten))
+	}
+	if m.KeysRead != 0 {
+		n += 1 + sovSchedulerpb(uint64(m.KeysRead))
+	}
+	if m.ApproximateSize != 0 {
+		n += 1 + sovSchedulerpb(uint64(m.ApproximateSize))
+	}
+	if m.Interval != nil {
+		l = m.Interval.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.ResolvedTs != 0 {
+		n += 1 + sovSchedulerpb(uint64(m.ResolvedTs))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChangePeer) Size() (n int) {
+	var l int
+	_ = l
+	if m.Peer != nil {
+		l = m.Peer.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.ChangeType != 0 {
+		n += 1 + sovSchedulerpb(uint64(m.ChangeType))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TransferLeader) Size() (n int) {
+	var l int
+	_ = l
+	if m.Peer != nil {
+		l = m.Peer.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Merge) Size() (n int) {
+	var l int
+	_ = l
+	if m.Target != nil {
+		l = m.Target.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RegionHeartbeatResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.ChangePeer != nil {
+		l = m.ChangePeer.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.TransferLeader != nil {
+		l = m.TransferLeader.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.RegionId != 0 {
+		n += 1 + sovSchedulerpb(uint64(m.RegionId))
+	}
+	if m.RegionEpoch != nil {
+		l = m.RegionEpoch.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.TargetPeer != nil {
+		l = m.TargetPeer.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.Merge != nil {
+		l = m.Merge.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AskSplitRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.Region != nil {
+		l = m.Region.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AskSplitResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.NewRegionId != 0 {
+		n += 1 + sovSchedulerpb(uint64(m.NewRegionId))
+	}
+	if len(m.NewPeerIds) > 0 {
+		l = 0
+		for _, e := range m.NewPeerIds {
+			l += sovSchedulerpb(uint64(e))
+		}
+		n += 1 + sovSchedulerpb(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReportSplitRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.Left != nil {
+		l = m.Left.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.Right != nil {
+		l = m.Right.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReportSplitResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SplitID) Size() (n int) {
+	var l int
+	_ = l
+	if m.NewRegionId != 0 {
+		n += 1 + sovSchedulerpb(uint64(m.NewRegionId))
+	}
+	if len(m.NewPeerIds) > 0 {
+		l = 0
+		for _, e := range m.NewPeerIds {
+			l += sovSchedulerpb(uint64(e))
+		}
+		n += 1 + sovSchedulerpb(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TimeInterval) Size() (n int) {
+	var l int
+	_ = l
+	if m.StartTimestamp != 0 {
+		n += 1 + sovSchedulerpb(uint64(m.StartTimestamp))
+	}
+	if m.EndTimestamp != 0 {
+		n += 1 + sovSchedulerpb(uint64(m.EndTimestamp))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RecordPair) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.Value != 0 {
+		n += 1 + sovSchedulerpb(uint64(m.Value))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StoreStats) Size() (n int) {
+	var l int
+	_ = l
+	if m.StoreId != 0 {
+		n += 1 + sovSchedulerpb(uint64(m.StoreId))
+	}
+	if m.Capacity != 0 {
+		n += 1 + sovSchedulerpb(uint64(m.Capacity))
+	}
+	if m.Available != 0 {
+		n += 1 + sovSchedulerpb(uint64(m.Available))
+	}
+	if m.RegionCount != 0 {
+		n += 1 + sovSchedulerpb(uint64(m.RegionCount))
+	}
+	if m.SendingSnapCount != 0 {
+		n += 1 + sovSchedulerpb(uint64(m.SendingSnapCount))
+	}
+	if m.ReceivingSnapCount != 0 {
+		n += 1 + sovSchedulerpb(uint64(m.ReceivingSnapCount))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovSchedulerpb(uint64(m.StartTime))
+	}
+	if m.ApplyingSnapCount != 0 {
+		n += 1 + sovSchedulerpb(uint64(m.ApplyingSnapCount))
+	}
+	if m.IsBusy {
+		n += 2
+	}
+	if m.UsedSize != 0 {
+		n += 1 + sovSchedulerpb(uint64(m.UsedSize))
+	}
+	if m.BytesWritten != 0 {
+		n += 1 + sovSchedulerpb(uint64(m.BytesWritten))
+	}
+	if m.KeysWritten != 0 {
+		n += 1 + sovSchedulerpb(uint64(m.KeysWritten))
+	}
+	if m.BytesRead != 0 {
+		n += 1 + sovSchedulerpb(uint64(m.BytesRead))
+	}
+	if m.KeysRead != 0 {
+		n += 1 + sovSchedulerpb(uint64(m.KeysRead))
+	}
+	if m.Interval != nil {
+		l = m.Interval.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if len(m.CpuUsages) > 0 {
+		for _, e := range m.CpuUsages {
+			l = e.Size()
+			n += 2 + l + sovSchedulerpb(uint64(l))
+		}
+	}
+	if len(m.ReadIoRates) > 0 {
+		for _, e := range m.ReadIoRates {
+			l = e.Size()
+			n += 2 + l + sovSchedulerpb(uint64(l))
+		}
+	}
+	if len(m.WriteIoRates) > 0 {
+		for _, e := range m.WriteIoRates {
+			l = e.Size()
+			n += 2 + l + sovSchedulerpb(uint64(l))
+		}
+	}
+	if len(m.OpLatencies) > 0 {
+		for _, e := range m.OpLatencies {
+			l = e.Size()
+			n += 2 + l + sovSchedulerpb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StoreHeartbeatRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.Stats != nil {
+		l = m.Stats.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StoreHeartbeatResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ScatterRegionRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.RegionId != 0 {
+		n += 1 + sovSchedulerpb(uint64(m.RegionId))
+	}
+	if m.Region != nil {
+		l = m.Region.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.Leader != nil {
+		l = m.Leader.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ScatterRegionResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetGCSafePointRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetGCSafePointResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.SafePoint != 0 {
+		n += 1 + sovSchedulerpb(uint64(m.SafePoint))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateGCSafePointRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.SafePoint != 0 {
+		n += 1 + sovSchedulerpb(uint64(m.SafePoint))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateGCSafePointResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.NewSafePoint != 0 {
+		n += 1 + sovSchedulerpb(uint64(m.NewSafePoint))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetOperatorRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.RegionId != 0 {
+		n += 1 + sovSchedulerpb(uint64(m.RegionId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetOperatorResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.RegionId != 0 {
+		n += 1 + sovSchedulerpb(uint64(m.RegionId))
+	}
+	l = len(m.Desc)
+	if l > 0 {
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovSchedulerpb(uint64(m.Status))
+	}
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LabelConstraint) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.Op != 0 {
+		n += 1 + sovSchedulerpb(uint64(m.Op))
+	}
+	if len(m.Values) > 0 {
+		for _, s := range m.Values {
+			l = len(s)
+			n += 1 + l + sovSchedulerpb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PlacementRule) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.GroupId)
+	if l > 0 {
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovSchedulerpb(uint64(m.Index))
+	}
+	l = len(m.StartKey)
+	if l > 0 {
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	l = len(m.EndKey)
+	if l > 0 {
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovSchedulerpb(uint64(m.Count))
+	}
+	if len(m.LabelConstraints) > 0 {
+		for _, e := range m.LabelConstraints {
+			l = e.Size()
+			n += 1 + l + sovSchedulerpb(uint64(l))
+		}
+	}
+	if len(m.LocationLabels) > 0 {
+		for _, s := range m.LocationLabels {
+			l = len(s)
+			n += 1 + l + sovSchedulerpb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetPlacementRulesRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetPlacementRulesResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if len(m.Rules) > 0 {
+		for _, e := range m.Rules {
+			l = e.Size()
+			n += 1 + l + sovSchedulerpb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetPlacementRuleRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.Rule != nil {
+		l = m.Rule.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetPlacementRuleResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeletePlacementRuleRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	l = len(m.GroupId)
+	if l > 0 {
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeletePlacementRuleResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovSchedulerpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovSchedulerpb(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozSchedulerpb(x uint64) (n int) {
+	return sovSchedulerpb(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RequestHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedulerpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+			}
+			m.ClusterId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClusterId |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedulerpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSchedulerpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResponseHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedulerpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResponseHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResponseHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+			}
+			m.ClusterId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClusterId |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedulerpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSchedulerpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Error) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedulerpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Error: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Error: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= (ErrorType(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedulerpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedulerpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSchedulerpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TsoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedulerpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TsoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TsoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &RequestHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedulerpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSchedulerpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Timestamp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedulerpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Timestamp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Timestamp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Physical", wireType)
+			}
+			m.Physical = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Physical |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logical", wireType)
+			}
+			m.Logical = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Logical |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedulerpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSchedulerpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TsoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedulerpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TsoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TsoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timestamp == nil {
+				m.Timestamp = &Timestamp{}
+			}
+			if err := m.Timestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedulerpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSchedulerpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BootstrapRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedulerpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BootstrapRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BootstrapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &RequestHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Store", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Store == nil {
+				m.Store = &metapb.Store{}
+			}
+			if err := m.Store.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedulerpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSchedulerpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BootstrapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedulerpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BootstrapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BootstrapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedulerpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSchedulerpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IsBootstrappedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedulerpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IsBootstrappedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IsBootstrappedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &RequestHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedulerpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSchedulerpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IsBootstrappedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedulerpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IsBootstrappedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IsBootstrappedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bootstrapped", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Bootstrapped = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedulerpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSchedulerpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllocIDRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedulerpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllocIDRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllocIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &RequestHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedulerpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSchedulerpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllocIDResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedulerpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllocIDResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllocIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedulerpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSchedulerpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *GetOperatorRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
-	}
-	if m.RegionId != 0 {
-		n += 1 + sovSchedulerpb(uint64(m.RegionId))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *GetOperatorResponse) Size() (n int) {
-	var l int
-	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovSchedulerpb(uint64(l))
-	}
-	if m.RegionId != 0 {
-		n += 1 + sovSchedulerpb(uint64(m.RegionId))
-	}
-	l = len(m.Desc)
-	if l > 0 {
-		n += 1 + l + sovSchedulerpb(uint64(l))
-	}
-	if m.Status != 0 {
-		n += 1 + sovSchedulerpb(uint64(m.Status))
-	}
-	l = len(m.Kind)
-	if l > 0 {
-		n += 1 + l + sovSchedulerpb(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *GetStoreRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedulerpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetStoreRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetStoreRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &RequestHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreId", wireType)
+			}
+			m.StoreId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StoreId |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedulerpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSchedulerpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovSchedulerpb(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-func sozSchedulerpb(x uint64) (n int) {
-	return sovSchedulerpb(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *RequestHeader) Unmarshal(dAtA []byte) error {
+func (m *GetStoreResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7326,17 +10036,17 @@ func (m *RequestHeader) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RequestHeader: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetStoreResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RequestHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetStoreResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
-			m.ClusterId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerpb
@@ -7346,11 +10056,91 @@ func (m *RequestHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ClusterId |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Store", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Store == nil {
+				m.Store = &metapb.Store{}
+			}
+			if err := m.Store.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stats == nil {
+				m.Stats = &StoreStats{}
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSchedulerpb(dAtA[iNdEx:])
@@ -7373,7 +10163,7 @@ func (m *RequestHeader) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResponseHeader) Unmarshal(dAtA []byte) error {
+func (m *PutStoreRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7396,17 +10186,17 @@ func (m *ResponseHeader) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResponseHeader: wiretype end group for non-group")
+			return fmt.Errorf("proto: PutStoreRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResponseHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PutStoreRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
-			m.ClusterId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerpb
@@ -7416,14 +10206,28 @@ func (m *ResponseHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ClusterId |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &RequestHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Store", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7447,10 +10251,10 @@ func (m *ResponseHeader) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Error == nil {
-				m.Error = &Error{}
+			if m.Store == nil {
+				m.Store = &metapb.Store{}
 			}
-			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Store.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7476,7 +10280,7 @@ func (m *ResponseHeader) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Error) Unmarshal(dAtA []byte) error {
+func (m *PutStoreResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7499,36 +10303,17 @@ func (m *Error) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Error: wiretype end group for non-group")
+			return fmt.Errorf("proto: PutStoreResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Error: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PutStoreResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSchedulerpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= (ErrorType(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerpb
@@ -7538,20 +10323,24 @@ func (m *Error) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSchedulerpb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7575,7 +10364,7 @@ func (m *Error) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TsoRequest) Unmarshal(dAtA []byte) error {
+func (m *GetAllStoresRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7598,10 +10387,10 @@ func (m *TsoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TsoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetAllStoresRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TsoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetAllStoresRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7639,9 +10428,9 @@ func (m *TsoRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeTombstoneStores", wireType)
 			}
-			m.Count = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerpb
@@ -7651,11 +10440,12 @@ func (m *TsoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= (uint32(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.ExcludeTombstoneStores = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSchedulerpb(dAtA[iNdEx:])
@@ -7678,7 +10468,7 @@ func (m *TsoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Timestamp) Unmarshal(dAtA []byte) error {
+func (m *GetAllStoresResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7701,17 +10491,17 @@ func (m *Timestamp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Timestamp: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetAllStoresResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Timestamp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetAllStoresResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Physical", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
-			m.Physical = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerpb
@@ -7721,16 +10511,30 @@ func (m *Timestamp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Physical |= (int64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Logical", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stores", wireType)
 			}
-			m.Logical = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerpb
@@ -7740,11 +10544,23 @@ func (m *Timestamp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Logical |= (int64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stores = append(m.Stores, &metapb.Store{})
+			if err := m.Stores[len(m.Stores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSchedulerpb(dAtA[iNdEx:])
@@ -7767,7 +10583,7 @@ func (m *Timestamp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TsoResponse) Unmarshal(dAtA []byte) error {
+func (m *GetRegionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7790,10 +10606,10 @@ func (m *TsoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TsoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetRegionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TsoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetRegionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7823,36 +10639,17 @@ func (m *TsoResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Header == nil {
-				m.Header = &ResponseHeader{}
+				m.Header = &RequestHeader{}
 			}
 			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
-			}
-			m.Count = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSchedulerpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Count |= (uint32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RegionKey", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerpb
@@ -7862,23 +10659,21 @@ func (m *TsoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthSchedulerpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Timestamp == nil {
-				m.Timestamp = &Timestamp{}
-			}
-			if err := m.Timestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.RegionKey = append(m.RegionKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.RegionKey == nil {
+				m.RegionKey = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -7903,7 +10698,7 @@ func (m *TsoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BootstrapRequest) Unmarshal(dAtA []byte) error {
+func (m *GetRegionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7926,10 +10721,10 @@ func (m *BootstrapRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BootstrapRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetRegionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BootstrapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetRegionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7959,7 +10754,7 @@ func (m *BootstrapRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Header == nil {
-				m.Header = &RequestHeader{}
+				m.Header = &ResponseHeader{}
 			}
 			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -7967,7 +10762,7 @@ func (m *BootstrapRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Store", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7991,67 +10786,49 @@ func (m *BootstrapRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Store == nil {
-				m.Store = &metapb.Store{}
+			if m.Region == nil {
+				m.Region = &metapb.Region{}
 			}
-			if err := m.Store.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Region.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSchedulerpb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthSchedulerpb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BootstrapResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSchedulerpb
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Leader == nil {
+				m.Leader = &metapb.Peer{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BootstrapResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BootstrapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.Leader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Slaves", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8075,10 +10852,8 @@ func (m *BootstrapResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &ResponseHeader{}
-			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Slaves = append(m.Slaves, &metapb.Peer{})
+			if err := m.Slaves[len(m.Slaves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8104,7 +10879,7 @@ func (m *BootstrapResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IsBootstrappedRequest) Unmarshal(dAtA []byte) error {
+func (m *GetRegionByIDRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8127,10 +10902,10 @@ func (m *IsBootstrappedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IsBootstrappedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetRegionByIDRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IsBootstrappedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetRegionByIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8166,6 +10941,25 @@ func (m *IsBootstrappedRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegionId", wireType)
+			}
+			m.RegionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegionId |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSchedulerpb(dAtA[iNdEx:])
@@ -8188,7 +10982,7 @@ func (m *IsBootstrappedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IsBootstrappedResponse) Unmarshal(dAtA []byte) error {
+func (m *ScanRegionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8211,10 +11005,10 @@ func (m *IsBootstrappedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IsBootstrappedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScanRegionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IsBootstrappedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScanRegionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8244,17 +11038,17 @@ func (m *IsBootstrappedResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Header == nil {
-				m.Header = &ResponseHeader{}
+				m.Header = &RequestHeader{}
 			}
 			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bootstrapped", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartKey", wireType)
 			}
-			var v int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerpb
@@ -8264,68 +11058,47 @@ func (m *IsBootstrappedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Bootstrapped = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSchedulerpb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthSchedulerpb
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AllocIDRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSchedulerpb
+			m.StartKey = append(m.StartKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.StartKey == nil {
+				m.StartKey = []byte{}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AllocIDRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllocIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndKey", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerpb
@@ -8335,23 +11108,21 @@ func (m *AllocIDRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthSchedulerpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &RequestHeader{}
-			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.EndKey = append(m.EndKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.EndKey == nil {
+				m.EndKey = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -8376,7 +11147,7 @@ func (m *AllocIDRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AllocIDResponse) Unmarshal(dAtA []byte) error {
+func (m *ScanRegionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8399,10 +11170,10 @@ func (m *AllocIDResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllocIDResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScanRegionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllocIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScanRegionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8439,10 +11210,10 @@ func (m *AllocIDResponse) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Regions", wireType)
 			}
-			m.Id = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerpb
@@ -8452,11 +11223,54 @@ func (m *AllocIDResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Regions = append(m.Regions, &metapb.Region{})
+			if err := m.Regions[len(m.Regions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leaders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Leaders = append(m.Leaders, &metapb.Peer{})
+			if err := m.Leaders[len(m.Leaders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSchedulerpb(dAtA[iNdEx:])
@@ -8479,7 +11293,7 @@ func (m *AllocIDResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetStoreRequest) Unmarshal(dAtA []byte) error {
+func (m *GetClusterConfigRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8502,10 +11316,10 @@ func (m *GetStoreRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetStoreRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetClusterConfigRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetStoreRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetClusterConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8537,29 +11351,10 @@ func (m *GetStoreRequest) Unmarshal(dAtA []byte) error {
 			if m.Header == nil {
 				m.Header = &RequestHeader{}
 			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StoreId", wireType)
-			}
-			m.StoreId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSchedulerpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StoreId |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSchedulerpb(dAtA[iNdEx:])
@@ -8582,7 +11377,7 @@ func (m *GetStoreRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetStoreResponse) Unmarshal(dAtA []byte) error {
+func (m *GetClusterConfigResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8605,10 +11400,10 @@ func (m *GetStoreResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetStoreResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetClusterConfigResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetStoreResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetClusterConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8646,40 +11441,7 @@ func (m *GetStoreResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Store", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSchedulerpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthSchedulerpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Store == nil {
-				m.Store = &metapb.Store{}
-			}
-			if err := m.Store.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cluster", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8703,10 +11465,10 @@ func (m *GetStoreResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Stats == nil {
-				m.Stats = &StoreStats{}
+			if m.Cluster == nil {
+				m.Cluster = &metapb.Cluster{}
 			}
-			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Cluster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8732,7 +11494,7 @@ func (m *GetStoreResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PutStoreRequest) Unmarshal(dAtA []byte) error {
+func (m *PutClusterConfigRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8755,10 +11517,10 @@ func (m *PutStoreRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PutStoreRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PutClusterConfigRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PutStoreRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PutClusterConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8796,7 +11558,7 @@ func (m *PutStoreRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Store", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cluster", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8820,10 +11582,10 @@ func (m *PutStoreRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Store == nil {
-				m.Store = &metapb.Store{}
+			if m.Cluster == nil {
+				m.Cluster = &metapb.Cluster{}
 			}
-			if err := m.Store.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Cluster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8849,7 +11611,7 @@ func (m *PutStoreRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PutStoreResponse) Unmarshal(dAtA []byte) error {
+func (m *PutClusterConfigResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8872,10 +11634,10 @@ func (m *PutStoreResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PutStoreResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PutClusterConfigResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PutStoreResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PutClusterConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8933,7 +11695,7 @@ func (m *PutStoreResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetAllStoresRequest) Unmarshal(dAtA []byte) error {
+func (m *Member) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8956,17 +11718,17 @@ func (m *GetAllStoresRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetAllStoresRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Member: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetAllStoresRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Member: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerpb
@@ -8976,30 +11738,103 @@ func (m *GetAllStoresRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSchedulerpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &RequestHeader{}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberId", wireType)
 			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.MemberId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemberId |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedulerpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.PeerUrls = append(m.PeerUrls, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedulerpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientUrls = append(m.ClientUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeTombstoneStores", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaderPriority", wireType)
 			}
-			var v int
+			m.LeaderPriority = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerpb
@@ -9009,12 +11844,95 @@ func (m *GetAllStoresRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.LeaderPriority |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedulerpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSchedulerpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetMembersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedulerpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetMembersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetMembersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ExcludeTombstoneStores = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &RequestHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSchedulerpb(dAtA[iNdEx:])
@@ -9037,7 +11955,7 @@ func (m *GetAllStoresRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetAllStoresResponse) Unmarshal(dAtA []byte) error {
+func (m *GetMembersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9060,10 +11978,10 @@ func (m *GetAllStoresResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetAllStoresResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetMembersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetAllStoresResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9101,7 +12019,7 @@ func (m *GetAllStoresResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stores", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9125,8 +12043,74 @@ func (m *GetAllStoresResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Stores = append(m.Stores, &metapb.Store{})
-			if err := m.Stores[len(m.Stores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Members = append(m.Members, &Member{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Leader == nil {
+				m.Leader = &Member{}
+			}
+			if err := m.Leader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EtcdLeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EtcdLeader == nil {
+				m.EtcdLeader = &Member{}
+			}
+			if err := m.EtcdLeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9152,7 +12136,7 @@ func (m *GetAllStoresResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetRegionRequest) Unmarshal(dAtA []byte) error {
+func (m *RegionHeartbeatRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9175,10 +12159,10 @@ func (m *GetRegionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetRegionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RegionHeartbeatRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRegionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RegionHeartbeatRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9216,9 +12200,9 @@ func (m *GetRegionRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegionKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerpb
@@ -9228,79 +12212,151 @@ func (m *GetRegionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSchedulerpb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RegionKey = append(m.RegionKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.RegionKey == nil {
-				m.RegionKey = []byte{}
+			if m.Region == nil {
+				m.Region = &metapb.Region{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSchedulerpb(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Region.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthSchedulerpb
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetRegionResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSchedulerpb
+			if m.Leader == nil {
+				m.Leader = &metapb.Peer{}
 			}
-			if iNdEx >= l {
+			if err := m.Leader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingPeers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			m.PendingPeers = append(m.PendingPeers, &metapb.Peer{})
+			if err := m.PendingPeers[len(m.PendingPeers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetRegionResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRegionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesWritten", wireType)
+			}
+			m.BytesWritten = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BytesWritten |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesRead", wireType)
+			}
+			m.BytesRead = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BytesRead |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeysWritten", wireType)
+			}
+			m.KeysWritten = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeysWritten |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeysRead", wireType)
 			}
-			var msglen int
+			m.KeysRead = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerpb
@@ -9310,30 +12366,16 @@ func (m *GetRegionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.KeysRead |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthSchedulerpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Header == nil {
-				m.Header = &ResponseHeader{}
-			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApproximateSize", wireType)
 			}
-			var msglen int
+			m.ApproximateSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerpb
@@ -9343,28 +12385,14 @@ func (m *GetRegionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.ApproximateSize |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthSchedulerpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Region == nil {
-				m.Region = &metapb.Region{}
-			}
-			if err := m.Region.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9388,18 +12416,18 @@ func (m *GetRegionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Leader == nil {
-				m.Leader = &metapb.Peer{}
+			if m.Interval == nil {
+				m.Interval = &TimeInterval{}
 			}
-			if err := m.Leader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Interval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slaves", wireType)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolvedTs", wireType)
 			}
-			var msglen int
+			m.ResolvedTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerpb
@@ -9409,23 +12437,11 @@ func (m *GetRegionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.ResolvedTs |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthSchedulerpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Slaves = append(m.Slaves, &metapb.Peer{})
-			if err := m.Slaves[len(m.Slaves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSchedulerpb(dAtA[iNdEx:])
@@ -9448,7 +12464,7 @@ func (m *GetRegionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetRegionByIDRequest) Unmarshal(dAtA []byte) error {
+func (m *ChangePeer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9471,15 +12487,15 @@ func (m *GetRegionByIDRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetRegionByIDRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChangePeer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRegionByIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChangePeer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9503,18 +12519,18 @@ func (m *GetRegionByIDRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &RequestHeader{}
+			if m.Peer == nil {
+				m.Peer = &metapb.Peer{}
 			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Peer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeType", wireType)
 			}
-			m.RegionId = 0
+			m.ChangeType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerpb
@@ -9524,7 +12540,7 @@ func (m *GetRegionByIDRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RegionId |= (uint64(b) & 0x7F) << shift
+				m.ChangeType |= (eraftpb.ConfChangeType(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -9551,7 +12567,7 @@ func (m *GetRegionByIDRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScanRegionsRequest) Unmarshal(dAtA []byte) error {
+func (m *TransferLeader) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9574,15 +12590,15 @@ func (m *ScanRegionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScanRegionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TransferLeader: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScanRegionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TransferLeader: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9606,68 +12622,69 @@ func (m *ScanRegionsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &RequestHeader{}
+			if m.Peer == nil {
+				m.Peer = &metapb.Peer{}
 			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Peer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartKey", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSchedulerpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedulerpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if byteLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthSchedulerpb
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StartKey = append(m.StartKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.StartKey == nil {
-				m.StartKey = []byte{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Merge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedulerpb
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Limit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSchedulerpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Limit |= (int32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Merge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Merge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerpb
@@ -9677,21 +12694,23 @@ func (m *ScanRegionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSchedulerpb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EndKey = append(m.EndKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.EndKey == nil {
-				m.EndKey = []byte{}
+			if m.Target == nil {
+				m.Target = &metapb.Region{}
+			}
+			if err := m.Target.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -9716,7 +12735,7 @@ func (m *ScanRegionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScanRegionsResponse) Unmarshal(dAtA []byte) error {
+func (m *RegionHeartbeatResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9739,10 +12758,10 @@ func (m *ScanRegionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScanRegionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RegionHeartbeatResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScanRegionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RegionHeartbeatResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9780,7 +12799,7 @@ func (m *ScanRegionsResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Regions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangePeer", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9804,14 +12823,16 @@ func (m *ScanRegionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Regions = append(m.Regions, &metapb.Region{})
-			if err := m.Regions[len(m.Regions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ChangePeer == nil {
+				m.ChangePeer = &ChangePeer{}
+			}
+			if err := m.ChangePeer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Leaders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferLeader", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9835,65 +12856,35 @@ func (m *ScanRegionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Leaders = append(m.Leaders, &metapb.Peer{})
-			if err := m.Leaders[len(m.Leaders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.TransferLeader == nil {
+				m.TransferLeader = &TransferLeader{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSchedulerpb(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.TransferLeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthSchedulerpb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetClusterConfigRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSchedulerpb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegionId", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			m.RegionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegionId |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetClusterConfigRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetClusterConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RegionEpoch", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9917,67 +12908,16 @@ func (m *GetClusterConfigRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &RequestHeader{}
+			if m.RegionEpoch == nil {
+				m.RegionEpoch = &metapb.RegionEpoch{}
 			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RegionEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSchedulerpb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthSchedulerpb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetClusterConfigResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSchedulerpb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetClusterConfigResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetClusterConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetPeer", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10001,16 +12941,16 @@ func (m *GetClusterConfigResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &ResponseHeader{}
+			if m.TargetPeer == nil {
+				m.TargetPeer = &metapb.Peer{}
 			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TargetPeer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cluster", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Merge", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10034,10 +12974,10 @@ func (m *GetClusterConfigResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Cluster == nil {
-				m.Cluster = &metapb.Cluster{}
+			if m.Merge == nil {
+				m.Merge = &Merge{}
 			}
-			if err := m.Cluster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Merge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10063,7 +13003,7 @@ func (m *GetClusterConfigResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PutClusterConfigRequest) Unmarshal(dAtA []byte) error {
+func (m *AskSplitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10086,10 +13026,10 @@ func (m *PutClusterConfigRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PutClusterConfigRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AskSplitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PutClusterConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AskSplitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10127,7 +13067,7 @@ func (m *PutClusterConfigRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cluster", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10151,10 +13091,10 @@ func (m *PutClusterConfigRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Cluster == nil {
-				m.Cluster = &metapb.Cluster{}
+			if m.Region == nil {
+				m.Region = &metapb.Region{}
 			}
-			if err := m.Cluster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Region.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10180,7 +13120,7 @@ func (m *PutClusterConfigRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PutClusterConfigResponse) Unmarshal(dAtA []byte) error {
+func (m *AskSplitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10203,10 +13143,10 @@ func (m *PutClusterConfigResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PutClusterConfigResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AskSplitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PutClusterConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AskSplitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10242,6 +13182,87 @@ func (m *PutClusterConfigResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewRegionId", wireType)
+			}
+			m.NewRegionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewRegionId |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSchedulerpb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.NewPeerIds = append(m.NewPeerIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSchedulerpb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthSchedulerpb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSchedulerpb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.NewPeerIds = append(m.NewPeerIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPeerIds", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSchedulerpb(dAtA[iNdEx:])
@@ -10264,7 +13285,7 @@ func (m *PutClusterConfigResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Member) Unmarshal(dAtA []byte) error {
+func (m *ReportSplitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10287,17 +13308,17 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Member: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReportSplitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Member: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReportSplitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerpb
@@ -10307,45 +13328,30 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSchedulerpb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemberId", wireType)
+			if m.Header == nil {
+				m.Header = &RequestHeader{}
 			}
-			m.MemberId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSchedulerpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MemberId |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 3:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeerUrls", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Left", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerpb
@@ -10355,26 +13361,30 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSchedulerpb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PeerUrls = append(m.PeerUrls, string(dAtA[iNdEx:postIndex]))
+			if m.Left == nil {
+				m.Left = &metapb.Region{}
+			}
+			if err := m.Left.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientUrls", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Right", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerpb
@@ -10384,40 +13394,25 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSchedulerpb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientUrls = append(m.ClientUrls, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LeaderPriority", wireType)
+			if m.Right == nil {
+				m.Right = &metapb.Region{}
 			}
-			m.LeaderPriority = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSchedulerpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LeaderPriority |= (int32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Right.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSchedulerpb(dAtA[iNdEx:])
@@ -10440,7 +13435,7 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetMembersRequest) Unmarshal(dAtA []byte) error {
+func (m *ReportSplitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10463,10 +13458,10 @@ func (m *GetMembersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetMembersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReportSplitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetMembersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReportSplitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10496,7 +13491,7 @@ func (m *GetMembersRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Header == nil {
-				m.Header = &RequestHeader{}
+				m.Header = &ResponseHeader{}
 			}
 			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -10524,7 +13519,7 @@ func (m *GetMembersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetMembersResponse) Unmarshal(dAtA []byte) error {
+func (m *SplitID) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10547,17 +13542,17 @@ func (m *GetMembersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetMembersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SplitID: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SplitID: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewRegionId", wireType)
 			}
-			var msglen int
+			m.NewRegionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerpb
@@ -10567,30 +13562,148 @@ func (m *GetMembersResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.NewRegionId |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSchedulerpb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.NewPeerIds = append(m.NewPeerIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSchedulerpb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthSchedulerpb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSchedulerpb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.NewPeerIds = append(m.NewPeerIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPeerIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedulerpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthSchedulerpb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &ResponseHeader{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TimeInterval) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedulerpb
 			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimeInterval: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimeInterval: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTimestamp", wireType)
+			}
+			m.StartTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTimestamp |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTimestamp", wireType)
 			}
-			var msglen int
+			m.EndTimestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerpb
@@ -10600,28 +13713,67 @@ func (m *GetMembersResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.EndTimestamp |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedulerpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthSchedulerpb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Members = append(m.Members, &Member{})
-			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordPair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedulerpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordPair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordPair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerpb
@@ -10631,30 +13783,26 @@ func (m *GetMembersResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSchedulerpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Leader == nil {
-				m.Leader = &Member{}
-			}
-			if err := m.Leader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EtcdLeader", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			m.Value = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerpb
@@ -10664,25 +13812,11 @@ func (m *GetMembersResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Value |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthSchedulerpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.EtcdLeader == nil {
-				m.EtcdLeader = &Member{}
-			}
-			if err := m.EtcdLeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSchedulerpb(dAtA[iNdEx:])
@@ -10705,7 +13839,7 @@ func (m *GetMembersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegionHeartbeatRequest) Unmarshal(dAtA []byte) error {
+func (m *StoreStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10728,17 +13862,17 @@ func (m *RegionHeartbeatRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegionHeartbeatRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: StoreStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegionHeartbeatRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StoreStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreId", wireType)
 			}
-			var msglen int
+			m.StoreId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerpb
@@ -10748,30 +13882,16 @@ func (m *RegionHeartbeatRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.StoreId |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthSchedulerpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Header == nil {
-				m.Header = &RequestHeader{}
-			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
 			}
-			var msglen int
+			m.Capacity = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerpb
@@ -10781,30 +13901,16 @@ func (m *RegionHeartbeatRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Capacity |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthSchedulerpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Region == nil {
-				m.Region = &metapb.Region{}
-			}
-			if err := m.Region.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Available", wireType)
 			}
-			var msglen int
+			m.Available = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerpb
@@ -10814,30 +13920,35 @@ func (m *RegionHeartbeatRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Available |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthSchedulerpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Leader == nil {
-				m.Leader = &metapb.Peer{}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegionCount", wireType)
 			}
-			if err := m.Leader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.RegionCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegionCount |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingPeers", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendingSnapCount", wireType)
 			}
-			var msglen int
+			m.SendingSnapCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerpb
@@ -10847,28 +13958,16 @@ func (m *RegionHeartbeatRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.SendingSnapCount |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthSchedulerpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PendingPeers = append(m.PendingPeers, &metapb.Peer{})
-			if err := m.PendingPeers[len(m.PendingPeers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 10:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApproximateSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivingSnapCount", wireType)
 			}
-			m.ApproximateSize = 0
+			m.ReceivingSnapCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerpb
@@ -10878,67 +13977,54 @@ func (m *RegionHeartbeatRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ApproximateSize |= (uint64(b) & 0x7F) << shift
+				m.ReceivingSnapCount |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSchedulerpb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthSchedulerpb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ChangePeer) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSchedulerpb
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplyingSnapCount", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ApplyingSnapCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApplyingSnapCount |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ChangePeer: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChangePeer: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsBusy", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerpb
@@ -10948,30 +14034,17 @@ func (m *ChangePeer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthSchedulerpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Peer == nil {
-				m.Peer = &metapb.Peer{}
-			}
-			if err := m.Peer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+			m.IsBusy = bool(v != 0)
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChangeType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedSize", wireType)
 			}
-			m.ChangeType = 0
+			m.UsedSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerpb
@@ -10981,67 +14054,16 @@ func (m *ChangePeer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChangeType |= (eraftpb.ConfChangeType(b) & 0x7F) << shift
+				m.UsedSize |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSchedulerpb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthSchedulerpb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TransferLeader) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSchedulerpb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TransferLeader: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransferLeader: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesWritten", wireType)
 			}
-			var msglen int
+			m.BytesWritten = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerpb
@@ -11051,79 +14073,71 @@ func (m *TransferLeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.BytesWritten |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthSchedulerpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Peer == nil {
-				m.Peer = &metapb.Peer{}
-			}
-			if err := m.Peer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSchedulerpb(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeysWritten", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthSchedulerpb
+			m.KeysWritten = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeysWritten |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesRead", wireType)
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RegionHeartbeatResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSchedulerpb
+			m.BytesRead = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BytesRead |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeysRead", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			m.KeysRead = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeysRead |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RegionHeartbeatResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegionHeartbeatResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11147,16 +14161,16 @@ func (m *RegionHeartbeatResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &ResponseHeader{}
+			if m.Interval == nil {
+				m.Interval = &TimeInterval{}
 			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Interval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChangePeer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CpuUsages", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11180,16 +14194,14 @@ func (m *RegionHeartbeatResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ChangePeer == nil {
-				m.ChangePeer = &ChangePeer{}
-			}
-			if err := m.ChangePeer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CpuUsages = append(m.CpuUsages, &RecordPair{})
+			if err := m.CpuUsages[len(m.CpuUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransferLeader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadIoRates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11213,35 +14225,14 @@ func (m *RegionHeartbeatResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TransferLeader == nil {
-				m.TransferLeader = &TransferLeader{}
-			}
-			if err := m.TransferLeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ReadIoRates = append(m.ReadIoRates, &RecordPair{})
+			if err := m.ReadIoRates[len(m.ReadIoRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegionId", wireType)
-			}
-			m.RegionId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSchedulerpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RegionId |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegionEpoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteIoRates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11265,16 +14256,14 @@ func (m *RegionHeartbeatResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RegionEpoch == nil {
-				m.RegionEpoch = &metapb.RegionEpoch{}
-			}
-			if err := m.RegionEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.WriteIoRates = append(m.WriteIoRates, &RecordPair{})
+			if err := m.WriteIoRates[len(m.WriteIoRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetPeer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OpLatencies", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11298,10 +14287,8 @@ func (m *RegionHeartbeatResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TargetPeer == nil {
-				m.TargetPeer = &metapb.Peer{}
-			}
-			if err := m.TargetPeer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.OpLatencies = append(m.OpLatencies, &RecordPair{})
+			if err := m.OpLatencies[len(m.OpLatencies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11327,7 +14314,7 @@ func (m *RegionHeartbeatResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AskSplitRequest) Unmarshal(dAtA []byte) error {
+func (m *StoreHeartbeatRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11350,10 +14337,10 @@ func (m *AskSplitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AskSplitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: StoreHeartbeatRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AskSplitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StoreHeartbeatRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11391,7 +14378,7 @@ func (m *AskSplitRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11415,10 +14402,10 @@ func (m *AskSplitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Region == nil {
-				m.Region = &metapb.Region{}
+			if m.Stats == nil {
+				m.Stats = &StoreStats{}
 			}
-			if err := m.Region.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11444,7 +14431,7 @@ func (m *AskSplitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AskSplitResponse) Unmarshal(dAtA []byte) error {
+func (m *StoreHeartbeatResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11467,10 +14454,10 @@ func (m *AskSplitResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AskSplitResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: StoreHeartbeatResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AskSplitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StoreHeartbeatResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11506,87 +14493,6 @@ func (m *AskSplitResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewRegionId", wireType)
-			}
-			m.NewRegionId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSchedulerpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NewRegionId |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowSchedulerpb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.NewPeerIds = append(m.NewPeerIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowSchedulerpb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthSchedulerpb
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowSchedulerpb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.NewPeerIds = append(m.NewPeerIds, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewPeerIds", wireType)
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSchedulerpb(dAtA[iNdEx:])
@@ -11609,7 +14515,7 @@ func (m *AskSplitResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReportSplitRequest) Unmarshal(dAtA []byte) error {
+func (m *ScatterRegionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11632,10 +14538,10 @@ func (m *ReportSplitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReportSplitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScatterRegionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReportSplitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScatterRegionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11672,10 +14578,10 @@ func (m *ReportSplitRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Left", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegionId", wireType)
 			}
-			var msglen int
+			m.RegionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerpb
@@ -11685,28 +14591,14 @@ func (m *ReportSplitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.RegionId |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthSchedulerpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Left == nil {
-				m.Left = &metapb.Region{}
-			}
-			if err := m.Left.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Right", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11730,67 +14622,16 @@ func (m *ReportSplitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Right == nil {
-				m.Right = &metapb.Region{}
+			if m.Region == nil {
+				m.Region = &metapb.Region{}
 			}
-			if err := m.Right.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Region.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSchedulerpb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthSchedulerpb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ReportSplitResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSchedulerpb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ReportSplitResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReportSplitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11814,10 +14655,10 @@ func (m *ReportSplitResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &ResponseHeader{}
+			if m.Leader == nil {
+				m.Leader = &metapb.Peer{}
 			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Leader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11843,7 +14684,7 @@ func (m *ReportSplitResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SplitID) Unmarshal(dAtA []byte) error {
+func (m *ScatterRegionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11866,93 +14707,45 @@ func (m *SplitID) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SplitID: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScatterRegionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SplitID: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScatterRegionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewRegionId", wireType)
-			}
-			m.NewRegionId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSchedulerpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NewRegionId |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
-		case 2:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowSchedulerpb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.NewPeerIds = append(m.NewPeerIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowSchedulerpb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthSchedulerpb
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerpb
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowSchedulerpb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.NewPeerIds = append(m.NewPeerIds, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewPeerIds", wireType)
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSchedulerpb(dAtA[iNdEx:])
@@ -11975,7 +14768,7 @@ func (m *SplitID) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TimeInterval) Unmarshal(dAtA []byte) error {
+func (m *GetGCSafePointRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11998,17 +14791,17 @@ func (m *TimeInterval) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TimeInterval: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetGCSafePointRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TimeInterval: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetGCSafePointRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTimestamp", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
-			m.StartTimestamp = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerpb
@@ -12018,30 +14811,25 @@ func (m *TimeInterval) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartTimestamp |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTimestamp", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerpb
 			}
-			m.EndTimestamp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSchedulerpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EndTimestamp |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &RequestHeader{}
 			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSchedulerpb(dAtA[iNdEx:])
@@ -12064,7 +14852,7 @@ func (m *TimeInterval) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordPair) Unmarshal(dAtA []byte) error {
+func (m *GetGCSafePointResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12087,17 +14875,17 @@ func (m *RecordPair) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordPair: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetGCSafePointResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordPair: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetGCSafePointResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerpb
@@ -12107,26 +14895,30 @@ func (m *RecordPair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSchedulerpb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SafePoint", wireType)
 			}
-			m.Value = 0
+			m.SafePoint = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerpb
@@ -12136,7 +14928,7 @@ func (m *RecordPair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Value |= (uint64(b) & 0x7F) << shift
+				m.SafePoint |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -12163,7 +14955,7 @@ func (m *RecordPair) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StoreStats) Unmarshal(dAtA []byte) error {
+func (m *UpdateGCSafePointRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12186,17 +14978,17 @@ func (m *StoreStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StoreStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateGCSafePointRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StoreStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateGCSafePointRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StoreId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
-			m.StoreId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerpb
@@ -12206,16 +14998,30 @@ func (m *StoreStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StoreId |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &RequestHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SafePoint", wireType)
 			}
-			m.Capacity = 0
+			m.SafePoint = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerpb
@@ -12225,73 +15031,67 @@ func (m *StoreStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Capacity |= (uint64(b) & 0x7F) << shift
+				m.SafePoint |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Available", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedulerpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Available = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSchedulerpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Available |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthSchedulerpb
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegionCount", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.RegionCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSchedulerpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RegionCount |= (uint32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateGCSafePointResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedulerpb
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SendingSnapCount", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.SendingSnapCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSchedulerpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SendingSnapCount |= (uint32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReceivingSnapCount", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateGCSafePointResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateGCSafePointResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
-			m.ReceivingSnapCount = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerpb
@@ -12301,35 +15101,30 @@ func (m *StoreStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReceivingSnapCount |= (uint32(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerpb
 			}
-			m.StartTime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSchedulerpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StartTime |= (uint32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 8:
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplyingSnapCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewSafePoint", wireType)
 			}
-			m.ApplyingSnapCount = 0
+			m.NewSafePoint = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerpb
@@ -12339,16 +15134,67 @@ func (m *StoreStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ApplyingSnapCount |= (uint32(b) & 0x7F) << shift
+				m.NewSafePoint |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsBusy", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedulerpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
+			if skippy < 0 {
+				return ErrInvalidLengthSchedulerpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetOperatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedulerpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetOperatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetOperatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerpb
@@ -12358,17 +15204,30 @@ func (m *StoreStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsBusy = bool(v != 0)
-		case 10:
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &RequestHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsedSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RegionId", wireType)
 			}
-			m.UsedSize = 0
+			m.RegionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerpb
@@ -12378,14 +15237,65 @@ func (m *StoreStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UsedSize |= (uint64(b) & 0x7F) << shift
+				m.RegionId |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 15:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedulerpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSchedulerpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetOperatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedulerpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetOperatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetOperatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12409,18 +15319,18 @@ func (m *StoreStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Interval == nil {
-				m.Interval = &TimeInterval{}
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
 			}
-			if err := m.Interval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 16:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CpuUsages", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegionId", wireType)
 			}
-			var msglen int
+			m.RegionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerpb
@@ -12430,28 +15340,16 @@ func (m *StoreStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.RegionId |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthSchedulerpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CpuUsages = append(m.CpuUsages, &RecordPair{})
-			if err := m.CpuUsages[len(m.CpuUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 17:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadIoRates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Desc", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerpb
@@ -12461,28 +15359,28 @@ func (m *StoreStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthSchedulerpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReadIoRates = append(m.ReadIoRates, &RecordPair{})
-			if err := m.ReadIoRates[len(m.ReadIoRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Desc = append(m.Desc[:0], dAtA[iNdEx:postIndex]...)
+			if m.Desc == nil {
+				m.Desc = []byte{}
 			}
 			iNdEx = postIndex
-		case 18:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WriteIoRates", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerpb
@@ -12492,28 +15390,16 @@ func (m *StoreStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Status |= (OperatorStatus(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthSchedulerpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.WriteIoRates = append(m.WriteIoRates, &RecordPair{})
-			if err := m.WriteIoRates[len(m.WriteIoRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 19:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OpLatencies", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerpb
@@ -12523,21 +15409,21 @@ func (m *StoreStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthSchedulerpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OpLatencies = append(m.OpLatencies, &RecordPair{})
-			if err := m.OpLatencies[len(m.OpLatencies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Kind = append(m.Kind[:0], dAtA[iNdEx:postIndex]...)
+			if m.Kind == nil {
+				m.Kind = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -12562,7 +15448,7 @@ func (m *StoreStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StoreHeartbeatRequest) Unmarshal(dAtA []byte) error {
+func (m *LabelConstraint) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12585,17 +15471,17 @@ func (m *StoreHeartbeatRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StoreHeartbeatRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: LabelConstraint: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StoreHeartbeatRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LabelConstraint: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerpb
@@ -12605,30 +15491,45 @@ func (m *StoreHeartbeatRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSchedulerpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &RequestHeader{}
-			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+			}
+			m.Op = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Op |= (LabelConstraintOp(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerpb
@@ -12638,24 +15539,20 @@ func (m *StoreHeartbeatRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSchedulerpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Stats == nil {
-				m.Stats = &StoreStats{}
-			}
-			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Values = append(m.Values, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12679,7 +15576,7 @@ func (m *StoreHeartbeatRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StoreHeartbeatResponse) Unmarshal(dAtA []byte) error {
+func (m *PlacementRule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12702,17 +15599,17 @@ func (m *StoreHeartbeatResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StoreHeartbeatResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PlacementRule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StoreHeartbeatResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PlacementRule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerpb
@@ -12722,81 +15619,26 @@ func (m *StoreHeartbeatResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSchedulerpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &ResponseHeader{}
-			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.GroupId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSchedulerpb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthSchedulerpb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ScatterRegionRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSchedulerpb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ScatterRegionRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScatterRegionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerpb
@@ -12806,30 +15648,26 @@ func (m *ScatterRegionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSchedulerpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &RequestHeader{}
-			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			m.RegionId = 0
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerpb
@@ -12839,16 +15677,16 @@ func (m *ScatterRegionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RegionId |= (uint64(b) & 0x7F) << shift
+				m.Index |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartKey", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerpb
@@ -12858,30 +15696,28 @@ func (m *ScatterRegionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthSchedulerpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Region == nil {
-				m.Region = &metapb.Region{}
-			}
-			if err := m.Region.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.StartKey = append(m.StartKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.StartKey == nil {
+				m.StartKey = []byte{}
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndKey", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerpb
@@ -12891,79 +15727,45 @@ func (m *ScatterRegionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthSchedulerpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Leader == nil {
-				m.Leader = &metapb.Peer{}
-			}
-			if err := m.Leader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.EndKey = append(m.EndKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.EndKey == nil {
+				m.EndKey = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSchedulerpb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthSchedulerpb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ScatterRegionResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSchedulerpb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ScatterRegionResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScatterRegionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelConstraints", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12987,13 +15789,40 @@ func (m *ScatterRegionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &ResponseHeader{}
-			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LabelConstraints = append(m.LabelConstraints, &LabelConstraint{})
+			if err := m.LabelConstraints[len(m.LabelConstraints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocationLabels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedulerpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LocationLabels = append(m.LocationLabels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSchedulerpb(dAtA[iNdEx:])
@@ -13016,7 +15845,7 @@ func (m *ScatterRegionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetGCSafePointRequest) Unmarshal(dAtA []byte) error {
+func (m *GetPlacementRulesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13039,10 +15868,10 @@ func (m *GetGCSafePointRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetGCSafePointRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetPlacementRulesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetGCSafePointRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetPlacementRulesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13100,7 +15929,7 @@ func (m *GetGCSafePointRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetGCSafePointResponse) Unmarshal(dAtA []byte) error {
+func (m *GetPlacementRulesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13123,10 +15952,10 @@ func (m *GetGCSafePointResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetGCSafePointResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetPlacementRulesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetGCSafePointResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetPlacementRulesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13163,10 +15992,10 @@ func (m *GetGCSafePointResponse) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SafePoint", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
 			}
-			m.SafePoint = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerpb
@@ -13176,11 +16005,23 @@ func (m *GetGCSafePointResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SafePoint |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rules = append(m.Rules, &PlacementRule{})
+			if err := m.Rules[len(m.Rules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSchedulerpb(dAtA[iNdEx:])
@@ -13203,7 +16044,7 @@ func (m *GetGCSafePointResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateGCSafePointRequest) Unmarshal(dAtA []byte) error {
+func (m *SetPlacementRuleRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13226,10 +16067,10 @@ func (m *UpdateGCSafePointRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateGCSafePointRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetPlacementRuleRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateGCSafePointRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetPlacementRuleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13266,10 +16107,10 @@ func (m *UpdateGCSafePointRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SafePoint", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rule", wireType)
 			}
-			m.SafePoint = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerpb
@@ -13279,11 +16120,25 @@ func (m *UpdateGCSafePointRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SafePoint |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Rule == nil {
+				m.Rule = &PlacementRule{}
+			}
+			if err := m.Rule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSchedulerpb(dAtA[iNdEx:])
@@ -13306,7 +16161,7 @@ func (m *UpdateGCSafePointRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateGCSafePointResponse) Unmarshal(dAtA []byte) error {
+func (m *SetPlacementRuleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13329,10 +16184,10 @@ func (m *UpdateGCSafePointResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateGCSafePointResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetPlacementRuleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateGCSafePointResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetPlacementRuleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13368,25 +16223,6 @@ func (m *UpdateGCSafePointResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewSafePoint", wireType)
-			}
-			m.NewSafePoint = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSchedulerpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NewSafePoint |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSchedulerpb(dAtA[iNdEx:])
@@ -13409,7 +16245,7 @@ func (m *UpdateGCSafePointResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetOperatorRequest) Unmarshal(dAtA []byte) error {
+func (m *DeletePlacementRuleRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13432,10 +16268,10 @@ func (m *GetOperatorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetOperatorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeletePlacementRuleRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetOperatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeletePlacementRuleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13472,10 +16308,10 @@ func (m *GetOperatorRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegionId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
-			m.RegionId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedulerpb
@@ -13485,11 +16321,50 @@ func (m *GetOperatorRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RegionId |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedulerpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedulerpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSchedulerpb(dAtA[iNdEx:])
@@ -13512,7 +16387,7 @@ func (m *GetOperatorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetOperatorResponse) Unmarshal(dAtA []byte) error {
+func (m *DeletePlacementRuleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13535,10 +16410,10 @@ func (m *GetOperatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetOperatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeletePlacementRuleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetOperatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeletePlacementRuleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13574,106 +16449,6 @@ func (m *GetOperatorResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegionId", wireType)
-			}
-			m.RegionId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSchedulerpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RegionId |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Desc", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSchedulerpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthSchedulerpb
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Desc = append(m.Desc[:0], dAtA[iNdEx:postIndex]...)
-			if m.Desc == nil {
-				m.Desc = []byte{}
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSchedulerpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= (OperatorStatus(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSchedulerpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthSchedulerpb
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Kind = append(m.Kind[:0], dAtA[iNdEx:postIndex]...)
-			if m.Kind == nil {
-				m.Kind = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSchedulerpb(dAtA[iNdEx:])
@@ -13801,155 +16576,183 @@ var (
 	ErrIntOverflowSchedulerpb   = fmt.Errorf("proto: integer overflow")
 )
 
-func init() { proto.RegisterFile("schedulerpb.proto", fileDescriptor_schedulerpb_4e333137f5959f12) }
-
-var fileDescriptor_schedulerpb_4e333137f5959f12 = []byte{
-	// 2343 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5a, 0xcf, 0x6f, 0x23, 0x49,
-	0xf5, 0x4f, 0x3b, 0x8e, 0x63, 0x3f, 0x3b, 0xb6, 0x53, 0xc9, 0x26, 0x5e, 0xef, 0x4c, 0x36, 0x53,
-	0x33, 0x3b, 0xdf, 0xd9, 0xf9, 0x32, 0x61, 0xc9, 0x0e, 0xab, 0x15, 0x08, 0xa4, 0xc4, 0xf1, 0x66,
-	0xcc, 0x24, 0xb6, 0xd5, 0x76, 0x06, 0x56, 0x20, 0x35, 0x1d, 0x77, 0xc5, 0x69, 0xa6, 0xdd, 0xdd,
-	0xdb, 0x55, 0xce, 0x8c, 0xe7, 0xca, 0x89, 0x03, 0x08, 0x21, 0x90, 0x90, 0xe0, 0xc0, 0x3f, 0xc1,
-	0x8d, 0x23, 0x07, 0x8e, 0x88, 0x2b, 0x17, 0x34, 0xfc, 0x13, 0x1c, 0x51, 0x55, 0x75, 0xb7, 0xbb,
-	0xdb, 0x76, 0x12, 0xd4, 0x03, 0x37, 0x57, 0xbd, 0x4f, 0xbd, 0xdf, 0x55, 0xf5, 0xea, 0xb5, 0x61,
-	0x9d, 0x0e, 0x2e, 0x89, 0x31, 0xb6, 0x88, 0xe7, 0x9e, 0xef, 0xb9, 0x9e, 0xc3, 0x1c, 0x54, 0x8c,
-	0x4c, 0xd5, 0x4b, 0x23, 0xc2, 0xf4, 0x80, 0x54, 0x5f, 0x23, 0x9e, 0x7e, 0xc1, 0xc2, 0xe1, 0xe6,
-	0xd0, 0x19, 0x3a, 0xe2, 0xe7, 0xd7, 0xf9, 0x2f, 0x39, 0x8b, 0xf7, 0x60, 0x4d, 0x25, 0x5f, 0x8d,
-	0x09, 0x65, 0xcf, 0x88, 0x6e, 0x10, 0x0f, 0xdd, 0x05, 0x18, 0x58, 0x63, 0xca, 0x88, 0xa7, 0x99,
-	0x46, 0x4d, 0xd9, 0x55, 0x1e, 0x65, 0xd5, 0x82, 0x3f, 0xd3, 0x32, 0xf0, 0x97, 0x50, 0x56, 0x09,
-	0x75, 0x1d, 0x9b, 0x92, 0x5b, 0x2d, 0x40, 0x8f, 0x60, 0x85, 0x78, 0x9e, 0xe3, 0xd5, 0x32, 0xbb,
-	0xca, 0xa3, 0xe2, 0x3e, 0xda, 0x8b, 0xda, 0xd0, 0xe4, 0x14, 0x55, 0x02, 0xf0, 0x29, 0xac, 0x88,
-	0x31, 0x7a, 0x0c, 0x59, 0x36, 0x71, 0x89, 0xe0, 0x55, 0xde, 0xdf, 0x9a, 0x5d, 0xd1, 0x9f, 0xb8,
-	0x44, 0x15, 0x18, 0x54, 0x83, 0xd5, 0x11, 0xa1, 0x54, 0x1f, 0x12, 0x21, 0xa0, 0xa0, 0x06, 0x43,
-	0xfc, 0x02, 0xa0, 0x4f, 0x1d, 0xdf, 0x38, 0xb4, 0x0f, 0xb9, 0x4b, 0xa1, 0xaf, 0xe0, 0x5a, 0xdc,
-	0xaf, 0xc7, 0xb8, 0xc6, 0x5c, 0xa0, 0xfa, 0x48, 0xb4, 0x09, 0x2b, 0x03, 0x67, 0x6c, 0x33, 0xc1,
-	0x79, 0x4d, 0x95, 0x03, 0x7c, 0x00, 0x85, 0xbe, 0x39, 0x22, 0x94, 0xe9, 0x23, 0x17, 0xd5, 0x21,
-	0xef, 0x5e, 0x4e, 0xa8, 0x39, 0xd0, 0x2d, 0xc1, 0x78, 0x59, 0x0d, 0xc7, 0x5c, 0x35, 0xcb, 0x19,
-	0x0a, 0x52, 0x46, 0x90, 0x82, 0x21, 0xfe, 0x85, 0x02, 0x45, 0xa1, 0x9b, 0x74, 0x24, 0xfa, 0x34,
-	0xa1, 0xdc, 0x07, 0x09, 0xe5, 0xa2, 0xfe, 0xbe, 0x5e, 0x3b, 0xf4, 0x14, 0x0a, 0x2c, 0xd0, 0xae,
-	0xb6, 0x2c, 0xb8, 0xc5, 0x1d, 0x18, 0xea, 0xae, 0x4e, 0x81, 0xf8, 0x25, 0x54, 0x0f, 0x1d, 0x87,
-	0x51, 0xe6, 0xe9, 0x6e, 0x1a, 0x8f, 0xdd, 0x87, 0x15, 0xca, 0x1c, 0x8f, 0xf8, 0xc1, 0x5e, 0xdb,
-	0xf3, 0x13, 0xb2, 0xc7, 0x27, 0x55, 0x49, 0xc3, 0xcf, 0x60, 0x3d, 0x22, 0x2c, 0x85, 0x0b, 0xf0,
-	0x73, 0x78, 0xaf, 0x45, 0x43, 0x5e, 0x2e, 0x31, 0x52, 0xe8, 0x8e, 0xbf, 0x82, 0xad, 0x24, 0xb3,
-	0x34, 0xe1, 0xc1, 0x50, 0x3a, 0x8f, 0x30, 0x13, 0x1e, 0xc9, 0xab, 0xb1, 0x39, 0x7c, 0x04, 0xe5,
-	0x03, 0xcb, 0x72, 0x06, 0xad, 0xa3, 0x34, 0x8a, 0xbf, 0x80, 0x4a, 0xc8, 0x25, 0x8d, 0xc6, 0x65,
-	0xc8, 0x98, 0x52, 0xcf, 0xac, 0x9a, 0x31, 0x0d, 0xfc, 0x63, 0xa8, 0x1c, 0x13, 0x26, 0x43, 0x97,
-	0x22, 0x27, 0xde, 0x87, 0xbc, 0x88, 0xbb, 0x16, 0x32, 0x5f, 0x15, 0xe3, 0x96, 0x81, 0x7f, 0xa7,
-	0x40, 0x75, 0x2a, 0x22, 0x8d, 0xee, 0xb7, 0x49, 0x3c, 0xf4, 0x84, 0x83, 0x74, 0x46, 0xfd, 0x7d,
-	0xb1, 0x1d, 0x63, 0x2c, 0x90, 0x3d, 0x4e, 0x56, 0x25, 0x0a, 0xff, 0x04, 0x2a, 0xdd, 0x71, 0x7a,
-	0xfb, 0x6f, 0xb5, 0x27, 0x8e, 0xa1, 0x3a, 0x95, 0x95, 0x66, 0x4b, 0xfc, 0x54, 0x81, 0x8d, 0x63,
-	0xc2, 0x0e, 0x2c, 0x4b, 0x30, 0xa3, 0x69, 0x34, 0xff, 0x1c, 0x6a, 0xe4, 0xf5, 0xc0, 0x1a, 0x1b,
-	0x44, 0x63, 0xce, 0xe8, 0x9c, 0x32, 0xc7, 0x26, 0x9a, 0xd0, 0x97, 0xfa, 0xe9, 0xbc, 0xe5, 0xd3,
-	0xfb, 0x01, 0x59, 0x0a, 0xc5, 0x1e, 0x6c, 0xc6, 0x95, 0x48, 0x13, 0xdb, 0x8f, 0x20, 0x17, 0x0a,
-	0x5d, 0x9e, 0xf5, 0xa0, 0x4f, 0xc4, 0x44, 0xe4, 0x92, 0x4a, 0x86, 0xa6, 0x63, 0xa7, 0xb1, 0xfa,
-	0x2e, 0x80, 0x27, 0x98, 0x68, 0x2f, 0xc9, 0x44, 0xd8, 0x59, 0x52, 0x0b, 0x72, 0xe6, 0x39, 0x99,
-	0xe0, 0x3f, 0x29, 0xb0, 0x1e, 0x91, 0x93, 0xc6, 0xb0, 0x87, 0x90, 0x93, 0x7c, 0xfd, 0xd4, 0x28,
-	0x07, 0x86, 0xf9, 0xcc, 0x7d, 0x2a, 0x7a, 0x00, 0x39, 0x4b, 0x32, 0x97, 0x89, 0x5b, 0x0a, 0x70,
-	0x5d, 0xc2, 0xb9, 0x49, 0x1a, 0x47, 0x51, 0x4b, 0xbf, 0x22, 0xb4, 0x96, 0x15, 0x6e, 0x4a, 0xa0,
-	0x24, 0x0d, 0x0f, 0x45, 0x64, 0xa4, 0x80, 0xc3, 0x49, 0xaa, 0x83, 0x07, 0x7d, 0x00, 0xbe, 0x5f,
-	0xa6, 0x5b, 0x3b, 0x2f, 0x27, 0x5a, 0x06, 0xfe, 0xb5, 0x02, 0xa8, 0x37, 0xd0, 0x6d, 0x29, 0x8a,
-	0xa6, 0x94, 0x43, 0x99, 0xee, 0xb1, 0x48, 0x40, 0xf2, 0x62, 0xe2, 0x39, 0x99, 0xf0, 0x6b, 0xd0,
-	0x32, 0x47, 0x26, 0x13, 0xbe, 0x59, 0x51, 0xe5, 0x00, 0x6d, 0xc3, 0x2a, 0xb1, 0x0d, 0xb1, 0x20,
-	0x2b, 0x16, 0xe4, 0x88, 0x6d, 0xf0, 0xf0, 0xfd, 0x5e, 0x81, 0x8d, 0x98, 0x5a, 0x69, 0x02, 0xf8,
-	0x08, 0x56, 0xa5, 0xbd, 0x41, 0x6a, 0x26, 0x23, 0x18, 0x90, 0xd1, 0x43, 0x58, 0x95, 0x61, 0xe2,
-	0x87, 0xcf, 0x6c, 0x74, 0x02, 0x22, 0x3e, 0x85, 0xed, 0x63, 0xc2, 0x1a, 0xb2, 0x7a, 0x6a, 0x38,
-	0xf6, 0x85, 0x39, 0x4c, 0x73, 0x35, 0xbc, 0x81, 0xda, 0x2c, 0xbb, 0x34, 0x16, 0x7f, 0x0c, 0xab,
-	0x7e, 0x69, 0xe7, 0xe7, 0x6c, 0x25, 0xb0, 0xc3, 0x17, 0xa2, 0x06, 0x74, 0xfc, 0x1a, 0xb6, 0xbb,
-	0xe3, 0x77, 0x66, 0xca, 0x7f, 0x22, 0xb9, 0x03, 0xb5, 0x59, 0xc9, 0x69, 0x0e, 0xd5, 0x3f, 0x28,
-	0x90, 0x3b, 0x25, 0xa3, 0x73, 0xe2, 0x21, 0x04, 0x59, 0x5b, 0x1f, 0xc9, 0xda, 0xb4, 0xa0, 0x8a,
-	0xdf, 0x3c, 0x3f, 0x47, 0x82, 0x1a, 0xd9, 0x07, 0x72, 0xa2, 0x65, 0x70, 0xa2, 0x4b, 0x88, 0xa7,
-	0x8d, 0x3d, 0x4b, 0xc6, 0xbe, 0xa0, 0xe6, 0xf9, 0xc4, 0x99, 0x67, 0x51, 0xf4, 0x21, 0x14, 0x07,
-	0x96, 0x49, 0x6c, 0x26, 0xc9, 0x59, 0x41, 0x06, 0x39, 0x25, 0x00, 0xff, 0x07, 0x15, 0x99, 0x1a,
-	0x9a, 0xeb, 0x99, 0x8e, 0x67, 0xb2, 0x49, 0x6d, 0x45, 0xe4, 0x79, 0x59, 0x4e, 0x77, 0xfd, 0x59,
-	0x7c, 0x2c, 0x4e, 0x25, 0xa9, 0x64, 0x9a, 0xcd, 0x86, 0xff, 0xae, 0x00, 0x8a, 0x72, 0x4a, 0x93,
-	0x2d, 0x4f, 0x78, 0x71, 0x2e, 0xf8, 0xf8, 0xfb, 0x63, 0x23, 0xb6, 0x4a, 0xca, 0x50, 0x03, 0x0c,
-	0xfa, 0xff, 0xc4, 0x39, 0x37, 0x17, 0x1d, 0x1c, 0x77, 0x4f, 0xa1, 0x48, 0xd8, 0xc0, 0xd0, 0xfc,
-	0x15, 0xd9, 0xc5, 0x2b, 0x80, 0xe3, 0x4e, 0xa4, 0x75, 0xff, 0x52, 0x60, 0x4b, 0xee, 0xcd, 0x67,
-	0x44, 0xf7, 0xd8, 0x39, 0xd1, 0x59, 0x9a, 0xa4, 0x7c, 0xb7, 0x27, 0xf8, 0x37, 0x60, 0xcd, 0x25,
-	0xb6, 0x61, 0xda, 0x43, 0x8d, 0x67, 0x08, 0xad, 0xad, 0xcc, 0x39, 0x2a, 0x4a, 0x3e, 0x84, 0x0f,
-	0x28, 0xfa, 0x18, 0xaa, 0xba, 0xeb, 0x7a, 0xce, 0x6b, 0x73, 0xa4, 0x33, 0xa2, 0x51, 0xf3, 0x0d,
-	0xa9, 0x81, 0xc8, 0xc0, 0x4a, 0x64, 0xbe, 0x67, 0xbe, 0x21, 0xf8, 0x12, 0xa0, 0x71, 0xa9, 0xdb,
-	0x43, 0xc2, 0x57, 0xa2, 0x5d, 0xc8, 0x72, 0x19, 0xbe, 0xad, 0x71, 0x11, 0x82, 0x82, 0x3e, 0x87,
-	0xe2, 0x40, 0xe0, 0x35, 0xf1, 0x18, 0xcb, 0x88, 0xc7, 0xd8, 0xf6, 0x5e, 0xf0, 0xa8, 0xe4, 0xfb,
-	0x4a, 0xf2, 0x13, 0xaf, 0x31, 0x18, 0x84, 0xbf, 0xf1, 0x3e, 0x94, 0xfb, 0x9e, 0x6e, 0xd3, 0x0b,
-	0xe2, 0x49, 0xb7, 0xdf, 0x2c, 0x0d, 0xff, 0x2d, 0x03, 0xdb, 0x33, 0x81, 0x49, 0x93, 0x7b, 0x53,
-	0xf5, 0x85, 0xe4, 0xcc, 0x9c, 0x92, 0x6f, 0xea, 0x8e, 0x40, 0x7d, 0xe1, 0x9a, 0x23, 0xa8, 0x30,
-	0x5f, 0x7d, 0x2d, 0x16, 0xb5, 0xb8, 0xdc, 0xb8, 0x89, 0x6a, 0x99, 0xc5, 0x4d, 0x8e, 0x5d, 0x8e,
-	0xd9, 0xf8, 0xe5, 0x88, 0x3e, 0x83, 0x92, 0x4f, 0x24, 0xae, 0x33, 0xb8, 0x14, 0x7b, 0x9a, 0x67,
-	0x6f, 0x2c, 0x7b, 0x9a, 0x9c, 0xa4, 0x16, 0xbd, 0xe9, 0x00, 0x3d, 0x81, 0x22, 0xd3, 0xbd, 0x21,
-	0x61, 0xd2, 0xa8, 0xdc, 0x1c, 0x77, 0x82, 0x04, 0xf0, 0xdf, 0x78, 0x04, 0x95, 0x03, 0xfa, 0xb2,
-	0xe7, 0x5a, 0xe6, 0xff, 0x22, 0xcb, 0xf1, 0xcf, 0x15, 0xa8, 0x4e, 0xe5, 0xa5, 0x7b, 0x3c, 0xad,
-	0xd9, 0xe4, 0x95, 0x96, 0xac, 0x2e, 0x8a, 0x36, 0x79, 0xa5, 0x06, 0x3e, 0xdc, 0x85, 0x12, 0xc7,
-	0x88, 0xc3, 0xd5, 0x34, 0xe4, 0xd9, 0x9a, 0x55, 0xc1, 0x26, 0xaf, 0xb8, 0xed, 0x2d, 0x83, 0xe2,
-	0x5f, 0x29, 0x80, 0x54, 0xe2, 0x3a, 0x1e, 0x4b, 0xed, 0x02, 0x0c, 0x59, 0x8b, 0x5c, 0xb0, 0x05,
-	0x0e, 0x10, 0x34, 0xf4, 0x00, 0x56, 0x3c, 0x73, 0x78, 0xc9, 0xfc, 0x6c, 0x49, 0x82, 0x24, 0x11,
-	0x7f, 0x0f, 0x36, 0x62, 0x3a, 0xa5, 0xb9, 0x97, 0x3a, 0xb0, 0x2a, 0xb8, 0xb4, 0x8e, 0x66, 0x3d,
-	0xa6, 0xdc, 0xec, 0xb1, 0xcc, 0x8c, 0xc7, 0x7e, 0x04, 0xa5, 0xbe, 0x39, 0x22, 0x2d, 0x9b, 0x11,
-	0xef, 0x4a, 0xb7, 0xf8, 0xf5, 0x23, 0x2b, 0xaf, 0x69, 0x4f, 0x41, 0xf2, 0x2d, 0x8b, 0xe9, 0x69,
-	0x1f, 0xe4, 0x3e, 0xac, 0xf1, 0x7a, 0x6b, 0x0a, 0x93, 0x01, 0x2b, 0x11, 0xdb, 0x08, 0x41, 0xf8,
-	0x29, 0x80, 0x4a, 0x06, 0x8e, 0x67, 0x74, 0x75, 0xd3, 0x43, 0x55, 0x58, 0xe6, 0xe5, 0x99, 0xbc,
-	0x48, 0xf9, 0x4f, 0x5e, 0xca, 0x5d, 0xe9, 0xd6, 0x98, 0xf8, 0x8b, 0xe5, 0x00, 0xff, 0x72, 0x05,
-	0x60, 0xfa, 0x38, 0x8b, 0x3d, 0x27, 0x95, 0xd8, 0x73, 0x12, 0xd5, 0x21, 0x3f, 0xd0, 0x5d, 0x7d,
-	0xc0, 0x6f, 0x49, 0xff, 0x1a, 0x0e, 0xc6, 0xe8, 0x0e, 0x14, 0xf4, 0x2b, 0xdd, 0xb4, 0xf4, 0x73,
-	0x8b, 0x88, 0x00, 0x65, 0xd5, 0xe9, 0x04, 0xba, 0x17, 0xee, 0x47, 0xd9, 0x52, 0xc9, 0x8a, 0x96,
-	0x8a, 0xbf, 0xf5, 0x1a, 0xa2, 0xb1, 0xf2, 0x35, 0x40, 0xd4, 0x3f, 0x9c, 0xa9, 0xad, 0xbb, 0x3e,
-	0x70, 0x45, 0x00, 0xab, 0x3e, 0xa5, 0x67, 0xeb, 0xae, 0x44, 0x7f, 0x02, 0x9b, 0x1e, 0x19, 0x10,
-	0xf3, 0x2a, 0x81, 0xcf, 0x09, 0x3c, 0x0a, 0x69, 0xd3, 0x15, 0x77, 0x01, 0xa6, 0xae, 0xae, 0xad,
-	0x0a, 0x5c, 0x21, 0xf4, 0x32, 0xda, 0x83, 0x0d, 0xdd, 0x75, 0xad, 0x49, 0x82, 0x5f, 0x5e, 0xe0,
-	0xd6, 0x03, 0xd2, 0x94, 0xdd, 0x36, 0xac, 0x9a, 0x54, 0x3b, 0x1f, 0xd3, 0x49, 0xad, 0x20, 0x9e,
-	0x6a, 0x39, 0x93, 0x1e, 0x8e, 0xe9, 0x84, 0x9f, 0x4b, 0x63, 0x4a, 0x8c, 0xe8, 0x55, 0x91, 0xe7,
-	0x13, 0xfc, 0x8e, 0x40, 0xdf, 0x84, 0xbc, 0xe9, 0xc7, 0xbe, 0x56, 0x11, 0x79, 0xf8, 0xfe, 0x4c,
-	0xf3, 0x28, 0x48, 0x0e, 0x35, 0x84, 0xa2, 0xcf, 0x00, 0x06, 0xee, 0x58, 0x1b, 0x53, 0x7d, 0x48,
-	0x68, 0xad, 0x2a, 0x6e, 0xad, 0xed, 0x44, 0x02, 0x07, 0x71, 0x57, 0x0b, 0x03, 0x77, 0x7c, 0x26,
-	0x90, 0xe8, 0xdb, 0xb0, 0xe6, 0x11, 0xdd, 0xd0, 0x4c, 0x47, 0xf3, 0x74, 0x46, 0x68, 0x6d, 0xfd,
-	0xfa, 0xa5, 0x45, 0x8e, 0x6e, 0x39, 0x2a, 0xc7, 0xa2, 0xef, 0x40, 0xf9, 0x95, 0x67, 0x32, 0x32,
-	0x5d, 0x8d, 0xae, 0x5f, 0x5d, 0x12, 0xf0, 0x60, 0xf9, 0xb7, 0xa0, 0xe4, 0xb8, 0x9a, 0xa5, 0x33,
-	0x62, 0x0f, 0x4c, 0x42, 0x6b, 0x1b, 0x37, 0x88, 0x76, 0xdc, 0x93, 0x00, 0x8b, 0xdf, 0xc0, 0x7b,
-	0x22, 0x23, 0xdf, 0x49, 0x0d, 0x11, 0x76, 0x25, 0x32, 0xb7, 0xea, 0x4a, 0x9c, 0xc2, 0x56, 0x52,
-	0x76, 0x9a, 0x23, 0xe4, 0x8f, 0x0a, 0x6c, 0xf6, 0x06, 0x3a, 0xe3, 0x05, 0x74, 0xea, 0xa7, 0xf3,
-	0x75, 0x0f, 0xc2, 0xc8, 0x2d, 0xb2, 0x7c, 0xcb, 0x5a, 0x29, 0xbb, 0xb8, 0x56, 0xc2, 0x27, 0xf0,
-	0x5e, 0x42, 0xed, 0x94, 0x8d, 0xc4, 0x63, 0xc2, 0x8e, 0x1b, 0x3d, 0xfd, 0x82, 0x74, 0x1d, 0xd3,
-	0x4e, 0x13, 0x50, 0x6c, 0xc1, 0x56, 0x92, 0x59, 0x9a, 0xbb, 0x90, 0x1f, 0x0c, 0xfa, 0x05, 0xd1,
-	0x5c, 0xce, 0xca, 0xf7, 0x6a, 0x81, 0x06, 0xbc, 0xf1, 0x08, 0x6a, 0x67, 0xae, 0xa1, 0x33, 0xf2,
-	0x6e, 0xb4, 0xbf, 0x49, 0xdc, 0x15, 0xbc, 0x3f, 0x47, 0x5c, 0x1a, 0xfb, 0x1e, 0x40, 0x99, 0xdf,
-	0x4a, 0x33, 0x42, 0xf9, 0x5d, 0x15, 0x8a, 0xc0, 0x44, 0xbc, 0x4a, 0x3a, 0x2e, 0xf1, 0x74, 0xe6,
-	0x78, 0xff, 0xb5, 0xae, 0xc5, 0x9f, 0x65, 0xfb, 0x6c, 0x2a, 0x27, 0x8d, 0x65, 0xd7, 0x6e, 0x07,
-	0x04, 0x59, 0x83, 0xd0, 0x81, 0xd8, 0x0c, 0x25, 0x55, 0xfc, 0xe6, 0x52, 0xf8, 0x26, 0x1f, 0x53,
-	0x91, 0xfa, 0xe5, 0x84, 0x94, 0x40, 0xa9, 0x9e, 0x80, 0xa8, 0x3e, 0x94, 0x33, 0x7a, 0x69, 0xda,
-	0x86, 0xb8, 0x8a, 0x4a, 0xaa, 0xf8, 0xfd, 0xf8, 0x37, 0x0a, 0x14, 0xc2, 0x2f, 0x25, 0x28, 0x07,
-	0x99, 0xce, 0xf3, 0xea, 0x12, 0x2a, 0xc2, 0xea, 0x59, 0xfb, 0x79, 0xbb, 0xf3, 0xfd, 0x76, 0x55,
-	0x41, 0x9b, 0x50, 0x6d, 0x77, 0xfa, 0xda, 0x61, 0xa7, 0xd3, 0xef, 0xf5, 0xd5, 0x83, 0x6e, 0xb7,
-	0x79, 0x54, 0xcd, 0xa0, 0x0d, 0xa8, 0xf4, 0xfa, 0x1d, 0xb5, 0xa9, 0xf5, 0x3b, 0xa7, 0x87, 0xbd,
-	0x7e, 0xa7, 0xdd, 0xac, 0x2e, 0xa3, 0x1a, 0x6c, 0x1e, 0x9c, 0xa8, 0xcd, 0x83, 0xa3, 0x2f, 0xe3,
-	0xf0, 0x2c, 0xa7, 0xb4, 0xda, 0x8d, 0xce, 0x69, 0xf7, 0xa0, 0xdf, 0x3a, 0x3c, 0x69, 0x6a, 0x2f,
-	0x9a, 0x6a, 0xaf, 0xd5, 0x69, 0x57, 0x57, 0x38, 0x7b, 0xb5, 0x79, 0xdc, 0xea, 0xb4, 0x35, 0x2e,
-	0xe5, 0x8b, 0xce, 0x59, 0xfb, 0xa8, 0x9a, 0x7b, 0xdc, 0x85, 0x72, 0xdc, 0x0a, 0xae, 0x53, 0xef,
-	0xac, 0xd1, 0x68, 0xf6, 0x7a, 0x52, 0xc1, 0x7e, 0xeb, 0xb4, 0xd9, 0x39, 0xeb, 0x57, 0x15, 0x04,
-	0x90, 0x6b, 0x1c, 0xb4, 0x1b, 0xcd, 0x93, 0x6a, 0x86, 0x13, 0xd4, 0x66, 0xf7, 0xe4, 0xa0, 0xc1,
-	0xd5, 0xe1, 0x83, 0xb3, 0x76, 0xbb, 0xd5, 0x3e, 0xae, 0x66, 0xf7, 0x7f, 0x56, 0x86, 0x42, 0x2f,
-	0x70, 0x12, 0xea, 0x00, 0x4c, 0xdf, 0xae, 0x68, 0x27, 0xe6, 0xbe, 0x99, 0xe7, 0x71, 0xfd, 0xc3,
-	0x85, 0x74, 0x19, 0x4e, 0xbc, 0x84, 0xbe, 0x0b, 0xcb, 0x7d, 0xea, 0xa0, 0xf8, 0xa1, 0x3c, 0xfd,
-	0xac, 0x54, 0xaf, 0xcd, 0x12, 0x82, 0xb5, 0x8f, 0x94, 0x4f, 0x14, 0x74, 0x02, 0x85, 0xf0, 0x93,
-	0x02, 0xba, 0x1b, 0x03, 0x27, 0x3f, 0xb8, 0xd4, 0x77, 0x16, 0x91, 0x43, 0x6d, 0x7e, 0x08, 0xe5,
-	0xf8, 0x27, 0x0a, 0x84, 0x63, 0x6b, 0xe6, 0x7e, 0x0c, 0xa9, 0xdf, 0xbf, 0x16, 0x13, 0x32, 0xff,
-	0x02, 0x56, 0xfd, 0xcf, 0x08, 0x28, 0x9e, 0x77, 0xf1, 0x4f, 0x14, 0xf5, 0x3b, 0xf3, 0x89, 0x21,
-	0x9f, 0x16, 0xe4, 0x83, 0x9e, 0x3e, 0xba, 0x93, 0xf4, 0x70, 0xb4, 0x9b, 0x5e, 0xbf, 0xbb, 0x80,
-	0x1a, 0x65, 0x15, 0x74, 0xc5, 0x13, 0xac, 0x12, 0x8d, 0xf9, 0x04, 0xab, 0x64, 0x2b, 0x1d, 0x2f,
-	0xa1, 0x33, 0x28, 0x45, 0x3b, 0xd2, 0x68, 0x37, 0x29, 0x3b, 0xd9, 0x31, 0xaf, 0xdf, 0xbb, 0x06,
-	0x11, 0x8d, 0x48, 0xfc, 0x36, 0x4e, 0x44, 0x64, 0x6e, 0x99, 0x90, 0x88, 0xc8, 0xfc, 0xeb, 0x1c,
-	0x2f, 0xa1, 0x73, 0xa8, 0x24, 0x9e, 0xc4, 0xe8, 0x7e, 0xe2, 0xdc, 0x99, 0xd7, 0xc9, 0xa8, 0x3f,
-	0xb8, 0x1e, 0x94, 0x4c, 0xd0, 0xb0, 0x1f, 0x8c, 0x66, 0x02, 0x12, 0x2b, 0x09, 0xea, 0x3b, 0x8b,
-	0xc8, 0xa1, 0xc6, 0x5d, 0x58, 0x3b, 0x26, 0xac, 0xeb, 0x91, 0xab, 0x77, 0xc5, 0xb1, 0x2f, 0x38,
-	0x4e, 0xfb, 0xd5, 0xe8, 0xde, 0xfc, 0x25, 0x91, 0x5e, 0xf6, 0x2d, 0xb8, 0xaa, 0x50, 0x8c, 0x34,
-	0x81, 0x51, 0xfc, 0x20, 0x98, 0xed, 0x5a, 0xd7, 0x77, 0x17, 0x03, 0xa2, 0xc9, 0x1a, 0x3c, 0x7e,
-	0x13, 0xc9, 0x9a, 0x78, 0x83, 0x27, 0x92, 0x35, 0xf9, 0x62, 0xc6, 0x4b, 0x48, 0x17, 0x9f, 0x32,
-	0x62, 0x0d, 0x4c, 0xf4, 0x20, 0x69, 0xd4, 0xbc, 0xce, 0x6a, 0xfd, 0xa3, 0x1b, 0x50, 0x51, 0x11,
-	0xc9, 0x1e, 0x69, 0x42, 0xc4, 0x82, 0xe6, 0x6d, 0x42, 0xc4, 0xa2, 0x46, 0x2b, 0x5e, 0x42, 0x3f,
-	0x80, 0xb5, 0x58, 0x89, 0x96, 0x08, 0xdd, 0xbc, 0xaa, 0xb3, 0x8e, 0xaf, 0x83, 0x44, 0x77, 0x5d,
-	0xbc, 0xc2, 0x4a, 0xec, 0xba, 0xb9, 0xb5, 0x5c, 0x62, 0xd7, 0xcd, 0x2f, 0xd1, 0xf0, 0x12, 0x32,
-	0x60, 0x7d, 0xa6, 0xc2, 0x41, 0x71, 0xa3, 0x17, 0x15, 0x5c, 0xf5, 0x87, 0x37, 0xc1, 0xa2, 0x19,
-	0x18, 0xa9, 0x33, 0xd0, 0xcc, 0x55, 0x94, 0xa8, 0x74, 0xea, 0xbb, 0x8b, 0x01, 0x01, 0xcf, 0xc3,
-	0xea, 0x5f, 0xde, 0xee, 0x28, 0x7f, 0x7d, 0xbb, 0xa3, 0xfc, 0xe3, 0xed, 0x8e, 0xf2, 0xdb, 0x7f,
-	0xee, 0x2c, 0x9d, 0xe7, 0xc4, 0x9f, 0x3c, 0x3e, 0xfd, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x40,
-	0x5d, 0x19, 0x5a, 0x39, 0x22, 0x00, 0x00,
+func init() { proto.RegisterFile("schedulerpb.proto", fileDescriptor_schedulerpb_ef051728427fc498) }
+
+var fileDescriptor_schedulerpb_ef051728427fc498 = []byte{
+	// 2795 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5a, 0xcf, 0x6f, 0xe3, 0xc6,
+	0xf5, 0x5f, 0xea, 0xa7, 0xf5, 0xf4, 0xc3, 0xf2, 0xd8, 0xb1, 0xb9, 0xda, 0x5d, 0xc7, 0xa1, 0x37,
+	0x1b, 0x67, 0xbf, 0xdf, 0x38, 0xe9, 0x26, 0x0d, 0x82, 0x16, 0x2d, 0xe0, 0x1f, 0x8a, 0xa3, 0xae,
+	0x2d, 0x09, 0x94, 0x9c, 0x34, 0x68, 0x01, 0x96, 0x16, 0x67, 0x65, 0xc6, 0x14, 0xc9, 0x70, 0x46,
+	0xde, 0xd5, 0x16, 0xbd, 0xb4, 0xe7, 0x16, 0x45, 0x91, 0x02, 0x05, 0xda, 0x43, 0xff, 0x89, 0xde,
+	0x8a, 0x9e, 0x7a, 0xe8, 0xb1, 0x87, 0xde, 0x7a, 0x29, 0xd2, 0x7f, 0xa3, 0x87, 0x62, 0x66, 0x48,
+	0x8a, 0xa4, 0x7e, 0xd8, 0x05, 0xb7, 0xbd, 0x89, 0xf3, 0x3e, 0xf3, 0x7e, 0xcd, 0x9b, 0x79, 0x6f,
+	0xde, 0x08, 0xd6, 0xc8, 0xe0, 0x12, 0x1b, 0x63, 0x0b, 0x7b, 0xee, 0xc5, 0xbe, 0xeb, 0x39, 0xd4,
+	0x41, 0xe5, 0xc8, 0x50, 0xa3, 0x32, 0xc2, 0x54, 0x0f, 0x48, 0x8d, 0x2a, 0xf6, 0xf4, 0x67, 0x34,
+	0xfc, 0xdc, 0x18, 0x3a, 0x43, 0x87, 0xff, 0x7c, 0x97, 0xfd, 0x12, 0xa3, 0xca, 0x3e, 0x54, 0x55,
+	0xfc, 0xe5, 0x18, 0x13, 0xfa, 0x09, 0xd6, 0x0d, 0xec, 0xa1, 0x07, 0x00, 0x03, 0x6b, 0x4c, 0x28,
+	0xf6, 0x34, 0xd3, 0x90, 0xa5, 0x1d, 0x69, 0x2f, 0xa7, 0x96, 0xfc, 0x91, 0x96, 0xa1, 0x7c, 0x0e,
+	0x35, 0x15, 0x13, 0xd7, 0xb1, 0x09, 0xbe, 0xd5, 0x04, 0xb4, 0x07, 0x79, 0xec, 0x79, 0x8e, 0x27,
+	0x67, 0x76, 0xa4, 0xbd, 0xf2, 0x13, 0xb4, 0x1f, 0xb5, 0xa1, 0xc9, 0x28, 0xaa, 0x00, 0x28, 0x67,
+	0x90, 0xe7, 0xdf, 0xe8, 0x31, 0xe4, 0xe8, 0xc4, 0xc5, 0x9c, 0x57, 0xed, 0xc9, 0xe6, 0xec, 0x8c,
+	0xfe, 0xc4, 0xc5, 0x2a, 0xc7, 0x20, 0x19, 0x8a, 0x23, 0x4c, 0x88, 0x3e, 0xc4, 0x5c, 0x40, 0x49,
+	0x0d, 0x3e, 0x95, 0x4f, 0x01, 0xfa, 0xc4, 0xf1, 0x8d, 0x43, 0x4f, 0xa0, 0x70, 0xc9, 0xf5, 0xe5,
+	0x5c, 0xcb, 0x4f, 0x1a, 0x31, 0xae, 0x31, 0x17, 0xa8, 0x3e, 0x12, 0x6d, 0x40, 0x7e, 0xe0, 0x8c,
+	0x6d, 0xca, 0x39, 0x57, 0x55, 0xf1, 0xa1, 0x1c, 0x40, 0xa9, 0x6f, 0x8e, 0x30, 0xa1, 0xfa, 0xc8,
+	0x45, 0x0d, 0x58, 0x71, 0x2f, 0x27, 0xc4, 0x1c, 0xe8, 0x16, 0x67, 0x9c, 0x55, 0xc3, 0x6f, 0xa6,
+	0x9a, 0xe5, 0x0c, 0x39, 0x29, 0xc3, 0x49, 0xc1, 0xa7, 0xf2, 0x0b, 0x09, 0xca, 0x5c, 0x37, 0xe1,
+	0x48, 0xf4, 0x7e, 0x42, 0xb9, 0x7b, 0x09, 0xe5, 0xa2, 0xfe, 0x5e, 0xae, 0x1d, 0xfa, 0x00, 0x4a,
+	0x34, 0xd0, 0x4e, 0xce, 0x72, 0x6e, 0x71, 0x07, 0x86, 0xba, 0xab, 0x53, 0xa0, 0x72, 0x05, 0xf5,
+	0x43, 0xc7, 0xa1, 0x84, 0x7a, 0xba, 0x9b, 0xc6, 0x63, 0xbb, 0x90, 0x27, 0xd4, 0xf1, 0xb0, 0xbf,
+	0xd8, 0xd5, 0x7d, 0x3f, 0x20, 0x7b, 0x6c, 0x50, 0x15, 0x34, 0xe5, 0x13, 0x58, 0x8b, 0x08, 0x4b,
+	0xe1, 0x02, 0xe5, 0x29, 0xbc, 0xd6, 0x22, 0x21, 0x2f, 0x17, 0x1b, 0x29, 0x74, 0x57, 0xbe, 0x84,
+	0xcd, 0x24, 0xb3, 0x34, 0xcb, 0xa3, 0x40, 0xe5, 0x22, 0xc2, 0x8c, 0x7b, 0x64, 0x45, 0x8d, 0x8d,
+	0x29, 0xc7, 0x50, 0x3b, 0xb0, 0x2c, 0x67, 0xd0, 0x3a, 0x4e, 0xa3, 0xf8, 0xa7, 0xb0, 0x1a, 0x72,
+	0x49, 0xa3, 0x71, 0x0d, 0x32, 0xa6, 0xd0, 0x33, 0xa7, 0x66, 0x4c, 0x43, 0xf9, 0x11, 0xac, 0x9e,
+	0x60, 0x2a, 0x96, 0x2e, 0x45, 0x4c, 0xdc, 0x85, 0x15, 0xbe, 0xee, 0x5a, 0xc8, 0xbc, 0xc8, 0xbf,
+	0x5b, 0x86, 0xf2, 0x5b, 0x09, 0xea, 0x53, 0x11, 0x69, 0x74, 0xbf, 0x4d, 0xe0, 0xa1, 0x77, 0x18,
+	0x48, 0xa7, 0xc4, 0xdf, 0x17, 0x5b, 0x31, 0xc6, 0x1c, 0xd9, 0x63, 0x64, 0x55, 0xa0, 0x94, 0x2f,
+	0x60, 0xb5, 0x3b, 0x4e, 0x6f, 0xff, 0xad, 0xf6, 0xc4, 0x09, 0xd4, 0xa7, 0xb2, 0xd2, 0x6c, 0x89,
+	0x9f, 0x49, 0xb0, 0x7e, 0x82, 0xe9, 0x81, 0x65, 0x71, 0x66, 0x24, 0x8d, 0xe6, 0x1f, 0x81, 0x8c,
+	0x5f, 0x0c, 0xac, 0xb1, 0x81, 0x35, 0xea, 0x8c, 0x2e, 0x08, 0x75, 0x6c, 0xac, 0x71, 0x7d, 0x89,
+	0x1f, 0xce, 0x9b, 0x3e, 0xbd, 0x1f, 0x90, 0x85, 0x50, 0xc5, 0x83, 0x8d, 0xb8, 0x12, 0x69, 0xd6,
+	0xf6, 0x4d, 0x28, 0x84, 0x42, 0xb3, 0xb3, 0x1e, 0xf4, 0x89, 0x0a, 0xe6, 0xb1, 0xa4, 0xe2, 0xa1,
+	0xe9, 0xd8, 0x69, 0xac, 0x7e, 0x00, 0xe0, 0x71, 0x26, 0xda, 0x15, 0x9e, 0x70, 0x3b, 0x2b, 0x6a,
+	0x49, 0x8c, 0x3c, 0xc5, 0x13, 0xe5, 0x8f, 0x12, 0xac, 0x45, 0xe4, 0xa4, 0x31, 0xec, 0x11, 0x14,
+	0x04, 0x5f, 0x3f, 0x34, 0x6a, 0x81, 0x61, 0x3e, 0x73, 0x9f, 0x8a, 0x1e, 0x42, 0xc1, 0x12, 0xcc,
+	0x45, 0xe0, 0x56, 0x02, 0x5c, 0x17, 0x33, 0x6e, 0x82, 0xc6, 0x50, 0xc4, 0xd2, 0xaf, 0x31, 0x91,
+	0x73, 0x3b, 0xd9, 0x59, 0x94, 0xa0, 0x29, 0x43, 0xbe, 0x32, 0x42, 0xc0, 0xe1, 0x24, 0xd5, 0xc1,
+	0x83, 0xee, 0x81, 0xef, 0x97, 0xe9, 0xd6, 0x5e, 0x11, 0x03, 0x2d, 0x43, 0xf9, 0x4a, 0x02, 0xd4,
+	0x1b, 0xe8, 0xb6, 0x10, 0x45, 0x52, 0xca, 0x21, 0x54, 0xf7, 0x68, 0x64, 0x41, 0x56, 0xf8, 0xc0,
+	0x53, 0x3c, 0x61, 0x69, 0xd0, 0x32, 0x47, 0x26, 0xe5, 0xbe, 0xc9, 0xab, 0xe2, 0x03, 0x6d, 0x41,
+	0x11, 0xdb, 0x06, 0x9f, 0x90, 0xe3, 0x13, 0x0a, 0xd8, 0x36, 0xd8, 0xf2, 0xfd, 0x4e, 0x82, 0xf5,
+	0x98, 0x5a, 0x69, 0x16, 0x70, 0x0f, 0x8a, 0xc2, 0xde, 0x20, 0x34, 0x93, 0x2b, 0x18, 0x90, 0xd1,
+	0x23, 0x28, 0x8a, 0x65, 0x62, 0x87, 0xcf, 0xec, 0xea, 0x04, 0x44, 0xe5, 0x0c, 0xb6, 0x4e, 0x30,
+	0x3d, 0x12, 0xd5, 0xd3, 0x91, 0x63, 0x3f, 0x33, 0x87, 0x69, 0x52, 0xc3, 0x4b, 0x90, 0x67, 0xd9,
+	0xa5, 0xb1, 0xf8, 0x6d, 0x28, 0xfa, 0xa5, 0x9d, 0x1f, 0xb3, 0xab, 0x81, 0x1d, 0xbe, 0x10, 0x35,
+	0xa0, 0x2b, 0x2f, 0x60, 0xab, 0x3b, 0x7e, 0x65, 0xa6, 0xfc, 0x27, 0x92, 0x3b, 0x20, 0xcf, 0x4a,
+	0x4e, 0x73, 0xa8, 0xfe, 0x5e, 0x82, 0xc2, 0x19, 0x1e, 0x5d, 0x60, 0x0f, 0x21, 0xc8, 0xd9, 0xfa,
+	0x48, 0xd4, 0xa6, 0x25, 0x95, 0xff, 0x66, 0xf1, 0x39, 0xe2, 0xd4, 0xc8, 0x3e, 0x10, 0x03, 0x2d,
+	0x83, 0x11, 0x5d, 0x8c, 0x3d, 0x6d, 0xec, 0x59, 0x62, 0xed, 0x4b, 0xea, 0x0a, 0x1b, 0x38, 0xf7,
+	0x2c, 0x82, 0x5e, 0x87, 0xf2, 0xc0, 0x32, 0xb1, 0x4d, 0x05, 0x39, 0xc7, 0xc9, 0x20, 0x86, 0x38,
+	0xe0, 0x2d, 0x58, 0x15, 0xa1, 0xa1, 0xb9, 0x9e, 0xe9, 0x78, 0x26, 0x9d, 0xc8, 0x79, 0x1e, 0xe7,
+	0x35, 0x31, 0xdc, 0xf5, 0x47, 0x95, 0x13, 0x7e, 0x2a, 0x09, 0x25, 0xd3, 0x6c, 0x36, 0xe5, 0xef,
+	0x12, 0xa0, 0x28, 0xa7, 0x34, 0xd1, 0xf2, 0x0e, 0x2b, 0xce, 0x39, 0x1f, 0x7f, 0x7f, 0xac, 0xc7,
+	0x66, 0x09, 0x19, 0x6a, 0x80, 0x41, 0xff, 0x97, 0x38, 0xe7, 0xe6, 0xa2, 0x83, 0xe3, 0xee, 0x03,
+	0x28, 0x63, 0x3a, 0x30, 0x34, 0x7f, 0x46, 0x6e, 0xf1, 0x0c, 0x60, 0xb8, 0x53, 0x61, 0xdd, 0xdf,
+	0xb2, 0xb0, 0x29, 0xf6, 0xe6, 0x27, 0x58, 0xf7, 0xe8, 0x05, 0xd6, 0x69, 0x9a, 0xa0, 0x7c, 0xb5,
+	0x27, 0xf8, 0x37, 0xa0, 0xea, 0x62, 0xdb, 0x30, 0xed, 0xa1, 0xe6, 0x62, 0xe6, 0xb4, 0xfc, 0x9c,
+	0xa3, 0xa2, 0xe2, 0x43, 0xd8, 0x07, 0x41, 0xbb, 0x50, 0xbd, 0x98, 0x50, 0x4c, 0xb4, 0xe7, 0x9e,
+	0x49, 0x29, 0xb6, 0xe5, 0x02, 0x0f, 0xbf, 0x0a, 0x1f, 0xfc, 0x4c, 0x8c, 0xb1, 0x8c, 0x26, 0x40,
+	0x1e, 0xd6, 0x0d, 0xb9, 0x28, 0x6e, 0x68, 0x7c, 0x44, 0xc5, 0xba, 0x81, 0xde, 0x80, 0xca, 0x15,
+	0x9e, 0x4c, 0x59, 0xac, 0x70, 0x40, 0x99, 0x8d, 0x05, 0x1c, 0xee, 0x41, 0x89, 0x43, 0x38, 0x83,
+	0x92, 0x88, 0x70, 0x36, 0xc0, 0xe7, 0xbf, 0x0d, 0x75, 0xdd, 0x75, 0x3d, 0xe7, 0x85, 0x39, 0xd2,
+	0x29, 0xd6, 0x88, 0xf9, 0x12, 0xcb, 0xc0, 0x31, 0xab, 0x91, 0xf1, 0x9e, 0xf9, 0x12, 0xa3, 0x6f,
+	0xc2, 0x8a, 0x69, 0x53, 0xec, 0x5d, 0xeb, 0x96, 0x5c, 0xe1, 0x9e, 0xb8, 0x3b, 0x73, 0x39, 0x69,
+	0xf9, 0x00, 0x35, 0x84, 0xb2, 0x6d, 0xe2, 0x61, 0xe2, 0x58, 0xd7, 0xd8, 0xd0, 0x28, 0x91, 0xab,
+	0x9c, 0x39, 0x04, 0x43, 0x7d, 0xa2, 0x5c, 0x02, 0x1c, 0x5d, 0xea, 0xf6, 0x10, 0x33, 0xaf, 0xa0,
+	0x1d, 0xc8, 0xb9, 0x38, 0x5c, 0xc7, 0xb8, 0xfb, 0x38, 0x05, 0x7d, 0x04, 0xe5, 0x01, 0xc7, 0x6b,
+	0xfc, 0xa2, 0x99, 0xe1, 0x17, 0xcd, 0xad, 0xfd, 0xe0, 0xc2, 0xcc, 0xce, 0x0c, 0xc1, 0x8f, 0xdf,
+	0x34, 0x61, 0x10, 0xfe, 0x56, 0x9e, 0x40, 0xad, 0xef, 0xe9, 0x36, 0x79, 0x86, 0x3d, 0x11, 0x52,
+	0x37, 0x4b, 0x53, 0xde, 0x85, 0xfc, 0x19, 0xf6, 0x86, 0x98, 0x85, 0x0b, 0xd5, 0xbd, 0x21, 0xa6,
+	0xb2, 0x34, 0x3f, 0x5c, 0x04, 0x55, 0xf9, 0x57, 0x06, 0xb6, 0x66, 0xa2, 0x34, 0xcd, 0x46, 0x9c,
+	0xda, 0xcb, 0x55, 0xcd, 0xcc, 0xa9, 0x7f, 0xa7, 0xfe, 0x0b, 0xec, 0xe5, 0xbe, 0x3c, 0x86, 0x55,
+	0xea, 0xdb, 0xab, 0xc5, 0x42, 0x38, 0x2e, 0x37, 0xee, 0x13, 0xb5, 0x46, 0xe3, 0x3e, 0x8a, 0x55,
+	0x0a, 0xb9, 0x78, 0xa5, 0x80, 0x3e, 0x84, 0x8a, 0x4f, 0xc4, 0xae, 0x33, 0xb8, 0x94, 0xf3, 0xfe,
+	0x56, 0x8e, 0xf9, 0xa6, 0xc9, 0x48, 0x6a, 0xd9, 0x9b, 0x7e, 0xa0, 0x77, 0xa0, 0x2c, 0xfc, 0x25,
+	0x8c, 0x2a, 0xcc, 0xf1, 0x3f, 0x08, 0x00, 0xb7, 0x64, 0x0f, 0xf2, 0x23, 0xb6, 0x0a, 0x72, 0x71,
+	0x4e, 0x23, 0x82, 0xaf, 0x8f, 0x2a, 0x00, 0xca, 0x08, 0x56, 0x0f, 0xc8, 0x55, 0xcf, 0xb5, 0xcc,
+	0xff, 0xc5, 0xe1, 0xa0, 0xfc, 0x5c, 0x82, 0xfa, 0x54, 0x5e, 0xba, 0x3b, 0x67, 0xd5, 0xc6, 0xcf,
+	0xb5, 0x64, 0x51, 0x56, 0xb6, 0xf1, 0x73, 0x35, 0xf0, 0xf6, 0x0e, 0x54, 0x18, 0x86, 0xe7, 0x24,
+	0xd3, 0x10, 0x29, 0x29, 0xa7, 0x82, 0x8d, 0x9f, 0x33, 0x2f, 0xb5, 0x0c, 0xa2, 0xfc, 0x4a, 0x02,
+	0xa4, 0x62, 0xd7, 0xf1, 0x68, 0x6a, 0x17, 0x28, 0x90, 0xb3, 0xf0, 0x33, 0xba, 0xc0, 0x01, 0x9c,
+	0x86, 0x1e, 0x42, 0xde, 0x33, 0x87, 0x97, 0x54, 0xce, 0xce, 0x05, 0x09, 0xa2, 0xf2, 0x3d, 0x58,
+	0x8f, 0xe9, 0x94, 0x26, 0x9d, 0x77, 0xa0, 0xc8, 0xb9, 0xb4, 0x8e, 0x67, 0x3d, 0x26, 0xdd, 0xec,
+	0xb1, 0xcc, 0x8c, 0xc7, 0x7e, 0x08, 0x95, 0xe8, 0xc9, 0xc5, 0xb2, 0xb6, 0x28, 0x58, 0xa7, 0xad,
+	0x18, 0xc1, 0xb7, 0xc6, 0x87, 0xa7, 0xed, 0xa3, 0x5d, 0xa8, 0xb2, 0x32, 0x75, 0x0a, 0x13, 0x0b,
+	0x56, 0xc1, 0xb6, 0x11, 0x82, 0x94, 0x0f, 0x00, 0x54, 0x3c, 0x70, 0x3c, 0xa3, 0xab, 0x9b, 0x1e,
+	0xaa, 0x43, 0x96, 0x55, 0xb5, 0xa2, 0xfe, 0xc8, 0x5e, 0x89, 0x0a, 0xf8, 0x5a, 0xb7, 0xc6, 0xd8,
+	0x9f, 0x2c, 0x3e, 0x94, 0xaf, 0x0a, 0x00, 0xd3, 0x3b, 0x6d, 0xec, 0x16, 0x2e, 0xc5, 0x6e, 0xe1,
+	0xac, 0x87, 0x35, 0xd0, 0x5d, 0x7d, 0xc0, 0x8a, 0x0b, 0xbf, 0x7a, 0x09, 0xbe, 0xd1, 0x7d, 0x28,
+	0xe9, 0xd7, 0xba, 0x69, 0xe9, 0x17, 0x16, 0xe6, 0x0b, 0x94, 0x53, 0xa7, 0x03, 0x2c, 0x73, 0xf8,
+	0x9e, 0x13, 0x9d, 0xa8, 0x1c, 0xef, 0x44, 0xf9, 0x9b, 0xf4, 0x88, 0x0d, 0xa1, 0xff, 0x07, 0x44,
+	0xfc, 0x9c, 0x46, 0x6c, 0xdd, 0xf5, 0x81, 0x79, 0x0e, 0xac, 0xfb, 0x94, 0x9e, 0xad, 0xbb, 0x02,
+	0xfd, 0x1e, 0x6c, 0x78, 0x78, 0x80, 0xcd, 0xeb, 0x04, 0xbe, 0xc0, 0xf1, 0x28, 0xa4, 0x4d, 0x67,
+	0x3c, 0x00, 0x98, 0xba, 0x9a, 0x6f, 0xed, 0xaa, 0x5a, 0x0a, 0xbd, 0x8c, 0xf6, 0x61, 0x5d, 0x77,
+	0x5d, 0x6b, 0x92, 0xe0, 0xb7, 0xc2, 0x71, 0x6b, 0x01, 0x69, 0xca, 0x6e, 0x0b, 0x8a, 0x26, 0xd1,
+	0x2e, 0xc6, 0x64, 0xc2, 0xd3, 0xdc, 0x8a, 0x5a, 0x30, 0xc9, 0xe1, 0x98, 0x4c, 0xd8, 0x09, 0x36,
+	0x26, 0xd8, 0x88, 0x66, 0xb7, 0x15, 0x36, 0xc0, 0xd3, 0xda, 0x4c, 0x16, 0x2e, 0xcf, 0xc9, 0xc2,
+	0xc9, 0x34, 0x5b, 0x99, 0x4d, 0xb3, 0xf1, 0x44, 0x5d, 0x4d, 0x26, 0xea, 0x58, 0x16, 0xae, 0x25,
+	0xb2, 0x70, 0x34, 0xb5, 0xae, 0xde, 0x3e, 0xb5, 0x7e, 0x08, 0x30, 0x70, 0xc7, 0xda, 0x98, 0xb5,
+	0x4c, 0x89, 0x5c, 0xdf, 0xc9, 0xce, 0x24, 0x86, 0x69, 0xec, 0xa9, 0xa5, 0x81, 0x3b, 0x3e, 0xe7,
+	0x48, 0xf4, 0x6d, 0xa8, 0x32, 0x35, 0x34, 0xd3, 0xd1, 0x3c, 0x9d, 0x62, 0x22, 0xaf, 0x2d, 0x9f,
+	0x5a, 0x66, 0xe8, 0x96, 0xa3, 0x32, 0x2c, 0xfa, 0x0e, 0xd4, 0x98, 0x17, 0xf0, 0x74, 0x36, 0x5a,
+	0x3e, 0xbb, 0xc2, 0xe1, 0xc1, 0xf4, 0x6f, 0x41, 0xc5, 0x71, 0x35, 0x4b, 0xa7, 0xd8, 0x1e, 0x98,
+	0x98, 0xc8, 0xeb, 0x37, 0x88, 0x76, 0xdc, 0xd3, 0x00, 0xab, 0xbc, 0x84, 0xd7, 0xf8, 0xae, 0x78,
+	0x25, 0xe5, 0x5f, 0xd8, 0x50, 0xca, 0xdc, 0xaa, 0xa1, 0x74, 0x06, 0x9b, 0x49, 0xd9, 0x69, 0x8e,
+	0xb1, 0x3f, 0x48, 0xb0, 0xd1, 0x1b, 0xe8, 0x94, 0x62, 0x2f, 0x7d, 0xd7, 0x63, 0xd9, 0x5d, 0x3e,
+	0x92, 0xc9, 0xb2, 0xb7, 0x2c, 0x73, 0x73, 0x8b, 0xcb, 0x5c, 0xe5, 0x14, 0x5e, 0x4b, 0xa8, 0x9d,
+	0xb2, 0x07, 0x7c, 0x82, 0xe9, 0xc9, 0x51, 0x4f, 0x7f, 0x86, 0xbb, 0x8e, 0x69, 0xa7, 0x59, 0x50,
+	0xc5, 0x82, 0xcd, 0x24, 0xb3, 0x34, 0xf9, 0x98, 0x1d, 0x4e, 0xfa, 0x33, 0xac, 0xb9, 0x8c, 0x95,
+	0xef, 0xd5, 0x12, 0x09, 0x78, 0x2b, 0x23, 0x90, 0xcf, 0x5d, 0x43, 0xa7, 0xf8, 0xd5, 0x68, 0x7f,
+	0x93, 0xb8, 0x6b, 0xb8, 0x3b, 0x47, 0x5c, 0x1a, 0xfb, 0x1e, 0x42, 0x8d, 0x65, 0xc6, 0x19, 0xa1,
+	0x2c, 0x5f, 0x86, 0x22, 0x14, 0xcc, 0x2f, 0x94, 0x1d, 0x17, 0x7b, 0x3a, 0x75, 0xbc, 0xff, 0x5a,
+	0xc3, 0xe9, 0xcf, 0xa2, 0xf3, 0x39, 0x95, 0x93, 0xc6, 0xb2, 0xa5, 0xdb, 0x01, 0x41, 0xce, 0xc0,
+	0x64, 0xc0, 0x37, 0x43, 0x45, 0xe5, 0xbf, 0x99, 0x14, 0xb6, 0xc9, 0xc7, 0x84, 0x87, 0x7e, 0x2d,
+	0x21, 0x25, 0x50, 0xaa, 0xc7, 0x21, 0xaa, 0x0f, 0x65, 0x8c, 0xae, 0x4c, 0xdb, 0xe0, 0xe9, 0xb0,
+	0xa2, 0xf2, 0xdf, 0xca, 0x15, 0xac, 0x9e, 0xea, 0x17, 0xd8, 0x3a, 0x72, 0x6c, 0x42, 0x3d, 0xdd,
+	0xb4, 0xe9, 0x9c, 0x94, 0xbf, 0x0f, 0x19, 0xc7, 0xf5, 0xaf, 0x2d, 0xdb, 0x31, 0x49, 0x89, 0xb9,
+	0x1d, 0x57, 0xcd, 0x38, 0x2e, 0xda, 0x84, 0x02, 0xaf, 0x0a, 0x82, 0x0e, 0x84, 0xff, 0xa5, 0xfc,
+	0x32, 0x03, 0xd5, 0xae, 0xa5, 0x0f, 0xf0, 0x08, 0xdb, 0x54, 0x1d, 0x5b, 0x98, 0xd5, 0x09, 0x43,
+	0xcf, 0x19, 0xbb, 0x41, 0x9d, 0x50, 0x52, 0x8b, 0xfc, 0xbb, 0x65, 0x44, 0xde, 0x07, 0x4a, 0xec,
+	0x7d, 0x80, 0xd5, 0x1d, 0xa6, 0x6d, 0xe0, 0x17, 0x41, 0xe7, 0x8d, 0x7f, 0xc4, 0x9b, 0x75, 0xb9,
+	0x44, 0xb3, 0x2e, 0xd2, 0x96, 0xcb, 0x47, 0xdb, 0x72, 0xd3, 0xc7, 0xac, 0x82, 0xe0, 0xc5, 0x3f,
+	0x50, 0x0b, 0xd6, 0x2c, 0x66, 0x8f, 0x36, 0x08, 0x0d, 0x22, 0x72, 0x91, 0x9f, 0xf6, 0xf7, 0x97,
+	0x59, 0xad, 0xd6, 0xad, 0xf8, 0x80, 0x68, 0xa4, 0x38, 0x03, 0x9d, 0xb2, 0x25, 0xe5, 0x44, 0x22,
+	0xaf, 0x70, 0x57, 0xd4, 0x82, 0x61, 0xce, 0x83, 0x28, 0x6d, 0xde, 0x32, 0x8b, 0x39, 0x25, 0x55,
+	0x3f, 0xe5, 0xa7, 0x12, 0xdc, 0x9d, 0xc3, 0x30, 0x4d, 0x70, 0xbe, 0x07, 0x79, 0x8f, 0x71, 0xf1,
+	0x9b, 0x2a, 0x71, 0x2d, 0x62, 0x82, 0x54, 0x01, 0x54, 0x7e, 0x02, 0x5b, 0xbd, 0x84, 0x0e, 0x69,
+	0xf6, 0xe1, 0x3e, 0xe4, 0x18, 0x5f, 0x39, 0x33, 0x67, 0x46, 0x5c, 0x08, 0xc7, 0xb1, 0x86, 0xdc,
+	0xac, 0xf8, 0x34, 0x87, 0xfe, 0x8f, 0xa1, 0x71, 0x8c, 0x2d, 0x4c, 0xf1, 0x2b, 0x33, 0x29, 0x1a,
+	0xf7, 0x99, 0x79, 0x71, 0x9f, 0x0d, 0xe2, 0x5e, 0x51, 0xe1, 0xde, 0x5c, 0xe1, 0x29, 0x0c, 0x7a,
+	0xfc, 0x6b, 0x09, 0x4a, 0xe1, 0xd3, 0x36, 0x2a, 0x40, 0xa6, 0xf3, 0xb4, 0x7e, 0x07, 0x95, 0xa1,
+	0x78, 0xde, 0x7e, 0xda, 0xee, 0x7c, 0xd6, 0xae, 0x4b, 0x68, 0x03, 0xea, 0xed, 0x4e, 0x5f, 0x3b,
+	0xec, 0x74, 0xfa, 0xbd, 0xbe, 0x7a, 0xd0, 0xed, 0x36, 0x8f, 0xeb, 0x19, 0xb4, 0x0e, 0xab, 0xbd,
+	0x7e, 0x47, 0x6d, 0x6a, 0xfd, 0xce, 0xd9, 0x61, 0xaf, 0xdf, 0x69, 0x37, 0xeb, 0x59, 0x24, 0xc3,
+	0xc6, 0xc1, 0xa9, 0xda, 0x3c, 0x38, 0xfe, 0x3c, 0x0e, 0xcf, 0x31, 0x4a, 0xab, 0x7d, 0xd4, 0x39,
+	0xeb, 0x1e, 0xf4, 0x5b, 0x87, 0xa7, 0x4d, 0xed, 0xd3, 0xa6, 0xda, 0x6b, 0x75, 0xda, 0xf5, 0x3c,
+	0x63, 0xaf, 0x36, 0x4f, 0x5a, 0x9d, 0xb6, 0xc6, 0xa4, 0x7c, 0xdc, 0x39, 0x6f, 0x1f, 0xd7, 0x0b,
+	0x8f, 0xbb, 0x50, 0x8b, 0x9f, 0x5d, 0x4c, 0xa7, 0xde, 0xf9, 0xd1, 0x51, 0xb3, 0xd7, 0x13, 0x0a,
+	0xf6, 0x5b, 0x67, 0xcd, 0xce, 0x79, 0xbf, 0x2e, 0x21, 0x80, 0xc2, 0xd1, 0x41, 0xfb, 0xa8, 0x79,
+	0x5a, 0xcf, 0x30, 0x82, 0xda, 0xec, 0x9e, 0x1e, 0x1c, 0x31, 0x75, 0xd8, 0xc7, 0x79, 0xbb, 0xdd,
+	0x6a, 0x9f, 0xd4, 0x73, 0x8f, 0x0f, 0x60, 0x6d, 0xe6, 0x8c, 0x62, 0x06, 0xb7, 0xec, 0xfa, 0x1d,
+	0x54, 0x82, 0x7c, 0xdb, 0xa1, 0x2d, 0x5b, 0x70, 0x6b, 0xbe, 0x30, 0x09, 0x25, 0xf5, 0x0c, 0xaa,
+	0x42, 0xa9, 0xed, 0x50, 0xff, 0x33, 0xfb, 0xe4, 0x4f, 0x75, 0x28, 0xf5, 0x02, 0x9f, 0xa2, 0x0e,
+	0xc0, 0xb4, 0x5f, 0x89, 0xe2, 0xa7, 0xe1, 0x4c, 0x4b, 0xb4, 0xf1, 0xfa, 0x42, 0xba, 0x58, 0x17,
+	0xe5, 0x0e, 0xfa, 0x2e, 0x64, 0xfb, 0xc4, 0x41, 0xf1, 0x6a, 0x6e, 0xfa, 0x57, 0x82, 0x86, 0x3c,
+	0x4b, 0x08, 0xe6, 0xee, 0x49, 0xef, 0x49, 0xe8, 0x14, 0x4a, 0xe1, 0x33, 0x32, 0x7a, 0x10, 0x03,
+	0x27, 0x1f, 0xd9, 0x1b, 0xdb, 0x8b, 0xc8, 0xa1, 0x36, 0x3f, 0x80, 0x5a, 0xfc, 0x59, 0x1a, 0x29,
+	0xb1, 0x39, 0x73, 0x1f, 0xc0, 0x1b, 0xbb, 0x4b, 0x31, 0x21, 0xf3, 0x8f, 0xa1, 0xe8, 0x3f, 0x1d,
+	0xa3, 0x78, 0x98, 0xc6, 0x9f, 0xa5, 0x1b, 0xf7, 0xe7, 0x13, 0x43, 0x3e, 0x2d, 0x58, 0x09, 0xde,
+	0x71, 0xd1, 0xfd, 0xa4, 0x87, 0xa3, 0x2f, 0xa8, 0x8d, 0x07, 0x0b, 0xa8, 0x51, 0x56, 0xdd, 0xf1,
+	0x5c, 0x56, 0xdd, 0xf1, 0x32, 0x56, 0xc9, 0xe7, 0x53, 0xe5, 0x0e, 0x3a, 0x87, 0x4a, 0xf4, 0x15,
+	0x12, 0xed, 0x24, 0x65, 0x27, 0x5f, 0x49, 0x1b, 0x6f, 0x2c, 0x41, 0x44, 0x57, 0x24, 0x5e, 0xc6,
+	0x27, 0x56, 0x64, 0xee, 0xfd, 0xa2, 0xb1, 0xbb, 0x14, 0x13, 0x32, 0xbf, 0x80, 0xd5, 0x44, 0xe7,
+	0x0f, 0xed, 0x26, 0x0e, 0x90, 0x79, 0xdd, 0xeb, 0xc6, 0xc3, 0xe5, 0xa0, 0x64, 0x80, 0x86, 0x6f,
+	0x80, 0x68, 0x66, 0x41, 0x62, 0x77, 0x89, 0xc6, 0xf6, 0x22, 0x72, 0xa8, 0x71, 0x17, 0xaa, 0x2c,
+	0xbf, 0x79, 0xf8, 0xfa, 0x55, 0x71, 0xec, 0x43, 0x35, 0x1c, 0x66, 0x6f, 0x94, 0xe8, 0x8d, 0xf9,
+	0x53, 0x22, 0xef, 0x97, 0xb7, 0xe0, 0xaa, 0x42, 0x39, 0xf2, 0xf0, 0x87, 0xe2, 0x07, 0xc1, 0xec,
+	0x4b, 0x65, 0x63, 0x67, 0x31, 0x20, 0x1a, 0xac, 0x41, 0xe7, 0x2e, 0x11, 0xac, 0x89, 0x06, 0x62,
+	0xe3, 0xc1, 0x02, 0x6a, 0xc8, 0x4a, 0xe7, 0xcf, 0xd7, 0xb1, 0x47, 0x2b, 0xf4, 0x30, 0x69, 0xd4,
+	0xbc, 0xd7, 0xb4, 0xc6, 0x9b, 0x37, 0xa0, 0xa2, 0x22, 0xba, 0xe3, 0xa5, 0x22, 0xba, 0xe3, 0xdb,
+	0x88, 0x58, 0xf4, 0xb8, 0xa6, 0xdc, 0x41, 0xdf, 0x87, 0x6a, 0xec, 0x6e, 0x97, 0x58, 0xba, 0x79,
+	0xd7, 0xd5, 0x86, 0xb2, 0x0c, 0x12, 0xdd, 0x75, 0xf1, 0xab, 0x59, 0x62, 0xd7, 0xcd, 0xbd, 0x04,
+	0x36, 0x76, 0x97, 0x62, 0x42, 0xe6, 0x06, 0xac, 0xcd, 0x5c, 0x8d, 0x50, 0xdc, 0xe8, 0x45, 0x37,
+	0xb5, 0xc6, 0xa3, 0x9b, 0x60, 0xd1, 0x08, 0x8c, 0x5c, 0x50, 0xd0, 0x4c, 0x2a, 0x4a, 0x5c, 0x91,
+	0x1a, 0x3b, 0x8b, 0x01, 0x51, 0xcd, 0x67, 0xaa, 0x4b, 0x34, 0x13, 0x11, 0x73, 0xcb, 0xd9, 0xc6,
+	0xa3, 0x9b, 0x60, 0xd1, 0xc8, 0x49, 0x16, 0x70, 0x89, 0xc8, 0x59, 0x50, 0x5e, 0x36, 0xde, 0xbc,
+	0x01, 0x15, 0x8a, 0xf8, 0x02, 0xd6, 0xe7, 0x54, 0x55, 0xe8, 0xad, 0xd8, 0xfc, 0xc5, 0x45, 0x5f,
+	0x63, 0xef, 0x66, 0x60, 0x20, 0xeb, 0xb0, 0xfe, 0x97, 0xaf, 0xb7, 0xa5, 0xbf, 0x7e, 0xbd, 0x2d,
+	0xfd, 0xe3, 0xeb, 0x6d, 0xe9, 0x37, 0xff, 0xdc, 0xbe, 0x73, 0x51, 0xe0, 0xff, 0x86, 0x7c, 0xff,
+	0xdf, 0x03, 0x00, 0x54, 0xc0, 0xac, 0x2f, 0x62, 0x29, 0x00, 0x00,
 }
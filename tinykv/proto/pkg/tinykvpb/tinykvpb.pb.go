@@ -11,6 +11,8 @@ import (
 
 	_ "github.com/gogo/protobuf/gogoproto"
 
+	cdcpb "github.com/pingcap-incubator/tinykv/proto/pkg/cdcpb"
+
 	coprocessor "github.com/pingcap-incubator/tinykv/proto/pkg/coprocessor"
 
 	kvrpcpb "github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
@@ -52,16 +54,35 @@ type TinyKvClient interface {
 	KvCheckTxnStatus(ctx context.Context, in *kvrpcpb.CheckTxnStatusRequest, opts ...grpc.CallOption) (*kvrpcpb.CheckTxnStatusResponse, error)
 	KvBatchRollback(ctx context.Context, in *kvrpcpb.BatchRollbackRequest, opts ...grpc.CallOption) (*kvrpcpb.BatchRollbackResponse, error)
 	KvResolveLock(ctx context.Context, in *kvrpcpb.ResolveLockRequest, opts ...grpc.CallOption) (*kvrpcpb.ResolveLockResponse, error)
+	KvGc(ctx context.Context, in *kvrpcpb.GcRequest, opts ...grpc.CallOption) (*kvrpcpb.GcResponse, error)
+	KvScanLock(ctx context.Context, in *kvrpcpb.ScanLockRequest, opts ...grpc.CallOption) (*kvrpcpb.ScanLockResponse, error)
+	KvDeleteRange(ctx context.Context, in *kvrpcpb.DeleteRangeRequest, opts ...grpc.CallOption) (*kvrpcpb.DeleteRangeResponse, error)
+	KvPessimisticLock(ctx context.Context, in *kvrpcpb.PessimisticLockRequest, opts ...grpc.CallOption) (*kvrpcpb.PessimisticLockResponse, error)
+	KvPessimisticRollback(ctx context.Context, in *kvrpcpb.PessimisticRollbackRequest, opts ...grpc.CallOption) (*kvrpcpb.PessimisticRollbackResponse, error)
+	KvCheckSecondaryLocks(ctx context.Context, in *kvrpcpb.CheckSecondaryLocksRequest, opts ...grpc.CallOption) (*kvrpcpb.CheckSecondaryLocksResponse, error)
+	KvTxnHeartBeat(ctx context.Context, in *kvrpcpb.TxnHeartBeatRequest, opts ...grpc.CallOption) (*kvrpcpb.TxnHeartBeatResponse, error)
+	// Backup and restore.
+	Backup(ctx context.Context, in *kvrpcpb.BackupRequest, opts ...grpc.CallOption) (*kvrpcpb.BackupResponse, error)
+	Restore(ctx context.Context, in *kvrpcpb.RestoreRequest, opts ...grpc.CallOption) (*kvrpcpb.RestoreResponse, error)
 	// RawKV commands.
 	RawGet(ctx context.Context, in *kvrpcpb.RawGetRequest, opts ...grpc.CallOption) (*kvrpcpb.RawGetResponse, error)
 	RawPut(ctx context.Context, in *kvrpcpb.RawPutRequest, opts ...grpc.CallOption) (*kvrpcpb.RawPutResponse, error)
 	RawDelete(ctx context.Context, in *kvrpcpb.RawDeleteRequest, opts ...grpc.CallOption) (*kvrpcpb.RawDeleteResponse, error)
 	RawScan(ctx context.Context, in *kvrpcpb.RawScanRequest, opts ...grpc.CallOption) (*kvrpcpb.RawScanResponse, error)
+	RawBatchGet(ctx context.Context, in *kvrpcpb.RawBatchGetRequest, opts ...grpc.CallOption) (*kvrpcpb.RawBatchGetResponse, error)
+	RawBatchPut(ctx context.Context, in *kvrpcpb.RawBatchPutRequest, opts ...grpc.CallOption) (*kvrpcpb.RawBatchPutResponse, error)
+	RawBatchDelete(ctx context.Context, in *kvrpcpb.RawBatchDeleteRequest, opts ...grpc.CallOption) (*kvrpcpb.RawBatchDeleteResponse, error)
+	RawDeleteRange(ctx context.Context, in *kvrpcpb.RawDeleteRangeRequest, opts ...grpc.CallOption) (*kvrpcpb.RawDeleteRangeResponse, error)
+	RawCompareAndSwap(ctx context.Context, in *kvrpcpb.RawCASRequest, opts ...grpc.CallOption) (*kvrpcpb.RawCASResponse, error)
 	// Raft commands (tinykv <-> tinykv).
 	Raft(ctx context.Context, opts ...grpc.CallOption) (TinyKv_RaftClient, error)
 	Snapshot(ctx context.Context, opts ...grpc.CallOption) (TinyKv_SnapshotClient, error)
 	// Coprocessor
 	Coprocessor(ctx context.Context, in *coprocessor.Request, opts ...grpc.CallOption) (*coprocessor.Response, error)
+	// Change data capture.
+	EventFeed(ctx context.Context, opts ...grpc.CallOption) (TinyKv_EventFeedClient, error)
+	// Debug commands.
+	GetResolvedTs(ctx context.Context, in *kvrpcpb.GetResolvedTsRequest, opts ...grpc.CallOption) (*kvrpcpb.GetResolvedTsResponse, error)
 }
 
 type tinyKvClient struct {
@@ -135,6 +156,87 @@ func (c *tinyKvClient) KvResolveLock(ctx context.Context, in *kvrpcpb.ResolveLoc
 	return out, nil
 }
 
+func (c *tinyKvClient) KvGc(ctx context.Context, in *kvrpcpb.GcRequest, opts ...grpc.CallOption) (*kvrpcpb.GcResponse, error) {
+	out := new(kvrpcpb.GcResponse)
+	err := c.cc.Invoke(ctx, "/tinykvpb.TinyKv/KvGc", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tinyKvClient) KvScanLock(ctx context.Context, in *kvrpcpb.ScanLockRequest, opts ...grpc.CallOption) (*kvrpcpb.ScanLockResponse, error) {
+	out := new(kvrpcpb.ScanLockResponse)
+	err := c.cc.Invoke(ctx, "/tinykvpb.TinyKv/KvScanLock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tinyKvClient) KvDeleteRange(ctx context.Context, in *kvrpcpb.DeleteRangeRequest, opts ...grpc.CallOption) (*kvrpcpb.DeleteRangeResponse, error) {
+	out := new(kvrpcpb.DeleteRangeResponse)
+	err := c.cc.Invoke(ctx, "/tinykvpb.TinyKv/KvDeleteRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tinyKvClient) KvPessimisticLock(ctx context.Context, in *kvrpcpb.PessimisticLockRequest, opts ...grpc.CallOption) (*kvrpcpb.PessimisticLockResponse, error) {
+	out := new(kvrpcpb.PessimisticLockResponse)
+	err := c.cc.Invoke(ctx, "/tinykvpb.TinyKv/KvPessimisticLock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tinyKvClient) KvPessimisticRollback(ctx context.Context, in *kvrpcpb.PessimisticRollbackRequest, opts ...grpc.CallOption) (*kvrpcpb.PessimisticRollbackResponse, error) {
+	out := new(kvrpcpb.PessimisticRollbackResponse)
+	err := c.cc.Invoke(ctx, "/tinykvpb.TinyKv/KvPessimisticRollback", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tinyKvClient) KvCheckSecondaryLocks(ctx context.Context, in *kvrpcpb.CheckSecondaryLocksRequest, opts ...grpc.CallOption) (*kvrpcpb.CheckSecondaryLocksResponse, error) {
+	out := new(kvrpcpb.CheckSecondaryLocksResponse)
+	err := c.cc.Invoke(ctx, "/tinykvpb.TinyKv/KvCheckSecondaryLocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tinyKvClient) KvTxnHeartBeat(ctx context.Context, in *kvrpcpb.TxnHeartBeatRequest, opts ...grpc.CallOption) (*kvrpcpb.TxnHeartBeatResponse, error) {
+	out := new(kvrpcpb.TxnHeartBeatResponse)
+	err := c.cc.Invoke(ctx, "/tinykvpb.TinyKv/KvTxnHeartBeat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tinyKvClient) Backup(ctx context.Context, in *kvrpcpb.BackupRequest, opts ...grpc.CallOption) (*kvrpcpb.BackupResponse, error) {
+	out := new(kvrpcpb.BackupResponse)
+	err := c.cc.Invoke(ctx, "/tinykvpb.TinyKv/Backup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tinyKvClient) Restore(ctx context.Context, in *kvrpcpb.RestoreRequest, opts ...grpc.CallOption) (*kvrpcpb.RestoreResponse, error) {
+	out := new(kvrpcpb.RestoreResponse)
+	err := c.cc.Invoke(ctx, "/tinykvpb.TinyKv/Restore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tinyKvClient) RawGet(ctx context.Context, in *kvrpcpb.RawGetRequest, opts ...grpc.CallOption) (*kvrpcpb.RawGetResponse, error) {
 	out := new(kvrpcpb.RawGetResponse)
 	err := c.cc.Invoke(ctx, "/tinykvpb.TinyKv/RawGet", in, out, opts...)
@@ -171,6 +273,51 @@ func (c *tinyKvClient) RawScan(ctx context.Context, in *kvrpcpb.RawScanRequest,
 	return out, nil
 }
 
+func (c *tinyKvClient) RawBatchGet(ctx context.Context, in *kvrpcpb.RawBatchGetRequest, opts ...grpc.CallOption) (*kvrpcpb.RawBatchGetResponse, error) {
+	out := new(kvrpcpb.RawBatchGetResponse)
+	err := c.cc.Invoke(ctx, "/tinykvpb.TinyKv/RawBatchGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tinyKvClient) RawBatchPut(ctx context.Context, in *kvrpcpb.RawBatchPutRequest, opts ...grpc.CallOption) (*kvrpcpb.RawBatchPutResponse, error) {
+	out := new(kvrpcpb.RawBatchPutResponse)
+	err := c.cc.Invoke(ctx, "/tinykvpb.TinyKv/RawBatchPut", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tinyKvClient) RawBatchDelete(ctx context.Context, in *kvrpcpb.RawBatchDeleteRequest, opts ...grpc.CallOption) (*kvrpcpb.RawBatchDeleteResponse, error) {
+	out := new(kvrpcpb.RawBatchDeleteResponse)
+	err := c.cc.Invoke(ctx, "/tinykvpb.TinyKv/RawBatchDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tinyKvClient) RawDeleteRange(ctx context.Context, in *kvrpcpb.RawDeleteRangeRequest, opts ...grpc.CallOption) (*kvrpcpb.RawDeleteRangeResponse, error) {
+	out := new(kvrpcpb.RawDeleteRangeResponse)
+	err := c.cc.Invoke(ctx, "/tinykvpb.TinyKv/RawDeleteRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tinyKvClient) RawCompareAndSwap(ctx context.Context, in *kvrpcpb.RawCASRequest, opts ...grpc.CallOption) (*kvrpcpb.RawCASResponse, error) {
+	out := new(kvrpcpb.RawCASResponse)
+	err := c.cc.Invoke(ctx, "/tinykvpb.TinyKv/RawCompareAndSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tinyKvClient) Raft(ctx context.Context, opts ...grpc.CallOption) (TinyKv_RaftClient, error) {
 	stream, err := c.cc.NewStream(ctx, &_TinyKv_serviceDesc.Streams[0], "/tinykvpb.TinyKv/Raft", opts...)
 	if err != nil {
@@ -248,6 +395,46 @@ func (c *tinyKvClient) Coprocessor(ctx context.Context, in *coprocessor.Request,
 	return out, nil
 }
 
+func (c *tinyKvClient) EventFeed(ctx context.Context, opts ...grpc.CallOption) (TinyKv_EventFeedClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_TinyKv_serviceDesc.Streams[2], "/tinykvpb.TinyKv/EventFeed", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &tinyKvEventFeedClient{stream}
+	return x, nil
+}
+
+type TinyKv_EventFeedClient interface {
+	Send(*cdcpb.ChangeDataRequest) error
+	Recv() (*cdcpb.ChangeDataEvent, error)
+	grpc.ClientStream
+}
+
+type tinyKvEventFeedClient struct {
+	grpc.ClientStream
+}
+
+func (x *tinyKvEventFeedClient) Send(m *cdcpb.ChangeDataRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *tinyKvEventFeedClient) Recv() (*cdcpb.ChangeDataEvent, error) {
+	m := new(cdcpb.ChangeDataEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *tinyKvClient) GetResolvedTs(ctx context.Context, in *kvrpcpb.GetResolvedTsRequest, opts ...grpc.CallOption) (*kvrpcpb.GetResolvedTsResponse, error) {
+	out := new(kvrpcpb.GetResolvedTsResponse)
+	err := c.cc.Invoke(ctx, "/tinykvpb.TinyKv/GetResolvedTs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for TinyKv service
 
 type TinyKvServer interface {
@@ -259,16 +446,35 @@ type TinyKvServer interface {
 	KvCheckTxnStatus(context.Context, *kvrpcpb.CheckTxnStatusRequest) (*kvrpcpb.CheckTxnStatusResponse, error)
 	KvBatchRollback(context.Context, *kvrpcpb.BatchRollbackRequest) (*kvrpcpb.BatchRollbackResponse, error)
 	KvResolveLock(context.Context, *kvrpcpb.ResolveLockRequest) (*kvrpcpb.ResolveLockResponse, error)
+	KvGc(context.Context, *kvrpcpb.GcRequest) (*kvrpcpb.GcResponse, error)
+	KvScanLock(context.Context, *kvrpcpb.ScanLockRequest) (*kvrpcpb.ScanLockResponse, error)
+	KvDeleteRange(context.Context, *kvrpcpb.DeleteRangeRequest) (*kvrpcpb.DeleteRangeResponse, error)
+	KvPessimisticLock(context.Context, *kvrpcpb.PessimisticLockRequest) (*kvrpcpb.PessimisticLockResponse, error)
+	KvPessimisticRollback(context.Context, *kvrpcpb.PessimisticRollbackRequest) (*kvrpcpb.PessimisticRollbackResponse, error)
+	KvCheckSecondaryLocks(context.Context, *kvrpcpb.CheckSecondaryLocksRequest) (*kvrpcpb.CheckSecondaryLocksResponse, error)
+	KvTxnHeartBeat(context.Context, *kvrpcpb.TxnHeartBeatRequest) (*kvrpcpb.TxnHeartBeatResponse, error)
+	// Backup and restore.
+	Backup(context.Context, *kvrpcpb.BackupRequest) (*kvrpcpb.BackupResponse, error)
+	Restore(context.Context, *kvrpcpb.RestoreRequest) (*kvrpcpb.RestoreResponse, error)
 	// RawKV commands.
 	RawGet(context.Context, *kvrpcpb.RawGetRequest) (*kvrpcpb.RawGetResponse, error)
 	RawPut(context.Context, *kvrpcpb.RawPutRequest) (*kvrpcpb.RawPutResponse, error)
 	RawDelete(context.Context, *kvrpcpb.RawDeleteRequest) (*kvrpcpb.RawDeleteResponse, error)
 	RawScan(context.Context, *kvrpcpb.RawScanRequest) (*kvrpcpb.RawScanResponse, error)
+	RawBatchGet(context.Context, *kvrpcpb.RawBatchGetRequest) (*kvrpcpb.RawBatchGetResponse, error)
+	RawBatchPut(context.Context, *kvrpcpb.RawBatchPutRequest) (*kvrpcpb.RawBatchPutResponse, error)
+	RawBatchDelete(context.Context, *kvrpcpb.RawBatchDeleteRequest) (*kvrpcpb.RawBatchDeleteResponse, error)
+	RawDeleteRange(context.Context, *kvrpcpb.RawDeleteRangeRequest) (*kvrpcpb.RawDeleteRangeResponse, error)
+	RawCompareAndSwap(context.Context, *kvrpcpb.RawCASRequest) (*kvrpcpb.RawCASResponse, error)
 	// Raft commands (tinykv <-> tinykv).
 	Raft(TinyKv_RaftServer) error
 	Snapshot(TinyKv_SnapshotServer) error
 	// Coprocessor
 	Coprocessor(context.Context, *coprocessor.Request) (*coprocessor.Response, error)
+	// Change data capture.
+	EventFeed(TinyKv_EventFeedServer) error
+	// Debug commands.
+	GetResolvedTs(context.Context, *kvrpcpb.GetResolvedTsRequest) (*kvrpcpb.GetResolvedTsResponse, error)
 }
 
 func RegisterTinyKvServer(s *grpc.Server, srv TinyKvServer) {
@@ -401,6 +607,168 @@ func _TinyKv_KvResolveLock_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TinyKv_KvGc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.GcRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TinyKvServer).KvGc(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tinykvpb.TinyKv/KvGc",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TinyKvServer).KvGc(ctx, req.(*kvrpcpb.GcRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TinyKv_KvScanLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.ScanLockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TinyKvServer).KvScanLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tinykvpb.TinyKv/KvScanLock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TinyKvServer).KvScanLock(ctx, req.(*kvrpcpb.ScanLockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TinyKv_KvDeleteRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.DeleteRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TinyKvServer).KvDeleteRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tinykvpb.TinyKv/KvDeleteRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TinyKvServer).KvDeleteRange(ctx, req.(*kvrpcpb.DeleteRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TinyKv_KvPessimisticLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.PessimisticLockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TinyKvServer).KvPessimisticLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tinykvpb.TinyKv/KvPessimisticLock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TinyKvServer).KvPessimisticLock(ctx, req.(*kvrpcpb.PessimisticLockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TinyKv_KvPessimisticRollback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.PessimisticRollbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TinyKvServer).KvPessimisticRollback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tinykvpb.TinyKv/KvPessimisticRollback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TinyKvServer).KvPessimisticRollback(ctx, req.(*kvrpcpb.PessimisticRollbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TinyKv_KvCheckSecondaryLocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.CheckSecondaryLocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TinyKvServer).KvCheckSecondaryLocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tinykvpb.TinyKv/KvCheckSecondaryLocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TinyKvServer).KvCheckSecondaryLocks(ctx, req.(*kvrpcpb.CheckSecondaryLocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TinyKv_KvTxnHeartBeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.TxnHeartBeatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TinyKvServer).KvTxnHeartBeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tinykvpb.TinyKv/KvTxnHeartBeat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TinyKvServer).KvTxnHeartBeat(ctx, req.(*kvrpcpb.TxnHeartBeatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TinyKv_Backup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.BackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TinyKvServer).Backup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tinykvpb.TinyKv/Backup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TinyKvServer).Backup(ctx, req.(*kvrpcpb.BackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TinyKv_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.RestoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TinyKvServer).Restore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tinykvpb.TinyKv/Restore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TinyKvServer).Restore(ctx, req.(*kvrpcpb.RestoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TinyKv_RawGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(kvrpcpb.RawGetRequest)
 	if err := dec(in); err != nil {
@@ -473,6 +841,96 @@ func _TinyKv_RawScan_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TinyKv_RawBatchGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.RawBatchGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TinyKvServer).RawBatchGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tinykvpb.TinyKv/RawBatchGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TinyKvServer).RawBatchGet(ctx, req.(*kvrpcpb.RawBatchGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TinyKv_RawBatchPut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.RawBatchPutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TinyKvServer).RawBatchPut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tinykvpb.TinyKv/RawBatchPut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TinyKvServer).RawBatchPut(ctx, req.(*kvrpcpb.RawBatchPutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TinyKv_RawBatchDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.RawBatchDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TinyKvServer).RawBatchDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tinykvpb.TinyKv/RawBatchDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TinyKvServer).RawBatchDelete(ctx, req.(*kvrpcpb.RawBatchDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TinyKv_RawDeleteRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.RawDeleteRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TinyKvServer).RawDeleteRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tinykvpb.TinyKv/RawDeleteRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TinyKvServer).RawDeleteRange(ctx, req.(*kvrpcpb.RawDeleteRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TinyKv_RawCompareAndSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.RawCASRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TinyKvServer).RawCompareAndSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tinykvpb.TinyKv/RawCompareAndSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TinyKvServer).RawCompareAndSwap(ctx, req.(*kvrpcpb.RawCASRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TinyKv_Raft_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(TinyKvServer).Raft(&tinyKvRaftServer{stream})
 }
@@ -543,6 +1001,50 @@ func _TinyKv_Coprocessor_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TinyKv_EventFeed_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(TinyKvServer).EventFeed(&tinyKvEventFeedServer{stream})
+}
+
+type TinyKv_EventFeedServer interface {
+	Send(*cdcpb.ChangeDataEvent) error
+	Recv() (*cdcpb.ChangeDataRequest, error)
+	grpc.ServerStream
+}
+
+type tinyKvEventFeedServer struct {
+	grpc.ServerStream
+}
+
+func (x *tinyKvEventFeedServer) Send(m *cdcpb.ChangeDataEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *tinyKvEventFeedServer) Recv() (*cdcpb.ChangeDataRequest, error) {
+	m := new(cdcpb.ChangeDataRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _TinyKv_GetResolvedTs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.GetResolvedTsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TinyKvServer).GetResolvedTs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tinykvpb.TinyKv/GetResolvedTs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TinyKvServer).GetResolvedTs(ctx, req.(*kvrpcpb.GetResolvedTsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _TinyKv_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "tinykvpb.TinyKv",
 	HandlerType: (*TinyKvServer)(nil),
@@ -575,6 +1077,42 @@ var _TinyKv_serviceDesc = grpc.ServiceDesc{
 			MethodName: "KvResolveLock",
 			Handler:    _TinyKv_KvResolveLock_Handler,
 		},
+		{
+			MethodName: "KvGc",
+			Handler:    _TinyKv_KvGc_Handler,
+		},
+		{
+			MethodName: "KvScanLock",
+			Handler:    _TinyKv_KvScanLock_Handler,
+		},
+		{
+			MethodName: "KvDeleteRange",
+			Handler:    _TinyKv_KvDeleteRange_Handler,
+		},
+		{
+			MethodName: "KvPessimisticLock",
+			Handler:    _TinyKv_KvPessimisticLock_Handler,
+		},
+		{
+			MethodName: "KvPessimisticRollback",
+			Handler:    _TinyKv_KvPessimisticRollback_Handler,
+		},
+		{
+			MethodName: "KvCheckSecondaryLocks",
+			Handler:    _TinyKv_KvCheckSecondaryLocks_Handler,
+		},
+		{
+			MethodName: "KvTxnHeartBeat",
+			Handler:    _TinyKv_KvTxnHeartBeat_Handler,
+		},
+		{
+			MethodName: "Backup",
+			Handler:    _TinyKv_Backup_Handler,
+		},
+		{
+			MethodName: "Restore",
+			Handler:    _TinyKv_Restore_Handler,
+		},
 		{
 			MethodName: "RawGet",
 			Handler:    _TinyKv_RawGet_Handler,
@@ -591,10 +1129,34 @@ var _TinyKv_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RawScan",
 			Handler:    _TinyKv_RawScan_Handler,
 		},
+		{
+			MethodName: "RawBatchGet",
+			Handler:    _TinyKv_RawBatchGet_Handler,
+		},
+		{
+			MethodName: "RawBatchPut",
+			Handler:    _TinyKv_RawBatchPut_Handler,
+		},
+		{
+			MethodName: "RawBatchDelete",
+			Handler:    _TinyKv_RawBatchDelete_Handler,
+		},
+		{
+			MethodName: "RawDeleteRange",
+			Handler:    _TinyKv_RawDeleteRange_Handler,
+		},
+		{
+			MethodName: "RawCompareAndSwap",
+			Handler:    _TinyKv_RawCompareAndSwap_Handler,
+		},
 		{
 			MethodName: "Coprocessor",
 			Handler:    _TinyKv_Coprocessor_Handler,
 		},
+		{
+			MethodName: "GetResolvedTs",
+			Handler:    _TinyKv_GetResolvedTs_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -607,41 +1169,66 @@ var _TinyKv_serviceDesc = grpc.ServiceDesc{
 			Handler:       _TinyKv_Snapshot_Handler,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "EventFeed",
+			Handler:       _TinyKv_EventFeed_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "tinykvpb.proto",
 }
 
-func init() { proto.RegisterFile("tinykvpb.proto", fileDescriptor_tinykvpb_71a6ae942ac295c5) }
-
-var fileDescriptor_tinykvpb_71a6ae942ac295c5 = []byte{
-	// 452 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0xdb, 0x6e, 0xd3, 0x30,
-	0x18, 0xc7, 0x5b, 0x09, 0xba, 0x62, 0x34, 0x18, 0x6e, 0x81, 0x2d, 0x8c, 0x20, 0xed, 0x8a, 0xab,
-	0x22, 0x01, 0x12, 0x17, 0x1c, 0x24, 0x96, 0x4a, 0xbb, 0xf0, 0x90, 0x2a, 0x77, 0x5c, 0x23, 0xd7,
-	0xfa, 0xd6, 0x56, 0xe9, 0xec, 0x60, 0x3b, 0x2e, 0x7d, 0x13, 0x9e, 0x83, 0xa7, 0xe0, 0x92, 0x47,
-	0x40, 0xe5, 0x45, 0x50, 0x92, 0xd9, 0x39, 0x34, 0xbd, 0xb3, 0x7f, 0xff, 0x83, 0x5b, 0xc7, 0x1f,
-	0x7a, 0x60, 0x96, 0x62, 0x13, 0xdb, 0x64, 0x36, 0x4a, 0x94, 0x34, 0x12, 0xf7, 0xdd, 0x3e, 0x38,
-	0x8c, 0xad, 0x4a, 0xb8, 0x13, 0x82, 0x81, 0x62, 0xd7, 0xe6, 0x9b, 0x06, 0x65, 0x41, 0x79, 0xf8,
-	0x88, 0xcb, 0x44, 0x49, 0x0e, 0x5a, 0x4b, 0x75, 0x8b, 0x86, 0x73, 0x39, 0x97, 0xf9, 0xf2, 0x55,
-	0xb6, 0x2a, 0xe8, 0xeb, 0x5f, 0x07, 0xa8, 0x77, 0xb5, 0x14, 0x1b, 0x62, 0xf1, 0x5b, 0x74, 0x97,
-	0xd8, 0x0b, 0x30, 0x78, 0x30, 0x72, 0x27, 0x5c, 0x80, 0xa1, 0xf0, 0x3d, 0x05, 0x6d, 0x82, 0x61,
-	0x1d, 0xea, 0x44, 0x0a, 0x0d, 0x67, 0x1d, 0xfc, 0x0e, 0xf5, 0x88, 0x9d, 0x72, 0x26, 0x70, 0xe9,
-	0xc8, 0xb6, 0x2e, 0xf7, 0xb8, 0x41, 0x7d, 0x30, 0x42, 0x88, 0xd8, 0x89, 0x82, 0xb5, 0x5a, 0x1a,
-	0xc0, 0xc7, 0xde, 0xe6, 0x90, 0x2b, 0x38, 0x69, 0x51, 0x7c, 0xc9, 0x47, 0xd4, 0x27, 0x36, 0x92,
-	0x37, 0x37, 0x4b, 0x83, 0x9f, 0x78, 0x63, 0x01, 0x5c, 0xc1, 0xd3, 0x1d, 0xee, 0xe3, 0x5f, 0xd1,
-	0x11, 0xb1, 0xd1, 0x02, 0x78, 0x7c, 0xf5, 0x43, 0x4c, 0x0d, 0x33, 0xa9, 0xc6, 0x61, 0x69, 0xaf,
-	0x09, 0xae, 0xee, 0xc5, 0x5e, 0xdd, 0xd7, 0x52, 0xf4, 0x90, 0xd8, 0x73, 0x66, 0xf8, 0x82, 0xca,
-	0xd5, 0x6a, 0xc6, 0x78, 0x8c, 0x9f, 0xfb, 0x54, 0x8d, 0xbb, 0xd2, 0x70, 0x9f, 0xec, 0x3b, 0x2f,
-	0xd1, 0x21, 0xb1, 0x14, 0xb4, 0x5c, 0x59, 0xb8, 0x94, 0x3c, 0xc6, 0xcf, 0x7c, 0xa4, 0x42, 0x5d,
-	0xdf, 0x69, 0xbb, 0xe8, 0xdb, 0xde, 0xa3, 0x1e, 0x65, 0xeb, 0xec, 0x63, 0x97, 0xb7, 0x56, 0x80,
-	0xdd, 0x5b, 0x73, 0xbc, 0x11, 0x9e, 0xa4, 0x8d, 0xf0, 0x24, 0x6d, 0x0f, 0xe7, 0xdc, 0x87, 0xc7,
-	0xe8, 0x1e, 0x65, 0xeb, 0x31, 0xac, 0xc0, 0x00, 0x3e, 0xa9, 0xfa, 0x0a, 0xe6, 0x2a, 0x82, 0x36,
-	0xc9, 0xb7, 0x7c, 0x42, 0x07, 0x94, 0xad, 0xf3, 0x67, 0x57, 0x3b, 0xab, 0xfa, 0xf2, 0x8e, 0x77,
-	0x85, 0xca, 0x5f, 0xb8, 0x43, 0xd9, 0xb5, 0xc1, 0xc1, 0xa8, 0x3e, 0x3d, 0x19, 0xfc, 0x02, 0x5a,
-	0xb3, 0x39, 0x04, 0x83, 0x86, 0x36, 0x96, 0x02, 0xce, 0x3a, 0x2f, 0xbb, 0xf8, 0x33, 0xea, 0x4f,
-	0x05, 0x4b, 0xf4, 0x42, 0x1a, 0x7c, 0xda, 0x30, 0x39, 0x21, 0x5a, 0xa4, 0x22, 0xde, 0x5f, 0xf1,
-	0x01, 0xdd, 0x8f, 0xca, 0x09, 0xc5, 0xc3, 0x51, 0x75, 0x5e, 0xcb, 0xd1, 0xa9, 0x53, 0xf7, 0xeb,
-	0xcf, 0x8f, 0x7e, 0x6f, 0xc3, 0xee, 0x9f, 0x6d, 0xd8, 0xfd, 0xbb, 0x0d, 0xbb, 0x3f, 0xff, 0x85,
-	0x9d, 0x59, 0x2f, 0x9f, 0xe6, 0x37, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0xc2, 0x6c, 0xc6, 0xd2,
-	0x36, 0x04, 0x00, 0x00,
+func init() { proto.RegisterFile("tinykvpb.proto", fileDescriptor_tinykvpb_3a890eb43ed9ac40) }
+
+var fileDescriptor_tinykvpb_3a890eb43ed9ac40 = []byte{
+	// 761 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x56, 0xcb, 0x6e, 0xdb, 0x38,
+	0x14, 0x8d, 0x81, 0x8c, 0x27, 0x61, 0x90, 0x4c, 0x4c, 0xe7, 0xe1, 0x38, 0x13, 0x65, 0x90, 0xe9,
+	0xa2, 0x2b, 0xf7, 0x09, 0x74, 0xd1, 0x07, 0x10, 0xcb, 0x4d, 0x8a, 0x2a, 0x45, 0x05, 0xd9, 0x05,
+	0xba, 0x2b, 0x18, 0xf9, 0xc6, 0x36, 0x6c, 0x8b, 0xaa, 0x48, 0xd1, 0xc9, 0x47, 0x74, 0xdf, 0x4f,
+	0xea, 0xb2, 0x9f, 0x50, 0xa4, 0x3f, 0x52, 0x48, 0x0a, 0x29, 0x52, 0x92, 0xb3, 0x93, 0xce, 0xb9,
+	0xe7, 0x5c, 0x92, 0xf7, 0xea, 0x52, 0x68, 0x8b, 0x4f, 0x82, 0x9b, 0xa9, 0x08, 0x2f, 0x3b, 0x61,
+	0x44, 0x39, 0xc5, 0x6b, 0xf2, 0xbd, 0xbd, 0x39, 0x15, 0x51, 0xe8, 0x4b, 0xa2, 0xdd, 0x8c, 0xc8,
+	0x15, 0xff, 0xc2, 0x20, 0x12, 0x10, 0x29, 0xb0, 0xe1, 0xd3, 0x30, 0xa2, 0x3e, 0x30, 0x46, 0xa3,
+	0x3b, 0x68, 0xc3, 0x1f, 0xe6, 0xa2, 0x9d, 0x11, 0x1d, 0xd1, 0xf4, 0xf1, 0x51, 0xf2, 0x94, 0xa1,
+	0x4f, 0xbf, 0x35, 0x50, 0x7d, 0x30, 0x09, 0x6e, 0x1c, 0x81, 0x9f, 0xa3, 0xbf, 0x1c, 0x71, 0x0e,
+	0x1c, 0x37, 0x3b, 0x32, 0xdd, 0x39, 0x70, 0x0f, 0xbe, 0xc6, 0xc0, 0x78, 0x7b, 0xc7, 0x04, 0x59,
+	0x48, 0x03, 0x06, 0x27, 0x2b, 0xf8, 0x05, 0xaa, 0x3b, 0xa2, 0xef, 0x93, 0x00, 0xe7, 0x11, 0xc9,
+	0xab, 0xd4, 0xed, 0x16, 0x50, 0x25, 0xb4, 0x11, 0x72, 0x84, 0x1b, 0xc1, 0x22, 0x9a, 0x70, 0xc0,
+	0x2d, 0x15, 0x26, 0x21, 0x69, 0x70, 0x50, 0xc1, 0x28, 0x93, 0xd7, 0x68, 0xcd, 0x11, 0x36, 0x9d,
+	0xcf, 0x27, 0x1c, 0xef, 0xa9, 0xc0, 0x0c, 0x90, 0x06, 0xfb, 0x25, 0x5c, 0xc9, 0x3f, 0xa1, 0x6d,
+	0x47, 0xd8, 0x63, 0xf0, 0xa7, 0x83, 0xeb, 0xa0, 0xcf, 0x09, 0x8f, 0x19, 0xb6, 0xf2, 0x70, 0x83,
+	0x90, 0x76, 0xc7, 0x4b, 0x79, 0x65, 0xeb, 0xa1, 0x7f, 0x1c, 0xd1, 0x25, 0xdc, 0x1f, 0x7b, 0x74,
+	0x36, 0xbb, 0x24, 0xfe, 0x14, 0x1f, 0x29, 0x95, 0x81, 0x4b, 0x53, 0x6b, 0x19, 0xad, 0x3c, 0x2f,
+	0xd0, 0xa6, 0x23, 0x3c, 0x60, 0x74, 0x26, 0xe0, 0x82, 0xfa, 0x53, 0x7c, 0xa8, 0x24, 0x1a, 0x2a,
+	0xfd, 0xfe, 0xad, 0x26, 0x95, 0xdb, 0x13, 0xb4, 0xea, 0x88, 0x73, 0x1f, 0xe3, 0xbc, 0xaa, 0xbe,
+	0xd4, 0x36, 0x0d, 0xcc, 0xac, 0x57, 0x52, 0xc3, 0x34, 0x7b, 0xcb, 0x28, 0xab, 0x9e, 0xfa, 0xa0,
+	0x82, 0x31, 0x77, 0xd1, 0x83, 0x19, 0x70, 0xf0, 0x48, 0x30, 0x02, 0x6d, 0x17, 0x1a, 0x5a, 0xde,
+	0x85, 0x41, 0x2a, 0xb7, 0xcf, 0xa8, 0xe1, 0x08, 0x17, 0x18, 0x9b, 0xcc, 0x27, 0x8c, 0x4f, 0xfc,
+	0x74, 0x65, 0x79, 0x7d, 0x0a, 0x8c, 0x74, 0xfd, 0x6f, 0x79, 0x80, 0x72, 0x1e, 0xa2, 0x5d, 0xc3,
+	0x59, 0xd5, 0xf1, 0xff, 0x2a, 0x71, 0xb1, 0x9a, 0x0f, 0xee, 0x0f, 0x32, 0xb3, 0xa4, 0x5d, 0xd4,
+	0x07, 0x9f, 0x06, 0x43, 0x12, 0xdd, 0x24, 0xeb, 0x60, 0x5a, 0x96, 0x0a, 0xb6, 0x9c, 0xa5, 0x32,
+	0x48, 0x65, 0xf9, 0x88, 0xb6, 0x1c, 0x31, 0xb8, 0x0e, 0xde, 0x01, 0x89, 0x78, 0x17, 0x08, 0xc7,
+	0xf9, 0xb9, 0xea, 0xb0, 0xf4, 0x3d, 0x5a, 0xc2, 0x2a, 0xc3, 0x97, 0xa8, 0xde, 0x25, 0xfe, 0x34,
+	0x0e, 0xb5, 0x4f, 0x2e, 0x03, 0xca, 0x9f, 0x9c, 0xc4, 0x95, 0xf8, 0x0d, 0xfa, 0xdb, 0x03, 0xc6,
+	0x69, 0x04, 0x78, 0x5f, 0x6f, 0xd2, 0x04, 0x91, 0xf2, 0x56, 0x99, 0xd0, 0x93, 0x7b, 0x64, 0x91,
+	0x8c, 0xa9, 0x3c, 0x79, 0x06, 0x94, 0x93, 0x4b, 0xbc, 0x20, 0x76, 0xe3, 0x82, 0xd8, 0x8d, 0xab,
+	0xc5, 0x6e, 0xac, 0x8b, 0x7b, 0x68, 0xdd, 0x23, 0x8b, 0xac, 0x13, 0xf1, 0x81, 0x1e, 0x97, 0x61,
+	0xd2, 0xa2, 0x5d, 0x45, 0x19, 0xfb, 0x27, 0x8b, 0x74, 0x60, 0x1a, 0xb9, 0xf4, 0x99, 0xd9, 0x2a,
+	0x13, 0x4a, 0xff, 0x1e, 0x6d, 0x78, 0x64, 0x91, 0x4e, 0x89, 0xe4, 0x10, 0x0e, 0xf5, 0x50, 0x89,
+	0x56, 0x4c, 0x01, 0x9d, 0xac, 0xf2, 0x72, 0xe3, 0x2a, 0x2f, 0x37, 0xbe, 0xc7, 0xcb, 0x3c, 0x9d,
+	0x3e, 0xda, 0x92, 0xc4, 0xdd, 0x11, 0x59, 0x25, 0x85, 0x79, 0x4e, 0xc7, 0x4b, 0xf9, 0x82, 0xa9,
+	0x3e, 0x2f, 0xac, 0x8a, 0xc3, 0xd5, 0x47, 0xc6, 0xf1, 0x52, 0x5e, 0x99, 0x9e, 0xa1, 0x86, 0x47,
+	0x16, 0x36, 0x9d, 0x87, 0x24, 0x82, 0xd3, 0x60, 0xd8, 0x5f, 0x90, 0xd0, 0xec, 0x07, 0xfb, 0xb4,
+	0x5f, 0xd9, 0x0f, 0x29, 0xae, 0x35, 0xd3, 0xaa, 0x47, 0xae, 0x38, 0x6e, 0x77, 0xcc, 0xeb, 0x38,
+	0x01, 0x3f, 0x00, 0x63, 0x64, 0x04, 0xed, 0x66, 0x81, 0xeb, 0xd1, 0x00, 0x4e, 0x56, 0x1e, 0xd6,
+	0xf0, 0x29, 0x5a, 0xeb, 0x07, 0x24, 0x64, 0x63, 0x9a, 0x7c, 0x8e, 0x66, 0x90, 0x24, 0xec, 0x71,
+	0x1c, 0x4c, 0x97, 0x5b, 0xbc, 0x42, 0x1b, 0x76, 0x7e, 0xe5, 0xe3, 0x9d, 0x8e, 0xfe, 0x03, 0x90,
+	0x5f, 0xbf, 0x26, 0xaa, 0x8d, 0xf3, 0xf5, 0xb7, 0x02, 0x02, 0x7e, 0x06, 0x30, 0xc4, 0xad, 0x4e,
+	0xf6, 0xa7, 0x60, 0x8f, 0x93, 0xb3, 0xea, 0x11, 0x4e, 0xa4, 0x7e, 0xaf, 0xc4, 0xa4, 0xaa, 0x64,
+	0x01, 0x8f, 0x6b, 0xd8, 0x45, 0x9b, 0x59, 0x47, 0x25, 0x57, 0xcc, 0x70, 0xc0, 0xb4, 0x6b, 0xce,
+	0xc0, 0xcb, 0xd7, 0x5c, 0x81, 0x96, 0xcb, 0xea, 0x6e, 0xff, 0xb8, 0xb5, 0x6a, 0x3f, 0x6f, 0xad,
+	0xda, 0xaf, 0x5b, 0xab, 0xf6, 0xfd, 0xb7, 0xb5, 0x72, 0x59, 0x4f, 0x7f, 0x54, 0x9e, 0xfd, 0x19,
+	0x00, 0x34, 0x74, 0x87, 0x71, 0x1e, 0x09, 0x00, 0x00,
 }
@@ -95,6 +95,9 @@ by setting the NodeId field to zero before calling ApplyConfChange
 (but ApplyConfChange must be called one way or the other, and the decision to cancel
 must be based solely on the state machine and not external information such as
 the observed health of the node).
+An Entry of Type EntryType_EntryConfChangeV2 is applied by Node.ApplyConfChangeV2()
+in the same way, it changes several nodes at once through a joint configuration,
+and an empty ConfChangeV2 leaves the joint configuration.
 
 4. Call Node.Advance() to signal readiness for the next batch of updates.
 This may be done at any time after step 1, although all updates must be processed
@@ -0,0 +1,254 @@
+// Copyright 2019 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raft
+
+import (
+	"fmt"
+	"sort"
+
+	pb "github.com/pingcap-incubator/tinykv/proto/pkg/eraftpb"
+	"github.com/pingcap/log"
+)
+
+// voteResult indicates the outcome of a vote.
+type voteResult uint8
+
+const (
+	// votePending indicates that the decision of the vote depends on future
+	// votes, i.e. neither "yes" or "no" has reached quorum yet.
+	votePending voteResult = 1 + iota
+	// voteLost indicates that the quorum has voted "no".
+	voteLost
+	// voteWon indicates that the quorum has voted "yes".
+	voteWon
+)
+
+// isJoint returns true if the raft group is in a joint configuration.
+func (r *Raft) isJoint() bool {
+	return r.outgoing != nil
+}
+
+// voterSets returns the voters of the configuration, or the voters of the
+// incoming and the outgoing configuration if the raft group is in a joint
+// configuration. A decision needs the majority of every returned set.
+func (r *Raft) voterSets() []map[uint64]bool {
+	if r.isJoint() {
+		return []map[uint64]bool{r.incoming, r.outgoing}
+	}
+	voters := make(map[uint64]bool, len(r.Prs))
+	for id, pr := range r.Prs {
+		if !pr.IsLearner {
+			voters[id] = true
+		}
+	}
+	return []map[uint64]bool{voters}
+}
+
+// committedIndex returns the largest index that has been replicated to a
+// majority of every voter set.
+func (r *Raft) committedIndex() uint64 {
+	var committed uint64
+	first := true
+	for _, voters := range r.voterSets() {
+		if len(voters) == 0 {
+			continue
+		}
+		matchIndex := make(uint64Slice, 0, len(voters))
+		for id := range voters {
+			matchIndex = append(matchIndex, r.Prs[id].Match)
+		}
+		sort.Sort(matchIndex)
+		mci := matchIndex[len(matchIndex)-(len(voters)/2+1)]
+		if first || mci < committed {
+			committed = mci
+		}
+		first = false
+	}
+	return committed
+}
+
+// voteResult tallies the given votes, which may be the votes of an election
+// or the acknowledgments of a read index request. The vote is won only if it
+// is won in every voter set, and lost as soon as it is lost in one of them.
+func (r *Raft) voteResult(votes map[uint64]bool) voteResult {
+	result := voteWon
+	for _, voters := range r.voterSets() {
+		granted, missing := 0, 0
+		for id := range voters {
+			v, ok := votes[id]
+			if !ok {
+				missing++
+			} else if v {
+				granted++
+			}
+		}
+		q := len(voters)/2 + 1
+		if granted >= q {
+			continue
+		}
+		if granted+missing >= q {
+			result = votePending
+			continue
+		}
+		return voteLost
+	}
+	return result
+}
+
+// applyConfChangeV2 applies a ConfChangeV2. An empty change leaves the joint
+// configuration, a change of a single node is made as a simple one when the
+// transition is ConfChangeTransition_Auto, any other change enters a joint
+// configuration.
+func (r *Raft) applyConfChangeV2(cc pb.ConfChangeV2) {
+	if len(cc.Changes) == 0 {
+		r.leaveJoint()
+		return
+	}
+	if cc.Transition == pb.ConfChangeTransition_Auto && len(cc.Changes) == 1 {
+		switch c := cc.Changes[0]; c.ChangeType {
+		case pb.ConfChangeType_AddNode:
+			r.addNode(c.NodeId)
+		case pb.ConfChangeType_AddLearnerNode:
+			r.addLearner(c.NodeId)
+		case pb.ConfChangeType_RemoveNode:
+			r.removeNode(c.NodeId)
+		default:
+			panic("unexpected conf type")
+		}
+		return
+	}
+	r.enterJoint(cc.Transition != pb.ConfChangeTransition_Explicit, cc.Changes)
+}
+
+// enterJoint makes the current voters the outgoing configuration, and applies
+// the changes to the incoming configuration. From now on a decision needs the
+// majority of both configurations, until the joint configuration is left.
+func (r *Raft) enterJoint(autoLeave bool, changes []*pb.ConfChangeSingle) {
+	if r.isJoint() {
+		panic(fmt.Sprintf("%d cannot enter a joint configuration when already in a joint configuration", r.id))
+	}
+	voters := r.voterSets()[0]
+	r.outgoing = voters
+	r.incoming = make(map[uint64]bool, len(voters))
+	for id := range voters {
+		r.incoming[id] = true
+	}
+	r.autoLeave = autoLeave
+
+	// Don't try to commit until all the changes are applied, the quorum of a
+	// half-applied incoming configuration means nothing.
+	for _, c := range changes {
+		switch c.ChangeType {
+		case pb.ConfChangeType_AddNode:
+			r.addNodeOrLearnerNode(c.NodeId, false)
+		case pb.ConfChangeType_AddLearnerNode:
+			r.addNodeOrLearnerNode(c.NodeId, true)
+		case pb.ConfChangeType_RemoveNode:
+			r.removeNodeOnly(c.NodeId)
+		default:
+			panic("unexpected conf type")
+		}
+	}
+	if len(r.incoming) == 0 {
+		panic(fmt.Sprintf("%d cannot remove all the voters of the incoming configuration", r.id))
+	}
+	log.Info(fmt.Sprintf("%d entered joint configuration [incoming: %v, outgoing: %v, autoLeave: %v]",
+		r.id, nodes(r), sortedNodes(r.outgoing), r.autoLeave))
+	r.commitAfterConfChange()
+	if r.State == StateLeader && r.leadTransferee != None && !r.incoming[r.leadTransferee] {
+		r.abortLeaderTransfer()
+	}
+}
+
+// leaveJoint drops the outgoing configuration, the voters which are not in the
+// incoming configuration are removed from the raft group.
+func (r *Raft) leaveJoint() {
+	if !r.isJoint() {
+		log.Warn(fmt.Sprintf("%d ignored leaving joint configuration: not in a joint configuration", r.id))
+		return
+	}
+	for id := range r.outgoing {
+		if r.incoming[id] {
+			continue
+		}
+		delete(r.Prs, id)
+		if r.State == StateLeader && r.leadTransferee == id {
+			r.abortLeaderTransfer()
+		}
+	}
+	r.incoming, r.outgoing, r.autoLeave = nil, nil, false
+	log.Info(fmt.Sprintf("%d left joint configuration [voters: %v]", r.id, nodes(r)))
+	r.commitAfterConfChange()
+}
+
+// maybeAutoLeaveJoint proposes an empty ConfChangeV2 to leave the joint
+// configuration once the leader has applied the entry entering it, if the
+// joint configuration is left automatically.
+func (r *Raft) maybeAutoLeaveJoint() {
+	if r.State != StateLeader || !r.isJoint() || !r.autoLeave || r.PendingConfIndex > r.RaftLog.applied {
+		return
+	}
+	data, err := (&pb.ConfChangeV2{}).Marshal()
+	if err != nil {
+		panic(err)
+	}
+	ent := pb.Entry{EntryType: pb.EntryType_EntryConfChangeV2, Data: data}
+	if err := r.Step(pb.Message{MsgType: pb.MessageType_MsgPropose, Entries: []*pb.Entry{&ent}}); err != nil {
+		log.Debug(fmt.Sprintf("%d failed to propose leaving joint configuration: %v", r.id, err))
+		return
+	}
+	log.Info(fmt.Sprintf("%d proposed leaving joint configuration automatically", r.id))
+}
+
+// restoreJoint restores the joint configuration, if any, from the ConfState.
+// The progress of all the nodes must have been restored.
+func (r *Raft) restoreJoint(cs *pb.ConfState) {
+	if len(cs.VotersOutgoing) == 0 {
+		r.incoming, r.outgoing, r.autoLeave = nil, nil, false
+		return
+	}
+	r.incoming = make(map[uint64]bool, len(cs.Nodes))
+	for _, id := range cs.Nodes {
+		r.incoming[id] = true
+	}
+	r.outgoing = make(map[uint64]bool, len(cs.VotersOutgoing))
+	for _, id := range cs.VotersOutgoing {
+		r.outgoing[id] = true
+	}
+	r.autoLeave = cs.AutoLeave
+}
+
+// confState returns the current configuration of the raft group.
+func (r *Raft) confState() *pb.ConfState {
+	cs := &pb.ConfState{Nodes: nodes(r), Learners: learnerNodes(r)}
+	if r.isJoint() {
+		cs.VotersOutgoing = sortedNodes(r.outgoing)
+		cs.AutoLeave = r.autoLeave
+	}
+	return cs
+}
+
+// isLeaveJointEntry returns true if the entry is an empty ConfChangeV2, which
+// leaves the joint configuration.
+func isLeaveJointEntry(e *pb.Entry) bool {
+	if e.EntryType != pb.EntryType_EntryConfChangeV2 {
+		return false
+	}
+	var cc pb.ConfChangeV2
+	if err := cc.Unmarshal(e.Data); err != nil {
+		return false
+	}
+	return len(cc.Changes) == 0
+}
@@ -18,7 +18,6 @@ import (
 	"errors"
 	"fmt"
 	"math/rand"
-	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -37,14 +36,32 @@ const (
 	StateFollower StateType = iota
 	StateCandidate
 	StateLeader
+	StatePreCandidate
 )
 
 var stmap = [...]string{
 	"StateFollower",
 	"StateCandidate",
 	"StateLeader",
+	"StatePreCandidate",
 }
 
+// CampaignType represents the type of campaigning
+// the reason we use the type of string instead of uint64
+// is because it's simpler to compare and fill in raft entries
+type CampaignType string
+
+const (
+	// campaignPreElection represents the first phase of a normal election when
+	// Config.PreVote is true.
+	campaignPreElection CampaignType = "CampaignPreElection"
+	// campaignElection represents a normal (time-based) election (the second phase
+	// of the election when Config.PreVote is true).
+	campaignElection CampaignType = "CampaignElection"
+	// campaignTransfer represents the type of leader transfer
+	campaignTransfer CampaignType = "CampaignTransfer"
+)
+
 func (st StateType) String() string {
 	return stmap[uint64(st)]
 }
@@ -83,6 +100,11 @@ type Config struct {
 	// used for testing right now.
 	peers []uint64
 
+	// learners contains the IDs of all learner nodes (including self if the
+	// local node is a learner) in the raft cluster. learners only receives
+	// entries from the leader node. It does not vote or promote itself.
+	learners []uint64
+
 	// ElectionTick is the number of Node.Tick invocations that must pass between
 	// elections. That is, if a follower does not receive any message from the
 	// leader of current term before ElectionTick has elapsed, it will become
@@ -105,6 +127,11 @@ type Config struct {
 	// Applied. If Applied is unset when restarting, raft might return previous
 	// applied entries. This is a very application dependent configuration.
 	Applied uint64
+
+	// PreVote enables the Pre-Vote algorithm described in raft thesis section
+	// 9.6. This prevents disruption when a node that has been partitioned away
+	// rejoins the cluster.
+	PreVote bool
 }
 
 func (c *Config) validate() error {
@@ -171,12 +198,39 @@ type Raft struct {
 	// value.
 	PendingConfIndex uint64
 
+	// In a joint configuration (raft thesis section 4.3), incoming and
+	// outgoing are the voters of the new and the old configuration, a
+	// decision needs the majority of both of them. They're nil if the raft
+	// group is not in a joint configuration, then the voters are the
+	// non-learner nodes in Prs.
+	incoming map[uint64]bool
+	outgoing map[uint64]bool
+	// autoLeave is true if the leader leaves the joint configuration by
+	// itself once the entry entering it is applied.
+	autoLeave bool
+
 	// number of ticks since it reached last electionTimeout
 	electionElapsed int
 
 	// number of ticks since it reached last heartbeatTimeout.
 	// only leader keeps heartbeatElapsed.
 	heartbeatElapsed int
+
+	// preVote indicates whether a candidate asks for pre-votes before
+	// increasing its term, see Config.PreVote.
+	preVote bool
+
+	// readOnly tracks the read index requests waiting for the leadership
+	// confirmation.
+	readOnly *readOnly
+	// readStates are the confirmed read index requests, they are handed to
+	// the application through Ready.
+	readStates []ReadState
+	// pendingReadIndexMessages is used to store messages of type MessageType_MsgReadIndex
+	// that can't be answered as new leader didn't committed any log in
+	// current term. Those will be handled as fast as first log is committed in
+	// current term.
+	pendingReadIndexMessages []pb.Message
 }
 
 // newRaft return a raft peer with the given config
@@ -190,11 +244,18 @@ func newRaft(c *Config) *Raft {
 		panic(err)
 	}
 	peers := c.peers
-	if len(cs.Nodes) > 0 {
-		if len(peers) > 0 {
-			panic("cannot specify both newRaft (peers) and ConfState.(Nodes)")
+	learners := c.learners
+	if len(cs.Nodes) > 0 || len(cs.Learners) > 0 {
+		if len(peers) > 0 || len(learners) > 0 {
+			panic("cannot specify both newRaft (peers, learners) and ConfState.(Nodes, Learners)")
 		}
 		peers = cs.Nodes
+		learners = cs.Learners
+		for _, id := range cs.VotersOutgoing {
+			if !containsID(peers, id) {
+				peers = append(peers, id)
+			}
+		}
 	}
 	r := &Raft{
 		id:               c.ID,
@@ -203,10 +264,19 @@ func newRaft(c *Config) *Raft {
 		Prs:              make(map[uint64]*Progress),
 		electionTimeout:  c.ElectionTick,
 		heartbeatTimeout: c.HeartbeatTick,
+		preVote:          c.PreVote,
+		readOnly:         newReadOnly(),
 	}
 	for _, p := range peers {
 		r.Prs[p] = &Progress{Next: 1}
 	}
+	for _, p := range learners {
+		if _, ok := r.Prs[p]; ok {
+			panic(fmt.Sprintf("node %x is in both learner and peer list", p))
+		}
+		r.Prs[p] = &Progress{Next: 1, IsLearner: true}
+	}
+	r.restoreJoint(&cs)
 
 	if !IsEmptyHardState(hs) {
 		r.loadState(hs)
@@ -220,9 +290,13 @@ func newRaft(c *Config) *Raft {
 	for _, n := range nodes(r) {
 		nodesStrs = append(nodesStrs, fmt.Sprintf("%d", n))
 	}
+	var learnersStrs []string
+	for _, n := range learnerNodes(r) {
+		learnersStrs = append(learnersStrs, fmt.Sprintf("%d", n))
+	}
 
-	log.Info(fmt.Sprintf("newRaft %d [peers: [%s], term: %d, commit: %d, applied: %d, lastindex: %d, lastterm: %d]",
-		r.id, strings.Join(nodesStrs, ","), r.Term, r.RaftLog.committed, r.RaftLog.applied, r.RaftLog.LastIndex(), r.RaftLog.lastTerm()))
+	log.Info(fmt.Sprintf("newRaft %d [peers: [%s], learners: [%s], term: %d, commit: %d, applied: %d, lastindex: %d, lastterm: %d]",
+		r.id, strings.Join(nodesStrs, ","), strings.Join(learnersStrs, ","), r.Term, r.RaftLog.committed, r.RaftLog.applied, r.RaftLog.LastIndex(), r.RaftLog.lastTerm()))
 	return r
 }
 
@@ -244,28 +318,47 @@ func (r *Raft) hardState() pb.HardState {
 	}
 }
 
-func (r *Raft) quorum() int { return len(r.Prs)/2 + 1 }
+// quorum only counts the voters, learners never take part in the election
+// or the commit decision.
+func (r *Raft) quorum() int { return r.voterCount()/2 + 1 }
+
+func (r *Raft) voterCount() int {
+	n := 0
+	for _, pr := range r.Prs {
+		if !pr.IsLearner {
+			n++
+		}
+	}
+	return n
+}
 
 // send persists state to stable storage and then sends to its mailbox.
 func (r *Raft) send(m pb.Message) {
 	m.From = r.id
-	if m.MsgType == pb.MessageType_MsgRequestVote || m.MsgType == pb.MessageType_MsgRequestVoteResponse {
+	if m.MsgType == pb.MessageType_MsgRequestVote || m.MsgType == pb.MessageType_MsgRequestVoteResponse ||
+		m.MsgType == pb.MessageType_MsgRequestPreVote || m.MsgType == pb.MessageType_MsgRequestPreVoteResponse {
 		if m.Term == 0 {
 			// All campaign messages need to have the term set when sending.
 			// - MessageType_MsgRequestVote: m.Term is the term the node is campaigning for,
 			//   non-zero as we increment the term when campaigning.
 			// - MessageType_MsgRequestVoteResponse: m.Term is the new r.Term if the MessageType_MsgRequestVote was
 			//   granted, non-zero for the same reason MessageType_MsgRequestVote is
+			// - MessageType_MsgRequestPreVote: m.Term is the term the node will campaign,
+			//   non-zero as we use m.Term to indicate the next term we'll be
+			//   campaigning for
+			// - MessageType_MsgRequestPreVoteResponse: m.Term is the term received in the original
+			//   MessageType_MsgRequestPreVote in the case of a granted pre-vote, and r.Term otherwise.
 			panic(fmt.Sprintf("term should be set when sending %s", m.MsgType))
 		}
 	} else {
 		if m.Term != 0 {
 			panic(fmt.Sprintf("term should not be set when sending %s (was %d)", m.MsgType, m.Term))
 		}
-		// do not attach term to MessageType_MsgPropose
+		// do not attach term to MessageType_MsgPropose, MessageType_MsgReadIndex
 		// proposals are a way to forward to the leader and
 		// should be treated as local message.
-		if m.MsgType != pb.MessageType_MsgPropose {
+		// MessageType_MsgReadIndex is also forwarded to leader.
+		if m.MsgType != pb.MessageType_MsgPropose && m.MsgType != pb.MessageType_MsgReadIndex {
 			m.Term = r.Term
 		}
 	}
@@ -321,7 +414,7 @@ func (r *Raft) sendAppend(to uint64) bool {
 }
 
 // sendHeartbeat sends a heartbeat RPC to the given peer.
-func (r *Raft) sendHeartbeat(to uint64) {
+func (r *Raft) sendHeartbeat(to uint64, ctx []byte) {
 	// Attach the commit as min(to.matched, r.committed).
 	// When the leader sends out heartbeat message,
 	// the receiver(follower) might not be matched with the leader
@@ -333,6 +426,7 @@ func (r *Raft) sendHeartbeat(to uint64) {
 		To:      to,
 		MsgType: pb.MessageType_MsgHeartbeat,
 		Commit:  commit,
+		Context: ctx,
 	}
 
 	r.send(m)
@@ -358,11 +452,20 @@ func (r *Raft) bcastAppend() {
 
 // bcastHeartbeat sends RPC, without entries to all the peers.
 func (r *Raft) bcastHeartbeat() {
+	lastCtx := r.readOnly.lastPendingRequestCtx()
+	if len(lastCtx) == 0 {
+		r.bcastHeartbeatWithCtx(nil)
+	} else {
+		r.bcastHeartbeatWithCtx([]byte(lastCtx))
+	}
+}
+
+func (r *Raft) bcastHeartbeatWithCtx(ctx []byte) {
 	r.forEachProgress(func(id uint64, _ *Progress) {
 		if id == r.id {
 			return
 		}
-		r.sendHeartbeat(id)
+		r.sendHeartbeat(id, ctx)
 	})
 }
 
@@ -370,15 +473,7 @@ func (r *Raft) bcastHeartbeat() {
 // the commit index changed (in which case the caller should call
 // r.bcastAppend).
 func (r *Raft) maybeCommit() bool {
-	matchIndex := make(uint64Slice, len(r.Prs))
-	idx := 0
-	for _, p := range r.Prs {
-		matchIndex[idx] = p.Match
-		idx++
-	}
-	sort.Sort(matchIndex)
-	mci := matchIndex[len(matchIndex)-r.quorum()]
-	return r.RaftLog.maybeCommit(mci, r.Term)
+	return r.RaftLog.maybeCommit(r.committedIndex(), r.Term)
 }
 
 func (r *Raft) reset(term uint64) {
@@ -396,13 +491,15 @@ func (r *Raft) reset(term uint64) {
 
 	r.votes = make(map[uint64]bool)
 	r.forEachProgress(func(id uint64, pr *Progress) {
-		*pr = Progress{Next: r.RaftLog.LastIndex() + 1}
+		*pr = Progress{Next: r.RaftLog.LastIndex() + 1, IsLearner: pr.IsLearner}
 		if id == r.id {
 			pr.Match = r.RaftLog.LastIndex()
 		}
 	})
 
 	r.PendingConfIndex = 0
+	r.readOnly = newReadOnly()
+	r.pendingReadIndexMessages = nil
 }
 
 func (r *Raft) appendEntry(es ...pb.Entry) {
@@ -421,7 +518,7 @@ func (r *Raft) appendEntry(es ...pb.Entry) {
 // tick advances the internal logical clock by a single tick.
 func (r *Raft) tick() {
 	switch r.State {
-	case StateFollower, StateCandidate:
+	case StateFollower, StateCandidate, StatePreCandidate:
 		r.tickElection()
 	case StateLeader:
 		r.tickHeartbeat()
@@ -477,6 +574,19 @@ func (r *Raft) becomeCandidate() {
 	log.Info(fmt.Sprintf("%d became candidate at term %d", r.id, r.Term))
 }
 
+// becomePreCandidate transform this peer's state to pre-candidate
+func (r *Raft) becomePreCandidate() {
+	if r.State == StateLeader {
+		panic("invalid transition [leader -> pre-candidate]")
+	}
+	// Becoming a pre-candidate changes our state, but doesn't change anything
+	// else. In particular it does not increase r.Term or change r.Vote.
+	r.votes = make(map[uint64]bool)
+	r.Lead = None
+	r.State = StatePreCandidate
+	log.Info(fmt.Sprintf("%d became pre-candidate at term %d", r.id, r.Term))
+}
+
 // becomeLeader transform this peer's state to leader
 func (r *Raft) becomeLeader() {
 	// NOTE: Leader should propose a noop entry on its term
@@ -496,19 +606,32 @@ func (r *Raft) becomeLeader() {
 	log.Info(fmt.Sprintf("%d became leader at term %d", r.id, r.Term))
 }
 
-func (r *Raft) campaign() {
-	r.becomeCandidate()
-	voteMsg := pb.MessageType_MsgRequestVote
-	term := r.Term
+func (r *Raft) campaign(t CampaignType) {
+	var term uint64
+	var voteMsg pb.MessageType
+	if t == campaignPreElection {
+		r.becomePreCandidate()
+		voteMsg = pb.MessageType_MsgRequestPreVote
+		// PreVote RPCs are sent for the next term before we've incremented r.Term.
+		term = r.Term + 1
+	} else {
+		r.becomeCandidate()
+		voteMsg = pb.MessageType_MsgRequestVote
+		term = r.Term
+	}
 
-	if r.quorum() == r.poll(r.id, pb.MessageType_MsgRequestVoteResponse, true) {
+	if _, _, res := r.poll(r.id, voteRespMsgType(voteMsg), true); res == voteWon {
 		// We won the election after voting for ourselves (which must mean that
 		// this is a single-node cluster). Advance to the next state.
-		r.becomeLeader()
+		if t == campaignPreElection {
+			r.campaign(campaignElection)
+		} else {
+			r.becomeLeader()
+		}
 		return
 	}
-	for id := range r.Prs {
-		if id == r.id {
+	for id, pr := range r.Prs {
+		if id == r.id || pr.IsLearner {
 			continue
 		}
 		log.Info(fmt.Sprintf("%d [logterm: %d, index: %d] sent %s request to %d at term %d",
@@ -518,21 +641,39 @@ func (r *Raft) campaign() {
 	}
 }
 
-func (r *Raft) poll(id uint64, t pb.MessageType, v bool) (granted int) {
+// voteRespMsgType maps vote and prevote message types to their corresponding responses.
+func voteRespMsgType(msgt pb.MessageType) pb.MessageType {
+	switch msgt {
+	case pb.MessageType_MsgRequestVote:
+		return pb.MessageType_MsgRequestVoteResponse
+	case pb.MessageType_MsgRequestPreVote:
+		return pb.MessageType_MsgRequestPreVoteResponse
+	default:
+		panic(fmt.Sprintf("not a vote message: %s", msgt))
+	}
+}
+
+func (r *Raft) poll(id uint64, t pb.MessageType, v bool) (granted int, rejected int, result voteResult) {
 	if v {
 		log.Info(fmt.Sprintf("%d received %s from %d at term %d", r.id, t, id, r.Term))
 	} else {
 		log.Info(fmt.Sprintf("%d received %s rejection from %d at term %d", r.id, t, id, r.Term))
 	}
+	if pr := r.getProgress(id); pr != nil && pr.IsLearner {
+		// The vote of a learner is never counted.
+		v = false
+	}
 	if _, ok := r.votes[id]; !ok {
 		r.votes[id] = v
 	}
 	for _, vv := range r.votes {
 		if vv {
 			granted++
+		} else {
+			rejected++
 		}
 	}
-	return granted
+	return granted, rejected, r.voteResult(r.votes)
 }
 
 // Step the entrance of handle message, see `MessageType`
@@ -543,21 +684,64 @@ func (r *Raft) Step(m pb.Message) error {
 	case m.Term == 0:
 		// local message
 	case m.Term > r.Term:
-		log.Info(fmt.Sprintf("%d [term: %d] received a %s message with higher term from %d [term: %d]",
-			r.id, r.Term, m.MsgType, m.From, m.Term))
-		if m.MsgType == pb.MessageType_MsgAppend || m.MsgType == pb.MessageType_MsgHeartbeat || m.MsgType == pb.MessageType_MsgSnapshot {
-			r.becomeFollower(m.Term, m.From)
-		} else {
-			r.becomeFollower(m.Term, None)
+		if m.MsgType == pb.MessageType_MsgRequestPreVote && r.inLease() {
+			// If a server receives a pre-vote request within the minimum election timeout
+			// of hearing from a current leader, it does not grant the pre-vote. This keeps
+			// a node that rejoins after a partition from disrupting a healthy leader.
+			log.Info(fmt.Sprintf("%d [logterm: %d, index: %d, vote: %d] rejected %s from %d [logterm: %d, index: %d] at term %d: lease is not expired (remaining ticks: %d)",
+				r.id, r.RaftLog.lastTerm(), r.RaftLog.LastIndex(), r.Vote, m.MsgType, m.From, m.LogTerm, m.Index, r.Term, r.electionTimeout-r.electionElapsed))
+			r.send(pb.Message{To: m.From, Term: r.Term, MsgType: pb.MessageType_MsgRequestPreVoteResponse, Reject: true})
+			return nil
+		}
+		switch {
+		case m.MsgType == pb.MessageType_MsgRequestPreVote:
+			// Never change our term in response to a PreVote
+		case m.MsgType == pb.MessageType_MsgRequestPreVoteResponse && !m.Reject:
+			// We send pre-vote requests with a term in our future. If the
+			// pre-vote is granted, we will increment our term when we get a
+			// quorum. If it is not, the term comes from the node that
+			// rejected our vote so we should become a follower at the new
+			// term.
+		default:
+			log.Info(fmt.Sprintf("%d [term: %d] received a %s message with higher term from %d [term: %d]",
+				r.id, r.Term, m.MsgType, m.From, m.Term))
+			if m.MsgType == pb.MessageType_MsgAppend || m.MsgType == pb.MessageType_MsgHeartbeat || m.MsgType == pb.MessageType_MsgSnapshot {
+				r.becomeFollower(m.Term, m.From)
+			} else {
+				r.becomeFollower(m.Term, None)
+			}
 		}
 	case m.Term < r.Term:
-		log.Info(fmt.Sprintf("%d [term: %d] ignored a %s message with lower term from %d [term: %d]", r.id, r.Term, m.MsgType, m.From, m.Term))
+		if r.preVote && (m.MsgType == pb.MessageType_MsgHeartbeat || m.MsgType == pb.MessageType_MsgAppend) {
+			// We have received messages from a leader at a lower term. It is possible
+			// that these messages were simply delayed in the network, but this could
+			// also mean that this node has advanced its term number during a network
+			// partition, and it is now unable to either win an election or to rejoin
+			// the majority on the old term. Without PreVote this would be handled by
+			// incrementing term numbers in response to MessageType_MsgRequestVote with
+			// a higher term, but with PreVote the other nodes never advance their term,
+			// so we reply with our term and let the stale leader step down.
+			r.send(pb.Message{To: m.From, MsgType: pb.MessageType_MsgAppendResponse})
+		} else if m.MsgType == pb.MessageType_MsgRequestPreVote {
+			// Before PreVote is enabled, there may be a candidate with higher term
+			// but less log. After PreVote is enabled, the cluster may deadlock if
+			// we drop messages with a lower term.
+			log.Info(fmt.Sprintf("%d [logterm: %d, index: %d, vote: %d] rejected %s from %d [logterm: %d, index: %d] at term %d",
+				r.id, r.RaftLog.lastTerm(), r.RaftLog.LastIndex(), r.Vote, m.MsgType, m.From, m.LogTerm, m.Index, r.Term))
+			r.send(pb.Message{To: m.From, Term: r.Term, MsgType: pb.MessageType_MsgRequestPreVoteResponse, Reject: true})
+		} else {
+			log.Info(fmt.Sprintf("%d [term: %d] ignored a %s message with lower term from %d [term: %d]", r.id, r.Term, m.MsgType, m.From, m.Term))
+		}
 		return nil
 	}
 
 	switch m.MsgType {
 	case pb.MessageType_MsgHup:
 		if r.State != StateLeader {
+			if !r.promotable() {
+				log.Warn(fmt.Sprintf("%d is unpromotable and can not campaign", r.id))
+				return nil
+			}
 			ents, err := r.RaftLog.slice(r.RaftLog.applied+1, r.RaftLog.committed+1)
 			if err != nil {
 				log.Fatal(fmt.Sprintf("unexpected error getting unapplied entries (%v)", err))
@@ -569,28 +753,43 @@ func (r *Raft) Step(m pb.Message) error {
 
 			log.Info(fmt.Sprintf("%d is starting a new election at term %d", r.id, r.Term))
 
-			r.campaign()
+			if r.preVote {
+				r.campaign(campaignPreElection)
+			} else {
+				r.campaign(campaignElection)
+			}
 		} else {
 			log.Debug(fmt.Sprintf("%d ignoring MessageType_MsgHup because already leader", r.id))
 		}
 
-	case pb.MessageType_MsgRequestVote:
+	case pb.MessageType_MsgRequestVote, pb.MessageType_MsgRequestPreVote:
 		// We can vote if this is a repeat of a vote we've already cast...
 		canVote := r.Vote == m.From ||
 			// ...we haven't voted and we don't think there's a leader yet in this term...
-			(r.Vote == None && r.Lead == None)
+			(r.Vote == None && r.Lead == None) ||
+			// ...or this is a PreVote for a future term...
+			(m.MsgType == pb.MessageType_MsgRequestPreVote && m.Term > r.Term)
 		// ...and we believe the candidate is up to date.
 		if canVote && r.RaftLog.isUpToDate(m.Index, m.LogTerm) {
 			log.Info(fmt.Sprintf("%d [logterm: %d, index: %d, vote: %d] cast %s for %d [logterm: %d, index: %d] at term %d",
 				r.id, r.RaftLog.lastTerm(), r.RaftLog.LastIndex(), r.Vote, m.MsgType, m.From, m.LogTerm, m.Index, r.Term))
-			r.send(pb.Message{To: m.From, Term: m.Term, MsgType: pb.MessageType_MsgRequestVoteResponse})
-			// Only record real votes.
-			r.electionElapsed = 0
-			r.Vote = m.From
+			// When responding to Msg{Pre,}Vote messages we include the term
+			// from the message, not the local term. To see why, consider the
+			// case where a single node was previously partitioned away and
+			// its local term is now out of date. If we include the local term
+			// (recall that for pre-votes we don't update the local term), the
+			// (pre-)campaigning node on the other end will proceed to ignore
+			// the message (it ignores all out of date messages).
+			r.send(pb.Message{To: m.From, Term: m.Term, MsgType: voteRespMsgType(m.MsgType)})
+			if m.MsgType == pb.MessageType_MsgRequestVote {
+				// Only record real votes.
+				r.electionElapsed = 0
+				r.Vote = m.From
+			}
 		} else {
 			log.Info(fmt.Sprintf("%d [logterm: %d, index: %d, vote: %d] rejected %s from %d [logterm: %d, index: %d] at term %d",
 				r.id, r.RaftLog.lastTerm(), r.RaftLog.LastIndex(), r.Vote, m.MsgType, m.From, m.LogTerm, m.Index, r.Term))
-			r.send(pb.Message{To: m.From, Term: r.Term, MsgType: pb.MessageType_MsgRequestVoteResponse, Reject: true})
+			r.send(pb.Message{To: m.From, Term: r.Term, MsgType: voteRespMsgType(m.MsgType), Reject: true})
 		}
 
 	default:
@@ -600,7 +799,7 @@ func (r *Raft) Step(m pb.Message) error {
 			if err != nil {
 				return err
 			}
-		case StateCandidate:
+		case StateCandidate, StatePreCandidate:
 			err := r.stepCandidate(m)
 			if err != nil {
 				return err
@@ -620,7 +819,8 @@ type stepFunc func(r *Raft, m pb.Message) error
 // stepLeader handle leader's message
 func (r *Raft) stepLeader(m pb.Message) error {
 	pr := r.getProgress(m.From)
-	if pr == nil && m.MsgType != pb.MessageType_MsgBeat && m.MsgType != pb.MessageType_MsgPropose {
+	if pr == nil && m.MsgType != pb.MessageType_MsgBeat && m.MsgType != pb.MessageType_MsgPropose &&
+		m.MsgType != pb.MessageType_MsgReadIndex {
 		log.Debug(fmt.Sprintf("%d no progress available for %d", r.id, m.From))
 		return nil
 	}
@@ -644,14 +844,22 @@ func (r *Raft) stepLeader(m pb.Message) error {
 		}
 
 		for i, e := range m.Entries {
-			if e.EntryType == pb.EntryType_EntryConfChange {
-				if r.PendingConfIndex > r.RaftLog.applied {
-					log.Info(fmt.Sprintf("propose conf %s ignored since pending unapplied configuration [index %d, applied %d]",
-						e.String(), r.PendingConfIndex, r.RaftLog.applied))
-					m.Entries[i] = &pb.Entry{EntryType: pb.EntryType_EntryNormal}
-				} else {
-					r.PendingConfIndex = r.RaftLog.LastIndex() + uint64(i) + 1
-				}
+			if e.EntryType != pb.EntryType_EntryConfChange && e.EntryType != pb.EntryType_EntryConfChangeV2 {
+				continue
+			}
+			var refused string
+			if r.PendingConfIndex > r.RaftLog.applied {
+				refused = fmt.Sprintf("pending unapplied configuration [index %d, applied %d]", r.PendingConfIndex, r.RaftLog.applied)
+			} else if leaveJoint := isLeaveJointEntry(e); r.isJoint() && !leaveJoint {
+				refused = "the joint configuration must be left first"
+			} else if !r.isJoint() && leaveJoint {
+				refused = "not in a joint configuration"
+			}
+			if refused != "" {
+				log.Info(fmt.Sprintf("propose conf %s ignored since %s", e.String(), refused))
+				m.Entries[i] = &pb.Entry{EntryType: pb.EntryType_EntryNormal}
+			} else {
+				r.PendingConfIndex = r.RaftLog.LastIndex() + uint64(i) + 1
 			}
 		}
 
@@ -663,6 +871,19 @@ func (r *Raft) stepLeader(m pb.Message) error {
 		r.appendEntry(es...)
 		r.bcastAppend()
 		return nil
+	case pb.MessageType_MsgReadIndex:
+		// If more than the local vote is needed, go through a full broadcast.
+		if r.voteResult(map[uint64]bool{r.id: true}) != voteWon {
+			// Reject read only request when this leader has not committed any log entry at its term.
+			if !r.committedEntryInCurrentTerm() {
+				r.pendingReadIndexMessages = append(r.pendingReadIndexMessages, m)
+				return nil
+			}
+			r.sendMsgReadIndexResponse(m)
+			return nil
+		}
+		r.responseToReadIndexReq(m, r.RaftLog.committed)
+		return nil
 	case pb.MessageType_MsgAppendResponse:
 		if m.Reject {
 			log.Debug(fmt.Sprintf("%d received MessageType_MsgAppend rejection(lastindex: %d) from %d for index %d",
@@ -674,6 +895,9 @@ func (r *Raft) stepLeader(m pb.Message) error {
 			if pr.maybeUpdate(m.Index) {
 
 				if r.maybeCommit() {
+					// committed index has progressed for the term, so it is safe
+					// to respond to pending read index requests
+					r.releasePendingReadIndexMessages()
 					r.bcastAppend()
 				}
 				// Transfer leadership is in progress.
@@ -687,7 +911,21 @@ func (r *Raft) stepLeader(m pb.Message) error {
 		if pr.Match < r.RaftLog.LastIndex() {
 			r.sendAppend(m.From)
 		}
+
+		if len(m.Context) == 0 || pr.IsLearner {
+			return nil
+		}
+		if r.voteResult(r.readOnly.recvAck(m.From, m.Context)) != voteWon {
+			return nil
+		}
+		for _, rs := range r.readOnly.advance(m) {
+			r.responseToReadIndexReq(rs.req, rs.index)
+		}
 	case pb.MessageType_MsgTransferLeader:
+		if pr.IsLearner {
+			log.Debug(fmt.Sprintf("%d is learner. Ignored transferring leadership", r.id))
+			return nil
+		}
 		leadTransferee := m.From
 		lastLeadTransferee := r.leadTransferee
 		if lastLeadTransferee != None {
@@ -718,8 +956,17 @@ func (r *Raft) stepLeader(m pb.Message) error {
 	return nil
 }
 
-// stepCandidate handle candidate's message
+// stepCandidate handle candidate's and pre-candidate's message
 func (r *Raft) stepCandidate(m pb.Message) error {
+	// Only handle vote responses corresponding to our candidacy (while in
+	// StateCandidate, we may get stale MessageType_MsgRequestPreVoteResponse
+	// messages in this term from our pre-candidate state).
+	var myVoteRespType pb.MessageType
+	if r.State == StatePreCandidate {
+		myVoteRespType = pb.MessageType_MsgRequestPreVoteResponse
+	} else {
+		myVoteRespType = pb.MessageType_MsgRequestVoteResponse
+	}
 	switch m.MsgType {
 	case pb.MessageType_MsgPropose:
 		log.Info(fmt.Sprintf("%d no leader at term %d; dropping proposal", r.id, r.Term))
@@ -733,14 +980,18 @@ func (r *Raft) stepCandidate(m pb.Message) error {
 	case pb.MessageType_MsgSnapshot:
 		r.becomeFollower(m.Term, m.From) // always m.Term == r.Term
 		r.handleSnapshot(m)
-	case pb.MessageType_MsgRequestVoteResponse:
-		gr := r.poll(m.From, m.MsgType, !m.Reject)
-		log.Info(fmt.Sprintf("%d [quorum:%d] has received %d %s votes and %d vote rejections", r.id, r.quorum(), gr, m.MsgType, len(r.votes)-gr))
-		switch r.quorum() {
-		case gr:
-			r.becomeLeader()
-			r.bcastAppend()
-		case len(r.votes) - gr:
+	case myVoteRespType:
+		gr, rj, res := r.poll(m.From, m.MsgType, !m.Reject)
+		log.Info(fmt.Sprintf("%d has received %d %s votes and %d vote rejections", r.id, gr, m.MsgType, rj))
+		switch res {
+		case voteWon:
+			if r.State == StatePreCandidate {
+				r.campaign(campaignElection)
+			} else {
+				r.becomeLeader()
+				r.bcastAppend()
+			}
+		case voteLost:
 			// m.Term > r.Term; reuse r.Term
 			r.becomeFollower(r.Term, None)
 		}
@@ -775,10 +1026,26 @@ func (r *Raft) stepFollower(m pb.Message) error {
 		}
 		m.To = r.Lead
 		r.send(m)
+	case pb.MessageType_MsgReadIndex:
+		if r.Lead == None {
+			log.Info(fmt.Sprintf("%d no leader at term %d; dropping index reading msg", r.id, r.Term))
+			return nil
+		}
+		m.To = r.Lead
+		r.send(m)
+	case pb.MessageType_MsgReadIndexResp:
+		if len(m.Entries) != 1 {
+			log.Error(fmt.Sprintf("%d invalid format of MessageType_MsgReadIndexResp from %d, entries count: %d", r.id, m.From, len(m.Entries)))
+			return nil
+		}
+		r.readStates = append(r.readStates, ReadState{Index: m.Index, RequestCtx: m.Entries[0].Data})
 	case pb.MessageType_MsgTimeoutNow:
 		if r.promotable() {
 			log.Info(fmt.Sprintf("%d [term %d] received MessageType_MsgTimeoutNow from %d and starts an election to get leadership.", r.id, r.Term, m.From))
-			r.campaign()
+			// Leadership transfers never use pre-vote even if r.preVote is true; we
+			// know we are not recovering from a partition so there is no need for the
+			// extra round trip.
+			r.campaign(campaignTransfer)
 		} else {
 			log.Info(fmt.Sprintf("%d received MessageType_MsgTimeoutNow from %d but is not promotable", r.id, m.From))
 		}
@@ -809,7 +1076,7 @@ func (r *Raft) handleAppendEntries(m pb.Message) {
 // handleHeartbeat handle Heartbeat RPC request
 func (r *Raft) handleHeartbeat(m pb.Message) {
 	r.RaftLog.commitTo(m.Commit)
-	r.send(pb.Message{To: m.From, MsgType: pb.MessageType_MsgHeartbeatResponse})
+	r.send(pb.Message{To: m.From, MsgType: pb.MessageType_MsgHeartbeatResponse, Context: m.Context})
 }
 
 // handleSnapshot handle Snapshot RPC request
@@ -844,59 +1111,113 @@ func (r *Raft) restore(s pb.Snapshot) bool {
 
 	r.RaftLog.restore(s)
 	r.Prs = make(map[uint64]*Progress)
-	r.restoreNode(s.Metadata.ConfState.Nodes)
+	cs := s.Metadata.ConfState
+	r.restoreNode(cs.Nodes, false)
+	for _, id := range cs.VotersOutgoing {
+		if !containsID(cs.Nodes, id) {
+			r.restoreNode([]uint64{id}, false)
+		}
+	}
+	r.restoreNode(cs.Learners, true)
+	r.restoreJoint(cs)
 	return true
 }
 
-func (r *Raft) restoreNode(nodes []uint64) {
+func (r *Raft) restoreNode(nodes []uint64, isLearner bool) {
 	for _, n := range nodes {
 		match, next := uint64(0), r.RaftLog.LastIndex()+1
 		if n == r.id {
 			match = next - 1
 		}
-		r.setProgress(n, match, next)
+		r.setProgress(n, match, next, isLearner)
 		log.Info(fmt.Sprintf("%d restored progress of %d [%+v]", r.id, n, r.getProgress(n)))
 	}
 }
 
 // promotable indicates whether state machine can be promoted to Leader,
-// which is true when its own id is in progress list.
+// which is true when its own id is in progress list and it's not a learner.
 func (r *Raft) promotable() bool {
-	_, ok := r.Prs[r.id]
-	return ok
+	pr := r.getProgress(r.id)
+	return pr != nil && !pr.IsLearner
 }
 
-// addNode add a new node to raft group
+// addNode add a new node to raft group, or promotes a learner to a voter.
 func (r *Raft) addNode(id uint64) {
-	if r.getProgress(id) == nil {
-		r.setProgress(id, 0, r.RaftLog.LastIndex()+1)
-	} else {
+	r.addNodeOrLearnerNode(id, false)
+}
+
+// addLearner add a new learner to raft group
+func (r *Raft) addLearner(id uint64) {
+	r.addNodeOrLearnerNode(id, true)
+}
+
+func (r *Raft) addNodeOrLearnerNode(id uint64, isLearner bool) {
+	pr := r.getProgress(id)
+	if r.isJoint() && !isLearner {
+		r.incoming[id] = true
+	}
+	if pr == nil {
+		r.setProgress(id, 0, r.RaftLog.LastIndex()+1, isLearner)
+		return
+	}
+	if isLearner && !pr.IsLearner {
+		// Can only change Learner to Voter.
+		log.Info(fmt.Sprintf("%d ignored addLearner: do not support changing %d from raft peer to learner.", r.id, id))
+		return
+	}
+	if isLearner == pr.IsLearner {
+		// Ignore any redundant addNode calls (which can happen because the
+		// initial bootstrapping entries are applied twice).
 		return
 	}
+	// Change Learner to Voter, use origin Learner progress.
+	pr.IsLearner = false
 }
 
 // removeNode remove a node from raft group
 func (r *Raft) removeNode(id uint64) {
-	delete(r.Prs, id)
+	r.removeNodeOnly(id)
 
 	// do not try to commit or abort transferring if there is no nodes in the cluster.
 	if len(r.Prs) == 0 {
 		return
 	}
 
-	// The quorum size is now smaller, so see if any pending entries can
-	// be committed.
-	if r.maybeCommit() {
-		r.bcastAppend()
-	}
+	r.commitAfterConfChange()
 	// If the removed node is the leadTransferee, then abort the leadership transferring.
 	if r.State == StateLeader && r.leadTransferee == id {
 		r.abortLeaderTransfer()
 	}
 }
 
-func (r *Raft) setProgress(id, match, next uint64) {
-	r.Prs[id] = &Progress{Next: next, Match: match}
+// removeNodeOnly removes the node from the configuration without trying to
+// commit. In a joint configuration, a voter of the outgoing configuration is
+// only removed from the incoming one, and is tracked until the joint
+// configuration is left.
+func (r *Raft) removeNodeOnly(id uint64) {
+	if r.isJoint() {
+		delete(r.incoming, id)
+		if r.outgoing[id] {
+			return
+		}
+	}
+	delete(r.Prs, id)
+}
+
+// commitAfterConfChange tries to commit the pending entries after the
+// configuration is changed, as the quorum may be smaller now.
+func (r *Raft) commitAfterConfChange() {
+	if len(r.Prs) == 0 {
+		return
+	}
+	if r.maybeCommit() {
+		r.releasePendingReadIndexMessages()
+		r.bcastAppend()
+	}
+}
+
+func (r *Raft) setProgress(id, match, next uint64, isLearner bool) {
+	r.Prs[id] = &Progress{Next: next, Match: match, IsLearner: isLearner}
 	return
 }
 
@@ -909,6 +1230,12 @@ func (r *Raft) loadState(state pb.HardState) {
 	r.Vote = state.Vote
 }
 
+// inLease returns true if this node has heard from a current leader within
+// the minimum election timeout. Only used when PreVote is enabled.
+func (r *Raft) inLease() bool {
+	return r.preVote && r.Lead != None && r.electionElapsed < r.electionTimeout
+}
+
 // pastElectionTimeout returns true iff r.electionElapsed is greater
 // than or equal to the randomized election timeout in
 // [electiontimeout, 2 * electiontimeout - 1].
@@ -920,6 +1247,53 @@ func (r *Raft) resetRandomizedElectionTimeout() {
 	r.randomizedElectionTimeout = r.electionTimeout + globalRand.Intn(r.electionTimeout)
 }
 
+// committedEntryInCurrentTerm return true if the peer has committed an entry in its term.
+func (r *Raft) committedEntryInCurrentTerm() bool {
+	return r.RaftLog.zeroTermOnRangeErr(r.RaftLog.Term(r.RaftLog.committed)) == r.Term
+}
+
+// responseToReadIndexReq constructs a response for `req`. If `req` comes from the peer
+// itself, a ReadState is appended to r.readStates instead.
+func (r *Raft) responseToReadIndexReq(req pb.Message, readIndex uint64) {
+	if req.From == None || req.From == r.id {
+		r.readStates = append(r.readStates, ReadState{
+			Index:      readIndex,
+			RequestCtx: req.Entries[0].Data,
+		})
+		return
+	}
+	r.send(pb.Message{
+		MsgType: pb.MessageType_MsgReadIndexResp,
+		To:      req.From,
+		Index:   readIndex,
+		Entries: req.Entries,
+	})
+}
+
+func (r *Raft) sendMsgReadIndexResponse(m pb.Message) {
+	// thinking: use an internally defined context instead of the user given context.
+	// We can express this in terms of the term and index instead of a user-supplied value.
+	// This would allow multiple reads to piggyback on the same message.
+	r.readOnly.addRequest(r.RaftLog.committed, m)
+	// The local node automatically acks the request.
+	r.readOnly.recvAck(r.id, m.Entries[0].Data)
+	r.bcastHeartbeatWithCtx(m.Entries[0].Data)
+}
+
+func (r *Raft) releasePendingReadIndexMessages() {
+	if !r.committedEntryInCurrentTerm() {
+		log.Error(fmt.Sprintf("%d pending MessageType_MsgReadIndex should be released only after first commit in current term", r.id))
+		return
+	}
+
+	msgs := r.pendingReadIndexMessages
+	r.pendingReadIndexMessages = nil
+
+	for _, m := range msgs {
+		r.sendMsgReadIndexResponse(m)
+	}
+}
+
 func (r *Raft) sendTimeoutNow(to uint64) {
 	r.send(pb.Message{To: to, MsgType: pb.MessageType_MsgTimeoutNow})
 }
@@ -931,7 +1305,7 @@ func (r *Raft) abortLeaderTransfer() {
 func numOfPendingConf(ents []pb.Entry) int {
 	n := 0
 	for i := range ents {
-		if ents[i].EntryType == pb.EntryType_EntryConfChange {
+		if ents[i].EntryType == pb.EntryType_EntryConfChange || ents[i].EntryType == pb.EntryType_EntryConfChangeV2 {
 			n++
 		}
 	}
@@ -942,6 +1316,8 @@ func numOfPendingConf(ents []pb.Entry) int {
 // progresses of all followers, and sends entries to the follower based on its progress.
 type Progress struct {
 	Match, Next uint64
+	// IsLearner is true if this progress is tracked for a learner.
+	IsLearner bool
 }
 
 // maybeUpdate returns false if the given n index comes from an outdated message.
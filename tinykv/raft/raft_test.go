@@ -869,6 +869,280 @@ func TestDisruptiveFollower2A(t *testing.T) {
 	}
 }
 
+func preVoteConfig(c *Config) {
+	c.PreVote = true
+}
+
+func TestLeaderElectionPreVote2A(t *testing.T) {
+	tests := []struct {
+		*network
+		state   StateType
+		expTerm uint64
+	}{
+		{newNetworkWithConfig(preVoteConfig, nil, nil, nil), StateLeader, 1},
+		{newNetworkWithConfig(preVoteConfig, nil, nil, nopStepper), StateLeader, 1},
+		// a pre-candidate that can not win must not increase its term
+		{newNetworkWithConfig(preVoteConfig, nil, nopStepper, nopStepper), StatePreCandidate, 0},
+		{newNetworkWithConfig(preVoteConfig, nil, nopStepper, nopStepper, nil), StatePreCandidate, 0},
+		{newNetworkWithConfig(preVoteConfig, nil, nopStepper, nopStepper, nil, nil), StateLeader, 1},
+	}
+
+	for i, tt := range tests {
+		tt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgHup})
+		sm := tt.network.peers[1].(*Raft)
+		if sm.State != tt.state {
+			t.Errorf("#%d: state = %s, want %s", i, sm.State, tt.state)
+		}
+		if g := sm.Term; g != tt.expTerm {
+			t.Errorf("#%d: term = %d, want %d", i, g, tt.expTerm)
+		}
+	}
+}
+
+// TestDisruptiveFollowerPreVote tests isolated follower,
+// with slow network incoming from leader, election times out
+// to become a pre-candidate with less log than current leader.
+// Then pre-vote phase prevents this isolated node from forcing
+// current leader to step down, thus less disruptions.
+func TestDisruptiveFollowerPreVote2A(t *testing.T) {
+	n1 := newTestRaft(1, []uint64{1, 2, 3}, 10, 1, NewMemoryStorage())
+	n2 := newTestRaft(2, []uint64{1, 2, 3}, 10, 1, NewMemoryStorage())
+	n3 := newTestRaft(3, []uint64{1, 2, 3}, 10, 1, NewMemoryStorage())
+	n1.preVote = true
+	n2.preVote = true
+	n3.preVote = true
+
+	n1.becomeFollower(1, None)
+	n2.becomeFollower(1, None)
+	n3.becomeFollower(1, None)
+
+	nt := newNetwork(n1, n2, n3)
+
+	nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgHup})
+
+	if n1.State != StateLeader {
+		t.Fatalf("node 1 state: %s, want %s", n1.State, StateLeader)
+	}
+
+	nt.isolate(3)
+	nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgPropose, Entries: []*pb.Entry{{Data: []byte("somedata")}}})
+	nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgPropose, Entries: []*pb.Entry{{Data: []byte("somedata")}}})
+	nt.recover()
+
+	// the isolated node times out and asks for pre-votes
+	for n3.State != StatePreCandidate {
+		n3.tick()
+	}
+
+	// check state
+	// n1.State == StateLeader
+	// n2.State == StateFollower
+	// n3.State == StatePreCandidate
+	if n1.State != StateLeader {
+		t.Fatalf("node 1 state: %s, want %s", n1.State, StateLeader)
+	}
+	if n2.State != StateFollower {
+		t.Fatalf("node 2 state: %s, want %s", n2.State, StateFollower)
+	}
+	if n3.State != StatePreCandidate {
+		t.Fatalf("node 3 state: %s, want %s", n3.State, StatePreCandidate)
+	}
+	// check term
+	// n1.Term == 2
+	// n2.Term == 2
+	// n3.Term == 2
+	if n1.Term != 2 {
+		t.Fatalf("node 1 term: %d, want %d", n1.Term, 2)
+	}
+	if n2.Term != 2 {
+		t.Fatalf("node 2 term: %d, want %d", n2.Term, 2)
+	}
+	if n3.Term != 2 {
+		t.Fatalf("node 3 term: %d, want %d", n3.Term, 2)
+	}
+
+	// the pre-vote requests are rejected since the pre-candidate has less
+	// log than the others, the leader keeps its leadership and nobody
+	// increases its term
+	nt.send(n3.readMessages()...)
+	if n1.State != StateLeader {
+		t.Fatalf("node 1 state: %s, want %s", n1.State, StateLeader)
+	}
+	if n3.State != StateFollower {
+		t.Fatalf("node 3 state: %s, want %s", n3.State, StateFollower)
+	}
+	if n1.Term != 2 {
+		t.Fatalf("node 1 term: %d, want %d", n1.Term, 2)
+	}
+	if n3.Term != 2 {
+		t.Fatalf("node 3 term: %d, want %d", n3.Term, 2)
+	}
+}
+
+// TestPreVoteRejectedInLease verifies that a follower which has heard from
+// the leader recently does not grant a pre-vote, even if the pre-candidate
+// has an up-to-date log, so a rejoining node can not disrupt the leader.
+func TestPreVoteRejectedInLease2A(t *testing.T) {
+	nt := newNetworkWithConfig(preVoteConfig, nil, nil, nil)
+	nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgHup})
+
+	sm1 := nt.peers[1].(*Raft)
+	sm3 := nt.peers[3].(*Raft)
+	if sm1.State != StateLeader {
+		t.Fatalf("node 1 state: %s, want %s", sm1.State, StateLeader)
+	}
+
+	// node 3 is partitioned away and keeps campaigning
+	nt.isolate(3)
+	for i := 0; i < 5; i++ {
+		nt.send(pb.Message{From: 3, To: 3, MsgType: pb.MessageType_MsgHup})
+	}
+	if sm3.State != StatePreCandidate {
+		t.Fatalf("node 3 state: %s, want %s", sm3.State, StatePreCandidate)
+	}
+	if sm3.Term != 1 {
+		t.Fatalf("node 3 term: %d, want %d", sm3.Term, 1)
+	}
+
+	// node 3 rejoins, node 2 still hears from the leader and rejects it
+	nt.recover()
+	nt.send(pb.Message{From: 3, To: 3, MsgType: pb.MessageType_MsgHup})
+	if sm1.State != StateLeader || sm1.Term != 1 {
+		t.Fatalf("node 1 state: %s term: %d, want %s term: %d", sm1.State, sm1.Term, StateLeader, 1)
+	}
+
+	// the leader heartbeat brings node 3 back as a follower
+	nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgBeat})
+	if sm3.State != StateFollower {
+		t.Fatalf("node 3 state: %s, want %s", sm3.State, StateFollower)
+	}
+	if sm3.Lead != 1 {
+		t.Fatalf("node 3 lead: %d, want %d", sm3.Lead, 1)
+	}
+}
+
+func TestReadIndex2AB(t *testing.T) {
+	a := newTestRaft(1, []uint64{1, 2, 3}, 10, 1, NewMemoryStorage())
+	b := newTestRaft(2, []uint64{1, 2, 3}, 10, 1, NewMemoryStorage())
+	c := newTestRaft(3, []uint64{1, 2, 3}, 10, 1, NewMemoryStorage())
+
+	nt := newNetwork(a, b, c)
+	nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgHup})
+	if a.State != StateLeader {
+		t.Fatalf("state = %s, want %s", a.State, StateLeader)
+	}
+
+	tests := []struct {
+		sm        *Raft
+		proposals int
+		wri       uint64
+		wctx      []byte
+	}{
+		{a, 10, 11, []byte("ctx1")},
+		{b, 10, 21, []byte("ctx2")},
+		{c, 10, 31, []byte("ctx3")},
+		{a, 10, 41, []byte("ctx4")},
+		{b, 10, 51, []byte("ctx5")},
+		{c, 10, 61, []byte("ctx6")},
+	}
+
+	for i, tt := range tests {
+		for j := 0; j < tt.proposals; j++ {
+			nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgPropose, Entries: []*pb.Entry{{}}})
+		}
+
+		nt.send(pb.Message{From: tt.sm.id, To: tt.sm.id, MsgType: pb.MessageType_MsgReadIndex, Entries: []*pb.Entry{{Data: tt.wctx}}})
+
+		r := tt.sm
+		if len(r.readStates) == 0 {
+			t.Errorf("#%d: len(readStates) = 0, want non-zero", i)
+		}
+		rs := r.readStates[0]
+		if rs.Index != tt.wri {
+			t.Errorf("#%d: readIndex = %d, want %d", i, rs.Index, tt.wri)
+		}
+
+		if !bytes.Equal(rs.RequestCtx, tt.wctx) {
+			t.Errorf("#%d: requestCtx = %v, want %v", i, rs.RequestCtx, tt.wctx)
+		}
+		r.readStates = nil
+	}
+}
+
+// TestReadIndexForNewLeader ensures that a leader only answers the read index
+// requests after it has committed an entry in its own term.
+func TestReadIndexForNewLeader2AB(t *testing.T) {
+	nodeConfigs := []struct {
+		id           uint64
+		committed    uint64
+		applied      uint64
+		compactIndex uint64
+	}{
+		{1, 1, 1, 0},
+		{2, 2, 2, 2},
+		{3, 2, 2, 2},
+	}
+	peers := make([]stateMachine, 0)
+	for _, c := range nodeConfigs {
+		storage := NewMemoryStorage()
+		storage.Append([]pb.Entry{{Index: 1, Term: 1}, {Index: 2, Term: 1}})
+		storage.SetHardState(pb.HardState{Term: 1, Commit: c.committed})
+		if c.compactIndex != 0 {
+			storage.Compact(c.compactIndex)
+		}
+		cfg := newTestConfig(c.id, []uint64{1, 2, 3}, 10, 1, storage)
+		cfg.Applied = c.applied
+		raft := newRaft(cfg)
+		peers = append(peers, raft)
+	}
+	nt := newNetwork(peers...)
+
+	// Drop MessageType_MsgAppend to forbid peer a to commit any log entry at its term after it becomes leader.
+	nt.ignore(pb.MessageType_MsgAppend)
+	// Force peer a to become leader.
+	nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgHup})
+
+	sm := nt.peers[1].(*Raft)
+	if sm.State != StateLeader {
+		t.Fatalf("state = %s, want %s", sm.State, StateLeader)
+	}
+
+	// Ensure peer a drops read only request.
+	var windex uint64 = 4
+	wctx := []byte("ctx")
+	nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgReadIndex, Entries: []*pb.Entry{{Data: wctx}}})
+	if len(sm.readStates) != 0 {
+		t.Fatalf("len(readStates) = %d, want zero", len(sm.readStates))
+	}
+
+	nt.recover()
+
+	// Force peer a to commit a log entry at its term
+	for i := 0; i < sm.heartbeatTimeout; i++ {
+		sm.tick()
+	}
+	nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgPropose, Entries: []*pb.Entry{{}}})
+	if sm.RaftLog.committed != 4 {
+		t.Fatalf("committed = %d, want 4", sm.RaftLog.committed)
+	}
+	lastLogTerm := sm.RaftLog.zeroTermOnRangeErr(sm.RaftLog.Term(sm.RaftLog.committed))
+	if lastLogTerm != sm.Term {
+		t.Fatalf("last log term = %d, want %d", lastLogTerm, sm.Term)
+	}
+
+	// Ensure peer a processed postponed read only request after it committed an entry at its term.
+	if len(sm.readStates) != 1 {
+		t.Fatalf("len(readStates) = %d, want 1", len(sm.readStates))
+	}
+	rs := sm.readStates[0]
+	if rs.Index != windex {
+		t.Fatalf("readIndex = %d, want %d", rs.Index, windex)
+	}
+	if !bytes.Equal(rs.RequestCtx, wctx) {
+		t.Fatalf("requestCtx = %v, want %v", rs.RequestCtx, wctx)
+	}
+}
+
 func TestHeartbeatUpdateCommit2AB(t *testing.T) {
 	tests := []struct {
 		failCnt    int
@@ -1121,6 +1395,352 @@ func TestRemoveNode3A(t *testing.T) {
 	}
 }
 
+// TestAddLearner tests that addLearner could update nodes correctly.
+func TestAddLearner3A(t *testing.T) {
+	r := newTestRaft(1, []uint64{1}, 10, 1, NewMemoryStorage())
+	r.addLearner(2)
+	nodes := learnerNodes(r)
+	wnodes := []uint64{2}
+	if !reflect.DeepEqual(nodes, wnodes) {
+		t.Errorf("nodes = %v, want %v", nodes, wnodes)
+	}
+	if !r.Prs[2].IsLearner {
+		t.Errorf("node 2 is learner %t, want %t", r.Prs[2].IsLearner, true)
+	}
+	if r.quorum() != 1 {
+		t.Errorf("quorum = %d, want %d", r.quorum(), 1)
+	}
+}
+
+// TestLearnerElectionTimeout verifies that the leader should not start election even
+// when times out.
+func TestLearnerElectionTimeout3A(t *testing.T) {
+	n1 := newTestLearnerRaft(1, []uint64{1}, []uint64{2}, 10, 1, NewMemoryStorage())
+	n2 := newTestLearnerRaft(2, []uint64{1}, []uint64{2}, 10, 1, NewMemoryStorage())
+
+	n1.becomeFollower(1, None)
+	n2.becomeFollower(1, None)
+
+	// n2 is learner. Learner should not start election even when times out.
+	for i := 0; i < 2*n2.electionTimeout; i++ {
+		n2.tick()
+	}
+
+	if n2.State != StateFollower {
+		t.Errorf("peer 2 state: %s, want %s", n2.State, StateFollower)
+	}
+}
+
+// TestLearnerPromotion verifies that the learner should not election until
+// it is promoted to a normal peer.
+func TestLearnerPromotion3A(t *testing.T) {
+	n1 := newTestLearnerRaft(1, []uint64{1}, []uint64{2}, 10, 1, NewMemoryStorage())
+	n2 := newTestLearnerRaft(2, []uint64{1}, []uint64{2}, 10, 1, NewMemoryStorage())
+
+	n1.becomeFollower(1, None)
+	n2.becomeFollower(1, None)
+
+	nt := newNetwork(n1, n2)
+
+	if n1.State == StateLeader {
+		t.Error("peer 1 state is leader, want not", n1.State)
+	}
+
+	// n1 should become leader
+	for i := 0; i < 2*n1.electionTimeout; i++ {
+		n1.tick()
+	}
+	nt.send(n1.readMessages()...)
+
+	if n1.State != StateLeader {
+		t.Errorf("peer 1 state: %s, want %s", n1.State, StateLeader)
+	}
+	if n2.State != StateFollower {
+		t.Errorf("peer 2 state: %s, want %s", n2.State, StateFollower)
+	}
+
+	nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgBeat})
+
+	n1.addNode(2)
+	n2.addNode(2)
+	if n2.Prs[2].IsLearner {
+		t.Error("peer 2 is learner, want not")
+	}
+
+	// n2 start election, should become leader
+	for i := 0; i < 2*n2.electionTimeout; i++ {
+		n2.tick()
+	}
+	nt.send(n2.readMessages()...)
+
+	if n1.State != StateFollower {
+		t.Errorf("peer 1 state: %s, want %s", n1.State, StateFollower)
+	}
+	if n2.State != StateLeader {
+		t.Errorf("peer 2 state: %s, want %s", n2.State, StateLeader)
+	}
+}
+
+// TestLearnerCannotVote checks that a learner can't vote even it receives a valid Vote request.
+func TestLearnerCannotVote3A(t *testing.T) {
+	n1 := newTestLearnerRaft(1, []uint64{1, 2}, []uint64{3}, 10, 1, NewMemoryStorage())
+	n1.Step(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgHup})
+	for _, m := range n1.readMessages() {
+		if m.To == 3 {
+			t.Errorf("learner 3 receives %s, want no vote request", m.MsgType)
+		}
+	}
+
+	// the learner grants the vote, but it should not be counted.
+	n1.Step(pb.Message{From: 3, To: 1, Term: n1.Term, MsgType: pb.MessageType_MsgRequestVoteResponse})
+	if n1.State != StateCandidate {
+		t.Errorf("peer 1 state: %s, want %s", n1.State, StateCandidate)
+	}
+	n1.Step(pb.Message{From: 2, To: 1, Term: n1.Term, MsgType: pb.MessageType_MsgRequestVoteResponse})
+	if n1.State != StateLeader {
+		t.Errorf("peer 1 state: %s, want %s", n1.State, StateLeader)
+	}
+}
+
+// TestLearnerLogReplication tests that a learner can receive entries from the leader,
+// and the entries are committed without its acknowledgement.
+func TestLearnerLogReplication3A(t *testing.T) {
+	n1 := newTestLearnerRaft(1, []uint64{1}, []uint64{2}, 10, 1, NewMemoryStorage())
+	n2 := newTestLearnerRaft(2, []uint64{1}, []uint64{2}, 10, 1, NewMemoryStorage())
+
+	nt := newNetwork(n1, n2)
+
+	n1.becomeFollower(1, None)
+	n2.becomeFollower(1, None)
+
+	for i := 0; i < 2*n1.electionTimeout; i++ {
+		n1.tick()
+	}
+	nt.send(n1.readMessages()...)
+	nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgBeat})
+
+	// n1 is leader and n2 is learner
+	if n1.State != StateLeader {
+		t.Errorf("peer 1 state: %s, want %s", n1.State, StateLeader)
+	}
+	if !n2.Prs[2].IsLearner {
+		t.Error("peer 2 state: not learner, want yes")
+	}
+
+	nextCommitted := n1.RaftLog.committed + 1
+	nt.isolate(2)
+	nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgPropose, Entries: []*pb.Entry{{Data: []byte("somedata")}}})
+	if n1.RaftLog.committed != nextCommitted {
+		t.Errorf("peer 1 wants committed to %d, but still %d", nextCommitted, n1.RaftLog.committed)
+	}
+
+	nt.recover()
+	nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgBeat})
+	if n2.RaftLog.committed != n1.RaftLog.committed {
+		t.Errorf("peer 2 wants committed to %d, but still %d", n1.RaftLog.committed, n2.RaftLog.committed)
+	}
+
+	match := n1.getProgress(2).Match
+	if match != n2.RaftLog.committed {
+		t.Errorf("progress 2 of leader 1 wants match %d, but got %d", n2.RaftLog.committed, match)
+	}
+}
+
+// TestRestoreWithLearner restores a snapshot which contains learners.
+func TestRestoreWithLearner3A(t *testing.T) {
+	s := pb.Snapshot{
+		Metadata: &pb.SnapshotMetadata{
+			Index:     11, // magic number
+			Term:      11, // magic number
+			ConfState: &pb.ConfState{Nodes: []uint64{1, 2}, Learners: []uint64{3}},
+		},
+	}
+
+	storage := NewMemoryStorage()
+	sm := newTestLearnerRaft(3, []uint64{1, 2}, []uint64{3}, 10, 1, storage)
+	sm.handleSnapshot(pb.Message{Snapshot: &s})
+
+	if sm.RaftLog.LastIndex() != s.Metadata.Index {
+		t.Errorf("log.lastIndex = %d, want %d", sm.RaftLog.LastIndex(), s.Metadata.Index)
+	}
+	if sg := nodes(sm); !reflect.DeepEqual(sg, s.Metadata.ConfState.Nodes) {
+		t.Errorf("sm.Nodes = %+v, want %+v", sg, s.Metadata.ConfState.Nodes)
+	}
+	if sg := learnerNodes(sm); !reflect.DeepEqual(sg, s.Metadata.ConfState.Learners) {
+		t.Errorf("sm.LearnerNodes = %+v, want %+v", sg, s.Metadata.ConfState.Learners)
+	}
+	if sm.promotable() {
+		t.Errorf("learner 3 is promotable, want not")
+	}
+}
+
+// TestJointConfChange tests that entering a joint configuration keeps the old
+// voters as the outgoing configuration until the joint configuration is left.
+func TestJointConfChange3A(t *testing.T) {
+	r := newTestRaft(1, []uint64{1, 2, 3}, 10, 1, NewMemoryStorage())
+	r.applyConfChangeV2(pb.ConfChangeV2{
+		Transition: pb.ConfChangeTransition_Explicit,
+		Changes: []*pb.ConfChangeSingle{
+			{ChangeType: pb.ConfChangeType_RemoveNode, NodeId: 3},
+			{ChangeType: pb.ConfChangeType_AddNode, NodeId: 4},
+			{ChangeType: pb.ConfChangeType_AddNode, NodeId: 5},
+		},
+	})
+	if !r.isJoint() {
+		t.Fatalf("raft is not in a joint configuration")
+	}
+	wcs := &pb.ConfState{Nodes: []uint64{1, 2, 4, 5}, Learners: []uint64{}, VotersOutgoing: []uint64{1, 2, 3}}
+	if cs := r.confState(); !reflect.DeepEqual(cs, wcs) {
+		t.Errorf("confState = %+v, want %+v", cs, wcs)
+	}
+	// The removed voter is still tracked as a voter of the outgoing configuration.
+	if pr := r.getProgress(3); pr == nil || pr.IsLearner {
+		t.Errorf("progress of 3 = %+v, want a voter", pr)
+	}
+
+	r.applyConfChangeV2(pb.ConfChangeV2{})
+	if r.isJoint() {
+		t.Fatalf("raft is still in a joint configuration")
+	}
+	wcs = &pb.ConfState{Nodes: []uint64{1, 2, 4, 5}, Learners: []uint64{}}
+	if cs := r.confState(); !reflect.DeepEqual(cs, wcs) {
+		t.Errorf("confState = %+v, want %+v", cs, wcs)
+	}
+	if pr := r.getProgress(3); pr != nil {
+		t.Errorf("progress of 3 = %+v, want nil", pr)
+	}
+}
+
+// TestJointCommit tests that an entry is committed in a joint configuration
+// only if it's replicated to a majority of both configurations.
+func TestJointCommit3A(t *testing.T) {
+	r := newTestRaft(1, []uint64{1, 2, 3}, 10, 1, NewMemoryStorage())
+	r.becomeCandidate()
+	r.becomeLeader()
+	r.applyConfChangeV2(pb.ConfChangeV2{
+		Transition: pb.ConfChangeTransition_Explicit,
+		Changes: []*pb.ConfChangeSingle{
+			{ChangeType: pb.ConfChangeType_RemoveNode, NodeId: 2},
+			{ChangeType: pb.ConfChangeType_RemoveNode, NodeId: 3},
+			{ChangeType: pb.ConfChangeType_AddNode, NodeId: 4},
+			{ChangeType: pb.ConfChangeType_AddNode, NodeId: 5},
+		},
+	})
+	r.Step(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgPropose, Entries: []*pb.Entry{{Data: []byte("somedata")}}})
+	li := r.RaftLog.LastIndex()
+
+	// The incoming configuration {1, 4, 5} has a majority.
+	r.Step(pb.Message{From: 4, To: 1, MsgType: pb.MessageType_MsgAppendResponse, Term: r.Term, Index: li})
+	r.Step(pb.Message{From: 5, To: 1, MsgType: pb.MessageType_MsgAppendResponse, Term: r.Term, Index: li})
+	if r.RaftLog.committed == li {
+		t.Fatalf("committed = %d, want less than %d", r.RaftLog.committed, li)
+	}
+
+	// The outgoing configuration {1, 2, 3} has a majority too.
+	r.Step(pb.Message{From: 2, To: 1, MsgType: pb.MessageType_MsgAppendResponse, Term: r.Term, Index: li})
+	if r.RaftLog.committed != li {
+		t.Errorf("committed = %d, want %d", r.RaftLog.committed, li)
+	}
+}
+
+// TestJointElection tests that a candidate in a joint configuration needs the
+// votes of a majority of both configurations to become the leader.
+func TestJointElection3A(t *testing.T) {
+	tests := []struct {
+		votes  map[uint64]bool
+		wstate StateType
+	}{
+		// the majority of the incoming configuration only
+		{map[uint64]bool{4: true}, StateCandidate},
+		// the majority of both configurations
+		{map[uint64]bool{3: true, 4: true}, StateLeader},
+		// the outgoing configuration rejects
+		{map[uint64]bool{2: false, 3: false, 4: true}, StateFollower},
+	}
+	for i, tt := range tests {
+		r := newTestRaft(1, []uint64{1, 2, 3}, 10, 1, NewMemoryStorage())
+		r.applyConfChangeV2(pb.ConfChangeV2{
+			Transition: pb.ConfChangeTransition_Explicit,
+			Changes: []*pb.ConfChangeSingle{
+				{ChangeType: pb.ConfChangeType_RemoveNode, NodeId: 3},
+				{ChangeType: pb.ConfChangeType_AddNode, NodeId: 4},
+			},
+		})
+		r.Step(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgHup})
+		for id, vote := range tt.votes {
+			r.Step(pb.Message{From: id, To: 1, MsgType: pb.MessageType_MsgRequestVoteResponse, Term: r.Term, Reject: !vote})
+		}
+		if r.State != tt.wstate {
+			t.Errorf("#%d: state = %s, want %s", i, r.State, tt.wstate)
+		}
+	}
+}
+
+// TestJointRefuseConfChange tests that the leader must leave a joint
+// configuration before proposing another configuration change.
+func TestJointRefuseConfChange3A(t *testing.T) {
+	ccData, err := (&pb.ConfChange{ChangeType: pb.ConfChangeType_AddNode, NodeId: 3}).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	leaveData, err := (&pb.ConfChangeV2{}).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		joint bool
+		ent   pb.Entry
+		wtype pb.EntryType
+	}{
+		{false, pb.Entry{EntryType: pb.EntryType_EntryConfChange, Data: ccData}, pb.EntryType_EntryConfChange},
+		{false, pb.Entry{EntryType: pb.EntryType_EntryConfChangeV2, Data: leaveData}, pb.EntryType_EntryNormal},
+		{true, pb.Entry{EntryType: pb.EntryType_EntryConfChange, Data: ccData}, pb.EntryType_EntryNormal},
+		{true, pb.Entry{EntryType: pb.EntryType_EntryConfChangeV2, Data: leaveData}, pb.EntryType_EntryConfChangeV2},
+	}
+	for i, tt := range tests {
+		r := newTestRaft(1, []uint64{1}, 10, 1, NewMemoryStorage())
+		r.becomeCandidate()
+		r.becomeLeader()
+		r.RaftLog.appliedTo(r.RaftLog.committed)
+		if tt.joint {
+			r.applyConfChangeV2(pb.ConfChangeV2{
+				Transition: pb.ConfChangeTransition_Explicit,
+				Changes:    []*pb.ConfChangeSingle{{ChangeType: pb.ConfChangeType_AddNode, NodeId: 2}},
+			})
+		}
+		ent := tt.ent
+		r.Step(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgPropose, Entries: []*pb.Entry{&ent}})
+		ents := r.RaftLog.allEntries()
+		if g := ents[len(ents)-1].EntryType; g != tt.wtype {
+			t.Errorf("#%d: entry type = %s, want %s", i, g, tt.wtype)
+		}
+	}
+}
+
+// TestRestoreJoint restores a snapshot taken in a joint configuration.
+func TestRestoreJoint3A(t *testing.T) {
+	s := pb.Snapshot{
+		Metadata: &pb.SnapshotMetadata{
+			Index:     11, // magic number
+			Term:      11, // magic number
+			ConfState: &pb.ConfState{Nodes: []uint64{1, 2, 4}, Learners: []uint64{}, VotersOutgoing: []uint64{1, 2, 3}},
+		},
+	}
+
+	storage := NewMemoryStorage()
+	sm := newTestRaft(1, []uint64{1, 2}, 10, 1, storage)
+	sm.handleSnapshot(pb.Message{Snapshot: &s})
+
+	if cs := sm.confState(); !reflect.DeepEqual(cs, s.Metadata.ConfState) {
+		t.Errorf("confState = %+v, want %+v", cs, s.Metadata.ConfState)
+	}
+	for _, id := range []uint64{1, 2, 3, 4} {
+		if sm.getProgress(id) == nil {
+			t.Errorf("progress of %d is missing", id)
+		}
+	}
+}
+
 func TestCampaignWhileLeader2A(t *testing.T) {
 	cfg := newTestConfig(1, []uint64{1}, 5, 1, NewMemoryStorage())
 	r := newRaft(cfg)
@@ -1661,3 +2281,9 @@ func newTestConfig(id uint64, peers []uint64, election, heartbeat int, storage S
 func newTestRaft(id uint64, peers []uint64, election, heartbeat int, storage Storage) *Raft {
 	return newRaft(newTestConfig(id, peers, election, heartbeat, storage))
 }
+
+func newTestLearnerRaft(id uint64, peers []uint64, learners []uint64, election, heartbeat int, storage Storage) *Raft {
+	cfg := newTestConfig(id, peers, election, heartbeat, storage)
+	cfg.learners = learners
+	return newRaft(cfg)
+}
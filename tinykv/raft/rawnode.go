@@ -51,6 +51,12 @@ type Ready struct {
 	// HardState will be equal to empty state if there is no update.
 	pb.HardState
 
+	// ReadStates can be used for node to serve linearizable read requests locally
+	// when its applied index is greater than the index in ReadState.
+	// Note that the readState will be returned when raft receives MessageType_MsgReadIndex.
+	// The returned is only valid for the request that requested to read.
+	ReadStates []ReadState
+
 	// Entries specifies entries to be saved to stable storage BEFORE
 	// Messages are sent.
 	Entries []pb.Entry
@@ -88,6 +94,9 @@ func newReady(r *Raft, prevSoftSt *SoftState, prevHardSt pb.HardState) Ready {
 	if r.RaftLog.pending_snapshot != nil {
 		rd.Snapshot = *r.RaftLog.pending_snapshot
 	}
+	if len(r.readStates) != 0 {
+		rd.ReadStates = r.readStates
+	}
 	return rd
 }
 
@@ -149,17 +158,40 @@ func (rn *RawNode) ProposeConfChange(cc pb.ConfChange) error {
 // ApplyConfChange applies a config change to the local node.
 func (rn *RawNode) ApplyConfChange(cc pb.ConfChange) *pb.ConfState {
 	if cc.NodeId == None {
-		return &pb.ConfState{Nodes: nodes(rn.Raft)}
+		return rn.Raft.confState()
 	}
 	switch cc.ChangeType {
 	case pb.ConfChangeType_AddNode:
 		rn.Raft.addNode(cc.NodeId)
+	case pb.ConfChangeType_AddLearnerNode:
+		rn.Raft.addLearner(cc.NodeId)
 	case pb.ConfChangeType_RemoveNode:
 		rn.Raft.removeNode(cc.NodeId)
 	default:
 		panic("unexpected conf type")
 	}
-	return &pb.ConfState{Nodes: nodes(rn.Raft)}
+	return rn.Raft.confState()
+}
+
+// ProposeConfChangeV2 proposes a config change of several nodes, which is
+// made through a joint configuration. An empty ConfChangeV2 proposes to leave
+// the joint configuration.
+func (rn *RawNode) ProposeConfChangeV2(cc pb.ConfChangeV2) error {
+	data, err := cc.Marshal()
+	if err != nil {
+		return err
+	}
+	ent := pb.Entry{EntryType: pb.EntryType_EntryConfChangeV2, Data: data}
+	return rn.Raft.Step(pb.Message{
+		MsgType: pb.MessageType_MsgPropose,
+		Entries: []*pb.Entry{&ent},
+	})
+}
+
+// ApplyConfChangeV2 applies a config change of several nodes to the local node.
+func (rn *RawNode) ApplyConfChangeV2(cc pb.ConfChangeV2) *pb.ConfState {
+	rn.Raft.applyConfChangeV2(cc)
+	return rn.Raft.confState()
 }
 
 // Step advances the state machine using the given message.
@@ -178,6 +210,7 @@ func (rn *RawNode) Step(m pb.Message) error {
 func (rn *RawNode) Ready() Ready {
 	rd := newReady(rn.Raft, rn.prevSoftSt, rn.prevHardSt)
 	rn.Raft.msgs = nil
+	rn.Raft.readStates = nil
 	return rd
 }
 
@@ -196,6 +229,9 @@ func (rn *RawNode) HasReady() bool {
 	if len(r.msgs) > 0 || len(r.RaftLog.unstableEntries()) > 0 || r.RaftLog.hasNextEnts() {
 		return true
 	}
+	if len(r.readStates) != 0 {
+		return true
+	}
 	return false
 }
 
@@ -210,6 +246,7 @@ func (rn *RawNode) Advance(rd Ready) {
 	}
 	if rn.prevHardSt.Commit != 0 {
 		rn.Raft.RaftLog.appliedTo(rn.prevHardSt.Commit)
+		rn.Raft.maybeAutoLeaveJoint()
 	}
 	if len(rd.Entries) > 0 {
 		e := rd.Entries[len(rd.Entries)-1]
@@ -236,6 +273,14 @@ func (rn *RawNode) GetSnap() *pb.Snapshot {
 	return rn.Raft.GetSnap()
 }
 
+// ReadIndex requests a read state. The read state will be set in ready.
+// Read State has a read index. Once the application advances further than the read
+// index, any linearizable read requests issued before the read request can be
+// processed safely. The read state will have the same rctx attached.
+func (rn *RawNode) ReadIndex(rctx []byte) {
+	_ = rn.Raft.Step(pb.Message{MsgType: pb.MessageType_MsgReadIndex, Entries: []*pb.Entry{{Data: rctx}}})
+}
+
 // TransferLeader tries to transfer leadership to the given transferee.
 func (rn *RawNode) TransferLeader(transferee uint64) {
 	_ = rn.Raft.Step(pb.Message{MsgType: pb.MessageType_MsgTransferLeader, From: transferee})
@@ -155,6 +155,60 @@ func TestRawNodeProposeAddDuplicateNode3A(t *testing.T) {
 	}
 }
 
+// TestRawNodeJointAutoLeave ensures that the leader proposes to leave the joint
+// configuration by itself once it has applied a ConfChangeV2 entering it
+// implicitly.
+func TestRawNodeJointAutoLeave3A(t *testing.T) {
+	s := NewMemoryStorage()
+	rawNode, err := NewRawNode(newTestConfig(1, []uint64{1}, 10, 1, s))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rd := rawNode.Ready()
+	s.Append(rd.Entries)
+	rawNode.Advance(rd)
+
+	rawNode.Campaign()
+	for {
+		rd = rawNode.Ready()
+		s.Append(rd.Entries)
+		if rd.SoftState.Lead == rawNode.Raft.id {
+			rawNode.Advance(rd)
+			break
+		}
+		rawNode.Advance(rd)
+	}
+
+	cc := pb.ConfChangeV2{
+		Transition: pb.ConfChangeTransition_Implicit,
+		Changes:    []*pb.ConfChangeSingle{{ChangeType: pb.ConfChangeType_AddNode, NodeId: 2}},
+	}
+	if err := rawNode.ProposeConfChangeV2(cc); err != nil {
+		t.Fatal(err)
+	}
+	rd = rawNode.Ready()
+	s.Append(rd.Entries)
+	var cs *pb.ConfState
+	for _, entry := range rd.CommittedEntries {
+		if entry.EntryType == pb.EntryType_EntryConfChangeV2 {
+			var cc pb.ConfChangeV2
+			cc.Unmarshal(entry.Data)
+			cs = rawNode.ApplyConfChangeV2(cc)
+		}
+	}
+	wcs := &pb.ConfState{Nodes: []uint64{1, 2}, Learners: []uint64{}, VotersOutgoing: []uint64{1}, AutoLeave: true}
+	if !reflect.DeepEqual(cs, wcs) {
+		t.Fatalf("confState = %+v, want %+v", cs, wcs)
+	}
+	rawNode.Advance(rd)
+
+	entries := rawNode.Raft.RaftLog.allEntries()
+	last := entries[len(entries)-1]
+	if !isLeaveJointEntry(&last) {
+		t.Errorf("last entry = %+v, want an empty ConfChangeV2", last)
+	}
+}
+
 // TestRawNodeStart ensures that a node can be started correctly, and can accept and commit
 // proposals.
 func TestRawNodeStart2C(t *testing.T) {
@@ -250,3 +304,35 @@ func TestRawNodeRestartFromSnapshot2C(t *testing.T) {
 		t.Errorf("unexpected Ready: %+v", rawNode.HasReady())
 	}
 }
+
+// TestRawNodeReadIndex ensures that RawNode.ReadIndex sends the MessageType_MsgReadIndex
+// message to the underlying raft. It also ensures that ReadState can be read out.
+func TestRawNodeReadIndex2AB(t *testing.T) {
+	wrequestCtx := []byte("somedata")
+	wrs := []ReadState{{Index: uint64(1), RequestCtx: wrequestCtx}}
+
+	s := NewMemoryStorage()
+	rawNode, err := NewRawNode(newTestConfig(1, []uint64{1}, 10, 1, s))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rawNode.Campaign()
+	rd := rawNode.Ready()
+	s.Append(rd.Entries)
+	rawNode.Advance(rd)
+
+	rawNode.ReadIndex(wrequestCtx)
+	if !rawNode.HasReady() {
+		t.Errorf("HasReady() returns %t, want %t", rawNode.HasReady(), true)
+	}
+	rd = rawNode.Ready()
+	if !reflect.DeepEqual(rd.ReadStates, wrs) {
+		t.Errorf("ReadStates = %+v, want %+v", rd.ReadStates, wrs)
+	}
+	s.Append(rd.Entries)
+	rawNode.Advance(rd)
+	// ensure the ReadStates are consumed by Ready
+	if rawNode.HasReady() {
+		t.Errorf("unexpected Ready: %+v", rawNode.Ready())
+	}
+}
@@ -60,15 +60,43 @@ func mustTerm(term uint64, err error) uint64 {
 	return term
 }
 
+// nodes returns the sorted IDs of the voters, or the voters of the incoming
+// configuration if the raft group is in a joint configuration.
 func nodes(r *Raft) []uint64 {
-	nodes := make([]uint64, 0, len(r.Prs))
-	for id := range r.Prs {
+	return sortedNodes(r.voterSets()[0])
+}
+
+// sortedNodes returns the sorted IDs of the node set.
+func sortedNodes(set map[uint64]bool) []uint64 {
+	nodes := make([]uint64, 0, len(set))
+	for id := range set {
 		nodes = append(nodes, id)
 	}
 	sort.Sort(uint64Slice(nodes))
 	return nodes
 }
 
+func containsID(ids []uint64, id uint64) bool {
+	for _, i := range ids {
+		if i == id {
+			return true
+		}
+	}
+	return false
+}
+
+// learnerNodes returns the sorted IDs of the learners.
+func learnerNodes(r *Raft) []uint64 {
+	nodes := make([]uint64, 0)
+	for id, pr := range r.Prs {
+		if pr.IsLearner {
+			nodes = append(nodes, id)
+		}
+	}
+	sort.Sort(uint64Slice(nodes))
+	return nodes
+}
+
 func diffu(a, b string) string {
 	if a == b {
 		return ""
@@ -122,7 +150,8 @@ func IsLocalMsg(msgt pb.MessageType) bool {
 }
 
 func IsResponseMsg(msgt pb.MessageType) bool {
-	return msgt == pb.MessageType_MsgAppendResponse || msgt == pb.MessageType_MsgRequestVoteResponse || msgt == pb.MessageType_MsgHeartbeatResponse
+	return msgt == pb.MessageType_MsgAppendResponse || msgt == pb.MessageType_MsgRequestVoteResponse ||
+		msgt == pb.MessageType_MsgHeartbeatResponse || msgt == pb.MessageType_MsgRequestPreVoteResponse
 }
 
 func isHardStateEqual(a, b pb.HardState) bool {
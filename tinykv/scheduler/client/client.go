@@ -70,6 +70,14 @@ type Client interface {
 	ScatterRegion(ctx context.Context, regionID uint64) error
 	// GetOperator gets the status of operator of the specified region.
 	GetOperator(ctx context.Context, regionID uint64) (*schedulerpb.GetOperatorResponse, error)
+	// GetPlacementRules gets all the placement rules, sorted by the priority
+	// in descending order.
+	GetPlacementRules(ctx context.Context) ([]*schedulerpb.PlacementRule, error)
+	// SetPlacementRule creates a placement rule, or updates the rule with
+	// the same group and ID.
+	SetPlacementRule(ctx context.Context, rule *schedulerpb.PlacementRule) error
+	// DeletePlacementRule deletes the placement rule with the group and ID.
+	DeletePlacementRule(ctx context.Context, groupID, id string) error
 	// Close closes the client.
 	Close()
 }
@@ -762,6 +770,75 @@ func (c *client) GetOperator(ctx context.Context, regionID uint64) (*schedulerpb
 	})
 }
 
+func (c *client) GetPlacementRules(ctx context.Context) ([]*schedulerpb.PlacementRule, error) {
+	if span := opentracing.SpanFromContext(ctx); span != nil {
+		span = opentracing.StartSpan("pdclient.GetPlacementRules", opentracing.ChildOf(span.Context()))
+		defer span.Finish()
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, pdTimeout)
+	resp, err := c.leaderClient().GetPlacementRules(ctx, &schedulerpb.GetPlacementRulesRequest{
+		Header: c.requestHeader(),
+	})
+	cancel()
+
+	if err != nil {
+		c.ScheduleCheckLeader()
+		return nil, errors.WithStack(err)
+	}
+	if resp.Header.GetError() != nil {
+		return nil, errors.Errorf("get placement rules failed: %s", resp.Header.GetError().String())
+	}
+	return resp.GetRules(), nil
+}
+
+func (c *client) SetPlacementRule(ctx context.Context, rule *schedulerpb.PlacementRule) error {
+	if span := opentracing.SpanFromContext(ctx); span != nil {
+		span = opentracing.StartSpan("pdclient.SetPlacementRule", opentracing.ChildOf(span.Context()))
+		defer span.Finish()
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, pdTimeout)
+	resp, err := c.leaderClient().SetPlacementRule(ctx, &schedulerpb.SetPlacementRuleRequest{
+		Header: c.requestHeader(),
+		Rule:   rule,
+	})
+	cancel()
+
+	if err != nil {
+		c.ScheduleCheckLeader()
+		return errors.WithStack(err)
+	}
+	if resp.Header.GetError() != nil {
+		return errors.Errorf("set placement rule %s/%s failed: %s", rule.GetGroupId(), rule.GetId(), resp.Header.GetError().String())
+	}
+	return nil
+}
+
+func (c *client) DeletePlacementRule(ctx context.Context, groupID, id string) error {
+	if span := opentracing.SpanFromContext(ctx); span != nil {
+		span = opentracing.StartSpan("pdclient.DeletePlacementRule", opentracing.ChildOf(span.Context()))
+		defer span.Finish()
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, pdTimeout)
+	resp, err := c.leaderClient().DeletePlacementRule(ctx, &schedulerpb.DeletePlacementRuleRequest{
+		Header:  c.requestHeader(),
+		GroupId: groupID,
+		Id:      id,
+	})
+	cancel()
+
+	if err != nil {
+		c.ScheduleCheckLeader()
+		return errors.WithStack(err)
+	}
+	if resp.Header.GetError() != nil {
+		return errors.Errorf("delete placement rule %s/%s failed: %s", groupID, id, resp.Header.GetError().String())
+	}
+	return nil
+}
+
 func (c *client) requestHeader() *schedulerpb.RequestHeader {
 	return &schedulerpb.RequestHeader{
 		ClusterId: c.clusterID,
@@ -23,6 +23,9 @@ import (
 	"github.com/pingcap-incubator/tinykv/scheduler/pkg/mock/mockid"
 	"github.com/pingcap-incubator/tinykv/scheduler/pkg/mock/mockoption"
 	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/kv"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/placement"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/statistics"
 	"github.com/pingcap/log"
 	"go.uber.org/zap"
 )
@@ -32,15 +35,23 @@ type Cluster struct {
 	*core.BasicCluster
 	*mockid.IDAllocator
 	*mockoption.ScheduleOptions
-	ID uint64
+	ID          uint64
+	hotCache    *statistics.HotCache
+	ruleManager *placement.RuleManager
 }
 
 // NewCluster creates a new Cluster
 func NewCluster(opt *mockoption.ScheduleOptions) *Cluster {
+	ruleManager := placement.NewRuleManager(core.NewStorage(kv.NewMemoryKV()))
+	if err := ruleManager.Initialize(opt.GetMaxReplicas(), opt.GetLocationLabels()); err != nil {
+		log.Fatal("initialize rule manager failed", zap.Error(err))
+	}
 	return &Cluster{
 		BasicCluster:    core.NewBasicCluster(),
 		IDAllocator:     mockid.NewIDAllocator(),
 		ScheduleOptions: opt,
+		hotCache:        statistics.NewHotCache(),
+		ruleManager:     ruleManager,
 	}
 }
 
@@ -170,6 +181,17 @@ func (mc *Cluster) AddRegionStore(storeID uint64, regionCount int) {
 	mc.PutStore(store)
 }
 
+// AddLabelsStore adds store with specified count of region and labels.
+func (mc *Cluster) AddLabelsStore(storeID uint64, regionCount int, labels map[string]string) {
+	mc.AddRegionStore(storeID, regionCount)
+	store := mc.GetStore(storeID)
+	var storeLabels []*metapb.StoreLabel
+	for k, v := range labels {
+		storeLabels = append(storeLabels, &metapb.StoreLabel{Key: k, Value: v})
+	}
+	mc.PutStore(store.Clone(core.SetStoreLabels(storeLabels)))
+}
+
 // AddLeaderRegion adds region with specified leader and followers.
 func (mc *Cluster) AddLeaderRegion(regionID uint64, leaderID uint64, followerIds ...uint64) {
 	origin := mc.newMockRegionInfo(regionID, leaderID, followerIds...)
@@ -177,6 +199,30 @@ func (mc *Cluster) AddLeaderRegion(regionID uint64, leaderID uint64, followerIds
 	mc.PutRegion(region)
 }
 
+// AddLeaderRegionWithWriteInfo adds region with specified leader, followers and write info.
+func (mc *Cluster) AddLeaderRegionWithWriteInfo(regionID uint64, leaderID uint64, writtenBytes, reportInterval uint64, followerIds ...uint64) {
+	origin := mc.newMockRegionInfo(regionID, leaderID, followerIds...)
+	r := origin.Clone(
+		core.SetWrittenBytes(writtenBytes),
+		core.SetReportInterval(reportInterval),
+		core.SetApproximateSize(10),
+	)
+	mc.hotCache.Update(r)
+	mc.PutRegion(r)
+}
+
+// AddLeaderRegionWithReadInfo adds region with specified leader, followers and read info.
+func (mc *Cluster) AddLeaderRegionWithReadInfo(regionID uint64, leaderID uint64, readBytes, reportInterval uint64, followerIds ...uint64) {
+	origin := mc.newMockRegionInfo(regionID, leaderID, followerIds...)
+	r := origin.Clone(
+		core.SetReadBytes(readBytes),
+		core.SetReportInterval(reportInterval),
+		core.SetApproximateSize(10),
+	)
+	mc.hotCache.Update(r)
+	mc.PutRegion(r)
+}
+
 // AddLeaderRegionWithRange adds region with specified leader, followers and key range.
 func (mc *Cluster) AddLeaderRegionWithRange(regionID uint64, startKey string, endKey string, leaderID uint64, followerIds ...uint64) {
 	o := mc.newMockRegionInfo(regionID, leaderID, followerIds...)
@@ -319,6 +365,36 @@ func (mc *Cluster) GetReplicaScheduleLimit() uint64 {
 	return mc.ScheduleOptions.GetReplicaScheduleLimit()
 }
 
+// RegionWriteStats returns hot region's write stats.
+func (mc *Cluster) RegionWriteStats() map[uint64][]*statistics.HotPeerStat {
+	return mc.hotCache.RegionStats(statistics.WriteFlow, mc.GetHotRegionCacheHitsThreshold())
+}
+
+// RegionReadStats returns hot region's read stats.
+func (mc *Cluster) RegionReadStats() map[uint64][]*statistics.HotPeerStat {
+	return mc.hotCache.RegionStats(statistics.ReadFlow, mc.GetHotRegionCacheHitsThreshold())
+}
+
+// IsRegionHot checks if the region is hot.
+func (mc *Cluster) IsRegionHot(region *core.RegionInfo) bool {
+	return mc.hotCache.IsRegionHot(region, mc.GetHotRegionCacheHitsThreshold())
+}
+
+// GetRuleManager returns the placement rule manager.
+func (mc *Cluster) GetRuleManager() *placement.RuleManager {
+	return mc.ruleManager
+}
+
+// GetRegionRule mocks method.
+func (mc *Cluster) GetRegionRule(region *core.RegionInfo) *schedulerpb.PlacementRule {
+	if mc.IsPlacementRulesEnabled() {
+		if rule := mc.ruleManager.GetRuleForRegion(region); rule != nil {
+			return rule
+		}
+	}
+	return placement.NewDefaultRule(mc.GetMaxReplicas(), mc.GetLocationLabels())
+}
+
 // GetMaxReplicas mocks method.
 func (mc *Cluster) GetMaxReplicas() int {
 	return mc.ScheduleOptions.GetMaxReplicas()
@@ -18,29 +18,39 @@ import (
 )
 
 const (
-	defaultMaxReplicas          = 3
-	defaultMaxSnapshotCount     = 3
-	defaultMaxPendingPeerCount  = 16
-	defaultMaxMergeRegionSize   = 0
-	defaultMaxMergeRegionKeys   = 0
-	defaultMaxStoreDownTime     = 30 * time.Minute
-	defaultLeaderScheduleLimit  = 4
-	defaultRegionScheduleLimit  = 64
-	defaultReplicaScheduleLimit = 64
+	defaultMaxReplicas                 = 3
+	defaultMaxSnapshotCount            = 3
+	defaultMaxPendingPeerCount         = 16
+	defaultMaxMergeRegionSize          = 0
+	defaultMaxMergeRegionKeys          = 0
+	defaultMaxStoreDownTime            = 30 * time.Minute
+	defaultLeaderScheduleLimit         = 4
+	defaultRegionScheduleLimit         = 64
+	defaultReplicaScheduleLimit        = 64
+	defaultMergeScheduleLimit          = 8
+	defaultHotRegionScheduleLimit      = 4
+	defaultHotRegionCacheHitsThreshold = 3
+	defaultSplitMergeInterval          = 0
 )
 
 // ScheduleOptions is a mock of ScheduleOptions
 // which implements Options interface
 type ScheduleOptions struct {
-	RegionScheduleLimit  uint64
-	LeaderScheduleLimit  uint64
-	ReplicaScheduleLimit uint64
-	MaxSnapshotCount     uint64
-	MaxPendingPeerCount  uint64
-	MaxMergeRegionSize   uint64
-	MaxMergeRegionKeys   uint64
-	MaxStoreDownTime     time.Duration
-	MaxReplicas          int
+	RegionScheduleLimit         uint64
+	LeaderScheduleLimit         uint64
+	ReplicaScheduleLimit        uint64
+	MergeScheduleLimit          uint64
+	HotRegionScheduleLimit      uint64
+	HotRegionCacheHitsThreshold int
+	MaxSnapshotCount            uint64
+	MaxPendingPeerCount         uint64
+	MaxMergeRegionSize          uint64
+	MaxMergeRegionKeys          uint64
+	SplitMergeInterval          time.Duration
+	MaxStoreDownTime            time.Duration
+	MaxReplicas                 int
+	LocationLabels              []string
+	EnablePlacementRules        bool
 }
 
 // NewScheduleOptions creates a mock schedule option.
@@ -49,9 +59,13 @@ func NewScheduleOptions() *ScheduleOptions {
 	mso.RegionScheduleLimit = defaultRegionScheduleLimit
 	mso.LeaderScheduleLimit = defaultLeaderScheduleLimit
 	mso.ReplicaScheduleLimit = defaultReplicaScheduleLimit
+	mso.MergeScheduleLimit = defaultMergeScheduleLimit
+	mso.HotRegionScheduleLimit = defaultHotRegionScheduleLimit
+	mso.HotRegionCacheHitsThreshold = defaultHotRegionCacheHitsThreshold
 	mso.MaxSnapshotCount = defaultMaxSnapshotCount
 	mso.MaxMergeRegionSize = defaultMaxMergeRegionSize
 	mso.MaxMergeRegionKeys = defaultMaxMergeRegionKeys
+	mso.SplitMergeInterval = defaultSplitMergeInterval
 	mso.MaxStoreDownTime = defaultMaxStoreDownTime
 	mso.MaxReplicas = defaultMaxReplicas
 	mso.MaxPendingPeerCount = defaultMaxPendingPeerCount
@@ -73,6 +87,26 @@ func (mso *ScheduleOptions) GetReplicaScheduleLimit() uint64 {
 	return mso.ReplicaScheduleLimit
 }
 
+// GetMergeScheduleLimit mocks method
+func (mso *ScheduleOptions) GetMergeScheduleLimit() uint64 {
+	return mso.MergeScheduleLimit
+}
+
+// GetHotRegionScheduleLimit mocks method
+func (mso *ScheduleOptions) GetHotRegionScheduleLimit() uint64 {
+	return mso.HotRegionScheduleLimit
+}
+
+// GetHotRegionCacheHitsThreshold mocks method
+func (mso *ScheduleOptions) GetHotRegionCacheHitsThreshold() int {
+	return mso.HotRegionCacheHitsThreshold
+}
+
+// GetSplitMergeInterval mocks method
+func (mso *ScheduleOptions) GetSplitMergeInterval() time.Duration {
+	return mso.SplitMergeInterval
+}
+
 // GetMaxMergeRegionSize mocks method
 func (mso *ScheduleOptions) GetMaxMergeRegionSize() uint64 {
 	return mso.MaxMergeRegionSize
@@ -97,3 +131,13 @@ func (mso *ScheduleOptions) GetMaxReplicas() int {
 func (mso *ScheduleOptions) SetMaxReplicas(replicas int) {
 	mso.MaxReplicas = replicas
 }
+
+// GetLocationLabels mocks method
+func (mso *ScheduleOptions) GetLocationLabels() []string {
+	return mso.LocationLabels
+}
+
+// IsPlacementRulesEnabled mocks method
+func (mso *ScheduleOptions) IsPlacementRulesEnabled() bool {
+	return mso.EnablePlacementRules
+}
@@ -0,0 +1,60 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package movingavg
+
+// MovingAvg is the average of the most recent records, it smooths the
+// fluctuation of the reported flow. It's not safe for concurrent use.
+type MovingAvg struct {
+	records []float64
+	next    int
+	count   int
+	sum     float64
+}
+
+// NewMovingAvg creates a MovingAvg over the most recent `size` records.
+func NewMovingAvg(size int) *MovingAvg {
+	return &MovingAvg{records: make([]float64, size)}
+}
+
+// Add adds a record, the oldest one is dropped if the window is full.
+func (m *MovingAvg) Add(n float64) {
+	if m.count == len(m.records) {
+		m.sum -= m.records[m.next]
+	} else {
+		m.count++
+	}
+	m.records[m.next] = n
+	m.sum += n
+	m.next = (m.next + 1) % len(m.records)
+}
+
+// Get returns the average of the records, or 0 if there is no record.
+func (m *MovingAvg) Get() float64 {
+	if m.count == 0 {
+		return 0
+	}
+	return m.sum / float64(m.count)
+}
+
+// Reset drops all the records.
+func (m *MovingAvg) Reset() {
+	m.next, m.count, m.sum = 0, 0, 0
+}
+
+// Clone returns a copy of the MovingAvg.
+func (m *MovingAvg) Clone() *MovingAvg {
+	records := make([]float64, len(m.records))
+	copy(records, m.records)
+	return &MovingAvg{records: records, next: m.next, count: m.count, sum: m.sum}
+}
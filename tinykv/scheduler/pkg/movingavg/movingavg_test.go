@@ -0,0 +1,49 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package movingavg
+
+import (
+	"testing"
+
+	. "github.com/pingcap/check"
+)
+
+func Test(t *testing.T) {
+	TestingT(t)
+}
+
+var _ = Suite(&testMovingAvgSuite{})
+
+type testMovingAvgSuite struct{}
+
+func (s *testMovingAvgSuite) TestMovingAvg(c *C) {
+	m := NewMovingAvg(3)
+	c.Assert(m.Get(), Equals, 0.0)
+	m.Add(3)
+	c.Assert(m.Get(), Equals, 3.0)
+	m.Add(6)
+	c.Assert(m.Get(), Equals, 4.5)
+	m.Add(9)
+	c.Assert(m.Get(), Equals, 6.0)
+	// The oldest record is dropped.
+	m.Add(12)
+	c.Assert(m.Get(), Equals, 9.0)
+
+	clone := m.Clone()
+	m.Reset()
+	c.Assert(m.Get(), Equals, 0.0)
+	c.Assert(clone.Get(), Equals, 9.0)
+	m.Add(1)
+	c.Assert(m.Get(), Equals, 1.0)
+}
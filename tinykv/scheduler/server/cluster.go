@@ -29,6 +29,8 @@ import (
 	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
 	"github.com/pingcap-incubator/tinykv/scheduler/server/id"
 	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/placement"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/statistics"
 	"github.com/pingcap/errcode"
 	"github.com/pingcap/log"
 	"github.com/pkg/errors"
@@ -65,6 +67,9 @@ type RaftCluster struct {
 	storage *core.Storage
 	id      id.Allocator
 
+	hotCache    *statistics.HotCache
+	ruleManager *placement.RuleManager
+
 	coordinator *coordinator
 
 	wg   sync.WaitGroup
@@ -132,6 +137,8 @@ func (c *RaftCluster) initCluster(id id.Allocator, opt *config.ScheduleOption, s
 	c.opt = opt
 	c.storage = storage
 	c.id = id
+	c.hotCache = statistics.NewHotCache()
+	c.ruleManager = placement.NewRuleManager(storage)
 }
 
 func (c *RaftCluster) start() error {
@@ -151,6 +158,9 @@ func (c *RaftCluster) start() error {
 	if cluster == nil {
 		return nil
 	}
+	if err := c.ruleManager.Initialize(c.GetMaxReplicas(), c.GetLocationLabels()); err != nil {
+		return err
+	}
 
 	c.coordinator = newCoordinator(c.ctx, cluster, c.s.hbStreams)
 	c.quit = make(chan struct{})
@@ -345,13 +355,25 @@ func (c *RaftCluster) processRegionHeartbeat(region *core.RegionInfo) error {
 		if region.GetApproximateSize() != origin.GetApproximateSize() {
 			saveCache = true
 		}
+
+		// Once the flow has changed, update the cache. Because keys and bytes
+		// are strongly related, only bytes are judged.
+		if region.GetBytesWritten() != origin.GetBytesWritten() ||
+			region.GetBytesRead() != origin.GetBytesRead() {
+			saveCache = true
+		}
 	}
 
+	c.hotCache.Update(region)
+
 	if saveCache {
 		c.Lock()
 		defer c.Unlock()
 
-		c.core.PutRegion(region)
+		overlaps := c.core.PutRegion(region)
+		for _, item := range overlaps {
+			c.hotCache.RemoveRegion(item.GetID())
+		}
 
 		// Update related stores.
 		if origin != nil {
@@ -539,6 +561,11 @@ func (c *RaftCluster) GetAverageRegionSize() int64 {
 	return c.core.GetAverageRegionSize()
 }
 
+// GetAdjacentRegions returns regions' information that are adjacent with the specific region ID.
+func (c *RaftCluster) GetAdjacentRegions(region *core.RegionInfo) (*core.RegionInfo, *core.RegionInfo) {
+	return c.core.GetAdjacentRegions(region)
+}
+
 // DropCacheRegion removes a region from the cache.
 func (c *RaftCluster) DropCacheRegion(id uint64) {
 	c.RLock()
@@ -590,6 +617,7 @@ func (c *RaftCluster) putStore(store *metapb.Store) error {
 		// Update an existed store.
 		s = s.Clone(
 			core.SetStoreAddress(store.Address),
+			core.SetStoreLabels(store.Labels),
 		)
 	}
 	return c.putStoreLocked(s)
@@ -869,6 +897,47 @@ func (c *RaftCluster) GetReplicaScheduleLimit() uint64 {
 	return c.opt.GetReplicaScheduleLimit()
 }
 
+// GetMergeScheduleLimit returns the limit for merge schedule.
+func (c *RaftCluster) GetMergeScheduleLimit() uint64 {
+	return c.opt.GetMergeScheduleLimit()
+}
+
+// GetHotRegionScheduleLimit returns the limit for hot region schedule.
+func (c *RaftCluster) GetHotRegionScheduleLimit() uint64 {
+	return c.opt.GetHotRegionScheduleLimit()
+}
+
+// GetHotRegionCacheHitsThreshold returns the number of heartbeats a region
+// must be hot in to be regarded as a hot region.
+func (c *RaftCluster) GetHotRegionCacheHitsThreshold() int {
+	return c.opt.GetHotRegionCacheHitsThreshold()
+}
+
+// RegionWriteStats returns the hot peers of the written flow, grouped by the store.
+func (c *RaftCluster) RegionWriteStats() map[uint64][]*statistics.HotPeerStat {
+	return c.hotCache.RegionStats(statistics.WriteFlow, c.GetHotRegionCacheHitsThreshold())
+}
+
+// RegionReadStats returns the hot peers of the read flow, grouped by the store.
+func (c *RaftCluster) RegionReadStats() map[uint64][]*statistics.HotPeerStat {
+	return c.hotCache.RegionStats(statistics.ReadFlow, c.GetHotRegionCacheHitsThreshold())
+}
+
+// IsRegionHot checks if a region is in hot state.
+func (c *RaftCluster) IsRegionHot(region *core.RegionInfo) bool {
+	return c.hotCache.IsRegionHot(region, c.GetHotRegionCacheHitsThreshold())
+}
+
+// GetMaxMergeRegionSize returns the max region size to merge.
+func (c *RaftCluster) GetMaxMergeRegionSize() uint64 {
+	return c.opt.GetMaxMergeRegionSize()
+}
+
+// GetSplitMergeInterval returns the interval between finishing split and starting to merge.
+func (c *RaftCluster) GetSplitMergeInterval() time.Duration {
+	return c.opt.GetSplitMergeInterval()
+}
+
 // GetPatrolRegionInterval returns the interval of patroling region.
 func (c *RaftCluster) GetPatrolRegionInterval() time.Duration {
 	return c.opt.GetPatrolRegionInterval()
@@ -884,6 +953,28 @@ func (c *RaftCluster) GetMaxReplicas() int {
 	return c.opt.GetMaxReplicas()
 }
 
+// GetLocationLabels returns the location labels for each region.
+func (c *RaftCluster) GetLocationLabels() []string {
+	return c.opt.GetLocationLabels()
+}
+
+// GetRuleManager returns the placement rule manager.
+func (c *RaftCluster) GetRuleManager() *placement.RuleManager {
+	return c.ruleManager
+}
+
+// GetRegionRule returns the placement rule of the region. If the placement
+// rules are disabled, the default rule built from the replication config is
+// returned.
+func (c *RaftCluster) GetRegionRule(region *core.RegionInfo) *schedulerpb.PlacementRule {
+	if c.opt.IsPlacementRulesEnabled() {
+		if rule := c.ruleManager.GetRuleForRegion(region); rule != nil {
+			return rule
+		}
+	}
+	return placement.NewDefaultRule(c.GetMaxReplicas(), c.GetLocationLabels())
+}
+
 func (c *RaftCluster) putRegion(region *core.RegionInfo) error {
 	c.Lock()
 	defer c.Unlock()
@@ -69,6 +69,11 @@ func (c *RaftCluster) handleAskSplit(request *schedulerpb.AskSplitRequest) (*sch
 		NewPeerIds:  peerIDs,
 	}
 
+	c.RLock()
+	co := c.coordinator
+	c.RUnlock()
+	co.checkers.RecordRegionSplit([]uint64{reqRegion.GetId(), newRegionID})
+
 	return split, nil
 }
 
@@ -422,6 +422,18 @@ type ScheduleConfig struct {
 	RegionScheduleLimit uint64 `toml:"region-schedule-limit,omitempty" json:"region-schedule-limit"`
 	// ReplicaScheduleLimit is the max coexist replica schedules.
 	ReplicaScheduleLimit uint64 `toml:"replica-schedule-limit,omitempty" json:"replica-schedule-limit"`
+	// MergeScheduleLimit is the max coexist merge schedules.
+	MergeScheduleLimit uint64 `toml:"merge-schedule-limit,omitempty" json:"merge-schedule-limit"`
+	// HotRegionScheduleLimit is the max coexist hot region schedules.
+	HotRegionScheduleLimit uint64 `toml:"hot-region-schedule-limit,omitempty" json:"hot-region-schedule-limit"`
+	// HotRegionCacheHitsThreshold is the number of heartbeats a region must
+	// be hot in to be regarded as a hot region.
+	HotRegionCacheHitsThreshold uint64 `toml:"hot-region-cache-hits-threshold,omitempty" json:"hot-region-cache-hits-threshold"`
+	// If the size of region is smaller than this value (MB),
+	// it will try to merge with adjacent regions.
+	MaxMergeRegionSize uint64 `toml:"max-merge-region-size,omitempty" json:"max-merge-region-size"`
+	// SplitMergeInterval is the minimum interval time to permit merge after split.
+	SplitMergeInterval typeutil.Duration `toml:"split-merge-interval,omitempty" json:"split-merge-interval"`
 
 	// Schedulers support for loading customized schedulers
 	Schedulers SchedulerConfigs `toml:"schedulers,omitempty" json:"schedulers-v2"` // json v2 is for the sake of compatible upgrade
@@ -435,22 +447,32 @@ func (c *ScheduleConfig) Clone() *ScheduleConfig {
 	schedulers := make(SchedulerConfigs, len(c.Schedulers))
 	copy(schedulers, c.Schedulers)
 	return &ScheduleConfig{
-		PatrolRegionInterval: c.PatrolRegionInterval,
-		MaxStoreDownTime:     c.MaxStoreDownTime,
-		LeaderScheduleLimit:  c.LeaderScheduleLimit,
-		RegionScheduleLimit:  c.RegionScheduleLimit,
-		ReplicaScheduleLimit: c.ReplicaScheduleLimit,
-		Schedulers:           schedulers,
+		PatrolRegionInterval:        c.PatrolRegionInterval,
+		MaxStoreDownTime:            c.MaxStoreDownTime,
+		LeaderScheduleLimit:         c.LeaderScheduleLimit,
+		RegionScheduleLimit:         c.RegionScheduleLimit,
+		ReplicaScheduleLimit:        c.ReplicaScheduleLimit,
+		MergeScheduleLimit:          c.MergeScheduleLimit,
+		HotRegionScheduleLimit:      c.HotRegionScheduleLimit,
+		HotRegionCacheHitsThreshold: c.HotRegionCacheHitsThreshold,
+		MaxMergeRegionSize:          c.MaxMergeRegionSize,
+		SplitMergeInterval:          c.SplitMergeInterval,
+		Schedulers:                  schedulers,
 	}
 }
 
 const (
-	defaultMaxReplicas          = 3
-	defaultPatrolRegionInterval = 100 * time.Millisecond
-	defaultMaxStoreDownTime     = 30 * time.Minute
-	defaultLeaderScheduleLimit  = 4
-	defaultRegionScheduleLimit  = 2048
-	defaultReplicaScheduleLimit = 64
+	defaultMaxReplicas                 = 3
+	defaultPatrolRegionInterval        = 100 * time.Millisecond
+	defaultMaxStoreDownTime            = 30 * time.Minute
+	defaultLeaderScheduleLimit         = 4
+	defaultRegionScheduleLimit         = 2048
+	defaultReplicaScheduleLimit        = 64
+	defaultMergeScheduleLimit          = 8
+	defaultHotRegionScheduleLimit      = 4
+	defaultHotRegionCacheHitsThreshold = 3
+	defaultMaxMergeRegionSize          = 20
+	defaultSplitMergeInterval          = 1 * time.Hour
 )
 
 func (c *ScheduleConfig) adjust(meta *configMetaData) error {
@@ -465,6 +487,19 @@ func (c *ScheduleConfig) adjust(meta *configMetaData) error {
 	if !meta.IsDefined("replica-schedule-limit") {
 		adjustUint64(&c.ReplicaScheduleLimit, defaultReplicaScheduleLimit)
 	}
+	if !meta.IsDefined("merge-schedule-limit") {
+		adjustUint64(&c.MergeScheduleLimit, defaultMergeScheduleLimit)
+	}
+	if !meta.IsDefined("hot-region-schedule-limit") {
+		adjustUint64(&c.HotRegionScheduleLimit, defaultHotRegionScheduleLimit)
+	}
+	if !meta.IsDefined("hot-region-cache-hits-threshold") {
+		adjustUint64(&c.HotRegionCacheHitsThreshold, defaultHotRegionCacheHitsThreshold)
+	}
+	if !meta.IsDefined("max-merge-region-size") {
+		adjustUint64(&c.MaxMergeRegionSize, defaultMaxMergeRegionSize)
+	}
+	adjustDuration(&c.SplitMergeInterval, defaultSplitMergeInterval)
 	adjustSchedulers(&c.Schedulers, defaultSchedulers)
 
 	return c.Validate()
@@ -510,17 +545,46 @@ func IsDefaultScheduler(typ string) bool {
 type ReplicationConfig struct {
 	// MaxReplicas is the number of replicas for each region.
 	MaxReplicas uint64 `toml:"max-replicas,omitempty" json:"max-replicas"`
+
+	// LocationLabels is the location labels of the stores, from the upper
+	// level to the lower level, e.g. ["zone", "rack", "host"]. The replicas
+	// of a region are spread across the stores with different labels.
+	LocationLabels typeutil.StringSlice `toml:"location-labels" json:"location-labels"`
+
+	// EnablePlacementRules makes the replicas of a region placed as the
+	// placement rules covering its key range instead of MaxReplicas and
+	// LocationLabels.
+	EnablePlacementRules bool `toml:"enable-placement-rules" json:"enable-placement-rules"`
 }
 
 func (c *ReplicationConfig) clone() *ReplicationConfig {
+	locationLabels := make(typeutil.StringSlice, len(c.LocationLabels))
+	copy(locationLabels, c.LocationLabels)
 	return &ReplicationConfig{
-		MaxReplicas: c.MaxReplicas,
+		MaxReplicas:          c.MaxReplicas,
+		LocationLabels:       locationLabels,
+		EnablePlacementRules: c.EnablePlacementRules,
 	}
 }
 
 func (c *ReplicationConfig) adjust() error {
 	adjustUint64(&c.MaxReplicas, defaultMaxReplicas)
 
+	return c.validate()
+}
+
+func (c *ReplicationConfig) validate() error {
+	labels := make(map[string]struct{}, len(c.LocationLabels))
+	for _, label := range c.LocationLabels {
+		if label == "" {
+			return errors.New("location label should not be empty")
+		}
+		key := strings.ToLower(label)
+		if _, ok := labels[key]; ok {
+			return errors.Errorf("duplicated location label %v", label)
+		}
+		labels[key] = struct{}{}
+	}
 	return nil
 }
 
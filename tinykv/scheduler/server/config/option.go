@@ -65,6 +65,16 @@ func (o *ScheduleOption) SetMaxReplicas(replicas int) {
 	o.replication.SetMaxReplicas(replicas)
 }
 
+// GetLocationLabels returns the location labels for each region.
+func (o *ScheduleOption) GetLocationLabels() []string {
+	return o.replication.GetLocationLabels()
+}
+
+// IsPlacementRulesEnabled returns if the placement rules are enabled.
+func (o *ScheduleOption) IsPlacementRulesEnabled() bool {
+	return o.replication.IsPlacementRulesEnabled()
+}
+
 // GetPatrolRegionInterval returns the interval of patroling region.
 func (o *ScheduleOption) GetPatrolRegionInterval() time.Duration {
 	return o.Load().PatrolRegionInterval.Duration
@@ -90,6 +100,32 @@ func (o *ScheduleOption) GetReplicaScheduleLimit() uint64 {
 	return o.Load().ReplicaScheduleLimit
 }
 
+// GetMergeScheduleLimit returns the limit for merge schedule.
+func (o *ScheduleOption) GetMergeScheduleLimit() uint64 {
+	return o.Load().MergeScheduleLimit
+}
+
+// GetHotRegionScheduleLimit returns the limit for hot region schedule.
+func (o *ScheduleOption) GetHotRegionScheduleLimit() uint64 {
+	return o.Load().HotRegionScheduleLimit
+}
+
+// GetHotRegionCacheHitsThreshold returns the number of heartbeats a region
+// must be hot in to be regarded as a hot region.
+func (o *ScheduleOption) GetHotRegionCacheHitsThreshold() int {
+	return int(o.Load().HotRegionCacheHitsThreshold)
+}
+
+// GetMaxMergeRegionSize returns the max region size to merge.
+func (o *ScheduleOption) GetMaxMergeRegionSize() uint64 {
+	return o.Load().MaxMergeRegionSize
+}
+
+// GetSplitMergeInterval returns the interval between finishing split and starting to merge.
+func (o *ScheduleOption) GetSplitMergeInterval() time.Duration {
+	return o.Load().SplitMergeInterval.Duration
+}
+
 // GetSchedulers gets the scheduler configurations.
 func (o *ScheduleOption) GetSchedulers() SchedulerConfigs {
 	return o.Load().Schedulers
@@ -181,3 +217,13 @@ func (r *Replication) SetMaxReplicas(replicas int) {
 	v.MaxReplicas = uint64(replicas)
 	r.Store(v)
 }
+
+// GetLocationLabels returns the location labels for each region.
+func (r *Replication) GetLocationLabels() []string {
+	return r.Load().LocationLabels
+}
+
+// IsPlacementRulesEnabled returns if the placement rules are enabled.
+func (r *Replication) IsPlacementRulesEnabled() bool {
+	return r.Load().EnablePlacementRules
+}
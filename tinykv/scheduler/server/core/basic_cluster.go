@@ -291,6 +291,13 @@ func (bc *BasicCluster) SearchRegion(regionKey []byte) *RegionInfo {
 	return bc.Regions.SearchRegion(regionKey)
 }
 
+// GetAdjacentRegions returns region's info that is adjacent with specific region.
+func (bc *BasicCluster) GetAdjacentRegions(region *RegionInfo) (*RegionInfo, *RegionInfo) {
+	bc.RLock()
+	defer bc.RUnlock()
+	return bc.Regions.GetAdjacentRegions(region)
+}
+
 // SearchPrevRegion searches previous RegionInfo from regionTree.
 func (bc *BasicCluster) SearchPrevRegion(regionKey []byte) *RegionInfo {
 	bc.RLock()
@@ -331,6 +338,7 @@ type RegionSetInformer interface {
 	GetAverageRegionSize() int64
 	GetStoreRegionCount(storeID uint64) int
 	GetRegion(id uint64) *RegionInfo
+	GetAdjacentRegions(region *RegionInfo) (*RegionInfo, *RegionInfo)
 	ScanRegions(startKey, endKey []byte, limit int) []*RegionInfo
 }
 
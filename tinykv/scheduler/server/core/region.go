@@ -34,6 +34,11 @@ type RegionInfo struct {
 	leader          *metapb.Peer
 	pendingPeers    []*metapb.Peer
 	approximateSize int64
+	writtenBytes    uint64
+	writtenKeys     uint64
+	readBytes       uint64
+	readKeys        uint64
+	interval        *schedulerpb.TimeInterval
 }
 
 // NewRegionInfo creates RegionInfo with region's meta and leader peer.
@@ -52,10 +57,16 @@ func NewRegionInfo(region *metapb.Region, leader *metapb.Peer, opts ...RegionCre
 
 // classifyVoterAndLearner sorts out voter and learner from peers into different slice.
 func classifyVoterAndLearner(region *RegionInfo) {
+	learners := make([]*metapb.Peer, 0, 1)
 	voters := make([]*metapb.Peer, 0, len(region.meta.Peers))
 	for _, p := range region.meta.Peers {
-		voters = append(voters, p)
+		if p.IsLearner {
+			learners = append(learners, p)
+		} else {
+			voters = append(voters, p)
+		}
 	}
+	region.learners = learners
 	region.voters = voters
 }
 
@@ -77,6 +88,11 @@ func RegionFromHeartbeat(heartbeat *schedulerpb.RegionHeartbeatRequest) *RegionI
 		leader:          heartbeat.GetLeader(),
 		pendingPeers:    heartbeat.GetPendingPeers(),
 		approximateSize: int64(regionSize),
+		writtenBytes:    heartbeat.GetBytesWritten(),
+		writtenKeys:     heartbeat.GetKeysWritten(),
+		readBytes:       heartbeat.GetBytesRead(),
+		readKeys:        heartbeat.GetKeysRead(),
+		interval:        heartbeat.GetInterval(),
 	}
 
 	classifyVoterAndLearner(region)
@@ -95,6 +111,11 @@ func (r *RegionInfo) Clone(opts ...RegionCreateOption) *RegionInfo {
 		leader:          proto.Clone(r.leader).(*metapb.Peer),
 		pendingPeers:    pendingPeers,
 		approximateSize: r.approximateSize,
+		writtenBytes:    r.writtenBytes,
+		writtenKeys:     r.writtenKeys,
+		readBytes:       r.readBytes,
+		readKeys:        r.readKeys,
+		interval:        proto.Clone(r.interval).(*schedulerpb.TimeInterval),
 	}
 
 	for _, opt := range opts {
@@ -142,7 +163,7 @@ func (r *RegionInfo) GetPendingPeer(peerID uint64) *metapb.Peer {
 // GetPendingVoter returns the pending voter with specified peer id.
 func (r *RegionInfo) GetPendingVoter(peerID uint64) *metapb.Peer {
 	for _, peer := range r.pendingPeers {
-		if peer.GetId() == peerID {
+		if peer.GetId() == peerID && !peer.GetIsLearner() {
 			return peer
 		}
 	}
@@ -151,6 +172,11 @@ func (r *RegionInfo) GetPendingVoter(peerID uint64) *metapb.Peer {
 
 // GetPendingLearner returns the pending learner peer with specified peer id.
 func (r *RegionInfo) GetPendingLearner(peerID uint64) *metapb.Peer {
+	for _, peer := range r.pendingPeers {
+		if peer.GetId() == peerID && peer.GetIsLearner() {
+			return peer
+		}
+	}
 	return nil
 }
 
@@ -250,6 +276,34 @@ func (r *RegionInfo) GetApproximateSize() int64 {
 	return r.approximateSize
 }
 
+// GetBytesWritten returns the written bytes of the region in the last
+// report interval.
+func (r *RegionInfo) GetBytesWritten() uint64 {
+	return r.writtenBytes
+}
+
+// GetKeysWritten returns the written keys of the region in the last report
+// interval.
+func (r *RegionInfo) GetKeysWritten() uint64 {
+	return r.writtenKeys
+}
+
+// GetBytesRead returns the read bytes of the region in the last report
+// interval.
+func (r *RegionInfo) GetBytesRead() uint64 {
+	return r.readBytes
+}
+
+// GetKeysRead returns the read keys of the region in the last report interval.
+func (r *RegionInfo) GetKeysRead() uint64 {
+	return r.readKeys
+}
+
+// GetInterval returns the interval of the last flow report.
+func (r *RegionInfo) GetInterval() *schedulerpb.TimeInterval {
+	return r.interval
+}
+
 // GetPendingPeers returns the pending peers of the region.
 func (r *RegionInfo) GetPendingPeers() []*metapb.Peer {
 	return r.pendingPeers
@@ -541,6 +595,20 @@ func (r *RegionsInfo) SearchPrevRegion(regionKey []byte) *RegionInfo {
 	return r.GetRegion(region.GetID())
 }
 
+// GetAdjacentRegions returns region's info that is adjacent with specific region
+func (r *RegionsInfo) GetAdjacentRegions(region *RegionInfo) (*RegionInfo, *RegionInfo) {
+	p, n := r.tree.getAdjacentRegions(region)
+	var prev, next *RegionInfo
+	// check key to avoid key range hole
+	if p != nil && bytes.Equal(p.region.GetEndKey(), region.GetStartKey()) {
+		prev = r.GetRegion(p.region.GetID())
+	}
+	if n != nil && bytes.Equal(region.GetEndKey(), n.region.GetStartKey()) {
+		next = r.GetRegion(n.region.GetID())
+	}
+	return prev, next
+}
+
 // GetRegions gets all RegionInfo from regionMap
 func (r *RegionsInfo) GetRegions() []*RegionInfo {
 	regions := make([]*RegionInfo, 0, r.regions.Len())
@@ -15,6 +15,7 @@ package core
 
 import (
 	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/schedulerpb"
 )
 
 // RegionOption is used to select region.
@@ -51,10 +52,20 @@ func WithLeader(leader *metapb.Peer) RegionCreateOption {
 	}
 }
 
-// WithLearners adds learner to the region
-func WithLearners(learner []*metapb.Peer) RegionCreateOption {
+// WithLearners sets the learners for the region.
+func WithLearners(learners []*metapb.Peer) RegionCreateOption {
 	return func(region *RegionInfo) {
-		region.learners = append(region.learners, learner...)
+		peers := make([]*metapb.Peer, 0, len(region.meta.GetPeers()))
+		for _, p := range region.meta.GetPeers() {
+			for _, l := range learners {
+				if p.GetId() == l.GetId() {
+					p = &metapb.Peer{Id: l.GetId(), StoreId: l.GetStoreId(), IsLearner: true}
+					break
+				}
+			}
+			peers = append(peers, p)
+		}
+		region.meta.Peers = peers
 	}
 }
 
@@ -112,6 +123,41 @@ func SetApproximateSize(v int64) RegionCreateOption {
 	}
 }
 
+// SetWrittenBytes sets the written bytes for the region.
+func SetWrittenBytes(v uint64) RegionCreateOption {
+	return func(region *RegionInfo) {
+		region.writtenBytes = v
+	}
+}
+
+// SetWrittenKeys sets the written keys for the region.
+func SetWrittenKeys(v uint64) RegionCreateOption {
+	return func(region *RegionInfo) {
+		region.writtenKeys = v
+	}
+}
+
+// SetReadBytes sets the read bytes for the region.
+func SetReadBytes(v uint64) RegionCreateOption {
+	return func(region *RegionInfo) {
+		region.readBytes = v
+	}
+}
+
+// SetReadKeys sets the read keys for the region.
+func SetReadKeys(v uint64) RegionCreateOption {
+	return func(region *RegionInfo) {
+		region.readKeys = v
+	}
+}
+
+// SetReportInterval sets the report interval in seconds for the region.
+func SetReportInterval(v uint64) RegionCreateOption {
+	return func(region *RegionInfo) {
+		region.interval = &schedulerpb.TimeInterval{StartTimestamp: 0, EndTimestamp: v}
+	}
+}
+
 // SetPeers sets the peers for the region.
 func SetPeers(peers []*metapb.Peer) RegionCreateOption {
 	return func(region *RegionInfo) {
@@ -123,6 +169,21 @@ func SetPeers(peers []*metapb.Peer) RegionCreateOption {
 func WithAddPeer(peer *metapb.Peer) RegionCreateOption {
 	return func(region *RegionInfo) {
 		region.meta.Peers = append(region.meta.Peers, peer)
-		region.voters = append(region.voters, peer)
+		if peer.IsLearner {
+			region.learners = append(region.learners, peer)
+		} else {
+			region.voters = append(region.voters, peer)
+		}
+	}
+}
+
+// WithPromoteLearner promotes the learner.
+func WithPromoteLearner(peerID uint64) RegionCreateOption {
+	return func(region *RegionInfo) {
+		for _, p := range region.meta.GetPeers() {
+			if p.GetId() == peerID {
+				p.IsLearner = false
+			}
+		}
 	}
 }
@@ -14,6 +14,7 @@
 package core
 
 import (
+	"encoding/hex"
 	"fmt"
 	"math"
 	"path"
@@ -22,6 +23,7 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/schedulerpb"
 	"github.com/pingcap-incubator/tinykv/scheduler/server/kv"
 	"github.com/pkg/errors"
 	"go.etcd.io/etcd/clientv3"
@@ -31,6 +33,7 @@ const (
 	clusterPath  = "raft"
 	schedulePath = "schedule"
 	gcPath       = "gc"
+	rulesPath    = "rules"
 
 	customScheduleConfigPath = "scheduler_config"
 )
@@ -91,6 +94,44 @@ func (s *Storage) LoadScheduleConfig(scheduleName string) (string, error) {
 	return s.Load(configPath)
 }
 
+func rulePath(groupID, id string) string {
+	return path.Join(rulesPath, hex.EncodeToString([]byte(groupID))+"-"+hex.EncodeToString([]byte(id)))
+}
+
+// SaveRule saves a placement rule to storage.
+func (s *Storage) SaveRule(rule *schedulerpb.PlacementRule) error {
+	return saveProto(s.Base, rulePath(rule.GetGroupId(), rule.GetId()), rule)
+}
+
+// DeleteRule deletes a placement rule from storage.
+func (s *Storage) DeleteRule(groupID, id string) error {
+	return s.Remove(rulePath(groupID, id))
+}
+
+// LoadRules loads all placement rules from storage.
+func (s *Storage) LoadRules(f func(rule *schedulerpb.PlacementRule)) error {
+	prefix := rulesPath + "/"
+	endKey := clientv3.GetPrefixRangeEnd(prefix)
+	nextKey := prefix
+	for {
+		keys, res, err := s.LoadRange(nextKey, endKey, minKVRangeLimit)
+		if err != nil {
+			return err
+		}
+		for _, str := range res {
+			rule := &schedulerpb.PlacementRule{}
+			if err := rule.Unmarshal([]byte(str)); err != nil {
+				return errors.WithStack(err)
+			}
+			f(rule)
+		}
+		if len(res) < minKVRangeLimit {
+			return nil
+		}
+		nextKey = keys[len(keys)-1] + "\x00"
+	}
+}
+
 // LoadMeta loads cluster meta from storage.
 func (s *Storage) LoadMeta(meta *metapb.Cluster) (bool, error) {
 	return loadProto(s.Base, clusterPath, meta)
@@ -14,9 +14,11 @@
 package core
 
 import (
+	"fmt"
 	"math"
 
 	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/schedulerpb"
 	"github.com/pingcap-incubator/tinykv/scheduler/server/kv"
 	. "github.com/pingcap/check"
 )
@@ -115,3 +117,24 @@ func (s *testKVSuite) TestLoadGCSafePoint(c *C) {
 		c.Assert(safePoint, Equals, safePoint1)
 	}
 }
+
+func (s *testKVSuite) TestLoadRules(c *C) {
+	storage := NewStorage(kv.NewMemoryKV())
+	// Save more rules than a batch of LoadRange.
+	n := minKVRangeLimit + 10
+	for i := 0; i < n; i++ {
+		rule := &schedulerpb.PlacementRule{GroupId: "g", Id: fmt.Sprintf("%d", i), Count: 3}
+		c.Assert(storage.SaveRule(rule), IsNil)
+	}
+	// The group and id of a rule may contain "/".
+	c.Assert(storage.SaveRule(&schedulerpb.PlacementRule{GroupId: "g/1", Id: "0", Count: 5}), IsNil)
+	c.Assert(storage.DeleteRule("g", "0"), IsNil)
+
+	rules := make(map[string]*schedulerpb.PlacementRule)
+	c.Assert(storage.LoadRules(func(rule *schedulerpb.PlacementRule) {
+		rules[rule.GetGroupId()+"-"+rule.GetId()] = rule
+	}), IsNil)
+	c.Assert(rules, HasLen, n)
+	c.Assert(rules["g-0"], IsNil)
+	c.Assert(rules["g/1-0"].GetCount(), Equals, int32(5))
+}
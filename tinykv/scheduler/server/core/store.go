@@ -15,6 +15,8 @@ package core
 
 import (
 	"fmt"
+	"math"
+	"strings"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
@@ -40,6 +42,9 @@ type StoreInfo struct {
 	leaderWeight     float64
 	regionWeight     float64
 	available        func() bool
+	// rollingStats is shared by all the clones of the store, it's updated
+	// when the store stats are set.
+	rollingStats *RollingStoreStats
 }
 
 // NewStoreInfo creates StoreInfo with meta data.
@@ -49,6 +54,7 @@ func NewStoreInfo(store *metapb.Store, opts ...StoreCreateOption) *StoreInfo {
 		stats:        &schedulerpb.StoreStats{},
 		leaderWeight: 1.0,
 		regionWeight: 1.0,
+		rollingStats: newRollingStoreStats(),
 	}
 	for _, opt := range opts {
 		opt(storeInfo)
@@ -72,6 +78,7 @@ func (s *StoreInfo) Clone(opts ...StoreCreateOption) *StoreInfo {
 		leaderWeight:     s.leaderWeight,
 		regionWeight:     s.regionWeight,
 		available:        s.available,
+		rollingStats:     s.rollingStats,
 	}
 
 	for _, opt := range opts {
@@ -133,6 +140,37 @@ func (s *StoreInfo) GetID() uint64 {
 	return s.meta.GetId()
 }
 
+// GetLabels returns the labels of the store.
+func (s *StoreInfo) GetLabels() []*metapb.StoreLabel {
+	return s.meta.GetLabels()
+}
+
+// GetLabelValue returns the value of the label, or an empty string if the
+// label is not set.
+func (s *StoreInfo) GetLabelValue(key string) string {
+	for _, label := range s.GetLabels() {
+		if strings.EqualFold(label.GetKey(), key) {
+			return label.GetValue()
+		}
+	}
+	return ""
+}
+
+// CompareLocation compares the labels of two stores and returns the index of
+// the first location label whose values differ. It returns -1 if the two
+// stores are at the same location.
+func (s *StoreInfo) CompareLocation(other *StoreInfo, labels []string) int {
+	for i, key := range labels {
+		v1, v2 := s.GetLabelValue(key), other.GetLabelValue(key)
+		// A store without the label is considered at the same location with
+		// any other store.
+		if v1 != "" && v2 != "" && !strings.EqualFold(v1, v2) {
+			return i
+		}
+	}
+	return -1
+}
+
 // GetStoreStats returns the statistics information of the store.
 func (s *StoreInfo) GetStoreStats() *schedulerpb.StoreStats {
 	return s.stats
@@ -178,6 +216,31 @@ func (s *StoreInfo) GetStartTime() uint32 {
 	return s.stats.GetStartTime()
 }
 
+// GetRollingStoreStats returns the rolling flow of the store.
+func (s *StoreInfo) GetRollingStoreStats() *RollingStoreStats {
+	return s.rollingStats
+}
+
+// GetBytesWriteRate returns the rolling written bytes per second of the store.
+func (s *StoreInfo) GetBytesWriteRate() float64 {
+	return s.rollingStats.GetBytesWriteRate()
+}
+
+// GetBytesReadRate returns the rolling read bytes per second of the store.
+func (s *StoreInfo) GetBytesReadRate() float64 {
+	return s.rollingStats.GetBytesReadRate()
+}
+
+// GetKeysWriteRate returns the rolling written keys per second of the store.
+func (s *StoreInfo) GetKeysWriteRate() float64 {
+	return s.rollingStats.GetKeysWriteRate()
+}
+
+// GetKeysReadRate returns the rolling read keys per second of the store.
+func (s *StoreInfo) GetKeysReadRate() float64 {
+	return s.rollingStats.GetKeysReadRate()
+}
+
 // GetLeaderCount returns the leader count of the store.
 func (s *StoreInfo) GetLeaderCount() int {
 	return s.leaderCount
@@ -296,6 +359,25 @@ func (s *StoreInfo) GetUptime() time.Duration {
 	return 0
 }
 
+const replicaBaseScore = 100
+
+// DistinctScore returns the score that the other store is distinct from the
+// stores. A higher score means the other store is more isolated from the
+// stores, and a difference at an upper level location label, e.g. zone,
+// always outweighs the differences at the lower levels, e.g. host.
+func DistinctScore(labels []string, stores []*StoreInfo, other *StoreInfo) float64 {
+	var score float64
+	for _, s := range stores {
+		if s.GetID() == other.GetID() {
+			continue
+		}
+		if index := s.CompareLocation(other, labels); index != -1 {
+			score += math.Pow(replicaBaseScore, float64(len(labels)-index-1))
+		}
+	}
+	return score
+}
+
 var (
 	// If a store's last heartbeat is storeDisconnectDuration ago, the store will
 	// be marked as disconnected state. The value should be greater than tikv's
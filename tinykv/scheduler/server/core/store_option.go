@@ -42,6 +42,15 @@ func SetStoreState(state metapb.StoreState) StoreCreateOption {
 	}
 }
 
+// SetStoreLabels sets the labels for the store.
+func SetStoreLabels(labels []*metapb.StoreLabel) StoreCreateOption {
+	return func(store *StoreInfo) {
+		meta := proto.Clone(store.meta).(*metapb.Store)
+		meta.Labels = labels
+		store.meta = meta
+	}
+}
+
 // SetStoreBlock stops balancer from selecting the store.
 func SetStoreBlock() StoreCreateOption {
 	return func(store *StoreInfo) {
@@ -116,6 +125,7 @@ func SetLastHeartbeatTS(lastHeartbeatTS time.Time) StoreCreateOption {
 func SetStoreStats(stats *schedulerpb.StoreStats) StoreCreateOption {
 	return func(store *StoreInfo) {
 		store.stats = stats
+		store.rollingStats.Observe(stats)
 	}
 }
 
@@ -0,0 +1,87 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"sync"
+
+	"github.com/pingcap-incubator/tinykv/proto/pkg/schedulerpb"
+	"github.com/pingcap-incubator/tinykv/scheduler/pkg/movingavg"
+)
+
+// storeStatsRollingWindows is the number of store heartbeats the flow of a
+// store is averaged over.
+const storeStatsRollingWindows = 3
+
+// RollingStoreStats is the flow of a store averaged over the recent store
+// heartbeats.
+type RollingStoreStats struct {
+	sync.RWMutex
+	bytesWriteRate *movingavg.MovingAvg
+	bytesReadRate  *movingavg.MovingAvg
+	keysWriteRate  *movingavg.MovingAvg
+	keysReadRate   *movingavg.MovingAvg
+}
+
+func newRollingStoreStats() *RollingStoreStats {
+	return &RollingStoreStats{
+		bytesWriteRate: movingavg.NewMovingAvg(storeStatsRollingWindows),
+		bytesReadRate:  movingavg.NewMovingAvg(storeStatsRollingWindows),
+		keysWriteRate:  movingavg.NewMovingAvg(storeStatsRollingWindows),
+		keysReadRate:   movingavg.NewMovingAvg(storeStatsRollingWindows),
+	}
+}
+
+// Observe records the flow reported by a store heartbeat. The heartbeats
+// without a report interval carry no flow and are ignored.
+func (r *RollingStoreStats) Observe(stats *schedulerpb.StoreStats) {
+	interval := stats.GetInterval().GetEndTimestamp() - stats.GetInterval().GetStartTimestamp()
+	if interval == 0 {
+		return
+	}
+	r.Lock()
+	defer r.Unlock()
+	r.bytesWriteRate.Add(float64(stats.GetBytesWritten()) / float64(interval))
+	r.bytesReadRate.Add(float64(stats.GetBytesRead()) / float64(interval))
+	r.keysWriteRate.Add(float64(stats.GetKeysWritten()) / float64(interval))
+	r.keysReadRate.Add(float64(stats.GetKeysRead()) / float64(interval))
+}
+
+// GetBytesWriteRate returns the written bytes per second.
+func (r *RollingStoreStats) GetBytesWriteRate() float64 {
+	r.RLock()
+	defer r.RUnlock()
+	return r.bytesWriteRate.Get()
+}
+
+// GetBytesReadRate returns the read bytes per second.
+func (r *RollingStoreStats) GetBytesReadRate() float64 {
+	r.RLock()
+	defer r.RUnlock()
+	return r.bytesReadRate.Get()
+}
+
+// GetKeysWriteRate returns the written keys per second.
+func (r *RollingStoreStats) GetKeysWriteRate() float64 {
+	r.RLock()
+	defer r.RUnlock()
+	return r.keysWriteRate.Get()
+}
+
+// GetKeysReadRate returns the read keys per second.
+func (r *RollingStoreStats) GetKeysReadRate() float64 {
+	r.RLock()
+	defer r.RUnlock()
+	return r.keysReadRate.Get()
+}
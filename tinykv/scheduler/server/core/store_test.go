@@ -18,6 +18,7 @@ import (
 	"time"
 
 	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/schedulerpb"
 	. "github.com/pingcap/check"
 )
 
@@ -54,3 +55,75 @@ func (s *testConcurrencySuite) TestCloneStore(c *C) {
 	}()
 	wg.Wait()
 }
+
+var _ = Suite(&testRollingStoreStatsSuite{})
+
+type testRollingStoreStatsSuite struct{}
+
+func (s *testRollingStoreStatsSuite) TestObserve(c *C) {
+	store := NewStoreInfo(&metapb.Store{Id: 1})
+	newStats := func(bytesWritten uint64) *schedulerpb.StoreStats {
+		return &schedulerpb.StoreStats{
+			StoreId:      1,
+			BytesWritten: bytesWritten,
+			KeysWritten:  bytesWritten / 100,
+			Interval:     &schedulerpb.TimeInterval{StartTimestamp: 0, EndTimestamp: 10},
+		}
+	}
+
+	// The rolling stats are shared by the clones.
+	store = store.Clone(SetStoreStats(newStats(1000)))
+	c.Assert(store.GetBytesWriteRate(), Equals, 100.0)
+	c.Assert(store.GetKeysWriteRate(), Equals, 1.0)
+	store = store.Clone(SetStoreStats(newStats(3000)))
+	c.Assert(store.GetBytesWriteRate(), Equals, 200.0)
+	c.Assert(store.GetBytesReadRate(), Equals, 0.0)
+
+	// The stats without a report interval are ignored.
+	store = store.Clone(SetStoreStats(&schedulerpb.StoreStats{StoreId: 1}))
+	c.Assert(store.GetBytesWriteRate(), Equals, 200.0)
+}
+
+var _ = Suite(&testDistinctScoreSuite{})
+
+type testDistinctScoreSuite struct{}
+
+func (s *testDistinctScoreSuite) TestDistinctScore(c *C) {
+	labels := []string{"zone", "rack", "host"}
+	zones := []string{"z1", "z2", "z3"}
+	racks := []string{"r1", "r2", "r3"}
+	hosts := []string{"h1", "h2", "h3"}
+
+	var stores []*StoreInfo
+	for i, zone := range zones {
+		for j, rack := range racks {
+			for k, host := range hosts {
+				storeID := uint64(i*len(racks)*len(hosts) + j*len(hosts) + k)
+				storeLabels := []*metapb.StoreLabel{
+					{Key: "zone", Value: zone},
+					{Key: "rack", Value: rack},
+					{Key: "host", Value: host},
+				}
+				stores = append(stores, NewStoreInfo(&metapb.Store{Id: storeID, Labels: storeLabels}))
+
+				// Number of stores in different zones.
+				nzones := i * len(racks) * len(hosts)
+				// Number of stores in the same zone but in different racks.
+				nracks := j * len(hosts)
+				// Number of stores in the same rack but in different hosts.
+				nhosts := k
+				score := (nzones*replicaBaseScore+nracks)*replicaBaseScore + nhosts
+				c.Assert(DistinctScore(labels, stores, stores[len(stores)-1]), Equals, float64(score))
+			}
+		}
+	}
+	// A store without labels is at the same location with all the stores.
+	store := NewStoreInfo(&metapb.Store{Id: 100})
+	c.Assert(DistinctScore(labels, stores, store), Equals, 0.0)
+}
+
+func (s *testDistinctScoreSuite) TestGetLabelValue(c *C) {
+	store := NewStoreInfo(&metapb.Store{Id: 1}, SetStoreLabels([]*metapb.StoreLabel{{Key: "Zone", Value: "z1"}}))
+	c.Assert(store.GetLabelValue("zone"), Equals, "z1")
+	c.Assert(store.GetLabelValue("host"), Equals, "")
+}
@@ -677,6 +677,69 @@ func (s *Server) GetOperator(ctx context.Context, request *schedulerpb.GetOperat
 	}, nil
 }
 
+// GetPlacementRules implements gRPC PDServer.
+func (s *Server) GetPlacementRules(ctx context.Context, request *schedulerpb.GetPlacementRulesRequest) (*schedulerpb.GetPlacementRulesResponse, error) {
+	if err := s.validateRequest(request.GetHeader()); err != nil {
+		return nil, err
+	}
+
+	cluster := s.GetRaftCluster()
+	if cluster == nil {
+		return &schedulerpb.GetPlacementRulesResponse{Header: s.notBootstrappedHeader()}, nil
+	}
+
+	return &schedulerpb.GetPlacementRulesResponse{
+		Header: s.header(),
+		Rules:  cluster.GetRuleManager().GetAllRules(),
+	}, nil
+}
+
+// SetPlacementRule implements gRPC PDServer.
+func (s *Server) SetPlacementRule(ctx context.Context, request *schedulerpb.SetPlacementRuleRequest) (*schedulerpb.SetPlacementRuleResponse, error) {
+	if err := s.validateRequest(request.GetHeader()); err != nil {
+		return nil, err
+	}
+
+	cluster := s.GetRaftCluster()
+	if cluster == nil {
+		return &schedulerpb.SetPlacementRuleResponse{Header: s.notBootstrappedHeader()}, nil
+	}
+
+	if err := cluster.GetRuleManager().SetRule(request.GetRule()); err != nil {
+		return &schedulerpb.SetPlacementRuleResponse{
+			Header: s.errorHeader(&schedulerpb.Error{
+				Type:    schedulerpb.ErrorType_UNKNOWN,
+				Message: err.Error(),
+			}),
+		}, nil
+	}
+
+	return &schedulerpb.SetPlacementRuleResponse{Header: s.header()}, nil
+}
+
+// DeletePlacementRule implements gRPC PDServer.
+func (s *Server) DeletePlacementRule(ctx context.Context, request *schedulerpb.DeletePlacementRuleRequest) (*schedulerpb.DeletePlacementRuleResponse, error) {
+	if err := s.validateRequest(request.GetHeader()); err != nil {
+		return nil, err
+	}
+
+	cluster := s.GetRaftCluster()
+	if cluster == nil {
+		return &schedulerpb.DeletePlacementRuleResponse{Header: s.notBootstrappedHeader()}, nil
+	}
+
+	if err := cluster.GetRuleManager().DeleteRule(request.GetGroupId(), request.GetId()); err != nil {
+		return &schedulerpb.DeletePlacementRuleResponse{
+			Header: s.errorHeader(&schedulerpb.Error{
+				Type:    schedulerpb.ErrorType_UNKNOWN,
+				Message: err.Error(),
+			}),
+		}, nil
+	}
+
+	return &schedulerpb.DeletePlacementRuleResponse{Header: s.header()}, nil
+}
+
 // validateRequest checks if Server is leader and clusterID is matched.
 // TODO: Call it in gRPC intercepter.
 func (s *Server) validateRequest(header *schedulerpb.RequestHeader) error {
@@ -0,0 +1,127 @@
+// Copyright 2017 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checker
+
+import (
+	"context"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/proto/pkg/schedulerpb"
+	"github.com/pingcap-incubator/tinykv/scheduler/pkg/cache"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/operator"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/opt"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/placement"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+)
+
+// MergeChecker ensures region to merge with adjacent region when size is small
+type MergeChecker struct {
+	cluster    opt.Cluster
+	splitCache *cache.TTLUint64
+	startTime  time.Time // it's used to judge whether server recently start.
+}
+
+// NewMergeChecker creates a merge checker.
+func NewMergeChecker(ctx context.Context, cluster opt.Cluster) *MergeChecker {
+	splitInterval := cluster.GetSplitMergeInterval()
+	return &MergeChecker{
+		cluster:    cluster,
+		splitCache: cache.NewIDTTL(ctx, time.Minute, splitInterval),
+		startTime:  time.Now(),
+	}
+}
+
+// RecordRegionSplit put the recently split region into cache. MergeChecker
+// will skip check it for a while.
+func (m *MergeChecker) RecordRegionSplit(regionIDs []uint64) {
+	for _, regionID := range regionIDs {
+		m.splitCache.PutWithTTL(regionID, nil, m.cluster.GetSplitMergeInterval())
+	}
+}
+
+// Check verifies a region's replicas, creating an Operator if need.
+func (m *MergeChecker) Check(region *core.RegionInfo) []*operator.Operator {
+	expireTime := m.startTime.Add(m.cluster.GetSplitMergeInterval())
+	if time.Now().Before(expireTime) {
+		return nil
+	}
+
+	if m.splitCache.Exists(region.GetID()) {
+		return nil
+	}
+
+	// when the scheduler just started, it will load region meta from storage,
+	// but the size for these loaded region info is 0, the scheduler doesn't know
+	// the real size of one region until the first heartbeat of the region,
+	// thus here when size is 0, just skip.
+	if region.GetApproximateSize() == 0 {
+		return nil
+	}
+
+	// region is not small enough
+	if region.GetApproximateSize() > int64(m.cluster.GetMaxMergeRegionSize()) {
+		return nil
+	}
+
+	// skip region has pending peers or learner peers, or has not enough replicas
+	rule := m.cluster.GetRegionRule(region)
+	if !m.isRegionHealthy(region, rule) {
+		return nil
+	}
+
+	prev, next := m.cluster.GetAdjacentRegions(region)
+
+	var target *core.RegionInfo
+	if m.checkTarget(next, rule) {
+		target = next
+	}
+	if m.checkTarget(prev, rule) {
+		// allow a region can be merged by two ways, prefer the smaller one.
+		if target == nil || prev.GetApproximateSize() < next.GetApproximateSize() {
+			target = prev
+		}
+	}
+
+	if target == nil {
+		return nil
+	}
+
+	log.Debug("try to merge region", zap.Stringer("from", core.RegionToHexMeta(region.GetMeta())), zap.Stringer("to", core.RegionToHexMeta(target.GetMeta())))
+	ops, err := operator.CreateMergeRegionOperator("merge-region", m.cluster, region, target, operator.OpMerge)
+	if err != nil {
+		log.Debug("create merge region operator failed", zap.Error(err))
+		return nil
+	}
+	return ops
+}
+
+// checkTarget checks if the adjacent region can be merged with the region
+// following the rule. The regions following different placement rules are
+// never merged, otherwise the merged region may break the rules.
+func (m *MergeChecker) checkTarget(adjacent *core.RegionInfo, rule *schedulerpb.PlacementRule) bool {
+	if adjacent == nil || adjacent.GetLeader() == nil {
+		return false
+	}
+	adjacentRule := m.cluster.GetRegionRule(adjacent)
+	return placement.IsSameRule(rule, adjacentRule) && m.isRegionHealthy(adjacent, adjacentRule)
+}
+
+// isRegionHealthy returns true if the region has no pending peers and no
+// learners, and has exactly the replicas of its placement rule.
+func (m *MergeChecker) isRegionHealthy(region *core.RegionInfo, rule *schedulerpb.PlacementRule) bool {
+	return len(region.GetPendingPeers()) == 0 && len(region.GetLearners()) == 0 &&
+		len(region.GetPeers()) == int(rule.GetCount())
+}
@@ -0,0 +1,186 @@
+// Copyright 2017 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/scheduler/pkg/mock/mockcluster"
+	"github.com/pingcap-incubator/tinykv/scheduler/pkg/mock/mockoption"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/operator"
+	. "github.com/pingcap/check"
+)
+
+func TestChecker(t *testing.T) {
+	TestingT(t)
+}
+
+var _ = Suite(&testMergeCheckerSuite{})
+
+type testMergeCheckerSuite struct {
+	ctx     context.Context
+	cancel  context.CancelFunc
+	cluster *mockcluster.Cluster
+	mc      *MergeChecker
+	regions []*core.RegionInfo
+}
+
+func (s *testMergeCheckerSuite) SetUpTest(c *C) {
+	cfg := mockoption.NewScheduleOptions()
+	cfg.MaxMergeRegionSize = 2
+	cfg.MaxReplicas = 3
+	s.cluster = mockcluster.NewCluster(cfg)
+	s.regions = []*core.RegionInfo{
+		core.NewRegionInfo(
+			&metapb.Region{
+				Id:       1,
+				StartKey: []byte(""),
+				EndKey:   []byte("a"),
+				Peers: []*metapb.Peer{
+					{Id: 101, StoreId: 1},
+					{Id: 102, StoreId: 2},
+				},
+				RegionEpoch: &metapb.RegionEpoch{Version: 1, ConfVer: 1},
+			},
+			&metapb.Peer{Id: 101, StoreId: 1},
+			core.SetApproximateSize(1),
+		),
+		core.NewRegionInfo(
+			&metapb.Region{
+				Id:       2,
+				StartKey: []byte("a"),
+				EndKey:   []byte("t"),
+				Peers: []*metapb.Peer{
+					{Id: 104, StoreId: 1},
+					{Id: 105, StoreId: 4},
+					{Id: 106, StoreId: 5},
+				},
+				RegionEpoch: &metapb.RegionEpoch{Version: 1, ConfVer: 1},
+			},
+			&metapb.Peer{Id: 104, StoreId: 1},
+			core.SetApproximateSize(200),
+		),
+		core.NewRegionInfo(
+			&metapb.Region{
+				Id:       3,
+				StartKey: []byte("t"),
+				EndKey:   []byte("x"),
+				Peers: []*metapb.Peer{
+					{Id: 107, StoreId: 1},
+					{Id: 108, StoreId: 2},
+					{Id: 109, StoreId: 3},
+				},
+				RegionEpoch: &metapb.RegionEpoch{Version: 1, ConfVer: 1},
+			},
+			&metapb.Peer{Id: 108, StoreId: 2},
+			core.SetApproximateSize(1),
+		),
+		core.NewRegionInfo(
+			&metapb.Region{
+				Id:       4,
+				StartKey: []byte("x"),
+				EndKey:   []byte(""),
+				Peers: []*metapb.Peer{
+					{Id: 110, StoreId: 1},
+					{Id: 111, StoreId: 4},
+					{Id: 112, StoreId: 5},
+				},
+				RegionEpoch: &metapb.RegionEpoch{Version: 1, ConfVer: 1},
+			},
+			&metapb.Peer{Id: 110, StoreId: 1},
+			core.SetApproximateSize(300),
+		),
+	}
+
+	for _, region := range s.regions {
+		s.cluster.PutRegion(region)
+	}
+	for i := uint64(1); i <= 5; i++ {
+		s.cluster.AddRegionStore(i, 1)
+	}
+
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+	s.mc = NewMergeChecker(s.ctx, s.cluster)
+}
+
+func (s *testMergeCheckerSuite) TearDownTest(c *C) {
+	s.cancel()
+}
+
+func (s *testMergeCheckerSuite) TestBasic(c *C) {
+	// The region should have enough replicas.
+	ops := s.mc.Check(s.regions[0])
+	c.Assert(ops, IsNil)
+	// The size should be small enough.
+	ops = s.mc.Check(s.regions[1])
+	c.Assert(ops, IsNil)
+	ops = s.mc.Check(s.regions[2])
+	c.Assert(ops, NotNil)
+	// Merge with the previous region, which is smaller.
+	c.Assert(ops[0].RegionID(), Equals, s.regions[2].GetID())
+	c.Assert(ops[1].RegionID(), Equals, s.regions[1].GetID())
+
+	// Enlarge the previous region, the region should be merged into the next one.
+	s.cluster.PutRegion(s.regions[1].Clone(core.SetApproximateSize(400)))
+	s.regions[1] = s.cluster.GetRegion(2)
+	ops = s.mc.Check(s.regions[2])
+	c.Assert(ops, NotNil)
+	c.Assert(ops[0].RegionID(), Equals, s.regions[2].GetID())
+	c.Assert(ops[1].RegionID(), Equals, s.regions[3].GetID())
+
+	// Skip recently split regions.
+	s.cluster.GetOpt().SplitMergeInterval = time.Hour
+	s.mc.startTime = time.Now().Add(-2 * time.Hour)
+	s.mc.RecordRegionSplit([]uint64{s.regions[2].GetID()})
+	ops = s.mc.Check(s.regions[2])
+	c.Assert(ops, IsNil)
+}
+
+func (s *testMergeCheckerSuite) checkSteps(c *C, op *operator.Operator, steps []operator.OpStep) {
+	c.Assert(op.Kind()&operator.OpMerge, Not(Equals), operator.OpKind(0))
+	c.Assert(steps, NotNil)
+	c.Assert(op.Len(), Equals, len(steps))
+	for i := range steps {
+		c.Assert(op.Step(i), DeepEquals, steps[i])
+	}
+}
+
+func (s *testMergeCheckerSuite) TestMatchPeers(c *C) {
+	// partial store overlap including leader
+	ops := s.mc.Check(s.regions[2])
+	c.Assert(ops, NotNil)
+	s.checkSteps(c, ops[0], []operator.OpStep{
+		operator.AddPeer{ToStore: 4, PeerID: 1},
+		operator.AddPeer{ToStore: 5, PeerID: 2},
+		operator.TransferLeader{FromStore: 2, ToStore: 1},
+		operator.RemovePeer{FromStore: 2},
+		operator.RemovePeer{FromStore: 3},
+		operator.MergeRegion{
+			FromRegion: s.regions[2].GetMeta(),
+			ToRegion:   s.regions[1].GetMeta(),
+			IsPassive:  false,
+		},
+	})
+	s.checkSteps(c, ops[1], []operator.OpStep{
+		operator.MergeRegion{
+			FromRegion: s.regions[2].GetMeta(),
+			ToRegion:   s.regions[1].GetMeta(),
+			IsPassive:  true,
+		},
+	})
+}
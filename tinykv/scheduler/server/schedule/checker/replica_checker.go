@@ -17,10 +17,12 @@ import (
 	"fmt"
 
 	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/schedulerpb"
 	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
 	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/filter"
 	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/operator"
 	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/opt"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/placement"
 	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/selector"
 	"github.com/pingcap/log"
 	"go.uber.org/zap"
@@ -29,8 +31,9 @@ import (
 const replicaCheckerName = "replica-checker"
 
 const (
-	offlineStatus = "offline"
-	downStatus    = "down"
+	offlineStatus    = "offline"
+	downStatus       = "down"
+	mismatchedStatus = "mismatched"
 )
 
 // ReplicaChecker ensures region has the best replicas.
@@ -38,6 +41,8 @@ const (
 // Replica number management.
 // Unhealthy replica management, mainly used for disaster recovery of TiKV.
 // Location management, mainly used for cross data center deployment.
+// The replica number, the locations and the label constraints of a region
+// follow the placement rule of the region.
 type ReplicaChecker struct {
 	name    string
 	cluster opt.Cluster
@@ -63,14 +68,16 @@ func NewReplicaChecker(cluster opt.Cluster, n ...string) *ReplicaChecker {
 
 // Check verifies a region's replicas, creating an operator.Operator if need.
 func (r *ReplicaChecker) Check(region *core.RegionInfo) *operator.Operator {
-	if op := r.checkOfflinePeer(region); op != nil {
+	rule := r.cluster.GetRegionRule(region)
+	if op := r.checkOfflinePeer(region, rule); op != nil {
 		op.SetPriorityLevel(core.HighPriority)
 		return op
 	}
 
-	if len(region.GetPeers()) < r.cluster.GetMaxReplicas() {
+	maxReplicas := int(rule.GetCount())
+	if len(region.GetPeers()) < maxReplicas {
 		log.Debug("region has fewer than max replicas", zap.Uint64("region-id", region.GetID()), zap.Int("peers", len(region.GetPeers())))
-		newPeer := r.selectBestPeerToAddReplica(region)
+		newPeer := r.selectBestPeerToAddReplica(region, rule)
 		if newPeer == nil {
 			return nil
 		}
@@ -79,9 +86,9 @@ func (r *ReplicaChecker) Check(region *core.RegionInfo) *operator.Operator {
 
 	// when add learner peer, the number of peer will exceed max replicas for a while,
 	// just comparing the the number of voters to avoid too many cancel add operator log.
-	if len(region.GetVoters()) > r.cluster.GetMaxReplicas() {
+	if len(region.GetVoters()) > maxReplicas {
 		log.Debug("region has more than max replicas", zap.Uint64("region-id", region.GetID()), zap.Int("peers", len(region.GetPeers())))
-		oldPeer := r.selectWorstPeer(region)
+		oldPeer, _ := r.selectWorstPeer(region, rule)
 		if oldPeer == nil {
 			return nil
 		}
@@ -92,19 +99,27 @@ func (r *ReplicaChecker) Check(region *core.RegionInfo) *operator.Operator {
 		return op
 	}
 
-	return nil
+	if op := r.checkMismatchedPeer(region, rule); op != nil {
+		return op
+	}
+
+	return r.checkBestReplacement(region, rule)
 }
 
 // SelectBestReplacementStore returns a store id that to be used to replace the old peer and distinct score.
-func (r *ReplicaChecker) SelectBestReplacementStore(region *core.RegionInfo, oldPeer *metapb.Peer, filters ...filter.Filter) uint64 {
+func (r *ReplicaChecker) SelectBestReplacementStore(region *core.RegionInfo, oldPeer *metapb.Peer, filters ...filter.Filter) (uint64, float64) {
+	return r.selectBestReplacementStore(region, r.cluster.GetRegionRule(region), oldPeer, filters...)
+}
+
+func (r *ReplicaChecker) selectBestReplacementStore(region *core.RegionInfo, rule *schedulerpb.PlacementRule, oldPeer *metapb.Peer, filters ...filter.Filter) (uint64, float64) {
 	filters = append(filters, filter.NewExcludedFilter(r.name, nil, region.GetStoreIds()))
 	newRegion := region.Clone(core.WithRemoveStorePeer(oldPeer.GetStoreId()))
-	return r.selectBestStoreToAddReplica(newRegion, filters...)
+	return r.selectBestStoreToAddReplica(newRegion, rule, filters...)
 }
 
 // selectBestPeerToAddReplica returns a new peer that to be used to add a replica and distinct score.
-func (r *ReplicaChecker) selectBestPeerToAddReplica(region *core.RegionInfo, filters ...filter.Filter) *metapb.Peer {
-	storeID := r.selectBestStoreToAddReplica(region, filters...)
+func (r *ReplicaChecker) selectBestPeerToAddReplica(region *core.RegionInfo, rule *schedulerpb.PlacementRule, filters ...filter.Filter) *metapb.Peer {
+	storeID, _ := r.selectBestStoreToAddReplica(region, rule, filters...)
 	if storeID == 0 {
 		log.Debug("no best store to add replica", zap.Uint64("region-id", region.GetID()))
 		return nil
@@ -116,37 +131,80 @@ func (r *ReplicaChecker) selectBestPeerToAddReplica(region *core.RegionInfo, fil
 	return newPeer
 }
 
-// selectBestStoreToAddReplica returns the store to add a replica.
-func (r *ReplicaChecker) selectBestStoreToAddReplica(region *core.RegionInfo, filters ...filter.Filter) uint64 {
+// selectBestStoreToAddReplica returns the store to add a replica and its
+// distinct score.
+func (r *ReplicaChecker) selectBestStoreToAddReplica(region *core.RegionInfo, rule *schedulerpb.PlacementRule, filters ...filter.Filter) (uint64, float64) {
 	// Add some must have filters.
 	newFilters := []filter.Filter{
 		filter.NewStateFilter(r.name),
 		filter.NewExcludedFilter(r.name, nil, region.GetStoreIds()),
+		filter.NewLabelConstraintFilter(r.name, rule.GetLabelConstraints()),
 	}
 	filters = append(filters, r.filters...)
 	filters = append(filters, newFilters...)
 	regionStores := r.cluster.GetRegionStores(region)
-	s := selector.NewReplicaSelector(regionStores, r.filters...)
+	labels := rule.GetLocationLabels()
+	s := selector.NewReplicaSelector(regionStores, labels, r.filters...)
 	target := s.SelectTarget(r.cluster, r.cluster.GetStores(), filters...)
 	if target == nil {
-		return 0
+		return 0, 0
 	}
-	return target.GetID()
+	return target.GetID(), core.DistinctScore(labels, regionStores, target)
 }
 
-// selectWorstPeer returns the worst peer in the region.
-func (r *ReplicaChecker) selectWorstPeer(region *core.RegionInfo) *metapb.Peer {
+// selectWorstPeer returns the worst peer in the region and its distinct
+// score. The peers on the stores mismatching the label constraints are the
+// worst.
+func (r *ReplicaChecker) selectWorstPeer(region *core.RegionInfo, rule *schedulerpb.PlacementRule) (*metapb.Peer, float64) {
 	regionStores := r.cluster.GetRegionStores(region)
-	s := selector.NewReplicaSelector(regionStores, r.filters...)
+	labels := rule.GetLocationLabels()
+	for _, store := range regionStores {
+		if !placement.MatchLabelConstraints(store, rule.GetLabelConstraints()) {
+			return region.GetStorePeer(store.GetID()), core.DistinctScore(labels, regionStores, store)
+		}
+	}
+	s := selector.NewReplicaSelector(regionStores, labels, r.filters...)
 	worstStore := s.SelectSource(r.cluster, regionStores)
 	if worstStore == nil {
 		log.Debug("no worst store", zap.Uint64("region-id", region.GetID()))
+		return nil, 0
+	}
+	return region.GetStorePeer(worstStore.GetID()), core.DistinctScore(labels, regionStores, worstStore)
+}
+
+// checkBestReplacement moves the worst peer of the region to a store at a
+// better location, so the replicas are spread across the failure domains
+// described by the location labels.
+func (r *ReplicaChecker) checkBestReplacement(region *core.RegionInfo, rule *schedulerpb.PlacementRule) *operator.Operator {
+	if len(rule.GetLocationLabels()) == 0 {
+		return nil
+	}
+	oldPeer, oldScore := r.selectWorstPeer(region, rule)
+	if oldPeer == nil {
+		return nil
+	}
+	storeID, newScore := r.selectBestReplacementStore(region, rule, oldPeer)
+	if storeID == 0 {
+		log.Debug("no replacement store", zap.Uint64("region-id", region.GetID()))
+		return nil
+	}
+	// Make sure the new peer is better than the old peer.
+	if newScore <= oldScore {
+		log.Debug("no better peer", zap.Uint64("region-id", region.GetID()), zap.Float64("new-score", newScore), zap.Float64("old-score", oldScore))
+		return nil
+	}
+	newPeer, err := r.cluster.AllocPeer(storeID)
+	if err != nil {
+		return nil
+	}
+	op, err := operator.CreateMovePeerOperator("move-to-better-location", r.cluster, region, operator.OpReplica, oldPeer.GetStoreId(), newPeer.GetStoreId(), newPeer.GetId())
+	if err != nil {
 		return nil
 	}
-	return region.GetStorePeer(worstStore.GetID())
+	return op
 }
 
-func (r *ReplicaChecker) checkOfflinePeer(region *core.RegionInfo) *operator.Operator {
+func (r *ReplicaChecker) checkOfflinePeer(region *core.RegionInfo, rule *schedulerpb.PlacementRule) *operator.Operator {
 	// just skip learner
 	if len(region.GetLearners()) != 0 {
 		return nil
@@ -163,16 +221,39 @@ func (r *ReplicaChecker) checkOfflinePeer(region *core.RegionInfo) *operator.Ope
 			continue
 		}
 
-		return r.fixPeer(region, peer, offlineStatus)
+		return r.fixPeer(region, rule, peer, offlineStatus)
+	}
+
+	return nil
+}
+
+// checkMismatchedPeer replaces the peer on the store which doesn't match the
+// label constraints of the placement rule.
+func (r *ReplicaChecker) checkMismatchedPeer(region *core.RegionInfo, rule *schedulerpb.PlacementRule) *operator.Operator {
+	if len(rule.GetLabelConstraints()) == 0 {
+		return nil
+	}
+	// just skip learner
+	if len(region.GetLearners()) != 0 {
+		return nil
+	}
+
+	for _, peer := range region.GetPeers() {
+		store := r.cluster.GetStore(peer.GetStoreId())
+		if store == nil || placement.MatchLabelConstraints(store, rule.GetLabelConstraints()) {
+			continue
+		}
+
+		return r.fixPeer(region, rule, peer, mismatchedStatus)
 	}
 
 	return nil
 }
 
-func (r *ReplicaChecker) fixPeer(region *core.RegionInfo, peer *metapb.Peer, status string) *operator.Operator {
+func (r *ReplicaChecker) fixPeer(region *core.RegionInfo, rule *schedulerpb.PlacementRule, peer *metapb.Peer, status string) *operator.Operator {
 	removeExtra := fmt.Sprintf("remove-extra-%s-replica", status)
 	// Check the number of replicas first.
-	if len(region.GetPeers()) > r.cluster.GetMaxReplicas() {
+	if len(region.GetPeers()) > int(rule.GetCount()) {
 		op, err := operator.CreateRemovePeerOperator(removeExtra, r.cluster, operator.OpReplica, region, peer.GetStoreId())
 		if err != nil {
 			return nil
@@ -180,7 +261,7 @@ func (r *ReplicaChecker) fixPeer(region *core.RegionInfo, peer *metapb.Peer, sta
 		return op
 	}
 
-	storeID := r.SelectBestReplacementStore(region, peer)
+	storeID, _ := r.selectBestReplacementStore(region, rule, peer)
 	if storeID == 0 {
 		log.Debug("no best store to add replica", zap.Uint64("region-id", region.GetID()))
 		return nil
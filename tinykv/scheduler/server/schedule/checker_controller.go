@@ -27,6 +27,7 @@ type CheckerController struct {
 	cluster        opt.Cluster
 	opController   *OperatorController
 	replicaChecker *checker.ReplicaChecker
+	mergeChecker   *checker.MergeChecker
 }
 
 // NewCheckerController create a new CheckerController.
@@ -36,6 +37,7 @@ func NewCheckerController(ctx context.Context, cluster opt.Cluster, opController
 		cluster:        cluster,
 		opController:   opController,
 		replicaChecker: checker.NewReplicaChecker(cluster),
+		mergeChecker:   checker.NewMergeChecker(ctx, cluster),
 	}
 }
 
@@ -51,5 +53,19 @@ func (c *CheckerController) CheckRegion(region *core.RegionInfo) (bool, []*opera
 			return checkerIsBusy, []*operator.Operator{op}
 		}
 	}
+
+	if opController.OperatorCount(operator.OpMerge) < c.cluster.GetMergeScheduleLimit() {
+		checkerIsBusy = false
+		if ops := c.mergeChecker.Check(region); ops != nil {
+			// It makes sure that two operators can be added successfully altogether.
+			return checkerIsBusy, ops
+		}
+	}
 	return checkerIsBusy, nil
 }
+
+// RecordRegionSplit records the regions which are split recently, the merge
+// checker will not merge them for a while.
+func (c *CheckerController) RecordRegionSplit(regionIDs []uint64) {
+	c.mergeChecker.RecordRegionSplit(regionIDs)
+}
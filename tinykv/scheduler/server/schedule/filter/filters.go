@@ -14,9 +14,11 @@
 package filter
 
 import (
+	"github.com/pingcap-incubator/tinykv/proto/pkg/schedulerpb"
 	"github.com/pingcap-incubator/tinykv/scheduler/pkg/slice"
 	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
 	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/opt"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/placement"
 )
 
 //revive:disable:unused-parameter
@@ -161,6 +163,75 @@ func (f *healthFilter) Target(opt opt.Options, store *core.StoreInfo) bool {
 	return f.filter(opt, store)
 }
 
+type distinctScoreFilter struct {
+	scope     string
+	labels    []string
+	stores    []*core.StoreInfo
+	safeScore float64
+}
+
+// NewDistinctScoreFilter creates a filter that filters all stores that have
+// lower distinct score than specified store.
+func NewDistinctScoreFilter(scope string, labels []string, stores []*core.StoreInfo, source *core.StoreInfo) Filter {
+	newStores := make([]*core.StoreInfo, 0, len(stores))
+	for _, s := range stores {
+		if s.GetID() == source.GetID() {
+			continue
+		}
+		newStores = append(newStores, s)
+	}
+
+	return &distinctScoreFilter{
+		scope:     scope,
+		labels:    labels,
+		stores:    newStores,
+		safeScore: core.DistinctScore(labels, newStores, source),
+	}
+}
+
+func (f *distinctScoreFilter) Scope() string {
+	return f.scope
+}
+
+func (f *distinctScoreFilter) Type() string {
+	return "distinct-filter"
+}
+
+func (f *distinctScoreFilter) Source(opt opt.Options, store *core.StoreInfo) bool {
+	return false
+}
+
+func (f *distinctScoreFilter) Target(opt opt.Options, store *core.StoreInfo) bool {
+	return core.DistinctScore(f.labels, f.stores, store) < f.safeScore
+}
+
+type labelConstraintFilter struct {
+	scope       string
+	constraints []*schedulerpb.LabelConstraint
+}
+
+// NewLabelConstraintFilter creates a filter that filters all stores that
+// don't match the label constraints of a placement rule.
+func NewLabelConstraintFilter(scope string, constraints []*schedulerpb.LabelConstraint) Filter {
+	return &labelConstraintFilter{scope: scope, constraints: constraints}
+}
+
+func (f *labelConstraintFilter) Scope() string {
+	return f.scope
+}
+
+func (f *labelConstraintFilter) Type() string {
+	return "label-constraint-filter"
+}
+
+func (f *labelConstraintFilter) Source(opt opt.Options, store *core.StoreInfo) bool {
+	return false
+}
+
+func (f *labelConstraintFilter) Target(opt opt.Options, store *core.StoreInfo) bool {
+	return !placement.MatchLabelConstraints(store, f.constraints)
+}
+
 // StoreStateFilter is used to determine whether a store can be selected as the
 // source or target of the schedule based on the store's state.
 type StoreStateFilter struct {
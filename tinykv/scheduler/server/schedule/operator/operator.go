@@ -14,6 +14,7 @@
 package operator
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"strings"
@@ -95,6 +96,64 @@ func (ap AddPeer) IsFinish(region *core.RegionInfo) bool {
 	return false
 }
 
+// AddLearner is an OpStep that adds a region learner peer.
+type AddLearner struct {
+	ToStore, PeerID uint64
+}
+
+// ConfVerChanged returns true if the conf version has been changed by this step
+func (al AddLearner) ConfVerChanged(region *core.RegionInfo) bool {
+	if p := region.GetStorePeer(al.ToStore); p != nil {
+		return p.GetId() == al.PeerID
+	}
+	return false
+}
+
+func (al AddLearner) String() string {
+	return fmt.Sprintf("add learner peer %v on store %v", al.PeerID, al.ToStore)
+}
+
+// IsFinish checks if current step is finished. The learner is considered
+// finished once it has caught up with the leader.
+func (al AddLearner) IsFinish(region *core.RegionInfo) bool {
+	if p := region.GetStoreLearner(al.ToStore); p != nil {
+		if p.GetId() != al.PeerID {
+			log.Warn("obtain unexpected peer", zap.String("expect", al.String()), zap.Uint64("obtain-learner", p.GetId()))
+			return false
+		}
+		return region.GetPendingLearner(p.GetId()) == nil
+	}
+	return false
+}
+
+// PromoteLearner is an OpStep that promotes a region learner peer to normal voter.
+type PromoteLearner struct {
+	ToStore, PeerID uint64
+}
+
+// ConfVerChanged returns true if the conf version has been changed by this step
+func (pl PromoteLearner) ConfVerChanged(region *core.RegionInfo) bool {
+	if p := region.GetStoreVoter(pl.ToStore); p != nil {
+		return p.GetId() == pl.PeerID
+	}
+	return false
+}
+
+func (pl PromoteLearner) String() string {
+	return fmt.Sprintf("promote learner peer %v on store %v to voter", pl.PeerID, pl.ToStore)
+}
+
+// IsFinish checks if current step is finished.
+func (pl PromoteLearner) IsFinish(region *core.RegionInfo) bool {
+	if p := region.GetStoreVoter(pl.ToStore); p != nil {
+		if p.GetId() != pl.PeerID {
+			log.Warn("obtain unexpected peer", zap.String("expect", pl.String()), zap.Uint64("obtain-voter", p.GetId()))
+		}
+		return p.GetId() == pl.PeerID
+	}
+	return false
+}
+
 // RemovePeer is an OpStep that removes a region peer.
 type RemovePeer struct {
 	FromStore uint64
@@ -114,6 +173,38 @@ func (rp RemovePeer) IsFinish(region *core.RegionInfo) bool {
 	return region.GetStorePeer(rp.FromStore) == nil
 }
 
+// MergeRegion is an OpStep that merge two regions.
+type MergeRegion struct {
+	FromRegion *metapb.Region
+	ToRegion   *metapb.Region
+	// there are two regions involved in merge process,
+	// so to keep them from other scheduler,
+	// both of them should add MerRegion operatorStep.
+	// But actually, TiKV just needs the region want to be merged to get the merge request,
+	// thus use a IsPassive mark to indicate that
+	// this region doesn't need to send merge request to TiKV.
+	IsPassive bool
+}
+
+// ConfVerChanged returns true if the conf version has been changed by this step
+func (mr MergeRegion) ConfVerChanged(region *core.RegionInfo) bool {
+	return false
+}
+
+func (mr MergeRegion) String() string {
+	return fmt.Sprintf("merge region %v into region %v", mr.FromRegion.GetId(), mr.ToRegion.GetId())
+}
+
+// IsFinish checks if current step is finished. The passive step is finished
+// once the range of the target region is extended, while the source region
+// is removed from the cluster after the merge.
+func (mr MergeRegion) IsFinish(region *core.RegionInfo) bool {
+	if mr.IsPassive {
+		return !bytes.Equal(region.GetStartKey(), mr.ToRegion.StartKey) || !bytes.Equal(region.GetEndKey(), mr.ToRegion.EndKey)
+	}
+	return false
+}
+
 // Operator contains execution steps generated by scheduler.
 type Operator struct {
 	desc        string
@@ -304,6 +395,14 @@ func CreateAddPeerOperator(desc string, region *core.RegionInfo, peerID uint64,
 	return NewOperator(desc, brief, region.GetID(), region.GetRegionEpoch(), kind|OpRegion, steps...)
 }
 
+// CreateAddLearnerOperator creates an operator that adds a new peer as a learner,
+// and promotes it to a voter once it has caught up with the leader.
+func CreateAddLearnerOperator(desc string, region *core.RegionInfo, peerID uint64, toStoreID uint64, kind OpKind) *Operator {
+	steps := CreateAddLearnerSteps(toStoreID, peerID)
+	brief := fmt.Sprintf("add peer: store %v", toStoreID)
+	return NewOperator(desc, brief, region.GetID(), region.GetRegionEpoch(), kind|OpRegion, steps...)
+}
+
 // CreateRemovePeerOperator creates an operator that removes a peer from region.
 func CreateRemovePeerOperator(desc string, cluster Cluster, kind OpKind, region *core.RegionInfo, storeID uint64) (*Operator, error) {
 	removeKind, steps, err := removePeerSteps(cluster, region, storeID, getRegionFollowerIDs(region))
@@ -322,6 +421,16 @@ func CreateAddPeerSteps(newStore uint64, peerID uint64) []OpStep {
 	return st
 }
 
+// CreateAddLearnerSteps creates an OpStep list that add a new learner peer
+// and promote it to a voter.
+func CreateAddLearnerSteps(newStore uint64, peerID uint64) []OpStep {
+	st := []OpStep{
+		AddLearner{ToStore: newStore, PeerID: peerID},
+		PromoteLearner{ToStore: newStore, PeerID: peerID},
+	}
+	return st
+}
+
 // CreateTransferLeaderOperator creates an operator that transfers the leader from a source store to a target store.
 func CreateTransferLeaderOperator(desc string, region *core.RegionInfo, sourceStoreID uint64, targetStoreID uint64, kind OpKind) *Operator {
 	step := TransferLeader{FromStore: sourceStoreID, ToStore: targetStoreID}
@@ -379,6 +488,77 @@ func CreateOfflinePeerOperator(desc string, cluster Cluster, region *core.Region
 	return NewOperator(desc, brief, region.GetID(), region.GetRegionEpoch(), kind|OpRegion, steps...), nil
 }
 
+// CreateMergeRegionOperator creates an operator that merge two region into one.
+func CreateMergeRegionOperator(desc string, cluster Cluster, source *core.RegionInfo, target *core.RegionInfo, kind OpKind) ([]*Operator, error) {
+	kinds, steps, err := matchPeerSteps(cluster, source, target)
+	if err != nil {
+		return nil, err
+	}
+
+	steps = append(steps, MergeRegion{
+		FromRegion: source.GetMeta(),
+		ToRegion:   target.GetMeta(),
+		IsPassive:  false,
+	})
+
+	brief := fmt.Sprintf("merge: region %v to %v", source.GetID(), target.GetID())
+	op1 := NewOperator(desc, brief, source.GetID(), source.GetRegionEpoch(), kinds|kind|OpMerge, steps...)
+	op2 := NewOperator(desc, brief, target.GetID(), target.GetRegionEpoch(), kinds|kind|OpMerge, MergeRegion{
+		FromRegion: source.GetMeta(),
+		ToRegion:   target.GetMeta(),
+		IsPassive:  true,
+	})
+
+	return []*Operator{op1, op2}, nil
+}
+
+// matchPeerSteps returns the steps to match the location of peer stores of source region with target's.
+func matchPeerSteps(cluster Cluster, source *core.RegionInfo, target *core.RegionInfo) (OpKind, []OpStep, error) {
+	var kind OpKind
+
+	sourcePeers := source.GetPeers()
+	targetPeers := target.GetPeers()
+
+	// make sure the peer count is same
+	if len(sourcePeers) != len(targetPeers) {
+		return kind, nil, errors.New("mismatch count of peer")
+	}
+
+	var steps []OpStep
+	targetStores := make([]uint64, 0, len(targetPeers))
+	for _, peer := range targetPeers {
+		storeID := peer.GetStoreId()
+		targetStores = append(targetStores, storeID)
+		if source.GetStorePeer(storeID) != nil {
+			continue
+		}
+		newPeer, err := cluster.AllocPeer(storeID)
+		if err != nil {
+			log.Debug("peer alloc failed", zap.Error(err))
+			return kind, nil, err
+		}
+		steps = append(steps, CreateAddPeerSteps(storeID, newPeer.Id)...)
+		kind |= OpRegion
+	}
+
+	for _, peer := range sourcePeers {
+		storeID := peer.GetStoreId()
+		if target.GetStorePeer(storeID) != nil {
+			continue
+		}
+		// The leader can only be transferred to the stores which the target
+		// region is located on, the peers on them have been added already.
+		k, st, err := removePeerSteps(cluster, source, storeID, targetStores)
+		if err != nil {
+			return kind, nil, err
+		}
+		steps = append(steps, st...)
+		kind |= k
+	}
+
+	return kind, steps, nil
+}
+
 func getRegionFollowerIDs(region *core.RegionInfo) []uint64 {
 	var ids []uint64
 	for id := range region.GetFollowers() {
@@ -107,6 +107,34 @@ func (s *testOperatorSuite) TestOperatorStep(c *C) {
 	c.Assert(RemovePeer{FromStore: 3}.IsFinish(region), IsTrue)
 }
 
+func (s *testOperatorSuite) TestAddLearnerOperator(c *C) {
+	region := s.newTestRegion(1, 1, [2]uint64{1, 1}, [2]uint64{2, 2})
+	op := CreateAddLearnerOperator("test", region, 3, 3, OpReplica)
+	s.checkSteps(c, op, []OpStep{
+		AddLearner{ToStore: 3, PeerID: 3},
+		PromoteLearner{ToStore: 3, PeerID: 3},
+	})
+	c.Assert(op.Check(region), Equals, AddLearner{ToStore: 3, PeerID: 3})
+
+	// the learner is added but still pending
+	learner := &metapb.Peer{Id: 3, StoreId: 3, IsLearner: true}
+	region = region.Clone(core.WithAddPeer(learner), core.WithPendingPeers([]*metapb.Peer{learner}))
+	c.Assert(region.GetStoreLearner(3), NotNil)
+	c.Assert(region.GetStoreVoter(3), IsNil)
+	c.Assert(op.Check(region), Equals, AddLearner{ToStore: 3, PeerID: 3})
+	c.Assert(op.ConfVerChanged(region), Equals, 1)
+
+	// the learner has caught up, promote it
+	region = region.Clone(core.WithPendingPeers(nil))
+	c.Assert(op.Check(region), Equals, PromoteLearner{ToStore: 3, PeerID: 3})
+
+	region = region.Clone(core.WithPromoteLearner(3))
+	c.Assert(region.GetStoreVoter(3), NotNil)
+	c.Assert(op.Check(region), IsNil)
+	c.Assert(op.IsFinish(), IsTrue)
+	c.Assert(op.ConfVerChanged(region), Equals, 2)
+}
+
 func (s *testOperatorSuite) newTestOperator(regionID uint64, kind OpKind, steps ...OpStep) *Operator {
 	return NewOperator("test", "test", regionID, &metapb.RegionEpoch{}, OpAdmin|kind, steps...)
 }
@@ -110,6 +110,7 @@ func (oc *OperatorController) Dispatch(region *core.RegionInfo, source string) {
 		if op.IsFinish() && oc.RemoveOperator(op) {
 			log.Info("operator finish", zap.Uint64("region-id", region.GetID()), zap.Duration("takes", op.RunningTime()), zap.Reflect("operator", op))
 			oc.opRecords.Put(op, schedulerpb.OperatorStatus_SUCCESS)
+			oc.finishMergeSource(op)
 		} else if timeout && oc.RemoveOperator(op) {
 			log.Info("operator timeout", zap.Uint64("region-id", region.GetID()), zap.Duration("takes", op.RunningTime()), zap.Reflect("operator", op))
 			oc.opRecords.Put(op, schedulerpb.OperatorStatus_TIMEOUT)
@@ -117,6 +118,23 @@ func (oc *OperatorController) Dispatch(region *core.RegionInfo, source string) {
 	}
 }
 
+// finishMergeSource removes the operator of the source region once the passive
+// merge operator of the target region is finished, as the source region has
+// been merged and will never send heartbeats again.
+func (oc *OperatorController) finishMergeSource(op *operator.Operator) {
+	if op.Len() == 0 {
+		return
+	}
+	step, ok := op.Step(op.Len() - 1).(operator.MergeRegion)
+	if !ok || !step.IsPassive {
+		return
+	}
+	if source := oc.GetOperator(step.FromRegion.GetId()); source != nil && source.Kind()&operator.OpMerge != 0 && oc.RemoveOperator(source) {
+		log.Info("operator finish", zap.Uint64("region-id", source.RegionID()), zap.Duration("takes", source.RunningTime()), zap.Reflect("operator", source))
+		oc.opRecords.Put(source, schedulerpb.OperatorStatus_SUCCESS)
+	}
+}
+
 func (oc *OperatorController) getNextPushOperatorTime(step operator.OpStep, now time.Time) time.Time {
 	nextTime := slowNotifyInterval
 	switch step.(type) {
@@ -275,6 +293,34 @@ func (oc *OperatorController) SendScheduleCommand(region *core.RegionInfo, step
 			},
 		}
 		oc.hbStreams.SendMsg(region, cmd)
+	case operator.AddLearner:
+		if region.GetStorePeer(st.ToStore) != nil {
+			// The newly added learner is pending.
+			return
+		}
+		cmd := &schedulerpb.RegionHeartbeatResponse{
+			ChangePeer: &schedulerpb.ChangePeer{
+				ChangeType: eraftpb.ConfChangeType_AddLearnerNode,
+				Peer: &metapb.Peer{
+					Id:        st.PeerID,
+					StoreId:   st.ToStore,
+					IsLearner: true,
+				},
+			},
+		}
+		oc.hbStreams.SendMsg(region, cmd)
+	case operator.PromoteLearner:
+		cmd := &schedulerpb.RegionHeartbeatResponse{
+			ChangePeer: &schedulerpb.ChangePeer{
+				// reuse AddNode type, the learner is promoted to a voter.
+				ChangeType: eraftpb.ConfChangeType_AddNode,
+				Peer: &metapb.Peer{
+					Id:      st.PeerID,
+					StoreId: st.ToStore,
+				},
+			},
+		}
+		oc.hbStreams.SendMsg(region, cmd)
 	case operator.RemovePeer:
 		cmd := &schedulerpb.RegionHeartbeatResponse{
 			ChangePeer: &schedulerpb.ChangePeer{
@@ -283,6 +329,16 @@ func (oc *OperatorController) SendScheduleCommand(region *core.RegionInfo, step
 			},
 		}
 		oc.hbStreams.SendMsg(region, cmd)
+	case operator.MergeRegion:
+		if st.IsPassive {
+			return
+		}
+		cmd := &schedulerpb.RegionHeartbeatResponse{
+			Merge: &schedulerpb.Merge{
+				Target: st.ToRegion,
+			},
+		}
+		oc.hbStreams.SendMsg(region, cmd)
 	default:
 		log.Error("unknown operator step", zap.Reflect("step", step))
 	}
@@ -17,7 +17,9 @@ import (
 	"time"
 
 	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/schedulerpb"
 	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/statistics"
 )
 
 // Options for schedulers.
@@ -25,10 +27,18 @@ type Options interface {
 	GetLeaderScheduleLimit() uint64
 	GetRegionScheduleLimit() uint64
 	GetReplicaScheduleLimit() uint64
+	GetMergeScheduleLimit() uint64
+	GetHotRegionScheduleLimit() uint64
+
+	GetHotRegionCacheHitsThreshold() int
+
+	GetMaxMergeRegionSize() uint64
+	GetSplitMergeInterval() time.Duration
 
 	GetMaxStoreDownTime() time.Duration
 
 	GetMaxReplicas() int
+	GetLocationLabels() []string
 }
 
 // Cluster provides an overview of a cluster's regions distribution.
@@ -40,6 +50,13 @@ type Cluster interface {
 
 	Options
 
+	RegionWriteStats() map[uint64][]*statistics.HotPeerStat
+	RegionReadStats() map[uint64][]*statistics.HotPeerStat
+	IsRegionHot(region *core.RegionInfo) bool
+	// GetRegionRule returns the placement rule the replicas of the region
+	// should follow.
+	GetRegionRule(region *core.RegionInfo) *schedulerpb.PlacementRule
+
 	// TODO: it should be removed. Schedulers don't need to know anything
 	// about peers.
 	AllocPeer(storeID uint64) (*metapb.Peer, error)
@@ -0,0 +1,143 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package placement
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/pingcap-incubator/tinykv/proto/pkg/schedulerpb"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultGroupID is the group ID of the default rule.
+	DefaultGroupID = "pd"
+	// DefaultRuleID is the ID of the default rule.
+	DefaultRuleID = "default"
+)
+
+// NewDefaultRule creates the rule which covers the whole key space and
+// places the replicas as the replication config.
+func NewDefaultRule(maxReplicas int, locationLabels []string) *schedulerpb.PlacementRule {
+	return &schedulerpb.PlacementRule{
+		GroupId:        DefaultGroupID,
+		Id:             DefaultRuleID,
+		Count:          int32(maxReplicas),
+		LocationLabels: append([]string(nil), locationLabels...),
+	}
+}
+
+// IsSameRule checks if the two rules have the same group and ID.
+func IsSameRule(a, b *schedulerpb.PlacementRule) bool {
+	return a.GetGroupId() == b.GetGroupId() && a.GetId() == b.GetId()
+}
+
+// MatchLabelConstraints checks if the store matches all the label constraints.
+func MatchLabelConstraints(store *core.StoreInfo, constraints []*schedulerpb.LabelConstraint) bool {
+	for _, constraint := range constraints {
+		if !matchLabelConstraint(store, constraint) {
+			return false
+		}
+	}
+	return true
+}
+
+func matchLabelConstraint(store *core.StoreInfo, constraint *schedulerpb.LabelConstraint) bool {
+	value := store.GetLabelValue(constraint.GetKey())
+	switch constraint.GetOp() {
+	case schedulerpb.LabelConstraintOp_In:
+		return value != "" && containsValue(constraint.GetValues(), value)
+	case schedulerpb.LabelConstraintOp_NotIn:
+		return value == "" || !containsValue(constraint.GetValues(), value)
+	case schedulerpb.LabelConstraintOp_Exists:
+		return value != ""
+	case schedulerpb.LabelConstraintOp_NotExists:
+		return value == ""
+	}
+	return false
+}
+
+func containsValue(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateRule checks if the rule is well formed.
+func validateRule(rule *schedulerpb.PlacementRule) error {
+	if rule.GetGroupId() == "" {
+		return errors.New("group id should not be empty")
+	}
+	if rule.GetId() == "" {
+		return errors.New("id should not be empty")
+	}
+	if rule.GetCount() <= 0 {
+		return errors.Errorf("invalid count %d", rule.GetCount())
+	}
+	if len(rule.GetEndKey()) > 0 && bytes.Compare(rule.GetStartKey(), rule.GetEndKey()) >= 0 {
+		return errors.New("start key should be less than end key")
+	}
+	for _, constraint := range rule.GetLabelConstraints() {
+		if constraint.GetKey() == "" {
+			return errors.New("label constraint key should not be empty")
+		}
+		switch constraint.GetOp() {
+		case schedulerpb.LabelConstraintOp_In, schedulerpb.LabelConstraintOp_NotIn:
+			if len(constraint.GetValues()) == 0 {
+				return errors.Errorf("label constraint %s %s should have values", constraint.GetKey(), constraint.GetOp())
+			}
+		case schedulerpb.LabelConstraintOp_Exists, schedulerpb.LabelConstraintOp_NotExists:
+		default:
+			return errors.Errorf("unknown label constraint op %d", constraint.GetOp())
+		}
+	}
+	for _, label := range rule.GetLocationLabels() {
+		if label == "" {
+			return errors.New("location label should not be empty")
+		}
+	}
+	return nil
+}
+
+// coversRange checks if the rule covers the key range [startKey, endKey).
+func coversRange(rule *schedulerpb.PlacementRule, startKey, endKey []byte) bool {
+	if bytes.Compare(rule.GetStartKey(), startKey) > 0 {
+		return false
+	}
+	if len(rule.GetEndKey()) == 0 {
+		return true
+	}
+	return len(endKey) > 0 && bytes.Compare(endKey, rule.GetEndKey()) <= 0
+}
+
+// compareRule compares the priority of the two rules, the rule with larger
+// index has higher priority, and the rules with the same index are ordered by
+// group and ID.
+func compareRule(a, b *schedulerpb.PlacementRule) int {
+	switch {
+	case a.GetIndex() < b.GetIndex():
+		return -1
+	case a.GetIndex() > b.GetIndex():
+		return 1
+	case a.GetGroupId() != b.GetGroupId():
+		return strings.Compare(a.GetGroupId(), b.GetGroupId())
+	default:
+		return strings.Compare(a.GetId(), b.GetId())
+	}
+}
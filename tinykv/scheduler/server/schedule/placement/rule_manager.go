@@ -0,0 +1,161 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package placement
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/schedulerpb"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+)
+
+type ruleKey struct {
+	groupID string
+	id      string
+}
+
+// RuleManager is responsible for the lifecycle of all placement rules. The
+// rules are persisted in the storage, and a region follows the rule with the
+// highest priority among the rules covering its key range. A region across
+// the boundary of a rule is not covered by it until the region is split at
+// the boundary.
+type RuleManager struct {
+	sync.RWMutex
+	storage *core.Storage
+	rules   map[ruleKey]*schedulerpb.PlacementRule
+	// sortedRules is sorted by the priority in descending order.
+	sortedRules []*schedulerpb.PlacementRule
+}
+
+// NewRuleManager creates a RuleManager instance.
+func NewRuleManager(storage *core.Storage) *RuleManager {
+	return &RuleManager{
+		storage: storage,
+		rules:   make(map[ruleKey]*schedulerpb.PlacementRule),
+	}
+}
+
+// Initialize loads the rules from the storage. If there is no rule, the
+// default rule is created with the replication config.
+func (m *RuleManager) Initialize(maxReplicas int, locationLabels []string) error {
+	m.Lock()
+	defer m.Unlock()
+
+	err := m.storage.LoadRules(func(rule *schedulerpb.PlacementRule) {
+		if err := validateRule(rule); err != nil {
+			log.Error("invalid placement rule, ignore it", zap.Stringer("rule", rule), zap.Error(err))
+			return
+		}
+		m.rules[ruleKey{rule.GetGroupId(), rule.GetId()}] = rule
+	})
+	if err != nil {
+		return err
+	}
+	if len(m.rules) == 0 {
+		rule := NewDefaultRule(maxReplicas, locationLabels)
+		if err := m.storage.SaveRule(rule); err != nil {
+			return err
+		}
+		m.rules[ruleKey{rule.GetGroupId(), rule.GetId()}] = rule
+	}
+	m.sortRules()
+	return nil
+}
+
+// GetRule returns the rule with the group and ID, or nil if it doesn't exist.
+func (m *RuleManager) GetRule(groupID, id string) *schedulerpb.PlacementRule {
+	m.RLock()
+	defer m.RUnlock()
+	if rule, ok := m.rules[ruleKey{groupID, id}]; ok {
+		return proto.Clone(rule).(*schedulerpb.PlacementRule)
+	}
+	return nil
+}
+
+// GetAllRules returns all the rules sorted by the priority in descending
+// order.
+func (m *RuleManager) GetAllRules() []*schedulerpb.PlacementRule {
+	m.RLock()
+	defer m.RUnlock()
+	rules := make([]*schedulerpb.PlacementRule, 0, len(m.sortedRules))
+	for _, rule := range m.sortedRules {
+		rules = append(rules, proto.Clone(rule).(*schedulerpb.PlacementRule))
+	}
+	return rules
+}
+
+// SetRule creates a rule or updates the existing rule with the same group
+// and ID.
+func (m *RuleManager) SetRule(rule *schedulerpb.PlacementRule) error {
+	if err := validateRule(rule); err != nil {
+		return err
+	}
+	rule = proto.Clone(rule).(*schedulerpb.PlacementRule)
+
+	m.Lock()
+	defer m.Unlock()
+	if err := m.storage.SaveRule(rule); err != nil {
+		return err
+	}
+	m.rules[ruleKey{rule.GetGroupId(), rule.GetId()}] = rule
+	m.sortRules()
+	log.Info("placement rule updated", zap.Stringer("rule", rule))
+	return nil
+}
+
+// DeleteRule deletes the rule with the group and ID.
+func (m *RuleManager) DeleteRule(groupID, id string) error {
+	m.Lock()
+	defer m.Unlock()
+	key := ruleKey{groupID, id}
+	if _, ok := m.rules[key]; !ok {
+		return nil
+	}
+	if err := m.storage.DeleteRule(groupID, id); err != nil {
+		return err
+	}
+	delete(m.rules, key)
+	m.sortRules()
+	log.Info("placement rule deleted", zap.String("group-id", groupID), zap.String("id", id))
+	return nil
+}
+
+// GetRuleForRegion returns the rule with the highest priority among the rules
+// covering the key range of the region, or nil if there is no such rule. The
+// returned rule must not be modified.
+func (m *RuleManager) GetRuleForRegion(region *core.RegionInfo) *schedulerpb.PlacementRule {
+	m.RLock()
+	defer m.RUnlock()
+	for _, rule := range m.sortedRules {
+		if coversRange(rule, region.GetStartKey(), region.GetEndKey()) {
+			return rule
+		}
+	}
+	return nil
+}
+
+func (m *RuleManager) sortRules() {
+	sortedRules := make([]*schedulerpb.PlacementRule, 0, len(m.rules))
+	for _, rule := range m.rules {
+		sortedRules = append(sortedRules, rule)
+	}
+	sort.Slice(sortedRules, func(i, j int) bool {
+		return compareRule(sortedRules[i], sortedRules[j]) > 0
+	})
+	m.sortedRules = sortedRules
+}
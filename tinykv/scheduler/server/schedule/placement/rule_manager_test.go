@@ -0,0 +1,151 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package placement
+
+import (
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/schedulerpb"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/kv"
+	. "github.com/pingcap/check"
+)
+
+func Test(t *testing.T) {
+	TestingT(t)
+}
+
+var _ = Suite(&testRuleManagerSuite{})
+
+type testRuleManagerSuite struct {
+	storage *core.Storage
+	manager *RuleManager
+}
+
+func (s *testRuleManagerSuite) SetUpTest(c *C) {
+	s.storage = core.NewStorage(kv.NewMemoryKV())
+	s.manager = NewRuleManager(s.storage)
+	c.Assert(s.manager.Initialize(3, []string{"zone", "host"}), IsNil)
+}
+
+func (s *testRuleManagerSuite) TestDefaultRule(c *C) {
+	rule := s.manager.GetRule(DefaultGroupID, DefaultRuleID)
+	c.Assert(rule, NotNil)
+	c.Assert(rule.GetCount(), Equals, int32(3))
+	c.Assert(rule.GetLocationLabels(), DeepEquals, []string{"zone", "host"})
+
+	// The default rule is persisted and not recreated with the new config.
+	manager := NewRuleManager(s.storage)
+	c.Assert(manager.Initialize(5, nil), IsNil)
+	c.Assert(manager.GetAllRules(), HasLen, 1)
+	c.Assert(manager.GetRule(DefaultGroupID, DefaultRuleID).GetCount(), Equals, int32(3))
+}
+
+func (s *testRuleManagerSuite) TestSetAndDeleteRule(c *C) {
+	invalidRules := []*schedulerpb.PlacementRule{
+		{Id: "r", Count: 3},
+		{GroupId: "g", Count: 3},
+		{GroupId: "g", Id: "r"},
+		{GroupId: "g", Id: "r", Count: 3, StartKey: []byte("b"), EndKey: []byte("a")},
+		{GroupId: "g", Id: "r", Count: 3, LabelConstraints: []*schedulerpb.LabelConstraint{{Key: "zone", Op: schedulerpb.LabelConstraintOp_In}}},
+		{GroupId: "g", Id: "r", Count: 3, LocationLabels: []string{""}},
+	}
+	for _, rule := range invalidRules {
+		c.Assert(s.manager.SetRule(rule), NotNil)
+	}
+
+	rule := &schedulerpb.PlacementRule{GroupId: "g", Id: "r", Index: 1, StartKey: []byte("a"), EndKey: []byte("b"), Count: 5}
+	c.Assert(s.manager.SetRule(rule), IsNil)
+	// The rule is cloned when it's set.
+	rule.Count = 1
+	c.Assert(s.manager.GetRule("g", "r").GetCount(), Equals, int32(5))
+	rules := s.manager.GetAllRules()
+	c.Assert(rules, HasLen, 2)
+	c.Assert(rules[0].GetId(), Equals, "r")
+	c.Assert(rules[1].GetId(), Equals, DefaultRuleID)
+
+	// The rules are persisted.
+	manager := NewRuleManager(s.storage)
+	c.Assert(manager.Initialize(3, nil), IsNil)
+	c.Assert(manager.GetAllRules(), DeepEquals, rules)
+
+	c.Assert(s.manager.DeleteRule("g", "r"), IsNil)
+	c.Assert(s.manager.GetRule("g", "r"), IsNil)
+	c.Assert(s.manager.GetAllRules(), HasLen, 1)
+	// Delete a nonexistent rule.
+	c.Assert(s.manager.DeleteRule("g", "r"), IsNil)
+}
+
+func (s *testRuleManagerSuite) TestGetRuleForRegion(c *C) {
+	rules := []*schedulerpb.PlacementRule{
+		{GroupId: "g", Id: "r1", Index: 1, StartKey: []byte("a"), EndKey: []byte("d"), Count: 5},
+		{GroupId: "g", Id: "r2", Index: 2, StartKey: []byte("b"), EndKey: []byte("c"), Count: 1},
+		{GroupId: "g", Id: "r3", Index: 1, StartKey: []byte("x"), Count: 4},
+	}
+	for _, rule := range rules {
+		c.Assert(s.manager.SetRule(rule), IsNil)
+	}
+
+	testCases := []struct {
+		startKey, endKey string
+		id               string
+	}{
+		{"", "a", DefaultRuleID},
+		{"a", "b", "r1"},
+		{"b", "c", "r2"},
+		{"b", "bb", "r2"},
+		// The region across the boundary of r2.
+		{"bb", "cc", "r1"},
+		// The region across the boundary of r1.
+		{"c", "e", DefaultRuleID},
+		{"x", "y", "r3"},
+		{"y", "", "r3"},
+		{"w", "", DefaultRuleID},
+	}
+	for _, t := range testCases {
+		region := core.NewTestRegionInfo([]byte(t.startKey), []byte(t.endKey))
+		c.Assert(s.manager.GetRuleForRegion(region).GetId(), Equals, t.id)
+	}
+
+	// No rule covers the region after the default rule is deleted.
+	c.Assert(s.manager.DeleteRule(DefaultGroupID, DefaultRuleID), IsNil)
+	c.Assert(s.manager.GetRuleForRegion(core.NewTestRegionInfo([]byte(""), []byte("a"))), IsNil)
+}
+
+func (s *testRuleManagerSuite) TestMatchLabelConstraints(c *C) {
+	store := core.NewStoreInfo(&metapb.Store{Id: 1, Labels: []*metapb.StoreLabel{
+		{Key: "zone", Value: "z1"},
+		{Key: "disk", Value: "ssd"},
+	}})
+	testCases := []struct {
+		constraint *schedulerpb.LabelConstraint
+		match      bool
+	}{
+		{&schedulerpb.LabelConstraint{Key: "zone", Op: schedulerpb.LabelConstraintOp_In, Values: []string{"z1", "z2"}}, true},
+		{&schedulerpb.LabelConstraint{Key: "zone", Op: schedulerpb.LabelConstraintOp_In, Values: []string{"z2"}}, false},
+		{&schedulerpb.LabelConstraint{Key: "host", Op: schedulerpb.LabelConstraintOp_In, Values: []string{"h1"}}, false},
+		{&schedulerpb.LabelConstraint{Key: "zone", Op: schedulerpb.LabelConstraintOp_NotIn, Values: []string{"z1"}}, false},
+		{&schedulerpb.LabelConstraint{Key: "zone", Op: schedulerpb.LabelConstraintOp_NotIn, Values: []string{"z2"}}, true},
+		{&schedulerpb.LabelConstraint{Key: "host", Op: schedulerpb.LabelConstraintOp_NotIn, Values: []string{"h1"}}, true},
+		{&schedulerpb.LabelConstraint{Key: "disk", Op: schedulerpb.LabelConstraintOp_Exists}, true},
+		{&schedulerpb.LabelConstraint{Key: "host", Op: schedulerpb.LabelConstraintOp_Exists}, false},
+		{&schedulerpb.LabelConstraint{Key: "disk", Op: schedulerpb.LabelConstraintOp_NotExists}, false},
+		{&schedulerpb.LabelConstraint{Key: "host", Op: schedulerpb.LabelConstraintOp_NotExists}, true},
+	}
+	for _, t := range testCases {
+		c.Assert(MatchLabelConstraints(store, []*schedulerpb.LabelConstraint{t.constraint}), Equals, t.match)
+	}
+	c.Assert(MatchLabelConstraints(store, nil), IsTrue)
+}
@@ -25,13 +25,15 @@ import (
 // distinct scores based on a region's peer stores.
 type ReplicaSelector struct {
 	regionStores []*core.StoreInfo
+	labels       []string
 	filters      []filter.Filter
 }
 
 // NewReplicaSelector creates a ReplicaSelector instance.
-func NewReplicaSelector(regionStores []*core.StoreInfo, filters ...filter.Filter) *ReplicaSelector {
+func NewReplicaSelector(regionStores []*core.StoreInfo, labels []string, filters ...filter.Filter) *ReplicaSelector {
 	return &ReplicaSelector{
 		regionStores: regionStores,
+		labels:       labels,
 		filters:      filters,
 	}
 }
@@ -40,11 +42,13 @@ func NewReplicaSelector(regionStores []*core.StoreInfo, filters ...filter.Filter
 // distinct score.
 func (s *ReplicaSelector) SelectSource(opt opt.Options, stores []*core.StoreInfo) *core.StoreInfo {
 	var (
-		best *core.StoreInfo
+		best      *core.StoreInfo
+		bestScore float64
 	)
 	for _, store := range stores {
-		if best == nil || compareStoreScore(store, best) < 0 {
-			best = store
+		score := core.DistinctScore(s.labels, s.regionStores, store)
+		if best == nil || compareStoreScore(store, score, best, bestScore) < 0 {
+			best, bestScore = store, score
 		}
 	}
 	if best == nil || filter.Source(opt, best, s.filters) {
@@ -57,14 +61,16 @@ func (s *ReplicaSelector) SelectSource(opt opt.Options, stores []*core.StoreInfo
 // distinct score.
 func (s *ReplicaSelector) SelectTarget(opt opt.Options, stores []*core.StoreInfo, filters ...filter.Filter) *core.StoreInfo {
 	var (
-		best *core.StoreInfo
+		best      *core.StoreInfo
+		bestScore float64
 	)
 	for _, store := range stores {
 		if filter.Target(opt, store, filters) {
 			continue
 		}
-		if best == nil || compareStoreScore(store, best) > 0 {
-			best = store
+		score := core.DistinctScore(s.labels, s.regionStores, store)
+		if best == nil || compareStoreScore(store, score, best, bestScore) > 0 {
+			best, bestScore = store, score
 		}
 	}
 	if best == nil || filter.Target(opt, best, s.filters) {
@@ -77,7 +83,14 @@ func (s *ReplicaSelector) SelectTarget(opt opt.Options, stores []*core.StoreInfo
 // Returns 0 if store A is as good as store B.
 // Returns 1 if store A is better than store B.
 // Returns -1 if store B is better than store A.
-func compareStoreScore(storeA *core.StoreInfo, storeB *core.StoreInfo) int {
+func compareStoreScore(storeA *core.StoreInfo, scoreA float64, storeB *core.StoreInfo, scoreB float64) int {
+	// The store with higher distinct score is better.
+	if scoreA > scoreB {
+		return 1
+	}
+	if scoreA < scoreB {
+		return -1
+	}
 	// The store with lower region score is better.
 	if storeA.GetRegionSize() <
 		storeB.GetRegionSize() {
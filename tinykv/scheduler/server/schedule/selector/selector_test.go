@@ -42,7 +42,11 @@ func (s *testSelectorSuite) TestCompareStoreScore(c *C) {
 	store2 := core.NewStoreInfoWithIdAndCount(2, 1)
 	store3 := core.NewStoreInfoWithIdAndCount(3, 3)
 
-	c.Assert(compareStoreScore(store1, store2), Equals, 0)
+	c.Assert(compareStoreScore(store1, 2, store2, 1), Equals, 1)
+	c.Assert(compareStoreScore(store1, 1, store2, 1), Equals, 0)
+	c.Assert(compareStoreScore(store1, 1, store2, 2), Equals, -1)
 
-	c.Assert(compareStoreScore(store1, store3), Equals, 1)
+	c.Assert(compareStoreScore(store1, 2, store3, 1), Equals, 1)
+	c.Assert(compareStoreScore(store1, 1, store3, 1), Equals, 1)
+	c.Assert(compareStoreScore(store1, 1, store3, 2), Equals, -1)
 }
@@ -35,6 +35,21 @@ func ApplyOperatorStep(region *core.RegionInfo, op *operator.Operator) *core.Reg
 				StoreId: s.ToStore,
 			}
 			region = region.Clone(core.WithAddPeer(peer))
+		case operator.AddLearner:
+			if region.GetStorePeer(s.ToStore) != nil {
+				panic("Add learner that exists")
+			}
+			peer := &metapb.Peer{
+				Id:        s.PeerID,
+				StoreId:   s.ToStore,
+				IsLearner: true,
+			}
+			region = region.Clone(core.WithAddPeer(peer))
+		case operator.PromoteLearner:
+			if region.GetStoreLearner(s.ToStore) == nil {
+				panic("Promote peer that doesn't exist")
+			}
+			region = region.Clone(core.WithPromoteLearner(s.PeerID))
 		case operator.RemovePeer:
 			if region.GetStorePeer(s.FromStore) == nil {
 				panic("Remove peer that doesn't exist")
@@ -17,8 +17,10 @@ import (
 	"sort"
 
 	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/schedulerpb"
 	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
 	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/filter"
 	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/operator"
 	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/opt"
 	"github.com/pingcap/log"
@@ -122,13 +124,14 @@ func (s *balanceRegionScheduler) Schedule(cluster opt.Cluster) *operator.Operato
 			log.Debug("select region", zap.String("scheduler", s.GetName()), zap.Uint64("region-id", region.GetID()))
 
 			// We don't schedule region with abnormal number of replicas.
-			if len(region.GetPeers()) != cluster.GetMaxReplicas() {
+			rule := cluster.GetRegionRule(region)
+			if len(region.GetPeers()) != int(rule.GetCount()) {
 				log.Debug("region has abnormal replica count", zap.String("scheduler", s.GetName()), zap.Uint64("region-id", region.GetID()))
 				continue
 			}
 
 			oldPeer := region.GetStorePeer(sourceID)
-			if op := s.transferPeer(cluster, region, oldPeer); op != nil {
+			if op := s.transferPeer(cluster, region, rule, oldPeer); op != nil {
 				return op
 			}
 		}
@@ -137,14 +140,15 @@ func (s *balanceRegionScheduler) Schedule(cluster opt.Cluster) *operator.Operato
 }
 
 // transferPeer selects the best store to create a new peer to replace the old peer.
-func (s *balanceRegionScheduler) transferPeer(cluster opt.Cluster, region *core.RegionInfo, oldPeer *metapb.Peer) *operator.Operator {
+func (s *balanceRegionScheduler) transferPeer(cluster opt.Cluster, region *core.RegionInfo, rule *schedulerpb.PlacementRule, oldPeer *metapb.Peer) *operator.Operator {
 	sourceStoreID := oldPeer.GetStoreId()
 	source := cluster.GetStore(sourceStoreID)
 	if source == nil {
 		log.Error("failed to get the source store", zap.Uint64("store-id", sourceStoreID))
+		return nil
 	}
 
-	storeID := selectBestReplacementStore(cluster, region)
+	storeID := selectBestReplacementStore(cluster, region, rule, source, s.GetName())
 	if storeID == 0 {
 		return nil
 	}
@@ -174,10 +178,17 @@ func (s *balanceRegionScheduler) transferPeer(cluster opt.Cluster, region *core.
 	return op
 }
 
-func selectBestReplacementStore(cluster opt.Cluster, region *core.RegionInfo) uint64 {
+// selectBestReplacementStore returns the store with the minimal region size
+// to replace the peer on the source store, the replicas of the region must be
+// at least as isolated as before and match the placement rule.
+func selectBestReplacementStore(cluster opt.Cluster, region *core.RegionInfo, rule *schedulerpb.PlacementRule, source *core.StoreInfo, scope string) uint64 {
 	var (
 		best *core.StoreInfo
 	)
+	filters := []filter.Filter{
+		filter.NewDistinctScoreFilter(scope, rule.GetLocationLabels(), cluster.GetRegionStores(region), source),
+		filter.NewLabelConstraintFilter(scope, rule.GetLabelConstraints()),
+	}
 	for _, store := range cluster.GetStores() {
 		_, ok := region.GetStoreIds()[store.GetID()]
 		if ok {
@@ -188,6 +199,10 @@ func selectBestReplacementStore(cluster opt.Cluster, region *core.RegionInfo) ui
 			continue
 		}
 
+		if filter.Target(cluster, store, filters) {
+			continue
+		}
+
 		if best == nil || store.GetRegionSize() < best.GetRegionSize() {
 			best = store
 		}
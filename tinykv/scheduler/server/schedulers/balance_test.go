@@ -16,6 +16,7 @@ package schedulers
 import (
 	"context"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/schedulerpb"
 	"github.com/pingcap-incubator/tinykv/scheduler/pkg/mock/mockcluster"
 	"github.com/pingcap-incubator/tinykv/scheduler/pkg/mock/mockoption"
 	"github.com/pingcap-incubator/tinykv/scheduler/pkg/testutil"
@@ -27,8 +28,9 @@ import (
 	. "github.com/pingcap/check"
 )
 
-func newTestReplication(mso *mockoption.ScheduleOptions, maxReplicas int) {
+func newTestReplication(mso *mockoption.ScheduleOptions, maxReplicas int, locationLabels ...string) {
 	mso.MaxReplicas = maxReplicas
+	mso.LocationLabels = locationLabels
 }
 
 var _ = Suite(&testBalanceRegionSchedulerSuite{})
@@ -124,6 +126,35 @@ func (s *testBalanceRegionSchedulerSuite) TestReplicas33C(c *C) {
 	c.Assert(sb.Schedule(tc), IsNil)
 }
 
+func (s *testBalanceRegionSchedulerSuite) TestReplicasWithLabels(c *C) {
+	opt := mockoption.NewScheduleOptions()
+	tc := mockcluster.NewCluster(opt)
+	oc := schedule.NewOperatorController(s.ctx, nil, nil)
+
+	newTestReplication(opt, 3, "zone", "rack", "host")
+
+	sb, err := schedule.CreateScheduler("balance-region", oc, core.NewStorage(kv.NewMemoryKV()), nil)
+	c.Assert(err, IsNil)
+
+	tc.AddLabelsStore(1, 14, map[string]string{"zone": "z1", "rack": "r1", "host": "h1"})
+	tc.AddLabelsStore(2, 16, map[string]string{"zone": "z2", "rack": "r1", "host": "h1"})
+	tc.AddLabelsStore(3, 15, map[string]string{"zone": "z3", "rack": "r1", "host": "h1"})
+	tc.AddLeaderRegion(1, 1, 2, 3)
+	c.Assert(sb.Schedule(tc), IsNil)
+
+	// Store 4 is in the same zone with store 1, so only the peer in store 1
+	// can be moved to it without losing the isolation, although store 2 and
+	// store 3 have larger region scores.
+	tc.AddLabelsStore(4, 2, map[string]string{"zone": "z1", "rack": "r2", "host": "h1"})
+	testutil.CheckTransferPeer(c, sb.Schedule(tc), operator.OpBalance, 1, 4)
+
+	// Store 5 has no labels, which is considered at the same location with
+	// any other store.
+	tc.SetStoreDown(4)
+	tc.AddRegionStore(5, 0)
+	c.Assert(sb.Schedule(tc), IsNil)
+}
+
 func (s *testBalanceRegionSchedulerSuite) TestReplicas53C(c *C) {
 	opt := mockoption.NewScheduleOptions()
 	tc := mockcluster.NewCluster(opt)
@@ -495,3 +526,99 @@ func (s *testReplicaCheckerSuite) TestOffline(c *C) {
 	tc.AddRegionStore(5, 3)
 	testutil.CheckTransferPeer(c, rc.Check(region), operator.OpReplica, 3, 5)
 }
+
+func (s *testReplicaCheckerSuite) TestDistinctScore(c *C) {
+	opt := mockoption.NewScheduleOptions()
+	tc := mockcluster.NewCluster(opt)
+
+	newTestReplication(opt, 3, "zone", "rack", "host")
+
+	rc := checker.NewReplicaChecker(tc)
+
+	tc.AddLabelsStore(1, 5, map[string]string{"zone": "z1", "rack": "r1", "host": "h1"})
+	tc.AddLabelsStore(2, 4, map[string]string{"zone": "z1", "rack": "r1", "host": "h2"})
+	tc.AddLabelsStore(3, 6, map[string]string{"zone": "z1", "rack": "r2", "host": "h1"})
+	tc.AddLabelsStore(4, 8, map[string]string{"zone": "z2", "rack": "r1", "host": "h1"})
+	tc.AddLabelsStore(5, 1, map[string]string{"zone": "z1", "rack": "r1", "host": "h1"})
+
+	tc.AddLeaderRegion(1, 1)
+	region := tc.GetRegion(1)
+
+	// Store 4 is in a different zone with store 1.
+	testutil.CheckAddPeer(c, rc.Check(region), operator.OpReplica, 4)
+	peer4, _ := tc.AllocPeer(4)
+	region = region.Clone(core.WithAddPeer(peer4))
+
+	// Store 3 is in a different rack with store 1, though store 2 and store 5
+	// have smaller region scores.
+	testutil.CheckAddPeer(c, rc.Check(region), operator.OpReplica, 3)
+	peer3, _ := tc.AllocPeer(3)
+	region = region.Clone(core.WithAddPeer(peer3))
+
+	// No store is at a better location than the current peers.
+	c.Assert(rc.Check(region), IsNil)
+
+	// Store 6 is in a new zone, move the worst peer to it.
+	tc.AddLabelsStore(6, 10, map[string]string{"zone": "z3", "rack": "r1", "host": "h1"})
+	testutil.CheckTransferPeer(c, rc.Check(region), operator.OpReplica, 3, 6)
+
+	// The peers in store 1 and store 3 are equally isolated, remove the one
+	// with the larger region score.
+	peer6, _ := tc.AllocPeer(6)
+	region = region.Clone(core.WithAddPeer(peer6))
+	testutil.CheckRemovePeer(c, rc.Check(region), 3)
+
+	// Without location labels, the replicas are never moved for isolation.
+	newTestReplication(opt, 3)
+	region = region.Clone(core.WithRemoveStorePeer(3))
+	c.Assert(rc.Check(region), IsNil)
+}
+
+func (s *testReplicaCheckerSuite) TestPlacementRules(c *C) {
+	opt := mockoption.NewScheduleOptions()
+	tc := mockcluster.NewCluster(opt)
+
+	newTestReplication(opt, 3)
+	opt.EnablePlacementRules = true
+
+	rc := checker.NewReplicaChecker(tc)
+
+	tc.AddLabelsStore(1, 1, map[string]string{"zone": "z1"})
+	tc.AddLabelsStore(2, 1, map[string]string{"zone": "z2"})
+	tc.AddLabelsStore(3, 2, map[string]string{"zone": "z1"})
+	tc.AddLabelsStore(4, 5, map[string]string{"zone": "z1"})
+	tc.AddLabelsStore(5, 0, map[string]string{"zone": "z2"})
+
+	tc.AddLeaderRegion(1, 1, 2)
+	region := tc.GetRegion(1)
+
+	// The default rule follows the replication config.
+	testutil.CheckAddPeer(c, rc.Check(region), operator.OpReplica, 5)
+
+	// The rule with higher priority overrides the default rule.
+	rule := &schedulerpb.PlacementRule{
+		GroupId: "test",
+		Id:      "z1",
+		Index:   1,
+		Count:   2,
+		LabelConstraints: []*schedulerpb.LabelConstraint{
+			{Key: "zone", Op: schedulerpb.LabelConstraintOp_In, Values: []string{"z1"}},
+		},
+	}
+	c.Assert(tc.GetRuleManager().SetRule(rule), IsNil)
+	// The peer in store 2 mismatches the label constraints.
+	testutil.CheckTransferPeer(c, rc.Check(region), operator.OpReplica, 2, 3)
+
+	// Only the stores matching the label constraints can take the new peer.
+	rule.Count = 3
+	c.Assert(tc.GetRuleManager().SetRule(rule), IsNil)
+	region = region.Clone(core.WithRemoveStorePeer(2))
+	testutil.CheckAddPeer(c, rc.Check(region), operator.OpReplica, 3)
+
+	// The rules are ignored if placement rules are disabled.
+	opt.EnablePlacementRules = false
+	peer2, _ := tc.AllocPeer(2)
+	peer3, _ := tc.AllocPeer(3)
+	region = region.Clone(core.WithAddPeer(peer2), core.WithAddPeer(peer3))
+	c.Assert(rc.Check(region), IsNil)
+}
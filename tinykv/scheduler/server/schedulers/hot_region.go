@@ -0,0 +1,293 @@
+// Copyright 2017 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedulers
+
+import (
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/filter"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/operator"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/opt"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/statistics"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+)
+
+func init() {
+	schedule.RegisterSliceDecoderBuilder("hot-region", func(args []string) schedule.ConfigDecoder {
+		return func(v interface{}) error {
+			return nil
+		}
+	})
+	schedule.RegisterScheduler("hot-region", func(opController *schedule.OperatorController, storage *core.Storage, decoder schedule.ConfigDecoder) (schedule.Scheduler, error) {
+		return newHotScheduler(opController), nil
+	})
+}
+
+const (
+	hotRegionName = "balance-hot-region-scheduler"
+	// hotRegionRetryLimit is the limit to retry schedule for the hot peers of
+	// the selected source store.
+	hotRegionRetryLimit = 10
+	// hotRegionScheduleFactor is the tolerant ratio of the target store's flow
+	// after the schedule to the source store's flow before the schedule. It
+	// keeps a hot region from bouncing between two stores.
+	hotRegionScheduleFactor = 0.9
+)
+
+// storeHotPeers is the hot peers of a store and their total flow.
+type storeHotPeers struct {
+	totalFlow float64
+	stats     []*statistics.HotPeerStat
+}
+
+func summaryStoresHotPeers(stats map[uint64][]*statistics.HotPeerStat) map[uint64]*storeHotPeers {
+	summary := make(map[uint64]*storeHotPeers, len(stats))
+	for storeID, peers := range stats {
+		s := &storeHotPeers{stats: peers}
+		for _, stat := range peers {
+			s.totalFlow += stat.ByteRate
+		}
+		summary[storeID] = s
+	}
+	return summary
+}
+
+func (s *storeHotPeers) flow() float64 {
+	if s == nil {
+		return 0
+	}
+	return s.totalFlow
+}
+
+type hotScheduler struct {
+	*baseScheduler
+	opController *schedule.OperatorController
+	r            *rand.Rand
+	filters      []filter.Filter
+}
+
+// newHotScheduler creates a scheduler that moves the hot leaders and peers
+// away from the stores serving the most hot flow.
+func newHotScheduler(opController *schedule.OperatorController) *hotScheduler {
+	base := newBaseScheduler(opController)
+	s := &hotScheduler{
+		baseScheduler: base,
+		opController:  opController,
+		r:             rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+	s.filters = []filter.Filter{filter.StoreStateFilter{ActionScope: s.GetName(), TransferLeader: true, MoveRegion: true}}
+	return s
+}
+
+func (h *hotScheduler) GetName() string {
+	return hotRegionName
+}
+
+func (h *hotScheduler) GetType() string {
+	return "hot-region"
+}
+
+// GetMinInterval returns a slow interval, the hot peers are updated by
+// region heartbeats so it's no use to schedule more frequently.
+func (h *hotScheduler) GetMinInterval() time.Duration {
+	return MinSlowScheduleInterval
+}
+
+func (h *hotScheduler) IsScheduleAllowed(cluster opt.Cluster) bool {
+	return h.opController.OperatorCount(operator.OpHotRegion) < cluster.GetHotRegionScheduleLimit()
+}
+
+func (h *hotScheduler) Schedule(cluster opt.Cluster) *operator.Operator {
+	if h.r.Intn(2) == 0 {
+		return h.balanceHotReadRegions(cluster)
+	}
+	return h.balanceHotWriteRegions(cluster)
+}
+
+// balanceHotReadRegions balances the read flow, which is served by the
+// leaders only. It prefers transferring the leader of a hot region to a
+// follower, and moves the leader peer out only if no follower can take it.
+func (h *hotScheduler) balanceHotReadRegions(cluster opt.Cluster) *operator.Operator {
+	summary := summaryStoresHotPeers(cluster.RegionReadStats())
+	if op := h.balanceByLeader(cluster, summary); op != nil {
+		return op
+	}
+	return h.balanceByPeer(cluster, summary, statistics.ReadFlow)
+}
+
+// balanceHotWriteRegions balances the written flow, which is served by all
+// the peers, so only moving a peer can ease the source store.
+func (h *hotScheduler) balanceHotWriteRegions(cluster opt.Cluster) *operator.Operator {
+	summary := summaryStoresHotPeers(cluster.RegionWriteStats())
+	return h.balanceByPeer(cluster, summary, statistics.WriteFlow)
+}
+
+// selectSrcStore returns the store with the most hot flow, or 0 if there
+// is no hot peer. The stores are checked in the order of their ids, so the
+// one with the smaller id is selected if two stores have the same flow.
+func (h *hotScheduler) selectSrcStore(cluster opt.Cluster, summary map[uint64]*storeHotPeers) uint64 {
+	storeIDs := make([]uint64, 0, len(summary))
+	for storeID := range summary {
+		storeIDs = append(storeIDs, storeID)
+	}
+	sort.Slice(storeIDs, func(i, j int) bool { return storeIDs[i] < storeIDs[j] })
+
+	var (
+		srcStoreID uint64
+		maxFlow    float64
+	)
+	for _, storeID := range storeIDs {
+		s := summary[storeID]
+		store := cluster.GetStore(storeID)
+		if store == nil || filter.Source(cluster, store, h.filters) {
+			continue
+		}
+		if len(s.stats) > 0 && s.totalFlow > maxFlow {
+			srcStoreID, maxFlow = storeID, s.totalFlow
+		}
+	}
+	return srcStoreID
+}
+
+// selectDstStore returns the candidate with the least hot flow, if the
+// flow of the candidate is still less than the source store's after the
+// hot peer is moved to it.
+func (h *hotScheduler) selectDstStore(candidates []*core.StoreInfo, summary map[uint64]*storeHotPeers, srcFlow, peerFlow float64) uint64 {
+	var (
+		dstStoreID uint64
+		minFlow    float64
+	)
+	for _, store := range candidates {
+		flow := summary[store.GetID()].flow()
+		if dstStoreID == 0 || flow < minFlow {
+			dstStoreID, minFlow = store.GetID(), flow
+		}
+	}
+	if dstStoreID == 0 || minFlow+peerFlow >= srcFlow*hotRegionScheduleFactor {
+		return 0
+	}
+	return dstStoreID
+}
+
+// randomHotPeers returns the hot peers of the store in random order, the
+// hottest ones are not preferred as moving them may just move the hot spot.
+func (h *hotScheduler) randomHotPeers(s *storeHotPeers) []*statistics.HotPeerStat {
+	stats := make([]*statistics.HotPeerStat, len(s.stats))
+	for i, j := range h.r.Perm(len(s.stats)) {
+		stats[i] = s.stats[j]
+	}
+	if len(stats) > hotRegionRetryLimit {
+		stats = stats[:hotRegionRetryLimit]
+	}
+	return stats
+}
+
+// getHealthyRegion returns the region of the hot peer if it can be scheduled.
+func (h *hotScheduler) getHealthyRegion(cluster opt.Cluster, stat *statistics.HotPeerStat) *core.RegionInfo {
+	region := cluster.GetRegion(stat.RegionID)
+	if region == nil || region.GetLeader() == nil {
+		return nil
+	}
+	if isRegionUnhealthy(region) || len(region.GetPendingPeers()) != 0 {
+		return nil
+	}
+	// We don't schedule region with abnormal number of replicas.
+	if len(region.GetPeers()) != int(cluster.GetRegionRule(region).GetCount()) {
+		return nil
+	}
+	return region
+}
+
+// balanceByLeader transfers the leader of a hot region out of the store
+// with the most hot flow.
+func (h *hotScheduler) balanceByLeader(cluster opt.Cluster, summary map[uint64]*storeHotPeers) *operator.Operator {
+	srcStoreID := h.selectSrcStore(cluster, summary)
+	if srcStoreID == 0 {
+		return nil
+	}
+	src := summary[srcStoreID]
+	for _, stat := range h.randomHotPeers(src) {
+		region := h.getHealthyRegion(cluster, stat)
+		if region == nil || region.GetLeader().GetStoreId() != srcStoreID {
+			continue
+		}
+		candidates := filter.SelectTargetStores(cluster.GetFollowerStores(region), h.filters, cluster)
+		dstStoreID := h.selectDstStore(candidates, summary, src.totalFlow, stat.ByteRate)
+		if dstStoreID == 0 {
+			continue
+		}
+		log.Debug("transfer hot leader", zap.String("scheduler", h.GetName()), zap.Uint64("region-id", region.GetID()),
+			zap.Uint64("source-store", srcStoreID), zap.Uint64("target-store", dstStoreID))
+		return operator.CreateTransferLeaderOperator("transfer-hot-read-leader", region, srcStoreID, dstStoreID, operator.OpHotRegion)
+	}
+	return nil
+}
+
+// balanceByPeer moves a hot peer out of the store with the most hot flow.
+func (h *hotScheduler) balanceByPeer(cluster opt.Cluster, summary map[uint64]*storeHotPeers, kind statistics.FlowKind) *operator.Operator {
+	srcStoreID := h.selectSrcStore(cluster, summary)
+	if srcStoreID == 0 {
+		return nil
+	}
+	src := summary[srcStoreID]
+	for _, stat := range h.randomHotPeers(src) {
+		region := h.getHealthyRegion(cluster, stat)
+		if region == nil {
+			continue
+		}
+		srcPeer := region.GetStorePeer(srcStoreID)
+		if srcPeer == nil || (kind == statistics.ReadFlow && region.GetLeader().GetStoreId() != srcStoreID) {
+			continue
+		}
+		var candidates []*core.StoreInfo
+		for _, store := range cluster.GetStores() {
+			if _, ok := region.GetStoreIds()[store.GetID()]; !ok {
+				candidates = append(candidates, store)
+			}
+		}
+		rule := cluster.GetRegionRule(region)
+		filters := append([]filter.Filter{
+			filter.NewDistinctScoreFilter(h.GetName(), rule.GetLocationLabels(), cluster.GetRegionStores(region), cluster.GetStore(srcStoreID)),
+			filter.NewLabelConstraintFilter(h.GetName(), rule.GetLabelConstraints()),
+		}, h.filters...)
+		candidates = filter.SelectTargetStores(candidates, filters, cluster)
+		sort.Slice(candidates, func(i, j int) bool {
+			return candidates[i].GetID() < candidates[j].GetID()
+		})
+		dstStoreID := h.selectDstStore(candidates, summary, src.totalFlow, stat.ByteRate)
+		if dstStoreID == 0 {
+			continue
+		}
+		newPeer, err := cluster.AllocPeer(dstStoreID)
+		if err != nil {
+			log.Error("failed to allocate peer", zap.Error(err))
+			return nil
+		}
+		op, err := operator.CreateMovePeerOperator("move-hot-"+kind.String()+"-region", cluster, region,
+			operator.OpHotRegion, srcStoreID, dstStoreID, newPeer.GetId())
+		if err != nil {
+			log.Debug("failed to create move hot peer operator", zap.Error(err))
+			return nil
+		}
+		log.Debug("move hot peer", zap.String("scheduler", h.GetName()), zap.Uint64("region-id", region.GetID()),
+			zap.Uint64("source-store", srcStoreID), zap.Uint64("target-store", dstStoreID))
+		return op
+	}
+	return nil
+}
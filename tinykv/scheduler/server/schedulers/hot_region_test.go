@@ -0,0 +1,121 @@
+// Copyright 2017 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedulers
+
+import (
+	"context"
+
+	"github.com/pingcap-incubator/tinykv/scheduler/pkg/mock/mockcluster"
+	"github.com/pingcap-incubator/tinykv/scheduler/pkg/mock/mockoption"
+	"github.com/pingcap-incubator/tinykv/scheduler/pkg/testutil"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/kv"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/operator"
+	. "github.com/pingcap/check"
+)
+
+var _ = Suite(&testHotRegionSchedulerSuite{})
+
+type testHotRegionSchedulerSuite struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+func (s *testHotRegionSchedulerSuite) SetUpSuite(c *C) {
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+}
+
+func (s *testHotRegionSchedulerSuite) TearDownSuite(c *C) {
+	s.cancel()
+}
+
+func (s *testHotRegionSchedulerSuite) newHotScheduler(c *C, opt *mockoption.ScheduleOptions) *hotScheduler {
+	oc := schedule.NewOperatorController(s.ctx, nil, nil)
+	sche, err := schedule.CreateScheduler("hot-region", oc, core.NewStorage(kv.NewMemoryKV()), nil)
+	c.Assert(err, IsNil)
+	return sche.(*hotScheduler)
+}
+
+func (s *testHotRegionSchedulerSuite) TestBalanceHotWriteRegions(c *C) {
+	opt := mockoption.NewScheduleOptions()
+	opt.HotRegionCacheHitsThreshold = 0
+	tc := mockcluster.NewCluster(opt)
+	hb := s.newHotScheduler(c, opt)
+
+	for i := uint64(1); i <= 5; i++ {
+		tc.AddRegionStore(i, 3)
+	}
+
+	// Each region writes 50KB/s.
+	// | region_id | leader_store | follower_store | follower_store |
+	// |-----------|--------------|----------------|----------------|
+	// |     1     |       1      |        2       |       3        |
+	// |     2     |       1      |        3       |       4        |
+	// |     3     |       1      |        3       |       4        |
+	tc.AddLeaderRegionWithWriteInfo(1, 1, 500*1024, 10, 2, 3)
+	tc.AddLeaderRegionWithWriteInfo(2, 1, 500*1024, 10, 3, 4)
+	tc.AddLeaderRegionWithWriteInfo(3, 1, 500*1024, 10, 3, 4)
+	c.Assert(tc.RegionWriteStats()[1], HasLen, 3)
+	c.Assert(tc.IsRegionHot(tc.GetRegion(1)), IsTrue)
+
+	// Store 1 has the most written flow, and store 5 has none.
+	testutil.CheckTransferPeerWithLeaderTransfer(c, hb.balanceHotWriteRegions(tc), operator.OpHotRegion, 1, 5)
+
+	// Store 5 is down, store 2 has the least written flow, but it is a
+	// replica of region 1.
+	tc.SetStoreDown(5)
+	op := hb.balanceHotWriteRegions(tc)
+	c.Assert(op, NotNil)
+	c.Assert(op.RegionID(), Not(Equals), uint64(1))
+	testutil.CheckTransferPeerWithLeaderTransfer(c, op, operator.OpHotRegion, 1, 2)
+
+	// A region must be hot in enough heartbeats.
+	opt.HotRegionCacheHitsThreshold = 3
+	c.Assert(hb.balanceHotWriteRegions(tc), IsNil)
+}
+
+func (s *testHotRegionSchedulerSuite) TestBalanceHotReadRegions(c *C) {
+	opt := mockoption.NewScheduleOptions()
+	opt.HotRegionCacheHitsThreshold = 0
+	tc := mockcluster.NewCluster(opt)
+	hb := s.newHotScheduler(c, opt)
+
+	for i := uint64(1); i <= 4; i++ {
+		tc.AddRegionStore(i, 3)
+	}
+
+	// Each region reads 100KB/s, which is served by the leader.
+	// | region_id | leader_store | follower_store | follower_store |
+	// |-----------|--------------|----------------|----------------|
+	// |     1     |       1      |        2       |       3        |
+	// |     2     |       1      |        2       |       3        |
+	// |     3     |       2      |        1       |       3        |
+	tc.AddLeaderRegionWithReadInfo(1, 1, 1000*1024, 10, 2, 3)
+	tc.AddLeaderRegionWithReadInfo(2, 1, 1000*1024, 10, 2, 3)
+	tc.AddLeaderRegionWithReadInfo(3, 2, 1000*1024, 10, 1, 3)
+	c.Assert(tc.RegionReadStats()[1], HasLen, 2)
+	c.Assert(tc.RegionReadStats()[3], HasLen, 0)
+
+	// Transfer a leader from store 1 to store 3, which serves no read flow.
+	testutil.CheckTransferLeader(c, hb.balanceHotReadRegions(tc), operator.OpHotRegion, 1, 3)
+
+	// No follower can take the leader, so the leader peer is moved to store 4.
+	tc.SetStoreDown(3)
+	testutil.CheckTransferPeerWithLeaderTransfer(c, hb.balanceHotReadRegions(tc), operator.OpHotRegion, 1, 4)
+
+	// The number of hot region operators is limited.
+	opt.HotRegionScheduleLimit = 0
+	c.Assert(hb.IsScheduleAllowed(tc), IsFalse)
+}
@@ -0,0 +1,303 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statistics
+
+import (
+	"sync"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/scheduler/pkg/movingavg"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
+)
+
+// FlowKind is the kind of the flow, a region is hot for the written flow or
+// the read flow separately.
+type FlowKind uint32
+
+// Flags for the flow kinds.
+const (
+	WriteFlow FlowKind = iota
+	ReadFlow
+)
+
+func (k FlowKind) String() string {
+	switch k {
+	case WriteFlow:
+		return "write"
+	case ReadFlow:
+		return "read"
+	}
+	return "unknown"
+}
+
+const (
+	// HotWriteRegionMinBytesRate is the min written bytes per second of a hot
+	// region.
+	HotWriteRegionMinBytesRate = 1 * 1024
+	// HotReadRegionMinBytesRate is the min read bytes per second of a hot
+	// region.
+	HotReadRegionMinBytesRate = 8 * 1024
+
+	// hotRegionAntiCount is the number of heartbeats a hot peer can be cold in
+	// before it's removed from the cache.
+	hotRegionAntiCount = 2
+	// rollingWindowsSize is the number of heartbeats the flow of a hot peer is
+	// averaged over.
+	rollingWindowsSize = 3
+)
+
+// HotPeerStat records the flow of a hot peer.
+type HotPeerStat struct {
+	StoreID  uint64
+	RegionID uint64
+	Kind     FlowKind
+
+	// HotDegree is the number of heartbeats the peer has been hot in, it
+	// decreases when the peer becomes cold.
+	HotDegree int
+	// AntiCount is the number of heartbeats left before the peer is removed
+	// from the cache if it keeps cold.
+	AntiCount int
+
+	// ByteRate and KeyRate are the flow averaged over the recent heartbeats.
+	ByteRate float64
+	KeyRate  float64
+
+	LastUpdateTime time.Time
+
+	rollingByteRate *movingavg.MovingAvg
+	rollingKeyRate  *movingavg.MovingAvg
+}
+
+// IsHot returns true if the peer has been hot in enough heartbeats.
+func (stat *HotPeerStat) IsHot(minHotDegree int) bool {
+	return stat.HotDegree >= minHotDegree
+}
+
+// clone returns a copy of the stat without the rolling records, so it's
+// safe to be read after the cache is unlocked.
+func (stat *HotPeerStat) clone() *HotPeerStat {
+	ret := *stat
+	ret.rollingByteRate, ret.rollingKeyRate = nil, nil
+	return &ret
+}
+
+// hotPeerCache caches the hot peers of a flow kind.
+type hotPeerCache struct {
+	kind FlowKind
+	// peersOfStore maps a store to the hot peers in it, by the region ID.
+	peersOfStore map[uint64]map[uint64]*HotPeerStat
+	// storesOfRegion maps a region to the stores of its hot peers.
+	storesOfRegion map[uint64]map[uint64]struct{}
+}
+
+func newHotPeerCache(kind FlowKind) *hotPeerCache {
+	return &hotPeerCache{
+		kind:           kind,
+		peersOfStore:   make(map[uint64]map[uint64]*HotPeerStat),
+		storesOfRegion: make(map[uint64]map[uint64]struct{}),
+	}
+}
+
+// flow returns the reported bytes and keys of the region, and the stores
+// which serve the flow. The written flow is served by all the peers, while
+// the read flow is served by the leader only.
+func (c *hotPeerCache) flow(region *core.RegionInfo) (bytes, keys uint64, storeIDs []uint64) {
+	switch c.kind {
+	case WriteFlow:
+		for _, peer := range region.GetPeers() {
+			storeIDs = append(storeIDs, peer.GetStoreId())
+		}
+		return region.GetBytesWritten(), region.GetKeysWritten(), storeIDs
+	case ReadFlow:
+		if leader := region.GetLeader(); leader != nil {
+			storeIDs = append(storeIDs, leader.GetStoreId())
+		}
+		return region.GetBytesRead(), region.GetKeysRead(), storeIDs
+	}
+	return 0, 0, nil
+}
+
+func (c *hotPeerCache) minBytesRate() float64 {
+	if c.kind == WriteFlow {
+		return HotWriteRegionMinBytesRate
+	}
+	return HotReadRegionMinBytesRate
+}
+
+func (c *hotPeerCache) update(region *core.RegionInfo) {
+	interval := region.GetInterval().GetEndTimestamp() - region.GetInterval().GetStartTimestamp()
+	if interval == 0 {
+		return
+	}
+	bytes, keys, storeIDs := c.flow(region)
+	byteRate := float64(bytes) / float64(interval)
+	keyRate := float64(keys) / float64(interval)
+	regionID := region.GetID()
+
+	// The peers moved out of the stores, or the leaders transferred out of
+	// the stores, no longer serve the flow.
+	serving := make(map[uint64]struct{}, len(storeIDs))
+	for _, storeID := range storeIDs {
+		serving[storeID] = struct{}{}
+	}
+	for storeID := range c.storesOfRegion[regionID] {
+		if _, ok := serving[storeID]; !ok {
+			c.remove(storeID, regionID)
+		}
+	}
+
+	isHot := byteRate >= c.minBytesRate()
+	for _, storeID := range storeIDs {
+		stat := c.peersOfStore[storeID][regionID]
+		if stat == nil {
+			if !isHot {
+				continue
+			}
+			stat = &HotPeerStat{
+				StoreID:         storeID,
+				RegionID:        regionID,
+				Kind:            c.kind,
+				rollingByteRate: movingavg.NewMovingAvg(rollingWindowsSize),
+				rollingKeyRate:  movingavg.NewMovingAvg(rollingWindowsSize),
+			}
+			c.put(stat)
+		}
+		if isHot {
+			stat.HotDegree++
+			stat.AntiCount = hotRegionAntiCount
+		} else {
+			stat.HotDegree--
+			stat.AntiCount--
+			if stat.AntiCount <= 0 {
+				c.remove(storeID, regionID)
+				continue
+			}
+		}
+		stat.rollingByteRate.Add(byteRate)
+		stat.rollingKeyRate.Add(keyRate)
+		stat.ByteRate = stat.rollingByteRate.Get()
+		stat.KeyRate = stat.rollingKeyRate.Get()
+		stat.LastUpdateTime = time.Now()
+	}
+}
+
+func (c *hotPeerCache) put(stat *HotPeerStat) {
+	peers, ok := c.peersOfStore[stat.StoreID]
+	if !ok {
+		peers = make(map[uint64]*HotPeerStat)
+		c.peersOfStore[stat.StoreID] = peers
+	}
+	peers[stat.RegionID] = stat
+	stores, ok := c.storesOfRegion[stat.RegionID]
+	if !ok {
+		stores = make(map[uint64]struct{})
+		c.storesOfRegion[stat.RegionID] = stores
+	}
+	stores[stat.StoreID] = struct{}{}
+}
+
+func (c *hotPeerCache) remove(storeID, regionID uint64) {
+	if peers, ok := c.peersOfStore[storeID]; ok {
+		delete(peers, regionID)
+		if len(peers) == 0 {
+			delete(c.peersOfStore, storeID)
+		}
+	}
+	if stores, ok := c.storesOfRegion[regionID]; ok {
+		delete(stores, storeID)
+		if len(stores) == 0 {
+			delete(c.storesOfRegion, regionID)
+		}
+	}
+}
+
+func (c *hotPeerCache) regionStats(minHotDegree int) map[uint64][]*HotPeerStat {
+	res := make(map[uint64][]*HotPeerStat)
+	for storeID, peers := range c.peersOfStore {
+		for _, stat := range peers {
+			if stat.IsHot(minHotDegree) {
+				res[storeID] = append(res[storeID], stat.clone())
+			}
+		}
+	}
+	return res
+}
+
+func (c *hotPeerCache) isRegionHot(regionID uint64, minHotDegree int) bool {
+	for storeID := range c.storesOfRegion[regionID] {
+		if c.peersOfStore[storeID][regionID].IsHot(minHotDegree) {
+			return true
+		}
+	}
+	return false
+}
+
+// HotCache caches the hot peers of the written flow and the read flow,
+// which are updated by region heartbeats.
+type HotCache struct {
+	sync.RWMutex
+	writeFlow *hotPeerCache
+	readFlow  *hotPeerCache
+}
+
+// NewHotCache creates a HotCache.
+func NewHotCache() *HotCache {
+	return &HotCache{
+		writeFlow: newHotPeerCache(WriteFlow),
+		readFlow:  newHotPeerCache(ReadFlow),
+	}
+}
+
+// Update updates the hot peers of the region by the flow it reports.
+func (c *HotCache) Update(region *core.RegionInfo) {
+	c.Lock()
+	defer c.Unlock()
+	c.writeFlow.update(region)
+	c.readFlow.update(region)
+}
+
+// RegionStats returns the hot peers of the flow kind which have been hot in
+// at least `minHotDegree` heartbeats, grouped by the store.
+func (c *HotCache) RegionStats(kind FlowKind, minHotDegree int) map[uint64][]*HotPeerStat {
+	c.RLock()
+	defer c.RUnlock()
+	switch kind {
+	case WriteFlow:
+		return c.writeFlow.regionStats(minHotDegree)
+	case ReadFlow:
+		return c.readFlow.regionStats(minHotDegree)
+	}
+	return nil
+}
+
+// IsRegionHot returns true if any peer of the region is hot for either flow.
+func (c *HotCache) IsRegionHot(region *core.RegionInfo, minHotDegree int) bool {
+	c.RLock()
+	defer c.RUnlock()
+	return c.writeFlow.isRegionHot(region.GetID(), minHotDegree) ||
+		c.readFlow.isRegionHot(region.GetID(), minHotDegree)
+}
+
+// RemoveRegion removes the hot peers of the region, it's called when the
+// region is removed from the cluster.
+func (c *HotCache) RemoveRegion(regionID uint64) {
+	c.Lock()
+	defer c.Unlock()
+	for _, cache := range []*hotPeerCache{c.writeFlow, c.readFlow} {
+		for storeID := range cache.storesOfRegion[regionID] {
+			cache.remove(storeID, regionID)
+		}
+	}
+}
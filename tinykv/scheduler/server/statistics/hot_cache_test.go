@@ -0,0 +1,97 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statistics
+
+import (
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
+	. "github.com/pingcap/check"
+)
+
+func Test(t *testing.T) {
+	TestingT(t)
+}
+
+var _ = Suite(&testHotCacheSuite{})
+
+type testHotCacheSuite struct{}
+
+func newTestRegion(leaderStore uint64, followerStores ...uint64) *core.RegionInfo {
+	leader := &metapb.Peer{Id: leaderStore, StoreId: leaderStore}
+	peers := []*metapb.Peer{leader}
+	for _, storeID := range followerStores {
+		peers = append(peers, &metapb.Peer{Id: storeID, StoreId: storeID})
+	}
+	return core.NewRegionInfo(&metapb.Region{Id: 1, Peers: peers}, leader)
+}
+
+func (s *testHotCacheSuite) TestWriteFlow(c *C) {
+	cache := NewHotCache()
+	region := newTestRegion(1, 2, 3)
+	hot := region.Clone(core.SetWrittenBytes(100*1024), core.SetReportInterval(10))
+
+	cache.Update(hot)
+	stats := cache.RegionStats(WriteFlow, 1)
+	c.Assert(stats, HasLen, 3)
+	c.Assert(stats[1][0].ByteRate, Equals, 10.0*1024)
+	c.Assert(stats[2][0].HotDegree, Equals, 1)
+	c.Assert(cache.RegionStats(WriteFlow, 2), HasLen, 0)
+	c.Assert(cache.RegionStats(ReadFlow, 0), HasLen, 0)
+	c.Assert(cache.IsRegionHot(region, 1), IsTrue)
+
+	cache.Update(hot)
+	c.Assert(cache.RegionStats(WriteFlow, 2), HasLen, 3)
+
+	// The peer moved out of store 3 no longer serves the flow.
+	moved := newTestRegion(1, 2, 4).Clone(core.SetWrittenBytes(100*1024), core.SetReportInterval(10))
+	cache.Update(moved)
+	stats = cache.RegionStats(WriteFlow, 1)
+	c.Assert(stats, HasLen, 3)
+	c.Assert(stats[3], HasLen, 0)
+	c.Assert(stats[4][0].HotDegree, Equals, 1)
+	c.Assert(stats[1][0].HotDegree, Equals, 3)
+
+	// The rate is averaged over the recent heartbeats, and the peers are
+	// removed once they keep cold for a while.
+	cold := newTestRegion(1, 2, 4).Clone(core.SetWrittenBytes(1024), core.SetReportInterval(10))
+	cache.Update(cold)
+	stats = cache.RegionStats(WriteFlow, 0)
+	c.Assert(stats[1][0].HotDegree, Equals, 2)
+	c.Assert(stats[1][0].ByteRate, Less, 10.0*1024)
+	cache.Update(cold)
+	c.Assert(cache.RegionStats(WriteFlow, 0), HasLen, 0)
+	c.Assert(cache.IsRegionHot(region, 0), IsFalse)
+}
+
+func (s *testHotCacheSuite) TestReadFlow(c *C) {
+	cache := NewHotCache()
+	region := newTestRegion(1, 2, 3).Clone(core.SetReadBytes(1000*1024), core.SetReportInterval(10))
+
+	// Only the leader serves the read flow.
+	cache.Update(region)
+	stats := cache.RegionStats(ReadFlow, 0)
+	c.Assert(stats, HasLen, 1)
+	c.Assert(stats[1], HasLen, 1)
+
+	// The leader is transferred.
+	cache.Update(region.Clone(core.WithLeader(region.GetStorePeer(2))))
+	stats = cache.RegionStats(ReadFlow, 0)
+	c.Assert(stats, HasLen, 1)
+	c.Assert(stats[2][0].HotDegree, Equals, 1)
+
+	cache.RemoveRegion(region.GetID())
+	c.Assert(cache.RegionStats(ReadFlow, 0), HasLen, 0)
+}
@@ -160,6 +160,8 @@ type DDL interface {
 	DropSchema(ctx sessionctx.Context, schema model.CIStr) error
 	CreateTable(ctx sessionctx.Context, stmt *ast.CreateTableStmt) error
 	DropTable(ctx sessionctx.Context, tableIdent ast.Ident) (err error)
+	TruncateTable(ctx sessionctx.Context, tableIdent ast.Ident) error
+	RenameTable(ctx sessionctx.Context, oldTableIdent, newTableIdent ast.Ident, isAlterTable bool) error
 	CreateIndex(ctx sessionctx.Context, tableIdent ast.Ident, keyType ast.IndexKeyType, indexName model.CIStr,
 		columnNames []*ast.IndexPartSpecification, indexOption *ast.IndexOption, ifNotExists bool) error
 	DropIndex(ctx sessionctx.Context, tableIdent ast.Ident, indexName model.CIStr, ifExists bool) error
@@ -191,8 +193,9 @@ type ddl struct {
 	quitCh chan struct{}
 
 	*ddlCtx
-	workers  map[workerType]*worker
-	sessPool *sessionPool
+	workers     map[workerType]*worker
+	sessPool    *sessionPool
+	delRangeMgr delRangeManager
 }
 
 // ddlCtx is the context when we use worker to handle DDL jobs.
@@ -291,8 +294,9 @@ func (d *ddl) start(ctx context.Context, ctxPool *pools.ResourcePool) {
 
 	d.workers = make(map[workerType]*worker, 2)
 	d.sessPool = newSessionPool(ctxPool)
-	d.workers[generalWorker] = newWorker(generalWorker, d.sessPool)
-	d.workers[addIdxWorker] = newWorker(addIdxWorker, d.sessPool)
+	d.delRangeMgr = newDelRangeManager(d.store, d.sessPool)
+	d.workers[generalWorker] = newWorker(generalWorker, d.sessPool, d.delRangeMgr)
+	d.workers[addIdxWorker] = newWorker(addIdxWorker, d.sessPool, d.delRangeMgr)
 	for _, worker := range d.workers {
 		worker.wg.Add(1)
 		w := worker
@@ -320,6 +324,7 @@ func (d *ddl) start(ctx context.Context, ctxPool *pools.ResourcePool) {
 			}
 		})
 
+	d.delRangeMgr.start()
 }
 
 func (d *ddl) close() {
@@ -339,6 +344,7 @@ func (d *ddl) close() {
 	for _, worker := range d.workers {
 		worker.close()
 	}
+	d.delRangeMgr.clear()
 	if d.sessPool != nil {
 		d.sessPool.close()
 	}
@@ -64,19 +64,21 @@ type worker struct {
 	quitCh   chan struct{}
 	wg       sync.WaitGroup
 
-	sessPool *sessionPool // sessPool is used to new sessions to execute SQL in ddl package.
-	reorgCtx *reorgCtx    // reorgCtx is used for reorganization.
-	logCtx   context.Context
+	sessPool        *sessionPool    // sessPool is used to new sessions to execute SQL in ddl package.
+	reorgCtx        *reorgCtx       // reorgCtx is used for reorganization.
+	delRangeManager delRangeManager // delRangeManager is used to delete the data of the dropped tables and indexes.
+	logCtx          context.Context
 }
 
-func newWorker(tp workerType, sessPool *sessionPool) *worker {
+func newWorker(tp workerType, sessPool *sessionPool, delRangeMgr delRangeManager) *worker {
 	worker := &worker{
-		id:       atomic.AddInt32(&ddlWorkerID, 1),
-		tp:       tp,
-		ddlJobCh: make(chan struct{}, 1),
-		quitCh:   make(chan struct{}),
-		reorgCtx: &reorgCtx{notifyCancelReorgJob: 0},
-		sessPool: sessPool,
+		id:              atomic.AddInt32(&ddlWorkerID, 1),
+		tp:              tp,
+		ddlJobCh:        make(chan struct{}, 1),
+		quitCh:          make(chan struct{}),
+		reorgCtx:        &reorgCtx{notifyCancelReorgJob: 0},
+		sessPool:        sessPool,
+		delRangeManager: delRangeMgr,
 	}
 
 	worker.logCtx = logutil.WithKeyValue(context.Background(), "worker", worker.String())
@@ -260,6 +262,13 @@ func (w *worker) finishDDLJob(t *meta.Meta, job *model.Job) (err error) {
 		return errors.Trace(err)
 	}
 
+	if job.IsSynced() {
+		// The dropped data is deleted after the GC safe point.
+		if err = w.delRangeManager.addDelRangeJob(job); err != nil {
+			return errors.Trace(err)
+		}
+	}
+
 	job.BinlogInfo.FinishedTS = t.StartTS
 	logutil.Logger(w.logCtx).Info("[ddl] finish DDL job", zap.String("job", job.String()))
 	updateRawArgs := true
@@ -452,6 +461,10 @@ func (w *worker) runDDLJob(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64,
 		ver, err = onCreateTable(d, t, job)
 	case model.ActionDropTable:
 		ver, err = onDropTableOrView(t, job)
+	case model.ActionTruncateTable:
+		ver, err = onTruncateTable(d, t, job)
+	case model.ActionRenameTable:
+		ver, err = onRenameTable(d, t, job)
 	case model.ActionAddColumn:
 		ver, err = onAddColumn(d, t, job)
 	case model.ActionDropColumn:
@@ -613,7 +626,23 @@ func updateSchemaVersion(t *meta.Meta, job *model.Job) (int64, error) {
 		Version:  schemaVersion,
 		Type:     job.Type,
 		SchemaID: job.SchemaID,
-		TableID:  job.TableID,
+	}
+	switch job.Type {
+	case model.ActionTruncateTable:
+		// Truncate table has two table IDs, the new one is in the job args.
+		err = job.DecodeArgs(&diff.TableID)
+		if err != nil {
+			return 0, errors.Trace(err)
+		}
+		diff.OldTableID = job.TableID
+	case model.ActionRenameTable:
+		err = job.DecodeArgs(&diff.OldSchemaID)
+		if err != nil {
+			return 0, errors.Trace(err)
+		}
+		diff.TableID = job.TableID
+	default:
+		diff.TableID = job.TableID
 	}
 	err = t.SetSchemaDiff(diff)
 	return schemaVersion, errors.Trace(err)
@@ -0,0 +1,227 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ddl
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/store/tikv"
+	"github.com/pingcap/tidb/tablecodec"
+	"github.com/pingcap/tidb/util/logutil"
+	"github.com/pingcap/tidb/util/sqlexec"
+	"go.uber.org/zap"
+)
+
+const (
+	insertDeleteRangeSQL = `REPLACE INTO mysql.gc_delete_range VALUES (%d, %d, "%s", "%s", %d)`
+	loadDeleteRangeSQL   = `SELECT HIGH_PRIORITY job_id, element_id, start_key, end_key FROM mysql.gc_delete_range WHERE ts < %d`
+	doneDeleteRangeSQL   = `REPLACE INTO mysql.gc_delete_range_done SELECT * FROM mysql.gc_delete_range WHERE job_id = %d AND element_id = %d`
+	removeDeleteRangeSQL = `DELETE FROM mysql.gc_delete_range WHERE job_id = %d AND element_id = %d`
+
+	delRangeConcurrency = 4
+)
+
+// DelRangeInterval is the interval to check the GC safe point and delete the ranges before it.
+var DelRangeInterval = 10 * time.Second
+
+// delRangeManager keeps the ranges of the tables and indexes dropped by the DDL jobs in mysql.gc_delete_range, and
+// deletes the data in TinyKV after the GC safe point passes the time they are dropped, because the transactions
+// started before that may still read them.
+type delRangeManager interface {
+	// addDelRangeJob records the ranges dropped by a finished job.
+	addDelRangeJob(job *model.Job) error
+	start()
+	clear()
+}
+
+// delRangeTask is a range to delete, its keys are decoded from mysql.gc_delete_range.
+type delRangeTask struct {
+	jobID     int64
+	elementID int64
+	startKey  kv.Key
+	endKey    kv.Key
+}
+
+type delRange struct {
+	store    kv.Storage
+	sessPool *sessionPool
+	quitCh   chan struct{}
+	wait     sync.WaitGroup
+}
+
+func newDelRangeManager(store kv.Storage, sessPool *sessionPool) delRangeManager {
+	return &delRange{
+		store:    store,
+		sessPool: sessPool,
+		quitCh:   make(chan struct{}),
+	}
+}
+
+// addDelRangeJob implements delRangeManager interface.
+func (dr *delRange) addDelRangeJob(job *model.Job) error {
+	tasks, err := jobDelRangeTasks(job)
+	if err != nil || len(tasks) == 0 {
+		return errors.Trace(err)
+	}
+	ver, err := dr.store.CurrentVersion()
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	sctx, err := dr.sessPool.get()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer dr.sessPool.put(sctx)
+	exec, ok := sctx.(sqlexec.RestrictedSQLExecutor)
+	if !ok {
+		return nil
+	}
+	for _, task := range tasks {
+		sql := fmt.Sprintf(insertDeleteRangeSQL, task.jobID, task.elementID,
+			hex.EncodeToString(task.startKey), hex.EncodeToString(task.endKey), ver.Ver)
+		if _, _, err = exec.ExecRestrictedSQL(sql); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	logutil.BgLogger().Info("[ddl] add job into delete-range table", zap.Int64("jobID", job.ID), zap.String("jobType", job.Type.String()))
+	return nil
+}
+
+// jobDelRangeTasks returns the ranges dropped by the job.
+func jobDelRangeTasks(job *model.Job) ([]delRangeTask, error) {
+	switch job.Type {
+	case model.ActionDropTable, model.ActionTruncateTable:
+		// The table ID of a truncate table job is the old one.
+		startKey := tablecodec.EncodeTablePrefix(job.TableID)
+		endKey := tablecodec.EncodeTablePrefix(job.TableID + 1)
+		return []delRangeTask{{jobID: job.ID, elementID: job.TableID, startKey: startKey, endKey: endKey}}, nil
+	case model.ActionDropIndex, model.ActionDropPrimaryKey:
+		var indexName model.CIStr
+		var indexID int64
+		if err := job.DecodeArgs(&indexName, &indexID); err != nil {
+			return nil, errors.Trace(err)
+		}
+		startKey := tablecodec.EncodeTableIndexPrefix(job.TableID, indexID)
+		endKey := tablecodec.EncodeTableIndexPrefix(job.TableID, indexID+1)
+		return []delRangeTask{{jobID: job.ID, elementID: indexID, startKey: startKey, endKey: endKey}}, nil
+	}
+	return nil, nil
+}
+
+// start implements delRangeManager interface.
+func (dr *delRange) start() {
+	if _, ok := dr.store.(tikv.Storage); !ok {
+		return
+	}
+	dr.wait.Add(1)
+	go dr.startGC()
+}
+
+// clear implements delRangeManager interface.
+func (dr *delRange) clear() {
+	close(dr.quitCh)
+	dr.wait.Wait()
+}
+
+func (dr *delRange) startGC() {
+	defer dr.wait.Done()
+	logutil.BgLogger().Info("[ddl] start delRange gc job")
+	ticker := time.NewTicker(DelRangeInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-dr.quitCh:
+			return
+		}
+		if err := dr.doDelRangeWork(); err != nil {
+			logutil.BgLogger().Error("[ddl] delRange gc job failed", zap.Error(err))
+		}
+	}
+}
+
+// doDelRangeWork deletes the ranges dropped before the GC safe point, and moves them to mysql.gc_delete_range_done.
+// Deleting a range again is harmless, so a range is moved after it's deleted, and it's deleted again if the move
+// fails.
+func (dr *delRange) doDelRangeWork() error {
+	ctx := context.Background()
+	safePoint, err := dr.store.(tikv.Storage).GetGCSafePoint(ctx)
+	if err != nil || safePoint == 0 {
+		return errors.Trace(err)
+	}
+
+	sctx, err := dr.sessPool.get()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer dr.sessPool.put(sctx)
+	exec, ok := sctx.(sqlexec.RestrictedSQLExecutor)
+	if !ok {
+		return nil
+	}
+	tasks, err := loadDelRangeTasks(exec, safePoint)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	for _, task := range tasks {
+		if err = tikv.DeleteRange(ctx, dr.store.(tikv.Storage), task.startKey, task.endKey, delRangeConcurrency); err != nil {
+			return errors.Trace(err)
+		}
+		if _, _, err = exec.ExecRestrictedSQL(fmt.Sprintf(doneDeleteRangeSQL, task.jobID, task.elementID)); err != nil {
+			return errors.Trace(err)
+		}
+		if _, _, err = exec.ExecRestrictedSQL(fmt.Sprintf(removeDeleteRangeSQL, task.jobID, task.elementID)); err != nil {
+			return errors.Trace(err)
+		}
+		logutil.BgLogger().Info("[ddl] delete range complete",
+			zap.Int64("jobID", task.jobID),
+			zap.Int64("elementID", task.elementID),
+			zap.Uint64("safePoint", safePoint))
+	}
+	return nil
+}
+
+// loadDelRangeTasks loads the ranges dropped before the safe point.
+func loadDelRangeTasks(exec sqlexec.RestrictedSQLExecutor, safePoint uint64) ([]delRangeTask, error) {
+	rows, _, err := exec.ExecRestrictedSQL(fmt.Sprintf(loadDeleteRangeSQL, safePoint))
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	tasks := make([]delRangeTask, 0, len(rows))
+	for _, row := range rows {
+		startKey, err := hex.DecodeString(row.GetString(2))
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		endKey, err := hex.DecodeString(row.GetString(3))
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		tasks = append(tasks, delRangeTask{
+			jobID:     row.GetInt64(0),
+			elementID: row.GetInt64(1),
+			startKey:  startKey,
+			endKey:    endKey,
+		})
+	}
+	return tasks, nil
+}
@@ -0,0 +1,124 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ddl_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb/ddl"
+	"github.com/pingcap/tidb/domain"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/session"
+	"github.com/pingcap/tidb/store/mockstore"
+	"github.com/pingcap/tidb/store/tikv"
+	"github.com/pingcap/tidb/tablecodec"
+	"github.com/pingcap/tidb/util/testkit"
+)
+
+var _ = SerialSuites(&testDeleteRangeSuite{})
+
+type testDeleteRangeSuite struct {
+	store               kv.Storage
+	dom                 *domain.Domain
+	oldDelRangeInterval time.Duration
+}
+
+func (s *testDeleteRangeSuite) SetUpSuite(c *C) {
+	session.SetSchemaLease(200 * time.Millisecond)
+	session.DisableStats4Test()
+	s.oldDelRangeInterval = ddl.DelRangeInterval
+	ddl.DelRangeInterval = 50 * time.Millisecond
+
+	var err error
+	s.store, err = mockstore.NewMockTikvStore()
+	c.Assert(err, IsNil)
+	s.dom, err = session.BootstrapSession(s.store)
+	c.Assert(err, IsNil)
+}
+
+func (s *testDeleteRangeSuite) TearDownSuite(c *C) {
+	s.dom.Close()
+	s.store.Close()
+	ddl.DelRangeInterval = s.oldDelRangeInterval
+}
+
+func (s *testDeleteRangeSuite) countKeys(c *C, prefix kv.Key) int {
+	txn, err := s.store.Begin()
+	c.Assert(err, IsNil)
+	defer txn.Rollback()
+	it, err := txn.Iter(prefix, prefix.PrefixNext())
+	c.Assert(err, IsNil)
+	defer it.Close()
+	count := 0
+	for it.Valid() {
+		count++
+		c.Assert(it.Next(), IsNil)
+	}
+	return count
+}
+
+func (s *testDeleteRangeSuite) TestDeleteRangeAfterSafePoint(c *C) {
+	tk := testkit.NewTestKit(c, s.store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int, b int, index idx(b))")
+	tk.MustExec("insert into t values (1, 1), (2, 2)")
+	tbl := testGetTableByName(c, tk.Se, "test", "t")
+	tableID, indexID := tbl.Meta().ID, tbl.Meta().Indices[0].ID
+	prefix := tablecodec.EncodeTablePrefix(tableID)
+	c.Assert(s.countKeys(c, prefix), Equals, 4)
+
+	tk.MustExec("alter table t drop index idx")
+	tk.MustExec("drop table t")
+	tk.MustQuery("select element_id from mysql.gc_delete_range order by element_id").Check(
+		testkit.Rows(fmt.Sprint(indexID), fmt.Sprint(tableID)))
+	// The data is kept until the GC safe point passes.
+	time.Sleep(5 * ddl.DelRangeInterval)
+	c.Assert(s.countKeys(c, prefix), Equals, 4)
+
+	ver, err := s.store.CurrentVersion()
+	c.Assert(err, IsNil)
+	_, err = s.store.(*tikv.TinykvStore).PdClient.UpdateGCSafePoint(context.Background(), ver.Ver)
+	c.Assert(err, IsNil)
+	for i := 0; i < 100; i++ {
+		if tk.MustQuery("select count(*) from mysql.gc_delete_range_done").Rows()[0][0] == "2" {
+			break
+		}
+		time.Sleep(ddl.DelRangeInterval)
+	}
+	tk.MustQuery("select element_id from mysql.gc_delete_range_done order by element_id").Check(
+		testkit.Rows(fmt.Sprint(indexID), fmt.Sprint(tableID)))
+	tk.MustQuery("select count(*) from mysql.gc_delete_range").Check(testkit.Rows("0"))
+	c.Assert(s.countKeys(c, prefix), Equals, 0)
+}
+
+func (s *testDeleteRangeSuite) TestTruncateTableDeleteRange(c *C) {
+	tk := testkit.NewTestKit(c, s.store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t1 (a int primary key, b int)")
+	tk.MustExec("insert into t1 values (1, 1), (2, 2)")
+	oldTableID := testGetTableByName(c, tk.Se, "test", "t1").Meta().ID
+
+	tk.MustExec("truncate table t1")
+	newTableID := testGetTableByName(c, tk.Se, "test", "t1").Meta().ID
+	c.Assert(newTableID, Not(Equals), oldTableID)
+	tk.MustQuery("select * from t1").Check(testkit.Rows())
+	tk.MustQuery(fmt.Sprintf("select count(*) from mysql.gc_delete_range where element_id = %d", oldTableID)).Check(testkit.Rows("1"))
+	c.Assert(s.countKeys(c, tablecodec.EncodeTablePrefix(oldTableID)), Equals, 2)
+
+	tk.MustExec("insert into t1 values (1, 3)")
+	tk.MustQuery("select * from t1").Check(testkit.Rows("1 3"))
+}
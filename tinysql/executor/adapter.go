@@ -21,6 +21,7 @@ import (
 	"github.com/pingcap/errors"
 	"github.com/pingcap/tidb/expression"
 	"github.com/pingcap/tidb/infoschema"
+	"github.com/pingcap/tidb/kv"
 	"github.com/pingcap/tidb/parser/ast"
 	"github.com/pingcap/tidb/parser/model"
 	"github.com/pingcap/tidb/parser/mysql"
@@ -177,6 +178,11 @@ func (a *ExecStmt) Exec(ctx context.Context) (_ sqlexec.RecordSet, err error) {
 	}()
 
 	sctx := a.Ctx
+	if a.isPessimisticDML() {
+		if err = a.updateForUpdateTS(ctx); err != nil {
+			return nil, err
+		}
+	}
 	var e Executor
 	// Hint: step I.4.1
 	// YOUR CODE HERE (lab4)
@@ -229,6 +235,9 @@ func (a *ExecStmt) handleNoDelay(ctx context.Context, e Executor) (bool, sqlexec
 	if toCheck.Schema().Len() == 0 {
 		// Hint: step I.4.3
 		// YOUR CODE HERE (lab4)
+		if a.isPessimisticDML() {
+			return true, nil, a.handlePessimisticDML(ctx, e)
+		}
 
 		r, err := a.handleNoDelayExecutor(ctx, e)
 
@@ -257,6 +266,78 @@ func (a *ExecStmt) handleNoDelayExecutor(ctx context.Context, e Executor) (sqlex
 }
 
 // BuildExecutor exposes buildExecutor, only for test usage
+// pessimisticTxn is the transaction of a session, which knows the keys written by the current statement.
+type pessimisticTxn interface {
+	kv.Transaction
+	// KeysNeedToLock returns the keys written by the current statement which need to be locked.
+	KeysNeedToLock() ([]kv.Key, error)
+}
+
+// isPessimisticDML returns true if the statement is a DML statement in a pessimistic transaction, which locks the
+// keys it writes as it executes.
+func (a *ExecStmt) isPessimisticDML() bool {
+	if !a.Ctx.GetSessionVars().TxnCtx.IsPessimistic {
+		return false
+	}
+	switch a.StmtNode.(type) {
+	case *ast.InsertStmt, *ast.UpdateStmt, *ast.DeleteStmt:
+		return true
+	}
+	return false
+}
+
+// updateForUpdateTS gets a new timestamp as the for_update_ts of the statement. The statement reads the data
+// committed before it, and the keys written after it are write conflicts.
+func (a *ExecStmt) updateForUpdateTS(ctx context.Context) error {
+	ts, err := a.Ctx.GetStore().GetOracle().GetTimestamp(ctx)
+	if err != nil {
+		return err
+	}
+	a.Ctx.GetSessionVars().TxnCtx.SetForUpdateTS(ts)
+	return nil
+}
+
+// handlePessimisticDML executes a DML statement of a pessimistic transaction, then locks the keys it writes. If any
+// key has been written after the for_update_ts, the statement is rolled back and retried with a new for_update_ts.
+func (a *ExecStmt) handlePessimisticDML(ctx context.Context, e Executor) error {
+	sctx := a.Ctx
+	txnCtx := sctx.GetSessionVars().TxnCtx
+	for {
+		_, err := a.handleNoDelayExecutor(ctx, e)
+		if err != nil {
+			return err
+		}
+		txn, err := sctx.Txn(true)
+		if err != nil {
+			return err
+		}
+		keys, err := txn.(pessimisticTxn).KeysNeedToLock()
+		if err != nil {
+			return err
+		}
+		err = txn.LockKeys(ctx, &kv.LockCtx{ForUpdateTS: txnCtx.GetForUpdateTS()}, keys...)
+		if !terror.ErrorEqual(err, kv.ErrWriteConflict) {
+			return err
+		}
+		logutil.Logger(ctx).Info("pessimistic write conflict, retry statement",
+			zap.Uint64("txn", txn.StartTS()),
+			zap.Uint64("forUpdateTS", txnCtx.GetForUpdateTS()),
+			zap.Error(err))
+		sctx.StmtRollback()
+		if err = a.updateForUpdateTS(ctx); err != nil {
+			return err
+		}
+		e, err = a.buildExecutor()
+		if err != nil {
+			return err
+		}
+		if err = e.Open(ctx); err != nil {
+			terror.Call(e.Close)
+			return err
+		}
+	}
+}
+
 func (a *ExecStmt) BuildExecutor() (Executor, error) {
 	return a.buildExecutor()
 }
@@ -55,8 +55,14 @@ func buildCount(aggFuncDesc *aggregation.AggFuncDesc, ordinal int) AggFunc {
 			return &countOriginal4Int{baseCount{base}}
 		case types.ETReal:
 			return &countOriginal4Real{baseCount{base}}
+		case types.ETDecimal:
+			return &countOriginal4Decimal{baseCount{base}}
 		case types.ETString:
 			return &countOriginal4String{baseCount{base}}
+		case types.ETDatetime, types.ETTimestamp:
+			return &countOriginal4Time{baseCount{base}}
+		case types.ETDuration:
+			return &countOriginal4Duration{baseCount{base}}
 		}
 	case aggregation.Partial2Mode, aggregation.FinalMode:
 		return &countPartial{baseCount{base}}
@@ -76,6 +82,8 @@ func buildSum(aggFuncDesc *aggregation.AggFuncDesc, ordinal int) AggFunc {
 	switch aggFuncDesc.RetTp.EvalType() {
 	case types.ETInt:
 		return &sum4Int64{base}
+	case types.ETDecimal:
+		return &sum4Decimal{base}
 	default:
 		return &sum4Float64{base}
 	}
@@ -94,6 +102,8 @@ func buildAvg(aggFuncDesc *aggregation.AggFuncDesc, ordinal int) AggFunc {
 		switch aggFuncDesc.RetTp.EvalType() {
 		case types.ETInt:
 			return &avgOriginal4Int64{baseAvgInt64{base}}
+		case types.ETDecimal:
+			return &avgOriginal4Decimal{baseAvgDecimal{base, aggFuncDesc.RetTp.Decimal}}
 		default:
 			return &avgOriginal4Float64{baseAvgFloat64{base}}
 		}
@@ -104,6 +114,8 @@ func buildAvg(aggFuncDesc *aggregation.AggFuncDesc, ordinal int) AggFunc {
 		switch aggFuncDesc.RetTp.Tp {
 		case mysql.TypeLonglong:
 			return &avgPartial4Int64{baseAvgInt64{base}}
+		case mysql.TypeNewDecimal:
+			return &avgPartial4Decimal{baseAvgDecimal{base, aggFuncDesc.RetTp.Decimal}}
 		case mysql.TypeDouble:
 			return &avgPartial4Float64{baseAvgFloat64{base}}
 		}
@@ -132,8 +144,14 @@ func buildFirstRow(aggFuncDesc *aggregation.AggFuncDesc, ordinal int) AggFunc {
 		case mysql.TypeDouble:
 			return &firstRow4Float64{base}
 		}
+	case types.ETDecimal:
+		return &firstRow4Decimal{base}
 	case types.ETString:
 		return &firstRow4String{base}
+	case types.ETDatetime, types.ETTimestamp:
+		return &firstRow4Time{base}
+	case types.ETDuration:
+		return &firstRow4Duration{base}
 	}
 	return nil
 }
@@ -165,8 +183,14 @@ func buildMaxMin(aggFuncDesc *aggregation.AggFuncDesc, ordinal int, isMax bool)
 		case mysql.TypeDouble:
 			return &maxMin4Float64{base}
 		}
+	case types.ETDecimal:
+		return &maxMin4Decimal{base}
 	case types.ETString:
 		return &maxMin4String{base}
+	case types.ETDatetime, types.ETTimestamp:
+		return &maxMin4Time{base}
+	case types.ETDuration:
+		return &maxMin4Duration{base}
 	}
 	return nil
 }
@@ -216,3 +216,127 @@ func (e *avgPartial4Float64) MergePartialResult(sctx sessionctx.Context, src Par
 	p2.count += p1.count
 	return nil
 }
+
+// All the following avg function implementations return the decimal result,
+// which store the partial results in "partialResult4AvgDecimal".
+//
+// "baseAvgDecimal" is wrapped by:
+// - "avgOriginal4Decimal"
+// - "avgPartial4Decimal"
+type baseAvgDecimal struct {
+	baseAggFunc
+
+	// frac is the number of fractional digits of the result.
+	frac int
+}
+
+type partialResult4AvgDecimal struct {
+	sum   types.MyDecimal
+	count int64
+}
+
+func (e *baseAvgDecimal) AllocPartialResult() PartialResult {
+	return PartialResult(&partialResult4AvgDecimal{})
+}
+
+func (e *baseAvgDecimal) ResetPartialResult(pr PartialResult) {
+	p := (*partialResult4AvgDecimal)(pr)
+	p.sum = *types.NewDecFromInt(0)
+	p.count = int64(0)
+}
+
+func (e *baseAvgDecimal) AppendFinalResult2Chunk(sctx sessionctx.Context, pr PartialResult, chk *chunk.Chunk) error {
+	p := (*partialResult4AvgDecimal)(pr)
+	if p.count == 0 {
+		chk.AppendNull(e.ordinal)
+		return nil
+	}
+	decimalCount := types.NewDecFromInt(p.count)
+	finalResult := new(types.MyDecimal)
+	err := types.DecimalDiv(&p.sum, decimalCount, finalResult, types.DivFracIncr)
+	if err != nil {
+		return err
+	}
+	// Round the result to the scale of the return type.
+	err = finalResult.Round(finalResult, e.frac, types.ModeHalfUp)
+	if err != nil {
+		return err
+	}
+	chk.AppendMyDecimal(e.ordinal, finalResult)
+	return nil
+}
+
+type avgOriginal4Decimal struct {
+	baseAvgDecimal
+}
+
+func (e *avgOriginal4Decimal) UpdatePartialResult(sctx sessionctx.Context, rowsInGroup []chunk.Row, pr PartialResult) error {
+	p := (*partialResult4AvgDecimal)(pr)
+	for _, row := range rowsInGroup {
+		input, isNull, err := e.args[0].EvalDecimal(sctx, row)
+		if err != nil {
+			return err
+		}
+		if isNull {
+			continue
+		}
+
+		newSum := new(types.MyDecimal)
+		err = types.DecimalAdd(&p.sum, input, newSum)
+		if err != nil {
+			return err
+		}
+		p.sum = *newSum
+		p.count++
+	}
+	return nil
+}
+
+type avgPartial4Decimal struct {
+	baseAvgDecimal
+}
+
+func (e *avgPartial4Decimal) UpdatePartialResult(sctx sessionctx.Context, rowsInGroup []chunk.Row, pr PartialResult) error {
+	p := (*partialResult4AvgDecimal)(pr)
+	for _, row := range rowsInGroup {
+		inputSum, isNull, err := e.args[1].EvalDecimal(sctx, row)
+		if err != nil {
+			return err
+		}
+		if isNull {
+			continue
+		}
+
+		inputCount, isNull, err := e.args[0].EvalInt(sctx, row)
+		if err != nil {
+			return err
+		}
+		if isNull {
+			continue
+		}
+
+		newSum := new(types.MyDecimal)
+		err = types.DecimalAdd(&p.sum, inputSum, newSum)
+		if err != nil {
+			return err
+		}
+		p.sum = *newSum
+		p.count += inputCount
+	}
+	return nil
+}
+
+func (e *avgPartial4Decimal) MergePartialResult(sctx sessionctx.Context, src PartialResult, dst PartialResult) error {
+	p1, p2 := (*partialResult4AvgDecimal)(src), (*partialResult4AvgDecimal)(dst)
+	if p1.count == 0 {
+		return nil
+	}
+	newSum := new(types.MyDecimal)
+	err := types.DecimalAdd(&p1.sum, &p2.sum, newSum)
+	if err != nil {
+		return err
+	}
+	p2.sum = *newSum
+	p2.count += p1.count
+	return nil
+}
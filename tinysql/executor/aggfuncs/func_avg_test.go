@@ -17,12 +17,14 @@ import (
 	. "github.com/pingcap/check"
 	"github.com/pingcap/tidb/parser/ast"
 	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/types"
 )
 
 func (s *testSuite) TestMergePartialResult4Avg(c *C) {
 	tests := []aggTest{
 		buildAggTester(ast.AggFuncAvg, mysql.TypeLonglong, 5, 2.0, 3.0, 2),
 		buildAggTester(ast.AggFuncAvg, mysql.TypeDouble, 5, 2.0, 3.0, 2.375),
+		buildAggTester(ast.AggFuncAvg, mysql.TypeNewDecimal, 5, types.NewDecFromInt(2), types.NewDecFromInt(3), types.NewDecFromStringForTest("2.375")),
 	}
 	for _, test := range tests {
 		s.testMergePartialResult(c, test)
@@ -33,6 +35,7 @@ func (s *testSuite) TestAvg(c *C) {
 	tests := []aggTest{
 		buildAggTester(ast.AggFuncAvg, mysql.TypeLonglong, 5, nil, 2.0),
 		buildAggTester(ast.AggFuncAvg, mysql.TypeDouble, 5, nil, 2.0),
+		buildAggTester(ast.AggFuncAvg, mysql.TypeNewDecimal, 5, nil, types.NewDecFromInt(2)),
 	}
 	for _, test := range tests {
 		s.testAggFunc(c, test)
@@ -92,6 +92,72 @@ func (e *countOriginal4String) UpdatePartialResult(sctx sessionctx.Context, rows
 	return nil
 }
 
+type countOriginal4Time struct {
+	baseCount
+}
+
+func (e *countOriginal4Time) UpdatePartialResult(sctx sessionctx.Context, rowsInGroup []chunk.Row, pr PartialResult) error {
+	p := (*partialResult4Count)(pr)
+
+	for _, row := range rowsInGroup {
+		_, isNull, err := e.args[0].EvalTime(sctx, row)
+		if err != nil {
+			return err
+		}
+		if isNull {
+			continue
+		}
+
+		*p++
+	}
+
+	return nil
+}
+
+type countOriginal4Decimal struct {
+	baseCount
+}
+
+func (e *countOriginal4Decimal) UpdatePartialResult(sctx sessionctx.Context, rowsInGroup []chunk.Row, pr PartialResult) error {
+	p := (*partialResult4Count)(pr)
+
+	for _, row := range rowsInGroup {
+		_, isNull, err := e.args[0].EvalDecimal(sctx, row)
+		if err != nil {
+			return err
+		}
+		if isNull {
+			continue
+		}
+
+		*p++
+	}
+
+	return nil
+}
+
+type countOriginal4Duration struct {
+	baseCount
+}
+
+func (e *countOriginal4Duration) UpdatePartialResult(sctx sessionctx.Context, rowsInGroup []chunk.Row, pr PartialResult) error {
+	p := (*partialResult4Count)(pr)
+
+	for _, row := range rowsInGroup {
+		_, isNull, err := e.args[0].EvalDuration(sctx, row)
+		if err != nil {
+			return err
+		}
+		if isNull {
+			continue
+		}
+
+		*p++
+	}
+
+	return nil
+}
+
 type countPartial struct {
 	baseCount
 }
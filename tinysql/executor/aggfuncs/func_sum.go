@@ -29,6 +29,11 @@ type partialResult4Int64 struct {
 	isNull bool
 }
 
+type partialResult4SumDecimal struct {
+	val    types.MyDecimal
+	isNull bool
+}
+
 type baseSumAggFunc struct {
 	baseAggFunc
 }
@@ -152,3 +157,72 @@ func (e *sum4Int64) MergePartialResult(sctx sessionctx.Context, src, dst Partial
 	p2.isNull = false
 	return nil
 }
+
+type sum4Decimal struct {
+	baseSumAggFunc
+}
+
+func (e *sum4Decimal) AllocPartialResult() PartialResult {
+	p := new(partialResult4SumDecimal)
+	p.isNull = true
+	return PartialResult(p)
+}
+
+func (e *sum4Decimal) ResetPartialResult(pr PartialResult) {
+	p := (*partialResult4SumDecimal)(pr)
+	p.isNull = true
+}
+
+func (e *sum4Decimal) AppendFinalResult2Chunk(sctx sessionctx.Context, pr PartialResult, chk *chunk.Chunk) error {
+	p := (*partialResult4SumDecimal)(pr)
+	if p.isNull {
+		chk.AppendNull(e.ordinal)
+		return nil
+	}
+	chk.AppendMyDecimal(e.ordinal, &p.val)
+	return nil
+}
+
+func (e *sum4Decimal) UpdatePartialResult(sctx sessionctx.Context, rowsInGroup []chunk.Row, pr PartialResult) error {
+	p := (*partialResult4SumDecimal)(pr)
+	for _, row := range rowsInGroup {
+		input, isNull, err := e.args[0].EvalDecimal(sctx, row)
+		if err != nil {
+			return err
+		}
+		if isNull {
+			continue
+		}
+		if p.isNull {
+			p.val = *input
+			p.isNull = false
+			continue
+		}
+
+		newSum := new(types.MyDecimal)
+		err = types.DecimalAdd(&p.val, input, newSum)
+		if err != nil {
+			return err
+		}
+		p.val = *newSum
+	}
+	return nil
+}
+
+func (e *sum4Decimal) MergePartialResult(sctx sessionctx.Context, src, dst PartialResult) error {
+	p1, p2 := (*partialResult4SumDecimal)(src), (*partialResult4SumDecimal)(dst)
+	if p1.isNull {
+		return nil
+	}
+	if p2.isNull {
+		*p2 = *p1
+		return nil
+	}
+	newSum := new(types.MyDecimal)
+	err := types.DecimalAdd(&p1.val, &p2.val, newSum)
+	if err != nil {
+		return err
+	}
+	p2.val = *newSum
+	return nil
+}
@@ -72,6 +72,8 @@ func (b *executorBuilder) build(p plannercore.Plan) Executor {
 		return b.buildDDL(v)
 	case *plannercore.Delete:
 		return b.buildDelete(v)
+	case *plannercore.Update:
+		return b.buildUpdate(v)
 	case *plannercore.Explain:
 		return b.buildExplain(v)
 	case *plannercore.Insert:
@@ -100,6 +102,10 @@ func (b *executorBuilder) build(p plannercore.Plan) Executor {
 		return b.buildMergeJoin(v)
 	case *plannercore.PhysicalSelection:
 		return b.buildSelection(v)
+	case *plannercore.PhysicalApply:
+		return b.buildApply(v)
+	case *plannercore.PhysicalMaxOneRow:
+		return b.buildMaxOneRow(v)
 	case *plannercore.PhysicalHashAgg:
 		return b.buildHashAgg(v)
 	case *plannercore.PhysicalProjection:
@@ -488,6 +494,53 @@ func (b *executorBuilder) buildHashJoin(v *plannercore.PhysicalHashJoin) Executo
 	return e
 }
 
+func (b *executorBuilder) buildApply(v *plannercore.PhysicalApply) Executor {
+	leftChild := b.build(v.Children()[0])
+	if b.err != nil {
+		return nil
+	}
+	rightChild := b.build(v.Children()[1])
+	if b.err != nil {
+		return nil
+	}
+	otherConditions := append(expression.ScalarFuncs2Exprs(v.EqualConditions), v.OtherConditions...)
+	defaultValues := v.DefaultValues
+	if defaultValues == nil {
+		defaultValues = make([]types.Datum, v.Children()[v.InnerChildIdx].Schema().Len())
+	}
+	tupleJoiner := newJoiner(b.ctx, v.JoinType, v.InnerChildIdx == 0,
+		defaultValues, otherConditions, retTypes(leftChild), retTypes(rightChild))
+	outerExec, innerExec := leftChild, rightChild
+	outerFilter, innerFilter := v.LeftConditions, v.RightConditions
+	if v.InnerChildIdx == 0 {
+		outerExec, innerExec = rightChild, leftChild
+		outerFilter, innerFilter = v.RightConditions, v.LeftConditions
+	}
+	e := &NestedLoopApplyExec{
+		baseExecutor: newBaseExecutor(b.ctx, v.Schema(), v.ExplainID(), outerExec, innerExec),
+		innerExec:    innerExec,
+		outerExec:    outerExec,
+		outerFilter:  outerFilter,
+		innerFilter:  innerFilter,
+		outer:        v.JoinType != plannercore.InnerJoin,
+		joiner:       tupleJoiner,
+		outerSchema:  v.OuterSchema,
+	}
+	return e
+}
+
+func (b *executorBuilder) buildMaxOneRow(v *plannercore.PhysicalMaxOneRow) Executor {
+	childExec := b.build(v.Children()[0])
+	if b.err != nil {
+		return nil
+	}
+	base := newBaseExecutor(b.ctx, v.Schema(), v.ExplainID(), childExec)
+	base.initCap = 2
+	base.maxChunkSize = 2
+	e := &MaxOneRowExec{baseExecutor: base}
+	return e
+}
+
 func (b *executorBuilder) buildHashAgg(v *plannercore.PhysicalHashAgg) Executor {
 	src := b.build(v.Children()[0])
 	if b.err != nil {
@@ -654,6 +707,28 @@ func (b *executorBuilder) buildTopN(v *plannercore.PhysicalTopN) Executor {
 	}
 }
 
+func (b *executorBuilder) buildUpdate(v *plannercore.Update) Executor {
+	tblID2table := make(map[int64]table.Table)
+	for _, info := range v.TblColPosInfos {
+		tblID2table[info.TblID], _ = b.is.TableByID(info.TblID)
+	}
+	b.startTS = b.ctx.GetSessionVars().TxnCtx.GetForUpdateTS()
+	selExec := b.build(v.SelectPlan)
+	if b.err != nil {
+		return nil
+	}
+	base := newBaseExecutor(b.ctx, v.Schema(), v.ExplainID(), selExec)
+	base.initCap = chunk.ZeroCapacity
+	updateExec := &UpdateExec{
+		baseExecutor:              base,
+		OrderedList:               v.OrderedList,
+		allAssignmentsAreConstant: v.AllAssignmentsAreConstant,
+		tblID2table:               tblID2table,
+		tblColPosInfos:            v.TblColPosInfos,
+	}
+	return updateExec
+}
+
 func (b *executorBuilder) buildDelete(v *plannercore.Delete) Executor {
 	tblID2table := make(map[int64]table.Table)
 	for _, info := range v.TblColPosInfos {
@@ -85,6 +85,10 @@ func (e *DDLExec) Next(ctx context.Context, req *chunk.Chunk) (err error) {
 		err = e.executeDropDatabase(x)
 	case *ast.DropTableStmt:
 		err = e.executeDropTableOrView(x)
+	case *ast.RenameTableStmt:
+		err = e.executeRenameTable(x)
+	case *ast.TruncateTableStmt:
+		err = e.executeTruncateTable(x)
 	}
 	if err != nil {
 		// If the owner return ErrTableNotExists error when running this DDL, it may be caused by schema changed,
@@ -230,6 +234,24 @@ func (e *DDLExec) executeDropTableOrView(s *ast.DropTableStmt) error {
 	return nil
 }
 
+func (e *DDLExec) executeTruncateTable(s *ast.TruncateTableStmt) error {
+	ident := ast.Ident{Schema: s.Table.Schema, Name: s.Table.Name}
+	err := domain.GetDomain(e.ctx).DDL().TruncateTable(e.ctx, ident)
+	return err
+}
+
+func (e *DDLExec) executeRenameTable(s *ast.RenameTableStmt) error {
+	if len(s.TableToTables) != 1 {
+		// Now we only allow one schema changing at the same time.
+		return errors.Errorf("can't run multi schema change")
+	}
+	t := s.TableToTables[0]
+	oldIdent := ast.Ident{Schema: t.OldTable.Schema, Name: t.OldTable.Name}
+	newIdent := ast.Ident{Schema: t.NewTable.Schema, Name: t.NewTable.Name}
+	err := domain.GetDomain(e.ctx).DDL().RenameTable(e.ctx, oldIdent, newIdent, false)
+	return err
+}
+
 func (e *DDLExec) executeDropIndex(s *ast.DropIndexStmt) error {
 	ti := ast.Ident{Schema: s.Table.Schema, Name: s.Table.Name}
 	err := domain.GetDomain(e.ctx).DDL().DropIndex(e.ctx, ti, model.NewCIStr(s.IndexName), s.IfExists)
@@ -16,8 +16,10 @@ package executor_test
 import (
 	"fmt"
 	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb/ddl"
 	ddlutil "github.com/pingcap/tidb/ddl/util"
 	"github.com/pingcap/tidb/domain"
+	"github.com/pingcap/tidb/infoschema"
 	"github.com/pingcap/tidb/parser/model"
 	"github.com/pingcap/tidb/parser/mysql"
 	"github.com/pingcap/tidb/parser/terror"
@@ -65,6 +67,69 @@ func (s *testSuite6) TestCreateDropIndex(c *C) {
 	tk.MustExec("drop table drop_test")
 }
 
+func (s *testSuite6) TestTruncateTable(c *C) {
+	tk := testkit.NewTestKit(c, s.store)
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists truncate_test")
+	tk.MustExec("create table truncate_test (a int primary key, b int, index idx_b(b))")
+	tk.MustExec("insert truncate_test values (1, 1), (2, 2)")
+	tk.MustExec("truncate table truncate_test")
+	tk.MustQuery("select * from truncate_test").Check(testkit.Rows())
+	tk.MustExec("insert truncate_test values (1, 3)")
+	tk.MustQuery("select * from truncate_test where b = 3").Check(testkit.Rows("1 3"))
+	tk.MustExec("truncate truncate_test")
+	tk.MustQuery("select count(*) from truncate_test").Check(testkit.Rows("0"))
+
+	_, err := tk.Exec("truncate table truncate_not_exist")
+	c.Assert(err, NotNil)
+}
+
+func (s *testSuite6) TestRenameTable(c *C) {
+	tk := testkit.NewTestKit(c, s.store)
+	tk.MustExec("drop database if exists rename1")
+	tk.MustExec("drop database if exists rename2")
+	tk.MustExec("create database rename1")
+	tk.MustExec("create database rename2")
+	tk.MustExec("use rename1")
+	tk.MustExec("create table t (a int primary key auto_increment, b int)")
+	tk.MustExec("insert t values (null, 1), (null, 2)")
+
+	// Rename in the same database.
+	tk.MustExec("rename table t to t1")
+	tk.MustQuery("select * from t1").Check(testkit.Rows("1 1", "2 2"))
+	_, err := tk.Exec("select * from t")
+	c.Assert(err, NotNil)
+	tk.MustExec("insert t1 values (null, 3)")
+	tk.MustQuery("select * from t1 where b = 3").Check(testkit.Rows("3 3"))
+
+	// Rename across databases.
+	tk.MustExec("rename table rename1.t1 to rename2.t2")
+	tk.MustQuery("select count(*) from rename2.t2").Check(testkit.Rows("3"))
+	tk.MustQuery("show tables from rename1").Check(testkit.Rows())
+	tk.MustExec("insert rename2.t2 (b) values (4)")
+	tk.MustQuery("select count(distinct a) from rename2.t2").Check(testkit.Rows("4"))
+
+	// Alter table rename.
+	tk.MustExec("alter table rename2.t2 rename to rename1.t")
+	tk.MustQuery("select count(*) from rename1.t").Check(testkit.Rows("4"))
+	tk.MustExec("alter table t rename as t")
+	tk.MustExec("alter table t rename t3")
+	tk.MustQuery("select count(*) from t3").Check(testkit.Rows("4"))
+
+	tk.MustExec("create table t4 (a int)")
+	_, err = tk.Exec("rename table t3 to t4")
+	c.Assert(terror.ErrorEqual(err, infoschema.ErrTableExists), IsTrue)
+	_, err = tk.Exec("rename table t3 to rename_not_exist.t3")
+	c.Assert(terror.ErrorEqual(err, ddl.ErrErrorOnRename), IsTrue)
+	_, err = tk.Exec("rename table t_not_exist to t5")
+	c.Assert(terror.ErrorEqual(err, infoschema.ErrTableNotExists), IsTrue)
+	_, err = tk.Exec("rename table t3 to t5, t4 to t6")
+	c.Assert(err, NotNil)
+
+	tk.MustExec("drop database rename1")
+	tk.MustExec("drop database rename2")
+}
+
 func (s *testSuite6) TestAddNotNullColumnNoDefault(c *C) {
 	tk := testkit.NewTestKit(c, s.store)
 	tk.MustExec("use test")
@@ -28,6 +28,8 @@ var (
 	ErrBuildExecutor   = terror.ClassExecutor.New(mysql.ErrBuildExecutor, mysql.MySQLErrName[mysql.ErrBuildExecutor])
 	ErrBatchInsertFail = terror.ClassExecutor.New(mysql.ErrBatchInsertFail, mysql.MySQLErrName[mysql.ErrBatchInsertFail])
 
+	ErrSubqueryMoreThan1Row = terror.ClassExecutor.New(mysql.ErrSubqueryNo1Row, mysql.MySQLErrName[mysql.ErrSubqueryNo1Row])
+
 	ErrCantCreateUserWithGrant     = terror.ClassExecutor.New(mysql.ErrCantCreateUserWithGrant, mysql.MySQLErrName[mysql.ErrCantCreateUserWithGrant])
 	ErrPasswordNoMatch             = terror.ClassExecutor.New(mysql.ErrPasswordNoMatch, mysql.MySQLErrName[mysql.ErrPasswordNoMatch])
 	ErrCannotUser                  = terror.ClassExecutor.New(mysql.ErrCannotUser, mysql.MySQLErrName[mysql.ErrCannotUser])
@@ -53,6 +55,7 @@ func init() {
 		mysql.ErrResultIsEmpty:   mysql.ErrResultIsEmpty,
 		mysql.ErrBuildExecutor:   mysql.ErrBuildExecutor,
 		mysql.ErrBatchInsertFail: mysql.ErrBatchInsertFail,
+		mysql.ErrSubqueryNo1Row:  mysql.ErrSubqueryNo1Row,
 
 		mysql.ErrCantCreateUserWithGrant:     mysql.ErrCantCreateUserWithGrant,
 		mysql.ErrPasswordNoMatch:             mysql.ErrPasswordNoMatch,
@@ -40,7 +40,9 @@ var (
 	_ Executor = &IndexLookUpExecutor{}
 	_ Executor = &IndexReaderExecutor{}
 	_ Executor = &LimitExec{}
+	_ Executor = &MaxOneRowExec{}
 	_ Executor = &MergeJoinExec{}
+	_ Executor = &NestedLoopApplyExec{}
 	_ Executor = &ProjectionExec{}
 	_ Executor = &SelectionExec{}
 	_ Executor = &ShowDDLExec{}
@@ -342,6 +344,56 @@ func (e *TableDualExec) Next(ctx context.Context, req *chunk.Chunk) error {
 	return nil
 }
 
+// MaxOneRowExec checks if the number of rows that a query returns is at maximum one.
+// It's built from subquery expression.
+type MaxOneRowExec struct {
+	baseExecutor
+
+	evaluated bool
+}
+
+// Open implements the Executor Open interface.
+func (e *MaxOneRowExec) Open(ctx context.Context) error {
+	if err := e.baseExecutor.Open(ctx); err != nil {
+		return err
+	}
+	e.evaluated = false
+	return nil
+}
+
+// Next implements the Executor Next interface.
+func (e *MaxOneRowExec) Next(ctx context.Context, req *chunk.Chunk) error {
+	req.Reset()
+	if e.evaluated {
+		return nil
+	}
+	e.evaluated = true
+	err := Next(ctx, e.children[0], req)
+	if err != nil {
+		return err
+	}
+
+	if num := req.NumRows(); num == 0 {
+		for i := range e.schema.Columns {
+			req.AppendNull(i)
+		}
+		return nil
+	} else if num != 1 {
+		return ErrSubqueryMoreThan1Row
+	}
+
+	childChunk := newFirstChunk(e.children[0])
+	err = Next(ctx, e.children[0], childChunk)
+	if err != nil {
+		return err
+	}
+	if childChunk.NumRows() != 0 {
+		return ErrSubqueryMoreThan1Row
+	}
+
+	return nil
+}
+
 // SelectionExec represents a filter executor.
 type SelectionExec struct {
 	baseExecutor
@@ -538,6 +590,8 @@ func extractStmtHintsFromStmtNode(stmtNode ast.StmtNode) []*ast.TableOptimizerHi
 	switch x := stmtNode.(type) {
 	case *ast.SelectStmt:
 		return x.TableHints
+	case *ast.UpdateStmt:
+		return x.TableHints
 	case *ast.DeleteStmt:
 		return nil
 	// TODO: support hint for InsertStmt
@@ -626,6 +680,13 @@ func ResetContextOfStmt(ctx sessionctx.Context, s ast.StmtNode) (err error) {
 	// IgnoreErr and StrictSQLMode) to avoid setting the same bool variables and
 	// pushing them down to TiKV as flags.
 	switch stmt := s.(type) {
+	case *ast.UpdateStmt:
+		sc.InUpdateStmt = true
+		sc.BadNullAsWarning = !vars.StrictSQLMode
+		sc.TruncateAsWarning = !vars.StrictSQLMode
+		sc.DividedByZeroAsWarning = !vars.StrictSQLMode
+		sc.AllowInvalidDate = vars.SQLMode.HasAllowInvalidDatesMode()
+		sc.IgnoreZeroInDate = !vars.StrictSQLMode || sc.AllowInvalidDate
 	case *ast.DeleteStmt:
 		sc.InDeleteStmt = true
 		sc.BadNullAsWarning = !vars.StrictSQLMode
@@ -680,7 +741,7 @@ func ResetContextOfStmt(ctx sessionctx.Context, s ast.StmtNode) (err error) {
 		sc.PrevLastInsertID = vars.StmtCtx.PrevLastInsertID
 	}
 	sc.PrevAffectedRows = 0
-	if vars.StmtCtx.InDeleteStmt || vars.StmtCtx.InInsertStmt {
+	if vars.StmtCtx.InUpdateStmt || vars.StmtCtx.InDeleteStmt || vars.StmtCtx.InInsertStmt {
 		sc.PrevAffectedRows = int64(vars.StmtCtx.AffectedRows())
 	} else if vars.StmtCtx.InSelectStmt {
 		sc.PrevAffectedRows = -1
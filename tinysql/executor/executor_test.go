@@ -1082,6 +1082,29 @@ func (s *testSuite) TestUnsignedPk(c *C) {
 	tk.MustQuery("select * from t use index(idx) where b = 1 order by b, a").Check(testkit.Rows("1 1", "9223372036854775808 1"))
 }
 
+func (s *testSuite) TestTimeColumns(c *C) {
+	tk := testkit.NewTestKitWithInit(c, s.store)
+
+	tk.MustExec("drop table if exists t")
+	tk.MustExec("create table t(id int primary key, d date, dt datetime(3), ts timestamp, du time, index idx_dt(dt), index idx_du(du))")
+	tk.MustExec("insert into t values(1, '2012-12-31', '2012-12-31 11:30:45.123', '2012-12-31 11:30:45', '11:30:45')")
+	tk.MustExec("insert into t values(2, 20130101, 20130101000000, '2013-01-01', '-1:00:00')")
+	tk.MustQuery("select * from t").Check(testkit.Rows(
+		"1 2012-12-31 2012-12-31 11:30:45.123 2012-12-31 11:30:45 11:30:45",
+		"2 2013-01-01 2013-01-01 00:00:00.000 2013-01-01 00:00:00 -01:00:00"))
+	tk.MustQuery("select id from t use index(idx_dt) where dt > '2012-12-31 11:30:45'").Check(testkit.Rows("1", "2"))
+	tk.MustQuery("select id from t use index(idx_dt) where dt = '2012-12-31 11:30:45.123'").Check(testkit.Rows("1"))
+	tk.MustQuery("select id from t use index(idx_du) where du < '00:00:00'").Check(testkit.Rows("2"))
+	tk.MustQuery("select id from t where d between '2012-12-01' and '2012-12-31'").Check(testkit.Rows("1"))
+	tk.MustQuery("select max(dt), min(du), count(ts) from t").Check(testkit.Rows("2013-01-01 00:00:00.000 -01:00:00 2"))
+	tk.MustQuery("select datediff(d, '2012-12-01'), extract(year_month from dt), date_add(d, interval 1 day) from t where id = 1").Check(testkit.Rows("30 201212 2013-01-01"))
+
+	tk.MustExec("update t set ts = '2014-01-01 00:00:00' where id = 2")
+	tk.MustQuery("select ts from t where id = 2").Check(testkit.Rows("2014-01-01 00:00:00"))
+	_, err := tk.Exec("insert into t values(3, '2012-13-01', null, null, null)")
+	c.Assert(err, NotNil)
+}
+
 func (s *testSuite) TestIssue5341(c *C) {
 	tk := testkit.NewTestKit(c, s.store)
 	tk.MustExec("drop table if exists test.t")
@@ -342,18 +342,19 @@ func (e *HashJoinExec) joinMatchedOuterSideRow2Chunk(workerID uint, outerKey uin
 		return false, joinResult
 	}
 	if len(buildSideRows) == 0 {
-		e.joiners[workerID].onMissMatch(outerSideRow, joinResult.chk)
+		e.joiners[workerID].onMissMatch(false, outerSideRow, joinResult.chk)
 		return true, joinResult
 	}
 	iter := chunk.NewIterator4Slice(buildSideRows)
-	hasMatch := false
+	hasMatch, hasNull := false, false
 	for iter.Begin(); iter.Current() != iter.End(); {
-		matched, _, err := e.joiners[workerID].tryToMatchInners(outerSideRow, iter, joinResult.chk)
+		matched, isNull, err := e.joiners[workerID].tryToMatchInners(outerSideRow, iter, joinResult.chk)
 		if err != nil {
 			joinResult.err = err
 			return false, joinResult
 		}
 		hasMatch = hasMatch || matched
+		hasNull = hasNull || isNull
 
 		if joinResult.chk.IsFull() {
 			e.joinResultCh <- joinResult
@@ -364,7 +365,7 @@ func (e *HashJoinExec) joinMatchedOuterSideRow2Chunk(workerID uint, outerKey uin
 		}
 	}
 	if !hasMatch {
-		e.joiners[workerID].onMissMatch(outerSideRow, joinResult.chk)
+		e.joiners[workerID].onMissMatch(hasNull, outerSideRow, joinResult.chk)
 	}
 	return true, joinResult
 }
@@ -389,7 +390,7 @@ func (e *HashJoinExec) join2Chunk(workerID uint, outerSideChk *chunk.Chunk, hCtx
 
 	for i := range selected {
 		if !selected[i] || hCtx.hasNull[i] { // process unmatched outer side rows
-			e.joiners[workerID].onMissMatch(outerSideChk.GetRow(i), joinResult.chk)
+			e.joiners[workerID].onMissMatch(false, outerSideChk.GetRow(i), joinResult.chk)
 		} else { // process matched outer side rows
 			outerKey, outerRow := hCtx.hashVals[i].Sum64(), outerSideChk.GetRow(i)
 			ok, joinResult = e.joinMatchedOuterSideRow2Chunk(workerID, outerKey, outerRow, hCtx, joinResult)
@@ -407,3 +408,157 @@ func (e *HashJoinExec) join2Chunk(workerID uint, outerSideChk *chunk.Chunk, hCtx
 	}
 	return true, joinResult
 }
+
+// NestedLoopApplyExec is the executor for apply.
+type NestedLoopApplyExec struct {
+	baseExecutor
+
+	innerExec   Executor
+	outerExec   Executor
+	innerFilter expression.CNFExprs
+	outerFilter expression.CNFExprs
+	outer       bool
+
+	joiner joiner
+
+	// outerSchema holds the correlated columns of the inner plan. They are set
+	// by the current outer row before the inner plan is executed.
+	outerSchema []*expression.CorrelatedColumn
+
+	outerChunk       *chunk.Chunk
+	outerChunkCursor int
+	outerSelected    []bool
+	innerList        *chunk.List
+	innerChunk       *chunk.Chunk
+	innerSelected    []bool
+	innerIter        chunk.Iterator
+	outerRow         *chunk.Row
+	hasMatch         bool
+	hasNull          bool
+}
+
+// Close implements the Executor interface.
+func (e *NestedLoopApplyExec) Close() error {
+	e.innerList = nil
+	return e.outerExec.Close()
+}
+
+// Open implements the Executor interface.
+func (e *NestedLoopApplyExec) Open(ctx context.Context) error {
+	err := e.outerExec.Open(ctx)
+	if err != nil {
+		return err
+	}
+	e.outerChunk = newFirstChunk(e.outerExec)
+	e.outerChunkCursor = 0
+	e.innerChunk = newFirstChunk(e.innerExec)
+	e.innerList = chunk.NewList(retTypes(e.innerExec), e.initCap, e.maxChunkSize)
+	e.innerIter = nil
+	e.outerRow = nil
+	return nil
+}
+
+// fetchSelectedOuterRow returns the next outer row which passes the outer
+// filter. The filtered outer rows are handled as unmatched rows for outer joins.
+func (e *NestedLoopApplyExec) fetchSelectedOuterRow(ctx context.Context, chk *chunk.Chunk) (*chunk.Row, error) {
+	outerIter := chunk.NewIterator4Chunk(e.outerChunk)
+	for {
+		if e.outerChunkCursor >= e.outerChunk.NumRows() {
+			err := Next(ctx, e.outerExec, e.outerChunk)
+			if err != nil {
+				return nil, err
+			}
+			if e.outerChunk.NumRows() == 0 {
+				return nil, nil
+			}
+			e.outerSelected, err = expression.VectorizedFilter(e.ctx, e.outerFilter, outerIter, e.outerSelected)
+			if err != nil {
+				return nil, err
+			}
+			e.outerChunkCursor = 0
+		}
+		outerRow := e.outerChunk.GetRow(e.outerChunkCursor)
+		selected := e.outerSelected[e.outerChunkCursor]
+		e.outerChunkCursor++
+		if selected {
+			return &outerRow, nil
+		} else if e.outer {
+			e.joiner.onMissMatch(false, outerRow, chk)
+			if chk.IsFull() {
+				return nil, nil
+			}
+		}
+	}
+}
+
+// fetchAllInners reads all data from the inner plan and stores the rows which
+// pass the inner filter in a List.
+func (e *NestedLoopApplyExec) fetchAllInners(ctx context.Context) (err error) {
+	if err = e.innerExec.Open(ctx); err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := e.innerExec.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+	e.innerList.Reset()
+	innerIter := chunk.NewIterator4Chunk(e.innerChunk)
+	for {
+		err = Next(ctx, e.innerExec, e.innerChunk)
+		if err != nil {
+			return err
+		}
+		if e.innerChunk.NumRows() == 0 {
+			return nil
+		}
+
+		e.innerSelected, err = expression.VectorizedFilter(e.ctx, e.innerFilter, innerIter, e.innerSelected)
+		if err != nil {
+			return err
+		}
+		for row := innerIter.Begin(); row != innerIter.End(); row = innerIter.Next() {
+			if e.innerSelected[row.Idx()] {
+				e.innerList.AppendRow(row)
+			}
+		}
+	}
+}
+
+// Next implements the Executor interface.
+// For every outer row, the correlated columns are set by the outer row, then
+// the inner plan is re-executed and the outer row is joined with its result.
+func (e *NestedLoopApplyExec) Next(ctx context.Context, req *chunk.Chunk) (err error) {
+	req.Reset()
+	for {
+		if e.innerIter == nil || e.innerIter.Current() == e.innerIter.End() {
+			if e.outerRow != nil && !e.hasMatch {
+				e.joiner.onMissMatch(e.hasNull, *e.outerRow, req)
+			}
+			e.outerRow, err = e.fetchSelectedOuterRow(ctx, req)
+			if e.outerRow == nil || err != nil {
+				return err
+			}
+			e.hasMatch = false
+			e.hasNull = false
+
+			for _, col := range e.outerSchema {
+				*col.Data = e.outerRow.GetDatum(col.Index, col.RetType)
+			}
+			err = e.fetchAllInners(ctx)
+			if err != nil {
+				return err
+			}
+			e.innerIter = chunk.NewIterator4List(e.innerList)
+			e.innerIter.Begin()
+		}
+
+		matched, isNull, err := e.joiner.tryToMatchInners(*e.outerRow, e.innerIter, req)
+		e.hasMatch = e.hasMatch || matched
+		e.hasNull = e.hasNull || isNull
+
+		if err != nil || req.IsFull() {
+			return err
+		}
+	}
+}
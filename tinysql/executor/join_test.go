@@ -18,6 +18,7 @@ import (
 	"time"
 
 	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb/parser/mysql"
 	"github.com/pingcap/tidb/util/testkit"
 )
 
@@ -412,3 +413,119 @@ func (s *testSuiteJoin1) TestInjectProjOnTopN(c *C) {
 		"2",
 	))
 }
+
+func (s *testSuiteJoin2) TestInSubquery(c *C) {
+	tk := testkit.NewTestKit(c, s.store)
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t, s")
+	tk.MustExec("create table t(a int, b int)")
+	tk.MustExec("create table s(a int, b int)")
+	tk.MustExec("insert into t values(1, 1), (2, 2), (3, null), (null, 4)")
+	tk.MustExec("insert into s values(1, 10), (2, null), (null, 30)")
+
+	tk.MustQuery("select a from t where a in (select a from s) order by a").Check(testkit.Rows("1", "2"))
+	tk.MustQuery("select a from t where a = any (select a from s) order by a").Check(testkit.Rows("1", "2"))
+	tk.MustQuery("select a from t where a in ((select a from s)) order by a").Check(testkit.Rows("1", "2"))
+	tk.MustQuery("select a from t where (a, a) in (select a, a from s) order by a").Check(testkit.Rows("1", "2"))
+	// The NULL in the subquery makes `not in` never be true.
+	tk.MustQuery("select a from t where a not in (select a from s)").Check(testkit.Rows())
+	tk.MustQuery("select a from t where a not in (select a from s where a is not null)").Check(testkit.Rows("3"))
+	tk.MustQuery("select a from t where a != all (select a from s where a is not null)").Check(testkit.Rows("3"))
+	tk.MustQuery("select a, a in (select a from s) from t order by a").Check(testkit.Rows(
+		"<nil> <nil>",
+		"1 1",
+		"2 1",
+		"3 <nil>",
+	))
+	tk.MustQuery("select a, a not in (select a from s where a is not null) from t order by a").Check(testkit.Rows(
+		"<nil> <nil>",
+		"1 0",
+		"2 0",
+		"3 1",
+	))
+	tk.MustQuery("select a from t where a in (select a from s where s.b > t.a) order by a").Check(testkit.Rows("1"))
+	tk.MustQuery("select a from t where a in (select a from s where a > 5)").Check(testkit.Rows())
+	tk.MustQuery("select a from t where a not in (select a from s where a > 5) order by a").Check(testkit.Rows("<nil>", "1", "2", "3"))
+
+	tk.MustGetErrCode("select a from t where a in (select a, b from s)", mysql.ErrOperandColumns)
+}
+
+func (s *testSuiteJoin2) TestExistsSubquery(c *C) {
+	tk := testkit.NewTestKit(c, s.store)
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t, s")
+	tk.MustExec("create table t(a int, b int)")
+	tk.MustExec("create table s(a int, b int)")
+	tk.MustExec("insert into t values(1, 1), (2, 2), (3, null), (null, 4)")
+	tk.MustExec("insert into s values(1, 10), (2, null), (null, 30)")
+
+	tk.MustQuery("select a from t where exists (select 1 from s where s.a = t.a) order by a").Check(testkit.Rows("1", "2"))
+	tk.MustQuery("select a from t where not exists (select 1 from s where s.a = t.a) order by a").Check(testkit.Rows("<nil>", "3"))
+	tk.MustQuery("select a from t where exists (select 1 from s where s.b > t.b * 10) order by a").Check(testkit.Rows("1", "2"))
+	tk.MustQuery("select a, exists (select 1 from s where s.a = t.a) from t order by a").Check(testkit.Rows(
+		"<nil> 0",
+		"1 1",
+		"2 1",
+		"3 0",
+	))
+	tk.MustQuery("select count(*) from t where exists (select * from s)").Check(testkit.Rows("4"))
+	tk.MustQuery("select count(*) from t where not exists (select * from s)").Check(testkit.Rows("0"))
+	tk.MustQuery("select count(*) from t where exists (select * from s where a > 5)").Check(testkit.Rows("0"))
+}
+
+func (s *testSuiteJoin2) TestScalarSubquery(c *C) {
+	tk := testkit.NewTestKit(c, s.store)
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t, s")
+	tk.MustExec("create table t(a int, b int)")
+	tk.MustExec("create table s(a int, b int)")
+	tk.MustExec("insert into t values(1, 1), (2, 2), (3, null), (null, 4)")
+	tk.MustExec("insert into s values(1, 10), (2, null), (null, 30)")
+
+	tk.MustQuery("select a from t where a = (select max(a) from s)").Check(testkit.Rows("2"))
+	tk.MustQuery("select (select b from s where a = 1)").Check(testkit.Rows("10"))
+	tk.MustQuery("select (select b from s where a = 5)").Check(testkit.Rows("<nil>"))
+	tk.MustQuery("select a, (select b from s where s.a = t.a) from t order by a").Check(testkit.Rows(
+		"<nil> <nil>",
+		"1 10",
+		"2 <nil>",
+		"3 <nil>",
+	))
+	tk.MustQuery("select a, (select count(*) from s where s.a <= t.a) from t order by a").Check(testkit.Rows(
+		"<nil> 0",
+		"1 1",
+		"2 2",
+		"3 2",
+	))
+	tk.MustQuery("select a from t where b > (select min(s.b) from s where s.a >= t.a) order by a").Check(testkit.Rows())
+	tk.MustQuery("select a from t where (select count(*) from s where s.a < t.a) = 1").Check(testkit.Rows("2"))
+
+	err := tk.QueryToErr("select (select a from s)")
+	c.Assert(err, NotNil)
+	c.Assert(err.Error(), Matches, ".*Subquery returns more than 1 row")
+}
+
+func (s *testSuiteJoin2) TestCompareSubquery(c *C) {
+	tk := testkit.NewTestKit(c, s.store)
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t, s")
+	tk.MustExec("create table t(a int, b int)")
+	tk.MustExec("create table s(a int, b int)")
+	tk.MustExec("insert into t values(1, 1), (2, 2), (3, null), (null, 4)")
+	tk.MustExec("insert into s values(1, 10), (2, null), (null, 30)")
+
+	tk.MustQuery("select a from t where a > any (select a from s) order by a").Check(testkit.Rows("2", "3"))
+	tk.MustQuery("select a from t where a < some (select a from s) order by a").Check(testkit.Rows("1"))
+	tk.MustQuery("select a from t where a >= all (select a from s where a is not null) order by a").Check(testkit.Rows("2", "3"))
+	// The NULL in the subquery makes `all` never be true.
+	tk.MustQuery("select a from t where a >= all (select a from s)").Check(testkit.Rows())
+	// `all` is true for an empty subquery.
+	tk.MustQuery("select count(*) from t where a > all (select a from s where a > 5)").Check(testkit.Rows("4"))
+	tk.MustQuery("select a, a > any (select a from s) from t order by a").Check(testkit.Rows(
+		"<nil> <nil>",
+		"1 <nil>",
+		"2 1",
+		"3 1",
+	))
+	tk.MustQuery("select a from t where a > any (select a from s where s.b < t.b * 20) order by a").Check(testkit.Rows("2"))
+}
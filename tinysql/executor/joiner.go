@@ -22,6 +22,10 @@ import (
 )
 
 var (
+	_ joiner = &semiJoiner{}
+	_ joiner = &antiSemiJoiner{}
+	_ joiner = &leftOuterSemiJoiner{}
+	_ joiner = &antiLeftOuterSemiJoiner{}
 	_ joiner = &leftOuterJoiner{}
 	_ joiner = &rightOuterJoiner{}
 	_ joiner = &innerJoiner{}
@@ -70,12 +74,21 @@ type joiner interface {
 	//
 	// On these conditions, the caller calls this function to handle the
 	// unmatched outer rows according to the current join type:
-	//   1. 'LeftOuterJoin': concats the unmatched outer row with a row of NULLs
+	//   1. 'SemiJoin': ignores the unmatched outer row.
+	//   2. 'AntiSemiJoin': appends the unmatched outer row to the result buffer
+	//      if the join conditions are never evaluated to null.
+	//   3. 'LeftOuterSemiJoin': concats the unmatched outer row with 0 or NULL
+	//      (if the join conditions were evaluated to null) and appends it to
+	//      the result buffer.
+	//   4. 'AntiLeftOuterSemiJoin': concats the unmatched outer row with 1 or
+	//      NULL (if the join conditions were evaluated to null) and appends it
+	//      to the result buffer.
+	//   5. 'LeftOuterJoin': concats the unmatched outer row with a row of NULLs
 	//      and appends it to the result buffer.
-	//   2. 'RightOuterJoin': concats the unmatched outer row with a row of NULLs
+	//   6. 'RightOuterJoin': concats the unmatched outer row with a row of NULLs
 	//      and appends it to the result buffer.
-	//   3. 'InnerJoin': ignores the unmatched outer row.
-	onMissMatch(outer chunk.Row, chk *chunk.Chunk)
+	//   7. 'InnerJoin': ignores the unmatched outer row.
+	onMissMatch(hasNull bool, outer chunk.Row, chk *chunk.Chunk)
 
 	// Clone deep copies a joiner.
 	Clone() joiner
@@ -103,6 +116,18 @@ func newJoiner(ctx sessionctx.Context, joinType plannercore.JoinType,
 		base.initDefaultInner(innerColTypes, defaultInner)
 	}
 	switch joinType {
+	case plannercore.SemiJoin:
+		base.shallowRow = chunk.MutRowFromTypes(colTypes)
+		return &semiJoiner{base}
+	case plannercore.AntiSemiJoin:
+		base.shallowRow = chunk.MutRowFromTypes(colTypes)
+		return &antiSemiJoiner{base}
+	case plannercore.LeftOuterSemiJoin:
+		base.shallowRow = chunk.MutRowFromTypes(colTypes)
+		return &leftOuterSemiJoiner{base}
+	case plannercore.AntiLeftOuterSemiJoin:
+		base.shallowRow = chunk.MutRowFromTypes(colTypes)
+		return &antiLeftOuterSemiJoiner{base}
 	case plannercore.LeftOuterJoin:
 		base.chk = chunk.NewChunkWithCapacity(colTypes, ctx.GetSessionVars().MaxChunkSize)
 		return &leftOuterJoiner{base}
@@ -142,6 +167,43 @@ func (j *baseJoiner) initDefaultInner(innerTypes []*types.FieldType, defaultInne
 	j.defaultInner = mutableRow.ToRow()
 }
 
+// makeShallowJoinRow shallow copies `inner` and `outer` into `shallowRow`.
+func (j *baseJoiner) makeShallowJoinRow(isRightJoin bool, inner, outer chunk.Row) {
+	if !isRightJoin {
+		inner, outer = outer, inner
+	}
+	j.shallowRow.ShallowCopyPartialRow(0, inner)
+	j.shallowRow.ShallowCopyPartialRow(inner.Len(), outer)
+}
+
+// evalOuterRowStatus evaluates the join conditions on each outer row joined
+// with `inner` and records the status of the outer rows. It's used by the
+// semi joiners, which never append the joined rows to the result.
+func (j *baseJoiner) evalOuterRowStatus(outers chunk.Iterator, inner chunk.Row, chk *chunk.Chunk, outerRowStatus []outerRowStatusFlag) ([]outerRowStatusFlag, error) {
+	outerRowStatus = outerRowStatus[:0]
+	outer, numToAppend := outers.Current(), chk.RequiredRows()-chk.NumRows()
+	for cursor := 0; outer != outers.End() && cursor < numToAppend; outer, cursor = outers.Next(), cursor+1 {
+		if len(j.conditions) == 0 {
+			outerRowStatus = append(outerRowStatus, outerRowMatched)
+			continue
+		}
+		j.makeShallowJoinRow(j.outerIsRight, inner, outer)
+		matched, isNull, err := expression.EvalBool(j.ctx, j.conditions, j.shallowRow.ToRow())
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case matched:
+			outerRowStatus = append(outerRowStatus, outerRowMatched)
+		case isNull:
+			outerRowStatus = append(outerRowStatus, outerRowHasNull)
+		default:
+			outerRowStatus = append(outerRowStatus, outerRowUnmatched)
+		}
+	}
+	return outerRowStatus, nil
+}
+
 func (j *baseJoiner) makeJoinRowToChunk(chk *chunk.Chunk, lhs, rhs chunk.Row) {
 	// Call AppendRow() first to increment the virtual rows.
 	// Fix: https://github.com/pingcap/tidb/issues/5771
@@ -209,7 +271,7 @@ func (j *baseJoiner) Clone() baseJoiner {
 	if j.chk != nil {
 		base.chk = j.chk.CopyConstruct()
 	} else {
-		base.shallowRow = chunk.MutRow(j.shallowRow.ToRow())
+		base.shallowRow = chunk.MutRow(j.shallowRow.ToRow().CopyConstruct())
 	}
 	if !j.defaultInner.IsEmpty() {
 		base.defaultInner = j.defaultInner.CopyConstruct()
@@ -217,6 +279,208 @@ func (j *baseJoiner) Clone() baseJoiner {
 	return base
 }
 
+type semiJoiner struct {
+	baseJoiner
+}
+
+// tryToMatchInners implements joiner interface.
+func (j *semiJoiner) tryToMatchInners(outer chunk.Row, inners chunk.Iterator, chk *chunk.Chunk) (matched bool, hasNull bool, err error) {
+	if inners.Len() == 0 {
+		return false, false, nil
+	}
+
+	if len(j.conditions) == 0 {
+		chk.AppendPartialRow(0, outer)
+		inners.ReachEnd()
+		return true, false, nil
+	}
+
+	for inner := inners.Current(); inner != inners.End(); inner = inners.Next() {
+		j.makeShallowJoinRow(j.outerIsRight, inner, outer)
+
+		// For SemiJoin, we can safely treat null result of join conditions as false,
+		// so we ignore the nullness returned by EvalBool here.
+		matched, _, err = expression.EvalBool(j.ctx, j.conditions, j.shallowRow.ToRow())
+		if err != nil {
+			return false, false, err
+		}
+		if matched {
+			chk.AppendPartialRow(0, outer)
+			inners.ReachEnd()
+			return true, false, nil
+		}
+	}
+	return false, false, nil
+}
+
+func (j *semiJoiner) tryToMatchOuters(outers chunk.Iterator, inner chunk.Row, chk *chunk.Chunk, outerRowStatus []outerRowStatusFlag) (_ []outerRowStatusFlag, err error) {
+	return j.evalOuterRowStatus(outers, inner, chk, outerRowStatus)
+}
+
+func (j *semiJoiner) onMissMatch(_ bool, outer chunk.Row, chk *chunk.Chunk) {
+}
+
+func (j *semiJoiner) Clone() joiner {
+	return &semiJoiner{baseJoiner: j.baseJoiner.Clone()}
+}
+
+type antiSemiJoiner struct {
+	baseJoiner
+}
+
+// tryToMatchInners implements joiner interface.
+func (j *antiSemiJoiner) tryToMatchInners(outer chunk.Row, inners chunk.Iterator, chk *chunk.Chunk) (matched bool, hasNull bool, err error) {
+	if inners.Len() == 0 {
+		return false, false, nil
+	}
+
+	// If the join condition was empty, it means the inner table is not empty.
+	if len(j.conditions) == 0 {
+		inners.ReachEnd()
+		return true, false, nil
+	}
+
+	for inner := inners.Current(); inner != inners.End(); inner = inners.Next() {
+		j.makeShallowJoinRow(j.outerIsRight, inner, outer)
+
+		matched, isNull, err := expression.EvalBool(j.ctx, j.conditions, j.shallowRow.ToRow())
+		if err != nil {
+			return false, false, err
+		}
+		if matched {
+			inners.ReachEnd()
+			return true, false, nil
+		}
+		hasNull = hasNull || isNull
+	}
+	return false, hasNull, nil
+}
+
+func (j *antiSemiJoiner) tryToMatchOuters(outers chunk.Iterator, inner chunk.Row, chk *chunk.Chunk, outerRowStatus []outerRowStatusFlag) (_ []outerRowStatusFlag, err error) {
+	return j.evalOuterRowStatus(outers, inner, chk, outerRowStatus)
+}
+
+func (j *antiSemiJoiner) onMissMatch(hasNull bool, outer chunk.Row, chk *chunk.Chunk) {
+	if !hasNull {
+		chk.AppendRow(outer)
+	}
+}
+
+func (j *antiSemiJoiner) Clone() joiner {
+	return &antiSemiJoiner{baseJoiner: j.baseJoiner.Clone()}
+}
+
+type leftOuterSemiJoiner struct {
+	baseJoiner
+}
+
+// tryToMatchInners implements joiner interface.
+func (j *leftOuterSemiJoiner) tryToMatchInners(outer chunk.Row, inners chunk.Iterator, chk *chunk.Chunk) (matched bool, hasNull bool, err error) {
+	if inners.Len() == 0 {
+		return false, false, nil
+	}
+
+	if len(j.conditions) == 0 {
+		j.onMatch(outer, chk)
+		inners.ReachEnd()
+		return true, false, nil
+	}
+
+	for inner := inners.Current(); inner != inners.End(); inner = inners.Next() {
+		j.makeShallowJoinRow(false, inner, outer)
+
+		matched, isNull, err := expression.EvalBool(j.ctx, j.conditions, j.shallowRow.ToRow())
+		if err != nil {
+			return false, false, err
+		}
+		if matched {
+			j.onMatch(outer, chk)
+			inners.ReachEnd()
+			return true, false, nil
+		}
+		hasNull = hasNull || isNull
+	}
+	return false, hasNull, nil
+}
+
+func (j *leftOuterSemiJoiner) tryToMatchOuters(outers chunk.Iterator, inner chunk.Row, chk *chunk.Chunk, outerRowStatus []outerRowStatusFlag) (_ []outerRowStatusFlag, err error) {
+	return j.evalOuterRowStatus(outers, inner, chk, outerRowStatus)
+}
+
+func (j *leftOuterSemiJoiner) onMatch(outer chunk.Row, chk *chunk.Chunk) {
+	chk.AppendPartialRow(0, outer)
+	chk.AppendInt64(outer.Len(), 1)
+}
+
+func (j *leftOuterSemiJoiner) onMissMatch(hasNull bool, outer chunk.Row, chk *chunk.Chunk) {
+	chk.AppendPartialRow(0, outer)
+	if hasNull {
+		chk.AppendNull(outer.Len())
+	} else {
+		chk.AppendInt64(outer.Len(), 0)
+	}
+}
+
+func (j *leftOuterSemiJoiner) Clone() joiner {
+	return &leftOuterSemiJoiner{baseJoiner: j.baseJoiner.Clone()}
+}
+
+type antiLeftOuterSemiJoiner struct {
+	baseJoiner
+}
+
+// tryToMatchInners implements joiner interface.
+func (j *antiLeftOuterSemiJoiner) tryToMatchInners(outer chunk.Row, inners chunk.Iterator, chk *chunk.Chunk) (matched bool, hasNull bool, err error) {
+	if inners.Len() == 0 {
+		return false, false, nil
+	}
+
+	// If the join condition was empty, it means the inner table is not empty.
+	if len(j.conditions) == 0 {
+		j.onMatch(outer, chk)
+		inners.ReachEnd()
+		return true, false, nil
+	}
+
+	for inner := inners.Current(); inner != inners.End(); inner = inners.Next() {
+		j.makeShallowJoinRow(false, inner, outer)
+
+		matched, isNull, err := expression.EvalBool(j.ctx, j.conditions, j.shallowRow.ToRow())
+		if err != nil {
+			return false, false, err
+		}
+		if matched {
+			j.onMatch(outer, chk)
+			inners.ReachEnd()
+			return true, false, nil
+		}
+		hasNull = hasNull || isNull
+	}
+	return false, hasNull, nil
+}
+
+func (j *antiLeftOuterSemiJoiner) tryToMatchOuters(outers chunk.Iterator, inner chunk.Row, chk *chunk.Chunk, outerRowStatus []outerRowStatusFlag) (_ []outerRowStatusFlag, err error) {
+	return j.evalOuterRowStatus(outers, inner, chk, outerRowStatus)
+}
+
+func (j *antiLeftOuterSemiJoiner) onMatch(outer chunk.Row, chk *chunk.Chunk) {
+	chk.AppendPartialRow(0, outer)
+	chk.AppendInt64(outer.Len(), 0)
+}
+
+func (j *antiLeftOuterSemiJoiner) onMissMatch(hasNull bool, outer chunk.Row, chk *chunk.Chunk) {
+	chk.AppendPartialRow(0, outer)
+	if hasNull {
+		chk.AppendNull(outer.Len())
+	} else {
+		chk.AppendInt64(outer.Len(), 1)
+	}
+}
+
+func (j *antiLeftOuterSemiJoiner) Clone() joiner {
+	return &antiLeftOuterSemiJoiner{baseJoiner: j.baseJoiner.Clone()}
+}
+
 type leftOuterJoiner struct {
 	baseJoiner
 }
@@ -271,7 +535,7 @@ func (j *leftOuterJoiner) tryToMatchOuters(outers chunk.Iterator, inner chunk.Ro
 	return j.filterAndCheckOuterRowStatus(chkForJoin, chk, inner.Len(), outerRowStatus)
 }
 
-func (j *leftOuterJoiner) onMissMatch(outer chunk.Row, chk *chunk.Chunk) {
+func (j *leftOuterJoiner) onMissMatch(_ bool, outer chunk.Row, chk *chunk.Chunk) {
 	chk.AppendPartialRow(0, outer)
 	chk.AppendPartialRow(outer.Len(), j.defaultInner)
 }
@@ -334,7 +598,7 @@ func (j *rightOuterJoiner) tryToMatchOuters(outers chunk.Iterator, inner chunk.R
 	return j.filterAndCheckOuterRowStatus(chkForJoin, chk, inner.Len(), outerRowStatus)
 }
 
-func (j *rightOuterJoiner) onMissMatch(outer chunk.Row, chk *chunk.Chunk) {
+func (j *rightOuterJoiner) onMissMatch(_ bool, outer chunk.Row, chk *chunk.Chunk) {
 	chk.AppendPartialRow(0, j.defaultInner)
 	chk.AppendPartialRow(j.defaultInner.Len(), outer)
 }
@@ -402,7 +666,7 @@ func (j *innerJoiner) tryToMatchOuters(outers chunk.Iterator, inner chunk.Row, c
 	return j.filterAndCheckOuterRowStatus(chkForJoin, chk, inner.Len(), outerRowStatus)
 }
 
-func (j *innerJoiner) onMissMatch(outer chunk.Row, chk *chunk.Chunk) {
+func (j *innerJoiner) onMissMatch(_ bool, outer chunk.Row, chk *chunk.Chunk) {
 }
 
 func (j *innerJoiner) Clone() joiner {
@@ -95,12 +95,17 @@ func (e *SimpleExec) executeBegin(ctx context.Context, s *ast.BeginStmt) error {
 	// reverts to its previous state.
 	e.ctx.GetSessionVars().SetStatusFlag(mysql.ServerStatusInTrans, true)
 	// Call ctx.Txn(true) to active pending txn.
-	var err error
 	// Hint: step I.5.1
 	// YOUR CODE HERE (lab4)
-	_, err = e.ctx.Txn(true)
-
-	return err
+	txn, err := e.ctx.Txn(true)
+	if err != nil {
+		return err
+	}
+	if s.Mode == ast.Pessimistic {
+		e.ctx.GetSessionVars().TxnCtx.IsPessimistic = true
+		txn.SetOption(kv.Pessimistic, true)
+	}
+	return nil
 }
 
 func (e *SimpleExec) executeCommit(s *ast.CommitStmt) {
@@ -481,6 +481,72 @@ func (s *testSuite) TestDelete(c *C) {
 	tk.MustExec("commit")
 }
 
+func (s *testSuite) TestUpdate(c *C) {
+	tk := testkit.NewTestKit(c, s.store)
+	s.fillData(tk, "update_test")
+
+	tk.MustExec(`update update_test set name = "abc" where id > 0;`)
+	tk.CheckExecResult(2, 0)
+	tk.MustQuery("select * from update_test").Check(testkit.Rows("1 abc", "2 abc"))
+
+	// Test the row which doesn't change.
+	tk.MustExec(`update update_test set name = "abc" where id = 1;`)
+	tk.CheckExecResult(0, 0)
+
+	// Test update with order by and limit.
+	tk.MustExec(`update update_test set name = "xyz" order by id desc limit 1;`)
+	tk.CheckExecResult(1, 0)
+	tk.MustQuery("select * from update_test").Check(testkit.Rows("1 abc", "2 xyz"))
+
+	// Test the assignments see the values assigned before them.
+	tk.MustExec("drop table if exists t")
+	tk.MustExec("create table t (a int, b int)")
+	tk.MustExec("insert into t values (1, 1), (2, 2)")
+	tk.MustExec("update t set a = a + 10, b = a where a = 1")
+	tk.MustQuery("select * from t").Check(testkit.Rows("11 11", "2 2"))
+	tk.MustExec("update t set a = default where b = 2")
+	tk.MustQuery("select * from t").Check(testkit.Rows("11 11", "<nil> 2"))
+
+	// Test updating the handle, the row is moved.
+	tk.MustExec("drop table if exists t")
+	tk.MustExec("create table t (id int primary key, v int)")
+	tk.MustExec("insert into t values (1, 10), (2, 20)")
+	tk.MustExec("update t set id = id + 10 where id = 1")
+	tk.CheckExecResult(1, 0)
+	tk.MustQuery("select * from t").Check(testkit.Rows("2 20", "11 10"))
+	_, err := tk.Exec("update t set id = 2 where id = 11")
+	c.Assert(err, NotNil)
+	tk.MustQuery("select * from t").Check(testkit.Rows("2 20", "11 10"))
+
+	// Test the indexes are kept consistent.
+	tk.MustExec("drop table if exists t")
+	tk.MustExec("create table t (a int, b int, unique index idx_b(b))")
+	tk.MustExec("insert into t values (1, 1), (2, 2)")
+	tk.MustExec("update t set b = b + 10 where a = 1")
+	tk.MustQuery("select a from t use index(idx_b) where b = 11").Check(testkit.Rows("1"))
+	tk.MustQuery("select a from t use index(idx_b) where b = 1").Check(testkit.Rows())
+	_, err = tk.Exec("update t set b = 2 where a = 1")
+	c.Assert(err, NotNil)
+
+	// Test multi-table update.
+	tk.MustExec("drop table if exists items, month")
+	tk.MustExec("create table items (id int, price int)")
+	tk.MustExec("create table month (id int, price int)")
+	tk.MustExec("insert into items values (1, 10), (2, 20), (3, 30)")
+	tk.MustExec("insert into month values (1, 11), (2, 22), (4, 44)")
+	tk.MustExec("update items, month set items.price = month.price, month.price = month.price + 100 where items.id = month.id")
+	tk.MustQuery("select * from items").Check(testkit.Rows("1 11", "2 22", "3 30"))
+	tk.MustQuery("select * from month").Check(testkit.Rows("1 111", "2 122", "4 44"))
+	tk.MustExec("update items left join month on items.id = month.id set items.price = 0, month.price = 0 where items.id = 3")
+	tk.MustQuery("select * from items").Check(testkit.Rows("1 11", "2 22", "3 0"))
+	tk.MustQuery("select * from month").Check(testkit.Rows("1 111", "2 122", "4 44"))
+
+	_, err = tk.Exec("update items set c = 1")
+	c.Assert(err, NotNil)
+	_, err = tk.Exec("update items, (select 1 as c) t set t.c = 2")
+	c.Assert(err, NotNil)
+}
+
 func (s *testSuite4) TestNotNullDefault(c *C) {
 	tk := testkit.NewTestKit(c, s.store)
 	tk.MustExec("use test; drop table if exists t1,t2;")
@@ -83,6 +83,33 @@ func (s *testAggFuncSuit) TestAvg(c *C) {
 	c.Assert(result.GetInt64(), Equals, int64(67))
 }
 
+func (s *testAggFuncSuit) TestAvgDecimal(c *C) {
+	tp := types.NewFieldType(mysql.TypeNewDecimal)
+	tp.Flen, tp.Decimal = 10, 2
+	col := &expression.Column{
+		Index:   0,
+		RetType: tp,
+	}
+	ctx := mock.NewContext()
+	desc, err := NewAggFuncDesc(s.ctx, ast.AggFuncAvg, []expression.Expression{col})
+	c.Assert(err, IsNil)
+	c.Assert(desc.RetTp.Tp, Equals, mysql.TypeNewDecimal)
+	c.Assert(desc.RetTp.Decimal, Equals, 6)
+	avgFunc := desc.GetAggFunc(ctx)
+	evalCtx := avgFunc.CreateContext(s.ctx.GetSessionVars().StmtCtx)
+
+	for _, str := range []string{"1.10", "2.20", "3.31"} {
+		row := chunk.MutRowFromDatums(types.MakeDatums(types.NewDecFromStringForTest(str))).ToRow()
+		err := avgFunc.Update(evalCtx, s.ctx.GetSessionVars().StmtCtx, row)
+		c.Assert(err, IsNil)
+	}
+	err = avgFunc.Update(evalCtx, s.ctx.GetSessionVars().StmtCtx, s.nullRow)
+	c.Assert(err, IsNil)
+	result := avgFunc.GetResult(evalCtx)
+	c.Assert(result.Kind(), Equals, types.KindMysqlDecimal)
+	c.Assert(result.GetMysqlDecimal().String(), Equals, "2.203333")
+}
+
 func (s *testAggFuncSuit) TestAvgFinalMode(c *C) {
 	rows := make([][]types.Datum, 0, 100)
 	for i := 1; i <= 100; i++ {
@@ -14,6 +14,7 @@
 package aggregation
 
 import (
+	"github.com/pingcap/tidb/parser/terror"
 	"github.com/pingcap/tidb/sessionctx/stmtctx"
 	"github.com/pingcap/tidb/types"
 	"github.com/pingcap/tidb/util/chunk"
@@ -71,6 +72,16 @@ func (af *avgFunction) GetResult(evalCtx *AggEvaluateContext) (d types.Datum) {
 		sum := evalCtx.Value.GetInt64()
 		d.SetInt64(sum / evalCtx.Count)
 		return
+	case types.KindMysqlDecimal:
+		x := evalCtx.Value.GetMysqlDecimal()
+		y := types.NewDecFromInt(evalCtx.Count)
+		to := new(types.MyDecimal)
+		err := types.DecimalDiv(x, y, to, types.DivFracIncr)
+		terror.Log(err)
+		err = to.Round(to, af.RetTp.Decimal, types.ModeHalfUp)
+		terror.Log(err)
+		d.SetMysqlDecimal(to)
+		return
 	}
 	return
 }
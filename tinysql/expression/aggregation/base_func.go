@@ -46,7 +46,16 @@ func newBaseFuncDesc(ctx sessionctx.Context, name string, args []expression.Expr
 	if _, ok := noNeedCastAggFuncs[name]; ok {
 		return b, nil
 	}
-	for _, arg := range args {
+	for i := range b.Args {
+		// The decimal values are summed up exactly, the time values are summed up as numbers,
+		// they are converted by a cast function.
+		if b.RetTp.EvalType() == types.ETDecimal {
+			b.Args[i] = expression.WrapWithCastAsDecimal(ctx, b.Args[i])
+		} else {
+			b.Args[i] = expression.WrapWithCastAsReal(ctx, b.Args[i])
+		}
+	}
+	for _, arg := range b.Args {
 		if arg.GetType().EvalType() != b.RetTp.EvalType() {
 			log.Warn(fmt.Sprintf("unmatched arg tp %v with return tp %v", arg.GetType().EvalType(), b.RetTp.EvalType()))
 		}
@@ -120,6 +129,12 @@ func (a *baseFuncDesc) typeInfer4Sum(ctx sessionctx.Context) {
 	switch a.Args[0].GetType().Tp {
 	case mysql.TypeTiny, mysql.TypeShort, mysql.TypeInt24, mysql.TypeLong, mysql.TypeLonglong:
 		a.RetTp = types.NewFieldType(mysql.TypeLonglong)
+	case mysql.TypeNewDecimal:
+		a.RetTp = types.NewFieldType(mysql.TypeNewDecimal)
+		a.RetTp.Flen, a.RetTp.Decimal = mysql.MaxDecimalWidth, a.Args[0].GetType().Decimal
+		if a.RetTp.Decimal < 0 || a.RetTp.Decimal > mysql.MaxDecimalScale {
+			a.RetTp.Decimal = mysql.MaxDecimalScale
+		}
 	case mysql.TypeDouble, mysql.TypeFloat:
 		a.RetTp = types.NewFieldType(mysql.TypeDouble)
 		a.RetTp.Flen, a.RetTp.Decimal = mysql.MaxRealWidth, a.Args[0].GetType().Decimal
@@ -136,6 +151,17 @@ func (a *baseFuncDesc) typeInfer4Avg(ctx sessionctx.Context) {
 	switch a.Args[0].GetType().Tp {
 	case mysql.TypeTiny, mysql.TypeShort, mysql.TypeInt24, mysql.TypeLong, mysql.TypeLonglong:
 		a.RetTp = types.NewFieldType(mysql.TypeLonglong)
+	case mysql.TypeNewDecimal:
+		a.RetTp = types.NewFieldType(mysql.TypeNewDecimal)
+		a.RetTp.Flen, a.RetTp.Decimal = mysql.MaxDecimalWidth, a.Args[0].GetType().Decimal
+		if a.RetTp.Decimal < 0 {
+			a.RetTp.Decimal = mysql.MaxDecimalScale
+		} else {
+			a.RetTp.Decimal += types.DivFracIncr
+		}
+		if a.RetTp.Decimal > mysql.MaxDecimalScale {
+			a.RetTp.Decimal = mysql.MaxDecimalScale
+		}
 	case mysql.TypeDouble, mysql.TypeFloat:
 		a.RetTp = types.NewFieldType(mysql.TypeDouble)
 		a.RetTp.Flen, a.RetTp.Decimal = mysql.MaxRealWidth, a.Args[0].GetType().Decimal
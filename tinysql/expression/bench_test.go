@@ -73,6 +73,18 @@ func (g *defaultGener) gen() interface{} {
 			return -rand.Float64() * 1000000
 		}
 		return rand.Float64() * 1000000
+	case types.ETDecimal:
+		d := new(types.MyDecimal)
+		var f float64
+		if rand.Float64() < 0.5 {
+			f = rand.Float64() * 100000
+		} else {
+			f = -rand.Float64() * 100000
+		}
+		if err := d.FromFloat64(f); err != nil {
+			panic(err)
+		}
+		return d
 	case types.ETString:
 		return randString()
 	}
@@ -110,6 +122,30 @@ func (g *rangeRealGener) gen() interface{} {
 	return rand.Float64()*(g.end-g.begin) + g.begin
 }
 
+// rangeDecimalGener is used to generate decimal items in [begin, end].
+type rangeDecimalGener struct {
+	begin float64
+	end   float64
+
+	nullRation float64
+}
+
+func (g *rangeDecimalGener) gen() interface{} {
+	if rand.Float64() < g.nullRation {
+		return nil
+	}
+	if g.end < g.begin {
+		g.begin = -100000
+		g.end = 100000
+	}
+	d := new(types.MyDecimal)
+	f := rand.Float64()*(g.end-g.begin) + g.begin
+	if err := d.FromFloat64(f); err != nil {
+		panic(err)
+	}
+	return d
+}
+
 // rangeInt64Gener is used to generate int64 items in [begin, end).
 type rangeInt64Gener struct {
 	begin int
@@ -206,6 +242,8 @@ func fillColumnWithGener(eType types.EvalType, chk *chunk.Chunk, colIdx int, gen
 			col.AppendInt64(v.(int64))
 		case types.ETReal:
 			col.AppendFloat64(v.(float64))
+		case types.ETDecimal:
+			col.AppendMyDecimal(v.(*types.MyDecimal))
 		case types.ETString:
 			col.AppendString(v.(string))
 		}
@@ -234,6 +272,8 @@ func eType2FieldType(eType types.EvalType) *types.FieldType {
 		return types.NewFieldType(mysql.TypeLonglong)
 	case types.ETReal:
 		return types.NewFieldType(mysql.TypeDouble)
+	case types.ETDecimal:
+		return types.NewFieldType(mysql.TypeNewDecimal)
 	case types.ETString:
 		return types.NewFieldType(mysql.TypeVarString)
 	default:
@@ -305,6 +345,13 @@ func testVectorizedEvalOneVec(c *C, vecExprCases vecExprBenchCases) {
 						c.Assert(c1.GetFloat64(i), Equals, c2.GetFloat64(i), commentf(i))
 					}
 				}
+			case types.ETDecimal:
+				for i := 0; i < input.NumRows(); i++ {
+					c.Assert(c1.IsNull(i), Equals, c2.IsNull(i), commentf(i))
+					if !c1.IsNull(i) {
+						c.Assert(c1.GetDecimal(i).Compare(c2.GetDecimal(i)), Equals, 0, commentf(i))
+					}
+				}
 			case types.ETString:
 				for i := 0; i < input.NumRows(); i++ {
 					c.Assert(c1.IsNull(i), Equals, c2.IsNull(i), commentf(i))
@@ -473,6 +520,22 @@ func testVectorizedBuiltinFunc(c *C, vecExprCases vecExprBenchCases) {
 					}
 					i++
 				}
+			case types.ETDecimal:
+				err := baseFunc.vecEvalDecimal(input, output)
+				c.Assert(err, IsNil, Commentf("func: %v, case: %+v", baseFuncName, testCase))
+				// do not forget to call ResizeXXX/ReserveXXX
+				c.Assert(getColumnLen(output, testCase.retEvalType), Equals, input.NumRows())
+				vecWarnCnt = ctx.GetSessionVars().StmtCtx.WarningCount()
+				d64s := output.Decimals()
+				for row := it.Begin(); row != it.End(); row = it.Next() {
+					val, isNull, err := baseFunc.evalDecimal(row)
+					c.Assert(err, IsNil, commentf(i))
+					c.Assert(isNull, Equals, output.IsNull(i), commentf(i))
+					if !isNull {
+						c.Assert(val.Compare(&d64s[i]), Equals, 0, commentf(i))
+					}
+					i++
+				}
 			case types.ETString:
 				err := baseFunc.vecEvalString(input, output)
 				c.Assert(err, IsNil, Commentf("func: %v, case: %+v", baseFuncName, testCase))
@@ -543,6 +606,12 @@ func benchmarkVectorizedBuiltinFunc(b *testing.B, vecExprCases vecExprBenchCases
 							b.Fatal(err)
 						}
 					}
+				case types.ETDecimal:
+					for i := 0; i < b.N; i++ {
+						if err := baseFunc.vecEvalDecimal(input, output); err != nil {
+							b.Fatal(err)
+						}
+					}
 				case types.ETString:
 					for i := 0; i < b.N; i++ {
 						if err := baseFunc.vecEvalString(input, output); err != nil {
@@ -587,6 +656,21 @@ func benchmarkVectorizedBuiltinFunc(b *testing.B, vecExprCases vecExprBenchCases
 							}
 						}
 					}
+				case types.ETDecimal:
+					for i := 0; i < b.N; i++ {
+						output.Reset(testCase.retEvalType)
+						for row := it.Begin(); row != it.End(); row = it.Next() {
+							v, isNull, err := baseFunc.evalDecimal(row)
+							if err != nil {
+								b.Fatal(err)
+							}
+							if isNull {
+								output.AppendNull()
+							} else {
+								output.AppendMyDecimal(v)
+							}
+						}
+					}
 				case types.ETString:
 					for i := 0; i < b.N; i++ {
 						output.Reset(testCase.retEvalType)
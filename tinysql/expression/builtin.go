@@ -94,9 +94,17 @@ func newBaseBuiltinFuncWithTp(ctx sessionctx.Context, args []Expression, retType
 		panic("ctx should not be nil")
 	}
 	for i := range args {
-		if argTps[i] != args[i].GetType().EvalType() {
-			log.Warn(fmt.Sprintf("unmatched arg type %v with %v", argTps[i], args[i].GetType().EvalType()))
+		argTp := args[i].GetType().EvalType()
+		if argTps[i] == argTp {
+			continue
+		}
+		// Time and decimal values are not stored in the same form as the other types,
+		// so the arguments involving them are converted by a cast function.
+		if needCastEvalType(argTps[i]) || needCastEvalType(argTp) {
+			args[i] = wrapWithCastAsType(ctx, args[i], argTps[i])
+			continue
 		}
+		log.Warn(fmt.Sprintf("unmatched arg type %v with %v", argTps[i], argTp))
 	}
 	var fieldType *types.FieldType
 	switch retType {
@@ -120,6 +128,34 @@ func newBaseBuiltinFuncWithTp(ctx sessionctx.Context, args []Expression, retType
 			Flen:    0,
 			Decimal: types.UnspecifiedLength,
 		}
+	case types.ETDecimal:
+		fieldType = &types.FieldType{
+			Tp:      mysql.TypeNewDecimal,
+			Flen:    11,
+			Decimal: 0,
+			Flag:    mysql.BinaryFlag,
+		}
+	case types.ETDatetime:
+		fieldType = &types.FieldType{
+			Tp:      mysql.TypeDatetime,
+			Flen:    mysql.MaxDatetimeWidthWithFsp,
+			Decimal: int(types.MaxFsp),
+			Flag:    mysql.BinaryFlag,
+		}
+	case types.ETTimestamp:
+		fieldType = &types.FieldType{
+			Tp:      mysql.TypeTimestamp,
+			Flen:    mysql.MaxDatetimeWidthWithFsp,
+			Decimal: int(types.MaxFsp),
+			Flag:    mysql.BinaryFlag,
+		}
+	case types.ETDuration:
+		fieldType = &types.FieldType{
+			Tp:      mysql.TypeDuration,
+			Flen:    mysql.MaxDurationWidthWithFsp,
+			Decimal: int(types.MaxFsp),
+			Flag:    mysql.BinaryFlag,
+		}
 	}
 	if mysql.HasBinaryFlag(fieldType.Flag) {
 		fieldType.Charset, fieldType.Collate = charset.CharsetBin, charset.CollationBin
@@ -180,6 +216,18 @@ func (b *baseBuiltinFunc) evalString(row chunk.Row) (string, bool, error) {
 	return "", false, errors.Errorf("baseBuiltinFunc.evalString() should never be called, please contact the TiDB team for help")
 }
 
+func (b *baseBuiltinFunc) evalDecimal(row chunk.Row) (*types.MyDecimal, bool, error) {
+	return nil, false, errors.Errorf("baseBuiltinFunc.evalDecimal() should never be called, please contact the TiDB team for help")
+}
+
+func (b *baseBuiltinFunc) evalTime(row chunk.Row) (types.Time, bool, error) {
+	return types.ZeroTime, true, errors.Errorf("baseBuiltinFunc.evalTime() should never be called, please contact the TiDB team for help")
+}
+
+func (b *baseBuiltinFunc) evalDuration(row chunk.Row) (types.Duration, bool, error) {
+	return types.Duration{}, true, errors.Errorf("baseBuiltinFunc.evalDuration() should never be called, please contact the TiDB team for help")
+}
+
 func (b *baseBuiltinFunc) vectorized() bool {
 	return false
 }
@@ -285,6 +333,12 @@ type builtinFunc interface {
 	evalReal(row chunk.Row) (val float64, isNull bool, err error)
 	// evalString evaluates string representation of builtinFunc by given row.
 	evalString(row chunk.Row) (val string, isNull bool, err error)
+	// evalDecimal evaluates decimal representation of builtinFunc by given row.
+	evalDecimal(row chunk.Row) (val *types.MyDecimal, isNull bool, err error)
+	// evalTime evaluates DATE/DATETIME/TIMESTAMP result of builtinFunc by given row.
+	evalTime(row chunk.Row) (val types.Time, isNull bool, err error)
+	// evalDuration evaluates duration result of builtinFunc by given row.
+	evalDuration(row chunk.Row) (val types.Duration, isNull bool, err error)
 	// getArgs returns the arguments expressions.
 	getArgs() []Expression
 	// equal check if this function equals to another function.
@@ -360,6 +414,20 @@ var funcs = map[string]functionClass{
 	ast.RowFunc:    &rowFunctionClass{baseFunctionClass{ast.RowFunc, 2, -1}},
 	ast.SetVar:     &setVarFunctionClass{baseFunctionClass{ast.SetVar, 2, 2}},
 	ast.GetVar:     &getVarFunctionClass{baseFunctionClass{ast.GetVar, 1, 1}},
+
+	// time functions
+	ast.AddDate:          &addDateFunctionClass{baseFunctionClass{ast.AddDate, 3, 3}},
+	ast.CurrentDate:      &currentDateFunctionClass{baseFunctionClass{ast.CurrentDate, 0, 0}},
+	ast.CurrentTimestamp: &nowFunctionClass{baseFunctionClass{ast.CurrentTimestamp, 0, 1}},
+	ast.Curdate:          &currentDateFunctionClass{baseFunctionClass{ast.Curdate, 0, 0}},
+	ast.DateAdd:          &addDateFunctionClass{baseFunctionClass{ast.DateAdd, 3, 3}},
+	ast.DateDiff:         &dateDiffFunctionClass{baseFunctionClass{ast.DateDiff, 2, 2}},
+	ast.DateSub:          &subDateFunctionClass{baseFunctionClass{ast.DateSub, 3, 3}},
+	ast.Extract:          &extractFunctionClass{baseFunctionClass{ast.Extract, 2, 2}},
+	ast.LocalTime:        &nowFunctionClass{baseFunctionClass{ast.LocalTime, 0, 1}},
+	ast.LocalTimestamp:   &nowFunctionClass{baseFunctionClass{ast.LocalTimestamp, 0, 1}},
+	ast.Now:              &nowFunctionClass{baseFunctionClass{ast.Now, 0, 1}},
+	ast.SubDate:          &subDateFunctionClass{baseFunctionClass{ast.SubDate, 3, 3}},
 }
 
 // IsFunctionSupported check if given function name is a builtin sql function.
@@ -19,6 +19,7 @@ import (
 
 	"github.com/cznic/mathutil"
 	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/parser/terror"
 	"github.com/pingcap/tidb/sessionctx"
 	"github.com/pingcap/tidb/types"
 	"github.com/pingcap/tidb/util/chunk"
@@ -34,11 +35,15 @@ var (
 
 var (
 	_ builtinFunc = &builtinArithmeticPlusRealSig{}
+	_ builtinFunc = &builtinArithmeticPlusDecimalSig{}
 	_ builtinFunc = &builtinArithmeticPlusIntSig{}
 	_ builtinFunc = &builtinArithmeticMinusRealSig{}
+	_ builtinFunc = &builtinArithmeticMinusDecimalSig{}
 	_ builtinFunc = &builtinArithmeticMinusIntSig{}
 	_ builtinFunc = &builtinArithmeticDivideRealSig{}
+	_ builtinFunc = &builtinArithmeticDivideDecimalSig{}
 	_ builtinFunc = &builtinArithmeticMultiplyRealSig{}
+	_ builtinFunc = &builtinArithmeticMultiplyDecimalSig{}
 	_ builtinFunc = &builtinArithmeticMultiplyIntUnsignedSig{}
 	_ builtinFunc = &builtinArithmeticMultiplyIntSig{}
 )
@@ -52,7 +57,7 @@ func numericContextResultType(ft *types.FieldType) types.EvalType {
 	evalTp4Ft := types.ETReal
 	if !ft.Hybrid() {
 		evalTp4Ft = ft.EvalType()
-		if evalTp4Ft != types.ETInt {
+		if evalTp4Ft != types.ETDecimal && evalTp4Ft != types.ETInt {
 			evalTp4Ft = types.ETReal
 		}
 	}
@@ -100,6 +105,28 @@ func setFlenDecimal4RealOrDecimal(retTp, a, b *types.FieldType, isReal bool, isM
 	}
 }
 
+func (c *arithmeticDivideFunctionClass) setType4DivDecimal(retTp, a, b *types.FieldType) {
+	var deca, decb = a.Decimal, b.Decimal
+	if deca == types.UnspecifiedLength {
+		deca = 0
+	}
+	if decb == types.UnspecifiedLength {
+		decb = 0
+	}
+	retTp.Decimal = deca + types.DivFracIncr
+	if retTp.Decimal > mysql.MaxDecimalScale {
+		retTp.Decimal = mysql.MaxDecimalScale
+	}
+	if a.Flen == types.UnspecifiedLength {
+		retTp.Flen = mysql.MaxDecimalWidth
+		return
+	}
+	retTp.Flen = a.Flen + decb + types.DivFracIncr
+	if retTp.Flen > mysql.MaxDecimalWidth {
+		retTp.Flen = mysql.MaxDecimalWidth
+	}
+}
+
 func (c *arithmeticDivideFunctionClass) setType4DivReal(retTp *types.FieldType) {
 	retTp.Decimal = types.UnspecifiedLength
 	retTp.Flen = mysql.MaxRealWidth
@@ -121,6 +148,12 @@ func (c *arithmeticPlusFunctionClass) getFunction(ctx sessionctx.Context, args [
 		sig := &builtinArithmeticPlusRealSig{bf}
 		sig.setPbCode(tipb.ScalarFuncSig_PlusReal)
 		return sig, nil
+	} else if lhsEvalTp == types.ETDecimal || rhsEvalTp == types.ETDecimal {
+		bf := newBaseBuiltinFuncWithTp(ctx, args, types.ETDecimal, types.ETDecimal, types.ETDecimal)
+		setFlenDecimal4RealOrDecimal(bf.tp, args[0].GetType(), args[1].GetType(), false, false)
+		sig := &builtinArithmeticPlusDecimalSig{bf}
+		sig.setPbCode(tipb.ScalarFuncSig_PlusDecimal)
+		return sig, nil
 	}
 	bf := newBaseBuiltinFuncWithTp(ctx, args, types.ETInt, types.ETInt, types.ETInt)
 	if mysql.HasUnsignedFlag(args[0].GetType().Flag) || mysql.HasUnsignedFlag(args[1].GetType().Flag) {
@@ -184,6 +217,36 @@ func (s *builtinArithmeticPlusIntSig) evalInt(row chunk.Row) (val int64, isNull
 	return a + b, false, nil
 }
 
+type builtinArithmeticPlusDecimalSig struct {
+	baseBuiltinFunc
+}
+
+func (s *builtinArithmeticPlusDecimalSig) Clone() builtinFunc {
+	newSig := &builtinArithmeticPlusDecimalSig{}
+	newSig.cloneFrom(&s.baseBuiltinFunc)
+	return newSig
+}
+
+func (s *builtinArithmeticPlusDecimalSig) evalDecimal(row chunk.Row) (*types.MyDecimal, bool, error) {
+	a, isNull, err := s.args[0].EvalDecimal(s.ctx, row)
+	if isNull || err != nil {
+		return nil, isNull, err
+	}
+	b, isNull, err := s.args[1].EvalDecimal(s.ctx, row)
+	if isNull || err != nil {
+		return nil, isNull, err
+	}
+	c := &types.MyDecimal{}
+	err = types.DecimalAdd(a, b, c)
+	if err != nil {
+		if terror.ErrorEqual(err, types.ErrOverflow) {
+			err = types.ErrOverflow.GenWithStackByArgs("DECIMAL", fmt.Sprintf("(%s + %s)", s.args[0].String(), s.args[1].String()))
+		}
+		return nil, true, err
+	}
+	return c, false, nil
+}
+
 type builtinArithmeticPlusRealSig struct {
 	baseBuiltinFunc
 }
@@ -225,6 +288,12 @@ func (c *arithmeticMinusFunctionClass) getFunction(ctx sessionctx.Context, args
 		sig := &builtinArithmeticMinusRealSig{bf}
 		sig.setPbCode(tipb.ScalarFuncSig_MinusReal)
 		return sig, nil
+	} else if lhsEvalTp == types.ETDecimal || rhsEvalTp == types.ETDecimal {
+		bf := newBaseBuiltinFuncWithTp(ctx, args, types.ETDecimal, types.ETDecimal, types.ETDecimal)
+		setFlenDecimal4RealOrDecimal(bf.tp, args[0].GetType(), args[1].GetType(), false, false)
+		sig := &builtinArithmeticMinusDecimalSig{bf}
+		sig.setPbCode(tipb.ScalarFuncSig_MinusDecimal)
+		return sig, nil
 	}
 	bf := newBaseBuiltinFuncWithTp(ctx, args, types.ETInt, types.ETInt, types.ETInt)
 	setFlenDecimal4Int(bf.tp, args[0].GetType(), args[1].GetType())
@@ -261,6 +330,36 @@ func (s *builtinArithmeticMinusRealSig) evalReal(row chunk.Row) (float64, bool,
 	return a - b, false, nil
 }
 
+type builtinArithmeticMinusDecimalSig struct {
+	baseBuiltinFunc
+}
+
+func (s *builtinArithmeticMinusDecimalSig) Clone() builtinFunc {
+	newSig := &builtinArithmeticMinusDecimalSig{}
+	newSig.cloneFrom(&s.baseBuiltinFunc)
+	return newSig
+}
+
+func (s *builtinArithmeticMinusDecimalSig) evalDecimal(row chunk.Row) (*types.MyDecimal, bool, error) {
+	a, isNull, err := s.args[0].EvalDecimal(s.ctx, row)
+	if isNull || err != nil {
+		return nil, isNull, err
+	}
+	b, isNull, err := s.args[1].EvalDecimal(s.ctx, row)
+	if isNull || err != nil {
+		return nil, isNull, err
+	}
+	c := &types.MyDecimal{}
+	err = types.DecimalSub(a, b, c)
+	if err != nil {
+		if terror.ErrorEqual(err, types.ErrOverflow) {
+			err = types.ErrOverflow.GenWithStackByArgs("DECIMAL", fmt.Sprintf("(%s - %s)", s.args[0].String(), s.args[1].String()))
+		}
+		return nil, true, err
+	}
+	return c, false, nil
+}
+
 type builtinArithmeticMinusIntSig struct {
 	baseBuiltinFunc
 }
@@ -339,6 +438,12 @@ func (c *arithmeticMultiplyFunctionClass) getFunction(ctx sessionctx.Context, ar
 		sig := &builtinArithmeticMultiplyRealSig{bf}
 		sig.setPbCode(tipb.ScalarFuncSig_MultiplyReal)
 		return sig, nil
+	} else if lhsEvalTp == types.ETDecimal || rhsEvalTp == types.ETDecimal {
+		bf := newBaseBuiltinFuncWithTp(ctx, args, types.ETDecimal, types.ETDecimal, types.ETDecimal)
+		setFlenDecimal4RealOrDecimal(bf.tp, args[0].GetType(), args[1].GetType(), false, true)
+		sig := &builtinArithmeticMultiplyDecimalSig{bf}
+		sig.setPbCode(tipb.ScalarFuncSig_MultiplyDecimal)
+		return sig, nil
 	}
 	bf := newBaseBuiltinFuncWithTp(ctx, args, types.ETInt, types.ETInt, types.ETInt)
 	if mysql.HasUnsignedFlag(lhsTp.Flag) || mysql.HasUnsignedFlag(rhsTp.Flag) {
@@ -362,6 +467,14 @@ func (s *builtinArithmeticMultiplyRealSig) Clone() builtinFunc {
 	return newSig
 }
 
+type builtinArithmeticMultiplyDecimalSig struct{ baseBuiltinFunc }
+
+func (s *builtinArithmeticMultiplyDecimalSig) Clone() builtinFunc {
+	newSig := &builtinArithmeticMultiplyDecimalSig{}
+	newSig.cloneFrom(&s.baseBuiltinFunc)
+	return newSig
+}
+
 type builtinArithmeticMultiplyIntUnsignedSig struct{ baseBuiltinFunc }
 
 func (s *builtinArithmeticMultiplyIntUnsignedSig) Clone() builtinFunc {
@@ -394,6 +507,26 @@ func (s *builtinArithmeticMultiplyRealSig) evalReal(row chunk.Row) (float64, boo
 	return result, false, nil
 }
 
+func (s *builtinArithmeticMultiplyDecimalSig) evalDecimal(row chunk.Row) (*types.MyDecimal, bool, error) {
+	a, isNull, err := s.args[0].EvalDecimal(s.ctx, row)
+	if isNull || err != nil {
+		return nil, isNull, err
+	}
+	b, isNull, err := s.args[1].EvalDecimal(s.ctx, row)
+	if isNull || err != nil {
+		return nil, isNull, err
+	}
+	c := &types.MyDecimal{}
+	err = types.DecimalMul(a, b, c)
+	if err != nil && !terror.ErrorEqual(err, types.ErrTruncated) {
+		if terror.ErrorEqual(err, types.ErrOverflow) {
+			err = types.ErrOverflow.GenWithStackByArgs("DECIMAL", fmt.Sprintf("(%s * %s)", s.args[0].String(), s.args[1].String()))
+		}
+		return nil, true, err
+	}
+	return c, false, nil
+}
+
 func (s *builtinArithmeticMultiplyIntUnsignedSig) evalInt(row chunk.Row) (val int64, isNull bool, err error) {
 	a, isNull, err := s.args[0].EvalInt(s.ctx, row)
 	if isNull || err != nil {
@@ -436,6 +569,14 @@ func (c *arithmeticDivideFunctionClass) getFunction(ctx sessionctx.Context, args
 	if err := c.verifyArgs(args); err != nil {
 		return nil, err
 	}
+	lhsEvalTp, rhsEvalTp := numericContextResultType(args[0].GetType()), numericContextResultType(args[1].GetType())
+	if lhsEvalTp != types.ETReal && rhsEvalTp != types.ETReal && (lhsEvalTp == types.ETDecimal || rhsEvalTp == types.ETDecimal) {
+		bf := newBaseBuiltinFuncWithTp(ctx, args, types.ETDecimal, types.ETDecimal, types.ETDecimal)
+		c.setType4DivDecimal(bf.tp, args[0].GetType(), args[1].GetType())
+		sig := &builtinArithmeticDivideDecimalSig{bf}
+		sig.setPbCode(tipb.ScalarFuncSig_DivideDecimal)
+		return sig, nil
+	}
 	bf := newBaseBuiltinFuncWithTp(ctx, args, types.ETReal, types.ETReal, types.ETReal)
 	c.setType4DivReal(bf.tp)
 	sig := &builtinArithmeticDivideRealSig{bf}
@@ -469,3 +610,34 @@ func (s *builtinArithmeticDivideRealSig) evalReal(row chunk.Row) (float64, bool,
 	}
 	return result, false, nil
 }
+
+type builtinArithmeticDivideDecimalSig struct{ baseBuiltinFunc }
+
+func (s *builtinArithmeticDivideDecimalSig) Clone() builtinFunc {
+	newSig := &builtinArithmeticDivideDecimalSig{}
+	newSig.cloneFrom(&s.baseBuiltinFunc)
+	return newSig
+}
+
+func (s *builtinArithmeticDivideDecimalSig) evalDecimal(row chunk.Row) (*types.MyDecimal, bool, error) {
+	a, isNull, err := s.args[0].EvalDecimal(s.ctx, row)
+	if isNull || err != nil {
+		return nil, isNull, err
+	}
+	b, isNull, err := s.args[1].EvalDecimal(s.ctx, row)
+	if isNull || err != nil {
+		return nil, isNull, err
+	}
+	c := &types.MyDecimal{}
+	err = types.DecimalDiv(a, b, c, types.DivFracIncr)
+	if terror.ErrorEqual(err, types.ErrDivByZero) {
+		return nil, true, handleDivisionByZeroError(s.ctx)
+	}
+	if err != nil && !terror.ErrorEqual(err, types.ErrTruncated) {
+		if terror.ErrorEqual(err, types.ErrOverflow) {
+			err = types.ErrOverflow.GenWithStackByArgs("DECIMAL", fmt.Sprintf("(%s / %s)", s.args[0].String(), s.args[1].String()))
+		}
+		return nil, true, err
+	}
+	return c, false, nil
+}
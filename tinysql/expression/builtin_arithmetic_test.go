@@ -171,6 +171,21 @@ func (s *testEvaluatorSuite) TestArithmeticPlus(c *C) {
 	c.Assert(err, IsNil)
 	c.Assert(isNull, IsTrue)
 	c.Assert(realResult, Equals, float64(0))
+
+	// case 5
+	args = []interface{}{types.NewDecFromStringForTest("1.01001"), int64(2)}
+
+	bf, err = funcs[ast.Plus].getFunction(s.ctx, s.datumsToConstants(types.MakeDatums(args...)))
+	c.Assert(err, IsNil)
+	c.Assert(bf, NotNil)
+	decimalSig, ok := bf.(*builtinArithmeticPlusDecimalSig)
+	c.Assert(ok, IsTrue)
+	c.Assert(decimalSig, NotNil)
+
+	decimalResult, isNull, err := decimalSig.evalDecimal(chunk.Row{})
+	c.Assert(err, IsNil)
+	c.Assert(isNull, IsFalse)
+	c.Assert(decimalResult.String(), Equals, "3.01001")
 }
 
 func (s *testEvaluatorSuite) TestArithmeticMinus(c *C) {
@@ -248,6 +263,21 @@ func (s *testEvaluatorSuite) TestArithmeticMinus(c *C) {
 	c.Assert(err, IsNil)
 	c.Assert(isNull, IsTrue)
 	c.Assert(realResult, Equals, float64(0))
+
+	// case 6
+	args = []interface{}{types.NewDecFromStringForTest("0.1"), types.NewDecFromStringForTest("0.3")}
+
+	bf, err = funcs[ast.Minus].getFunction(s.ctx, s.datumsToConstants(types.MakeDatums(args...)))
+	c.Assert(err, IsNil)
+	c.Assert(bf, NotNil)
+	decimalSig, ok := bf.(*builtinArithmeticMinusDecimalSig)
+	c.Assert(ok, IsTrue)
+	c.Assert(decimalSig, NotNil)
+
+	decimalResult, isNull, err := decimalSig.evalDecimal(chunk.Row{})
+	c.Assert(err, IsNil)
+	c.Assert(isNull, IsFalse)
+	c.Assert(decimalResult.String(), Equals, "-0.2")
 }
 
 func (s *testEvaluatorSuite) TestArithmeticMultiply(c *C) {
@@ -268,6 +298,14 @@ func (s *testEvaluatorSuite) TestArithmeticMultiply(c *C) {
 			args:   []interface{}{float64(11), float64(11)},
 			expect: float64(121),
 		},
+		{
+			args:   []interface{}{types.NewDecFromStringForTest("1.1"), types.NewDecFromStringForTest("-1.1")},
+			expect: types.NewDecFromStringForTest("-1.21"),
+		},
+		{
+			args:   []interface{}{types.NewDecFromStringForTest("1.5"), int64(3)},
+			expect: types.NewDecFromStringForTest("4.5"),
+		},
 		{
 			args:   []interface{}{nil, float64(-0.11101)},
 			expect: nil,
@@ -291,3 +329,48 @@ func (s *testEvaluatorSuite) TestArithmeticMultiply(c *C) {
 		c.Assert(val, testutil.DatumEquals, types.NewDatum(tc.expect))
 	}
 }
+
+func (s *testEvaluatorSuite) TestArithmeticDivide(c *C) {
+	testCases := []struct {
+		args   []interface{}
+		expect interface{}
+	}{
+		{
+			args:   []interface{}{float64(11.1111111), float64(11.1)},
+			expect: float64(1.001001),
+		},
+		{
+			args:   []interface{}{float64(11.1111111), float64(0)},
+			expect: nil,
+		},
+		{
+			args:   []interface{}{int64(11), int64(11)},
+			expect: float64(1),
+		},
+		{
+			args:   []interface{}{types.NewDecFromStringForTest("1"), int64(3)},
+			expect: types.NewDecFromStringForTest("0.3333"),
+		},
+		{
+			args:   []interface{}{types.NewDecFromStringForTest("2.20"), types.NewDecFromStringForTest("-1.1")},
+			expect: types.NewDecFromStringForTest("-2"),
+		},
+		{
+			args:   []interface{}{types.NewDecFromStringForTest("1.1"), types.NewDecFromStringForTest("0")},
+			expect: nil,
+		},
+		{
+			args:   []interface{}{nil, types.NewDecFromStringForTest("1.1")},
+			expect: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		sig, err := funcs[ast.Div].getFunction(s.ctx, s.datumsToConstants(types.MakeDatums(tc.args...)))
+		c.Assert(err, IsNil)
+		c.Assert(sig, NotNil)
+		val, err := evalBuiltinFunc(sig, chunk.Row{})
+		c.Assert(err, IsNil)
+		c.Assert(val, testutil.DatumEquals, types.NewDatum(tc.expect))
+	}
+}
@@ -0,0 +1,1002 @@
+// Copyright 2017 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// The cast functions are only built internally, they convert the arguments of
+// a built-in function from or to the time and decimal types, whose values are
+// not stored in the same form as the int, real and string values.
+
+package expression
+
+import (
+	"strconv"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/charset"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/parser/terror"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/chunk"
+)
+
+var (
+	_ functionClass = &castAsIntFunctionClass{}
+	_ functionClass = &castAsRealFunctionClass{}
+	_ functionClass = &castAsStringFunctionClass{}
+	_ functionClass = &castAsTimeFunctionClass{}
+	_ functionClass = &castAsDurationFunctionClass{}
+	_ functionClass = &castAsDecimalFunctionClass{}
+)
+
+var (
+	_ builtinFunc = &builtinCastTimeAsIntSig{}
+	_ builtinFunc = &builtinCastDurationAsIntSig{}
+	_ builtinFunc = &builtinCastTimeAsRealSig{}
+	_ builtinFunc = &builtinCastDurationAsRealSig{}
+	_ builtinFunc = &builtinCastTimeAsStringSig{}
+	_ builtinFunc = &builtinCastDurationAsStringSig{}
+
+	_ builtinFunc = &builtinCastIntAsTimeSig{}
+	_ builtinFunc = &builtinCastRealAsTimeSig{}
+	_ builtinFunc = &builtinCastStringAsTimeSig{}
+	_ builtinFunc = &builtinCastTimeAsTimeSig{}
+	_ builtinFunc = &builtinCastDurationAsTimeSig{}
+
+	_ builtinFunc = &builtinCastIntAsDurationSig{}
+	_ builtinFunc = &builtinCastRealAsDurationSig{}
+	_ builtinFunc = &builtinCastStringAsDurationSig{}
+	_ builtinFunc = &builtinCastTimeAsDurationSig{}
+	_ builtinFunc = &builtinCastDurationAsDurationSig{}
+
+	_ builtinFunc = &builtinCastDecimalAsIntSig{}
+	_ builtinFunc = &builtinCastDecimalAsRealSig{}
+	_ builtinFunc = &builtinCastDecimalAsStringSig{}
+	_ builtinFunc = &builtinCastDecimalAsTimeSig{}
+	_ builtinFunc = &builtinCastDecimalAsDurationSig{}
+
+	_ builtinFunc = &builtinCastIntAsDecimalSig{}
+	_ builtinFunc = &builtinCastRealAsDecimalSig{}
+	_ builtinFunc = &builtinCastStringAsDecimalSig{}
+	_ builtinFunc = &builtinCastTimeAsDecimalSig{}
+	_ builtinFunc = &builtinCastDurationAsDecimalSig{}
+	_ builtinFunc = &builtinCastDecimalAsDecimalSig{}
+)
+
+// isTemporalEvalType checks whether the values of the EvalType are types.Time or types.Duration.
+func isTemporalEvalType(tp types.EvalType) bool {
+	return tp == types.ETDatetime || tp == types.ETTimestamp || tp == types.ETDuration
+}
+
+// needCastEvalType checks whether the values of the EvalType have to be converted by
+// a cast function before they are evaluated as another EvalType.
+func needCastEvalType(tp types.EvalType) bool {
+	return isTemporalEvalType(tp) || tp == types.ETDecimal
+}
+
+type castAsIntFunctionClass struct {
+	baseFunctionClass
+
+	tp *types.FieldType
+}
+
+func (c *castAsIntFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (sig builtinFunc, err error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	bf := newBaseBuiltinFunc(ctx, args)
+	bf.tp = c.tp
+	switch argTp := args[0].GetType().EvalType(); argTp {
+	case types.ETDatetime, types.ETTimestamp:
+		sig = &builtinCastTimeAsIntSig{bf}
+	case types.ETDuration:
+		sig = &builtinCastDurationAsIntSig{bf}
+	case types.ETDecimal:
+		sig = &builtinCastDecimalAsIntSig{bf}
+	default:
+		return nil, errors.Errorf("unsupported cast from %v to int", argTp)
+	}
+	return sig, nil
+}
+
+type castAsRealFunctionClass struct {
+	baseFunctionClass
+
+	tp *types.FieldType
+}
+
+func (c *castAsRealFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (sig builtinFunc, err error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	bf := newBaseBuiltinFunc(ctx, args)
+	bf.tp = c.tp
+	switch argTp := args[0].GetType().EvalType(); argTp {
+	case types.ETDatetime, types.ETTimestamp:
+		sig = &builtinCastTimeAsRealSig{bf}
+	case types.ETDuration:
+		sig = &builtinCastDurationAsRealSig{bf}
+	case types.ETDecimal:
+		sig = &builtinCastDecimalAsRealSig{bf}
+	default:
+		return nil, errors.Errorf("unsupported cast from %v to real", argTp)
+	}
+	return sig, nil
+}
+
+type castAsStringFunctionClass struct {
+	baseFunctionClass
+
+	tp *types.FieldType
+}
+
+func (c *castAsStringFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (sig builtinFunc, err error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	bf := newBaseBuiltinFunc(ctx, args)
+	bf.tp = c.tp
+	switch argTp := args[0].GetType().EvalType(); argTp {
+	case types.ETDatetime, types.ETTimestamp:
+		sig = &builtinCastTimeAsStringSig{bf}
+	case types.ETDuration:
+		sig = &builtinCastDurationAsStringSig{bf}
+	case types.ETDecimal:
+		sig = &builtinCastDecimalAsStringSig{bf}
+	default:
+		return nil, errors.Errorf("unsupported cast from %v to string", argTp)
+	}
+	return sig, nil
+}
+
+type castAsTimeFunctionClass struct {
+	baseFunctionClass
+
+	tp *types.FieldType
+}
+
+func (c *castAsTimeFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (sig builtinFunc, err error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	bf := newBaseBuiltinFunc(ctx, args)
+	bf.tp = c.tp
+	switch argTp := args[0].GetType().EvalType(); argTp {
+	case types.ETInt:
+		sig = &builtinCastIntAsTimeSig{bf}
+	case types.ETReal:
+		sig = &builtinCastRealAsTimeSig{bf}
+	case types.ETString:
+		sig = &builtinCastStringAsTimeSig{bf}
+	case types.ETDatetime, types.ETTimestamp:
+		sig = &builtinCastTimeAsTimeSig{bf}
+	case types.ETDuration:
+		sig = &builtinCastDurationAsTimeSig{bf}
+	case types.ETDecimal:
+		sig = &builtinCastDecimalAsTimeSig{bf}
+	default:
+		return nil, errors.Errorf("unsupported cast from %v to time", argTp)
+	}
+	return sig, nil
+}
+
+type castAsDurationFunctionClass struct {
+	baseFunctionClass
+
+	tp *types.FieldType
+}
+
+func (c *castAsDurationFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (sig builtinFunc, err error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	bf := newBaseBuiltinFunc(ctx, args)
+	bf.tp = c.tp
+	switch argTp := args[0].GetType().EvalType(); argTp {
+	case types.ETInt:
+		sig = &builtinCastIntAsDurationSig{bf}
+	case types.ETReal:
+		sig = &builtinCastRealAsDurationSig{bf}
+	case types.ETString:
+		sig = &builtinCastStringAsDurationSig{bf}
+	case types.ETDatetime, types.ETTimestamp:
+		sig = &builtinCastTimeAsDurationSig{bf}
+	case types.ETDuration:
+		sig = &builtinCastDurationAsDurationSig{bf}
+	case types.ETDecimal:
+		sig = &builtinCastDecimalAsDurationSig{bf}
+	default:
+		return nil, errors.Errorf("unsupported cast from %v to duration", argTp)
+	}
+	return sig, nil
+}
+
+type castAsDecimalFunctionClass struct {
+	baseFunctionClass
+
+	tp *types.FieldType
+}
+
+func (c *castAsDecimalFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (sig builtinFunc, err error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	bf := newBaseBuiltinFunc(ctx, args)
+	bf.tp = c.tp
+	switch argTp := args[0].GetType().EvalType(); argTp {
+	case types.ETInt:
+		sig = &builtinCastIntAsDecimalSig{bf}
+	case types.ETReal:
+		sig = &builtinCastRealAsDecimalSig{bf}
+	case types.ETString:
+		sig = &builtinCastStringAsDecimalSig{bf}
+	case types.ETDatetime, types.ETTimestamp:
+		sig = &builtinCastTimeAsDecimalSig{bf}
+	case types.ETDuration:
+		sig = &builtinCastDurationAsDecimalSig{bf}
+	case types.ETDecimal:
+		sig = &builtinCastDecimalAsDecimalSig{bf}
+	default:
+		return nil, errors.Errorf("unsupported cast from %v to decimal", argTp)
+	}
+	return sig, nil
+}
+
+type builtinCastTimeAsIntSig struct {
+	baseBuiltinFunc
+}
+
+func (b *builtinCastTimeAsIntSig) Clone() builtinFunc {
+	newSig := &builtinCastTimeAsIntSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+func (b *builtinCastTimeAsIntSig) evalInt(row chunk.Row) (int64, bool, error) {
+	val, isNull, err := b.args[0].EvalTime(b.ctx, row)
+	if isNull || err != nil {
+		return 0, isNull, err
+	}
+	t, err := val.RoundFrac(b.ctx.GetSessionVars().StmtCtx, types.DefaultFsp)
+	if err != nil {
+		return 0, false, err
+	}
+	return t.ToInt64(), false, nil
+}
+
+type builtinCastDurationAsIntSig struct {
+	baseBuiltinFunc
+}
+
+func (b *builtinCastDurationAsIntSig) Clone() builtinFunc {
+	newSig := &builtinCastDurationAsIntSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+func (b *builtinCastDurationAsIntSig) evalInt(row chunk.Row) (int64, bool, error) {
+	val, isNull, err := b.args[0].EvalDuration(b.ctx, row)
+	if isNull || err != nil {
+		return 0, isNull, err
+	}
+	dur, err := val.RoundFrac(types.DefaultFsp)
+	if err != nil {
+		return 0, false, err
+	}
+	return dur.ToInt64(), false, nil
+}
+
+type builtinCastTimeAsRealSig struct {
+	baseBuiltinFunc
+}
+
+func (b *builtinCastTimeAsRealSig) Clone() builtinFunc {
+	newSig := &builtinCastTimeAsRealSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+func (b *builtinCastTimeAsRealSig) evalReal(row chunk.Row) (float64, bool, error) {
+	val, isNull, err := b.args[0].EvalTime(b.ctx, row)
+	if isNull || err != nil {
+		return 0, isNull, err
+	}
+	return val.ToFloat64(), false, nil
+}
+
+type builtinCastDurationAsRealSig struct {
+	baseBuiltinFunc
+}
+
+func (b *builtinCastDurationAsRealSig) Clone() builtinFunc {
+	newSig := &builtinCastDurationAsRealSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+func (b *builtinCastDurationAsRealSig) evalReal(row chunk.Row) (float64, bool, error) {
+	val, isNull, err := b.args[0].EvalDuration(b.ctx, row)
+	if isNull || err != nil {
+		return 0, isNull, err
+	}
+	return val.ToFloat64(), false, nil
+}
+
+type builtinCastTimeAsStringSig struct {
+	baseBuiltinFunc
+}
+
+func (b *builtinCastTimeAsStringSig) Clone() builtinFunc {
+	newSig := &builtinCastTimeAsStringSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+func (b *builtinCastTimeAsStringSig) evalString(row chunk.Row) (string, bool, error) {
+	val, isNull, err := b.args[0].EvalTime(b.ctx, row)
+	if isNull || err != nil {
+		return "", isNull, err
+	}
+	return val.String(), false, nil
+}
+
+type builtinCastDurationAsStringSig struct {
+	baseBuiltinFunc
+}
+
+func (b *builtinCastDurationAsStringSig) Clone() builtinFunc {
+	newSig := &builtinCastDurationAsStringSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+func (b *builtinCastDurationAsStringSig) evalString(row chunk.Row) (string, bool, error) {
+	val, isNull, err := b.args[0].EvalDuration(b.ctx, row)
+	if isNull || err != nil {
+		return "", isNull, err
+	}
+	return val.String(), false, nil
+}
+
+type builtinCastIntAsTimeSig struct {
+	baseBuiltinFunc
+}
+
+func (b *builtinCastIntAsTimeSig) Clone() builtinFunc {
+	newSig := &builtinCastIntAsTimeSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+func (b *builtinCastIntAsTimeSig) evalTime(row chunk.Row) (types.Time, bool, error) {
+	val, isNull, err := b.args[0].EvalInt(b.ctx, row)
+	if isNull || err != nil {
+		return types.ZeroTime, isNull, err
+	}
+	res, err := types.ParseTimeFromNum(b.ctx.GetSessionVars().StmtCtx, val, b.tp.Tp, int8(b.tp.Decimal))
+	if err != nil {
+		return types.ZeroTime, true, handleInvalidTimeError(b.ctx, err)
+	}
+	return res, false, nil
+}
+
+type builtinCastRealAsTimeSig struct {
+	baseBuiltinFunc
+}
+
+func (b *builtinCastRealAsTimeSig) Clone() builtinFunc {
+	newSig := &builtinCastRealAsTimeSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+func (b *builtinCastRealAsTimeSig) evalTime(row chunk.Row) (types.Time, bool, error) {
+	val, isNull, err := b.args[0].EvalReal(b.ctx, row)
+	if isNull || err != nil {
+		return types.ZeroTime, isNull, err
+	}
+	fv := strconv.FormatFloat(val, 'f', -1, 64)
+	res, err := types.ParseTimeFromFloatString(b.ctx.GetSessionVars().StmtCtx, fv, b.tp.Tp, int8(b.tp.Decimal))
+	if err != nil {
+		return types.ZeroTime, true, handleInvalidTimeError(b.ctx, err)
+	}
+	return res, false, nil
+}
+
+type builtinCastStringAsTimeSig struct {
+	baseBuiltinFunc
+}
+
+func (b *builtinCastStringAsTimeSig) Clone() builtinFunc {
+	newSig := &builtinCastStringAsTimeSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+func (b *builtinCastStringAsTimeSig) evalTime(row chunk.Row) (types.Time, bool, error) {
+	val, isNull, err := b.args[0].EvalString(b.ctx, row)
+	if isNull || err != nil {
+		return types.ZeroTime, isNull, err
+	}
+	res, err := types.ParseTime(b.ctx.GetSessionVars().StmtCtx, val, b.tp.Tp, int8(b.tp.Decimal))
+	if err != nil {
+		return types.ZeroTime, true, handleInvalidTimeError(b.ctx, err)
+	}
+	return res, false, nil
+}
+
+type builtinCastTimeAsTimeSig struct {
+	baseBuiltinFunc
+}
+
+func (b *builtinCastTimeAsTimeSig) Clone() builtinFunc {
+	newSig := &builtinCastTimeAsTimeSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+func (b *builtinCastTimeAsTimeSig) evalTime(row chunk.Row) (types.Time, bool, error) {
+	val, isNull, err := b.args[0].EvalTime(b.ctx, row)
+	if isNull || err != nil {
+		return types.ZeroTime, isNull, err
+	}
+	sc := b.ctx.GetSessionVars().StmtCtx
+	res, err := val.Convert(sc, b.tp.Tp)
+	if err != nil {
+		return types.ZeroTime, true, handleInvalidTimeError(b.ctx, err)
+	}
+	res, err = res.RoundFrac(sc, int8(b.tp.Decimal))
+	if err != nil {
+		return types.ZeroTime, true, handleInvalidTimeError(b.ctx, err)
+	}
+	return res, false, nil
+}
+
+type builtinCastDurationAsTimeSig struct {
+	baseBuiltinFunc
+}
+
+func (b *builtinCastDurationAsTimeSig) Clone() builtinFunc {
+	newSig := &builtinCastDurationAsTimeSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+func (b *builtinCastDurationAsTimeSig) evalTime(row chunk.Row) (types.Time, bool, error) {
+	val, isNull, err := b.args[0].EvalDuration(b.ctx, row)
+	if isNull || err != nil {
+		return types.ZeroTime, isNull, err
+	}
+	sc := b.ctx.GetSessionVars().StmtCtx
+	res, err := val.ConvertToTime(sc, b.tp.Tp)
+	if err != nil {
+		return types.ZeroTime, true, handleInvalidTimeError(b.ctx, err)
+	}
+	res, err = res.RoundFrac(sc, int8(b.tp.Decimal))
+	if err != nil {
+		return types.ZeroTime, true, handleInvalidTimeError(b.ctx, err)
+	}
+	return res, false, nil
+}
+
+type builtinCastIntAsDurationSig struct {
+	baseBuiltinFunc
+}
+
+func (b *builtinCastIntAsDurationSig) Clone() builtinFunc {
+	newSig := &builtinCastIntAsDurationSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+func (b *builtinCastIntAsDurationSig) evalDuration(row chunk.Row) (types.Duration, bool, error) {
+	val, isNull, err := b.args[0].EvalInt(b.ctx, row)
+	if isNull || err != nil {
+		return types.Duration{}, isNull, err
+	}
+	res, err := types.NumberToDuration(val, int8(b.tp.Decimal))
+	if err != nil {
+		return types.Duration{}, true, handleInvalidTimeError(b.ctx, err)
+	}
+	return res, false, nil
+}
+
+type builtinCastRealAsDurationSig struct {
+	baseBuiltinFunc
+}
+
+func (b *builtinCastRealAsDurationSig) Clone() builtinFunc {
+	newSig := &builtinCastRealAsDurationSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+func (b *builtinCastRealAsDurationSig) evalDuration(row chunk.Row) (types.Duration, bool, error) {
+	val, isNull, err := b.args[0].EvalReal(b.ctx, row)
+	if isNull || err != nil {
+		return types.Duration{}, isNull, err
+	}
+	res, err := types.ParseDuration(b.ctx.GetSessionVars().StmtCtx, strconv.FormatFloat(val, 'f', -1, 64), int8(b.tp.Decimal))
+	if err != nil {
+		return types.Duration{}, true, handleInvalidTimeError(b.ctx, err)
+	}
+	return res, false, nil
+}
+
+type builtinCastStringAsDurationSig struct {
+	baseBuiltinFunc
+}
+
+func (b *builtinCastStringAsDurationSig) Clone() builtinFunc {
+	newSig := &builtinCastStringAsDurationSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+func (b *builtinCastStringAsDurationSig) evalDuration(row chunk.Row) (types.Duration, bool, error) {
+	val, isNull, err := b.args[0].EvalString(b.ctx, row)
+	if isNull || err != nil {
+		return types.Duration{}, isNull, err
+	}
+	res, err := types.ParseDuration(b.ctx.GetSessionVars().StmtCtx, val, int8(b.tp.Decimal))
+	if err != nil {
+		return types.Duration{}, true, handleInvalidTimeError(b.ctx, err)
+	}
+	return res, false, nil
+}
+
+type builtinCastTimeAsDurationSig struct {
+	baseBuiltinFunc
+}
+
+func (b *builtinCastTimeAsDurationSig) Clone() builtinFunc {
+	newSig := &builtinCastTimeAsDurationSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+func (b *builtinCastTimeAsDurationSig) evalDuration(row chunk.Row) (types.Duration, bool, error) {
+	val, isNull, err := b.args[0].EvalTime(b.ctx, row)
+	if isNull || err != nil {
+		return types.Duration{}, isNull, err
+	}
+	res, err := val.ConvertToDuration()
+	if err != nil {
+		return types.Duration{}, true, handleInvalidTimeError(b.ctx, err)
+	}
+	res, err = res.RoundFrac(int8(b.tp.Decimal))
+	return res, false, err
+}
+
+type builtinCastDurationAsDurationSig struct {
+	baseBuiltinFunc
+}
+
+func (b *builtinCastDurationAsDurationSig) Clone() builtinFunc {
+	newSig := &builtinCastDurationAsDurationSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+func (b *builtinCastDurationAsDurationSig) evalDuration(row chunk.Row) (types.Duration, bool, error) {
+	val, isNull, err := b.args[0].EvalDuration(b.ctx, row)
+	if isNull || err != nil {
+		return types.Duration{}, isNull, err
+	}
+	res, err := val.RoundFrac(int8(b.tp.Decimal))
+	return res, false, err
+}
+
+type builtinCastDecimalAsIntSig struct {
+	baseBuiltinFunc
+}
+
+func (b *builtinCastDecimalAsIntSig) Clone() builtinFunc {
+	newSig := &builtinCastDecimalAsIntSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+func (b *builtinCastDecimalAsIntSig) evalInt(row chunk.Row) (res int64, isNull bool, err error) {
+	val, isNull, err := b.args[0].EvalDecimal(b.ctx, row)
+	if isNull || err != nil {
+		return 0, isNull, err
+	}
+	// Round is needed for both unsigned and signed.
+	var to types.MyDecimal
+	err = val.Round(&to, 0, types.ModeHalfUp)
+	if err != nil {
+		return 0, true, err
+	}
+	if !mysql.HasUnsignedFlag(b.tp.Flag) {
+		res, err = to.ToInt()
+	} else if val.IsNegative() {
+		res, err = 0, types.ErrOverflow
+	} else {
+		var uintRes uint64
+		uintRes, err = to.ToUint()
+		res = int64(uintRes)
+	}
+	if types.ErrOverflow.Equal(err) {
+		warnErr := types.ErrTruncatedWrongVal.GenWithStackByArgs("DECIMAL", val)
+		err = b.ctx.GetSessionVars().StmtCtx.HandleOverflow(err, warnErr)
+	}
+	return res, false, err
+}
+
+type builtinCastDecimalAsRealSig struct {
+	baseBuiltinFunc
+}
+
+func (b *builtinCastDecimalAsRealSig) Clone() builtinFunc {
+	newSig := &builtinCastDecimalAsRealSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+func (b *builtinCastDecimalAsRealSig) evalReal(row chunk.Row) (float64, bool, error) {
+	val, isNull, err := b.args[0].EvalDecimal(b.ctx, row)
+	if isNull || err != nil {
+		return 0, isNull, err
+	}
+	res, err := val.ToFloat64()
+	return res, false, err
+}
+
+type builtinCastDecimalAsStringSig struct {
+	baseBuiltinFunc
+}
+
+func (b *builtinCastDecimalAsStringSig) Clone() builtinFunc {
+	newSig := &builtinCastDecimalAsStringSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+func (b *builtinCastDecimalAsStringSig) evalString(row chunk.Row) (string, bool, error) {
+	val, isNull, err := b.args[0].EvalDecimal(b.ctx, row)
+	if isNull || err != nil {
+		return "", isNull, err
+	}
+	return string(val.ToString()), false, nil
+}
+
+type builtinCastDecimalAsTimeSig struct {
+	baseBuiltinFunc
+}
+
+func (b *builtinCastDecimalAsTimeSig) Clone() builtinFunc {
+	newSig := &builtinCastDecimalAsTimeSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+func (b *builtinCastDecimalAsTimeSig) evalTime(row chunk.Row) (types.Time, bool, error) {
+	val, isNull, err := b.args[0].EvalDecimal(b.ctx, row)
+	if isNull || err != nil {
+		return types.ZeroTime, isNull, err
+	}
+	res, err := types.ParseTimeFromFloatString(b.ctx.GetSessionVars().StmtCtx, string(val.ToString()), b.tp.Tp, int8(b.tp.Decimal))
+	if err != nil {
+		return types.ZeroTime, true, handleInvalidTimeError(b.ctx, err)
+	}
+	return res, false, nil
+}
+
+type builtinCastDecimalAsDurationSig struct {
+	baseBuiltinFunc
+}
+
+func (b *builtinCastDecimalAsDurationSig) Clone() builtinFunc {
+	newSig := &builtinCastDecimalAsDurationSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+func (b *builtinCastDecimalAsDurationSig) evalDuration(row chunk.Row) (types.Duration, bool, error) {
+	val, isNull, err := b.args[0].EvalDecimal(b.ctx, row)
+	if isNull || err != nil {
+		return types.Duration{}, isNull, err
+	}
+	res, err := types.ParseDuration(b.ctx.GetSessionVars().StmtCtx, string(val.ToString()), int8(b.tp.Decimal))
+	if err != nil {
+		return types.Duration{}, true, handleInvalidTimeError(b.ctx, err)
+	}
+	return res, false, nil
+}
+
+type builtinCastIntAsDecimalSig struct {
+	baseBuiltinFunc
+}
+
+func (b *builtinCastIntAsDecimalSig) Clone() builtinFunc {
+	newSig := &builtinCastIntAsDecimalSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+func (b *builtinCastIntAsDecimalSig) evalDecimal(row chunk.Row) (*types.MyDecimal, bool, error) {
+	val, isNull, err := b.args[0].EvalInt(b.ctx, row)
+	if isNull || err != nil {
+		return nil, isNull, err
+	}
+	var res *types.MyDecimal
+	if mysql.HasUnsignedFlag(b.args[0].GetType().Flag) {
+		res = types.NewDecFromUint(uint64(val))
+	} else {
+		res = types.NewDecFromInt(val)
+	}
+	res, err = types.ProduceDecimalWithSpecifiedTp(res, b.tp.Flen, b.tp.Decimal, b.ctx.GetSessionVars().StmtCtx)
+	return res, err != nil, err
+}
+
+type builtinCastRealAsDecimalSig struct {
+	baseBuiltinFunc
+}
+
+func (b *builtinCastRealAsDecimalSig) Clone() builtinFunc {
+	newSig := &builtinCastRealAsDecimalSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+func (b *builtinCastRealAsDecimalSig) evalDecimal(row chunk.Row) (*types.MyDecimal, bool, error) {
+	val, isNull, err := b.args[0].EvalReal(b.ctx, row)
+	if isNull || err != nil {
+		return nil, isNull, err
+	}
+	res := new(types.MyDecimal)
+	if err = res.FromFloat64(val); err != nil {
+		return nil, true, err
+	}
+	res, err = types.ProduceDecimalWithSpecifiedTp(res, b.tp.Flen, b.tp.Decimal, b.ctx.GetSessionVars().StmtCtx)
+	return res, err != nil, err
+}
+
+type builtinCastStringAsDecimalSig struct {
+	baseBuiltinFunc
+}
+
+func (b *builtinCastStringAsDecimalSig) Clone() builtinFunc {
+	newSig := &builtinCastStringAsDecimalSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+func (b *builtinCastStringAsDecimalSig) evalDecimal(row chunk.Row) (*types.MyDecimal, bool, error) {
+	val, isNull, err := b.args[0].EvalString(b.ctx, row)
+	if isNull || err != nil {
+		return nil, isNull, err
+	}
+	sc := b.ctx.GetSessionVars().StmtCtx
+	res := new(types.MyDecimal)
+	if err = sc.HandleTruncate(res.FromString([]byte(val))); err != nil {
+		return nil, true, err
+	}
+	res, err = types.ProduceDecimalWithSpecifiedTp(res, b.tp.Flen, b.tp.Decimal, sc)
+	return res, err != nil, err
+}
+
+type builtinCastTimeAsDecimalSig struct {
+	baseBuiltinFunc
+}
+
+func (b *builtinCastTimeAsDecimalSig) Clone() builtinFunc {
+	newSig := &builtinCastTimeAsDecimalSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+func (b *builtinCastTimeAsDecimalSig) evalDecimal(row chunk.Row) (*types.MyDecimal, bool, error) {
+	val, isNull, err := b.args[0].EvalTime(b.ctx, row)
+	if isNull || err != nil {
+		return nil, isNull, err
+	}
+	res, err := types.ProduceDecimalWithSpecifiedTp(val.ToNumber(), b.tp.Flen, b.tp.Decimal, b.ctx.GetSessionVars().StmtCtx)
+	return res, err != nil, err
+}
+
+type builtinCastDurationAsDecimalSig struct {
+	baseBuiltinFunc
+}
+
+func (b *builtinCastDurationAsDecimalSig) Clone() builtinFunc {
+	newSig := &builtinCastDurationAsDecimalSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+func (b *builtinCastDurationAsDecimalSig) evalDecimal(row chunk.Row) (*types.MyDecimal, bool, error) {
+	val, isNull, err := b.args[0].EvalDuration(b.ctx, row)
+	if isNull || err != nil {
+		return nil, isNull, err
+	}
+	res, err := types.ProduceDecimalWithSpecifiedTp(val.ToNumber(), b.tp.Flen, b.tp.Decimal, b.ctx.GetSessionVars().StmtCtx)
+	return res, err != nil, err
+}
+
+type builtinCastDecimalAsDecimalSig struct {
+	baseBuiltinFunc
+}
+
+func (b *builtinCastDecimalAsDecimalSig) Clone() builtinFunc {
+	newSig := &builtinCastDecimalAsDecimalSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+func (b *builtinCastDecimalAsDecimalSig) evalDecimal(row chunk.Row) (*types.MyDecimal, bool, error) {
+	val, isNull, err := b.args[0].EvalDecimal(b.ctx, row)
+	if isNull || err != nil {
+		return nil, isNull, err
+	}
+	// The value may point into the input chunk, so it is copied before rounding.
+	res := new(types.MyDecimal)
+	*res = *val
+	res, err = types.ProduceDecimalWithSpecifiedTp(res, b.tp.Flen, b.tp.Decimal, b.ctx.GetSessionVars().StmtCtx)
+	return res, err != nil, err
+}
+
+// BuildCastFunction builds a CAST ScalarFunction from the Expression.
+func BuildCastFunction(ctx sessionctx.Context, expr Expression, tp *types.FieldType) (res Expression) {
+	var fc functionClass
+	switch tp.EvalType() {
+	case types.ETInt:
+		fc = &castAsIntFunctionClass{baseFunctionClass{ast.Cast, 1, 1}, tp}
+	case types.ETReal:
+		fc = &castAsRealFunctionClass{baseFunctionClass{ast.Cast, 1, 1}, tp}
+	case types.ETDatetime, types.ETTimestamp:
+		fc = &castAsTimeFunctionClass{baseFunctionClass{ast.Cast, 1, 1}, tp}
+	case types.ETDuration:
+		fc = &castAsDurationFunctionClass{baseFunctionClass{ast.Cast, 1, 1}, tp}
+	case types.ETDecimal:
+		fc = &castAsDecimalFunctionClass{baseFunctionClass{ast.Cast, 1, 1}, tp}
+	default:
+		fc = &castAsStringFunctionClass{baseFunctionClass{ast.Cast, 1, 1}, tp}
+	}
+	f, err := fc.getFunction(ctx, []Expression{expr})
+	if err != nil {
+		terror.Log(err)
+		return expr
+	}
+	res = &ScalarFunction{
+		FuncName: model.NewCIStr(ast.Cast),
+		RetType:  tp,
+		Function: f,
+	}
+	return FoldConstant(res)
+}
+
+// wrapWithCastAsType wraps `expr` with a cast function which converts it to the EvalType.
+func wrapWithCastAsType(ctx sessionctx.Context, expr Expression, tp types.EvalType) Expression {
+	switch tp {
+	case types.ETInt:
+		return WrapWithCastAsInt(ctx, expr)
+	case types.ETReal:
+		return WrapWithCastAsReal(ctx, expr)
+	case types.ETString:
+		return WrapWithCastAsString(ctx, expr)
+	case types.ETDatetime:
+		return WrapWithCastAsTime(ctx, expr, types.NewFieldType(mysql.TypeDatetime))
+	case types.ETTimestamp:
+		return WrapWithCastAsTime(ctx, expr, types.NewFieldType(mysql.TypeTimestamp))
+	case types.ETDuration:
+		return WrapWithCastAsDuration(ctx, expr)
+	case types.ETDecimal:
+		return WrapWithCastAsDecimal(ctx, expr)
+	}
+	return expr
+}
+
+// WrapWithCastAsInt wraps `expr` with `cast` if the return type of expr is a time or decimal type,
+// otherwise, returns `expr` directly.
+func WrapWithCastAsInt(ctx sessionctx.Context, expr Expression) Expression {
+	if !needCastEvalType(expr.GetType().EvalType()) {
+		return expr
+	}
+	tp := types.NewFieldType(mysql.TypeLonglong)
+	tp.Flen, tp.Decimal = expr.GetType().Flen, 0
+	types.SetBinChsClnFlag(tp)
+	return BuildCastFunction(ctx, expr, tp)
+}
+
+// WrapWithCastAsReal wraps `expr` with `cast` if the return type of expr is a time or decimal type,
+// otherwise, returns `expr` directly.
+func WrapWithCastAsReal(ctx sessionctx.Context, expr Expression) Expression {
+	if !needCastEvalType(expr.GetType().EvalType()) {
+		return expr
+	}
+	tp := types.NewFieldType(mysql.TypeDouble)
+	tp.Flen, tp.Decimal = mysql.MaxRealWidth, types.UnspecifiedLength
+	types.SetBinChsClnFlag(tp)
+	return BuildCastFunction(ctx, expr, tp)
+}
+
+// WrapWithCastAsString wraps `expr` with `cast` if the return type of expr is a time or decimal type,
+// otherwise, returns `expr` directly.
+func WrapWithCastAsString(ctx sessionctx.Context, expr Expression) Expression {
+	exprTp := expr.GetType()
+	if !needCastEvalType(exprTp.EvalType()) {
+		return expr
+	}
+	tp := types.NewFieldType(mysql.TypeVarString)
+	tp.Charset, tp.Collate = charset.GetDefaultCharsetAndCollate()
+	tp.Flen, tp.Decimal = exprTp.Flen, types.UnspecifiedLength
+	return BuildCastFunction(ctx, expr, tp)
+}
+
+// WrapWithCastAsTime wraps `expr` with `cast` if the return type of expr is not
+// same as type of the specified `tp` , otherwise, returns `expr` directly.
+func WrapWithCastAsTime(ctx sessionctx.Context, expr Expression, tp *types.FieldType) Expression {
+	exprTp := expr.GetType().Tp
+	if tp.Tp == exprTp {
+		return expr
+	} else if (exprTp == mysql.TypeDate || exprTp == mysql.TypeTimestamp) && tp.Tp == mysql.TypeDatetime {
+		return expr
+	}
+	switch x := expr.GetType(); x.Tp {
+	case mysql.TypeDatetime, mysql.TypeTimestamp, mysql.TypeDate, mysql.TypeDuration:
+		tp.Decimal = x.Decimal
+	default:
+		tp.Decimal = int(types.MaxFsp)
+	}
+	switch tp.Tp {
+	case mysql.TypeDate:
+		tp.Flen = mysql.MaxDateWidth
+	case mysql.TypeDatetime, mysql.TypeTimestamp:
+		tp.Flen = mysql.MaxDatetimeWidthNoFsp
+		if tp.Decimal > 0 {
+			tp.Flen = tp.Flen + 1 + tp.Decimal
+		}
+	}
+	types.SetBinChsClnFlag(tp)
+	return BuildCastFunction(ctx, expr, tp)
+}
+
+// WrapWithCastAsDuration wraps `expr` with `cast` if the return type of expr is
+// not type duration, otherwise, returns `expr` directly.
+func WrapWithCastAsDuration(ctx sessionctx.Context, expr Expression) Expression {
+	if expr.GetType().Tp == mysql.TypeDuration {
+		return expr
+	}
+	tp := types.NewFieldType(mysql.TypeDuration)
+	switch x := expr.GetType(); x.Tp {
+	case mysql.TypeDatetime, mysql.TypeTimestamp, mysql.TypeDate:
+		tp.Decimal = x.Decimal
+	default:
+		tp.Decimal = int(types.MaxFsp)
+	}
+	tp.Flen = mysql.MaxDurationWidthNoFsp
+	if tp.Decimal > 0 {
+		tp.Flen = tp.Flen + 1 + tp.Decimal
+	}
+	types.SetBinChsClnFlag(tp)
+	return BuildCastFunction(ctx, expr, tp)
+}
+
+// WrapWithCastAsDecimal wraps `expr` with `cast` if the return type of expr is
+// not type decimal, otherwise, returns `expr` directly.
+func WrapWithCastAsDecimal(ctx sessionctx.Context, expr Expression) Expression {
+	if expr.GetType().EvalType() == types.ETDecimal {
+		return expr
+	}
+	tp := types.NewFieldType(mysql.TypeNewDecimal)
+	tp.Flen, tp.Decimal = expr.GetType().Flen, expr.GetType().Decimal
+	if expr.GetType().EvalType() == types.ETInt {
+		tp.Flen = mysql.MaxIntWidth
+	}
+	types.SetBinChsClnFlag(tp)
+	tp.Flag |= expr.GetType().Flag & mysql.UnsignedFlag
+	return BuildCastFunction(ctx, expr, tp)
+}
@@ -0,0 +1,553 @@
+// Copyright 2013 The ql Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSES/QL-LICENSE file.
+
+// Copyright 2015 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/chunk"
+)
+
+var (
+	_ functionClass = &nowFunctionClass{}
+	_ functionClass = &currentDateFunctionClass{}
+	_ functionClass = &addDateFunctionClass{}
+	_ functionClass = &subDateFunctionClass{}
+	_ functionClass = &dateDiffFunctionClass{}
+	_ functionClass = &extractFunctionClass{}
+)
+
+var (
+	_ builtinFunc = &builtinNowWithoutArgSig{}
+	_ builtinFunc = &builtinNowWithArgSig{}
+	_ builtinFunc = &builtinCurrentDateSig{}
+	_ builtinFunc = &builtinAddDateStringSig{}
+	_ builtinFunc = &builtinAddDateDatetimeSig{}
+	_ builtinFunc = &builtinSubDateStringSig{}
+	_ builtinFunc = &builtinSubDateDatetimeSig{}
+	_ builtinFunc = &builtinDateDiffSig{}
+	_ builtinFunc = &builtinExtractDatetimeSig{}
+	_ builtinFunc = &builtinExtractDurationSig{}
+)
+
+// handleInvalidTimeError reports error or warning depend on the context.
+func handleInvalidTimeError(ctx sessionctx.Context, err error) error {
+	if err == nil || !(types.ErrWrongValue.Equal(err) ||
+		types.ErrTruncatedWrongVal.Equal(err) || types.ErrDatetimeFunctionOverflow.Equal(err)) {
+		return err
+	}
+	sc := ctx.GetSessionVars().StmtCtx
+	err = sc.HandleTruncate(err)
+	if ctx.GetSessionVars().StrictSQLMode && (sc.InInsertStmt || sc.InUpdateStmt || sc.InDeleteStmt) {
+		return err
+	}
+	return nil
+}
+
+// getStmtTimestamp gets the timestamp of the current statement in the session time zone.
+func getStmtTimestamp(ctx sessionctx.Context) time.Time {
+	return ctx.GetSessionVars().StmtCtx.GetNowTsCached().In(ctx.GetSessionVars().Location())
+}
+
+type nowFunctionClass struct {
+	baseFunctionClass
+}
+
+func (c *nowFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	if len(args) == 0 {
+		bf := newBaseBuiltinFuncWithTp(ctx, args, types.ETDatetime)
+		bf.tp.Flen, bf.tp.Decimal = mysql.MaxDatetimeWidthNoFsp, int(types.MinFsp)
+		return &builtinNowWithoutArgSig{bf}, nil
+	}
+
+	fsp, err := getFspByIntArg(ctx, args[0])
+	if err != nil {
+		return nil, err
+	}
+	bf := newBaseBuiltinFuncWithTp(ctx, args, types.ETDatetime, types.ETInt)
+	bf.tp.Flen, bf.tp.Decimal = mysql.MaxDatetimeWidthNoFsp, int(fsp)
+	if fsp > 0 {
+		bf.tp.Flen += 1 + int(fsp)
+	}
+	return &builtinNowWithArgSig{bf}, nil
+}
+
+// getFspByIntArg gets the fsp from the constant argument of the functions like NOW(fsp).
+func getFspByIntArg(ctx sessionctx.Context, arg Expression) (int8, error) {
+	con, ok := arg.(*Constant)
+	if !ok {
+		return 0, errors.Errorf("the fsp argument must be a constant")
+	}
+	fsp, isNull, err := con.EvalInt(ctx, chunk.Row{})
+	if err != nil {
+		return 0, err
+	}
+	if isNull {
+		return 0, nil
+	}
+	if fsp > int64(types.MaxFsp) {
+		return 0, errors.Errorf("Too-big precision %d specified for 'now'. Maximum is %d.", fsp, types.MaxFsp)
+	} else if fsp < int64(types.MinFsp) {
+		return 0, errors.Errorf("Invalid negative %d specified, must in [0, 6].", fsp)
+	}
+	return int8(fsp), nil
+}
+
+func evalNowWithFsp(ctx sessionctx.Context, fsp int8) (types.Time, bool, error) {
+	result := types.NewTime(types.FromGoTime(getStmtTimestamp(ctx)), mysql.TypeDatetime, types.MaxFsp)
+	result, err := result.RoundFrac(ctx.GetSessionVars().StmtCtx, fsp)
+	if err != nil {
+		return types.ZeroTime, true, err
+	}
+	return result, false, nil
+}
+
+type builtinNowWithoutArgSig struct {
+	baseBuiltinFunc
+}
+
+func (b *builtinNowWithoutArgSig) Clone() builtinFunc {
+	newSig := &builtinNowWithoutArgSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+// evalTime evals NOW()
+// See https://dev.mysql.com/doc/refman/5.7/en/date-and-time-functions.html#function_now
+func (b *builtinNowWithoutArgSig) evalTime(row chunk.Row) (types.Time, bool, error) {
+	return evalNowWithFsp(b.ctx, types.DefaultFsp)
+}
+
+type builtinNowWithArgSig struct {
+	baseBuiltinFunc
+}
+
+func (b *builtinNowWithArgSig) Clone() builtinFunc {
+	newSig := &builtinNowWithArgSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+// evalTime evals NOW(fsp)
+// See https://dev.mysql.com/doc/refman/5.7/en/date-and-time-functions.html#function_now
+func (b *builtinNowWithArgSig) evalTime(row chunk.Row) (types.Time, bool, error) {
+	return evalNowWithFsp(b.ctx, int8(b.tp.Decimal))
+}
+
+type currentDateFunctionClass struct {
+	baseFunctionClass
+}
+
+func (c *currentDateFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	bf := newBaseBuiltinFuncWithTp(ctx, args, types.ETDatetime)
+	bf.tp.Tp, bf.tp.Flen, bf.tp.Decimal = mysql.TypeDate, mysql.MaxDateWidth, int(types.DefaultFsp)
+	return &builtinCurrentDateSig{bf}, nil
+}
+
+type builtinCurrentDateSig struct {
+	baseBuiltinFunc
+}
+
+func (b *builtinCurrentDateSig) Clone() builtinFunc {
+	newSig := &builtinCurrentDateSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+// evalTime evals CURDATE().
+// See https://dev.mysql.com/doc/refman/5.7/en/date-and-time-functions.html#function_curdate
+func (b *builtinCurrentDateSig) evalTime(row chunk.Row) (types.Time, bool, error) {
+	year, month, day := getStmtTimestamp(b.ctx).Date()
+	return types.NewTime(types.FromDate(year, int(month), day, 0, 0, 0, 0), mysql.TypeDate, types.DefaultFsp), false, nil
+}
+
+// baseDateArithmetical is the common part of DATE_ADD and DATE_SUB, the arguments are
+// the date, the interval and the unit of the interval.
+type baseDateArithmetical struct {
+	baseBuiltinFunc
+}
+
+// getDateArithmeticalBase creates the baseDateArithmetical, a string date is kept as
+// a string while the other dates are converted to DATETIME.
+func getDateArithmeticalBase(ctx sessionctx.Context, args []Expression) (baseDateArithmetical, types.EvalType) {
+	dateEvalTp := args[0].GetType().EvalType()
+	if dateEvalTp != types.ETString {
+		dateEvalTp = types.ETDatetime
+	}
+	intervalEvalTp := args[1].GetType().EvalType()
+	if intervalEvalTp != types.ETInt && intervalEvalTp != types.ETReal {
+		intervalEvalTp = types.ETString
+	}
+	bf := newBaseBuiltinFuncWithTp(ctx, args, dateEvalTp, dateEvalTp, intervalEvalTp, types.ETString)
+	if dateEvalTp == types.ETString {
+		bf.tp.Flen = mysql.MaxDatetimeFullWidth
+	} else {
+		bf.tp.Flen, bf.tp.Decimal = mysql.MaxDatetimeFullWidth, types.UnspecifiedLength
+	}
+	return baseDateArithmetical{bf}, dateEvalTp
+}
+
+func (b *baseDateArithmetical) getUnit(row chunk.Row) (string, bool, error) {
+	unit, isNull, err := b.args[2].EvalString(b.ctx, row)
+	return strings.ToUpper(unit), isNull, err
+}
+
+func (b *baseDateArithmetical) getDateFromString(row chunk.Row, unit string) (types.Time, bool, error) {
+	dateStr, isNull, err := b.args[0].EvalString(b.ctx, row)
+	if isNull || err != nil {
+		return types.ZeroTime, isNull, err
+	}
+	dateTp := mysql.TypeDate
+	if !types.IsDateFormat(dateStr) || types.IsClockUnit(unit) {
+		dateTp = mysql.TypeDatetime
+	}
+	date, err := types.ParseTime(b.ctx.GetSessionVars().StmtCtx, dateStr, dateTp, types.MaxFsp)
+	if err != nil {
+		return types.ZeroTime, true, handleInvalidTimeError(b.ctx, err)
+	}
+	return date, false, nil
+}
+
+func (b *baseDateArithmetical) getDateFromDatetime(row chunk.Row, unit string) (types.Time, bool, error) {
+	date, isNull, err := b.args[0].EvalTime(b.ctx, row)
+	if isNull || err != nil {
+		return types.ZeroTime, isNull, err
+	}
+	if date.Type() == mysql.TypeDate && types.IsClockUnit(unit) {
+		date.SetType(mysql.TypeDatetime)
+	}
+	return date, false, nil
+}
+
+func (b *baseDateArithmetical) getInterval(row chunk.Row) (string, bool, error) {
+	switch b.args[1].GetType().EvalType() {
+	case types.ETInt:
+		interval, isNull, err := b.args[1].EvalInt(b.ctx, row)
+		if isNull || err != nil {
+			return "", isNull, err
+		}
+		return strconv.FormatInt(interval, 10), false, nil
+	case types.ETReal:
+		interval, isNull, err := b.args[1].EvalReal(b.ctx, row)
+		if isNull || err != nil {
+			return "", isNull, err
+		}
+		return strconv.FormatFloat(interval, 'f', -1, 64), false, nil
+	case types.ETDecimal:
+		interval, isNull, err := b.args[1].EvalDecimal(b.ctx, row)
+		if isNull || err != nil {
+			return "", isNull, err
+		}
+		return interval.String(), false, nil
+	}
+	return b.args[1].EvalString(b.ctx, row)
+}
+
+// add adds the interval to the date, the interval is subtracted if isSub is true.
+func (b *baseDateArithmetical) add(date types.Time, interval string, unit string, isSub bool) (types.Time, bool, error) {
+	year, month, day, nano, err := types.ParseDurationValue(unit, interval)
+	if err != nil {
+		return types.ZeroTime, true, handleInvalidTimeError(b.ctx, err)
+	}
+	if isSub {
+		year, month, day, nano = -year, -month, -day, -nano
+	}
+
+	goTime, err := date.GoTime(time.UTC)
+	if err != nil {
+		return types.ZeroTime, true, handleInvalidTimeError(b.ctx, err)
+	}
+	goTime = goTime.Add(time.Duration(nano))
+	goTime = types.AddDate(year, month, day, goTime)
+	if goTime.Nanosecond() == 0 {
+		date.SetFsp(0)
+	}
+	if goTime.Year() < 0 || goTime.Year() > 9999 {
+		return types.ZeroTime, true, handleInvalidTimeError(b.ctx, types.ErrDatetimeFunctionOverflow.GenWithStackByArgs("datetime"))
+	}
+	date.SetCoreTime(types.FromGoTime(goTime))
+	return date, false, nil
+}
+
+func (b *baseDateArithmetical) evalStringResult(row chunk.Row, isSub bool) (string, bool, error) {
+	unit, isNull, err := b.getUnit(row)
+	if isNull || err != nil {
+		return "", true, err
+	}
+	date, isNull, err := b.getDateFromString(row, unit)
+	if isNull || err != nil {
+		return "", true, err
+	}
+	interval, isNull, err := b.getInterval(row)
+	if isNull || err != nil {
+		return "", true, err
+	}
+	result, isNull, err := b.add(date, interval, unit, isSub)
+	if isNull || err != nil {
+		return "", true, err
+	}
+	return result.String(), false, nil
+}
+
+func (b *baseDateArithmetical) evalTimeResult(row chunk.Row, isSub bool) (types.Time, bool, error) {
+	unit, isNull, err := b.getUnit(row)
+	if isNull || err != nil {
+		return types.ZeroTime, true, err
+	}
+	date, isNull, err := b.getDateFromDatetime(row, unit)
+	if isNull || err != nil {
+		return types.ZeroTime, true, err
+	}
+	interval, isNull, err := b.getInterval(row)
+	if isNull || err != nil {
+		return types.ZeroTime, true, err
+	}
+	return b.add(date, interval, unit, isSub)
+}
+
+type addDateFunctionClass struct {
+	baseFunctionClass
+}
+
+func (c *addDateFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	base, dateEvalTp := getDateArithmeticalBase(ctx, args)
+	if dateEvalTp == types.ETString {
+		return &builtinAddDateStringSig{base}, nil
+	}
+	return &builtinAddDateDatetimeSig{base}, nil
+}
+
+type builtinAddDateStringSig struct {
+	baseDateArithmetical
+}
+
+func (b *builtinAddDateStringSig) Clone() builtinFunc {
+	newSig := &builtinAddDateStringSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+// evalString evals ADDDATE(date,INTERVAL expr unit) when the date is a string.
+// See https://dev.mysql.com/doc/refman/5.7/en/date-and-time-functions.html#function_adddate
+func (b *builtinAddDateStringSig) evalString(row chunk.Row) (string, bool, error) {
+	return b.evalStringResult(row, false)
+}
+
+type builtinAddDateDatetimeSig struct {
+	baseDateArithmetical
+}
+
+func (b *builtinAddDateDatetimeSig) Clone() builtinFunc {
+	newSig := &builtinAddDateDatetimeSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+// evalTime evals ADDDATE(date,INTERVAL expr unit) when the date is not a string.
+// See https://dev.mysql.com/doc/refman/5.7/en/date-and-time-functions.html#function_adddate
+func (b *builtinAddDateDatetimeSig) evalTime(row chunk.Row) (types.Time, bool, error) {
+	return b.evalTimeResult(row, false)
+}
+
+type subDateFunctionClass struct {
+	baseFunctionClass
+}
+
+func (c *subDateFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	base, dateEvalTp := getDateArithmeticalBase(ctx, args)
+	if dateEvalTp == types.ETString {
+		return &builtinSubDateStringSig{base}, nil
+	}
+	return &builtinSubDateDatetimeSig{base}, nil
+}
+
+type builtinSubDateStringSig struct {
+	baseDateArithmetical
+}
+
+func (b *builtinSubDateStringSig) Clone() builtinFunc {
+	newSig := &builtinSubDateStringSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+// evalString evals SUBDATE(date,INTERVAL expr unit) when the date is a string.
+// See https://dev.mysql.com/doc/refman/5.7/en/date-and-time-functions.html#function_subdate
+func (b *builtinSubDateStringSig) evalString(row chunk.Row) (string, bool, error) {
+	return b.evalStringResult(row, true)
+}
+
+type builtinSubDateDatetimeSig struct {
+	baseDateArithmetical
+}
+
+func (b *builtinSubDateDatetimeSig) Clone() builtinFunc {
+	newSig := &builtinSubDateDatetimeSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+// evalTime evals SUBDATE(date,INTERVAL expr unit) when the date is not a string.
+// See https://dev.mysql.com/doc/refman/5.7/en/date-and-time-functions.html#function_subdate
+func (b *builtinSubDateDatetimeSig) evalTime(row chunk.Row) (types.Time, bool, error) {
+	return b.evalTimeResult(row, true)
+}
+
+type dateDiffFunctionClass struct {
+	baseFunctionClass
+}
+
+func (c *dateDiffFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	bf := newBaseBuiltinFuncWithTp(ctx, args, types.ETInt, types.ETDatetime, types.ETDatetime)
+	return &builtinDateDiffSig{bf}, nil
+}
+
+type builtinDateDiffSig struct {
+	baseBuiltinFunc
+}
+
+func (b *builtinDateDiffSig) Clone() builtinFunc {
+	newSig := &builtinDateDiffSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+// evalInt evals a builtinDateDiffSig.
+// See https://dev.mysql.com/doc/refman/5.7/en/date-and-time-functions.html#function_datediff
+func (b *builtinDateDiffSig) evalInt(row chunk.Row) (int64, bool, error) {
+	lhs, isNull, err := b.args[0].EvalTime(b.ctx, row)
+	if isNull || err != nil {
+		return 0, true, err
+	}
+	rhs, isNull, err := b.args[1].EvalTime(b.ctx, row)
+	if isNull || err != nil {
+		return 0, true, err
+	}
+	if invalidLHS, invalidRHS := lhs.InvalidZero(), rhs.InvalidZero(); invalidLHS || invalidRHS {
+		if invalidLHS {
+			err = handleInvalidTimeError(b.ctx, types.ErrWrongValue.GenWithStackByArgs(types.DateTimeStr, lhs.String()))
+		}
+		if invalidRHS {
+			err = handleInvalidTimeError(b.ctx, types.ErrWrongValue.GenWithStackByArgs(types.DateTimeStr, rhs.String()))
+		}
+		return 0, true, err
+	}
+	return int64(types.DateDiff(lhs.CoreTime(), rhs.CoreTime())), false, nil
+}
+
+type extractFunctionClass struct {
+	baseFunctionClass
+}
+
+func (c *extractFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (sig builtinFunc, err error) {
+	if err = c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+
+	datetimeEvalTp := types.ETDatetime
+	if args[1].GetType().EvalType() == types.ETDuration {
+		datetimeEvalTp = types.ETDuration
+	}
+	bf := newBaseBuiltinFuncWithTp(ctx, args, types.ETInt, types.ETString, datetimeEvalTp)
+	if datetimeEvalTp == types.ETDuration {
+		sig = &builtinExtractDurationSig{bf}
+	} else {
+		sig = &builtinExtractDatetimeSig{bf}
+	}
+	return sig, nil
+}
+
+type builtinExtractDatetimeSig struct {
+	baseBuiltinFunc
+}
+
+func (b *builtinExtractDatetimeSig) Clone() builtinFunc {
+	newSig := &builtinExtractDatetimeSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+// evalInt evals a builtinExtractDatetimeSig.
+// See https://dev.mysql.com/doc/refman/5.7/en/date-and-time-functions.html#function_extract
+func (b *builtinExtractDatetimeSig) evalInt(row chunk.Row) (int64, bool, error) {
+	unit, isNull, err := b.args[0].EvalString(b.ctx, row)
+	if isNull || err != nil {
+		return 0, true, err
+	}
+	dt, isNull, err := b.args[1].EvalTime(b.ctx, row)
+	if isNull || err != nil {
+		return 0, true, err
+	}
+	if types.IsDateUnit(unit) {
+		res, err := types.ExtractDatetimeNum(&dt, unit)
+		return res, err != nil, err
+	}
+	// The units with only the clock parts are extracted from the time of the day.
+	dur, err := dt.ConvertToDuration()
+	if err != nil {
+		return 0, true, err
+	}
+	res, err := types.ExtractDurationNum(&dur, unit)
+	return res, err != nil, err
+}
+
+type builtinExtractDurationSig struct {
+	baseBuiltinFunc
+}
+
+func (b *builtinExtractDurationSig) Clone() builtinFunc {
+	newSig := &builtinExtractDurationSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+// evalInt evals a builtinExtractDurationSig.
+// See https://dev.mysql.com/doc/refman/5.7/en/date-and-time-functions.html#function_extract
+func (b *builtinExtractDurationSig) evalInt(row chunk.Row) (int64, bool, error) {
+	unit, isNull, err := b.args[0].EvalString(b.ctx, row)
+	if isNull || err != nil {
+		return 0, true, err
+	}
+	dur, isNull, err := b.args[1].EvalDuration(b.ctx, row)
+	if isNull || err != nil {
+		return 0, true, err
+	}
+	res, err := types.ExtractDurationNum(&dur, unit)
+	return res, err != nil, err
+}
@@ -0,0 +1,194 @@
+// Copyright 2015 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"time"
+
+	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/chunk"
+)
+
+func (s *testEvaluatorSuite) TestNowAndCurrentDate(c *C) {
+	sc := s.ctx.GetSessionVars().StmtCtx
+	sc.ResetNowTs()
+	defer sc.ResetNowTs()
+
+	fc := funcs[ast.Now]
+	f, err := fc.getFunction(s.ctx, nil)
+	c.Assert(err, IsNil)
+	c.Assert(f.getRetTp().Tp, Equals, mysql.TypeDatetime)
+	v, err := evalBuiltinFunc(f, chunk.Row{})
+	c.Assert(err, IsNil)
+	now := v.GetMysqlTime()
+	c.Assert(now.Fsp(), Equals, int8(0))
+	c.Assert(len(now.String()), Equals, mysql.MaxDatetimeWidthNoFsp)
+	goNow := sc.GetNowTsCached().In(s.ctx.GetSessionVars().Location())
+	c.Assert(now.Year(), Equals, goNow.Year())
+	c.Assert(now.Day(), Equals, goNow.Day())
+
+	f, err = fc.getFunction(s.ctx, s.datumsToConstants(types.MakeDatums(3)))
+	c.Assert(err, IsNil)
+	c.Assert(f.getRetTp().Decimal, Equals, 3)
+	v, err = evalBuiltinFunc(f, chunk.Row{})
+	c.Assert(err, IsNil)
+	c.Assert(v.GetMysqlTime().Fsp(), Equals, int8(3))
+
+	_, err = fc.getFunction(s.ctx, s.datumsToConstants(types.MakeDatums(7)))
+	c.Assert(err, NotNil)
+
+	f, err = funcs[ast.CurrentDate].getFunction(s.ctx, nil)
+	c.Assert(err, IsNil)
+	v, err = evalBuiltinFunc(f, chunk.Row{})
+	c.Assert(err, IsNil)
+	c.Assert(v.GetMysqlTime().Type(), Equals, mysql.TypeDate)
+	c.Assert(v.GetMysqlTime().String(), Equals, goNow.Format(types.DateFormat))
+}
+
+func (s *testEvaluatorSuite) TestDateArithmetical(c *C) {
+	tests := []struct {
+		fn       string
+		date     interface{}
+		interval interface{}
+		unit     string
+		expect   interface{}
+	}{
+		{ast.DateAdd, "2012-12-31", 1, "DAY", "2013-01-01"},
+		{ast.DateAdd, "2012-12-31", 1, "HOUR", "2012-12-31 01:00:00"},
+		{ast.DateAdd, "2012-12-31 23:59:59", "1:1", "MINUTE_SECOND", "2013-01-01 00:01:00"},
+		{ast.DateAdd, "2018-01-31", 1, "MONTH", "2018-02-28"},
+		{ast.DateAdd, "2012-12-31 11:30:45", 1.5, "SECOND", "2012-12-31 11:30:46.500000"},
+		{ast.DateSub, "2013-01-01", 1, "DAY", "2012-12-31"},
+		{ast.DateSub, "2013-01-01", "1-1", "YEAR_MONTH", "2011-12-01"},
+		{ast.DateAdd, nil, 1, "DAY", nil},
+		{ast.DateAdd, "2012-12-31", nil, "DAY", nil},
+		{ast.DateAdd, "9999-12-31", 1, "DAY", nil},
+	}
+	for _, t := range tests {
+		f, err := funcs[t.fn].getFunction(s.ctx, s.datumsToConstants(types.MakeDatums(t.date, t.interval, t.unit)))
+		c.Assert(err, IsNil)
+		v, err := evalBuiltinFunc(f, chunk.Row{})
+		c.Assert(err, IsNil)
+		c.Assert(v.GetValue(), Equals, t.expect, Commentf("%s(%v, %v %s)", t.fn, t.date, t.interval, t.unit))
+	}
+
+	date := types.NewTime(types.FromDate(2012, 12, 31, 11, 30, 45, 0), mysql.TypeDatetime, 0)
+	f, err := funcs[ast.DateAdd].getFunction(s.ctx, s.datumsToConstants(types.MakeDatums(date, 1, "DAY")))
+	c.Assert(err, IsNil)
+	c.Assert(f.getRetTp().EvalType(), Equals, types.ETDatetime)
+	v, err := evalBuiltinFunc(f, chunk.Row{})
+	c.Assert(err, IsNil)
+	c.Assert(v.GetMysqlTime().String(), Equals, "2013-01-01 11:30:45")
+}
+
+func (s *testEvaluatorSuite) TestDateDiff(c *C) {
+	tests := []struct {
+		lhs    interface{}
+		rhs    interface{}
+		expect interface{}
+	}{
+		{"2013-01-01", "2012-12-31", int64(1)},
+		{"2012-12-31 23:59:59", "2013-01-01", int64(-1)},
+		{"2012-03-01", "2012-02-01", int64(29)},
+		{"2012-12-31", nil, nil},
+		{"0000-00-00", "2012-12-31", nil},
+	}
+	for _, t := range tests {
+		f, err := funcs[ast.DateDiff].getFunction(s.ctx, s.datumsToConstants(types.MakeDatums(t.lhs, t.rhs)))
+		c.Assert(err, IsNil)
+		v, err := evalBuiltinFunc(f, chunk.Row{})
+		c.Assert(err, IsNil)
+		c.Assert(v.GetValue(), Equals, t.expect, Commentf("DATEDIFF(%v, %v)", t.lhs, t.rhs))
+	}
+}
+
+func (s *testEvaluatorSuite) TestExtract(c *C) {
+	tests := []struct {
+		unit   string
+		date   interface{}
+		expect interface{}
+	}{
+		{"YEAR", "2012-12-31 11:30:45", int64(2012)},
+		{"MONTH", "2012-12-31 11:30:45", int64(12)},
+		{"DAY", "2012-12-31 11:30:45", int64(31)},
+		{"HOUR", "2012-12-31 11:30:45", int64(11)},
+		{"MINUTE", "2012-12-31 11:30:45", int64(30)},
+		{"YEAR_MONTH", "2012-12-31 11:30:45", int64(201212)},
+		{"DAY_SECOND", "2012-12-31 11:30:45", int64(31113045)},
+		{"HOUR_MINUTE", types.Duration{Duration: -(10*time.Hour + 11*time.Minute)}, int64(-1011)},
+		{"SECOND", types.Duration{Duration: 10*time.Hour + 11*time.Second}, int64(11)},
+		{"YEAR", nil, nil},
+	}
+	for _, t := range tests {
+		f, err := funcs[ast.Extract].getFunction(s.ctx, s.datumsToConstants(types.MakeDatums(t.unit, t.date)))
+		c.Assert(err, IsNil)
+		v, err := evalBuiltinFunc(f, chunk.Row{})
+		c.Assert(err, IsNil)
+		c.Assert(v.GetValue(), Equals, t.expect, Commentf("EXTRACT(%s FROM %v)", t.unit, t.date))
+	}
+}
+
+func (s *testEvaluatorSuite) TestTimeCompare(c *C) {
+	date := types.NewTime(types.FromDate(2012, 12, 31, 0, 0, 0, 0), mysql.TypeDate, 0)
+	dur := types.Duration{Duration: 11*time.Hour + 30*time.Minute}
+	tests := []struct {
+		fn     string
+		lhs    interface{}
+		rhs    interface{}
+		expect int64
+	}{
+		{ast.EQ, date, "2012-12-31", 1},
+		{ast.LT, date, "2013-01-01 00:00:00", 1},
+		{ast.GT, date, "2012-12-30 23:59:59", 1},
+		{ast.NE, date, date, 0},
+		{ast.EQ, dur, dur, 1},
+		{ast.GE, dur, types.Duration{Duration: 12 * time.Hour}, 0},
+	}
+	for _, t := range tests {
+		f, err := newFunctionForTest(s.ctx, t.fn, s.datumsToConstants(types.MakeDatums(t.lhs, t.rhs))...)
+		c.Assert(err, IsNil)
+		v, err := f.Eval(chunk.Row{})
+		c.Assert(err, IsNil)
+		c.Assert(v.GetInt64(), Equals, t.expect, Commentf("%v %s %v", t.lhs, t.fn, t.rhs))
+	}
+}
+
+func (s *testEvaluatorSuite) TestCastAsTime(c *C) {
+	tp := types.NewFieldType(mysql.TypeDate)
+	expr := BuildCastFunction(s.ctx, s.datumsToConstants(types.MakeDatums("2012-12-31 11:30:45"))[0], tp)
+	v, err := expr.Eval(chunk.Row{})
+	c.Assert(err, IsNil)
+	c.Assert(v.GetMysqlTime().String(), Equals, "2012-12-31")
+
+	tp = types.NewFieldType(mysql.TypeDatetime)
+	expr = BuildCastFunction(s.ctx, s.datumsToConstants(types.MakeDatums(int64(20121231113045)))[0], tp)
+	v, err = expr.Eval(chunk.Row{})
+	c.Assert(err, IsNil)
+	c.Assert(v.GetMysqlTime().String(), Equals, "2012-12-31 11:30:45")
+
+	tp = types.NewFieldType(mysql.TypeDuration)
+	expr = BuildCastFunction(s.ctx, s.datumsToConstants(types.MakeDatums("11:30:45"))[0], tp)
+	v, err = expr.Eval(chunk.Row{})
+	c.Assert(err, IsNil)
+	c.Assert(v.GetMysqlDuration().String(), Equals, "11:30:45")
+
+	date := types.NewTime(types.FromDate(2012, 12, 31, 11, 30, 45, 0), mysql.TypeDatetime, 0)
+	expr = BuildCastFunction(s.ctx, s.datumsToConstants(types.MakeDatums(date))[0], types.NewFieldType(mysql.TypeLonglong))
+	v, err = expr.Eval(chunk.Row{})
+	c.Assert(err, IsNil)
+	c.Assert(v.GetInt64(), Equals, int64(20121231113045))
+}
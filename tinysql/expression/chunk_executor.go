@@ -105,6 +105,18 @@ func evalOneVec(ctx sessionctx.Context, expr Expression, input *chunk.Chunk, out
 		if err := expr.VecEvalString(ctx, input, result); err != nil {
 			return err
 		}
+	case types.ETDecimal:
+		if err := expr.VecEvalDecimal(ctx, input, result); err != nil {
+			return err
+		}
+	case types.ETDatetime, types.ETTimestamp:
+		if err := expr.VecEvalTime(ctx, input, result); err != nil {
+			return err
+		}
+	case types.ETDuration:
+		if err := expr.VecEvalDuration(ctx, input, result); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -123,6 +135,18 @@ func evalOneColumn(ctx sessionctx.Context, expr Expression, iterator *chunk.Iter
 		for row := iterator.Begin(); err == nil && row != iterator.End(); row = iterator.Next() {
 			err = executeToString(ctx, expr, fieldType, row, output, colID)
 		}
+	case types.ETDecimal:
+		for row := iterator.Begin(); err == nil && row != iterator.End(); row = iterator.Next() {
+			err = executeToDecimal(ctx, expr, fieldType, row, output, colID)
+		}
+	case types.ETDatetime, types.ETTimestamp:
+		for row := iterator.Begin(); err == nil && row != iterator.End(); row = iterator.Next() {
+			err = executeToDatetime(ctx, expr, fieldType, row, output, colID)
+		}
+	case types.ETDuration:
+		for row := iterator.Begin(); err == nil && row != iterator.End(); row = iterator.Next() {
+			err = executeToDuration(ctx, expr, fieldType, row, output, colID)
+		}
 	}
 	return err
 }
@@ -135,6 +159,12 @@ func evalOneCell(ctx sessionctx.Context, expr Expression, row chunk.Row, output
 		err = executeToReal(ctx, expr, fieldType, row, output, colID)
 	case types.ETString:
 		err = executeToString(ctx, expr, fieldType, row, output, colID)
+	case types.ETDecimal:
+		err = executeToDecimal(ctx, expr, fieldType, row, output, colID)
+	case types.ETDatetime, types.ETTimestamp:
+		err = executeToDatetime(ctx, expr, fieldType, row, output, colID)
+	case types.ETDuration:
+		err = executeToDuration(ctx, expr, fieldType, row, output, colID)
 	}
 	return err
 }
@@ -190,6 +220,45 @@ func executeToString(ctx sessionctx.Context, expr Expression, fieldType *types.F
 	return nil
 }
 
+func executeToDecimal(ctx sessionctx.Context, expr Expression, fieldType *types.FieldType, row chunk.Row, output *chunk.Chunk, colID int) error {
+	res, isNull, err := expr.EvalDecimal(ctx, row)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		output.AppendNull(colID)
+	} else {
+		output.AppendMyDecimal(colID, res)
+	}
+	return nil
+}
+
+func executeToDatetime(ctx sessionctx.Context, expr Expression, fieldType *types.FieldType, row chunk.Row, output *chunk.Chunk, colID int) error {
+	res, isNull, err := expr.EvalTime(ctx, row)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		output.AppendNull(colID)
+	} else {
+		output.AppendTime(colID, res)
+	}
+	return nil
+}
+
+func executeToDuration(ctx sessionctx.Context, expr Expression, fieldType *types.FieldType, row chunk.Row, output *chunk.Chunk, colID int) error {
+	res, isNull, err := expr.EvalDuration(ctx, row)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		output.AppendNull(colID)
+	} else {
+		output.AppendDuration(colID, res)
+	}
+	return nil
+}
+
 // VectorizedFilter applies a list of filters to a Chunk and
 // returns a bool slice, which indicates whether a row is passed the filters.
 // Filters is executed vectorized.
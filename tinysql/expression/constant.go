@@ -87,6 +87,21 @@ func (c *Constant) VecEvalString(ctx sessionctx.Context, input *chunk.Chunk, res
 	return genVecFromConstExpr(ctx, c, types.ETString, input, result)
 }
 
+// VecEvalDecimal evaluates this expression in a vectorized manner.
+func (c *Constant) VecEvalDecimal(ctx sessionctx.Context, input *chunk.Chunk, result *chunk.Column) error {
+	return genVecFromConstExpr(ctx, c, types.ETDecimal, input, result)
+}
+
+// VecEvalTime evaluates this expression in a vectorized manner.
+func (c *Constant) VecEvalTime(ctx sessionctx.Context, input *chunk.Chunk, result *chunk.Column) error {
+	return genVecFromConstExpr(ctx, c, types.ETTimestamp, input, result)
+}
+
+// VecEvalDuration evaluates this expression in a vectorized manner.
+func (c *Constant) VecEvalDuration(ctx sessionctx.Context, input *chunk.Chunk, result *chunk.Column) error {
+	return genVecFromConstExpr(ctx, c, types.ETDuration, input, result)
+}
+
 // Eval implements Expression interface.
 func (c *Constant) Eval(_ chunk.Row) (types.Datum, error) {
 	return c.Value, nil
@@ -125,6 +140,31 @@ func (c *Constant) EvalString(ctx sessionctx.Context, _ chunk.Row) (string, bool
 	return res, err != nil, err
 }
 
+// EvalDecimal returns decimal representation of Constant.
+func (c *Constant) EvalDecimal(ctx sessionctx.Context, _ chunk.Row) (*types.MyDecimal, bool, error) {
+	if c.GetType().Tp == mysql.TypeNull || c.Value.IsNull() {
+		return nil, true, nil
+	}
+	res, err := c.Value.ToDecimal(ctx.GetSessionVars().StmtCtx)
+	return res, err != nil, err
+}
+
+// EvalTime returns DATE/DATETIME/TIMESTAMP representation of Constant.
+func (c *Constant) EvalTime(ctx sessionctx.Context, _ chunk.Row) (val types.Time, isNull bool, err error) {
+	if c.GetType().Tp == mysql.TypeNull || c.Value.IsNull() {
+		return types.ZeroTime, true, nil
+	}
+	return c.Value.GetMysqlTime(), false, nil
+}
+
+// EvalDuration returns Duration representation of Constant.
+func (c *Constant) EvalDuration(ctx sessionctx.Context, _ chunk.Row) (val types.Duration, isNull bool, err error) {
+	if c.GetType().Tp == mysql.TypeNull || c.Value.IsNull() {
+		return types.Duration{}, true, nil
+	}
+	return c.Value.GetMysqlDuration(), false, nil
+}
+
 // Equal implements Expression interface.
 func (c *Constant) Equal(ctx sessionctx.Context, b Expression) bool {
 	y, ok := b.(*Constant)
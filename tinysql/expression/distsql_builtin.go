@@ -49,50 +49,70 @@ func getSignatureByPB(ctx sessionctx.Context, sigCode tipb.ScalarFuncSig, tp *ti
 		f = &builtinLTRealSig{base}
 	case tipb.ScalarFuncSig_LTString:
 		f = &builtinLTStringSig{base}
+	case tipb.ScalarFuncSig_LTDecimal:
+		f = &builtinLTDecimalSig{base}
 	case tipb.ScalarFuncSig_LEInt:
 		f = &builtinLEIntSig{base}
 	case tipb.ScalarFuncSig_LEReal:
 		f = &builtinLERealSig{base}
 	case tipb.ScalarFuncSig_LEString:
 		f = &builtinLEStringSig{base}
+	case tipb.ScalarFuncSig_LEDecimal:
+		f = &builtinLEDecimalSig{base}
 	case tipb.ScalarFuncSig_GTInt:
 		f = &builtinGTIntSig{base}
 	case tipb.ScalarFuncSig_GTReal:
 		f = &builtinGTRealSig{base}
 	case tipb.ScalarFuncSig_GTString:
 		f = &builtinGTStringSig{base}
+	case tipb.ScalarFuncSig_GTDecimal:
+		f = &builtinGTDecimalSig{base}
 	case tipb.ScalarFuncSig_GEInt:
 		f = &builtinGEIntSig{base}
 	case tipb.ScalarFuncSig_GEReal:
 		f = &builtinGERealSig{base}
 	case tipb.ScalarFuncSig_GEString:
 		f = &builtinGEStringSig{base}
+	case tipb.ScalarFuncSig_GEDecimal:
+		f = &builtinGEDecimalSig{base}
 	case tipb.ScalarFuncSig_EQInt:
 		f = &builtinEQIntSig{base}
 	case tipb.ScalarFuncSig_EQReal:
 		f = &builtinEQRealSig{base}
 	case tipb.ScalarFuncSig_EQString:
 		f = &builtinEQStringSig{base}
+	case tipb.ScalarFuncSig_EQDecimal:
+		f = &builtinEQDecimalSig{base}
 	case tipb.ScalarFuncSig_NEInt:
 		f = &builtinNEIntSig{base}
 	case tipb.ScalarFuncSig_NEReal:
 		f = &builtinNERealSig{base}
 	case tipb.ScalarFuncSig_NEString:
 		f = &builtinNEStringSig{base}
+	case tipb.ScalarFuncSig_NEDecimal:
+		f = &builtinNEDecimalSig{base}
 	case tipb.ScalarFuncSig_PlusReal:
 		f = &builtinArithmeticPlusRealSig{base}
 	case tipb.ScalarFuncSig_PlusInt:
 		f = &builtinArithmeticPlusIntSig{base}
+	case tipb.ScalarFuncSig_PlusDecimal:
+		f = &builtinArithmeticPlusDecimalSig{base}
 	case tipb.ScalarFuncSig_MinusReal:
 		f = &builtinArithmeticMinusRealSig{base}
 	case tipb.ScalarFuncSig_MinusInt:
 		f = &builtinArithmeticMinusIntSig{base}
+	case tipb.ScalarFuncSig_MinusDecimal:
+		f = &builtinArithmeticMinusDecimalSig{base}
 	case tipb.ScalarFuncSig_MultiplyReal:
 		f = &builtinArithmeticMultiplyRealSig{base}
 	case tipb.ScalarFuncSig_MultiplyInt:
 		f = &builtinArithmeticMultiplyIntSig{base}
+	case tipb.ScalarFuncSig_MultiplyDecimal:
+		f = &builtinArithmeticMultiplyDecimalSig{base}
 	case tipb.ScalarFuncSig_DivideReal:
 		f = &builtinArithmeticDivideRealSig{base}
+	case tipb.ScalarFuncSig_DivideDecimal:
+		f = &builtinArithmeticDivideDecimalSig{base}
 	case tipb.ScalarFuncSig_MultiplyIntUnsigned:
 		f = &builtinArithmeticMultiplyIntUnsignedSig{base}
 	case tipb.ScalarFuncSig_LogicalAnd:
@@ -103,12 +123,18 @@ func getSignatureByPB(ctx sessionctx.Context, sigCode tipb.ScalarFuncSig, tp *ti
 		f = &builtinUnaryNotIntSig{base}
 	case tipb.ScalarFuncSig_UnaryNotReal:
 		f = &builtinUnaryNotRealSig{base}
+	case tipb.ScalarFuncSig_UnaryNotDecimal:
+		f = &builtinUnaryNotDecimalSig{base}
 	case tipb.ScalarFuncSig_UnaryMinusInt:
 		f = &builtinUnaryMinusIntSig{base}
 	case tipb.ScalarFuncSig_UnaryMinusReal:
 		f = &builtinUnaryMinusRealSig{base}
+	case tipb.ScalarFuncSig_UnaryMinusDecimal:
+		f = &builtinUnaryMinusDecimalSig{base}
 	case tipb.ScalarFuncSig_RealIsNull:
 		f = &builtinRealIsNullSig{base}
+	case tipb.ScalarFuncSig_DecimalIsNull:
+		f = &builtinDecimalIsNullSig{base}
 	case tipb.ScalarFuncSig_StringIsNull:
 		f = &builtinStringIsNullSig{base}
 	case tipb.ScalarFuncSig_IntIsNull:
@@ -121,18 +147,24 @@ func getSignatureByPB(ctx sessionctx.Context, sigCode tipb.ScalarFuncSig, tp *ti
 		f = &builtinInIntSig{base}
 	case tipb.ScalarFuncSig_InReal:
 		f = &builtinInRealSig{base}
+	case tipb.ScalarFuncSig_InDecimal:
+		f = &builtinInDecimalSig{base}
 	case tipb.ScalarFuncSig_InString:
 		f = &builtinInStringSig{base}
 	case tipb.ScalarFuncSig_IfNullInt:
 		f = &builtinIfNullIntSig{base}
 	case tipb.ScalarFuncSig_IfNullReal:
 		f = &builtinIfNullRealSig{base}
+	case tipb.ScalarFuncSig_IfNullDecimal:
+		f = &builtinIfNullDecimalSig{base}
 	case tipb.ScalarFuncSig_IfNullString:
 		f = &builtinIfNullStringSig{base}
 	case tipb.ScalarFuncSig_IfInt:
 		f = &builtinIfIntSig{base}
 	case tipb.ScalarFuncSig_IfReal:
 		f = &builtinIfRealSig{base}
+	case tipb.ScalarFuncSig_IfDecimal:
+		f = &builtinIfDecimalSig{base}
 	case tipb.ScalarFuncSig_IfString:
 		f = &builtinIfStringSig{base}
 	case tipb.ScalarFuncSig_Length:
@@ -201,6 +233,8 @@ func PBToExpr(expr *tipb.Expr, tps []*types.FieldType, sc *stmtctx.StatementCont
 		return convertFloat(expr.Val, true)
 	case tipb.ExprType_Float64:
 		return convertFloat(expr.Val, false)
+	case tipb.ExprType_MysqlDecimal:
+		return convertDecimal(expr.Val)
 	}
 	if expr.Tp != tipb.ExprType_ScalarFunc {
 		panic("should be a tipb.ExprType_ScalarFunc")
@@ -282,3 +316,15 @@ func convertFloat(val []byte, f32 bool) (*Constant, error) {
 	}
 	return &Constant{Value: d, RetType: types.NewFieldType(mysql.TypeDouble)}, nil
 }
+
+func convertDecimal(val []byte) (*Constant, error) {
+	_, dec, precision, frac, err := codec.DecodeDecimal(val)
+	var d types.Datum
+	d.SetMysqlDecimal(dec)
+	d.SetLength(precision)
+	d.SetFrac(frac)
+	if err != nil {
+		return nil, errors.Errorf("invalid decimal % x", val)
+	}
+	return &Constant{Value: d, RetType: types.NewFieldType(mysql.TypeNewDecimal)}, nil
+}
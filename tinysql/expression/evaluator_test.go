@@ -86,12 +86,18 @@ func (s *testEvaluatorSuite) kindToFieldType(kind byte) types.FieldType {
 		ft.Tp = mysql.TypeDouble
 	case types.KindFloat64:
 		ft.Tp = mysql.TypeDouble
+	case types.KindMysqlDecimal:
+		ft.Tp = mysql.TypeNewDecimal
 	case types.KindString:
 		ft.Tp = mysql.TypeVarString
 	case types.KindBytes:
 		ft.Tp = mysql.TypeVarString
 	case types.KindInterface:
 		ft.Tp = mysql.TypeVarString
+	case types.KindMysqlDuration:
+		ft.Tp = mysql.TypeDuration
+	case types.KindMysqlTime:
+		ft.Tp = mysql.TypeDatetime
 	}
 	return ft
 }
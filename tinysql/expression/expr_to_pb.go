@@ -142,6 +142,14 @@ func (pc *PbConverter) encodeDatum(ft *types.FieldType, d types.Datum) (tipb.Exp
 	case types.KindFloat64:
 		tp = tipb.ExprType_Float64
 		val = codec.EncodeFloat(nil, d.GetFloat64())
+	case types.KindMysqlDecimal:
+		tp = tipb.ExprType_MysqlDecimal
+		var err error
+		val, err = codec.EncodeDecimal(nil, d.GetMysqlDecimal(), d.Length(), d.Frac())
+		if err != nil {
+			logutil.BgLogger().Error("encode decimal", zap.Error(err))
+			return tp, nil, false
+		}
 	default:
 		return tp, nil, false
 	}
@@ -195,6 +203,10 @@ func (pc PbConverter) columnToPBExpr(column *Column) *tipb.Expr {
 	switch column.GetType().Tp {
 	case mysql.TypeBit, mysql.TypeSet, mysql.TypeEnum, mysql.TypeGeometry, mysql.TypeUnspecified:
 		return nil
+	// The time values are evaluated by the cast functions which are not supported by the
+	// coprocessor, so the expressions on the time columns are kept in TiDB.
+	case mysql.TypeDate, mysql.TypeDatetime, mysql.TypeTimestamp, mysql.TypeDuration:
+		return nil
 	}
 
 	if pc.client.IsRequestTypeSupported(kv.ReqTypeDAG, kv.ReqSubTypeBasic) {
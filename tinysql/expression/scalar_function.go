@@ -236,6 +236,12 @@ func (sf *ScalarFunction) Eval(row chunk.Row) (d types.Datum, err error) {
 		res, isNull, err = sf.EvalReal(sf.GetCtx(), row)
 	case types.ETString:
 		res, isNull, err = sf.EvalString(sf.GetCtx(), row)
+	case types.ETDecimal:
+		res, isNull, err = sf.EvalDecimal(sf.GetCtx(), row)
+	case types.ETDatetime, types.ETTimestamp:
+		res, isNull, err = sf.EvalTime(sf.GetCtx(), row)
+	case types.ETDuration:
+		res, isNull, err = sf.EvalDuration(sf.GetCtx(), row)
 	}
 
 	if isNull || err != nil {
@@ -261,6 +267,21 @@ func (sf *ScalarFunction) EvalString(ctx sessionctx.Context, row chunk.Row) (str
 	return sf.Function.evalString(row)
 }
 
+// EvalDecimal implements Expression interface.
+func (sf *ScalarFunction) EvalDecimal(ctx sessionctx.Context, row chunk.Row) (*types.MyDecimal, bool, error) {
+	return sf.Function.evalDecimal(row)
+}
+
+// EvalTime implements Expression interface.
+func (sf *ScalarFunction) EvalTime(ctx sessionctx.Context, row chunk.Row) (types.Time, bool, error) {
+	return sf.Function.evalTime(row)
+}
+
+// EvalDuration implements Expression interface.
+func (sf *ScalarFunction) EvalDuration(ctx sessionctx.Context, row chunk.Row) (types.Duration, bool, error) {
+	return sf.Function.evalDuration(row)
+}
+
 // HashCode implements Expression interface.
 func (sf *ScalarFunction) HashCode(sc *stmtctx.StatementContext) []byte {
 	if len(sf.hashcode) > 0 {
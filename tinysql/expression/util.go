@@ -118,6 +118,19 @@ func extractColumns(result []*Column, expr Expression, filter func(*Column) bool
 	return result
 }
 
+// ExtractCorColumns extracts correlated column from given expression.
+func ExtractCorColumns(expr Expression) (cols []*CorrelatedColumn) {
+	switch v := expr.(type) {
+	case *CorrelatedColumn:
+		return []*CorrelatedColumn{v}
+	case *ScalarFunction:
+		for _, arg := range v.GetArgs() {
+			cols = append(cols, ExtractCorColumns(arg)...)
+		}
+	}
+	return
+}
+
 // ExtractColumnSet extracts the different values of `UniqueId` for columns in expressions.
 func ExtractColumnSet(exprs []Expression) *intsets.Sparse {
 	set := &intsets.Sparse{}
@@ -155,6 +168,9 @@ func ColumnSubstituteImpl(expr Expression, schema *Schema, newExprs []Expression
 			return false, v
 		}
 		newExpr := newExprs[id]
+		if v.InOperand {
+			newExpr = SetExprColumnInOperand(newExpr)
+		}
 		return true, newExpr
 	case *ScalarFunction:
 		// cowExprRef is a copy-on-write util, args array allocation happens only
@@ -175,6 +191,22 @@ func ColumnSubstituteImpl(expr Expression, schema *Schema, newExprs []Expression
 	return false, expr
 }
 
+// SetExprColumnInOperand is used to set columns in expr as InOperand.
+func SetExprColumnInOperand(expr Expression) Expression {
+	switch v := expr.(type) {
+	case *Column:
+		col := v.Clone().(*Column)
+		col.InOperand = true
+		return col
+	case *ScalarFunction:
+		args := v.GetArgs()
+		for i, arg := range args {
+			args[i] = SetExprColumnInOperand(arg)
+		}
+	}
+	return expr
+}
+
 var oppositeOp = map[string]string{
 	ast.LT:       ast.GE,
 	ast.GE:       ast.LT,
@@ -59,6 +59,10 @@ func (b *Builder) ApplyDiff(m *meta.Meta, diff *model.SchemaDiff) ([]int64, erro
 	case model.ActionDropTable:
 		oldTableID = diff.TableID
 		tblIDs = append(tblIDs, oldTableID)
+	case model.ActionTruncateTable:
+		oldTableID = diff.OldTableID
+		newTableID = diff.TableID
+		tblIDs = append(tblIDs, oldTableID, newTableID)
 	default:
 		oldTableID = diff.TableID
 		newTableID = diff.TableID
@@ -70,10 +74,23 @@ func (b *Builder) ApplyDiff(m *meta.Meta, diff *model.SchemaDiff) ([]int64, erro
 	// We try to reuse the old allocator, so the cached auto ID can be reused.
 	var alloc autoid.Allocator
 	if tableIDIsValid(oldTableID) {
-		if oldTableID == newTableID && diff.Type != model.ActionRebaseAutoID {
+		renameAcrossSchemas := diff.Type == model.ActionRenameTable && diff.OldSchemaID != diff.SchemaID
+		if oldTableID == newTableID && diff.Type != model.ActionRebaseAutoID && !renameAcrossSchemas {
 			alloc, _ = b.is.AllocByID(oldTableID)
 		}
-		b.applyDropTable(dbInfo, oldTableID)
+		if renameAcrossSchemas {
+			// The table is moved from another schema, remove it from there.
+			oldRoDBInfo, ok := b.is.SchemaByID(diff.OldSchemaID)
+			if !ok {
+				return nil, ErrDatabaseNotExists.GenWithStackByArgs(
+					fmt.Sprintf("(Schema ID %d)", diff.OldSchemaID),
+				)
+			}
+			oldDBInfo := b.copySchemaTables(oldRoDBInfo.Name.L)
+			b.applyDropTable(oldDBInfo, oldTableID)
+		} else {
+			b.applyDropTable(dbInfo, oldTableID)
+		}
 	}
 	if tableIDIsValid(newTableID) {
 		// All types except DropTableOrView.
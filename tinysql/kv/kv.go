@@ -47,6 +47,12 @@ const (
 	SnapshotTS
 	// Set replica read
 	ReplicaRead
+	// Pessimistic is defined for pessimistic lock
+	Pessimistic
+	// EnableAsyncCommit indicates whether to commit the transaction with async commit.
+	EnableAsyncCommit
+	// Enable1PC indicates whether to commit the transaction with one phase commit if it's in a single region.
+	Enable1PC
 )
 
 // Priority value for transaction priority.
@@ -87,11 +93,14 @@ const (
 	ReplicaReadLeader ReplicaReadType = 1 << iota
 	// ReplicaReadFollower stands for 'read from follower'.
 	ReplicaReadFollower
+	// ReplicaReadStale stands for 'read from the applied state of follower', the data may be stale, so it should be
+	// used to read a snapshot at a past timestamp.
+	ReplicaReadStale
 )
 
-// IsFollowerRead checks if leader is going to be used to read data.
+// IsFollowerRead checks if follower is going to be used to read data.
 func (r ReplicaReadType) IsFollowerRead() bool {
-	return r == ReplicaReadFollower
+	return r == ReplicaReadFollower || r == ReplicaReadStale
 }
 
 // Those limits is enforced to make sure the transaction can be well handled by TiKV.
@@ -231,7 +240,7 @@ type Request struct {
 	NotFillCache bool
 	// SyncLog decides whether the WAL(write-ahead log) of this request should be synchronized.
 	SyncLog bool
-	// ReplicaRead is used for reading data from replicas, only follower is supported at this time.
+	// ReplicaRead is used for reading data from replicas, the followers or their applied state.
 	ReplicaRead ReplicaReadType
 }
 
@@ -26,11 +26,14 @@ var (
 	_ ExprNode = &BetweenExpr{}
 	_ ExprNode = &BinaryOperationExpr{}
 	_ ExprNode = &ColumnNameExpr{}
+	_ ExprNode = &CompareSubqueryExpr{}
 	_ ExprNode = &DefaultExpr{}
+	_ ExprNode = &ExistsSubqueryExpr{}
 	_ ExprNode = &IsNullExpr{}
 	_ ExprNode = &ParenthesesExpr{}
 	_ ExprNode = &PatternInExpr{}
 	_ ExprNode = &RowExpr{}
+	_ ExprNode = &SubqueryExpr{}
 	_ ExprNode = &UnaryOperationExpr{}
 	_ ExprNode = &ValuesExpr{}
 	_ ExprNode = &VariableExpr{}
@@ -150,6 +153,78 @@ func (n *BinaryOperationExpr) Accept(v Visitor) (Node, bool) {
 	return v.Leave(n)
 }
 
+// SubqueryExpr represents a subquery.
+type SubqueryExpr struct {
+	exprNode
+	// Query is the query SelectNode.
+	Query      ResultSetNode
+	Evaluated  bool
+	Correlated bool
+	MultiRows  bool
+	Exists     bool
+}
+
+// Format the ExprNode into a Writer.
+func (n *SubqueryExpr) Format(w io.Writer) {
+	panic("Not implemented")
+}
+
+// Accept implements Node Accept interface.
+func (n *SubqueryExpr) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*SubqueryExpr)
+	node, ok := n.Query.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.Query = node.(ResultSetNode)
+	return v.Leave(n)
+}
+
+// CompareSubqueryExpr is the expression for "expr cmp (select ...)".
+// See https://dev.mysql.com/doc/refman/5.7/en/comparisons-using-subqueries.html
+// See https://dev.mysql.com/doc/refman/5.7/en/any-in-some-subqueries.html
+// See https://dev.mysql.com/doc/refman/5.7/en/all-subqueries.html
+type CompareSubqueryExpr struct {
+	exprNode
+	// L is the left expression
+	L ExprNode
+	// Op is the comparison opcode.
+	Op opcode.Op
+	// R is the subquery for right expression, may be rewritten to other type of expression.
+	R ExprNode
+	// All is true, we should compare all records in subquery.
+	All bool
+}
+
+// Format the ExprNode into a Writer.
+func (n *CompareSubqueryExpr) Format(w io.Writer) {
+	panic("Not implemented")
+}
+
+// Accept implements Node Accept interface.
+func (n *CompareSubqueryExpr) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*CompareSubqueryExpr)
+	node, ok := n.L.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.L = node.(ExprNode)
+	node, ok = n.R.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.R = node.(ExprNode)
+	return v.Leave(n)
+}
+
 // ColumnName represents column name.
 type ColumnName struct {
 	node
@@ -256,6 +331,36 @@ func (n *DefaultExpr) Accept(v Visitor) (Node, bool) {
 	return v.Leave(n)
 }
 
+// ExistsSubqueryExpr is the expression for "exists (select ...)".
+// See https://dev.mysql.com/doc/refman/5.7/en/exists-and-not-exists-subqueries.html
+type ExistsSubqueryExpr struct {
+	exprNode
+	// Sel is the subquery, may be rewritten to other type of expression.
+	Sel ExprNode
+	// Not is true, the expression is "not exists".
+	Not bool
+}
+
+// Format the ExprNode into a Writer.
+func (n *ExistsSubqueryExpr) Format(w io.Writer) {
+	panic("Not implemented")
+}
+
+// Accept implements Node Accept interface.
+func (n *ExistsSubqueryExpr) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*ExistsSubqueryExpr)
+	node, ok := n.Sel.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.Sel = node.(ExprNode)
+	return v.Leave(n)
+}
+
 // PatternInExpr is the expression for in operator, like "expr in (1, 2, 3)" or "expr in (select c from t)".
 type PatternInExpr struct {
 	exprNode
@@ -265,6 +370,8 @@ type PatternInExpr struct {
 	List []ExprNode
 	// Not is true, the expression is "not in".
 	Not bool
+	// Sel is the subquery, may be rewritten to other type of expression.
+	Sel ExprNode
 }
 
 // Format the ExprNode into a Writer.
@@ -303,6 +410,13 @@ func (n *PatternInExpr) Accept(v Visitor) (Node, bool) {
 		}
 		n.List[i] = node.(ExprNode)
 	}
+	if n.Sel != nil {
+		node, ok = n.Sel.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Sel = node.(ExprNode)
+	}
 	return v.Leave(n)
 }
 
@@ -73,8 +73,11 @@ func (tc *testExpressionsSuite) TestExpresionsVisitorCover(c *C) {
 			{&BetweenExpr{Expr: ce, Left: ce, Right: ce}, 3, 3},
 			{&BinaryOperationExpr{L: ce, R: ce}, 2, 2},
 			{&ColumnNameExpr{Name: &ColumnName{}}, 0, 0},
+			{&CompareSubqueryExpr{L: ce, R: ce}, 2, 2},
 			{&DefaultExpr{Name: &ColumnName{}}, 0, 0},
+			{&ExistsSubqueryExpr{Sel: ce}, 1, 1},
 			{&IsNullExpr{Expr: ce}, 1, 1},
+			{&PatternInExpr{Expr: ce, Sel: ce}, 2, 2},
 			{&ParenthesesExpr{Expr: ce}, 1, 1},
 			{&RowExpr{Values: []ExprNode{ce, ce}}, 2, 2},
 			{&UnaryOperationExpr{V: ce}, 1, 1},
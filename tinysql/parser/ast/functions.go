@@ -52,6 +52,23 @@ const (
 	SetVar      = "setvar"
 	GetVar      = "getvar"
 	Values      = "values"
+
+	// time functions.
+	AddDate          = "adddate"
+	CurrentDate      = "current_date"
+	CurrentTimestamp = "current_timestamp"
+	Curdate          = "curdate"
+	DateAdd          = "date_add"
+	DateDiff         = "datediff"
+	DateSub          = "date_sub"
+	Extract          = "extract"
+	LocalTime        = "localtime"
+	LocalTimestamp   = "localtimestamp"
+	Now              = "now"
+	SubDate          = "subdate"
+
+	// cast function, it is only built internally to convert the time values.
+	Cast = "cast"
 )
 
 // FuncCallExpr is for function expression.
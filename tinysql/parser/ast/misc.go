@@ -84,10 +84,18 @@ func (n *ExplainStmt) Accept(v Visitor) (Node, bool) {
 	return v.Leave(n)
 }
 
+// Transaction mode constants.
+const (
+	Optimistic  = "OPTIMISTIC"
+	Pessimistic = "PESSIMISTIC"
+)
+
 // BeginStmt is a statement to start a new transaction.
 // See https://dev.mysql.com/doc/refman/5.7/en/commit.html
 type BeginStmt struct {
 	stmtNode
+	// Mode is the transaction mode, an empty Mode means the default optimistic mode.
+	Mode string
 }
 
 // Accept implements Node Accept interface.
@@ -50,6 +50,7 @@ func (ts *testMiscSuite) TestSensitiveStatement(c *C) {
 		&DropDatabaseStmt{},
 		&DropIndexStmt{},
 		&DropTableStmt{},
+		&RenameTableStmt{},
 		&TruncateTableStmt{},
 	}
 	for _, stmt := range negative {
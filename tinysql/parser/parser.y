@@ -695,6 +695,7 @@ import (
 	BitExpr				"bit expression"
 	SimpleExpr			"simple expression"
 	SimpleIdent			"Simple Identifier expression"
+	SubSelect			"Sub Select"
 	SumExpr				"aggregate functions"
 	FunctionCallGeneric		"Function call with Identifier"
 	FunctionCallKeyword		"Function call with keyword as function name"
@@ -729,12 +730,14 @@ import (
 	ExplainableStmt			"explainable statement"
 	InsertIntoStmt			"INSERT INTO statement"
 	SelectStmt			"SELECT statement"
+	RenameTableStmt			"rename table statement"
 	ReplaceIntoStmt			"REPLACE INTO statement"
 	RollbackStmt			"ROLLBACK statement"
 	SetStmt				"Set variable statement"
 	ShowStmt			"Show engines/databases/tables/user/columns/warnings/status statement"
 	Statement			"statement"
 	TruncateTableStmt		"TRUNCATE TABLE statement"
+	UpdateStmt			"UPDATE statement"
 	UseStmt				"USE statement"
 
 %type   <item>
@@ -860,6 +863,8 @@ import (
 	TableName			"Table name"
 	TableNameOptWild		"Table name with optional wildcard"
 	TableNameList			"Table name list"
+	TableToTable			"rename table to table"
+	TableToTableList		"rename table to table by list"
 	TableNameListOpt		"Table name list opt"
 	TableRef 			"table reference"
 	TableRefs 			"table references"
@@ -961,6 +966,7 @@ import (
 	FunctionNameDatetimePrecision	"Function with optional datetime precision, all of them are reserved keywords."
 	FunctionNameDateArith		"Date arith function call names (date_add or date_sub)"
 	FunctionNameDateArithMultiForms	"Date arith function call names (adddate or subdate)"
+	TimeUnit			"Time unit for 'DATE_ADD', 'DATE_SUB', 'ADDDATE', 'SUBDATE', 'EXTRACT'"
 	VariableName			"A simple Identifier like xx or the xx.xx form"
 
 %precedence empty
@@ -1206,6 +1212,27 @@ AlterTableSpec:
 			NewColumnName:    $5.(*ast.ColumnName),
 		}
 	}
+|	"RENAME" "TO" TableName
+	{
+		$$ = &ast.AlterTableSpec{
+			Tp:    		ast.AlterTableRenameTable,
+			NewTable:	$3.(*ast.TableName),
+		}
+	}
+|	"RENAME" "AS" TableName
+	{
+		$$ = &ast.AlterTableSpec{
+			Tp:    		ast.AlterTableRenameTable,
+			NewTable:	$3.(*ast.TableName),
+		}
+	}
+|	"RENAME" TableName
+	{
+		$$ = &ast.AlterTableSpec{
+			Tp:    		ast.AlterTableRenameTable,
+			NewTable:	$2.(*ast.TableName),
+		}
+	}
 |	"RENAME" KeyOrIndex Identifier "TO" Identifier
 	{
 		$$ = &ast.AlterTableSpec{
@@ -1401,6 +1428,18 @@ BeginTransactionStmt:
 	{
 		$$ = &ast.BeginStmt{}
 	}
+|	"BEGIN" "PESSIMISTIC"
+	{
+		$$ = &ast.BeginStmt{
+			Mode: ast.Pessimistic,
+		}
+	}
+|	"BEGIN" "OPTIMISTIC"
+	{
+		$$ = &ast.BeginStmt{
+			Mode: ast.Optimistic,
+		}
+	}
 |	"START" "TRANSACTION"
 	{
 		$$ = &ast.BeginStmt{}
@@ -2033,6 +2072,57 @@ LikeTableWithOrWithoutParen:
 		$$ = $3
 	}
 
+/*******************************************************************
+ *
+ *  Update Statement
+ *  See https://dev.mysql.com/doc/refman/5.7/en/update.html
+ *
+ *******************************************************************/
+UpdateStmt:
+	"UPDATE" TableOptimizerHints PriorityOpt TableRef "SET" AssignmentList WhereClauseOptional OrderByOptional LimitClause
+	{
+		var refs *ast.Join
+		if x, ok := $4.(*ast.Join); ok {
+			refs = x
+		} else {
+			refs = &ast.Join{Left: $4.(ast.ResultSetNode)}
+		}
+		st := &ast.UpdateStmt{
+			Priority:  $3.(mysql.PriorityEnum),
+			TableRefs: &ast.TableRefsClause{TableRefs: refs},
+			List:	   $6.([]*ast.Assignment),
+		}
+		if $2 != nil {
+			st.TableHints = $2.([]*ast.TableOptimizerHint)
+		}
+		if $7 != nil {
+			st.Where = $7.(ast.ExprNode)
+		}
+		if $8 != nil {
+			st.Order = $8.(*ast.OrderByClause)
+		}
+		if $9 != nil {
+			st.Limit = $9.(*ast.Limit)
+		}
+		$$ = st
+	}
+|	"UPDATE" TableOptimizerHints PriorityOpt TableRefs "SET" AssignmentList WhereClauseOptional
+	{
+		st := &ast.UpdateStmt{
+			Priority:      $3.(mysql.PriorityEnum),
+			TableRefs:     &ast.TableRefsClause{TableRefs: $4.(*ast.Join)},
+			List:          $6.([]*ast.Assignment),
+			MultipleTable: true,
+		}
+		if $2 != nil {
+			st.TableHints = $2.([]*ast.TableOptimizerHint)
+		}
+		if $7 != nil {
+			st.Where = $7.(ast.ExprNode)
+		}
+		$$ = st
+	}
+
 /*******************************************************************
  *
  *  Delete Statement
@@ -2196,7 +2286,13 @@ Expression:
 	}
 |	"NOT" Expression %prec not
 	{
-		$$ = &ast.UnaryOperationExpr{Op: opcode.Not, V: $2}
+		expr, ok := $2.(*ast.ExistsSubqueryExpr)
+		if ok {
+			expr.Not = true
+			$$ = $2
+		} else {
+			$$ = &ast.UnaryOperationExpr{Op: opcode.Not, V: $2}
+		}
 	}
 |	BoolPri IsOrNotOp "UNKNOWN" %prec is
 	{
@@ -2253,6 +2349,12 @@ BoolPri:
 	{
 		$$ = &ast.BinaryOperationExpr{Op: $2.(opcode.Op), L: $1, R: $3}
 	}
+|	BoolPri CompareOp AnyOrAll SubSelect %prec eq
+	{
+		sq := $4.(*ast.SubqueryExpr)
+		sq.MultiRows = true
+		$$ = &ast.CompareSubqueryExpr{Op: $2.(opcode.Op), L: $1, R: sq, All: $3.(bool)}
+	}
 |	BoolPri CompareOp singleAtIdentifier assignmentEq PredicateExpr %prec assignmentEq
 	{
 		v := $3
@@ -2350,6 +2452,12 @@ PredicateExpr:
 	{
 		$$ = &ast.PatternInExpr{Expr: $1, Not: !$2.(bool), List: $4.([]ast.ExprNode)}
 	}
+|	BitExpr InOrNotOp SubSelect
+	{
+		sq := $3.(*ast.SubqueryExpr)
+		sq.MultiRows = true
+		$$ = &ast.PatternInExpr{Expr: $1, Not: !$2.(bool), Sel: sq}
+	}
 |	BitExpr BetweenOrNotOp BitExpr "AND" PredicateExpr
 	{
 		$$ = &ast.BetweenExpr{
@@ -3053,6 +3161,13 @@ SimpleExpr:
 		values := append($2.([]ast.ExprNode), $4)
 		$$ = &ast.RowExpr{Values: values}
 	}
+|	SubSelect
+|	"EXISTS" SubSelect
+	{
+		sq := $2.(*ast.SubqueryExpr)
+		sq.Exists = true
+		$$ = &ast.ExistsSubqueryExpr{Sel: sq}
+	}
 |	"ROW" '(' ExpressionList ',' Expression ')'
 	{
 		values := append($3.([]ast.ExprNode), $5)
@@ -3236,6 +3351,34 @@ FunctionCallNonKeyword:
 			Args: []ast.ExprNode{$5, $3},
 		}
 	}
+|	FunctionNameDateArith '(' Expression ',' "INTERVAL" Expression TimeUnit ')'
+	{
+		$$ = &ast.FuncCallExpr{
+			FnName: model.NewCIStr($1),
+			Args: []ast.ExprNode{$3, $6, ast.NewValueExpr($7)},
+		}
+	}
+|	FunctionNameDateArithMultiForms '(' Expression ',' Expression ')'
+	{
+		$$ = &ast.FuncCallExpr{
+			FnName: model.NewCIStr($1),
+			Args: []ast.ExprNode{$3, $5, ast.NewValueExpr("DAY")},
+		}
+	}
+|	FunctionNameDateArithMultiForms '(' Expression ',' "INTERVAL" Expression TimeUnit ')'
+	{
+		$$ = &ast.FuncCallExpr{
+			FnName: model.NewCIStr($1),
+			Args: []ast.ExprNode{$3, $6, ast.NewValueExpr($7)},
+		}
+	}
+|	builtinExtract '(' TimeUnit "FROM" Expression ')'
+	{
+		$$ = &ast.FuncCallExpr{
+			FnName: model.NewCIStr($1),
+			Args: []ast.ExprNode{ast.NewValueExpr($3), $5},
+		}
+	}
 
 FunctionNameDateArith:
 	builtinDateAdd
@@ -3246,6 +3389,28 @@ FunctionNameDateArithMultiForms:
 	builtinAddDate
 |	builtinSubDate
 
+TimeUnit:
+	"MICROSECOND"
+|	"SECOND"
+|	"MINUTE"
+|	"HOUR"
+|	"DAY"
+|	"WEEK"
+|	"MONTH"
+|	"QUARTER"
+|	"YEAR"
+|	"SECOND_MICROSECOND"
+|	"MINUTE_MICROSECOND"
+|	"MINUTE_SECOND"
+|	"HOUR_MICROSECOND"
+|	"HOUR_SECOND"
+|	"HOUR_MINUTE"
+|	"DAY_MICROSECOND"
+|	"DAY_SECOND"
+|	"DAY_MINUTE"
+|	"DAY_HOUR"
+|	"YEAR_MONTH"
+
 SumExpr:
 	"AVG" '(' Expression ')'
 	{
@@ -3632,6 +3797,18 @@ SelectStmt:
 		$$ = st
 	}
 
+SubSelect:
+	'(' SelectStmt ')'
+	{
+		s := $2.(*ast.SelectStmt)
+		endOffset := parser.endOffset(&yyS[yypt])
+		parser.setLastSelectFieldText(s, endOffset)
+		src := parser.src
+		// See the implementation of yyParse function
+		s.SetText(src[yyS[yypt-1].offset:yyS[yypt].offset])
+		$$ = &ast.SubqueryExpr{Query: s}
+	}
+
 FromDual:
 	"FROM" "DUAL"
 
@@ -4543,12 +4720,14 @@ Statement:
 |	DropIndexStmt
 |	DropTableStmt
 |	InsertIntoStmt
+|	RenameTableStmt
 |	RollbackStmt
 |	ReplaceIntoStmt
 |	SelectStmt
 |	SetStmt
 |	ShowStmt
 |	TruncateTableStmt
+|	UpdateStmt
 |	UseStmt
 
 ExplainableStmt:
@@ -4556,6 +4735,7 @@ ExplainableStmt:
 |	DeleteFromStmt
 |	InsertIntoStmt
 |	ReplaceIntoStmt
+|	UpdateStmt
 
 StatementList:
 	Statement
@@ -4650,6 +4830,38 @@ OptTable:
 	{}
 |	"TABLE"
 
+/*******************************************************************
+ *
+ *  Rename Table Statement
+ *
+ *  Example:
+ *      RENAME TABLE t1 TO t2, db1.t3 TO db2.t4
+ *******************************************************************/
+RenameTableStmt:
+	"RENAME" "TABLE" TableToTableList
+	{
+		$$ = &ast.RenameTableStmt{TableToTables: $3.([]*ast.TableToTable)}
+	}
+
+TableToTableList:
+	TableToTable
+	{
+		$$ = []*ast.TableToTable{$1.(*ast.TableToTable)}
+	}
+|	TableToTableList ',' TableToTable
+	{
+		$$ = append($1.([]*ast.TableToTable), $3.(*ast.TableToTable))
+	}
+
+TableToTable:
+	TableName "TO" TableName
+	{
+		$$ = &ast.TableToTable{
+			OldTable: $1.(*ast.TableName),
+			NewTable: $3.(*ast.TableName),
+		}
+	}
+
 TruncateTableStmt:
 	"TRUNCATE" OptTable TableName
 	{
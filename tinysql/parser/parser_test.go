@@ -350,6 +350,8 @@ func (s *testParserSuite) TestDMLStmt(c *C) {
 		{"REPLACE INTO foo () VALUES ()", true, "REPLACE INTO `foo` () VALUES ()"},
 		{"REPLACE INTO foo VALUE ()", true, "REPLACE INTO `foo` VALUES ()"},
 		{"BEGIN", true, "START TRANSACTION"},
+		{"BEGIN PESSIMISTIC", true, "BEGIN PESSIMISTIC"},
+		{"BEGIN OPTIMISTIC", true, "BEGIN OPTIMISTIC"},
 		// 45
 		{"COMMIT", true, "COMMIT"},
 		{"ROLLBACK", true, "ROLLBACK"},
@@ -392,6 +394,16 @@ func (s *testParserSuite) TestDMLStmt(c *C) {
 		{"DELETE t1, t2 FROM t1 INNER JOIN t2 INNER JOIN t3 WHERE t1.id=t2.id AND t2.id=t3.id limit 10;", false, ""},
 		{"DELETE t1, t2 FROM t1 INNER JOIN t2 INNER JOIN t3 WHERE t1.id=t2.id AND t2.id=t3.id order by t1.id;", false, ""},
 
+		// update statement
+		{"UPDATE t SET id = id + 1 ORDER BY id DESC;", true, "UPDATE `t` SET `id`=`id`+1 ORDER BY `id` DESC"},
+		{"UPDATE items,month SET items.price=month.price WHERE items.id=month.id;", true, "UPDATE (`items`) JOIN `month` SET `items`.`price`=`month`.`price` WHERE `items`.`id`=`month`.`id`"},
+		{"UPDATE user T0 LEFT OUTER JOIN user_profile T1 ON T1.id = T0.profile_id SET T0.profile_id = 1 WHERE T0.profile_id IN (1);", true, "UPDATE `user` AS `T0` LEFT JOIN `user_profile` AS `T1` ON `T1`.`id`=`T0`.`profile_id` SET `T0`.`profile_id`=1 WHERE `T0`.`profile_id` IN (1)"},
+		{"UPDATE LOW_PRIORITY t1 SET a = 1 WHERE b = 2 LIMIT 10", true, "UPDATE LOW_PRIORITY `t1` SET `a`=1 WHERE `b`=2 LIMIT 10"},
+		{"UPDATE t1 SET a = DEFAULT, b = b * 2", true, "UPDATE `t1` SET `a`=DEFAULT,`b`=`b`*2"},
+		{"UPDATE t1, t2 SET t1.a = 1 ORDER BY t1.a", false, ""},
+		{"UPDATE t1, t2 SET t1.a = 1 LIMIT 1", false, ""},
+		{"UPDATE t1 SET", false, ""},
+
 		// for admin
 		{"admin show ddl;", true, "ADMIN SHOW DDL"},
 		{"admin show ddl jobs;", true, "ADMIN SHOW DDL JOBS"},
@@ -406,6 +418,19 @@ func (s *testParserSuite) TestDMLStmt(c *C) {
 		// for select with where clause
 		{"SELECT * FROM t WHERE 1 = 1", true, "SELECT * FROM `t` WHERE 1=1"},
 
+		// for subquery
+		{"SELECT * FROM t WHERE a IN (SELECT b FROM s)", true, "SELECT * FROM `t` WHERE `a` IN (SELECT `b` FROM `s`)"},
+		{"SELECT * FROM t WHERE a NOT IN (SELECT b FROM s WHERE s.c = t.c)", true, "SELECT * FROM `t` WHERE `a` NOT IN (SELECT `b` FROM `s` WHERE `s`.`c`=`t`.`c`)"},
+		{"SELECT * FROM t WHERE EXISTS (SELECT * FROM s WHERE s.b = t.a)", true, "SELECT * FROM `t` WHERE EXISTS (SELECT * FROM `s` WHERE `s`.`b`=`t`.`a`)"},
+		{"SELECT * FROM t WHERE NOT EXISTS (SELECT * FROM s)", true, "SELECT * FROM `t` WHERE NOT EXISTS (SELECT * FROM `s`)"},
+		{"SELECT a, (SELECT MAX(b) FROM s WHERE s.c = t.c) FROM t", true, "SELECT `a`,(SELECT MAX(`b`) FROM `s` WHERE `s`.`c`=`t`.`c`) FROM `t`"},
+		{"SELECT * FROM t WHERE a > (SELECT 1)", true, "SELECT * FROM `t` WHERE `a`>(SELECT 1)"},
+		{"SELECT * FROM t WHERE a > ANY (SELECT b FROM s)", true, "SELECT * FROM `t` WHERE `a`>ANY (SELECT `b` FROM `s`)"},
+		{"SELECT * FROM t WHERE a = SOME (SELECT b FROM s)", true, "SELECT * FROM `t` WHERE `a`=ANY (SELECT `b` FROM `s`)"},
+		{"SELECT * FROM t WHERE a <> ALL (SELECT b FROM s)", true, "SELECT * FROM `t` WHERE `a`!=ALL (SELECT `b` FROM `s`)"},
+		{"SELECT * FROM t WHERE a IN (SELECT b FROM s", false, ""},
+		{"SELECT * FROM t WHERE EXISTS SELECT b FROM s", false, ""},
+
 		// for dual
 		{"select 1 from dual", true, "SELECT 1"},
 		{"select 1 from dual limit 1", true, "SELECT 1 LIMIT 1"},
@@ -738,6 +763,22 @@ func (s *testParserSuite) TestBuiltin(c *C) {
 		{"SELECT ADDTIME();", true, "SELECT ADDTIME()"},
 		{"SELECT SUBTIME('01:00:00.999999', '02:00:00.999998');", true, "SELECT SUBTIME('01:00:00.999999', '02:00:00.999998')"},
 
+		// for DATE_ADD, DATE_SUB, ADDDATE, SUBDATE
+		{"SELECT DATE_ADD('2008-01-02', INTERVAL 31 DAY);", true, "SELECT DATE_ADD('2008-01-02', INTERVAL 31 DAY)"},
+		{"SELECT DATE_ADD('2008-01-02', INTERVAL '1 1' DAY_HOUR);", true, "SELECT DATE_ADD('2008-01-02', INTERVAL '1 1' DAY_HOUR)"},
+		{"SELECT DATE_SUB('2008-01-02', INTERVAL '1-1' YEAR_MONTH);", true, "SELECT DATE_SUB('2008-01-02', INTERVAL '1-1' YEAR_MONTH)"},
+		{"SELECT ADDDATE('2008-01-02', 31);", true, "SELECT ADDDATE('2008-01-02', INTERVAL 31 DAY)"},
+		{"SELECT SUBDATE('2008-01-02', INTERVAL 1 MICROSECOND);", true, "SELECT SUBDATE('2008-01-02', INTERVAL 1 MICROSECOND)"},
+		{"SELECT DATE_ADD('2008-01-02', 31);", false, ""},
+		{"SELECT DATE_ADD('2008-01-02', INTERVAL 31);", false, ""},
+		{"SELECT DATE_ADD('2008-01-02', INTERVAL 31 CENTURY);", false, ""},
+
+		// for EXTRACT
+		{"SELECT EXTRACT(YEAR FROM '2009-07-02');", true, "SELECT EXTRACT(YEAR FROM '2009-07-02')"},
+		{"SELECT EXTRACT(DAY_MINUTE FROM '2009-07-02 01:02:03');", true, "SELECT EXTRACT(DAY_MINUTE FROM '2009-07-02 01:02:03')"},
+		{"SELECT EXTRACT(MICROSECOND FROM '2003-01-02 10:30:00.000123');", true, "SELECT EXTRACT(MICROSECOND FROM '2003-01-02 10:30:00.000123')"},
+		{"SELECT EXTRACT(YEAR, '2009-07-02');", false, ""},
+
 		// for CONVERT_TZ
 		{"SELECT CONVERT_TZ();", true, "SELECT CONVERT_TZ()"},
 		{"SELECT CONVERT_TZ('2004-01-01 12:00:00','+00:00','+10:00');", true, "SELECT CONVERT_TZ('2004-01-01 12:00:00', '+00:00', '+10:00')"},
@@ -1065,6 +1106,9 @@ func (s *testParserSuite) TestDDL(c *C) {
 		// For #6405
 		{"ALTER TABLE t RENAME KEY a TO b;", true, "ALTER TABLE `t` RENAME INDEX `a` TO `b`"},
 		{"ALTER TABLE t RENAME INDEX a TO b;", true, "ALTER TABLE `t` RENAME INDEX `a` TO `b`"},
+		{"ALTER TABLE t RENAME TO t1;", true, "ALTER TABLE `t` RENAME AS `t1`"},
+		{"ALTER TABLE t RENAME AS db.t1;", true, "ALTER TABLE `t` RENAME AS `db`.`t1`"},
+		{"ALTER TABLE t RENAME t1;", true, "ALTER TABLE `t` RENAME AS `t1`"},
 		// For create index statement
 		{"CREATE INDEX idx ON t (a)", true, "CREATE INDEX `idx` ON `t` (`a`)"},
 		{"CREATE INDEX IF NOT EXISTS idx ON t (a)", true, "CREATE INDEX IF NOT EXISTS `idx` ON `t` (`a`)"},
@@ -1106,6 +1150,12 @@ func (s *testParserSuite) TestDDL(c *C) {
 		{"TRUNCATE TABLE t1", true, "TRUNCATE TABLE `t1`"},
 		{"TRUNCATE t1", true, "TRUNCATE TABLE `t1`"},
 
+		// for rename table statement
+		{"RENAME TABLE t TO t1", true, "RENAME TABLE `t` TO `t1`"},
+		{"RENAME TABLE t t1", false, ""},
+		{"RENAME TABLE d.t TO d1.t1", true, "RENAME TABLE `d`.`t` TO `d1`.`t1`"},
+		{"RENAME TABLE t1 TO t2, t2 TO t3", true, "RENAME TABLE `t1` TO `t2`, `t2` TO `t3`"},
+
 		// for empty alert table index
 		{"ALTER TABLE t ADD INDEX () ", false, ""},
 		{"ALTER TABLE t ADD UNIQUE ()", false, ""},
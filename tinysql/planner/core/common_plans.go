@@ -68,6 +68,19 @@ type Insert struct {
 	AllAssignmentsAreConstant bool
 }
 
+// Update represents Update plan.
+type Update struct {
+	baseSchemaProducer
+
+	OrderedList []*expression.Assignment
+
+	AllAssignmentsAreConstant bool
+
+	SelectPlan PhysicalPlan
+
+	TblColPosInfos TblColPosInfoSlice
+}
+
 // Delete represents a delete plan.
 type Delete struct {
 	baseSchemaProducer
@@ -205,6 +218,10 @@ func (e *Explain) explainPlanInRowFormat(p Plan, taskType, indent string, isLast
 		if x.SelectPlan != nil {
 			err = e.explainPlanInRowFormat(x.SelectPlan, "root", childIndent, true)
 		}
+	case *Update:
+		if x.SelectPlan != nil {
+			err = e.explainPlanInRowFormat(x.SelectPlan, "root", childIndent, true)
+		}
 	case *Delete:
 		if x.SelectPlan != nil {
 			err = e.explainPlanInRowFormat(x.SelectPlan, "root", childIndent, true)
@@ -250,7 +250,7 @@ func (p *LogicalJoin) getHashJoins(prop *property.PhysicalProperty) []PhysicalPl
 	}
 	joins := make([]PhysicalPlan, 0, 2)
 	switch p.JoinType {
-	case LeftOuterJoin:
+	case SemiJoin, AntiSemiJoin, LeftOuterSemiJoin, AntiLeftOuterSemiJoin, LeftOuterJoin:
 		joins = append(joins, p.getHashJoin(prop, 1))
 	case RightOuterJoin:
 		joins = append(joins, p.getHashJoin(prop, 0))
@@ -377,6 +377,30 @@ func (lt *LogicalTopN) exhaustPhysicalPlans(prop *property.PhysicalProperty) []P
 	return nil
 }
 
+func (la *LogicalApply) exhaustPhysicalPlans(prop *property.PhysicalProperty) []PhysicalPlan {
+	if !prop.AllColsFromSchema(la.children[0].Schema()) { // for convenient, we don't pass through any prop
+		return nil
+	}
+	join := la.getHashJoin(prop, 1)
+	apply := PhysicalApply{
+		PhysicalHashJoin: *join,
+		OuterSchema:      la.corCols,
+	}.Init(la.ctx,
+		la.stats.ScaleByExpectCnt(prop.ExpectedCnt),
+		&property.PhysicalProperty{ExpectedCnt: math.MaxFloat64, Items: prop.Items},
+		&property.PhysicalProperty{ExpectedCnt: math.MaxFloat64})
+	apply.SetSchema(la.schema)
+	return []PhysicalPlan{apply}
+}
+
+func (p *LogicalMaxOneRow) exhaustPhysicalPlans(prop *property.PhysicalProperty) []PhysicalPlan {
+	if !prop.IsEmpty() {
+		return nil
+	}
+	mor := PhysicalMaxOneRow{}.Init(p.ctx, p.stats, &property.PhysicalProperty{ExpectedCnt: 2})
+	return []PhysicalPlan{mor}
+}
+
 // exhaustPhysicalPlans is only for implementing interface. DataSource and Dual generate task in `findBestTask` directly.
 func (p *baseLogicalPlan) exhaustPhysicalPlans(_ *property.PhysicalProperty) []PhysicalPlan {
 	panic("baseLogicalPlan.exhaustPhysicalPlans() should never be called.")
@@ -246,7 +246,14 @@ func (er *expressionRewriter) Enter(inNode ast.Node) (ast.Node, bool) {
 			er.ctxStackAppend(er.schema.Columns[index], er.names[index])
 			return inNode, true
 		}
+	case *ast.CompareSubqueryExpr:
+		return er.handleCompareSubquery(er.ctx, v)
+	case *ast.ExistsSubqueryExpr:
+		return er.handleExistSubquery(er.ctx, v)
 	case *ast.PatternInExpr:
+		if v.Sel != nil {
+			return er.handleInSubquery(er.ctx, v)
+		}
 		if len(v.List) != 1 {
 			break
 		}
@@ -255,12 +262,17 @@ func (er *expressionRewriter) Enter(inNode ast.Node) (ast.Node, bool) {
 		x := v.List[0]
 		for {
 			switch y := x.(type) {
+			case *ast.SubqueryExpr:
+				v.Sel = y
+				return er.handleInSubquery(er.ctx, v)
 			case *ast.ParenthesesExpr:
 				x = y.Expr
 			default:
 				return inNode, false
 			}
 		}
+	case *ast.SubqueryExpr:
+		return er.handleScalarSubquery(er.ctx, v)
 	case *ast.ParenthesesExpr:
 	case *ast.ValuesExpr:
 		schema, names := er.schema, er.names
@@ -290,6 +302,204 @@ func (er *expressionRewriter) Enter(inNode ast.Node) (ast.Node, bool) {
 	return inNode, false
 }
 
+func (er *expressionRewriter) buildSubquery(ctx context.Context, subq *ast.SubqueryExpr) (LogicalPlan, error) {
+	if er.schema != nil {
+		outerSchema := er.schema.Clone()
+		er.b.outerSchemas = append(er.b.outerSchemas, outerSchema)
+		er.b.outerNames = append(er.b.outerNames, er.names)
+		defer func() {
+			er.b.outerSchemas = er.b.outerSchemas[0 : len(er.b.outerSchemas)-1]
+			er.b.outerNames = er.b.outerNames[0 : len(er.b.outerNames)-1]
+		}()
+	}
+
+	np, err := er.b.buildResultSetNode(ctx, subq.Query)
+	if err != nil {
+		return nil, err
+	}
+	// Pop the handle map generated by the subquery.
+	er.b.handleHelper.popMap()
+	return np, nil
+}
+
+func (er *expressionRewriter) handleCompareSubquery(ctx context.Context, v *ast.CompareSubqueryExpr) (ast.Node, bool) {
+	asScalar := er.asScalar
+	er.asScalar = true
+	v.L.Accept(er)
+	if er.err != nil {
+		return v, true
+	}
+	lexpr := er.ctxStack[len(er.ctxStack)-1]
+	subq, ok := v.R.(*ast.SubqueryExpr)
+	if !ok {
+		er.err = errors.Errorf("Unknown compare type %T.", v.R)
+		return v, true
+	}
+	np, err := er.buildSubquery(ctx, subq)
+	if err != nil {
+		er.err = err
+		return v, true
+	}
+	// Only (a,b,c) = any (...) and (a,b,c) != all (...) can use row expression.
+	canMultiCol := (!v.All && v.Op == opcode.EQ) || (v.All && v.Op == opcode.NE)
+	if !canMultiCol && (expression.GetRowLen(lexpr) != 1 || np.Schema().Len() != 1) {
+		er.err = expression.ErrOperandColumns.GenWithStackByArgs(1)
+		return v, true
+	}
+	lLen := expression.GetRowLen(lexpr)
+	if lLen != np.Schema().Len() {
+		er.err = expression.ErrOperandColumns.GenWithStackByArgs(lLen)
+		return v, true
+	}
+	switch {
+	case v.Op == opcode.EQ && !v.All:
+		// `a = any(subq)` will be rewritten as `a in (subq)`.
+		er.buildSemiJoinForIn(lexpr, np, asScalar, false)
+	case v.Op == opcode.NE && v.All:
+		// `a != all(subq)` will be rewritten as `a not in (subq)`.
+		er.buildSemiJoinForIn(lexpr, np, asScalar, true)
+	default:
+		// `a op any(subq)` is true if `a op b` is true for some row b of the subquery, and it
+		// is rewritten as a semi join on `a op b`.
+		// `a op all(subq)` is true if `a op b` is never false or NULL, and it is rewritten as
+		// an anti semi join on `not(a op b)`.
+		var cond expression.Expression
+		cond, er.err = er.constructBinaryOpFunction(lexpr, np.Schema().Columns[0], v.Op.String())
+		if er.err != nil {
+			return v, true
+		}
+		if v.All {
+			cond, er.err = er.newFunction(ast.UnaryNot, types.NewFieldType(mysql.TypeTiny), cond)
+			if er.err != nil {
+				return v, true
+			}
+		}
+		er.p, er.err = er.b.buildSemiApply(er.p, np, []expression.Expression{cond}, asScalar, v.All)
+	}
+	if er.err != nil {
+		return v, true
+	}
+	er.ctxStackPop(1)
+	if asScalar {
+		er.ctxStackAppend(er.p.Schema().Columns[er.p.Schema().Len()-1], er.p.OutputNames()[er.p.Schema().Len()-1])
+	}
+	return v, true
+}
+
+func (er *expressionRewriter) handleExistSubquery(ctx context.Context, v *ast.ExistsSubqueryExpr) (ast.Node, bool) {
+	subq, ok := v.Sel.(*ast.SubqueryExpr)
+	if !ok {
+		er.err = errors.Errorf("Unknown exists type %T.", v.Sel)
+		return v, true
+	}
+	np, err := er.buildSubquery(ctx, subq)
+	if err != nil {
+		er.err = err
+		return v, true
+	}
+	er.p, er.err = er.b.buildSemiApply(er.p, np, nil, er.asScalar, v.Not)
+	if er.err != nil || !er.asScalar {
+		return v, true
+	}
+	er.ctxStackAppend(er.p.Schema().Columns[er.p.Schema().Len()-1], er.p.OutputNames()[er.p.Schema().Len()-1])
+	return v, true
+}
+
+func (er *expressionRewriter) handleInSubquery(ctx context.Context, v *ast.PatternInExpr) (ast.Node, bool) {
+	asScalar := er.asScalar
+	er.asScalar = true
+	v.Expr.Accept(er)
+	if er.err != nil {
+		return v, true
+	}
+	lexpr := er.ctxStack[len(er.ctxStack)-1]
+	subq, ok := v.Sel.(*ast.SubqueryExpr)
+	if !ok {
+		er.err = errors.Errorf("Unknown compare type %T.", v.Sel)
+		return v, true
+	}
+	np, err := er.buildSubquery(ctx, subq)
+	if err != nil {
+		er.err = err
+		return v, true
+	}
+	lLen := expression.GetRowLen(lexpr)
+	if lLen != np.Schema().Len() {
+		er.err = expression.ErrOperandColumns.GenWithStackByArgs(lLen)
+		return v, true
+	}
+	er.buildSemiJoinForIn(lexpr, np, asScalar, v.Not)
+	if er.err != nil {
+		return v, true
+	}
+	er.ctxStackPop(1)
+	if asScalar {
+		er.ctxStackAppend(er.p.Schema().Columns[er.p.Schema().Len()-1], er.p.OutputNames()[er.p.Schema().Len()-1])
+	}
+	return v, true
+}
+
+// buildSemiJoinForIn builds the semi apply for `lexpr [not] in (np)` on the
+// condition `lexpr = np.cols`.
+func (er *expressionRewriter) buildSemiJoinForIn(lexpr expression.Expression, np LogicalPlan, asScalar, not bool) {
+	var rexpr expression.Expression
+	if np.Schema().Len() == 1 {
+		rexpr = np.Schema().Columns[0]
+		rCol := rexpr.(*expression.Column)
+		// For AntiSemiJoin/LeftOuterSemiJoin/AntiLeftOuterSemiJoin, we cannot treat `in` expression as
+		// normal column equal condition, so we specially mark the inner operand here.
+		if not || asScalar {
+			// If both input columns of `in` expression are not null, we can treat the expression
+			// as normal column equal condition instead.
+			if !mysql.HasNotNullFlag(lexpr.GetType().Flag) || !mysql.HasNotNullFlag(rCol.GetType().Flag) {
+				rColCopy := *rCol
+				rColCopy.InOperand = true
+				rexpr = &rColCopy
+			}
+		}
+	} else {
+		args := make([]expression.Expression, 0, np.Schema().Len())
+		for _, col := range np.Schema().Columns {
+			args = append(args, col)
+		}
+		rexpr, er.err = er.newFunction(ast.RowFunc, args[0].GetType(), args...)
+		if er.err != nil {
+			return
+		}
+	}
+	checkCondition, err := er.constructBinaryOpFunction(lexpr, rexpr, ast.EQ)
+	if err != nil {
+		er.err = err
+		return
+	}
+	er.p, er.err = er.b.buildSemiApply(er.p, np, expression.SplitCNFItems(checkCondition), asScalar, not)
+}
+
+func (er *expressionRewriter) handleScalarSubquery(ctx context.Context, v *ast.SubqueryExpr) (ast.Node, bool) {
+	np, err := er.buildSubquery(ctx, v)
+	if err != nil {
+		er.err = err
+		return v, true
+	}
+	np = er.b.buildMaxOneRow(np)
+	er.p = er.b.buildApplyWithJoinType(er.p, np, LeftOuterJoin)
+	if np.Schema().Len() > 1 {
+		newCols := make([]expression.Expression, 0, np.Schema().Len())
+		for _, col := range er.p.Schema().Columns[er.p.Schema().Len()-np.Schema().Len():] {
+			newCols = append(newCols, col)
+		}
+		expr, err1 := er.newFunction(ast.RowFunc, newCols[0].GetType(), newCols...)
+		if err1 != nil {
+			er.err = err1
+			return v, true
+		}
+		er.ctxStackAppend(expr, types.EmptyName)
+		return v, true
+	}
+	er.ctxStackAppend(er.p.Schema().Columns[er.p.Schema().Len()-1], er.p.OutputNames()[er.p.Schema().Len()-1])
+	return v, true
+}
+
 // Leave implements Visitor interface.
 func (er *expressionRewriter) Leave(originInNode ast.Node) (retNode ast.Node, ok bool) {
 	if er.err != nil {
@@ -300,7 +510,8 @@ func (er *expressionRewriter) Leave(originInNode ast.Node) (retNode ast.Node, ok
 		inNode = er.preprocess(inNode)
 	}
 	switch v := inNode.(type) {
-	case *ast.AggregateFuncExpr, *ast.ColumnNameExpr, *ast.ParenthesesExpr, *ast.ValuesExpr:
+	case *ast.AggregateFuncExpr, *ast.ColumnNameExpr, *ast.ParenthesesExpr, *ast.ValuesExpr, *ast.SubqueryExpr,
+		*ast.CompareSubqueryExpr, *ast.ExistsSubqueryExpr:
 	case *driver.ValueExpr:
 		value := &expression.Constant{Value: v.Datum, RetType: &v.Type}
 		er.ctxStackAppend(value, types.EmptyName)
@@ -319,7 +530,9 @@ func (er *expressionRewriter) Leave(originInNode ast.Node) (retNode ast.Node, ok
 	case *ast.RowExpr:
 		er.rowToScalarFunc(v)
 	case *ast.PatternInExpr:
-		er.inToExpression(len(v.List), v.Not, &v.Type)
+		if v.Sel == nil {
+			er.inToExpression(len(v.List), v.Not, &v.Type)
+		}
 	case *ast.IsNullExpr:
 		er.isNullToExpression(v)
 	case *ast.DefaultExpr:
@@ -633,6 +846,19 @@ func (er *expressionRewriter) toColumn(v *ast.ColumnName) {
 		er.ctxStackAppend(column, er.names[idx])
 		return
 	}
+	for i := len(er.b.outerSchemas) - 1; i >= 0; i-- {
+		outerSchema, outerName := er.b.outerSchemas[i], er.b.outerNames[i]
+		idx, err = expression.FindFieldName(outerName, v)
+		if idx >= 0 {
+			column := outerSchema.Columns[idx]
+			er.ctxStackAppend(&expression.CorrelatedColumn{Column: *column, Data: new(types.Datum)}, outerName[idx])
+			return
+		}
+		if err != nil {
+			er.err = ErrAmbiguous.GenWithStackByArgs(v.Name, clauseMsg[fieldList])
+			return
+		}
+	}
 	if er.b.curClause == globalOrderByClause {
 		er.b.curClause = orderByClause
 	}
@@ -59,6 +59,8 @@ const (
 	TypeDual = "TableDual"
 	// TypeInsert is the type of Insert
 	TypeInsert = "Insert"
+	// TypeUpdate is the type of Update.
+	TypeUpdate = "Update"
 	// TypeDelete is the type of Delete.
 	TypeDelete = "Delete"
 	// TypeIndexLookUp is the type of IndexLookUp.
@@ -192,6 +194,26 @@ func (p PhysicalLimit) Init(ctx sessionctx.Context, stats *property.StatsInfo, p
 	return &p
 }
 
+// Init initializes LogicalApply.
+func (la LogicalApply) Init(ctx sessionctx.Context) *LogicalApply {
+	la.baseLogicalPlan = newBaseLogicalPlan(ctx, TypeApply, &la)
+	return &la
+}
+
+// Init initializes LogicalMaxOneRow.
+func (p LogicalMaxOneRow) Init(ctx sessionctx.Context) *LogicalMaxOneRow {
+	p.baseLogicalPlan = newBaseLogicalPlan(ctx, TypeMaxOneRow, &p)
+	return &p
+}
+
+// Init initializes PhysicalMaxOneRow.
+func (p PhysicalMaxOneRow) Init(ctx sessionctx.Context, stats *property.StatsInfo, props ...*property.PhysicalProperty) *PhysicalMaxOneRow {
+	p.basePhysicalPlan = newBasePhysicalPlan(ctx, TypeMaxOneRow, &p)
+	p.childrenReqProps = props
+	p.stats = stats
+	return &p
+}
+
 // Init initializes LogicalTableDual.
 func (p LogicalTableDual) Init(ctx sessionctx.Context) *LogicalTableDual {
 	p.baseLogicalPlan = newBaseLogicalPlan(ctx, TypeDual, &p)
@@ -205,6 +227,12 @@ func (p PhysicalTableDual) Init(ctx sessionctx.Context, stats *property.StatsInf
 	return &p
 }
 
+// Init initializes Update.
+func (p Update) Init(ctx sessionctx.Context) *Update {
+	p.basePlan = newBasePlan(ctx, TypeUpdate)
+	return &p
+}
+
 // Init initializes Delete.
 func (p Delete) Init(ctx sessionctx.Context) *Delete {
 	p.basePlan = newBasePlan(ctx, TypeDelete)
@@ -282,6 +310,14 @@ func (p PhysicalHashJoin) Init(ctx sessionctx.Context, stats *property.StatsInfo
 	return &p
 }
 
+// Init initializes PhysicalApply.
+func (p PhysicalApply) Init(ctx sessionctx.Context, stats *property.StatsInfo, props ...*property.PhysicalProperty) *PhysicalApply {
+	p.basePhysicalPlan = newBasePhysicalPlan(ctx, TypeApply, &p)
+	p.childrenReqProps = props
+	p.stats = stats
+	return &p
+}
+
 // Init initializes PhysicalMergeJoin.
 func (p PhysicalMergeJoin) Init(ctx sessionctx.Context, stats *property.StatsInfo) *PhysicalMergeJoin {
 	p.basePhysicalPlan = newBasePhysicalPlan(ctx, TypeMergeJoin, &p)
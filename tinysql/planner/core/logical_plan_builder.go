@@ -182,7 +182,7 @@ func (b *PlanBuilder) buildResultSetNode(ctx context.Context, node ast.ResultSet
 func (p *LogicalJoin) pushDownConstExpr(expr expression.Expression, leftCond []expression.Expression,
 	rightCond []expression.Expression, filterCond bool) ([]expression.Expression, []expression.Expression) {
 	switch p.JoinType {
-	case LeftOuterJoin:
+	case LeftOuterJoin, LeftOuterSemiJoin, AntiLeftOuterSemiJoin:
 		if filterCond {
 			leftCond = append(leftCond, expr)
 			// Append the expr to right join condition instead of `rightCond`, to make it able to be
@@ -198,7 +198,7 @@ func (p *LogicalJoin) pushDownConstExpr(expr expression.Expression, leftCond []e
 		} else {
 			leftCond = append(leftCond, expr)
 		}
-	case InnerJoin:
+	case InnerJoin, SemiJoin, AntiSemiJoin:
 		leftCond = append(leftCond, expr)
 		rightCond = append(rightCond, expr)
 	}
@@ -236,19 +236,22 @@ func (p *LogicalJoin) ExtractOnCondition(
 					arg0, arg1 = arg1, arg0
 				}
 				if leftCol != nil && rightCol != nil {
-					if deriveLeft {
+					// Do not derive `is not null` for anti semi join, since it may cause wrong results.
+					// For example, `select * from t t1 where t1.a not in (select b from t t2)` does not
+					// imply `t2.b is not null`.
+					if deriveLeft && p.JoinType != AntiSemiJoin {
 						if isNullRejected(ctx, leftSchema, expr) && !mysql.HasNotNullFlag(leftCol.RetType.Flag) {
 							notNullExpr := expression.BuildNotNullExpr(ctx, leftCol)
 							leftCond = append(leftCond, notNullExpr)
 						}
 					}
-					if deriveRight {
+					if deriveRight && p.JoinType != AntiSemiJoin {
 						if isNullRejected(ctx, rightSchema, expr) && !mysql.HasNotNullFlag(rightCol.RetType.Flag) {
 							notNullExpr := expression.BuildNotNullExpr(ctx, rightCol)
 							rightCond = append(rightCond, notNullExpr)
 						}
 					}
-					if binop.FuncName.L == ast.EQ {
+					if binop.FuncName.L == ast.EQ && !arg0.InOperand && !arg1.InOperand {
 						cond := expression.NewFunctionInternal(ctx, ast.EQ, types.NewFieldType(mysql.TypeTiny), arg0, arg1)
 						eqCond = append(eqCond, cond.(*expression.ScalarFunction))
 						continue
@@ -815,6 +818,8 @@ func (a *havingAndOrderbyExprResolver) Enter(n ast.Node) (node ast.Node, skipChi
 	case *ast.AggregateFuncExpr:
 		a.inAggFunc = true
 	case *ast.ColumnNameExpr, *ast.ColumnName:
+	case *ast.SelectStmt:
+		return n, true
 	default:
 		a.inExpr = true
 	}
@@ -986,6 +991,8 @@ func (g *gbyResolver) Enter(inNode ast.Node) (ast.Node, bool) {
 	g.exprDepth++
 	switch inNode.(type) {
 	case *driver.ValueExpr, *ast.ColumnNameExpr, *ast.ParenthesesExpr, *ast.ColumnName:
+	case *ast.SelectStmt:
+		return inNode, true
 	default:
 		g.inExpr = true
 	}
@@ -1304,6 +1311,110 @@ func (b *PlanBuilder) buildTableDual() *LogicalTableDual {
 	return LogicalTableDual{RowCount: 1}.Init(b.ctx)
 }
 
+// buildApplyWithJoinType builds an apply plan, the inner plan will be evaluated once
+// for every row of the outer plan with the correlated columns set.
+func (b *PlanBuilder) buildApplyWithJoinType(outerPlan, innerPlan LogicalPlan, tp JoinType) LogicalPlan {
+	b.optFlag = b.optFlag | flagPredicatePushDown | flagBuildKeyInfo | flagDecorrelate
+	ap := LogicalApply{LogicalJoin: LogicalJoin{JoinType: tp}}.Init(b.ctx)
+	ap.SetChildren(outerPlan, innerPlan)
+	ap.names = make([]*types.FieldName, outerPlan.Schema().Len()+innerPlan.Schema().Len())
+	copy(ap.names, outerPlan.OutputNames())
+	ap.SetSchema(expression.MergeSchema(outerPlan.Schema(), innerPlan.Schema()))
+	// The inner columns are filled with NULL when no row is matched.
+	if tp == LeftOuterJoin {
+		resetNotNullFlag(ap.schema, outerPlan.Schema().Len(), ap.schema.Len())
+	}
+	for i := outerPlan.Schema().Len(); i < ap.Schema().Len(); i++ {
+		ap.names[i] = types.EmptyName
+	}
+	return ap
+}
+
+// buildSemiApply builds an apply plan whose join type is one of the semi joins.
+func (b *PlanBuilder) buildSemiApply(outerPlan, innerPlan LogicalPlan, condition []expression.Expression, asScalar, not bool) (LogicalPlan, error) {
+	b.optFlag = b.optFlag | flagPredicatePushDown | flagBuildKeyInfo | flagDecorrelate
+
+	join, err := b.buildSemiJoin(outerPlan, innerPlan, condition, asScalar, not)
+	if err != nil {
+		return nil, err
+	}
+
+	ap := &LogicalApply{LogicalJoin: *join}
+	ap.tp = TypeApply
+	ap.self = ap
+	return ap, nil
+}
+
+func (b *PlanBuilder) buildMaxOneRow(p LogicalPlan) LogicalPlan {
+	maxOneRow := LogicalMaxOneRow{}.Init(b.ctx)
+	maxOneRow.SetChildren(p)
+	return maxOneRow
+}
+
+// buildSemiJoin builds a semi join, or a left outer semi join when the result of the
+// subquery is needed as a scalar value. The `not` means the anti version of them.
+func (b *PlanBuilder) buildSemiJoin(outerPlan, innerPlan LogicalPlan, onCondition []expression.Expression, asScalar bool, not bool) (*LogicalJoin, error) {
+	joinPlan := LogicalJoin{}.Init(b.ctx)
+	for i, expr := range onCondition {
+		onCondition[i] = expr.Decorrelate(outerPlan.Schema())
+	}
+	joinPlan.SetChildren(outerPlan, innerPlan)
+	joinPlan.attachOnConds(onCondition)
+	joinPlan.names = make([]*types.FieldName, outerPlan.Schema().Len(), outerPlan.Schema().Len()+1)
+	copy(joinPlan.names, outerPlan.OutputNames())
+	if asScalar {
+		newSchema := outerPlan.Schema().Clone()
+		newSchema.Append(&expression.Column{
+			RetType:  types.NewFieldType(mysql.TypeTiny),
+			UniqueID: b.ctx.GetSessionVars().AllocPlanColumnID(),
+		})
+		joinPlan.names = append(joinPlan.names, types.EmptyName)
+		joinPlan.SetSchema(newSchema)
+		if not {
+			joinPlan.JoinType = AntiLeftOuterSemiJoin
+		} else {
+			joinPlan.JoinType = LeftOuterSemiJoin
+		}
+	} else {
+		joinPlan.SetSchema(outerPlan.Schema().Clone())
+		if not {
+			joinPlan.JoinType = AntiSemiJoin
+		} else {
+			joinPlan.JoinType = SemiJoin
+		}
+	}
+	return joinPlan, nil
+}
+
+// extractCorColumnsBySchema only extracts the correlated columns that match the specified schema.
+// e.g. If the correlated columns from plan are [t1.a, t2.a, t3.a] and specified schema is [t2.a, t2.b, t2.c],
+// only [t2.a] is returned.
+func extractCorColumnsBySchema(p LogicalPlan, schema *expression.Schema) []*expression.CorrelatedColumn {
+	corCols := p.extractCorrelatedCols()
+	resultCorCols := make([]*expression.CorrelatedColumn, schema.Len())
+	for _, corCol := range corCols {
+		idx := schema.ColumnIndex(&corCol.Column)
+		if idx != -1 {
+			if resultCorCols[idx] == nil {
+				resultCorCols[idx] = &expression.CorrelatedColumn{
+					Column: *schema.Columns[idx],
+					Data:   new(types.Datum),
+				}
+			}
+			corCol.Data = resultCorCols[idx].Data
+		}
+	}
+	// Shrink slice. e.g. [col1, nil, col2, nil] will be changed to [col1, col2].
+	length := 0
+	for _, col := range resultCorCols {
+		if col != nil {
+			resultCorCols[length] = col
+			length++
+		}
+	}
+	return resultCorCols[:length]
+}
+
 func (ds *DataSource) newExtraHandleSchemaCol() *expression.Column {
 	return &expression.Column{
 		RetType:  types.NewFieldType(mysql.TypeLonglong),
@@ -1594,6 +1705,147 @@ func extractDefaultExpr(node ast.ExprNode) *ast.DefaultExpr {
 	return nil
 }
 
+func (b *PlanBuilder) buildUpdate(ctx context.Context, update *ast.UpdateStmt) (Plan, error) {
+	p, err := b.buildResultSetNode(ctx, update.TableRefs.TableRefs)
+	if err != nil {
+		return nil, err
+	}
+	oldSchemaLen := p.Schema().Len()
+
+	if update.Where != nil {
+		p, err = b.buildSelection(ctx, p, update.Where, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if update.Order != nil {
+		p, err = b.buildSort(ctx, p, update.Order.Items, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if update.Limit != nil {
+		p, err = b.buildLimit(p, update.Limit)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Add project to freeze the order of output columns.
+	proj := LogicalProjection{Exprs: expression.Column2Exprs(p.Schema().Columns[:oldSchemaLen])}.Init(b.ctx)
+	proj.SetChildren(p)
+	proj.SetSchema(expression.NewSchema(make([]*expression.Column, oldSchemaLen)...))
+	copy(proj.schema.Columns, p.Schema().Columns[:oldSchemaLen])
+	proj.names = p.OutputNames()[:oldSchemaLen]
+	p = proj
+
+	orderedList, np, allAssignmentsAreConstant, err := b.buildUpdateLists(ctx, update.List, p)
+	if err != nil {
+		return nil, err
+	}
+	p = np
+
+	updt := Update{OrderedList: orderedList, AllAssignmentsAreConstant: allAssignmentsAreConstant}.Init(b.ctx)
+	updt.names = p.OutputNames()
+	// The projection can't be eliminated, the columns in the ordered list are resolved by its schema.
+	updt.SelectPlan, err = DoOptimize(ctx, b.optFlag&^flagEliminateProjection, p)
+	if err != nil {
+		return nil, err
+	}
+	err = updt.ResolveIndices()
+	if err != nil {
+		return nil, err
+	}
+
+	tblID2Handle, err := resolveIndicesForTblID2Handle(b.handleHelper.tailMap(), updt.SelectPlan.Schema())
+	if err != nil {
+		return nil, err
+	}
+	tblID2table := make(map[int64]table.Table)
+	for id := range tblID2Handle {
+		tblID2table[id], _ = b.is.TableByID(id)
+	}
+	updt.TblColPosInfos, err = buildColumns2Handle(updt.OutputNames(), tblID2Handle, tblID2table, true)
+	if err != nil {
+		return nil, err
+	}
+	err = checkUpdateList(b.ctx, tblID2table, updt)
+	return updt, err
+}
+
+// buildUpdateLists rewrites the assignments of an update statement, the assigned columns are resolved by the output
+// of p, the plan of the rows to update.
+func (b *PlanBuilder) buildUpdateLists(ctx context.Context, list []*ast.Assignment, p LogicalPlan) (
+	newList []*expression.Assignment, po LogicalPlan, allAssignmentsAreConstant bool, err error) {
+	b.curClause = fieldList
+	allAssignmentsAreConstant = true
+	newList = make([]*expression.Assignment, 0, len(list))
+	for _, assign := range list {
+		idx, err := expression.FindFieldName(p.OutputNames(), assign.Column)
+		if err != nil {
+			return nil, nil, false, err
+		}
+		if idx < 0 {
+			return nil, nil, false, ErrUnknownColumn.GenWithStackByArgs(assign.Column.Name, clauseMsg[fieldList])
+		}
+		col := p.Schema().Columns[idx]
+		name := p.OutputNames()[idx]
+		// If `DEFAULT` is assigned to the column, fill the `defaultExpr.Name` before rewriting the expression.
+		if expr := extractDefaultExpr(assign.Expr); expr != nil {
+			expr.Name = assign.Column
+		}
+		newExpr, np, err := b.rewrite(ctx, assign.Expr, p, nil, false)
+		if err != nil {
+			return nil, nil, false, err
+		}
+		if _, isConst := newExpr.(*expression.Constant); !isConst {
+			allAssignmentsAreConstant = false
+		}
+		p = np
+		newList = append(newList, &expression.Assignment{Col: col, ColName: name.ColName, Expr: newExpr})
+	}
+	return newList, p, allAssignmentsAreConstant, nil
+}
+
+// checkUpdateList checks that the assigned columns belong to the updatable tables, a derived table isn't updatable.
+func checkUpdateList(ctx sessionctx.Context, tblID2table map[int64]table.Table, updt *Update) error {
+	assignFlags, err := GetUpdateColumns(ctx, updt.OrderedList, updt.SelectPlan.Schema().Len())
+	if err != nil {
+		return err
+	}
+	for _, content := range updt.TblColPosInfos {
+		tbl := tblID2table[content.TblID]
+		flags := assignFlags[content.Start:content.End]
+		for i, col := range tbl.WritableCols() {
+			if flags[i] && col.State != model.StatePublic {
+				return ErrUnknownColumn.GenWithStackByArgs(col.Name, clauseMsg[fieldList])
+			}
+			flags[i] = false
+		}
+	}
+	for i, flag := range assignFlags {
+		if flag {
+			name := updt.OutputNames()[i]
+			return ErrNonUpdatableTable.GenWithStackByArgs(name.TblName.O, "UPDATE")
+		}
+	}
+	return nil
+}
+
+// GetUpdateColumns gets the columns of the rows to update which are assigned by the update statement.
+func GetUpdateColumns(ctx sessionctx.Context, orderedList []*expression.Assignment, schemaLen int) ([]bool, error) {
+	assignFlag := make([]bool, schemaLen)
+	for _, v := range orderedList {
+		if !ctx.GetSessionVars().AllowWriteRowID && v.Col.ID == model.ExtraHandleID {
+			return nil, errors.Errorf("insert, update and replace statements for _tidb_rowid are not supported.")
+		}
+		assignFlag[v.Col.Index] = true
+	}
+	return assignFlag, nil
+}
+
 func (b *PlanBuilder) buildDelete(ctx context.Context, delete *ast.DeleteStmt) (Plan, error) {
 	p, err := b.buildResultSetNode(ctx, delete.TableRefs.TableRefs)
 	if err != nil {
@@ -34,6 +34,8 @@ import (
 var (
 	_ LogicalPlan = &LogicalJoin{}
 	_ LogicalPlan = &LogicalAggregation{}
+	_ LogicalPlan = &LogicalApply{}
+	_ LogicalPlan = &LogicalMaxOneRow{}
 	_ LogicalPlan = &LogicalProjection{}
 	_ LogicalPlan = &LogicalSelection{}
 	_ LogicalPlan = &LogicalTableDual{}
@@ -55,11 +57,20 @@ const (
 	LeftOuterJoin
 	// RightOuterJoin means right join.
 	RightOuterJoin
+	// SemiJoin means if row a in table A matches some rows in B, just output a.
+	SemiJoin
+	// AntiSemiJoin means if row a in table A does not match any row in B, then output a.
+	AntiSemiJoin
+	// LeftOuterSemiJoin means if row a in table A matches some rows in B, output (a, true), otherwise, output (a, false).
+	LeftOuterSemiJoin
+	// AntiLeftOuterSemiJoin means if row a in table A matches some rows in B, output (a, false), otherwise, output (a, true).
+	AntiLeftOuterSemiJoin
 )
 
 // IsOuterJoin returns if this joiner is a outer joiner
 func (tp JoinType) IsOuterJoin() bool {
-	return tp == LeftOuterJoin || tp == RightOuterJoin
+	return tp == LeftOuterJoin || tp == RightOuterJoin ||
+		tp == LeftOuterSemiJoin || tp == AntiLeftOuterSemiJoin
 }
 
 func (tp JoinType) String() string {
@@ -70,6 +81,14 @@ func (tp JoinType) String() string {
 		return "left outer join"
 	case RightOuterJoin:
 		return "right outer join"
+	case SemiJoin:
+		return "semi join"
+	case AntiSemiJoin:
+		return "anti semi join"
+	case LeftOuterSemiJoin:
+		return "left outer semi join"
+	case AntiLeftOuterSemiJoin:
+		return "anti left outer semi join"
 	}
 	return "unsupported join type"
 }
@@ -120,6 +139,23 @@ func (p *LogicalJoin) attachOnConds(onConds []expression.Expression) {
 	p.OtherConditions = append(other, p.OtherConditions...)
 }
 
+func (p *LogicalJoin) extractCorrelatedCols() []*expression.CorrelatedColumn {
+	corCols := p.baseLogicalPlan.extractCorrelatedCols()
+	for _, fun := range p.EqualConditions {
+		corCols = append(corCols, expression.ExtractCorColumns(fun)...)
+	}
+	for _, fun := range p.LeftConditions {
+		corCols = append(corCols, expression.ExtractCorColumns(fun)...)
+	}
+	for _, fun := range p.RightConditions {
+		corCols = append(corCols, expression.ExtractCorColumns(fun)...)
+	}
+	for _, fun := range p.OtherConditions {
+		corCols = append(corCols, expression.ExtractCorColumns(fun)...)
+	}
+	return corCols
+}
+
 // LogicalProjection represents a select fields plan.
 type LogicalProjection struct {
 	logicalSchemaProducer
@@ -127,6 +163,14 @@ type LogicalProjection struct {
 	Exprs []expression.Expression
 }
 
+func (p *LogicalProjection) extractCorrelatedCols() []*expression.CorrelatedColumn {
+	corCols := p.baseLogicalPlan.extractCorrelatedCols()
+	for _, expr := range p.Exprs {
+		corCols = append(corCols, expression.ExtractCorColumns(expr)...)
+	}
+	return corCols
+}
+
 // LogicalAggregation represents an aggregate plan.
 type LogicalAggregation struct {
 	logicalSchemaProducer
@@ -156,6 +200,19 @@ func (la *LogicalAggregation) GetGroupByCols() []*expression.Column {
 	return la.groupByCols
 }
 
+func (la *LogicalAggregation) extractCorrelatedCols() []*expression.CorrelatedColumn {
+	corCols := la.baseLogicalPlan.extractCorrelatedCols()
+	for _, expr := range la.GroupByItems {
+		corCols = append(corCols, expression.ExtractCorColumns(expr)...)
+	}
+	for _, fun := range la.AggFuncs {
+		for _, arg := range fun.Args {
+			corCols = append(corCols, expression.ExtractCorColumns(arg)...)
+		}
+	}
+	return corCols
+}
+
 // LogicalSelection represents a where or having predicate.
 type LogicalSelection struct {
 	baseLogicalPlan
@@ -166,6 +223,36 @@ type LogicalSelection struct {
 	Conditions []expression.Expression
 }
 
+func (p *LogicalSelection) extractCorrelatedCols() []*expression.CorrelatedColumn {
+	corCols := p.baseLogicalPlan.extractCorrelatedCols()
+	for _, cond := range p.Conditions {
+		corCols = append(corCols, expression.ExtractCorColumns(cond)...)
+	}
+	return corCols
+}
+
+// LogicalApply gets one row from outer executor and gets one row from inner executor according to outer row.
+type LogicalApply struct {
+	LogicalJoin
+
+	corCols []*expression.CorrelatedColumn
+}
+
+func (la *LogicalApply) extractCorrelatedCols() []*expression.CorrelatedColumn {
+	corCols := la.LogicalJoin.extractCorrelatedCols()
+	for i := len(corCols) - 1; i >= 0; i-- {
+		if la.children[0].Schema().Contains(&corCols[i].Column) {
+			corCols = append(corCols[:i], corCols[i+1:]...)
+		}
+	}
+	return corCols
+}
+
+// LogicalMaxOneRow checks if a query returns no more than one row.
+type LogicalMaxOneRow struct {
+	baseLogicalPlan
+}
+
 // LogicalTableDual represents a dual table plan.
 type LogicalTableDual struct {
 	logicalSchemaProducer
@@ -551,6 +638,14 @@ type LogicalSort struct {
 	ByItems []*ByItems
 }
 
+func (ls *LogicalSort) extractCorrelatedCols() []*expression.CorrelatedColumn {
+	corCols := ls.baseLogicalPlan.extractCorrelatedCols()
+	for _, item := range ls.ByItems {
+		corCols = append(corCols, expression.ExtractCorColumns(item.Expr)...)
+	}
+	return corCols
+}
+
 // LogicalTopN represents a top-n plan.
 type LogicalTopN struct {
 	baseLogicalPlan
@@ -560,6 +655,14 @@ type LogicalTopN struct {
 	Count   uint64
 }
 
+func (lt *LogicalTopN) extractCorrelatedCols() []*expression.CorrelatedColumn {
+	corCols := lt.baseLogicalPlan.extractCorrelatedCols()
+	for _, item := range lt.ByItems {
+		corCols = append(corCols, expression.ExtractCorColumns(item.Expr)...)
+	}
+	return corCols
+}
+
 // isLimit checks if TopN is a limit plan.
 func (lt *LogicalTopN) isLimit() bool {
 	return len(lt.ByItems) == 0
@@ -31,6 +31,7 @@ var OptimizeAstNode func(ctx context.Context, sctx sessionctx.Context, node ast.
 const (
 	flagPrunColumns uint64 = 1 << iota
 	flagBuildKeyInfo
+	flagDecorrelate
 	flagEliminateAgg
 	flagEliminateProjection
 	flagMaxMinEliminate
@@ -44,6 +45,7 @@ const (
 var optRuleList = []logicalOptRule{
 	&columnPruner{},
 	&buildKeySolver{},
+	&decorrelateSolver{},
 	&aggregationEliminator{},
 	&projectionEliminator{},
 	&maxMinEliminator{},
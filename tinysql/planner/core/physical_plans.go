@@ -39,6 +39,8 @@ var (
 	_ PhysicalPlan = &PhysicalHashAgg{}
 	_ PhysicalPlan = &PhysicalHashJoin{}
 	_ PhysicalPlan = &PhysicalMergeJoin{}
+	_ PhysicalPlan = &PhysicalApply{}
+	_ PhysicalPlan = &PhysicalMaxOneRow{}
 	_ PhysicalPlan = &PhysicalUnionScan{}
 )
 
@@ -250,6 +252,18 @@ type PhysicalMergeJoin struct {
 	CompareFuncs []expression.CompareFunc
 }
 
+// PhysicalApply represents apply plan, only used for subquery.
+type PhysicalApply struct {
+	PhysicalHashJoin
+
+	OuterSchema []*expression.CorrelatedColumn
+}
+
+// PhysicalMaxOneRow is the physical operator of maxOneRow.
+type PhysicalMaxOneRow struct {
+	basePhysicalPlan
+}
+
 // PhysicalLimit is the physical operator of Limit.
 type PhysicalLimit struct {
 	basePhysicalPlan
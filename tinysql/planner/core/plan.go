@@ -124,6 +124,9 @@ type LogicalPlan interface {
 
 	// SetChild sets the ith child for the plan.
 	SetChild(i int, child LogicalPlan)
+
+	// extractCorrelatedCols extracts correlated columns inside the LogicalPlan.
+	extractCorrelatedCols() []*expression.CorrelatedColumn
 }
 
 // PhysicalPlan is a tree of the physical operators.
@@ -241,6 +244,14 @@ func newBasePhysicalPlan(ctx sessionctx.Context, tp string, self PhysicalPlan) b
 	}
 }
 
+func (p *baseLogicalPlan) extractCorrelatedCols() []*expression.CorrelatedColumn {
+	corCols := make([]*expression.CorrelatedColumn, 0, len(p.children))
+	for _, child := range p.children {
+		corCols = append(corCols, child.extractCorrelatedCols()...)
+	}
+	return corCols
+}
+
 // PruneColumns implements LogicalPlan interface.
 func (p *baseLogicalPlan) PruneColumns(parentUsedCols []*expression.Column) error {
 	if len(p.children) == 0 {
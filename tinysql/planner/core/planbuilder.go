@@ -159,6 +159,10 @@ type PlanBuilder struct {
 	is  infoschema.InfoSchema
 	// colMapper stores the column that must be pre-resolved.
 	colMapper map[*ast.ColumnNameExpr]int
+	// outerSchemas and outerNames are the schemas and names of the plans outside the subquery
+	// being built, they are used to resolve the correlated columns.
+	outerSchemas []*expression.Schema
+	outerNames   [][]*types.FieldName
 
 	tableHintInfo []tableHintInfo
 	// optFlag indicates the flags of the optimizer rules.
@@ -247,6 +251,8 @@ func (b *PlanBuilder) Build(ctx context.Context, node ast.Node) (Plan, error) {
 		return b.buildAdmin(ctx, x)
 	case *ast.DeleteStmt:
 		return b.buildDelete(ctx, x)
+	case *ast.UpdateStmt:
+		return b.buildUpdate(ctx, x)
 	case *ast.ExplainStmt:
 		return b.buildExplain(ctx, x)
 	case *ast.InsertStmt:
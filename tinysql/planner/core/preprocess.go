@@ -46,7 +46,7 @@ func Preprocess(ctx sessionctx.Context, node ast.Node, is infoschema.InfoSchema,
 type preprocessorFlag uint8
 
 const (
-	// inCreateOrDropTable is set when visiting create/drop table statement.
+	// inCreateOrDropTable is set when visiting create/drop/rename table statement.
 	inCreateOrDropTable preprocessorFlag = 1 << iota
 	// parentIsJoin is set when visiting node's parent is join.
 	parentIsJoin
@@ -73,6 +73,9 @@ func (p *preprocessor) Enter(in ast.Node) (out ast.Node, skipChildren bool) {
 	case *ast.DropTableStmt:
 		p.flag |= inCreateOrDropTable
 		p.checkDropTableGrammar(node)
+	case *ast.RenameTableStmt:
+		p.flag |= inCreateOrDropTable
+		p.checkRenameTableGrammar(node)
 	case *ast.CreateIndexStmt:
 		p.checkCreateIndexGrammar(node)
 	case *ast.AlterTableStmt:
@@ -98,7 +101,7 @@ func (p *preprocessor) Leave(in ast.Node) (out ast.Node, ok bool) {
 		p.flag &= ^inCreateOrDropTable
 		p.checkAutoIncrement(x)
 		p.checkContainDotColumn(x)
-	case *ast.DropTableStmt, *ast.AlterTableStmt:
+	case *ast.DropTableStmt, *ast.AlterTableStmt, *ast.RenameTableStmt:
 		p.flag &= ^inCreateOrDropTable
 	case *ast.ExplainStmt:
 		if _, ok := x.Stmt.(*ast.ShowStmt); ok {
@@ -270,6 +273,21 @@ func (p *preprocessor) checkDropTableGrammar(stmt *ast.DropTableStmt) {
 	}
 }
 
+func (p *preprocessor) checkRenameTableGrammar(stmt *ast.RenameTableStmt) {
+	for _, t := range stmt.TableToTables {
+		oldTable := t.OldTable.Name.String()
+		newTable := t.NewTable.Name.String()
+		if isIncorrectName(oldTable) {
+			p.err = ddl.ErrWrongTableName.GenWithStackByArgs(oldTable)
+			return
+		}
+		if isIncorrectName(newTable) {
+			p.err = ddl.ErrWrongTableName.GenWithStackByArgs(newTable)
+			return
+		}
+	}
+}
+
 func (p *preprocessor) checkNonUniqTableAlias(stmt *ast.Join) {
 	if p.flag&parentIsJoin == 0 {
 		p.tableAliasInJoin = append(p.tableAliasInJoin, make(map[string]interface{}))
@@ -536,4 +554,12 @@ func (p *preprocessor) resolveShowStmt(node *ast.ShowStmt) {
 	}
 }
 
-func (p *preprocessor) resolveAlterTableStmt(node *ast.AlterTableStmt) {}
+func (p *preprocessor) resolveAlterTableStmt(node *ast.AlterTableStmt) {
+	for _, spec := range node.Specs {
+		if spec.Tp == ast.AlterTableRenameTable {
+			// The new table doesn't exist yet.
+			p.flag |= inCreateOrDropTable
+			break
+		}
+	}
+}
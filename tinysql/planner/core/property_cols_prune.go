@@ -155,9 +155,10 @@ func (p *LogicalJoin) PreparePossibleProperties(schema *expression.Schema, child
 	// TODO: We should consider properties propagation.
 	p.leftProperties = leftProperties
 	p.rightProperties = rightProperties
-	if p.JoinType == LeftOuterJoin {
+	switch p.JoinType {
+	case LeftOuterJoin, SemiJoin, AntiSemiJoin, LeftOuterSemiJoin, AntiLeftOuterSemiJoin:
 		rightProperties = nil
-	} else if p.JoinType == RightOuterJoin {
+	case RightOuterJoin:
 		leftProperties = nil
 	}
 	resultProperties := make([][]*expression.Column, len(leftProperties)+len(rightProperties))
@@ -113,6 +113,34 @@ func (p *PhysicalHashJoin) ResolveIndices() (err error) {
 	return
 }
 
+// ResolveIndices implements Plan interface.
+func (p *PhysicalApply) ResolveIndices() (err error) {
+	err = p.PhysicalHashJoin.ResolveIndices()
+	if err != nil {
+		return err
+	}
+	for _, col := range p.OuterSchema {
+		newCol, err := col.Column.ResolveIndices(p.children[0].Schema())
+		if err != nil {
+			return err
+		}
+		col.Column = *newCol.(*expression.Column)
+	}
+	// Resolve index for equal conditions again, because apply is different from
+	// hash join on the fact that equal conditions are evaluated against the join result,
+	// so columns from equal conditions come from merged schema of children, instead of
+	// single child's schema.
+	joinedSchema := expression.MergeSchema(p.children[0].Schema(), p.children[1].Schema())
+	for i, cond := range p.PhysicalHashJoin.EqualConditions {
+		newSf, err := cond.ResolveIndices(joinedSchema)
+		if err != nil {
+			return err
+		}
+		p.PhysicalHashJoin.EqualConditions[i] = newSf.(*expression.ScalarFunction)
+	}
+	return
+}
+
 // ResolveIndices implements Plan interface.
 func (p *PhysicalMergeJoin) ResolveIndices() (err error) {
 	err = p.physicalSchemaProducer.ResolveIndices()
@@ -289,6 +317,27 @@ func (p *PhysicalTopN) ResolveIndices() (err error) {
 	return
 }
 
+// ResolveIndices implements Plan interface.
+func (p *Update) ResolveIndices() (err error) {
+	err = p.baseSchemaProducer.ResolveIndices()
+	if err != nil {
+		return err
+	}
+	schema := p.SelectPlan.Schema()
+	for _, assign := range p.OrderedList {
+		newCol, err := assign.Col.ResolveIndices(schema)
+		if err != nil {
+			return err
+		}
+		assign.Col = newCol.(*expression.Column)
+		assign.Expr, err = assign.Expr.ResolveIndices(schema)
+		if err != nil {
+			return err
+		}
+	}
+	return
+}
+
 // ResolveIndices implements Plan interface.
 func (p *Insert) ResolveIndices() (err error) {
 	err = p.baseSchemaProducer.ResolveIndices()
@@ -240,7 +240,16 @@ func (p *LogicalJoin) extractUsedCols(parentUsedCols []*expression.Column) (left
 func (p *LogicalJoin) mergeSchema() {
 	lChild := p.children[0]
 	rChild := p.children[1]
-	p.schema = expression.MergeSchema(lChild.Schema(), rChild.Schema())
+	switch p.JoinType {
+	case SemiJoin, AntiSemiJoin:
+		p.schema = lChild.Schema().Clone()
+	case LeftOuterSemiJoin, AntiLeftOuterSemiJoin:
+		joinCol := p.schema.Columns[len(p.schema.Columns)-1]
+		p.schema = lChild.Schema().Clone()
+		p.schema.Append(joinCol)
+	default:
+		p.schema = expression.MergeSchema(lChild.Schema(), rChild.Schema())
+	}
 }
 
 // PruneColumns implements LogicalPlan interface.
@@ -261,6 +270,29 @@ func (p *LogicalJoin) PruneColumns(parentUsedCols []*expression.Column) error {
 	return nil
 }
 
+// PruneColumns implements LogicalPlan interface.
+func (la *LogicalApply) PruneColumns(parentUsedCols []*expression.Column) error {
+	leftCols, rightCols := la.extractUsedCols(parentUsedCols)
+
+	err := la.children[1].PruneColumns(rightCols)
+	if err != nil {
+		return err
+	}
+
+	la.corCols = extractCorColumnsBySchema(la.children[1], la.children[0].Schema())
+	for _, col := range la.corCols {
+		leftCols = append(leftCols, &col.Column)
+	}
+
+	err = la.children[0].PruneColumns(leftCols)
+	if err != nil {
+		return err
+	}
+
+	la.mergeSchema()
+	return nil
+}
+
 func (*columnPruner) name() string {
 	return "column_prune"
 }
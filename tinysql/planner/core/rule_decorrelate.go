@@ -0,0 +1,184 @@
+// Copyright 2016 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"context"
+
+	"github.com/pingcap/tidb/expression"
+	"github.com/pingcap/tidb/types"
+)
+
+// columnSubstitute substitutes the columns of the join conditions by the expressions.
+func (p *LogicalJoin) columnSubstitute(schema *expression.Schema, exprs []expression.Expression) {
+	for i, cond := range p.LeftConditions {
+		p.LeftConditions[i] = expression.ColumnSubstitute(cond, schema, exprs)
+	}
+
+	for i, cond := range p.RightConditions {
+		p.RightConditions[i] = expression.ColumnSubstitute(cond, schema, exprs)
+	}
+
+	for i, cond := range p.OtherConditions {
+		p.OtherConditions[i] = expression.ColumnSubstitute(cond, schema, exprs)
+	}
+
+	for i := len(p.EqualConditions) - 1; i >= 0; i-- {
+		newCond := expression.ColumnSubstitute(p.EqualConditions[i], schema, exprs).(*expression.ScalarFunction)
+
+		// If the columns used in the new filter all come from the left child,
+		// we can push this filter to it.
+		if expression.ExprFromSchema(newCond, p.children[0].Schema()) {
+			p.LeftConditions = append(p.LeftConditions, newCond)
+			p.EqualConditions = append(p.EqualConditions[:i], p.EqualConditions[i+1:]...)
+			continue
+		}
+
+		// If the columns used in the new filter all come from the right
+		// child, we can push this filter to it.
+		if expression.ExprFromSchema(newCond, p.children[1].Schema()) {
+			p.RightConditions = append(p.RightConditions, newCond)
+			p.EqualConditions = append(p.EqualConditions[:i], p.EqualConditions[i+1:]...)
+			continue
+		}
+
+		_, lhsIsCol := newCond.GetArgs()[0].(*expression.Column)
+		_, rhsIsCol := newCond.GetArgs()[1].(*expression.Column)
+
+		// If the columns used in the new filter are not all expression.Column,
+		// we can not use it as join's equal condition.
+		if !(lhsIsCol && rhsIsCol) {
+			p.OtherConditions = append(p.OtherConditions, newCond)
+			p.EqualConditions = append(p.EqualConditions[:i], p.EqualConditions[i+1:]...)
+			continue
+		}
+
+		p.EqualConditions[i] = newCond
+	}
+}
+
+// hasMaxOneRow checks whether the plan is sure to output no more than one row.
+func hasMaxOneRow(p LogicalPlan) bool {
+	switch x := p.(type) {
+	case *LogicalMaxOneRow:
+		return true
+	case *LogicalTableDual:
+		return x.RowCount <= 1
+	case *LogicalLimit:
+		return x.Count == 1 || hasMaxOneRow(x.children[0])
+	case *LogicalTopN:
+		return x.Count == 1 || hasMaxOneRow(x.children[0])
+	case *LogicalAggregation:
+		return len(x.GroupByItems) == 0 || hasMaxOneRow(x.children[0])
+	case *LogicalSort, *LogicalSelection, *LogicalProjection:
+		return hasMaxOneRow(x.Children()[0])
+	}
+	return false
+}
+
+// decorrelateSolver tries to convert apply plan to join plan.
+type decorrelateSolver struct{}
+
+// optimize implements logicalOptRule interface.
+func (s *decorrelateSolver) optimize(ctx context.Context, p LogicalPlan) (LogicalPlan, error) {
+	if apply, ok := p.(*LogicalApply); ok {
+		outerPlan := apply.children[0]
+		innerPlan := apply.children[1]
+		apply.corCols = extractCorColumnsBySchema(apply.children[1], apply.children[0].Schema())
+		if len(apply.corCols) == 0 {
+			// If the inner plan is non-correlated, the apply will be simplified to join.
+			join := &apply.LogicalJoin
+			join.self = join
+			join.tp = TypeJoin
+			p = join
+		} else if sel, ok := innerPlan.(*LogicalSelection); ok {
+			// If the inner plan is a selection, we add this condition to join predicates.
+			// Notice that no matter what kind of join is, it's always right.
+			newConds := make([]expression.Expression, 0, len(sel.Conditions))
+			for _, cond := range sel.Conditions {
+				newConds = append(newConds, cond.Decorrelate(outerPlan.Schema()))
+			}
+			apply.attachOnConds(newConds)
+			innerPlan = sel.children[0]
+			apply.SetChildren(outerPlan, innerPlan)
+			return s.optimize(ctx, p)
+		} else if m, ok := innerPlan.(*LogicalMaxOneRow); ok {
+			if hasMaxOneRow(m.children[0]) {
+				innerPlan = m.children[0]
+				apply.SetChildren(outerPlan, innerPlan)
+				return s.optimize(ctx, p)
+			}
+		} else if proj, ok := innerPlan.(*LogicalProjection); ok {
+			isSemi := apply.JoinType == SemiJoin || apply.JoinType == AntiSemiJoin ||
+				apply.JoinType == LeftOuterSemiJoin || apply.JoinType == AntiLeftOuterSemiJoin
+			// For the outer join, the projection can only be pulled up when it just renames
+			// the columns, otherwise the expressions would be evaluated on the NULL-filled rows.
+			// e.g. select (select 1 from t1 where t1.a=t2.a) from t2; will output 1 instead of
+			// NULL for the unmatched rows if the projection is pulled up.
+			canPullUp := isSemi
+			if apply.JoinType == LeftOuterJoin {
+				canPullUp = true
+				for _, expr := range proj.Exprs {
+					if _, isCol := expr.(*expression.Column); !isCol {
+						canPullUp = false
+						break
+					}
+				}
+			}
+			if canPullUp {
+				for i, expr := range proj.Exprs {
+					proj.Exprs[i] = expr.Decorrelate(outerPlan.Schema())
+				}
+				apply.columnSubstitute(proj.Schema(), proj.Exprs)
+				innerPlan = proj.children[0]
+				apply.SetChildren(outerPlan, innerPlan)
+				if !isSemi {
+					proj.SetSchema(apply.Schema())
+					proj.names = apply.names
+					proj.Exprs = append(expression.Column2Exprs(outerPlan.Schema().Clone().Columns), proj.Exprs...)
+					apply.SetSchema(expression.MergeSchema(outerPlan.Schema(), innerPlan.Schema()))
+					if apply.JoinType == LeftOuterJoin {
+						resetNotNullFlag(apply.schema, outerPlan.Schema().Len(), apply.schema.Len())
+					}
+					apply.names = make([]*types.FieldName, apply.schema.Len())
+					copy(apply.names, outerPlan.OutputNames())
+					for i := outerPlan.Schema().Len(); i < len(apply.names); i++ {
+						apply.names[i] = types.EmptyName
+					}
+					np, err := s.optimize(ctx, p)
+					if err != nil {
+						return nil, err
+					}
+					proj.SetChildren(np)
+					return proj, nil
+				}
+				return s.optimize(ctx, p)
+			}
+		}
+	}
+	newChildren := make([]LogicalPlan, 0, len(p.Children()))
+	for _, child := range p.Children() {
+		np, err := s.optimize(ctx, child)
+		if err != nil {
+			return nil, err
+		}
+		newChildren = append(newChildren, np)
+	}
+	p.SetChildren(newChildren...)
+	return p, nil
+}
+
+func (*decorrelateSolver) name() string {
+	return "decorrelate"
+}
@@ -53,9 +53,9 @@ func canProjectionBeEliminatedStrict(p *PhysicalProjection) bool {
 func resolveColumnAndReplace(origin *expression.Column, replace map[string]*expression.Column) {
 	dst := replace[string(origin.HashCode(nil))]
 	if dst != nil {
-		retType := origin.RetType
+		retType, inOperand := origin.RetType, origin.InOperand
 		*origin = *dst
-		origin.RetType = retType
+		origin.RetType, origin.InOperand = retType, inOperand
 	}
 }
 
@@ -64,6 +64,8 @@ func ResolveExprAndReplace(origin expression.Expression, replace map[string]*exp
 	switch expr := origin.(type) {
 	case *expression.Column:
 		resolveColumnAndReplace(expr, replace)
+	case *expression.CorrelatedColumn:
+		resolveColumnAndReplace(&expr.Column, replace)
 	case *expression.ScalarFunction:
 		for _, arg := range expr.GetArgs() {
 			ResolveExprAndReplace(arg, replace)
@@ -122,6 +124,8 @@ func (pe *projectionEliminator) eliminate(p LogicalPlan, replace map[string]*exp
 	switch x := p.(type) {
 	case *LogicalJoin:
 		x.schema = buildLogicalJoinSchema(x.JoinType, x)
+	case *LogicalApply:
+		x.schema = buildLogicalJoinSchema(x.JoinType, x)
 	default:
 		for _, dst := range p.Schema().Columns {
 			resolveColumnAndReplace(dst, replace)
@@ -178,6 +182,16 @@ func (p *LogicalJoin) replaceExprColumns(replace map[string]*expression.Column)
 	}
 }
 
+func (la *LogicalApply) replaceExprColumns(replace map[string]*expression.Column) {
+	la.LogicalJoin.replaceExprColumns(replace)
+	for _, coCol := range la.corCols {
+		dst := replace[string(coCol.Column.HashCode(nil))]
+		if dst != nil {
+			coCol.Column = *dst
+		}
+	}
+}
+
 func (p *LogicalProjection) replaceExprColumns(replace map[string]*expression.Column) {
 	for _, expr := range p.Exprs {
 		ResolveExprAndReplace(expr, replace)
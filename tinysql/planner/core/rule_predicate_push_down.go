@@ -114,7 +114,7 @@ func (p *LogicalJoin) PredicatePushDown(predicates []expression.Expression) (ret
 	var equalCond []*expression.ScalarFunction
 	var leftPushCond, rightPushCond, otherCond, leftCond, rightCond []expression.Expression
 	switch p.JoinType {
-	case LeftOuterJoin:
+	case LeftOuterJoin, LeftOuterSemiJoin, AntiLeftOuterSemiJoin:
 		predicates = p.outerJoinPropConst(predicates)
 		dual := Conds2TableDual(p, predicates)
 		if dual != nil {
@@ -148,7 +148,7 @@ func (p *LogicalJoin) PredicatePushDown(predicates []expression.Expression) (ret
 		p.LeftConditions = nil
 		ret = append(expression.ScalarFuncs2Exprs(equalCond), otherCond...)
 		ret = append(ret, leftPushCond...)
-	case InnerJoin:
+	case SemiJoin, InnerJoin:
 		tempCond := make([]expression.Expression, 0, len(p.LeftConditions)+len(p.RightConditions)+len(p.EqualConditions)+len(p.OtherConditions)+len(predicates))
 		tempCond = append(tempCond, p.LeftConditions...)
 		tempCond = append(tempCond, p.RightConditions...)
@@ -169,6 +169,22 @@ func (p *LogicalJoin) PredicatePushDown(predicates []expression.Expression) (ret
 		p.OtherConditions = otherCond
 		leftCond = leftPushCond
 		rightCond = rightPushCond
+	case AntiSemiJoin:
+		predicates = expression.PropagateConstant(p.ctx, predicates)
+		// Return table dual when filter is constant false or null.
+		dual := Conds2TableDual(p, predicates)
+		if dual != nil {
+			return ret, dual
+		}
+		// `predicates` should only contain left conditions or constant filters.
+		_, leftPushCond, rightPushCond, _ = p.extractOnCondition(predicates, true, true)
+		// Do not derive left conditions for AntiSemiJoin, the outer rows that can not
+		// match them must still be outputted.
+		_, derivedRightJoinCond := deriveOtherConditions(p, false, true)
+		leftCond = leftPushCond
+		rightCond = append(p.RightConditions, rightPushCond...)
+		rightCond = append(rightCond, derivedRightJoinCond...)
+		p.RightConditions = nil
 	}
 	leftCond = expression.RemoveDupExprs(p.ctx, leftCond)
 	rightCond = expression.RemoveDupExprs(p.ctx, rightCond)
@@ -186,6 +202,22 @@ func (p *LogicalJoin) PredicatePushDown(predicates []expression.Expression) (ret
 	return ret, p.self
 }
 
+// isInOperand checks whether the expression is the inner operand of column equal condition
+// converted from `[not] in (subq)`.
+func isInOperand(expr expression.Expression) bool {
+	switch v := expr.(type) {
+	case *expression.Column:
+		return v.InOperand
+	case *expression.ScalarFunction:
+		for _, arg := range v.GetArgs() {
+			if isInOperand(arg) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // updateEQCond will extract the arguments of a equal condition that connect two expressions.
 func (p *LogicalJoin) updateEQCond() {
 	lChild, rChild := p.children[0], p.children[1]
@@ -194,6 +226,9 @@ func (p *LogicalJoin) updateEQCond() {
 		need2Remove := false
 		if eqCond, ok := p.OtherConditions[i].(*expression.ScalarFunction); ok && eqCond.FuncName.L == ast.EQ {
 			lExpr, rExpr := eqCond.GetArgs()[0], eqCond.GetArgs()[1]
+			if isInOperand(lExpr) || isInOperand(rExpr) {
+				continue
+			}
 			if expression.ExprFromSchema(lExpr, lChild.Schema()) && expression.ExprFromSchema(rExpr, rChild.Schema()) {
 				lKeys = append(lKeys, lExpr)
 				rKeys = append(rKeys, rExpr)
@@ -402,6 +437,7 @@ func (p *LogicalLimit) PredicatePushDown(predicates []expression.Expression) ([]
 func deriveOtherConditions(p *LogicalJoin, deriveLeft bool, deriveRight bool) (leftCond []expression.Expression,
 	rightCond []expression.Expression) {
 	leftPlan, rightPlan := p.children[0], p.children[1]
+	isOuterSemi := (p.JoinType == LeftOuterSemiJoin) || (p.JoinType == AntiLeftOuterSemiJoin)
 	for _, expr := range p.OtherConditions {
 		if deriveLeft {
 			leftRelaxedCond := expression.DeriveRelaxedFiltersFromDNF(expr, leftPlan.Schema())
@@ -418,6 +454,11 @@ func deriveOtherConditions(p *LogicalJoin, deriveLeft bool, deriveRight bool) (l
 			if rightRelaxedCond != nil {
 				rightCond = append(rightCond, rightRelaxedCond)
 			}
+			// The column equal conditions converted from `in (subq)` are kept in `OtherConditions`
+			// for outer semi joins, deriving `col is not null` from them would cause wrong results.
+			if isOuterSemi {
+				continue
+			}
 			notNullExpr := deriveNotNullExpr(expr, rightPlan.Schema())
 			if notNullExpr != nil {
 				rightCond = append(rightCond, notNullExpr)
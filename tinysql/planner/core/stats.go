@@ -347,6 +347,25 @@ func (la *LogicalAggregation) DeriveStats(childStats []*property.StatsInfo, self
 // every matched bucket.
 func (p *LogicalJoin) DeriveStats(childStats []*property.StatsInfo, selfSchema *expression.Schema, childSchema []*expression.Schema) (*property.StatsInfo, error) {
 	leftProfile, rightProfile := childStats[0], childStats[1]
+	if p.JoinType == SemiJoin || p.JoinType == AntiSemiJoin {
+		p.stats = &property.StatsInfo{
+			RowCount:    leftProfile.RowCount * selectionFactor,
+			Cardinality: make([]float64, len(leftProfile.Cardinality)),
+		}
+		for i := range p.stats.Cardinality {
+			p.stats.Cardinality[i] = leftProfile.Cardinality[i] * selectionFactor
+		}
+		return p.stats, nil
+	}
+	if p.JoinType == LeftOuterSemiJoin || p.JoinType == AntiLeftOuterSemiJoin {
+		p.stats = &property.StatsInfo{
+			RowCount:    leftProfile.RowCount,
+			Cardinality: make([]float64, selfSchema.Len()),
+		}
+		copy(p.stats.Cardinality, leftProfile.Cardinality)
+		p.stats.Cardinality[len(p.stats.Cardinality)-1] = 2.0
+		return p.stats, nil
+	}
 	helper := &fullJoinRowCountHelper{
 		cartesian:     0 == len(p.EqualConditions),
 		leftProfile:   leftProfile,
@@ -376,6 +395,41 @@ func (p *LogicalJoin) DeriveStats(childStats []*property.StatsInfo, selfSchema *
 	return p.stats, nil
 }
 
+// DeriveStats implement LogicalPlan DeriveStats interface.
+func (la *LogicalApply) DeriveStats(childStats []*property.StatsInfo, selfSchema *expression.Schema, childSchema []*expression.Schema) (*property.StatsInfo, error) {
+	leftProfile := childStats[0]
+	la.stats = &property.StatsInfo{
+		RowCount:    leftProfile.RowCount,
+		Cardinality: make([]float64, selfSchema.Len()),
+	}
+	copy(la.stats.Cardinality, leftProfile.Cardinality)
+	if la.JoinType == LeftOuterSemiJoin || la.JoinType == AntiLeftOuterSemiJoin {
+		la.stats.Cardinality[selfSchema.Len()-1] = 2.0
+	} else {
+		for i := childSchema[0].Len(); i < selfSchema.Len(); i++ {
+			la.stats.Cardinality[i] = leftProfile.RowCount
+		}
+	}
+	return la.stats, nil
+}
+
+// DeriveStats implement LogicalPlan DeriveStats interface.
+func (p *LogicalMaxOneRow) DeriveStats(childStats []*property.StatsInfo, selfSchema *expression.Schema, childSchema []*expression.Schema) (*property.StatsInfo, error) {
+	p.stats = getSingletonStats(selfSchema.Len())
+	return p.stats, nil
+}
+
+func getSingletonStats(len int) *property.StatsInfo {
+	ret := &property.StatsInfo{
+		RowCount:    1.0,
+		Cardinality: make([]float64, len),
+	}
+	for i := 0; i < len; i++ {
+		ret.Cardinality[i] = 1
+	}
+	return ret
+}
+
 type fullJoinRowCountHelper struct {
 	cartesian     bool
 	leftProfile   *property.StatsInfo
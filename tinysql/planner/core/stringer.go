@@ -80,6 +80,14 @@ func toString(in Plan, strs []string, idxs []int) ([]string, []int) {
 			id = "MergeRightOuterJoin"
 		case InnerJoin:
 			id = "MergeInnerJoin"
+		case SemiJoin:
+			id = "MergeSemiJoin"
+		case AntiSemiJoin:
+			id = "MergeAntiSemiJoin"
+		case LeftOuterSemiJoin:
+			id = "MergeLeftOuterSemiJoin"
+		case AntiLeftOuterSemiJoin:
+			id = "MergeAntiLeftOuterSemiJoin"
 		}
 		str = id + "{" + strings.Join(children, "->") + "}"
 		for i := range x.LeftJoinKeys {
@@ -87,6 +95,22 @@ func toString(in Plan, strs []string, idxs []int) ([]string, []int) {
 			r := x.RightJoinKeys[i].String()
 			str += fmt.Sprintf("(%s,%s)", l, r)
 		}
+	case *LogicalApply:
+		last := len(idxs) - 1
+		idx := idxs[last]
+		children := strs[idx:]
+		strs = strs[:idx]
+		str = "Apply{" + strings.Join(children, "->") + "}"
+		idxs = idxs[:last]
+	case *PhysicalApply:
+		last := len(idxs) - 1
+		idx := idxs[last]
+		children := strs[idx:]
+		strs = strs[:idx]
+		str = "Apply{" + strings.Join(children, "->") + "}"
+		idxs = idxs[:last]
+	case *LogicalMaxOneRow, *PhysicalMaxOneRow:
+		str = "MaxOneRow"
 	case *LogicalLimit, *PhysicalLimit:
 		str = "Limit"
 	case *ShowDDL:
@@ -163,6 +187,8 @@ func toString(in Plan, strs []string, idxs []int) ([]string, []int) {
 			children = append(children, fmt.Sprintf("Table(%s)", strings.Join(colNames, ", ")))
 		}
 		str = str + strings.Join(children, ",") + "}"
+	case *Update:
+		str = fmt.Sprintf("%s->Update", ToString(x.SelectPlan))
 	case *Delete:
 		str = fmt.Sprintf("%s->Delete", ToString(x.SelectPlan))
 	case *Insert:
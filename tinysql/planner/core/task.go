@@ -142,6 +142,44 @@ func (p *basePhysicalPlan) attach2Task(tasks ...task) task {
 	return attachPlan2Task(p.self, t)
 }
 
+// GetCost computes the cost of apply operator.
+func (p *PhysicalApply) GetCost(lCount, rCount, lCost, rCost float64) float64 {
+	var cpuCost float64
+	sessVars := p.ctx.GetSessionVars()
+	if len(p.LeftConditions) > 0 {
+		cpuCost += lCount * sessVars.CPUFactor
+		lCount *= selectionFactor
+	}
+	if len(p.RightConditions) > 0 {
+		cpuCost += lCount * rCount * sessVars.CPUFactor
+		rCount *= selectionFactor
+	}
+	if len(p.EqualConditions)+len(p.OtherConditions) > 0 {
+		if p.JoinType == SemiJoin || p.JoinType == AntiSemiJoin ||
+			p.JoinType == LeftOuterSemiJoin || p.JoinType == AntiLeftOuterSemiJoin {
+			cpuCost += lCount * rCount * sessVars.CPUFactor * 0.5
+		} else {
+			cpuCost += lCount * rCount * sessVars.CPUFactor
+		}
+	}
+	// Apply uses a NestedLoop method for execution.
+	// For every row from the left(outer) side, it executes
+	// the whole right(inner) plan tree. So the cost of apply
+	// should be : apply cost + left cost + left count * right cost
+	return cpuCost + lCost + lCount*rCost
+}
+
+func (p *PhysicalApply) attach2Task(tasks ...task) task {
+	lTask := finishCopTask(p.ctx, tasks[0].copy())
+	rTask := finishCopTask(p.ctx, tasks[1].copy())
+	p.SetChildren(lTask.plan(), rTask.plan())
+	p.schema = BuildPhysicalJoinSchema(p.JoinType, p)
+	return &rootTask{
+		p:   p,
+		cst: p.GetCost(lTask.count(), rTask.count(), lTask.cost(), rTask.cost()),
+	}
+}
+
 // GetCost computes cost of hash join operator itself.
 func (p *PhysicalHashJoin) GetCost(lCnt, rCnt float64) float64 {
 	buildCnt, probeCnt := lCnt, rCnt
@@ -44,6 +44,7 @@ import (
 	"math"
 	"net/http"
 	"strconv"
+	"time"
 )
 
 func parseNullTermString(b []byte) (str []byte, remain []byte) {
@@ -168,6 +169,52 @@ func init() {
 	}
 }
 
+func dumpBinaryTime(dur time.Duration) (data []byte) {
+	if dur == 0 {
+		return tinyIntCache[0]
+	}
+	data = make([]byte, 13)
+	data[0] = 12
+	if dur < 0 {
+		data[1] = 1
+		dur = -dur
+	}
+	days := dur / (24 * time.Hour)
+	dur -= days * 24 * time.Hour
+	data[2] = byte(days)
+	hours := dur / time.Hour
+	dur -= hours * time.Hour
+	data[6] = byte(hours)
+	minutes := dur / time.Minute
+	dur -= minutes * time.Minute
+	data[7] = byte(minutes)
+	seconds := dur / time.Second
+	dur -= seconds * time.Second
+	data[8] = byte(seconds)
+	if dur == 0 {
+		data[0] = 8
+		return data[:9]
+	}
+	data = dumpUint32(data[:9], uint32(dur/time.Microsecond))
+	return
+}
+
+func dumpBinaryDateTime(data []byte, t types.Time) []byte {
+	year, mon, day := t.Year(), t.Month(), t.Day()
+	switch t.Type() {
+	case mysql.TypeTimestamp, mysql.TypeDatetime:
+		data = append(data, 11)
+		data = dumpUint16(data, uint16(year))
+		data = append(data, byte(mon), byte(day), byte(t.Hour()), byte(t.Minute()), byte(t.Second()))
+		data = dumpUint32(data, uint32(t.Microsecond()))
+	case mysql.TypeDate:
+		data = append(data, 4)
+		data = dumpUint16(data, uint16(year))
+		data = append(data, byte(mon), byte(day))
+	}
+	return data
+}
+
 func dumpBinaryRow(buffer []byte, columns []*ColumnInfo, row chunk.Row) ([]byte, error) {
 	buffer = append(buffer, mysql.OKHeader)
 	nullBitmapOff := len(buffer)
@@ -195,9 +242,15 @@ func dumpBinaryRow(buffer []byte, columns []*ColumnInfo, row chunk.Row) ([]byte,
 			buffer = dumpUint32(buffer, math.Float32bits(row.GetFloat32(i)))
 		case mysql.TypeDouble:
 			buffer = dumpUint64(buffer, math.Float64bits(row.GetFloat64(i)))
+		case mysql.TypeNewDecimal:
+			buffer = dumpLengthEncodedString(buffer, []byte(row.GetMyDecimal(i).String()))
 		case mysql.TypeString, mysql.TypeVarString, mysql.TypeVarchar, mysql.TypeBit,
 			mysql.TypeTinyBlob, mysql.TypeMediumBlob, mysql.TypeLongBlob, mysql.TypeBlob:
 			buffer = dumpLengthEncodedString(buffer, row.GetBytes(i))
+		case mysql.TypeDate, mysql.TypeDatetime, mysql.TypeTimestamp:
+			buffer = dumpBinaryDateTime(buffer, row.GetTime(i))
+		case mysql.TypeDuration:
+			buffer = append(buffer, dumpBinaryTime(row.GetDuration(i, 0).Duration)...)
 		default:
 			return nil, errInvalidType.GenWithStack("invalid type %v", columns[i].Type)
 		}
@@ -246,9 +299,16 @@ func dumpTextRow(buffer []byte, columns []*ColumnInfo, row chunk.Row) ([]byte, e
 			}
 			tmp = appendFormatFloat(tmp[:0], row.GetFloat64(i), prec, 64)
 			buffer = dumpLengthEncodedString(buffer, tmp)
+		case mysql.TypeNewDecimal:
+			buffer = dumpLengthEncodedString(buffer, []byte(row.GetMyDecimal(i).String()))
 		case mysql.TypeString, mysql.TypeVarString, mysql.TypeVarchar, mysql.TypeBit,
 			mysql.TypeTinyBlob, mysql.TypeMediumBlob, mysql.TypeLongBlob, mysql.TypeBlob:
 			buffer = dumpLengthEncodedString(buffer, row.GetBytes(i))
+		case mysql.TypeDate, mysql.TypeDatetime, mysql.TypeTimestamp:
+			buffer = dumpLengthEncodedString(buffer, []byte(row.GetTime(i).String()))
+		case mysql.TypeDuration:
+			dur := row.GetDuration(i, int(col.Decimal))
+			buffer = dumpLengthEncodedString(buffer, []byte(dur.String()))
 		default:
 			return nil, errInvalidType.GenWithStack("invalid type %v", columns[i].Type)
 		}
@@ -109,6 +109,12 @@ func (s *testUtilSuite) TestDumpTextValue(c *C) {
 	c.Assert(err, IsNil)
 	c.Assert(mustDecodeStr(c, bs), Equals, "2.20")
 
+	d := types.NewDecimalDatum(types.NewDecFromStringForTest("-1.50"))
+	columns[0].Type = mysql.TypeNewDecimal
+	bs, err = dumpTextRow(nil, columns, chunk.MutRowFromDatums([]types.Datum{d}).ToRow())
+	c.Assert(err, IsNil)
+	c.Assert(mustDecodeStr(c, bs), Equals, "-1.50")
+
 	columns[0].Type = mysql.TypeBlob
 	bs, err = dumpTextRow(nil, columns, chunk.MutRowFromDatums([]types.Datum{types.NewBytesDatum([]byte("foo"))}).ToRow())
 	c.Assert(err, IsNil)
@@ -232,6 +232,12 @@ func (s *session) doCommit(ctx context.Context) error {
 	}
 	// Set this option for 2 phase commit to validate schema lease.
 	s.txn.SetOption(kv.SchemaChecker, domain.NewSchemaChecker(domain.GetDomain(s), s.sessionVars.TxnCtx.SchemaVersion, tableIDs))
+	if s.sessionVars.EnableAsyncCommit {
+		s.txn.SetOption(kv.EnableAsyncCommit, true)
+	}
+	if s.sessionVars.Enable1PC {
+		s.txn.SetOption(kv.Enable1PC, true)
+	}
 
 	return s.txn.Commit(sessionctx.SetCommitCtx(ctx, s))
 }
@@ -680,8 +686,8 @@ func (s *session) Txn(active bool) (kv.Transaction, error) {
 		if !s.sessionVars.IsAutocommit() {
 			s.sessionVars.SetStatusFlag(mysql.ServerStatusInTrans, true)
 		}
-		if s.sessionVars.GetReplicaRead().IsFollowerRead() {
-			s.txn.SetOption(kv.ReplicaRead, kv.ReplicaReadFollower)
+		if replicaRead := s.sessionVars.GetReplicaRead(); replicaRead.IsFollowerRead() {
+			s.txn.SetOption(kv.ReplicaRead, replicaRead)
 		}
 	}
 	return &s.txn, nil
@@ -706,8 +712,8 @@ func (s *session) NewTxn(ctx context.Context) error {
 	}
 	txn.SetCap(s.getMembufCap())
 	txn.SetVars(s.sessionVars.KVVars)
-	if s.GetSessionVars().GetReplicaRead().IsFollowerRead() {
-		txn.SetOption(kv.ReplicaRead, kv.ReplicaReadFollower)
+	if replicaRead := s.GetSessionVars().GetReplicaRead(); replicaRead.IsFollowerRead() {
+		txn.SetOption(kv.ReplicaRead, replicaRead)
 	}
 	s.txn.changeInvalidToValid(txn)
 	is := domain.GetDomain(s).InfoSchema()
@@ -972,6 +978,8 @@ var builtinGlobalVariable = []string{
 	variable.TiDBEnableVectorizedExpression,
 	variable.TiDBEnableNoopFuncs,
 	variable.TiDBMaxDeltaSchemaCount,
+	variable.TiDBEnableAsyncCommit,
+	variable.TiDBEnable1PC,
 }
 
 var (
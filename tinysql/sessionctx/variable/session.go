@@ -52,6 +52,9 @@ type TransactionContext struct {
 
 	CreateTime     time.Time
 	StatementCount int
+	// IsPessimistic is true if the transaction is started by BEGIN PESSIMISTIC, its DML statements lock the keys as
+	// they execute.
+	IsPessimistic bool
 }
 
 // UpdateDeltaForTable updates the delta info for some table.
@@ -268,6 +271,12 @@ type SessionVars struct {
 	// ConstraintCheckInPlace indicates whether to check the constraint when the SQL executing.
 	ConstraintCheckInPlace bool
 
+	// EnableAsyncCommit indicates whether to commit the transactions with async commit.
+	EnableAsyncCommit bool
+
+	// Enable1PC indicates whether to commit the transactions in a single region with one phase commit.
+	Enable1PC bool
+
 	// CommandValue indicates which command current session is doing.
 	CommandValue uint32
 
@@ -312,7 +321,7 @@ type SessionVars struct {
 	// allowInSubqToJoinAndAgg can be set to false to forbid rewriting the semi join to inner join with agg.
 	allowInSubqToJoinAndAgg bool
 
-	// replicaRead is used for reading data from replicas, only follower is supported at this time.
+	// replicaRead is used for reading data from replicas, the followers or their applied state.
 	replicaRead kv.ReplicaReadType
 
 	// RowEncoder is reused in session for encode row data.
@@ -372,6 +381,8 @@ func NewSessionVars() *SessionVars {
 		EnableNoopFuncs:             DefTiDBEnableNoopFuncs,
 		replicaRead:                 kv.ReplicaReadLeader,
 		AllowRemoveAutoInc:          DefTiDBAllowRemoveAutoInc,
+		EnableAsyncCommit:           DefTiDBEnableAsyncCommit,
+		Enable1PC:                   DefTiDBEnable1PC,
 	}
 	vars.Concurrency = Concurrency{
 		IndexLookupConcurrency:     DefIndexLookupConcurrency,
@@ -632,6 +643,10 @@ func (s *SessionVars) SetSystemVar(name string, val string) error {
 		s.EnableRadixJoin = TiDBOptOn(val)
 	case TiDBEnableVectorizedExpression:
 		s.EnableVectorizedExpression = TiDBOptOn(val)
+	case TiDBEnableAsyncCommit:
+		s.EnableAsyncCommit = TiDBOptOn(val)
+	case TiDBEnable1PC:
+		s.Enable1PC = TiDBOptOn(val)
 	case TiDBOptJoinReorderThreshold:
 		s.TiDBOptJoinReorderThreshold = tidbOptPositiveInt32(val, DefTiDBOptJoinReorderThreshold)
 	case TiDBSlowQueryFile:
@@ -645,6 +660,8 @@ func (s *SessionVars) SetSystemVar(name string, val string) error {
 	case TiDBReplicaRead:
 		if strings.EqualFold(val, "follower") {
 			s.SetReplicaRead(kv.ReplicaReadFollower)
+		} else if strings.EqualFold(val, "stale") {
+			s.SetReplicaRead(kv.ReplicaReadStale)
 		} else if strings.EqualFold(val, "leader") || len(val) == 0 {
 			s.SetReplicaRead(kv.ReplicaReadLeader)
 		}
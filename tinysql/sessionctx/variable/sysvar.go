@@ -677,6 +677,8 @@ var defaultSysVars = []*SysVar{
 	{ScopeGlobal | ScopeSession, TiDBEnableNoopFuncs, BoolToIntStr(DefTiDBEnableNoopFuncs)},
 	{ScopeSession, TiDBReplicaRead, "leader"},
 	{ScopeSession, TiDBAllowRemoveAutoInc, BoolToIntStr(DefTiDBAllowRemoveAutoInc)},
+	{ScopeGlobal | ScopeSession, TiDBEnableAsyncCommit, BoolToIntStr(DefTiDBEnableAsyncCommit)},
+	{ScopeGlobal | ScopeSession, TiDBEnable1PC, BoolToIntStr(DefTiDBEnable1PC)},
 }
 
 // SynonymsSysVariables is synonyms of system variables.
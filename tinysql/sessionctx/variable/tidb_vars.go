@@ -57,7 +57,8 @@ const (
 	// isolation level.
 	TiDBSkipIsolationLevelCheck = "tidb_skip_isolation_level_check"
 
-	// TiDBReplicaRead is used for reading data from replicas, followers for example.
+	// TiDBReplicaRead is used for reading data from replicas, followers for example. It's "leader", "follower" or
+	// "stale", a stale read serves the data applied by a follower, it should be used with tidb_snapshot.
 	TiDBReplicaRead = "tidb_replica_read"
 
 	// TiDBAllowRemoveAutoInc indicates whether a user can drop the auto_increment column attribute or not.
@@ -211,6 +212,13 @@ const (
 
 	// TiDBEnableNoopFuncs set true will enable using fake funcs(like get_lock release_lock)
 	TiDBEnableNoopFuncs = "tidb_enable_noop_functions"
+
+	// tidb_enable_async_commit indicates whether to commit small transactions with async commit, the transaction is
+	// committed once all the keys are prewritten.
+	TiDBEnableAsyncCommit = "tidb_enable_async_commit"
+
+	// tidb_enable_1pc indicates whether to commit the transactions in a single region with one phase commit.
+	TiDBEnable1PC = "tidb_enable_1pc"
 )
 
 // Default TiDB system variable values.
@@ -263,6 +271,8 @@ const (
 	DefWaitSplitRegionTimeout        = 300 // 300s
 	DefTiDBEnableNoopFuncs           = false
 	DefTiDBAllowRemoveAutoInc        = false
+	DefTiDBEnableAsyncCommit         = false
+	DefTiDBEnable1PC                 = false
 	DefInnodbLockWaitTimeout         = 50 // 50s
 )
 
@@ -79,3 +79,14 @@ type ErrConflict struct {
 func (e *ErrConflict) Error() string {
 	return "write conflict"
 }
+
+// ErrDeadlock is returned when a pessimistic lock request waits for a lock which would form a deadlock.
+type ErrDeadlock struct {
+	LockTS          uint64
+	LockKey         []byte
+	DeadlockKeyHash uint64
+}
+
+func (e *ErrDeadlock) Error() string {
+	return "deadlock"
+}
@@ -572,6 +572,155 @@ func (s *testMockTiKVSuite) TestDeleteRange(c *C) {
 	s.mustScanOK(c, "0", 10, 70)
 }
 
+func (s *testMockTiKVSuite) pessimisticLock(primary string, startTS, forUpdateTS uint64, keys ...string) []error {
+	req := &kvrpcpb.PessimisticLockRequest{
+		PrimaryLock:  []byte(primary),
+		StartVersion: startTS,
+		ForUpdateTs:  forUpdateTS,
+		LockTtl:      100,
+	}
+	for _, key := range keys {
+		req.Mutations = append(req.Mutations, &kvrpcpb.Mutation{
+			Op:  kvrpcpb.Op_PessimisticLock,
+			Key: []byte(key),
+		})
+	}
+	return s.store.PessimisticLock(req)
+}
+
+func (s *testMockTiKVSuite) mustPessimisticLockOK(c *C, primary string, startTS, forUpdateTS uint64, keys ...string) {
+	for _, err := range s.pessimisticLock(primary, startTS, forUpdateTS, keys...) {
+		c.Assert(err, IsNil)
+	}
+}
+
+func (s *testMockTiKVSuite) TestPessimisticLock(c *C) {
+	s.mustPutOK(c, "k1", "v1", 1, 2)
+	s.mustPutOK(c, "k2", "v2", 5, 10)
+
+	// k2 is written after the for_update_ts, no key is locked.
+	errs := s.pessimisticLock("k1", 3, 3, "k1", "k2")
+	c.Assert(errs[0], IsNil)
+	s.mustWriteWriteConflict(c, errs, 1)
+	s.mustPessimisticLockOK(c, "k1", 3, 20, "k3")
+
+	s.mustPessimisticLockOK(c, "k1", 3, 20, "k1", "k2")
+	// The pessimistic locks don't block reads.
+	s.mustGetOK(c, "k1", 25, "v1")
+	// But they block the other transactions.
+	errs = s.pessimisticLock("k2", 30, 30, "k2")
+	_, ok := errs[0].(*ErrLocked)
+	c.Assert(ok, IsTrue)
+
+	// k2 is not written, its pessimistic lock is rolled back.
+	mutations := putMutations("k1", "v3")
+	req := &kvrpcpb.PrewriteRequest{
+		Mutations:         append(mutations, putMutations("k4", "v4")...),
+		PrimaryLock:       []byte("k1"),
+		StartVersion:      3,
+		ForUpdateTs:       20,
+		IsPessimisticLock: []bool{true, false},
+	}
+	for _, err := range s.store.Prewrite(req) {
+		c.Assert(err, IsNil)
+	}
+	c.Assert(s.store.PessimisticRollback([][]byte{[]byte("k1"), []byte("k2"), []byte("k3")}, 3), HasLen, 0)
+	s.mustCommitOK(c, [][]byte{[]byte("k1"), []byte("k4")}, 3, 40)
+	s.mustGetOK(c, "k1", 45, "v3")
+	s.mustGetOK(c, "k4", 45, "v4")
+	s.mustPessimisticLockOK(c, "k2", 30, 50, "k2", "k3")
+}
+
+func (s *testMockTiKVSuite) TestPessimisticLockDeadlock(c *C) {
+	s.mustPessimisticLockOK(c, "k1", 10, 10, "k1")
+	s.mustPessimisticLockOK(c, "k2", 20, 20, "k2")
+
+	// 10 waits for 20.
+	errs := s.pessimisticLock("k1", 10, 30, "k2")
+	_, ok := errs[0].(*ErrLocked)
+	c.Assert(ok, IsTrue)
+	// 20 waits for 10, which is a deadlock.
+	errs = s.pessimisticLock("k2", 20, 40, "k1")
+	dl, ok := errs[0].(*ErrDeadlock)
+	c.Assert(ok, IsTrue)
+	c.Assert(dl.LockTS, Equals, uint64(10))
+	c.Assert(dl.LockKey, BytesEquals, []byte("k1"))
+
+	// 10 is rolled back, then 20 can lock k1.
+	c.Assert(s.store.PessimisticRollback([][]byte{[]byte("k1")}, 10), HasLen, 0)
+	s.mustPessimisticLockOK(c, "k2", 20, 40, "k1")
+}
+
+func (s *testMockTiKVSuite) TestPrewriteAsyncCommit(c *C) {
+	s.mustGetNone(c, "k1", 30)
+	req := &kvrpcpb.PrewriteRequest{
+		Mutations:      putMutations("k1", "v1", "k2", "v2"),
+		PrimaryLock:    []byte("k1"),
+		StartVersion:   10,
+		UseAsyncCommit: true,
+		Secondaries:    [][]byte{[]byte("k2")},
+	}
+	minCommitTS, errs := s.store.PrewriteAsyncCommit(req)
+	c.Assert(errs, HasLen, 2)
+	c.Assert(errs[0], IsNil)
+	c.Assert(errs[1], IsNil)
+	// The min commit ts is greater than the max read ts.
+	c.Assert(minCommitTS, Equals, uint64(31))
+
+	locks, commitTS, err := s.store.CheckSecondaryLocks([][]byte{[]byte("k2")}, 10)
+	c.Assert(err, IsNil)
+	c.Assert(commitTS, Equals, uint64(0))
+	c.Assert(locks, HasLen, 1)
+	c.Assert(locks[0].UseAsyncCommit, IsTrue)
+	c.Assert(locks[0].MinCommitTs, Equals, uint64(31))
+
+	s.mustCommitErr(c, [][]byte{[]byte("k1")}, 10, 20)
+	s.mustCommitOK(c, [][]byte{[]byte("k1")}, 10, 31)
+	locks, commitTS, err = s.store.CheckSecondaryLocks([][]byte{[]byte("k1")}, 10)
+	c.Assert(err, IsNil)
+	c.Assert(locks, HasLen, 0)
+	c.Assert(commitTS, Equals, uint64(31))
+}
+
+func (s *testMockTiKVSuite) TestCheckSecondaryLocksRollback(c *C) {
+	req := &kvrpcpb.PrewriteRequest{
+		Mutations:      putMutations("k1", "v1"),
+		PrimaryLock:    []byte("k1"),
+		StartVersion:   10,
+		UseAsyncCommit: true,
+		Secondaries:    [][]byte{[]byte("k2")},
+	}
+	_, errs := s.store.PrewriteAsyncCommit(req)
+	c.Assert(errs[0], IsNil)
+
+	// k2 isn't prewritten, it's rolled back.
+	locks, commitTS, err := s.store.CheckSecondaryLocks([][]byte{[]byte("k2")}, 10)
+	c.Assert(err, IsNil)
+	c.Assert(locks, HasLen, 0)
+	c.Assert(commitTS, Equals, uint64(0))
+
+	req.Mutations = putMutations("k2", "v2")
+	_, errs = s.store.PrewriteAsyncCommit(req)
+	c.Assert(errs[0], NotNil)
+}
+
+func (s *testMockTiKVSuite) TestPrewriteOnePC(c *C) {
+	req := &kvrpcpb.PrewriteRequest{
+		Mutations:    putMutations("k1", "v1", "k2", "v2"),
+		PrimaryLock:  []byte("k1"),
+		StartVersion: 10,
+		TryOnePc:     true,
+	}
+	commitTS, errs := s.store.PrewriteAsyncCommit(req)
+	c.Assert(errs[0], IsNil)
+	c.Assert(errs[1], IsNil)
+	c.Assert(commitTS, Equals, uint64(11))
+	s.mustScanLock(c, 20, nil)
+	s.mustGetNone(c, "k1", 10)
+	s.mustGetOK(c, "k1", 11, "v1")
+	s.mustGetOK(c, "k2", 11, "v2")
+}
+
 func (s *testMockTiKVSuite) mustWriteWriteConflict(c *C, errs []error, i int) {
 	c.Assert(errs[i], NotNil)
 	_, ok := errs[i].(*ErrConflict)
@@ -585,6 +734,10 @@ func (s testMarshal) TestMarshalmvccLock(c *C) {
 		value:   []byte{'d', 'e'},
 		op:      kvrpcpb.Op_Put,
 		ttl:     444,
+		// The fields of async commit.
+		useAsyncCommit: true,
+		minCommitTS:    48,
+		secondaries:    [][]byte{{'f'}, {'g', 'h'}},
 	}
 	bin, err := l.MarshalBinary()
 	c.Assert(err, IsNil)
@@ -598,6 +751,9 @@ func (s testMarshal) TestMarshalmvccLock(c *C) {
 	c.Assert(l.ttl, Equals, l1.ttl)
 	c.Assert(string(l.primary), Equals, string(l1.primary))
 	c.Assert(string(l.value), Equals, string(l1.value))
+	c.Assert(l1.useAsyncCommit, IsTrue)
+	c.Assert(l1.minCommitTS, Equals, l.minCommitTS)
+	c.Assert(l1.secondaries, DeepEquals, l.secondaries)
 }
 
 func (s testMarshal) TestMarshalmvccValue(c *C) {
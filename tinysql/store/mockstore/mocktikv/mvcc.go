@@ -47,6 +47,11 @@ type mvccLock struct {
 	op          kvrpcpb.Op
 	ttl         uint64
 	forUpdateTS uint64
+	// The locks of an async commit transaction carry the min commit ts, and the primary lock records the secondary
+	// keys.
+	useAsyncCommit bool
+	minCommitTS    uint64
+	secondaries    [][]byte
 }
 
 type mvccEntry struct {
@@ -67,6 +72,12 @@ func (l *mvccLock) MarshalBinary() ([]byte, error) {
 	mh.WriteNumber(&buf, l.op)
 	mh.WriteNumber(&buf, l.ttl)
 	mh.WriteNumber(&buf, l.forUpdateTS)
+	mh.WriteNumber(&buf, l.useAsyncCommit)
+	mh.WriteNumber(&buf, l.minCommitTS)
+	mh.WriteNumber(&buf, uint64(len(l.secondaries)))
+	for _, key := range l.secondaries {
+		mh.WriteSlice(&buf, key)
+	}
 	return buf.Bytes(), errors.Trace(mh.err)
 }
 
@@ -80,6 +91,16 @@ func (l *mvccLock) UnmarshalBinary(data []byte) error {
 	mh.ReadNumber(buf, &l.op)
 	mh.ReadNumber(buf, &l.ttl)
 	mh.ReadNumber(buf, &l.forUpdateTS)
+	mh.ReadNumber(buf, &l.useAsyncCommit)
+	mh.ReadNumber(buf, &l.minCommitTS)
+	var n uint64
+	mh.ReadNumber(buf, &n)
+	l.secondaries = nil
+	for i := uint64(0); i < n && mh.err == nil; i++ {
+		var key []byte
+		mh.ReadSlice(buf, &key)
+		l.secondaries = append(l.secondaries, key)
+	}
 	return errors.Trace(mh.err)
 }
 
@@ -194,9 +215,21 @@ func (l *mvccLock) lockErr(key []byte) error {
 	}
 }
 
+func (l *mvccLock) lockInfo(key []byte) *kvrpcpb.LockInfo {
+	return &kvrpcpb.LockInfo{
+		PrimaryLock:    l.primary,
+		LockVersion:    l.startTS,
+		Key:            key,
+		LockTtl:        l.ttl,
+		UseAsyncCommit: l.useAsyncCommit,
+		MinCommitTs:    l.minCommitTS,
+		Secondaries:    l.secondaries,
+	}
+}
+
 func (l *mvccLock) check(ts uint64, key []byte) (uint64, error) {
-	// ignore when ts is older than lock or lock's type is Lock.
-	if l.startTS > ts || l.op == kvrpcpb.Op_Lock {
+	// ignore when ts is older than lock or lock's type is Lock or PessimisticLock.
+	if l.startTS > ts || l.op == kvrpcpb.Op_Lock || l.op == kvrpcpb.Op_PessimisticLock {
 		return ts, nil
 	}
 	// for point get latest version.
@@ -232,6 +265,11 @@ type MVCCStore interface {
 	Scan(startKey, endKey []byte, limit int, startTS uint64) []Pair
 	ReverseScan(startKey, endKey []byte, limit int, startTS uint64) []Pair
 	Prewrite(req *kvrpcpb.PrewriteRequest) []error
+	// PrewriteAsyncCommit prewrites the mutations of an async commit or 1PC request, all or nothing. It returns the
+	// min commit ts of the locks, or the commit ts of a 1PC request.
+	PrewriteAsyncCommit(req *kvrpcpb.PrewriteRequest) (uint64, []error)
+	PessimisticLock(req *kvrpcpb.PessimisticLockRequest) []error
+	PessimisticRollback(keys [][]byte, startTS uint64) []error
 	Commit(keys [][]byte, startTS, commitTS uint64) error
 	Rollback(keys [][]byte, startTS uint64) error
 	Cleanup(key []byte, startTS, currentTS uint64) error
@@ -242,6 +280,9 @@ type MVCCStore interface {
 	GC(startKey, endKey []byte, safePoint uint64) error
 	DeleteRange(startKey, endKey []byte) error
 	CheckTxnStatus(primaryKey []byte, lockTS uint64, currentTS uint64) (uint64, uint64, kvrpcpb.Action, error)
+	// CheckSecondaryLocks returns the locks of an async commit transaction if all keys are prewritten, or the commit
+	// ts if any key is committed. Otherwise the keys which are not prewritten are rolled back.
+	CheckSecondaryLocks(keys [][]byte, startTS uint64) ([]*kvrpcpb.LockInfo, uint64, error)
 	Close() error
 }
 
@@ -256,6 +297,9 @@ type RawKV interface {
 	RawDelete(key []byte)
 	RawBatchDelete(keys [][]byte)
 	RawDeleteRange(startKey, endKey []byte)
+	// RawCompareAndSwap sets the value if the previous value matches, it returns the previous value, which is nil if
+	// the key doesn't exist.
+	RawCompareAndSwap(key, value []byte, previousNotExist bool, previousValue []byte) (previous []byte, succeed bool)
 }
 
 // Pair is a KV pair read from MvccStore or an error if any occurs.
@@ -15,9 +15,12 @@ package mocktikv
 
 import (
 	"bytes"
+	"fmt"
 	"math"
 	"sync"
+	"sync/atomic"
 
+	"github.com/pingcap-incubator/tinykv/kv/transaction/deadlock"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
 	"github.com/pingcap/errors"
 	"github.com/pingcap/goleveldb/leveldb"
@@ -61,6 +64,10 @@ type MVCCLevelDB struct {
 	// leveldb can not guarantee multiple operations to be atomic, for example, read
 	// then write, another write may happen during it, so this lock is necessory.
 	mu sync.RWMutex
+	// deadlockDetector detects the deadlocks of the pessimistic lock requests.
+	deadlockDetector *deadlock.Detector
+	// maxTS is the max timestamp of the reads, the commit ts of an async commit or 1PC transaction is greater than it.
+	maxTS uint64
 }
 
 const lockVer uint64 = math.MaxUint64
@@ -121,7 +128,7 @@ func NewMVCCLevelDB(path string) (*MVCCLevelDB, error) {
 		d, err = leveldb.OpenFile(path, &opt.Options{BlockCacheCapacity: 600 * 1024 * 1024})
 	}
 
-	return &MVCCLevelDB{db: d}, errors.Trace(err)
+	return &MVCCLevelDB{db: d, deadlockDetector: deadlock.NewDetector(deadlock.DefaultEntryTTL)}, errors.Trace(err)
 }
 
 // Iterator wraps iterator.Iterator to provide Valid() method.
@@ -258,9 +265,24 @@ func (dec *skipDecoder) Decode(iter *Iterator) (bool, error) {
 	return false, nil
 }
 
+// updateMaxTS is called before reading at ts, so an async commit or 1PC prewrite either sees the ts, or writes the
+// locks before the read.
+func (mvcc *MVCCLevelDB) updateMaxTS(ts uint64) {
+	if ts == math.MaxUint64 {
+		return
+	}
+	for {
+		maxTS := atomic.LoadUint64(&mvcc.maxTS)
+		if ts <= maxTS || atomic.CompareAndSwapUint64(&mvcc.maxTS, maxTS, ts) {
+			return
+		}
+	}
+}
+
 // Get implements the MVCCStore interface.
 // key cannot be nil or []byte{}
 func (mvcc *MVCCLevelDB) Get(key []byte, startTS uint64) ([]byte, error) {
+	mvcc.updateMaxTS(startTS)
 	mvcc.mu.RLock()
 	defer mvcc.mu.RUnlock()
 
@@ -313,6 +335,7 @@ func getValue(iter *Iterator, key []byte, startTS uint64) ([]byte, error) {
 
 // Scan implements the MVCCStore interface.
 func (mvcc *MVCCLevelDB) Scan(startKey, endKey []byte, limit int, startTS uint64) []Pair {
+	mvcc.updateMaxTS(startTS)
 	mvcc.mu.RLock()
 	defer mvcc.mu.RUnlock()
 
@@ -353,6 +376,7 @@ func (mvcc *MVCCLevelDB) Scan(startKey, endKey []byte, limit int, startTS uint64
 
 // ReverseScan implements the MVCCStore interface. The search range is [startKey, endKey).
 func (mvcc *MVCCLevelDB) ReverseScan(startKey, endKey []byte, limit int, startTS uint64) []Pair {
+	mvcc.updateMaxTS(startTS)
 	mvcc.mu.RLock()
 	defer mvcc.mu.RUnlock()
 
@@ -450,10 +474,11 @@ func (mvcc *MVCCLevelDB) Prewrite(req *kvrpcpb.PrewriteRequest) []error {
 	anyError := false
 	batch := &leveldb.Batch{}
 	errs := make([]error, 0, len(mutations))
-	for _, m := range mutations {
+	for i, m := range mutations {
 		// If the operation is Insert, check if key is exists at first.
 		var err error
-		err = prewriteMutation(mvcc.db, batch, m, startTS, primary, ttl)
+		isPessimisticLock := len(req.IsPessimisticLock) > 0 && req.IsPessimisticLock[i]
+		err = prewriteMutation(mvcc.db, batch, m, startTS, req.ForUpdateTs, primary, ttl, isPessimisticLock)
 		errs = append(errs, err)
 		if err != nil {
 			anyError = true
@@ -469,6 +494,63 @@ func (mvcc *MVCCLevelDB) Prewrite(req *kvrpcpb.PrewriteRequest) []error {
 	return errs
 }
 
+// PrewriteAsyncCommit implements the MVCCStore interface.
+func (mvcc *MVCCLevelDB) PrewriteAsyncCommit(req *kvrpcpb.PrewriteRequest) (uint64, []error) {
+	startTS := req.StartVersion
+	mvcc.mu.Lock()
+	defer mvcc.mu.Unlock()
+
+	minCommitTS := req.MinCommitTs
+	for _, ts := range []uint64{startTS, req.ForUpdateTs, atomic.LoadUint64(&mvcc.maxTS)} {
+		if minCommitTS <= ts {
+			minCommitTS = ts + 1
+		}
+	}
+
+	anyError := false
+	errs := make([]error, 0, len(req.Mutations))
+	for i, m := range req.Mutations {
+		isPessimisticLock := len(req.IsPessimisticLock) > 0 && req.IsPessimisticLock[i]
+		err := checkPrewriteMutation(mvcc.db, m, startTS, req.ForUpdateTs, isPessimisticLock)
+		errs = append(errs, err)
+		if err != nil {
+			anyError = true
+		}
+	}
+	if anyError {
+		return 0, errs
+	}
+
+	batch := &leveldb.Batch{}
+	for _, m := range req.Mutations {
+		lock := mvccLock{
+			startTS:        startTS,
+			primary:        req.PrimaryLock,
+			value:          m.Value,
+			op:             m.Op,
+			ttl:            req.LockTtl,
+			useAsyncCommit: req.UseAsyncCommit,
+			minCommitTS:    minCommitTS,
+		}
+		if req.UseAsyncCommit && bytes.Equal(m.Key, req.PrimaryLock) {
+			lock.secondaries = req.Secondaries
+		}
+		var err error
+		if req.TryOnePc {
+			err = commitLock(batch, lock, m.Key, startTS, minCommitTS)
+		} else {
+			err = putLock(batch, m.Key, lock)
+		}
+		if err != nil {
+			return 0, []error{err}
+		}
+	}
+	if err := mvcc.db.Write(batch, nil); err != nil {
+		return 0, []error{err}
+	}
+	return minCommitTS, errs
+}
+
 func checkConflictValue(iter *Iterator, m *kvrpcpb.Mutation, startTS uint64) error {
 	dec := valueDecoder{
 		expectKey: m.Key,
@@ -491,8 +573,34 @@ func checkConflictValue(iter *Iterator, m *kvrpcpb.Mutation, startTS uint64) err
 }
 
 func prewriteMutation(db *leveldb.DB, batch *leveldb.Batch,
-	mutation *kvrpcpb.Mutation, startTS uint64,
-	primary []byte, ttl uint64) error {
+	mutation *kvrpcpb.Mutation, startTS, forUpdateTS uint64,
+	primary []byte, ttl uint64, isPessimisticLock bool) error {
+	if err := checkPrewriteMutation(db, mutation, startTS, forUpdateTS, isPessimisticLock); err != nil {
+		return err
+	}
+	lock := mvccLock{
+		startTS: startTS,
+		primary: primary,
+		value:   mutation.Value,
+		op:      mutation.GetOp(),
+		ttl:     ttl,
+	}
+	return putLock(batch, mutation.Key, lock)
+}
+
+func putLock(batch *leveldb.Batch, key []byte, lock mvccLock) error {
+	writeValue, err := lock.MarshalBinary()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	batch.Put(mvccEncode(key, lockVer), writeValue)
+	return nil
+}
+
+// checkPrewriteMutation checks if mutation can be prewritten, the key must not be locked by other transactions or
+// written after the transaction starts.
+func checkPrewriteMutation(db *leveldb.DB, mutation *kvrpcpb.Mutation, startTS, forUpdateTS uint64,
+	isPessimisticLock bool) error {
 	startKey := mvccEncode(mutation.Key, lockVer)
 	iter := newIterator(db, &util.Range{
 		Start: startKey,
@@ -506,33 +614,145 @@ func prewriteMutation(db *leveldb.DB, batch *leveldb.Batch,
 	if err != nil {
 		return errors.Trace(err)
 	}
-	if ok {
+	if isPessimisticLock {
+		// The key must have been locked by the pessimistic lock of the transaction.
+		if !ok || dec.lock.startTS != startTS {
+			return ErrAbort("pessimistic lock not found")
+		}
+	} else if ok {
 		if dec.lock.startTS != startTS {
 			return dec.lock.lockErr(mutation.Key)
 		}
 	} else {
-		err = checkConflictValue(iter, mutation, startTS)
+		// The keys of a pessimistic transaction which are not locked are checked from the for_update_ts.
+		if forUpdateTS < startTS {
+			forUpdateTS = startTS
+		}
+		err = checkConflictValue(iter, mutation, forUpdateTS)
 		if err != nil {
 			return err
 		}
 	}
+	return nil
+}
 
-	op := mutation.GetOp()
-	lock := mvccLock{
-		startTS: startTS,
-		primary: primary,
-		value:   mutation.Value,
-		op:      op,
-		ttl:     ttl,
+// PessimisticLock implements the MVCCStore interface.
+func (mvcc *MVCCLevelDB) PessimisticLock(req *kvrpcpb.PessimisticLockRequest) []error {
+	mutations := req.Mutations
+	startTS := req.StartVersion
+	mvcc.mu.Lock()
+	defer mvcc.mu.Unlock()
+
+	anyError := false
+	batch := &leveldb.Batch{}
+	errs := make([]error, 0, len(mutations))
+	for _, m := range mutations {
+		err := pessimisticLockMutation(mvcc.db, batch, m, startTS, req.ForUpdateTs, req.PrimaryLock, req.LockTtl)
+		if locked, ok := err.(*ErrLocked); ok {
+			if dl := mvcc.deadlockDetector.Detect(startTS, locked.StartTS, deadlock.KeyHash(m.Key)); dl != nil {
+				err = &ErrDeadlock{
+					LockTS:          locked.StartTS,
+					LockKey:         m.Key,
+					DeadlockKeyHash: dl.KeyHash,
+				}
+			}
+		}
+		errs = append(errs, err)
+		if err != nil {
+			anyError = true
+		}
+	}
+	if anyError {
+		return errs
+	}
+	mvcc.deadlockDetector.CleanUp(startTS)
+	if err := mvcc.db.Write(batch, nil); err != nil {
+		return []error{err}
 	}
 
-	writeKey := mvccEncode(mutation.Key, lockVer)
+	return errs
+}
+
+func pessimisticLockMutation(db *leveldb.DB, batch *leveldb.Batch,
+	mutation *kvrpcpb.Mutation, startTS, forUpdateTS uint64,
+	primary []byte, ttl uint64) error {
+	startKey := mvccEncode(mutation.Key, lockVer)
+	iter := newIterator(db, &util.Range{
+		Start: startKey,
+	})
+	defer iter.Release()
+
+	dec := lockDecoder{
+		expectKey: mutation.Key,
+	}
+	ok, err := dec.Decode(iter)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if ok {
+		if dec.lock.startTS != startTS {
+			return dec.lock.lockErr(mutation.Key)
+		}
+		// The key is locked by the transaction already.
+		return nil
+	}
+	err = checkConflictValue(iter, mutation, forUpdateTS)
+	if err != nil {
+		return err
+	}
+
+	lock := mvccLock{
+		startTS:     startTS,
+		primary:     primary,
+		op:          kvrpcpb.Op_PessimisticLock,
+		ttl:         ttl,
+		forUpdateTS: forUpdateTS,
+	}
 	writeValue, err := lock.MarshalBinary()
 	if err != nil {
 		return errors.Trace(err)
 	}
+	batch.Put(startKey, writeValue)
+	return nil
+}
 
-	batch.Put(writeKey, writeValue)
+// PessimisticRollback implements the MVCCStore interface.
+func (mvcc *MVCCLevelDB) PessimisticRollback(keys [][]byte, startTS uint64) []error {
+	mvcc.mu.Lock()
+	defer mvcc.mu.Unlock()
+
+	batch := &leveldb.Batch{}
+	for _, k := range keys {
+		err := pessimisticRollbackKey(mvcc.db, batch, k, startTS)
+		if err != nil {
+			return []error{err}
+		}
+	}
+	mvcc.deadlockDetector.CleanUp(startTS)
+	if err := mvcc.db.Write(batch, nil); err != nil {
+		return []error{err}
+	}
+	return nil
+}
+
+func pessimisticRollbackKey(db *leveldb.DB, batch *leveldb.Batch, key []byte, startTS uint64) error {
+	startKey := mvccEncode(key, lockVer)
+	iter := newIterator(db, &util.Range{
+		Start: startKey,
+	})
+	defer iter.Release()
+
+	dec := lockDecoder{
+		expectKey: key,
+	}
+	ok, err := dec.Decode(iter)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	// The prewritten keys are committed or rolled back with the transaction.
+	if ok && dec.lock.startTS == startTS && dec.lock.op == kvrpcpb.Op_PessimisticLock {
+		batch.Delete(startKey)
+	}
 	return nil
 }
 
@@ -542,6 +762,7 @@ func (mvcc *MVCCLevelDB) Commit(keys [][]byte, startTS, commitTS uint64) error {
 	defer func() {
 		mvcc.mu.Unlock()
 	}()
+	mvcc.deadlockDetector.CleanUp(startTS)
 
 	batch := &leveldb.Batch{}
 	for _, k := range keys {
@@ -588,7 +809,10 @@ func commitKey(db *leveldb.DB, batch *leveldb.Batch, key []byte, startTS, commit
 }
 
 func commitLock(batch *leveldb.Batch, lock mvccLock, key []byte, startTS, commitTS uint64) error {
-	if lock.op != kvrpcpb.Op_Lock {
+	if lock.useAsyncCommit && commitTS < lock.minCommitTS {
+		return ErrAbort(fmt.Sprintf("commit ts %d is less than the min commit ts %d", commitTS, lock.minCommitTS))
+	}
+	if lock.op != kvrpcpb.Op_Lock && lock.op != kvrpcpb.Op_PessimisticLock {
 		var valueType mvccValueType
 		if lock.op == kvrpcpb.Op_Put {
 			valueType = typePut
@@ -618,6 +842,7 @@ func (mvcc *MVCCLevelDB) Rollback(keys [][]byte, startTS uint64) error {
 	defer func() {
 		mvcc.mu.Unlock()
 	}()
+	mvcc.deadlockDetector.CleanUp(startTS)
 
 	batch := &leveldb.Batch{}
 	for _, k := range keys {
@@ -821,8 +1046,9 @@ func (mvcc *MVCCLevelDB) CheckTxnStatus(primaryKey []byte, lockTS, currentTS uin
 			lock := dec.lock
 			batch := &leveldb.Batch{}
 
-			// If the lock has already outdated, clean up it.
-			if uint64(oracle.ExtractPhysical(lock.startTS))+lock.ttl < uint64(oracle.ExtractPhysical(currentTS)) {
+			// If the lock has already outdated, clean up it. The status of an async commit transaction is decided by
+			// the secondary locks instead.
+			if !lock.useAsyncCommit && uint64(oracle.ExtractPhysical(lock.startTS))+lock.ttl < uint64(oracle.ExtractPhysical(currentTS)) {
 				if err = rollbackLock(batch, primaryKey, lockTS); err != nil {
 					err = errors.Trace(err)
 					return
@@ -921,11 +1147,7 @@ func (mvcc *MVCCLevelDB) ScanLock(startKey, endKey []byte, maxTS uint64) ([]*kvr
 			return nil, errors.Trace(err)
 		}
 		if ok && dec.lock.startTS <= maxTS {
-			locks = append(locks, &kvrpcpb.LockInfo{
-				PrimaryLock: dec.lock.primary,
-				LockVersion: dec.lock.startTS,
-				Key:         currKey,
-			})
+			locks = append(locks, dec.lock.lockInfo(currKey))
 		}
 
 		skip := skipDecoder{currKey: currKey}
@@ -938,10 +1160,70 @@ func (mvcc *MVCCLevelDB) ScanLock(startKey, endKey []byte, maxTS uint64) ([]*kvr
 	return locks, nil
 }
 
+// CheckSecondaryLocks implements the MVCCStore interface.
+func (mvcc *MVCCLevelDB) CheckSecondaryLocks(keys [][]byte, startTS uint64) ([]*kvrpcpb.LockInfo, uint64, error) {
+	mvcc.mu.Lock()
+	defer mvcc.mu.Unlock()
+
+	var locks []*kvrpcpb.LockInfo
+	for _, key := range keys {
+		lock, commitTS, err := checkSecondaryLock(mvcc.db, key, startTS)
+		if err != nil {
+			return nil, 0, errors.Trace(err)
+		}
+		if lock != nil {
+			locks = append(locks, lock)
+			continue
+		}
+		if commitTS > 0 {
+			return nil, commitTS, nil
+		}
+		// The key isn't prewritten, roll it back so it can't be prewritten any more.
+		batch := &leveldb.Batch{}
+		if err = rollbackKey(mvcc.db, batch, key, startTS); err != nil {
+			return nil, 0, errors.Trace(err)
+		}
+		return nil, 0, mvcc.db.Write(batch, nil)
+	}
+	return locks, 0, nil
+}
+
+// checkSecondaryLock returns the prewritten lock of the transaction on key, or the commit ts if the key has been
+// committed.
+func checkSecondaryLock(db *leveldb.DB, key []byte, startTS uint64) (*kvrpcpb.LockInfo, uint64, error) {
+	iter := newIterator(db, &util.Range{
+		Start: mvccEncode(key, lockVer),
+	})
+	defer iter.Release()
+
+	dec := lockDecoder{
+		expectKey: key,
+	}
+	ok, err := dec.Decode(iter)
+	if err != nil {
+		return nil, 0, errors.Trace(err)
+	}
+	if ok && dec.lock.startTS == startTS {
+		if dec.lock.op == kvrpcpb.Op_PessimisticLock {
+			return nil, 0, nil
+		}
+		return dec.lock.lockInfo(key), 0, nil
+	}
+	c, ok, err := getTxnCommitInfo(iter, key, startTS)
+	if err != nil {
+		return nil, 0, errors.Trace(err)
+	}
+	if ok && c.valueType != typeRollback {
+		return nil, c.commitTS, nil
+	}
+	return nil, 0, nil
+}
+
 // ResolveLock implements the MVCCStore interface.
 func (mvcc *MVCCLevelDB) ResolveLock(startKey, endKey []byte, startTS, commitTS uint64) error {
 	mvcc.mu.Lock()
 	defer mvcc.mu.Unlock()
+	mvcc.deadlockDetector.CleanUp(startTS)
 
 	iter, currKey, err := newScanIterator(mvcc.db, startKey, endKey)
 	defer iter.Release()
@@ -1139,7 +1421,13 @@ func (mvcc *MVCCLevelDB) RawBatchGet(keys [][]byte) [][]byte {
 	values := make([][]byte, 0, len(keys))
 	for _, key := range keys {
 		value, err := mvcc.db.Get(key, nil)
-		terror.Log(err)
+		if err != nil {
+			// An absent key is nil, an empty value is kept, the same as TiKV.
+			if err != leveldb.ErrNotFound {
+				terror.Log(err)
+			}
+			value = nil
+		}
 		values = append(values, value)
 	}
 	return values
@@ -1227,6 +1515,29 @@ func (mvcc *MVCCLevelDB) RawDeleteRange(startKey, endKey []byte) {
 	terror.Log(mvcc.doRawDeleteRange(startKey, endKey))
 }
 
+// RawCompareAndSwap implements the RawKV interface.
+func (mvcc *MVCCLevelDB) RawCompareAndSwap(key, value []byte, previousNotExist bool, previousValue []byte) ([]byte, bool) {
+	mvcc.mu.Lock()
+	defer mvcc.mu.Unlock()
+
+	previous, err := mvcc.db.Get(key, nil)
+	if err != nil && err != leveldb.ErrNotFound {
+		terror.Log(err)
+		return nil, false
+	}
+	if previousNotExist != (previous == nil) || (!previousNotExist && !bytes.Equal(previous, previousValue)) {
+		return previous, false
+	}
+	if value == nil {
+		value = []byte{}
+	}
+	if err = mvcc.db.Put(key, value, nil); err != nil {
+		terror.Log(err)
+		return previous, false
+	}
+	return previous, true
+}
+
 // doRawDeleteRange deletes all keys in a range and return the error if any.
 func (mvcc *MVCCLevelDB) doRawDeleteRange(startKey, endKey []byte) error {
 	mvcc.mu.Lock()
@@ -131,4 +131,16 @@ func (c *pdClient) GetOperator(ctx context.Context, regionID uint64) (*scheduler
 	return &schedulerpb.GetOperatorResponse{Status: schedulerpb.OperatorStatus_SUCCESS}, nil
 }
 
+func (c *pdClient) GetPlacementRules(ctx context.Context) ([]*schedulerpb.PlacementRule, error) {
+	return nil, nil
+}
+
+func (c *pdClient) SetPlacementRule(ctx context.Context, rule *schedulerpb.PlacementRule) error {
+	return nil
+}
+
+func (c *pdClient) DeletePlacementRule(ctx context.Context, groupID, id string) error {
+	return nil
+}
+
 func (c *pdClient) GetLeaderAddr() string { return "mockpd" }
@@ -14,6 +14,7 @@
 package mocktikv
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
@@ -63,6 +64,15 @@ func convertToKeyError(err error) *kvrpcpb.KeyError {
 			},
 		}
 	}
+	if dl, ok := errors.Cause(err).(*ErrDeadlock); ok {
+		return &kvrpcpb.KeyError{
+			Deadlock: &kvrpcpb.Deadlock{
+				LockTs:          dl.LockTS,
+				LockKey:         dl.LockKey,
+				DeadlockKeyHash: dl.DeadlockKeyHash,
+			},
+		}
+	}
 	if retryable, ok := errors.Cause(err).(ErrRetryable); ok {
 		return &kvrpcpb.KeyError{
 			Retryable: retryable.Error(),
@@ -163,8 +173,8 @@ func (h *rpcHandler) checkRequestContext(ctx *kvrpcpb.Context) *errorpb.Error {
 			},
 		}
 	}
-	// The Peer on the Store is not leader.
-	if storePeer.GetId() != leaderPeer.GetId() {
+	// The Peer on the Store is not leader, the replica reads can be served by the followers.
+	if storePeer.GetId() != leaderPeer.GetId() && !ctx.GetReplicaRead() && !ctx.GetStaleRead() {
 		return &errorpb.Error{
 			Message: *proto.String("not leader"),
 			NotLeader: &errorpb.NotLeader{
@@ -211,7 +221,8 @@ func (h *rpcHandler) handleKvGet(req *kvrpcpb.GetRequest) *kvrpcpb.GetResponse {
 		}
 	}
 	return &kvrpcpb.GetResponse{
-		Value: val,
+		Value:    val,
+		NotFound: val == nil,
 	}
 }
 
@@ -233,12 +244,50 @@ func (h *rpcHandler) handleKvPrewrite(req *kvrpcpb.PrewriteRequest) *kvrpcpb.Pre
 			panic("KvPrewrite: key not in region")
 		}
 	}
+	if req.UseAsyncCommit || req.TryOnePc {
+		commitTS, errs := h.mvccStore.PrewriteAsyncCommit(req)
+		resp := &kvrpcpb.PrewriteResponse{
+			Errors: convertToKeyErrors(errs),
+		}
+		if len(resp.Errors) == 0 {
+			if req.TryOnePc {
+				resp.OnePcCommitTs = commitTS
+			} else {
+				resp.MinCommitTs = commitTS
+			}
+		}
+		return resp
+	}
 	errs := h.mvccStore.Prewrite(req)
 	return &kvrpcpb.PrewriteResponse{
 		Errors: convertToKeyErrors(errs),
 	}
 }
 
+func (h *rpcHandler) handleKvPessimisticLock(req *kvrpcpb.PessimisticLockRequest) *kvrpcpb.PessimisticLockResponse {
+	for _, m := range req.Mutations {
+		if !h.checkKeyInRegion(m.Key) {
+			panic("KvPessimisticLock: key not in region")
+		}
+	}
+	errs := h.mvccStore.PessimisticLock(req)
+	return &kvrpcpb.PessimisticLockResponse{
+		Errors: convertToKeyErrors(errs),
+	}
+}
+
+func (h *rpcHandler) handleKvPessimisticRollback(req *kvrpcpb.PessimisticRollbackRequest) *kvrpcpb.PessimisticRollbackResponse {
+	for _, k := range req.Keys {
+		if !h.checkKeyInRegion(k) {
+			panic("KvPessimisticRollback: key not in region")
+		}
+	}
+	errs := h.mvccStore.PessimisticRollback(req.Keys, req.StartVersion)
+	return &kvrpcpb.PessimisticRollbackResponse{
+		Errors: convertToKeyErrors(errs),
+	}
+}
+
 func (h *rpcHandler) handleKvCommit(req *kvrpcpb.CommitRequest) *kvrpcpb.CommitResponse {
 	for _, k := range req.Keys {
 		if !h.checkKeyInRegion(k) {
@@ -263,9 +312,55 @@ func (h *rpcHandler) handleKvCheckTxnStatus(req *kvrpcpb.CheckTxnStatusRequest)
 		return nil, err
 	}
 	resp.LockTtl, resp.CommitVersion, resp.Action = ttl, commitTS, action
+	if ttl > 0 {
+		// Return the primary lock of an async commit transaction, the status is decided by the secondary locks.
+		endKey := append(append([]byte{}, req.PrimaryKey...), 0)
+		locks, err := h.mvccStore.ScanLock(req.PrimaryKey, endKey, req.LockTs)
+		if err != nil {
+			return nil, err
+		}
+		for _, lock := range locks {
+			if lock.LockVersion == req.LockTs && lock.UseAsyncCommit {
+				resp.LockInfo = lock
+			}
+		}
+	}
 	return &resp, nil
 }
 
+func (h *rpcHandler) handleKvTxnHeartBeat(req *kvrpcpb.TxnHeartBeatRequest) *kvrpcpb.TxnHeartBeatResponse {
+	if !h.checkKeyInRegion(req.PrimaryLock) {
+		panic("KvTxnHeartBeat: key not in region")
+	}
+	ttl, err := h.mvccStore.TxnHeartBeat(req.PrimaryLock, req.StartVersion, req.AdviseLockTtl)
+	if err != nil {
+		return &kvrpcpb.TxnHeartBeatResponse{
+			Error: convertToKeyError(err),
+		}
+	}
+	return &kvrpcpb.TxnHeartBeatResponse{
+		LockTtl: ttl,
+	}
+}
+
+func (h *rpcHandler) handleKvCheckSecondaryLocks(req *kvrpcpb.CheckSecondaryLocksRequest) *kvrpcpb.CheckSecondaryLocksResponse {
+	for _, k := range req.Keys {
+		if !h.checkKeyInRegion(k) {
+			panic("KvCheckSecondaryLocks: key not in region")
+		}
+	}
+	locks, commitTS, err := h.mvccStore.CheckSecondaryLocks(req.Keys, req.StartVersion)
+	if err != nil {
+		return &kvrpcpb.CheckSecondaryLocksResponse{
+			Error: convertToKeyError(err),
+		}
+	}
+	return &kvrpcpb.CheckSecondaryLocksResponse{
+		Locks:    locks,
+		CommitTs: commitTS,
+	}
+}
+
 func (h *rpcHandler) handleKvBatchRollback(req *kvrpcpb.BatchRollbackRequest) *kvrpcpb.BatchRollbackResponse {
 	err := h.mvccStore.Rollback(req.Keys, req.StartVersion)
 	if err != nil {
@@ -276,6 +371,41 @@ func (h *rpcHandler) handleKvBatchRollback(req *kvrpcpb.BatchRollbackRequest) *k
 	return &kvrpcpb.BatchRollbackResponse{}
 }
 
+func (h *rpcHandler) handleKvScanLock(req *kvrpcpb.ScanLockRequest) *kvrpcpb.ScanLockResponse {
+	startKey := MvccKey(h.startKey).Raw()
+	endKey := MvccKey(h.endKey).Raw()
+	if bytes.Compare(req.GetStartKey(), startKey) > 0 {
+		startKey = req.GetStartKey()
+	}
+	if len(req.GetEndKey()) > 0 && (len(endKey) == 0 || bytes.Compare(req.GetEndKey(), endKey) < 0) {
+		endKey = req.GetEndKey()
+	}
+	locks, err := h.mvccStore.ScanLock(startKey, endKey, req.GetMaxVersion())
+	if err != nil {
+		return &kvrpcpb.ScanLockResponse{
+			Error: convertToKeyError(err),
+		}
+	}
+	if req.GetLimit() > 0 && len(locks) > int(req.GetLimit()) {
+		locks = locks[:req.GetLimit()]
+	}
+	return &kvrpcpb.ScanLockResponse{
+		Locks: locks,
+	}
+}
+
+func (h *rpcHandler) handleKvDeleteRange(req *kvrpcpb.DeleteRangeRequest) *kvrpcpb.DeleteRangeResponse {
+	if !h.checkKeyInRegion(req.StartKey) {
+		panic("KvDeleteRange: key not in region")
+	}
+	var resp kvrpcpb.DeleteRangeResponse
+	err := h.mvccStore.DeleteRange(req.StartKey, req.EndKey)
+	if err != nil {
+		resp.Error = err.Error()
+	}
+	return &resp
+}
+
 func (h *rpcHandler) handleKvResolveLock(req *kvrpcpb.ResolveLockRequest) *kvrpcpb.ResolveLockResponse {
 	startKey := MvccKey(h.startKey).Raw()
 	endKey := MvccKey(h.endKey).Raw()
@@ -307,6 +437,11 @@ func (h *rpcHandler) handleKvRawPut(req *kvrpcpb.RawPutRequest) *kvrpcpb.RawPutR
 			Error: "not implemented",
 		}
 	}
+	if req.GetTtl() != 0 {
+		return &kvrpcpb.RawPutResponse{
+			Error: errRawTTLNotSupported,
+		}
+	}
 	rawKV.RawPut(req.GetKey(), req.GetValue())
 	return &kvrpcpb.RawPutResponse{}
 }
@@ -346,6 +481,92 @@ func (h *rpcHandler) handleKvRawScan(req *kvrpcpb.RawScanRequest) *kvrpcpb.RawSc
 	}
 }
 
+// errRawTTLNotSupported is returned for the raw writes with TTL, the mock store doesn't keep the expire time.
+const errRawTTLNotSupported = "raw ttl is not supported"
+
+func (h *rpcHandler) handleKvRawBatchGet(req *kvrpcpb.RawBatchGetRequest) *kvrpcpb.RawBatchGetResponse {
+	rawKV, ok := h.mvccStore.(RawKV)
+	if !ok {
+		return &kvrpcpb.RawBatchGetResponse{
+			Error: "not implemented",
+		}
+	}
+	values := rawKV.RawBatchGet(req.Keys)
+	pairs := make([]*kvrpcpb.KvPair, 0, len(values))
+	for i, value := range values {
+		if value != nil {
+			pairs = append(pairs, &kvrpcpb.KvPair{Key: req.Keys[i], Value: value})
+		}
+	}
+	return &kvrpcpb.RawBatchGetResponse{
+		Pairs: pairs,
+	}
+}
+
+func (h *rpcHandler) handleKvRawBatchPut(req *kvrpcpb.RawBatchPutRequest) *kvrpcpb.RawBatchPutResponse {
+	rawKV, ok := h.mvccStore.(RawKV)
+	if !ok {
+		return &kvrpcpb.RawBatchPutResponse{
+			Error: "not implemented",
+		}
+	}
+	if req.GetTtl() != 0 {
+		return &kvrpcpb.RawBatchPutResponse{
+			Error: errRawTTLNotSupported,
+		}
+	}
+	keys := make([][]byte, 0, len(req.Pairs))
+	values := make([][]byte, 0, len(req.Pairs))
+	for _, pair := range req.Pairs {
+		keys = append(keys, pair.Key)
+		values = append(values, pair.Value)
+	}
+	rawKV.RawBatchPut(keys, values)
+	return &kvrpcpb.RawBatchPutResponse{}
+}
+
+func (h *rpcHandler) handleKvRawBatchDelete(req *kvrpcpb.RawBatchDeleteRequest) *kvrpcpb.RawBatchDeleteResponse {
+	rawKV, ok := h.mvccStore.(RawKV)
+	if !ok {
+		return &kvrpcpb.RawBatchDeleteResponse{
+			Error: "not implemented",
+		}
+	}
+	rawKV.RawBatchDelete(req.Keys)
+	return &kvrpcpb.RawBatchDeleteResponse{}
+}
+
+func (h *rpcHandler) handleKvRawDeleteRange(req *kvrpcpb.RawDeleteRangeRequest) *kvrpcpb.RawDeleteRangeResponse {
+	rawKV, ok := h.mvccStore.(RawKV)
+	if !ok {
+		return &kvrpcpb.RawDeleteRangeResponse{
+			Error: "not implemented",
+		}
+	}
+	rawKV.RawDeleteRange(req.GetStartKey(), req.GetEndKey())
+	return &kvrpcpb.RawDeleteRangeResponse{}
+}
+
+func (h *rpcHandler) handleKvRawCompareAndSwap(req *kvrpcpb.RawCASRequest) *kvrpcpb.RawCASResponse {
+	rawKV, ok := h.mvccStore.(RawKV)
+	if !ok {
+		return &kvrpcpb.RawCASResponse{
+			Error: "not implemented",
+		}
+	}
+	if req.GetTtl() != 0 {
+		return &kvrpcpb.RawCASResponse{
+			Error: errRawTTLNotSupported,
+		}
+	}
+	previous, succeed := rawKV.RawCompareAndSwap(req.Key, req.Value, req.PreviousNotExist, req.PreviousValue)
+	return &kvrpcpb.RawCASResponse{
+		Succeed:          succeed,
+		PreviousNotExist: previous == nil,
+		PreviousValue:    previous,
+	}
+}
+
 // RPCClient sends kv RPC calls to mock cluster. RPCClient mocks the behavior of
 // a rpc client at tikv's side.
 type RPCClient struct {
@@ -474,6 +695,34 @@ func (c *RPCClient) SendRequest(ctx context.Context, addr string, req *tikvrpc.R
 		}
 		resp.Resp, err = handler.handleKvCheckTxnStatus(r)
 		return resp, err
+	case tikvrpc.CmdPessimisticLock:
+		r := req.PessimisticLock()
+		if err := handler.checkRequest(reqCtx, r.Size()); err != nil {
+			resp.Resp = &kvrpcpb.PessimisticLockResponse{RegionError: err}
+			return resp, nil
+		}
+		resp.Resp = handler.handleKvPessimisticLock(r)
+	case tikvrpc.CmdPessimisticRollback:
+		r := req.PessimisticRollback()
+		if err := handler.checkRequest(reqCtx, r.Size()); err != nil {
+			resp.Resp = &kvrpcpb.PessimisticRollbackResponse{RegionError: err}
+			return resp, nil
+		}
+		resp.Resp = handler.handleKvPessimisticRollback(r)
+	case tikvrpc.CmdCheckSecondaryLocks:
+		r := req.CheckSecondaryLocks()
+		if err := handler.checkRequest(reqCtx, r.Size()); err != nil {
+			resp.Resp = &kvrpcpb.CheckSecondaryLocksResponse{RegionError: err}
+			return resp, nil
+		}
+		resp.Resp = handler.handleKvCheckSecondaryLocks(r)
+	case tikvrpc.CmdTxnHeartBeat:
+		r := req.TxnHeartBeat()
+		if err := handler.checkRequest(reqCtx, r.Size()); err != nil {
+			resp.Resp = &kvrpcpb.TxnHeartBeatResponse{RegionError: err}
+			return resp, nil
+		}
+		resp.Resp = handler.handleKvTxnHeartBeat(r)
 	case tikvrpc.CmdBatchRollback:
 		r := req.BatchRollback()
 		if err := handler.checkRequest(reqCtx, r.Size()); err != nil {
@@ -488,6 +737,20 @@ func (c *RPCClient) SendRequest(ctx context.Context, addr string, req *tikvrpc.R
 			return resp, nil
 		}
 		resp.Resp = handler.handleKvResolveLock(r)
+	case tikvrpc.CmdScanLock:
+		r := req.ScanLock()
+		if err := handler.checkRequest(reqCtx, r.Size()); err != nil {
+			resp.Resp = &kvrpcpb.ScanLockResponse{RegionError: err}
+			return resp, nil
+		}
+		resp.Resp = handler.handleKvScanLock(r)
+	case tikvrpc.CmdDeleteRange:
+		r := req.DeleteRange()
+		if err := handler.checkRequest(reqCtx, r.Size()); err != nil {
+			resp.Resp = &kvrpcpb.DeleteRangeResponse{RegionError: err}
+			return resp, nil
+		}
+		resp.Resp = handler.handleKvDeleteRange(r)
 	case tikvrpc.CmdRawGet:
 		r := req.RawGet()
 		if err := handler.checkRequest(reqCtx, r.Size()); err != nil {
@@ -516,6 +779,41 @@ func (c *RPCClient) SendRequest(ctx context.Context, addr string, req *tikvrpc.R
 			return resp, nil
 		}
 		resp.Resp = handler.handleKvRawScan(r)
+	case tikvrpc.CmdRawBatchGet:
+		r := req.RawBatchGet()
+		if err := handler.checkRequest(reqCtx, r.Size()); err != nil {
+			resp.Resp = &kvrpcpb.RawBatchGetResponse{RegionError: err}
+			return resp, nil
+		}
+		resp.Resp = handler.handleKvRawBatchGet(r)
+	case tikvrpc.CmdRawBatchPut:
+		r := req.RawBatchPut()
+		if err := handler.checkRequest(reqCtx, r.Size()); err != nil {
+			resp.Resp = &kvrpcpb.RawBatchPutResponse{RegionError: err}
+			return resp, nil
+		}
+		resp.Resp = handler.handleKvRawBatchPut(r)
+	case tikvrpc.CmdRawBatchDelete:
+		r := req.RawBatchDelete()
+		if err := handler.checkRequest(reqCtx, r.Size()); err != nil {
+			resp.Resp = &kvrpcpb.RawBatchDeleteResponse{RegionError: err}
+			return resp, nil
+		}
+		resp.Resp = handler.handleKvRawBatchDelete(r)
+	case tikvrpc.CmdRawDeleteRange:
+		r := req.RawDeleteRange()
+		if err := handler.checkRequest(reqCtx, r.Size()); err != nil {
+			resp.Resp = &kvrpcpb.RawDeleteRangeResponse{RegionError: err}
+			return resp, nil
+		}
+		resp.Resp = handler.handleKvRawDeleteRange(r)
+	case tikvrpc.CmdRawCompareAndSwap:
+		r := req.RawCompareAndSwap()
+		if err := handler.checkRequest(reqCtx, r.Size()); err != nil {
+			resp.Resp = &kvrpcpb.RawCASResponse{RegionError: err}
+			return resp, nil
+		}
+		resp.Resp = handler.handleKvRawCompareAndSwap(r)
 	case tikvrpc.CmdCop:
 		r := req.Cop()
 		if err := handler.checkRequestContext(reqCtx); err != nil {
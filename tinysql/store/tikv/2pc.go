@@ -18,6 +18,7 @@ import (
 	"context"
 	"math"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	pb "github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
@@ -25,6 +26,7 @@ import (
 	"github.com/pingcap/failpoint"
 	"github.com/pingcap/tidb/kv"
 	"github.com/pingcap/tidb/parser/terror"
+	"github.com/pingcap/tidb/store/tikv/oracle"
 	"github.com/pingcap/tidb/store/tikv/tikvrpc"
 	"github.com/pingcap/tidb/tablecodec"
 	"github.com/pingcap/tidb/util/logutil"
@@ -39,16 +41,30 @@ type twoPhaseCommitAction interface {
 type actionPrewrite struct{}
 type actionCommit struct{}
 type actionCleanup struct{}
+type actionPessimisticLock struct{}
+type actionPessimisticRollback struct{}
 
 var (
 	_ twoPhaseCommitAction = actionPrewrite{}
 	_ twoPhaseCommitAction = actionCommit{}
 	_ twoPhaseCommitAction = actionCleanup{}
+	_ twoPhaseCommitAction = actionPessimisticLock{}
+	_ twoPhaseCommitAction = actionPessimisticRollback{}
 )
 
 // Global variable set by config file.
 var (
 	ManagedLockTTL uint64 = 20000 // 20s
+	// TTLRefreshedTxnSize is the size above which a transaction keeps its primary lock alive by heartbeats. The TTL
+	// of a smaller transaction is enough for it to be prewritten and committed.
+	TTLRefreshedTxnSize = 32 * 1024 * 1024
+)
+
+// The primary lock of an async commit transaction records all the secondary keys, so async commit is only used by
+// small transactions.
+const (
+	asyncCommitMaxKeys = 256
+	asyncCommitMaxSize = 4096
 )
 
 func (actionPrewrite) String() string {
@@ -63,6 +79,14 @@ func (actionCleanup) String() string {
 	return "cleanup"
 }
 
+func (actionPessimisticLock) String() string {
+	return "pessimistic_lock"
+}
+
+func (actionPessimisticRollback) String() string {
+	return "pessimistic_rollback"
+}
+
 // twoPhaseCommitter executes a two-phase commit protocol.
 type twoPhaseCommitter struct {
 	store     *TinykvStore
@@ -77,14 +101,142 @@ type twoPhaseCommitter struct {
 	txnSize   int
 
 	primaryKey []byte
+	// forUpdateTS is the for_update_ts of the statement locking the keys in a pessimistic transaction, the keys
+	// written after it are write conflicts.
+	forUpdateTS uint64
+	// useAsyncCommit is true if the primary lock records the secondary keys, the transaction is committed once all
+	// the keys are prewritten, and the keys are committed in the background.
+	useAsyncCommit bool
+	// useOnePC is true if all the keys are prewritten and committed by a single request.
+	useOnePC bool
 
 	mu struct {
 		sync.RWMutex
 		undeterminedErr error // undeterminedErr saves the rpc error we encounter when commit primary key.
 		committed       bool
+		// minCommitTS is the commit ts of an async commit or 1PC transaction, decided by prewrite.
+		minCommitTS uint64
 	}
 	// regionTxnSize stores the number of keys involved in each region
 	regionTxnSize map[uint64]int
+	// ttlManager keeps the primary lock alive while the transaction is being committed.
+	ttlManager ttlManager
+}
+
+type ttlManagerState uint32
+
+const (
+	stateUninitialized ttlManagerState = iota
+	stateRunning
+	stateClosed
+)
+
+// ttlManager sends heartbeats to extend the TTL of the primary lock, so that a transaction which takes a long time to
+// prewrite and commit isn't rolled back by other transactions as expired.
+type ttlManager struct {
+	state ttlManagerState
+	ch    chan struct{}
+}
+
+// run starts the heartbeat goroutine after the primary key is prewritten, it only runs once.
+func (tm *ttlManager) run(c *twoPhaseCommitter) {
+	if !atomic.CompareAndSwapUint32((*uint32)(&tm.state), uint32(stateUninitialized), uint32(stateRunning)) {
+		return
+	}
+	tm.ch = make(chan struct{})
+	go tm.keepAlive(c)
+}
+
+// close stops the heartbeat goroutine if it's running.
+func (tm *ttlManager) close() {
+	if !atomic.CompareAndSwapUint32((*uint32)(&tm.state), uint32(stateRunning), uint32(stateClosed)) {
+		return
+	}
+	close(tm.ch)
+}
+
+func (tm *ttlManager) keepAlive(c *twoPhaseCommitter) {
+	// Send a heartbeat when half of the lock TTL has passed.
+	ticker := time.NewTicker(time.Duration(c.lockTTL) * time.Millisecond / 2)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-tm.ch:
+			return
+		case <-ticker.C:
+			bo := NewBackoffer(context.Background(), txnHeartBeatMaxBackoff).WithVars(c.txn.vars)
+			now, err := c.store.GetOracle().GetTimestamp(bo.ctx)
+			if err != nil {
+				err1 := bo.Backoff(BoPDRPC, err)
+				if err1 != nil {
+					logutil.BgLogger().Warn("keepAlive get tso fail",
+						zap.Uint64("txnStartTS", c.startTS),
+						zap.Error(err))
+					return
+				}
+				continue
+			}
+
+			uptime := uint64(oracle.ExtractPhysical(now) - oracle.ExtractPhysical(c.startTS))
+			if uptime > kv.MaxTxnTimeUse {
+				// The transaction can't be committed any more, let the lock expire.
+				logutil.BgLogger().Info("ttlManager live up to its lifetime",
+					zap.Uint64("txnStartTS", c.startTS),
+					zap.Uint64("uptime", uptime))
+				return
+			}
+
+			newTTL := uptime + ManagedLockTTL
+			logutil.BgLogger().Debug("send TxnHeartBeat",
+				zap.Uint64("startTS", c.startTS),
+				zap.Uint64("newTTL", newTTL))
+			_, err = sendTxnHeartBeat(bo, c.store, c.primary(), c.startTS, newTTL)
+			if err != nil {
+				logutil.BgLogger().Warn("send TxnHeartBeat failed",
+					zap.Uint64("txnStartTS", c.startTS),
+					zap.Error(err))
+				return
+			}
+		}
+	}
+}
+
+// sendTxnHeartBeat sets the TTL of the primary lock to ttl if it's larger, and returns the TTL of the lock.
+func sendTxnHeartBeat(bo *Backoffer, store *TinykvStore, primary []byte, startTS, ttl uint64) (uint64, error) {
+	req := tikvrpc.NewRequest(tikvrpc.CmdTxnHeartBeat, &pb.TxnHeartBeatRequest{
+		PrimaryLock:   primary,
+		StartVersion:  startTS,
+		AdviseLockTtl: ttl,
+	})
+	for {
+		loc, err := store.GetRegionCache().LocateKey(bo, primary)
+		if err != nil {
+			return 0, errors.Trace(err)
+		}
+		resp, err := store.SendReq(bo, req, loc.Region, readTimeoutShort)
+		if err != nil {
+			return 0, errors.Trace(err)
+		}
+		regionErr, err := resp.GetRegionError()
+		if err != nil {
+			return 0, errors.Trace(err)
+		}
+		if regionErr != nil {
+			err = bo.Backoff(BoRegionMiss, errors.New(regionErr.String()))
+			if err != nil {
+				return 0, errors.Trace(err)
+			}
+			continue
+		}
+		if resp.Resp == nil {
+			return 0, errors.Trace(ErrBodyMissing)
+		}
+		cmdResp := resp.Resp.(*pb.TxnHeartBeatResponse)
+		if keyErr := cmdResp.GetError(); keyErr != nil {
+			return 0, errors.Errorf("txn %d heartbeat fail, primary key = %v, err = %s", startTS, primary, keyErr.Abort)
+		}
+		return cmdResp.GetLockTtl(), nil
+	}
 }
 
 // batchExecutor is txn controller providing rate control like utils
@@ -99,6 +251,8 @@ type batchExecutor struct {
 
 type mutationEx struct {
 	pb.Mutation
+	// isPessimisticLock is true if the key has been locked by a pessimistic lock of the transaction.
+	isPessimisticLock bool
 }
 
 // newTwoPhaseCommitter creates a twoPhaseCommitter.
@@ -169,8 +323,12 @@ func (c *twoPhaseCommitter) initKeysAndMutations() error {
 	// Don't forget to update the keys array and statistic information
 	for _, lockKey := range txn.lockKeys {
 		// YOUR CODE HERE (lab3).
-		_, ok := mutations[string(lockKey)]
-		if !ok {
+		m, ok := mutations[string(lockKey)]
+		if ok {
+			m.isPessimisticLock = txn.isPessimistic
+		} else if !txn.isPessimistic {
+			// TinyKV doesn't support Op_Lock, the pessimistic locks of the keys which are locked but not updated
+			// are rolled back after the transaction finishes instead.
 			lockCnt++
 			mutation := &mutationEx{
 				Mutation: pb.Mutation{
@@ -188,6 +346,21 @@ func (c *twoPhaseCommitter) initKeysAndMutations() error {
 	}
 	c.txnSize = size
 
+	// The primary key of a pessimistic transaction is chosen when the first key is locked, it stays the primary if
+	// it's written. Otherwise it's unlocked with the other unwritten keys, and a written key is chosen instead.
+	if len(c.primaryKey) > 0 {
+		if _, ok := mutations[string(c.primaryKey)]; ok {
+			for i, key := range keys {
+				if bytes.Equal(key, c.primaryKey) {
+					keys[0], keys[i] = keys[i], keys[0]
+					break
+				}
+			}
+		} else {
+			c.primaryKey = nil
+		}
+	}
+
 	if size > int(kv.TxnTotalSizeLimit) {
 		return kv.ErrTxnTooLarge.GenWithStackByArgs(size)
 	}
@@ -288,12 +461,17 @@ func (c *twoPhaseCommitter) doActionOnKeys(bo *Backoffer, action twoPhaseCommitA
 	for id, g := range groups {
 		batches = appendBatchBySize(batches, id, g, sizeFunc, txnCommitBatchSize)
 	}
+	if _, ok := action.(actionPrewrite); ok && c.useOnePC && len(batches) > 1 {
+		// The keys can't be committed by a single request, fall back to 2PC.
+		c.useOnePC = false
+	}
 
 	firstIsPrimary := bytes.Equal(keys[0], c.primary())
 	_, actionIsCommit := action.(actionCommit)
 	_, actionIsCleanup := action.(actionCleanup)
-	if firstIsPrimary && (actionIsCommit || actionIsCleanup) {
-		// primary should be committed/cleanup first
+	_, actionIsPessimisticLock := action.(actionPessimisticLock)
+	if firstIsPrimary && (actionIsCommit || actionIsCleanup || actionIsPessimisticLock) {
+		// primary should be committed/cleanup/locked first
 		err = c.doActionOnBatches(bo, action, batches[:1])
 		// fmt.Println("primery ok 1")
 		if err != nil {
@@ -380,6 +558,22 @@ func (c *twoPhaseCommitter) buildPrewriteRequest(batch batchKeys) *tikvrpc.Reque
 	req.PrimaryLock = c.primary()
 	req.StartVersion = c.startTS
 	req.LockTtl = c.lockTTL
+	if c.txn.isPessimistic {
+		req.IsPessimisticLock = make([]bool, 0, len(batch.keys))
+		for _, key := range batch.keys {
+			req.IsPessimisticLock = append(req.IsPessimisticLock, c.mutations[string(key)].isPessimisticLock)
+		}
+		req.ForUpdateTs = c.forUpdateTS
+	}
+	if c.useAsyncCommit || c.useOnePC {
+		req.UseAsyncCommit = c.useAsyncCommit
+		req.TryOnePc = c.useOnePC
+		req.MinCommitTs = c.startTS + 1
+		// The primary key is always the first key, the batch containing it goes first.
+		if c.useAsyncCommit && bytes.Equal(batch.keys[0], c.primary()) {
+			req.Secondaries = c.keys[1:]
+		}
+	}
 
 	return tikvrpc.NewRequest(tikvrpc.CmdPrewrite, req, pb.Context{})
 }
@@ -407,12 +601,41 @@ func (c *twoPhaseCommitter) buildRollbackRequest(batch batchKeys) *tikvrpc.Reque
 	return tikvrpc.NewRequest(tikvrpc.CmdBatchRollback, req, pb.Context{})
 }
 
+func (c *twoPhaseCommitter) buildPessimisticLockRequest(batch batchKeys) *tikvrpc.Request {
+	req := &pb.PessimisticLockRequest{}
+	for _, key := range batch.keys {
+		req.Mutations = append(req.Mutations, &pb.Mutation{
+			Op:  pb.Op_PessimisticLock,
+			Key: key,
+		})
+	}
+	req.PrimaryLock = c.primary()
+	req.StartVersion = c.startTS
+	req.LockTtl = ManagedLockTTL
+	req.ForUpdateTs = c.forUpdateTS
+
+	return tikvrpc.NewRequest(tikvrpc.CmdPessimisticLock, req, pb.Context{})
+}
+
+func (c *twoPhaseCommitter) buildPessimisticRollbackRequest(batch batchKeys) *tikvrpc.Request {
+	req := &pb.PessimisticRollbackRequest{}
+	req.StartVersion = c.startTS
+	req.Keys = batch.keys
+
+	return tikvrpc.NewRequest(tikvrpc.CmdPessimisticRollback, req, pb.Context{})
+}
+
 // handleSingleBatch prewrites a batch of keys
 func (actionPrewrite) handleSingleBatch(c *twoPhaseCommitter, bo *Backoffer, batch batchKeys) error {
 	req := c.buildPrewriteRequest(batch)
 	for {
 		// fmt.Println("actionPrewrite.handleSingleBatch roud")
-		resp, err := c.store.SendReq(bo, req, batch.region, readTimeoutShort)
+		sender := NewRegionRequestSender(c.store.regionCache, c.store.client)
+		resp, err := sender.SendReq(bo, req, batch.region, readTimeoutShort)
+		// An async commit or 1PC transaction may have been committed if the prewrite response is lost.
+		if (c.useAsyncCommit || c.useOnePC) && sender.rpcError != nil {
+			c.setUndeterminedErr(errors.Trace(sender.rpcError))
+		}
 		if err != nil {
 			return errors.Trace(err)
 		}
@@ -439,6 +662,16 @@ func (actionPrewrite) handleSingleBatch(c *twoPhaseCommitter, bo *Backoffer, bat
 		prewriteResp := resp.Resp.(*pb.PrewriteResponse)
 		keyErrs := prewriteResp.GetErrors()
 		if len(keyErrs) == 0 {
+			if bytes.Equal(batch.keys[0], c.primary()) && !c.useOnePC && c.txnSize > TTLRefreshedTxnSize {
+				// The primary lock is written, keep it alive until the transaction is committed. The ttl manager is
+				// closed when execute returns.
+				c.ttlManager.run(c)
+			}
+			if c.useOnePC {
+				c.updateMinCommitTS(prewriteResp.OnePcCommitTs)
+			} else if c.useAsyncCommit {
+				c.updateMinCommitTS(prewriteResp.MinCommitTs)
+			}
 			return nil
 		}
 		var locks []*Lock
@@ -485,6 +718,31 @@ func (c *twoPhaseCommitter) getUndeterminedErr() error {
 	return c.mu.undeterminedErr
 }
 
+// updateMinCommitTS updates the commit ts of an async commit or 1PC transaction by a prewrite response, the commit
+// ts of an async commit transaction is the max min commit ts of all the locks.
+func (c *twoPhaseCommitter) updateMinCommitTS(ts uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if ts > c.mu.minCommitTS {
+		c.mu.minCommitTS = ts
+	}
+}
+
+// checkAsyncCommit decides whether to commit the transaction with async commit or 1PC, by the options of the
+// transaction. 1PC is tried first, it falls back if the keys are not in a single batch.
+func (c *twoPhaseCommitter) checkAsyncCommit() {
+	if enabled, _ := c.txn.us.GetOption(kv.Enable1PC).(bool); enabled {
+		c.useOnePC = true
+	}
+	if enabled, _ := c.txn.us.GetOption(kv.EnableAsyncCommit).(bool); enabled && len(c.keys) <= asyncCommitMaxKeys {
+		size := 0
+		for _, key := range c.keys[1:] {
+			size += len(key)
+		}
+		c.useAsyncCommit = size <= asyncCommitMaxSize
+	}
+}
+
 func (actionCommit) handleSingleBatch(c *twoPhaseCommitter, bo *Backoffer, batch batchKeys) error {
 	// follow actionPrewrite.handleSingleBatch, build the commit request
 
@@ -606,6 +864,98 @@ func (actionCleanup) handleSingleBatch(c *twoPhaseCommitter, bo *Backoffer, batc
 	return nil
 }
 
+// handleSingleBatch locks a batch of keys with pessimistic locks. The locks of other transactions are resolved or
+// waited for, until they are released or the backoffer runs out of time.
+func (actionPessimisticLock) handleSingleBatch(c *twoPhaseCommitter, bo *Backoffer, batch batchKeys) error {
+	req := c.buildPessimisticLockRequest(batch)
+	for {
+		resp, err := c.store.SendReq(bo, req, batch.region, readTimeoutShort)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		regionErr, err := resp.GetRegionError()
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if regionErr != nil {
+			err = bo.Backoff(BoRegionMiss, errors.New(regionErr.String()))
+			if err != nil {
+				return errors.Trace(err)
+			}
+			// re-split keys and lock again.
+			err = c.pessimisticLockKeys(bo, batch.keys)
+			return errors.Trace(err)
+		}
+		if resp.Resp == nil {
+			return errors.Trace(ErrBodyMissing)
+		}
+		lockResp := resp.Resp.(*pb.PessimisticLockResponse)
+		keyErrs := lockResp.GetErrors()
+		if len(keyErrs) == 0 {
+			return nil
+		}
+		var locks []*Lock
+		for _, keyErr := range keyErrs {
+			if deadlock := keyErr.Deadlock; deadlock != nil {
+				logutil.BgLogger().Info("pessimistic lock encounters deadlock",
+					zap.Uint64("conn", c.connID),
+					zap.Uint64("txnStartTS", c.startTS),
+					zap.Uint64("lockTS", deadlock.LockTs))
+				return errors.Trace(ErrLockDeadlock)
+			}
+			// Write conflicts are returned to restart the statement with a newer for_update_ts.
+			lock, err1 := extractLockFromKeyErr(keyErr)
+			if err1 != nil {
+				return errors.Trace(err1)
+			}
+			locks = append(locks, lock)
+		}
+		msBeforeExpired, _, err := c.store.lockResolver.ResolveLocks(bo, 0, locks)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if msBeforeExpired > 0 {
+			err = bo.BackoffWithMaxSleep(BoTxnLock, int(msBeforeExpired), errors.Errorf("2PC pessimistic lock lockedKeys: %d", len(locks)))
+			if err != nil {
+				if terror.ErrorEqual(err, ErrResolveLockTimeout) {
+					return errors.Trace(ErrLockWaitTimeout)
+				}
+				return errors.Trace(err)
+			}
+		}
+	}
+}
+
+// handleSingleBatch rollbacks the pessimistic locks of a batch of keys.
+func (actionPessimisticRollback) handleSingleBatch(c *twoPhaseCommitter, bo *Backoffer, batch batchKeys) error {
+	req := c.buildPessimisticRollbackRequest(batch)
+	resp, err := c.store.SendReq(bo, req, batch.region, readTimeoutShort)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	regionErr, err := resp.GetRegionError()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if regionErr != nil {
+		err = bo.Backoff(BoRegionMiss, errors.New(regionErr.String()))
+		if err != nil {
+			return errors.Trace(err)
+		}
+		// re-split keys and rollback again.
+		err = c.pessimisticRollbackKeys(bo, batch.keys)
+		return errors.Trace(err)
+	}
+	if resp.Resp == nil {
+		return errors.Trace(ErrBodyMissing)
+	}
+	rollbackResp := resp.Resp.(*pb.PessimisticRollbackResponse)
+	if keyErrs := rollbackResp.GetErrors(); len(keyErrs) > 0 {
+		return errors.Trace(extractKeyErr(keyErrs[0]))
+	}
+	return nil
+}
+
 func (c *twoPhaseCommitter) prewriteKeys(bo *Backoffer, keys [][]byte) error {
 	return c.doActionOnKeys(bo, actionPrewrite{}, keys)
 }
@@ -618,10 +968,21 @@ func (c *twoPhaseCommitter) cleanupKeys(bo *Backoffer, keys [][]byte) error {
 	return c.doActionOnKeys(bo, actionCleanup{}, keys)
 }
 
+func (c *twoPhaseCommitter) pessimisticLockKeys(bo *Backoffer, keys [][]byte) error {
+	return c.doActionOnKeys(bo, actionPessimisticLock{}, keys)
+}
+
+func (c *twoPhaseCommitter) pessimisticRollbackKeys(bo *Backoffer, keys [][]byte) error {
+	return c.doActionOnKeys(bo, actionPessimisticRollback{}, keys)
+}
+
 // execute executes the two-phase commit protocol.
 // Prewrite phase:
 //		1. Split keys by region -> batchKeys
 // 		2. Prewrite all batches with transaction's start timestamp
+// An async commit or 1PC transaction is committed once the keys are prewritten, the commit ts is decided by
+// prewrite, and the schema is checked before that. The keys of an async commit transaction are committed in the
+// background.
 // Commit phase:
 //		1. Get the latest timestamp as commit ts
 //      2. Check if the transaction can be committed(schema change during execution will fail the transaction)
@@ -653,20 +1014,44 @@ func (c *twoPhaseCommitter) execute(ctx context.Context) (err error) {
 				c.cleanWg.Done()
 			}()
 		}
+		c.ttlManager.close()
 		c.txn.commitTS = c.commitTS
 	}()
 
+	c.checkAsyncCommit()
+	if c.useAsyncCommit || c.useOnePC {
+		// The transaction can't fail after prewrite, check the schema with a recent timestamp before that.
+		var ts uint64
+		ts, err = c.store.getTimestampWithRetry(NewBackoffer(ctx, tsoMaxBackoff).WithVars(c.txn.vars))
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if err = c.checkSchemaValid(ts); err != nil {
+			return errors.Trace(err)
+		}
+	}
+
 	// prewrite phase
 	prewriteBo := NewBackoffer(ctx, PrewriteMaxBackoff).WithVars(c.txn.vars)
 	logutil.BgLogger().Debug("prewriteBo", zap.Bool("nil", prewriteBo == nil))
 	// YOUR CODE HERE (lab3).
 	err = c.prewriteKeys(prewriteBo, c.keys)
 	if err != nil {
+		if undeterminedErr := c.getUndeterminedErr(); undeterminedErr != nil {
+			logutil.Logger(ctx).Error("2PC prewrite result undetermined",
+				zap.Error(err),
+				zap.NamedError("rpcErr", undeterminedErr),
+				zap.Uint64("txnStartTS", c.startTS))
+			return errors.Trace(terror.ErrResultUndetermined)
+		}
 		logutil.Logger(ctx).Warn("2PC prewrite failed",
 			zap.Error(err),
 			zap.Uint64("txnStartTS", c.startTS))
 		return errors.Trace(err)
 	}
+	if c.useAsyncCommit || c.useOnePC {
+		return c.finishAsyncCommit()
+	}
 
 	// commit phase
 	commitTS, err := c.store.getTimestampWithRetry(NewBackoffer(ctx, tsoMaxBackoff).WithVars(c.txn.vars))
@@ -685,7 +1070,7 @@ func (c *twoPhaseCommitter) execute(ctx context.Context) (err error) {
 		return errors.Trace(err)
 	}
 	c.commitTS = commitTS
-	if err = c.checkSchemaValid(); err != nil {
+	if err = c.checkSchemaValid(c.commitTS); err != nil {
 		return errors.Trace(err)
 	}
 
@@ -724,16 +1109,48 @@ func (c *twoPhaseCommitter) execute(ctx context.Context) (err error) {
 	return nil
 }
 
+// finishAsyncCommit marks an async commit or 1PC transaction committed after all the keys are prewritten, and
+// commits the keys of an async commit transaction in the background.
+func (c *twoPhaseCommitter) finishAsyncCommit() error {
+	c.mu.Lock()
+	commitTS := c.mu.minCommitTS
+	if commitTS > c.startTS {
+		c.commitTS = commitTS
+		c.mu.committed = true
+	}
+	c.mu.Unlock()
+	if commitTS <= c.startTS {
+		err := errors.Errorf("conn %d invalid min commit ts with txnStartTS=%v while minCommitTS=%v",
+			c.connID, c.startTS, commitTS)
+		logutil.BgLogger().Error("invalid transaction", zap.Error(err))
+		return errors.Trace(err)
+	}
+	if c.useOnePC {
+		return nil
+	}
+
+	commitBo := NewBackoffer(context.Background(), CommitMaxBackoff).WithVars(c.txn.vars)
+	go func() {
+		if err := c.commitKeys(commitBo, c.keys); err != nil {
+			logutil.BgLogger().Debug("2PC async commit failed, the locks are left to be resolved",
+				zap.Uint64("conn", c.connID),
+				zap.Uint64("txnStartTS", c.startTS),
+				zap.Error(err))
+		}
+	}()
+	return nil
+}
+
 type schemaLeaseChecker interface {
 	Check(txnTS uint64) error
 }
 
 // checkSchemaValid checks if there are schema changes during the transaction execution(from startTS to commitTS).
 // Schema change in a transaction is not allowed.
-func (c *twoPhaseCommitter) checkSchemaValid() error {
+func (c *twoPhaseCommitter) checkSchemaValid(commitTS uint64) error {
 	checker, ok := c.txn.us.GetOption(kv.SchemaChecker).(schemaLeaseChecker)
 	if ok {
-		err := checker.Check(c.commitTS)
+		err := checker.Check(commitTS)
 		if err != nil {
 			return errors.Trace(err)
 		}
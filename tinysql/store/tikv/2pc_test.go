@@ -18,6 +18,7 @@ import (
 	"math"
 	"math/rand"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
@@ -487,3 +488,47 @@ func (s *testCommitterSuite) getLockInfo(c *C, key []byte) *kvrpcpb.LockInfo {
 	c.Assert(locked, NotNil)
 	return locked
 }
+
+func (s *testCommitterSuite) TestTxnHeartBeat(c *C) {
+	// A small transaction doesn't send heartbeats.
+	txn := s.begin(c)
+	err := txn.Set([]byte("a"), []byte("a"))
+	c.Assert(err, IsNil)
+	committer, err := newTwoPhaseCommitterWithInit(txn, 1)
+	c.Assert(err, IsNil)
+	err = committer.prewriteKeys(NewBackoffer(context.Background(), PrewriteMaxBackoff), committer.keys)
+	c.Assert(err, IsNil)
+	c.Assert(atomic.LoadUint32((*uint32)(&committer.ttlManager.state)), Equals, uint32(stateUninitialized))
+	err = committer.cleanupKeys(NewBackoffer(context.Background(), cleanupMaxBackoff), committer.keys)
+	c.Assert(err, IsNil)
+
+	defer func(size int) { TTLRefreshedTxnSize = size }(TTLRefreshedTxnSize)
+	TTLRefreshedTxnSize = 0
+	txn = s.begin(c)
+	key := []byte("key")
+	err = txn.Set(key, key)
+	c.Assert(err, IsNil)
+	committer, err = newTwoPhaseCommitterWithInit(txn, 1)
+	c.Assert(err, IsNil)
+	err = committer.prewriteKeys(NewBackoffer(context.Background(), PrewriteMaxBackoff), committer.keys)
+	c.Assert(err, IsNil)
+	// The primary lock is prewritten, so the ttl manager is running.
+	c.Assert(atomic.LoadUint32((*uint32)(&committer.ttlManager.state)), Equals, uint32(stateRunning))
+	committer.ttlManager.close()
+
+	bo := NewBackoffer(context.Background(), txnHeartBeatMaxBackoff)
+	ttl, err := sendTxnHeartBeat(bo, s.store, key, txn.StartTS(), committer.lockTTL+1000)
+	c.Assert(err, IsNil)
+	c.Assert(ttl, Equals, committer.lockTTL+1000)
+
+	// The TTL is never decreased.
+	ttl, err = sendTxnHeartBeat(bo, s.store, key, txn.StartTS(), committer.lockTTL)
+	c.Assert(err, IsNil)
+	c.Assert(ttl, Equals, committer.lockTTL+1000)
+
+	// The heartbeat fails after the transaction is rolled back.
+	err = committer.cleanupKeys(NewBackoffer(context.Background(), cleanupMaxBackoff), committer.keys)
+	c.Assert(err, IsNil)
+	_, err = sendTxnHeartBeat(bo, s.store, key, txn.StartTS(), committer.lockTTL+2000)
+	c.Assert(err, NotNil)
+}
@@ -187,6 +187,8 @@ const (
 	scatterRegionBackoff           = 20000
 	waitScatterRegionFinishBackoff = 120000
 	locateRegionMaxBackoff         = 20000
+	pessimisticLockMaxBackoff      = 20000
+	txnHeartBeatMaxBackoff         = 20000
 )
 
 var (
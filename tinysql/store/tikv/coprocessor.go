@@ -54,6 +54,8 @@ func (c *CopClient) Send(ctx context.Context, req *kv.Request, vars *kv.Variable
 		concurrency: req.Concurrency,
 		finishCh:    make(chan struct{}),
 		vars:        vars,
+
+		replicaReadSeed: c.store.nextReplicaReadSeed(),
 	}
 	it.minCommitTSPushed.data = make(map[uint64]struct{}, 5)
 	it.tasks = tasks
@@ -603,12 +605,12 @@ func (worker *copIteratorWorker) handleTaskOnce(bo *Backoffer, task *copTask, ch
 		}
 	})
 
-	req := tikvrpc.NewRequest(task.cmdType, &coprocessor.Request{
+	req := tikvrpc.NewReplicaReadRequest(task.cmdType, &coprocessor.Request{
 		Tp:      worker.req.Tp,
 		StartTs: worker.req.StartTs,
 		Data:    worker.req.Data,
 		Ranges:  task.ranges.toPBRanges(),
-	}, kvrpcpb.Context{})
+	}, worker.req.ReplicaRead, worker.replicaReadSeed, kvrpcpb.Context{})
 	startTime := time.Now()
 	resp, rpcCtx, storeAddr, err := worker.SendReqCtx(bo, req, task.region, ReadTimeoutMedium, task.storeAddr)
 	if err != nil {
@@ -0,0 +1,105 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tikv
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/store/tikv/tikvrpc"
+	"github.com/pingcap/tidb/util/logutil"
+	"go.uber.org/zap"
+)
+
+// DeleteRange deletes all the data in [startKey, endKey) of all the regions without writing MVCC versions, the
+// transactions reading the range after that would see the data partially, so it's only used for the ranges which
+// are not accessed any more, e.g. the data of a dropped table after the GC safe point.
+func DeleteRange(ctx context.Context, store Storage, startKey, endKey []byte, concurrency int) error {
+	handler := func(ctx context.Context, r kv.KeyRange) (RangeTaskStat, error) {
+		return deleteRangeInRegions(ctx, store, r)
+	}
+	runner := NewRangeTaskRunner("delete-range-runner", store, concurrency, handler)
+	if err := runner.RunOnRange(ctx, startKey, endKey); err != nil {
+		logutil.Logger(ctx).Error("delete range failed",
+			zap.Binary("startKey", startKey),
+			zap.Binary("endKey", endKey),
+			zap.Error(err))
+		return errors.Trace(err)
+	}
+	logutil.Logger(ctx).Info("finish delete range",
+		zap.Binary("startKey", startKey),
+		zap.Binary("endKey", endKey),
+		zap.Int("regions", runner.CompletedRegions()))
+	return nil
+}
+
+// deleteRangeInRegions deletes the range in the regions one by one, the request sent to a region is clamped to it.
+func deleteRangeInRegions(ctx context.Context, store Storage, r kv.KeyRange) (RangeTaskStat, error) {
+	var stat RangeTaskStat
+	key := r.StartKey
+	for {
+		select {
+		case <-ctx.Done():
+			return stat, errors.New("delete range canceled")
+		default:
+		}
+
+		bo := NewBackoffer(ctx, deleteRangeOneRegionMaxBackoff)
+		loc, err := store.GetRegionCache().LocateKey(bo, key)
+		if err != nil {
+			return stat, errors.Trace(err)
+		}
+		endKey := loc.EndKey
+		if len(r.EndKey) != 0 && loc.Contains(r.EndKey) {
+			// It's the last region in the range.
+			endKey = r.EndKey
+		}
+
+		req := tikvrpc.NewRequest(tikvrpc.CmdDeleteRange, &kvrpcpb.DeleteRangeRequest{
+			StartKey: key,
+			EndKey:   endKey,
+		})
+		resp, err := store.SendReq(bo, req, loc.Region, ReadTimeoutMedium)
+		if err != nil {
+			return stat, errors.Trace(err)
+		}
+		regionErr, err := resp.GetRegionError()
+		if err != nil {
+			return stat, errors.Trace(err)
+		}
+		if regionErr != nil {
+			err = bo.Backoff(BoRegionMiss, errors.New(regionErr.String()))
+			if err != nil {
+				return stat, errors.Trace(err)
+			}
+			continue
+		}
+		if resp.Resp == nil {
+			return stat, errors.Trace(ErrBodyMissing)
+		}
+		if err := resp.Resp.(*kvrpcpb.DeleteRangeResponse).GetError(); err != "" {
+			return stat, errors.Errorf("unexpected delete range error: %s", err)
+		}
+
+		stat.CompletedRegions++
+		key = endKey
+		if len(key) == 0 || (len(r.EndKey) != 0 && bytes.Compare(key, r.EndKey) >= 0) {
+			break
+		}
+	}
+	return stat, nil
+}
@@ -0,0 +1,118 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tikv
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/store/tikv/tikvrpc"
+	"github.com/pingcap/tidb/util/logutil"
+	"go.uber.org/zap"
+)
+
+// gcScanLockLimit is the max number of locks returned by a ScanLock request.
+var gcScanLockLimit = 1024
+
+// ResolveLocksBeforeSafePoint scans the locks in all the regions which are left by the transactions started before the
+// safe point, and resolves them. MVCC GC may delete the versions of a transaction committed before the safe point, so
+// the locks must be resolved before that, otherwise a stale lock could be committed after its data is collected.
+func ResolveLocksBeforeSafePoint(ctx context.Context, store Storage, safePoint uint64, concurrency int) error {
+	handler := func(ctx context.Context, r kv.KeyRange) (RangeTaskStat, error) {
+		return resolveLocksInRange(ctx, store, safePoint, r)
+	}
+	runner := NewRangeTaskRunner("resolve-locks-runner", store, concurrency, handler)
+	if err := runner.RunOnRange(ctx, []byte(""), []byte("")); err != nil {
+		logutil.Logger(ctx).Error("resolve locks failed",
+			zap.Uint64("safePoint", safePoint),
+			zap.Error(err))
+		return errors.Trace(err)
+	}
+	logutil.Logger(ctx).Info("finish resolve locks",
+		zap.Uint64("safePoint", safePoint),
+		zap.Int("regions", runner.CompletedRegions()))
+	return nil
+}
+
+// resolveLocksInRange resolves the locks in the regions of the range one by one.
+func resolveLocksInRange(ctx context.Context, store Storage, safePoint uint64, r kv.KeyRange) (RangeTaskStat, error) {
+	var stat RangeTaskStat
+	key := r.StartKey
+	bo := NewBackoffer(ctx, GcResolveLockMaxBackoff)
+	for {
+		select {
+		case <-ctx.Done():
+			return stat, errors.New("resolve locks canceled")
+		default:
+		}
+
+		req := tikvrpc.NewRequest(tikvrpc.CmdScanLock, &kvrpcpb.ScanLockRequest{
+			MaxVersion: safePoint,
+			StartKey:   key,
+			EndKey:     r.EndKey,
+			Limit:      uint32(gcScanLockLimit),
+		})
+		loc, err := store.GetRegionCache().LocateKey(bo, key)
+		if err != nil {
+			return stat, errors.Trace(err)
+		}
+		resp, err := store.SendReq(bo, req, loc.Region, ReadTimeoutMedium)
+		if err != nil {
+			return stat, errors.Trace(err)
+		}
+		regionErr, err := resp.GetRegionError()
+		if err != nil {
+			return stat, errors.Trace(err)
+		}
+		if regionErr != nil {
+			err = bo.Backoff(BoRegionMiss, errors.New(regionErr.String()))
+			if err != nil {
+				return stat, errors.Trace(err)
+			}
+			continue
+		}
+		if resp.Resp == nil {
+			return stat, errors.Trace(ErrBodyMissing)
+		}
+		locksResp := resp.Resp.(*kvrpcpb.ScanLockResponse)
+		if locksResp.GetError() != nil {
+			return stat, errors.Errorf("unexpected scan lock error: %s", locksResp.GetError())
+		}
+		locksInfo := locksResp.GetLocks()
+		locks := make([]*Lock, len(locksInfo))
+		for i := range locksInfo {
+			locks[i] = NewLock(locksInfo[i])
+		}
+		if err = store.GetLockResolver().BatchResolveLocks(bo, locks); err != nil {
+			return stat, errors.Trace(err)
+		}
+
+		if len(locks) < gcScanLockLimit {
+			// All the locks in the region are resolved, go on with the next region.
+			stat.CompletedRegions++
+			key = loc.EndKey
+		} else {
+			// There may be more locks in the region, scan again after the last resolved lock.
+			key = locks[len(locks)-1].Key
+		}
+		if len(key) == 0 || (len(r.EndKey) != 0 && bytes.Compare(key, r.EndKey) >= 0) {
+			break
+		}
+		bo = NewBackoffer(ctx, GcResolveLockMaxBackoff)
+	}
+	return stat, nil
+}
@@ -14,6 +14,7 @@
 package tikv
 
 import (
+	"context"
 	"time"
 
 	"github.com/pingcap/tidb/kv"
@@ -40,6 +41,9 @@ type Storage interface {
 	// UpdateSPCache updates the cache of safe point.
 	UpdateSPCache(cachedSP uint64, cachedTime time.Time)
 
+	// GetGCSafePoint gets the GC safe point saved in the placement driver.
+	GetGCSafePoint(ctx context.Context) (uint64, error)
+
 	// SetOracle sets the Oracle.
 	SetOracle(oracle oracle.Oracle)
 
@@ -21,6 +21,7 @@ import (
 	"net/url"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	pd "github.com/pingcap-incubator/tinykv/scheduler/client"
@@ -231,11 +232,15 @@ func (s *TinykvStore) BeginWithStartTS(startTS uint64) (kv.Transaction, error) {
 }
 
 func (s *TinykvStore) GetSnapshot(ver kv.Version) (kv.Snapshot, error) {
-	snapshot := newTiKVSnapshot(s, ver)
+	snapshot := newTiKVSnapshot(s, ver, s.nextReplicaReadSeed())
 
 	return snapshot, nil
 }
 
+func (s *TinykvStore) nextReplicaReadSeed() uint32 {
+	return atomic.AddUint32(&s.replicaReadSeed, 1)
+}
+
 func (s *TinykvStore) Close() error {
 	mc.Lock()
 	defer mc.Unlock()
@@ -315,6 +320,12 @@ func (s *TinykvStore) SupportDeleteRange() (supported bool) {
 	return !s.mock
 }
 
+// GetGCSafePoint implements the Storage interface.
+func (s *TinykvStore) GetGCSafePoint(ctx context.Context) (uint64, error) {
+	// The safe point never goes back, so updating it with 0 returns the current one.
+	return s.PdClient.UpdateGCSafePoint(ctx, 0)
+}
+
 func (s *TinykvStore) SendReq(bo *Backoffer, req *tikvrpc.Request, regionID RegionVerID, timeout time.Duration) (*tikvrpc.Response, error) {
 	sender := NewRegionRequestSender(s.regionCache, s.client)
 	return sender.SendReq(bo, req, regionID, timeout)
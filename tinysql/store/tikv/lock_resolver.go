@@ -18,6 +18,7 @@ import (
 	"container/list"
 	"context"
 	"fmt"
+	"math"
 	"sync"
 
 	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
@@ -86,6 +87,8 @@ type TxnStatus struct {
 	ttl      uint64
 	commitTS uint64
 	action   kvrpcpb.Action
+	// primaryLock is the primary lock of an async commit transaction, which records the secondary keys.
+	primaryLock *kvrpcpb.LockInfo
 }
 
 // IsCommitted returns true if the txn's final status is Commit.
@@ -186,6 +189,18 @@ func (lr *LockResolver) ResolveLocks(bo *Backoffer, callerStartTS uint64, locks
 			return msBeforeTxnExpired.value(), nil, err
 		}
 
+		if status.ttl != 0 && status.primaryLock != nil && lr.store.GetOracle().UntilExpired(l.TxnID, status.ttl) <= 0 {
+			// The primary lock of an async commit transaction is never rolled back alone, the status of the
+			// transaction is decided by the secondary locks once it expires.
+			err = lr.resolveAsyncCommitLock(bo, l, status.primaryLock)
+			if err != nil {
+				msBeforeTxnExpired.update(0)
+				err = errors.Trace(err)
+				return msBeforeTxnExpired.value(), nil, err
+			}
+			continue
+		}
+
 		if status.ttl == 0 {
 			// If the lock is committed or rollbacked, resolve lock.
 			cleanRegions, exists := cleanTxns[l.TxnID]
@@ -219,6 +234,38 @@ func (lr *LockResolver) ResolveLocks(bo *Backoffer, callerStartTS uint64, locks
 	return msBeforeTxnExpired.value(), pushed, nil
 }
 
+// BatchResolveLocks resolves the locks left by the transactions started before the GC safe point. The transactions
+// can't be committed after the safe point, so the locks are resolved regardless of their TTL.
+func (lr *LockResolver) BatchResolveLocks(bo *Backoffer, locks []*Lock) error {
+	cleanTxns := make(map[uint64]map[RegionVerID]struct{})
+	for _, l := range locks {
+		// Every lock has expired at math.MaxUint64, so the primary lock is rolled back if it's still there.
+		status, err := lr.getTxnStatus(bo, l.TxnID, l.Primary, 0, math.MaxUint64, true)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if status.primaryLock != nil {
+			if err = lr.resolveAsyncCommitLock(bo, l, status.primaryLock); err != nil {
+				return errors.Trace(err)
+			}
+			continue
+		}
+		if status.ttl != 0 {
+			return errors.Errorf("txn %d is still alive with ttl %d after it's checked at the max ts", l.TxnID, status.ttl)
+		}
+
+		cleanRegions, exists := cleanTxns[l.TxnID]
+		if !exists {
+			cleanRegions = make(map[RegionVerID]struct{})
+			cleanTxns[l.TxnID] = cleanRegions
+		}
+		if err = lr.resolveLock(bo, l, status, cleanRegions); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
 type txnExpireTime struct {
 	initialized bool
 	txnExpire   int64
@@ -336,6 +383,9 @@ func (lr *LockResolver) getTxnStatus(bo *Backoffer, txnID uint64, primary []byte
 		status.ttl = cmdResp.GetLockTtl()
 		status.commitTS = cmdResp.GetCommitVersion()
 		status.action = cmdResp.GetAction()
+		if lockInfo := cmdResp.GetLockInfo(); lockInfo != nil && lockInfo.UseAsyncCommit {
+			status.primaryLock = lockInfo
+		}
 		// fmt.Println(status.ttl, status.commitTS, status.action)
 
 		return status, nil
@@ -395,3 +445,97 @@ func (lr *LockResolver) resolveLock(bo *Backoffer, l *Lock, status TxnStatus, cl
 		return nil
 	}
 }
+
+// asyncCommitStatus is the status of an async commit transaction decided by its secondary locks.
+type asyncCommitStatus struct {
+	// commitTS is set if any key has been committed.
+	commitTS uint64
+	// minCommitTS is the max min commit ts of the locks.
+	minCommitTS uint64
+	// rolledBack is true if any key isn't prewritten, it can't be prewritten any more.
+	rolledBack bool
+}
+
+// resolveAsyncCommitLock resolves the locks of an async commit transaction whose primary lock has expired. The
+// transaction is committed if all the keys have been prewritten, at the max min commit ts of the locks, otherwise
+// it's rolled back.
+func (lr *LockResolver) resolveAsyncCommitLock(bo *Backoffer, l *Lock, primaryLock *kvrpcpb.LockInfo) error {
+	acStatus := asyncCommitStatus{minCommitTS: primaryLock.MinCommitTs}
+	if err := lr.checkSecondaryLocks(bo, l.TxnID, primaryLock.Secondaries, &acStatus); err != nil {
+		return errors.Trace(err)
+	}
+	var status TxnStatus
+	if acStatus.commitTS > 0 {
+		status.commitTS = acStatus.commitTS
+	} else if !acStatus.rolledBack {
+		status.commitTS = acStatus.minCommitTS
+	}
+	logutil.BgLogger().Info("resolve async commit locks",
+		zap.Uint64("txnStartTS", l.TxnID),
+		zap.Uint64("commitTS", status.commitTS))
+
+	keys := append([][]byte{primaryLock.Key}, primaryLock.Secondaries...)
+	groups, _, err := lr.store.GetRegionCache().GroupKeysByRegion(bo, keys, nil)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	for _, regionKeys := range groups {
+		lock := *l
+		lock.Key = regionKeys[0]
+		if err = lr.resolveLock(bo, &lock, status, map[RegionVerID]struct{}{}); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+// checkSecondaryLocks checks the secondary locks of keys and merges the results into status.
+func (lr *LockResolver) checkSecondaryLocks(bo *Backoffer, txnID uint64, keys [][]byte, status *asyncCommitStatus) error {
+	groups, _, err := lr.store.GetRegionCache().GroupKeysByRegion(bo, keys, nil)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	for region, regionKeys := range groups {
+		req := tikvrpc.NewRequest(tikvrpc.CmdCheckSecondaryLocks, &kvrpcpb.CheckSecondaryLocksRequest{
+			Keys:         regionKeys,
+			StartVersion: txnID,
+		})
+		resp, err := lr.store.SendReq(bo, req, region, readTimeoutShort)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		regionErr, err := resp.GetRegionError()
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if regionErr != nil {
+			err = bo.Backoff(BoRegionMiss, errors.New(regionErr.String()))
+			if err != nil {
+				return errors.Trace(err)
+			}
+			// re-split keys and check again.
+			if err = lr.checkSecondaryLocks(bo, txnID, regionKeys, status); err != nil {
+				return errors.Trace(err)
+			}
+			continue
+		}
+		if resp.Resp == nil {
+			return errors.Trace(ErrBodyMissing)
+		}
+		cmdResp := resp.Resp.(*kvrpcpb.CheckSecondaryLocksResponse)
+		if keyErr := cmdResp.GetError(); keyErr != nil {
+			return errors.Trace(extractKeyErr(keyErr))
+		}
+		if cmdResp.CommitTs > 0 {
+			status.commitTS = cmdResp.CommitTs
+		} else if len(cmdResp.Locks) < len(regionKeys) {
+			status.rolledBack = true
+		}
+		for _, lock := range cmdResp.Locks {
+			if lock.MinCommitTs > status.minCommitTS {
+				status.minCommitTS = lock.MinCommitTs
+			}
+		}
+	}
+	return nil
+}
@@ -364,3 +364,38 @@ func (s *testLockSuite) TestZeroMinCommitTS(c *C) {
 	c.Assert(pushed, HasLen, 0)
 	c.Assert(expire, Greater, int64(0))
 }
+
+func (s *testLockSuite) TestResolveLocksBeforeSafePoint(c *C) {
+	s.putAlphabets(c)
+	s.prepareAlphabetLocks(c)
+	// Scan the locks in a few rounds.
+	defer func(limit int) { gcScanLockLimit = limit }(gcScanLockLimit)
+	gcScanLockLimit = 2
+
+	ctx := context.Background()
+	safePoint, err := s.store.GetOracle().GetTimestamp(ctx)
+	c.Assert(err, IsNil)
+	err = ResolveLocksBeforeSafePoint(ctx, s.store, safePoint, 1)
+	c.Assert(err, IsNil)
+
+	bo := NewBackoffer(ctx, GcResolveLockMaxBackoff)
+	loc, err := s.store.GetRegionCache().LocateKey(bo, []byte("a"))
+	c.Assert(err, IsNil)
+	req := tikvrpc.NewRequest(tikvrpc.CmdScanLock, &kvrpcpb.ScanLockRequest{MaxVersion: safePoint})
+	resp, err := s.store.SendReq(bo, req, loc.Region, ReadTimeoutMedium)
+	c.Assert(err, IsNil)
+	c.Assert(resp.Resp.(*kvrpcpb.ScanLockResponse).Locks, HasLen, 0)
+
+	// The committed transactions are committed, the others are rolled back.
+	txn, err := s.store.Begin()
+	c.Assert(err, IsNil)
+	for key, value := range map[string]string{"c": "c", "d": "d", "z1": "z1", "bar": "", "foo": ""} {
+		v, err := txn.Get(ctx, []byte(key))
+		if value == "" {
+			c.Assert(kv.IsErrNotFound(err), IsTrue)
+			continue
+		}
+		c.Assert(err, IsNil)
+		c.Assert(v, BytesEquals, []byte(value))
+	}
+}
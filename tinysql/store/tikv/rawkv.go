@@ -14,6 +14,7 @@
 package tikv
 
 import (
+	"bytes"
 	"context"
 
 	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
@@ -30,7 +31,7 @@ var (
 )
 
 // RawKVClient is a client of TiKV server which is used as a key-value storage,
-// only GET/PUT/DELETE commands are supported.
+// the keys are read and written without transactions.
 type RawKVClient struct {
 	clusterID   uint64
 	regionCache *RegionCache
@@ -77,8 +78,45 @@ func (c *RawKVClient) Get(key []byte) ([]byte, error) {
 	return cmdResp.Value, nil
 }
 
+// BatchGet queries values with the keys, the values of the keys which don't exist are nil.
+func (c *RawKVClient) BatchGet(keys [][]byte) ([][]byte, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+	resps, err := c.sendBatchReq(keys, func(keys [][]byte) *tikvrpc.Request {
+		return tikvrpc.NewRequest(tikvrpc.CmdRawBatchGet, &kvrpcpb.RawBatchGetRequest{Keys: keys})
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	found := make(map[string][]byte, len(keys))
+	for _, resp := range resps {
+		if resp.Resp == nil {
+			return nil, errors.Trace(ErrBodyMissing)
+		}
+		cmdResp := resp.Resp.(*kvrpcpb.RawBatchGetResponse)
+		if cmdResp.GetError() != "" {
+			return nil, errors.New(cmdResp.GetError())
+		}
+		for _, pair := range cmdResp.Pairs {
+			found[string(pair.Key)] = pair.Value
+		}
+	}
+	values := make([][]byte, len(keys))
+	for i, key := range keys {
+		values[i] = found[string(key)]
+	}
+	return values, nil
+}
+
 // Put stores a key-value pair to TiKV.
 func (c *RawKVClient) Put(key, value []byte) error {
+	return c.PutWithTTL(key, value, 0)
+}
+
+// PutWithTTL stores a key-value pair which expires after ttl seconds, 0 means it never expires. TiKV must have the
+// raw TTL enabled to store a pair with TTL.
+func (c *RawKVClient) PutWithTTL(key, value []byte, ttl uint64) error {
 	if len(value) == 0 {
 		return errors.New("empty value is not supported")
 	}
@@ -86,6 +124,7 @@ func (c *RawKVClient) Put(key, value []byte) error {
 	req := tikvrpc.NewRequest(tikvrpc.CmdRawPut, &kvrpcpb.RawPutRequest{
 		Key:   key,
 		Value: value,
+		Ttl:   ttl,
 	})
 	resp, _, err := c.sendReq(key, req, false)
 	if err != nil {
@@ -101,6 +140,47 @@ func (c *RawKVClient) Put(key, value []byte) error {
 	return nil
 }
 
+// BatchPut stores the key-value pairs to TiKV, the pairs in the same region are written atomically.
+func (c *RawKVClient) BatchPut(keys, values [][]byte) error {
+	return c.BatchPutWithTTL(keys, values, 0)
+}
+
+// BatchPutWithTTL stores the key-value pairs which expire after ttl seconds, see PutWithTTL.
+func (c *RawKVClient) BatchPutWithTTL(keys, values [][]byte, ttl uint64) error {
+	if len(keys) != len(values) {
+		return errors.New("the len of keys is not equal to the len of values")
+	}
+	valueOfKey := make(map[string][]byte, len(keys))
+	for i, value := range values {
+		if len(value) == 0 {
+			return errors.New("empty value is not supported")
+		}
+		valueOfKey[string(keys[i])] = value
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	resps, err := c.sendBatchReq(keys, func(keys [][]byte) *tikvrpc.Request {
+		pairs := make([]*kvrpcpb.KvPair, 0, len(keys))
+		for _, key := range keys {
+			pairs = append(pairs, &kvrpcpb.KvPair{Key: key, Value: valueOfKey[string(key)]})
+		}
+		return tikvrpc.NewRequest(tikvrpc.CmdRawBatchPut, &kvrpcpb.RawBatchPutRequest{Pairs: pairs, Ttl: ttl})
+	})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	for _, resp := range resps {
+		if resp.Resp == nil {
+			return errors.Trace(ErrBodyMissing)
+		}
+		if cmdResp := resp.Resp.(*kvrpcpb.RawBatchPutResponse); cmdResp.GetError() != "" {
+			return errors.New(cmdResp.GetError())
+		}
+	}
+	return nil
+}
+
 // Delete deletes a key-value pair from TiKV.
 func (c *RawKVClient) Delete(key []byte) error {
 	req := tikvrpc.NewRequest(tikvrpc.CmdRawDelete, &kvrpcpb.RawDeleteRequest{
@@ -120,6 +200,80 @@ func (c *RawKVClient) Delete(key []byte) error {
 	return nil
 }
 
+// BatchDelete deletes the key-value pairs from TiKV.
+func (c *RawKVClient) BatchDelete(keys [][]byte) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	resps, err := c.sendBatchReq(keys, func(keys [][]byte) *tikvrpc.Request {
+		return tikvrpc.NewRequest(tikvrpc.CmdRawBatchDelete, &kvrpcpb.RawBatchDeleteRequest{Keys: keys})
+	})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	for _, resp := range resps {
+		if resp.Resp == nil {
+			return errors.Trace(ErrBodyMissing)
+		}
+		if cmdResp := resp.Resp.(*kvrpcpb.RawBatchDeleteResponse); cmdResp.GetError() != "" {
+			return errors.New(cmdResp.GetError())
+		}
+	}
+	return nil
+}
+
+// DeleteRange deletes all key-value pairs in range [startKey, endKey) from TiKV, an empty endKey means unbounded.
+// The range is deleted region by region, so it's not atomic.
+func (c *RawKVClient) DeleteRange(startKey, endKey []byte) error {
+	for len(endKey) == 0 || bytes.Compare(startKey, endKey) < 0 {
+		resp, actualEndKey, err := c.sendDeleteRangeReq(startKey, endKey)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if resp.Resp == nil {
+			return errors.Trace(ErrBodyMissing)
+		}
+		if cmdResp := resp.Resp.(*kvrpcpb.RawDeleteRangeResponse); cmdResp.GetError() != "" {
+			return errors.New(cmdResp.GetError())
+		}
+		if len(actualEndKey) == 0 {
+			break
+		}
+		startKey = actualEndKey
+	}
+	return nil
+}
+
+// CompareAndSwap sets the value of the key to newValue if its current value is previousValue, a nil previousValue
+// means the key doesn't exist. It returns the value of the key before the call and whether the value is set.
+func (c *RawKVClient) CompareAndSwap(key, previousValue, newValue []byte) ([]byte, bool, error) {
+	if len(newValue) == 0 {
+		return nil, false, errors.New("empty value is not supported")
+	}
+
+	req := tikvrpc.NewRequest(tikvrpc.CmdRawCompareAndSwap, &kvrpcpb.RawCASRequest{
+		Key:              key,
+		Value:            newValue,
+		PreviousNotExist: previousValue == nil,
+		PreviousValue:    previousValue,
+	})
+	resp, _, err := c.sendReq(key, req, false)
+	if err != nil {
+		return nil, false, errors.Trace(err)
+	}
+	if resp.Resp == nil {
+		return nil, false, errors.Trace(ErrBodyMissing)
+	}
+	cmdResp := resp.Resp.(*kvrpcpb.RawCASResponse)
+	if cmdResp.GetError() != "" {
+		return nil, false, errors.New(cmdResp.GetError())
+	}
+	if cmdResp.PreviousNotExist {
+		return nil, cmdResp.Succeed, nil
+	}
+	return cmdResp.PreviousValue, cmdResp.Succeed, nil
+}
+
 // Scan queries continuous kv pairs in range [startKey, endKey), up to limit pairs.
 // If endKey is empty, it means unbounded.
 // If you want to exclude the startKey or include the endKey, append a '\0' to the key. For example, to scan
@@ -187,3 +341,79 @@ func (c *RawKVClient) sendReq(key []byte, req *tikvrpc.Request, reverse bool) (*
 		return resp, loc, nil
 	}
 }
+
+// sendBatchReq sends the requests created by newReq for the keys grouped by region. If a request meets a region
+// error, e.g. the region is split, its keys are grouped again and sent with new requests.
+func (c *RawKVClient) sendBatchReq(keys [][]byte, newReq func(keys [][]byte) *tikvrpc.Request) ([]*tikvrpc.Response, error) {
+	bo := NewBackoffer(context.Background(), rawkvMaxBackoff)
+	return c.doBatchReq(bo, keys, newReq)
+}
+
+func (c *RawKVClient) doBatchReq(bo *Backoffer, keys [][]byte, newReq func(keys [][]byte) *tikvrpc.Request) ([]*tikvrpc.Response, error) {
+	groups, _, err := c.regionCache.GroupKeysByRegion(bo, keys, nil)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	sender := NewRegionRequestSender(c.regionCache, c.rpcClient)
+	resps := make([]*tikvrpc.Response, 0, len(groups))
+	for region, groupKeys := range groups {
+		resp, err := sender.SendReq(bo, newReq(groupKeys), region, readTimeoutShort)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		regionErr, err := resp.GetRegionError()
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if regionErr != nil {
+			if err = bo.Backoff(BoRegionMiss, errors.New(regionErr.String())); err != nil {
+				return nil, errors.Trace(err)
+			}
+			retryResps, err := c.doBatchReq(bo, groupKeys, newReq)
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			resps = append(resps, retryResps...)
+			continue
+		}
+		resps = append(resps, resp)
+	}
+	return resps, nil
+}
+
+// sendDeleteRangeReq deletes the part of [startKey, endKey) in the region of startKey, it returns the end key of
+// the deleted part. The range is clamped to the region again if the region changes.
+func (c *RawKVClient) sendDeleteRangeReq(startKey, endKey []byte) (*tikvrpc.Response, []byte, error) {
+	bo := NewBackoffer(context.Background(), rawkvMaxBackoff)
+	sender := NewRegionRequestSender(c.regionCache, c.rpcClient)
+	for {
+		loc, err := c.regionCache.LocateKey(bo, startKey)
+		if err != nil {
+			return nil, nil, errors.Trace(err)
+		}
+		actualEndKey := endKey
+		if len(loc.EndKey) > 0 && (len(endKey) == 0 || bytes.Compare(loc.EndKey, endKey) < 0) {
+			actualEndKey = loc.EndKey
+		}
+		req := tikvrpc.NewRequest(tikvrpc.CmdRawDeleteRange, &kvrpcpb.RawDeleteRangeRequest{
+			StartKey: startKey,
+			EndKey:   actualEndKey,
+		})
+		resp, err := sender.SendReq(bo, req, loc.Region, readTimeoutShort)
+		if err != nil {
+			return nil, nil, errors.Trace(err)
+		}
+		regionErr, err := resp.GetRegionError()
+		if err != nil {
+			return nil, nil, errors.Trace(err)
+		}
+		if regionErr != nil {
+			err := bo.Backoff(BoRegionMiss, errors.New(regionErr.String()))
+			if err != nil {
+				return nil, nil, errors.Trace(err)
+			}
+			continue
+		}
+		return resp, actualEndKey, nil
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tikv
+
+import (
+	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb/store/mockstore/mocktikv"
+)
+
+type testRawKVSuite struct {
+	OneByOneSuite
+	store  mocktikv.MVCCStore
+	client *RawKVClient
+}
+
+var _ = Suite(&testRawKVSuite{})
+
+func (s *testRawKVSuite) SetUpTest(c *C) {
+	cluster := mocktikv.NewCluster()
+	// The regions are [, b), [b, d) and [d, ).
+	mocktikv.BootstrapWithMultiRegions(cluster, []byte("b"), []byte("d"))
+	s.store = mocktikv.MustNewMVCCStore()
+	s.client = &RawKVClient{
+		regionCache: NewRegionCache(&codecPDClient{mocktikv.NewPDClient(cluster)}),
+		rpcClient:   mocktikv.NewRPCClient(cluster, s.store),
+	}
+}
+
+func (s *testRawKVSuite) TearDownTest(c *C) {
+	c.Assert(s.client.Close(), IsNil)
+}
+
+func (s *testRawKVSuite) mustGet(c *C, key string) []byte {
+	value, err := s.client.Get([]byte(key))
+	c.Assert(err, IsNil)
+	return value
+}
+
+func (s *testRawKVSuite) TestBatch(c *C) {
+	keys := [][]byte{[]byte("a"), []byte("b"), []byte("c"), []byte("e")}
+	values := [][]byte{[]byte("1"), []byte("2"), []byte("3"), []byte("4")}
+	c.Assert(s.client.BatchPut(keys, values), IsNil)
+
+	got, err := s.client.BatchGet(append(keys, []byte("f")))
+	c.Assert(err, IsNil)
+	c.Assert(got, DeepEquals, append(values, nil))
+
+	c.Assert(s.client.BatchDelete([][]byte{[]byte("a"), []byte("e")}), IsNil)
+	got, err = s.client.BatchGet(keys)
+	c.Assert(err, IsNil)
+	c.Assert(got, DeepEquals, [][]byte{nil, []byte("2"), []byte("3"), nil})
+
+	c.Assert(s.client.BatchPut(keys, values[:1]), NotNil)
+	c.Assert(s.client.BatchPut(keys[:1], [][]byte{nil}), NotNil)
+}
+
+func (s *testRawKVSuite) TestBatchGetEmptyValue(c *C) {
+	// The client rejects empty values, but a key written by others may have one. It's returned as an empty value
+	// rather than as an absent key.
+	s.store.(mocktikv.RawKV).RawPut([]byte("a"), nil)
+	got, err := s.client.BatchGet([][]byte{[]byte("a"), []byte("b")})
+	c.Assert(err, IsNil)
+	c.Assert(got, DeepEquals, [][]byte{{}, nil})
+}
+
+func (s *testRawKVSuite) TestDeleteRange(c *C) {
+	keys := [][]byte{[]byte("a"), []byte("b"), []byte("c"), []byte("d"), []byte("e")}
+	for _, key := range keys {
+		c.Assert(s.client.Put(key, key), IsNil)
+	}
+
+	// The range crosses all the regions.
+	c.Assert(s.client.DeleteRange([]byte("a1"), []byte("e")), IsNil)
+	got, err := s.client.BatchGet(keys)
+	c.Assert(err, IsNil)
+	c.Assert(got, DeepEquals, [][]byte{[]byte("a"), nil, nil, nil, []byte("e")})
+
+	c.Assert(s.client.DeleteRange([]byte("c"), nil), IsNil)
+	c.Assert(s.mustGet(c, "a"), DeepEquals, []byte("a"))
+	c.Assert(s.mustGet(c, "e"), IsNil)
+}
+
+func (s *testRawKVSuite) TestCompareAndSwap(c *C) {
+	key := []byte("c")
+	previous, succeed, err := s.client.CompareAndSwap(key, nil, []byte("1"))
+	c.Assert(err, IsNil)
+	c.Assert(succeed, IsTrue)
+	c.Assert(previous, IsNil)
+
+	previous, succeed, err = s.client.CompareAndSwap(key, nil, []byte("2"))
+	c.Assert(err, IsNil)
+	c.Assert(succeed, IsFalse)
+	c.Assert(previous, DeepEquals, []byte("1"))
+
+	previous, succeed, err = s.client.CompareAndSwap(key, []byte("0"), []byte("2"))
+	c.Assert(err, IsNil)
+	c.Assert(succeed, IsFalse)
+	c.Assert(previous, DeepEquals, []byte("1"))
+
+	previous, succeed, err = s.client.CompareAndSwap(key, []byte("1"), []byte("2"))
+	c.Assert(err, IsNil)
+	c.Assert(succeed, IsTrue)
+	c.Assert(previous, DeepEquals, []byte("1"))
+	c.Assert(s.mustGet(c, "c"), DeepEquals, []byte("2"))
+}
+
+func (s *testRawKVSuite) TestPutWithTTL(c *C) {
+	// The mock store doesn't keep the expire time, so the writes with TTL are rejected.
+	c.Assert(s.client.PutWithTTL([]byte("a"), []byte("1"), 10), NotNil)
+	c.Assert(s.client.BatchPutWithTTL([][]byte{[]byte("a")}, [][]byte{[]byte("1")}, 10), NotNil)
+	c.Assert(s.mustGet(c, "a"), IsNil)
+}
@@ -281,7 +281,7 @@ func (c *RegionCache) GetTiKVRPCContext(bo *Backoffer, id RegionVerID, replicaRe
 	var peer *metapb.Peer
 	var storeIdx int
 	switch replicaRead {
-	case kv.ReplicaReadFollower:
+	case kv.ReplicaReadFollower, kv.ReplicaReadStale:
 		store, peer, storeIdx = cachedRegion.FollowerStorePeer(regionStore, followerStoreSeed)
 	default:
 		store, peer, storeIdx = cachedRegion.WorkStorePeer(regionStore)
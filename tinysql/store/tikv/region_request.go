@@ -49,6 +49,11 @@ var ShuttingDown uint32
 // range, such as 'I/O timeout', 'NotLeader', and 'ServerIsBusy'. For other
 // errors, since region range have changed, the request may need to split, so we
 // simply return the error to caller.
+//
+// A replica read or stale read request is sent to a follower picked by the
+// ReplicaReadSeed of the request, the followers of a region are chosen in turn
+// as the seed increases, and the next one is tried if the follower fails to
+// serve the request.
 type RegionRequestSender struct {
 	regionCache  *RegionCache
 	client       Client
@@ -89,7 +94,7 @@ func (s *RegionRequestSender) SendReqCtx(
 		}
 	})
 
-	replicaRead := kv.ReplicaReadLeader
+	replicaRead := req.ReplicaReadType()
 	seed := req.ReplicaReadSeed
 	for {
 		rpcCtx, err = s.regionCache.GetTiKVRPCContext(bo, regionID, replicaRead, seed)
@@ -123,6 +128,19 @@ func (s *RegionRequestSender) SendReqCtx(
 			return nil, nil, errors.Trace(err)
 		}
 		if regionErr != nil {
+			if req.StaleRead && regionErr.GetDataIsNotReady() != nil {
+				// The resolved ts of the replica is behind the read, the leader serves it with the latest data.
+				logutil.BgLogger().Debug("tikv reports `DataIsNotReady` retry on the leader",
+					zap.Stringer("dataIsNotReady", regionErr.GetDataIsNotReady()),
+					zap.Stringer("ctx", rpcCtx))
+				req.StaleRead = false
+				replicaRead = kv.ReplicaReadLeader
+				continue
+			}
+			if replicaRead.IsFollowerRead() && regionErr.GetNotLeader() != nil {
+				// The follower can't get the read index from the leader, try the next one.
+				seed++
+			}
 			retry, err = s.onRegionError(bo, rpcCtx, &seed, regionErr)
 			if err != nil {
 				return nil, nil, errors.Trace(err)
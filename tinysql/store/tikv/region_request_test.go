@@ -0,0 +1,121 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tikv
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/proto/pkg/errorpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/store/mockstore/mocktikv"
+	"github.com/pingcap/tidb/store/tikv/tikvrpc"
+)
+
+// notReadyClient fails the stale reads as if the resolved ts of the replicas is behind.
+type notReadyClient struct {
+	Client
+	addrs []string
+}
+
+func (c *notReadyClient) SendRequest(ctx context.Context, addr string, req *tikvrpc.Request, timeout time.Duration) (*tikvrpc.Response, error) {
+	c.addrs = append(c.addrs, addr)
+	if req.StaleRead {
+		return tikvrpc.GenRegionErrorResp(req, &errorpb.Error{DataIsNotReady: &errorpb.DataIsNotReady{}})
+	}
+	return c.Client.SendRequest(ctx, addr, req, timeout)
+}
+
+type testRegionRequestSuite struct {
+	OneByOneSuite
+	cluster  *mocktikv.Cluster
+	storeIDs []uint64
+	cache    *RegionCache
+	sender   *RegionRequestSender
+}
+
+var _ = Suite(&testRegionRequestSuite{})
+
+func (s *testRegionRequestSuite) SetUpTest(c *C) {
+	s.cluster = mocktikv.NewCluster()
+	// The first store is the leader.
+	s.storeIDs, _, _, _ = mocktikv.BootstrapWithMultiStores(s.cluster, 3)
+	s.cache = NewRegionCache(&codecPDClient{mocktikv.NewPDClient(s.cluster)})
+	s.sender = NewRegionRequestSender(s.cache, mocktikv.NewRPCClient(s.cluster, mocktikv.MustNewMVCCStore()))
+}
+
+func (s *testRegionRequestSuite) TearDownTest(c *C) {
+	s.cache.Close()
+}
+
+func (s *testRegionRequestSuite) sendGet(c *C, replicaRead kv.ReplicaReadType, seed uint32) *RPCContext {
+	bo := NewBackoffer(context.Background(), 5000)
+	loc, err := s.cache.LocateKey(bo, []byte("a"))
+	c.Assert(err, IsNil)
+	req := tikvrpc.NewReplicaReadRequest(tikvrpc.CmdGet, &kvrpcpb.GetRequest{Key: []byte("a"), Version: 1},
+		replicaRead, seed, kvrpcpb.Context{})
+	resp, rpcCtx, err := s.sender.SendReqCtx(bo, req, loc.Region, readTimeoutShort)
+	c.Assert(err, IsNil)
+	regionErr, err := resp.GetRegionError()
+	c.Assert(err, IsNil)
+	c.Assert(regionErr, IsNil)
+	c.Assert(resp.Resp.(*kvrpcpb.GetResponse).NotFound, IsTrue)
+	return rpcCtx
+}
+
+func (s *testRegionRequestSuite) TestReplicaSelection(c *C) {
+	leaderAddr := fmt.Sprintf("store%d", s.storeIDs[0])
+	for seed := uint32(0); seed < 4; seed++ {
+		c.Assert(s.sendGet(c, kv.ReplicaReadLeader, seed).Addr, Equals, leaderAddr)
+	}
+
+	// The followers are chosen in turn.
+	addrs := make(map[string]struct{})
+	for seed := uint32(0); seed < 4; seed++ {
+		rpcCtx := s.sendGet(c, kv.ReplicaReadFollower, seed)
+		c.Assert(rpcCtx.Addr, Not(Equals), leaderAddr)
+		addrs[rpcCtx.Addr] = struct{}{}
+		c.Assert(s.sendGet(c, kv.ReplicaReadStale, seed).Addr, Equals, rpcCtx.Addr)
+	}
+	c.Assert(addrs, HasLen, 2)
+}
+
+func (s *testRegionRequestSuite) TestReplicaReadContext(c *C) {
+	req := tikvrpc.NewReplicaReadRequest(tikvrpc.CmdGet, &kvrpcpb.GetRequest{}, kv.ReplicaReadFollower, 1)
+	c.Assert(req.ReplicaRead, IsTrue)
+	c.Assert(req.StaleRead, IsFalse)
+	c.Assert(req.ReplicaReadType(), Equals, kv.ReplicaReadFollower)
+
+	req = tikvrpc.NewReplicaReadRequest(tikvrpc.CmdGet, &kvrpcpb.GetRequest{}, kv.ReplicaReadStale, 1)
+	c.Assert(req.ReplicaRead, IsFalse)
+	c.Assert(req.StaleRead, IsTrue)
+	c.Assert(req.ReplicaReadType(), Equals, kv.ReplicaReadStale)
+
+	// The zero value of kv.Request.ReplicaRead reads from the leader.
+	req = tikvrpc.NewReplicaReadRequest(tikvrpc.CmdGet, &kvrpcpb.GetRequest{}, 0, 1)
+	c.Assert(req.ReplicaReadType(), Equals, kv.ReplicaReadLeader)
+}
+
+func (s *testRegionRequestSuite) TestStaleReadNotReady(c *C) {
+	client := &notReadyClient{Client: mocktikv.NewRPCClient(s.cluster, mocktikv.MustNewMVCCStore())}
+	s.sender = NewRegionRequestSender(s.cache, client)
+	rpcCtx := s.sendGet(c, kv.ReplicaReadStale, 0)
+	// The read is retried on the leader.
+	c.Assert(rpcCtx.Addr, Equals, fmt.Sprintf("store%d", s.storeIDs[0]))
+	c.Assert(client.addrs, HasLen, 2)
+	c.Assert(client.addrs[0], Not(Equals), rpcCtx.Addr)
+}
@@ -189,7 +189,7 @@ func (s *Scanner) getData(bo *Backoffer) error {
 		if s.reverse {
 			sreq.StartKey = s.nextEndKey
 		}
-		req := tikvrpc.NewRequest(tikvrpc.CmdScan, sreq, pb.Context{})
+		req := tikvrpc.NewReplicaReadRequest(tikvrpc.CmdScan, sreq, s.snapshot.replicaRead, s.snapshot.replicaReadSeed, pb.Context{})
 		resp, err := sender.SendReq(bo, req, loc.Region, ReadTimeoutMedium)
 		if err != nil {
 			return errors.Trace(err)
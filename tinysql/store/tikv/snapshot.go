@@ -49,6 +49,9 @@ type tikvSnapshot struct {
 	vars    *kv.Variables
 	minCommitTSPushed
 
+	replicaRead     kv.ReplicaReadType
+	replicaReadSeed uint32
+
 	// Cache the result of BatchGet.
 	// The invariance is that calling BatchGet multiple times using the same start ts,
 	// the result should not change.
@@ -60,7 +63,7 @@ type tikvSnapshot struct {
 }
 
 // newTiKVSnapshot creates a snapshot of an TiKV store.
-func newTiKVSnapshot(store *TinykvStore, ver kv.Version) *tikvSnapshot {
+func newTiKVSnapshot(store *TinykvStore, ver kv.Version, replicaReadSeed uint32) *tikvSnapshot {
 	return &tikvSnapshot{
 		store:   store,
 		version: ver,
@@ -68,6 +71,8 @@ func newTiKVSnapshot(store *TinykvStore, ver kv.Version) *tikvSnapshot {
 		minCommitTSPushed: minCommitTSPushed{
 			data: make(map[uint64]struct{}, 5),
 		},
+		replicaRead:     kv.ReplicaReadLeader,
+		replicaReadSeed: replicaReadSeed,
 	}
 }
 
@@ -115,11 +120,11 @@ func (s *tikvSnapshot) get(bo *Backoffer, k kv.Key) ([]byte, error) {
 		Client:            s.store.client,
 	}
 
-	req := tikvrpc.NewRequest(tikvrpc.CmdGet,
+	req := tikvrpc.NewReplicaReadRequest(tikvrpc.CmdGet,
 		&pb.GetRequest{
 			Key:     k,
 			Version: s.version.Ver,
-		}, pb.Context{})
+		}, s.replicaRead, s.replicaReadSeed, pb.Context{})
 	for {
 		loc, err := s.store.regionCache.LocateKey(bo, k)
 		if err != nil {
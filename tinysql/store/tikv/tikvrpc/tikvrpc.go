@@ -23,6 +23,7 @@ import (
 	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/tinykvpb"
 	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/kv"
 )
 
 // CmdType represents the concrete request type in Request or response type in Response.
@@ -37,11 +38,22 @@ const (
 	CmdBatchRollback
 	CmdResolveLock
 	CmdCheckTxnStatus
+	CmdPessimisticLock
+	CmdPessimisticRollback
+	CmdCheckSecondaryLocks
+	CmdTxnHeartBeat
+	CmdScanLock
+	CmdDeleteRange
 
 	CmdRawGet CmdType = 256 + iota
 	CmdRawPut
 	CmdRawDelete
 	CmdRawScan
+	CmdRawBatchGet
+	CmdRawBatchPut
+	CmdRawBatchDelete
+	CmdRawDeleteRange
+	CmdRawCompareAndSwap
 
 	CmdCop CmdType = 512 + iota
 )
@@ -68,10 +80,32 @@ func (t CmdType) String() string {
 		return "RawDelete"
 	case CmdRawScan:
 		return "RawScan"
+	case CmdRawBatchGet:
+		return "RawBatchGet"
+	case CmdRawBatchPut:
+		return "RawBatchPut"
+	case CmdRawBatchDelete:
+		return "RawBatchDelete"
+	case CmdRawDeleteRange:
+		return "RawDeleteRange"
+	case CmdRawCompareAndSwap:
+		return "RawCompareAndSwap"
 	case CmdCop:
 		return "Cop"
 	case CmdCheckTxnStatus:
 		return "CheckTxnStatus"
+	case CmdPessimisticLock:
+		return "PessimisticLock"
+	case CmdPessimisticRollback:
+		return "PessimisticRollback"
+	case CmdCheckSecondaryLocks:
+		return "CheckSecondaryLocks"
+	case CmdTxnHeartBeat:
+		return "TxnHeartBeat"
+	case CmdScanLock:
+		return "ScanLock"
+	case CmdDeleteRange:
+		return "DeleteRange"
 	}
 	return "Unknown"
 }
@@ -99,6 +133,26 @@ func NewRequest(typ CmdType, pointer interface{}, ctxs ...kvrpcpb.Context) *Requ
 	}
 }
 
+// NewReplicaReadRequest returns new kv rpc request with replica read.
+func NewReplicaReadRequest(typ CmdType, pointer interface{}, replicaReadType kv.ReplicaReadType, replicaReadSeed uint32, ctxs ...kvrpcpb.Context) *Request {
+	req := NewRequest(typ, pointer, ctxs...)
+	req.ReplicaRead = replicaReadType == kv.ReplicaReadFollower
+	req.StaleRead = replicaReadType == kv.ReplicaReadStale
+	req.ReplicaReadSeed = replicaReadSeed
+	return req
+}
+
+// ReplicaReadType returns the type of the replicas the request can be sent to.
+func (req *Request) ReplicaReadType() kv.ReplicaReadType {
+	switch {
+	case req.StaleRead:
+		return kv.ReplicaReadStale
+	case req.ReplicaRead:
+		return kv.ReplicaReadFollower
+	}
+	return kv.ReplicaReadLeader
+}
+
 // Get returns GetRequest in request.
 func (req *Request) Get() *kvrpcpb.GetRequest {
 	return req.req.(*kvrpcpb.GetRequest)
@@ -149,6 +203,31 @@ func (req *Request) RawScan() *kvrpcpb.RawScanRequest {
 	return req.req.(*kvrpcpb.RawScanRequest)
 }
 
+// RawBatchGet returns RawBatchGetRequest in request.
+func (req *Request) RawBatchGet() *kvrpcpb.RawBatchGetRequest {
+	return req.req.(*kvrpcpb.RawBatchGetRequest)
+}
+
+// RawBatchPut returns RawBatchPutRequest in request.
+func (req *Request) RawBatchPut() *kvrpcpb.RawBatchPutRequest {
+	return req.req.(*kvrpcpb.RawBatchPutRequest)
+}
+
+// RawBatchDelete returns RawBatchDeleteRequest in request.
+func (req *Request) RawBatchDelete() *kvrpcpb.RawBatchDeleteRequest {
+	return req.req.(*kvrpcpb.RawBatchDeleteRequest)
+}
+
+// RawDeleteRange returns RawDeleteRangeRequest in request.
+func (req *Request) RawDeleteRange() *kvrpcpb.RawDeleteRangeRequest {
+	return req.req.(*kvrpcpb.RawDeleteRangeRequest)
+}
+
+// RawCompareAndSwap returns RawCASRequest in request.
+func (req *Request) RawCompareAndSwap() *kvrpcpb.RawCASRequest {
+	return req.req.(*kvrpcpb.RawCASRequest)
+}
+
 // Cop returns coprocessor request in request.
 func (req *Request) Cop() *coprocessor.Request {
 	return req.req.(*coprocessor.Request)
@@ -159,6 +238,36 @@ func (req *Request) CheckTxnStatus() *kvrpcpb.CheckTxnStatusRequest {
 	return req.req.(*kvrpcpb.CheckTxnStatusRequest)
 }
 
+// PessimisticLock returns PessimisticLockRequest in request.
+func (req *Request) PessimisticLock() *kvrpcpb.PessimisticLockRequest {
+	return req.req.(*kvrpcpb.PessimisticLockRequest)
+}
+
+// PessimisticRollback returns PessimisticRollbackRequest in request.
+func (req *Request) PessimisticRollback() *kvrpcpb.PessimisticRollbackRequest {
+	return req.req.(*kvrpcpb.PessimisticRollbackRequest)
+}
+
+// CheckSecondaryLocks returns CheckSecondaryLocksRequest in request.
+func (req *Request) CheckSecondaryLocks() *kvrpcpb.CheckSecondaryLocksRequest {
+	return req.req.(*kvrpcpb.CheckSecondaryLocksRequest)
+}
+
+// TxnHeartBeat returns TxnHeartBeatRequest in request.
+func (req *Request) TxnHeartBeat() *kvrpcpb.TxnHeartBeatRequest {
+	return req.req.(*kvrpcpb.TxnHeartBeatRequest)
+}
+
+// ScanLock returns ScanLockRequest in request.
+func (req *Request) ScanLock() *kvrpcpb.ScanLockRequest {
+	return req.req.(*kvrpcpb.ScanLockRequest)
+}
+
+// DeleteRange returns DeleteRangeRequest in request.
+func (req *Request) DeleteRange() *kvrpcpb.DeleteRangeRequest {
+	return req.req.(*kvrpcpb.DeleteRangeRequest)
+}
+
 // Response wraps all kv/coprocessor responses.
 type Response struct {
 	Resp interface{}
@@ -194,10 +303,32 @@ func SetContext(req *Request, region *metapb.Region, peer *metapb.Peer) error {
 		req.RawDelete().Context = ctx
 	case CmdRawScan:
 		req.RawScan().Context = ctx
+	case CmdRawBatchGet:
+		req.RawBatchGet().Context = ctx
+	case CmdRawBatchPut:
+		req.RawBatchPut().Context = ctx
+	case CmdRawBatchDelete:
+		req.RawBatchDelete().Context = ctx
+	case CmdRawDeleteRange:
+		req.RawDeleteRange().Context = ctx
+	case CmdRawCompareAndSwap:
+		req.RawCompareAndSwap().Context = ctx
 	case CmdCop:
 		req.Cop().Context = ctx
 	case CmdCheckTxnStatus:
 		req.CheckTxnStatus().Context = ctx
+	case CmdPessimisticLock:
+		req.PessimisticLock().Context = ctx
+	case CmdPessimisticRollback:
+		req.PessimisticRollback().Context = ctx
+	case CmdCheckSecondaryLocks:
+		req.CheckSecondaryLocks().Context = ctx
+	case CmdTxnHeartBeat:
+		req.TxnHeartBeat().Context = ctx
+	case CmdScanLock:
+		req.ScanLock().Context = ctx
+	case CmdDeleteRange:
+		req.DeleteRange().Context = ctx
 	default:
 		return fmt.Errorf("invalid request type %v", req.Type)
 	}
@@ -250,6 +381,26 @@ func GenRegionErrorResp(req *Request, e *errorpb.Error) (*Response, error) {
 		p = &kvrpcpb.RawScanResponse{
 			RegionError: e,
 		}
+	case CmdRawBatchGet:
+		p = &kvrpcpb.RawBatchGetResponse{
+			RegionError: e,
+		}
+	case CmdRawBatchPut:
+		p = &kvrpcpb.RawBatchPutResponse{
+			RegionError: e,
+		}
+	case CmdRawBatchDelete:
+		p = &kvrpcpb.RawBatchDeleteResponse{
+			RegionError: e,
+		}
+	case CmdRawDeleteRange:
+		p = &kvrpcpb.RawDeleteRangeResponse{
+			RegionError: e,
+		}
+	case CmdRawCompareAndSwap:
+		p = &kvrpcpb.RawCASResponse{
+			RegionError: e,
+		}
 	case CmdCop:
 		p = &coprocessor.Response{
 			RegionError: e,
@@ -258,6 +409,30 @@ func GenRegionErrorResp(req *Request, e *errorpb.Error) (*Response, error) {
 		p = &kvrpcpb.CheckTxnStatusResponse{
 			RegionError: e,
 		}
+	case CmdPessimisticLock:
+		p = &kvrpcpb.PessimisticLockResponse{
+			RegionError: e,
+		}
+	case CmdPessimisticRollback:
+		p = &kvrpcpb.PessimisticRollbackResponse{
+			RegionError: e,
+		}
+	case CmdCheckSecondaryLocks:
+		p = &kvrpcpb.CheckSecondaryLocksResponse{
+			RegionError: e,
+		}
+	case CmdTxnHeartBeat:
+		p = &kvrpcpb.TxnHeartBeatResponse{
+			RegionError: e,
+		}
+	case CmdScanLock:
+		p = &kvrpcpb.ScanLockResponse{
+			RegionError: e,
+		}
+	case CmdDeleteRange:
+		p = &kvrpcpb.DeleteRangeResponse{
+			RegionError: e,
+		}
 	default:
 		return nil, fmt.Errorf("invalid request type %v", req.Type)
 	}
@@ -308,10 +483,32 @@ func CallRPC(ctx context.Context, client tinykvpb.TinyKvClient, req *Request) (*
 		resp.Resp, err = client.RawDelete(ctx, req.RawDelete())
 	case CmdRawScan:
 		resp.Resp, err = client.RawScan(ctx, req.RawScan())
+	case CmdRawBatchGet:
+		resp.Resp, err = client.RawBatchGet(ctx, req.RawBatchGet())
+	case CmdRawBatchPut:
+		resp.Resp, err = client.RawBatchPut(ctx, req.RawBatchPut())
+	case CmdRawBatchDelete:
+		resp.Resp, err = client.RawBatchDelete(ctx, req.RawBatchDelete())
+	case CmdRawDeleteRange:
+		resp.Resp, err = client.RawDeleteRange(ctx, req.RawDeleteRange())
+	case CmdRawCompareAndSwap:
+		resp.Resp, err = client.RawCompareAndSwap(ctx, req.RawCompareAndSwap())
 	case CmdCop:
 		resp.Resp, err = client.Coprocessor(ctx, req.Cop())
 	case CmdCheckTxnStatus:
 		resp.Resp, err = client.KvCheckTxnStatus(ctx, req.CheckTxnStatus())
+	case CmdPessimisticLock:
+		resp.Resp, err = client.KvPessimisticLock(ctx, req.PessimisticLock())
+	case CmdPessimisticRollback:
+		resp.Resp, err = client.KvPessimisticRollback(ctx, req.PessimisticRollback())
+	case CmdCheckSecondaryLocks:
+		resp.Resp, err = client.KvCheckSecondaryLocks(ctx, req.CheckSecondaryLocks())
+	case CmdTxnHeartBeat:
+		resp.Resp, err = client.KvTxnHeartBeat(ctx, req.TxnHeartBeat())
+	case CmdScanLock:
+		resp.Resp, err = client.KvScanLock(ctx, req.ScanLock())
+	case CmdDeleteRange:
+		resp.Resp, err = client.KvDeleteRange(ctx, req.DeleteRange())
 	default:
 		return nil, errors.Errorf("invalid request type: %v", req.Type)
 	}
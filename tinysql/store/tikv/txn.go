@@ -49,6 +49,8 @@ type tikvTxn struct {
 
 	valid bool
 	dirty bool
+	// isPessimistic is true if the keys are locked by pessimistic locks in LockKeys.
+	isPessimistic bool
 }
 
 func newTiKVTxn(store *TinykvStore) (*tikvTxn, error) {
@@ -63,7 +65,7 @@ func newTiKVTxn(store *TinykvStore) (*tikvTxn, error) {
 // newTikvTxnWithStartTS creates a txn with startTS.
 func newTikvTxnWithStartTS(store *TinykvStore, startTS uint64) (*tikvTxn, error) {
 	ver := kv.NewVersion(startTS)
-	snapshot := newTiKVSnapshot(store, ver)
+	snapshot := newTiKVSnapshot(store, ver, store.nextReplicaReadSeed())
 	return &tikvTxn{
 		snapshot:  snapshot,
 		us:        kv.NewUnionStore(snapshot),
@@ -143,6 +145,10 @@ func (txn *tikvTxn) SetOption(opt kv.Option, val interface{}) {
 		txn.snapshot.keyOnly = val.(bool)
 	case kv.SnapshotTS:
 		txn.snapshot.setSnapshotTS(val.(uint64))
+	case kv.Pessimistic:
+		txn.isPessimistic = val.(bool)
+	case kv.ReplicaRead:
+		txn.snapshot.replicaRead = val.(kv.ReplicaReadType)
 	}
 }
 
@@ -171,21 +177,17 @@ func (txn *tikvTxn) Commit(ctx context.Context) error {
 		}
 	})
 
-	// connID is used for log.
-	var connID uint64
-	val := ctx.Value(sessionctx.ConnID)
-	if val != nil {
-		connID = val.(uint64)
-	}
-
 	var err error
 	committer := txn.committer
 	if committer == nil {
-		committer, err = newTwoPhaseCommitter(txn, connID)
+		committer, err = newTwoPhaseCommitter(txn, connIDFromContext(ctx))
 		if err != nil {
 			return errors.Trace(err)
 		}
 	}
+	if txn.isPessimistic {
+		defer txn.rollbackUnwrittenLocks(committer)
+	}
 	if err := committer.initKeysAndMutations(); err != nil {
 		return errors.Trace(err)
 	}
@@ -197,6 +199,41 @@ func (txn *tikvTxn) Commit(ctx context.Context) error {
 	return errors.Trace(err)
 }
 
+// connIDFromContext returns the connection ID in ctx, it's used for log.
+func connIDFromContext(ctx context.Context) uint64 {
+	if val := ctx.Value(sessionctx.ConnID); val != nil {
+		return val.(uint64)
+	}
+	return 0
+}
+
+// rollbackUnwrittenLocks rollbacks the pessimistic locks of the keys which are locked but not written by the
+// transaction, they are not prewritten so they're not resolved with the transaction.
+func (txn *tikvTxn) rollbackUnwrittenLocks(committer *twoPhaseCommitter) {
+	var keys [][]byte
+	for _, key := range txn.lockKeys {
+		if _, ok := committer.mutations[string(key)]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	txn.asyncPessimisticRollback(committer, keys)
+}
+
+// asyncPessimisticRollback rollbacks the pessimistic locks of keys in the background.
+func (txn *tikvTxn) asyncPessimisticRollback(committer *twoPhaseCommitter, keys [][]byte) {
+	if len(keys) == 0 {
+		return
+	}
+	go func() {
+		bo := NewBackoffer(context.Background(), cleanupMaxBackoff).WithVars(txn.vars)
+		if err := committer.pessimisticRollbackKeys(bo, keys); err != nil {
+			logutil.BgLogger().Warn("[kv] pessimistic rollback failed",
+				zap.Uint64("txnStartTS", txn.startTS),
+				zap.Error(err))
+		}
+	}()
+}
+
 func (txn *tikvTxn) close() {
 	txn.valid = false
 }
@@ -207,6 +244,9 @@ func (txn *tikvTxn) Rollback() error {
 	}
 	txn.close()
 	logutil.BgLogger().Debug("[kv] rollback txn", zap.Uint64("txnStartTS", txn.StartTS()))
+	if txn.isPessimistic && txn.committer != nil {
+		txn.asyncPessimisticRollback(txn.committer, txn.lockKeys)
+	}
 	return nil
 }
 
@@ -224,6 +264,11 @@ func (txn *tikvTxn) LockKeys(ctx context.Context, lockCtx *kv.LockCtx, keysInput
 	if len(keys) == 0 {
 		return nil
 	}
+	if txn.isPessimistic && lockCtx.ForUpdateTS > 0 {
+		if err := txn.pessimisticLockKeys(ctx, lockCtx, keys); err != nil {
+			return errors.Trace(err)
+		}
+	}
 	txn.mu.Lock()
 	txn.lockKeys = append(txn.lockKeys, keys...)
 	for _, key := range keys {
@@ -234,6 +279,44 @@ func (txn *tikvTxn) LockKeys(ctx context.Context, lockCtx *kv.LockCtx, keysInput
 	return nil
 }
 
+// pessimisticLockKeys locks keys with pessimistic locks. The first key ever locked by the transaction is chosen as
+// the primary key.
+func (txn *tikvTxn) pessimisticLockKeys(ctx context.Context, lockCtx *kv.LockCtx, keys [][]byte) error {
+	if txn.committer == nil {
+		committer, err := newTwoPhaseCommitter(txn, connIDFromContext(ctx))
+		if err != nil {
+			return errors.Trace(err)
+		}
+		txn.committer = committer
+	}
+	c := txn.committer
+	assignedPrimaryKey := false
+	if len(c.primaryKey) == 0 {
+		c.primaryKey = keys[0]
+		assignedPrimaryKey = true
+	}
+	c.forUpdateTS = lockCtx.ForUpdateTS
+	bo := NewBackoffer(ctx, pessimisticLockMaxBackoff).WithVars(txn.vars)
+	err := c.pessimisticLockKeys(bo, keys)
+	if err != nil {
+		// Some of the keys may have been locked, they're unlocked before the statement retries, otherwise the retry
+		// may wait for the locks of its own.
+		if len(keys) > 1 {
+			rollbackBo := NewBackoffer(ctx, cleanupMaxBackoff).WithVars(txn.vars)
+			if err1 := c.pessimisticRollbackKeys(rollbackBo, keys); err1 != nil {
+				logutil.Logger(ctx).Warn("[kv] pessimistic rollback failed",
+					zap.Uint64("txnStartTS", txn.startTS),
+					zap.Error(err1))
+			}
+		}
+		if assignedPrimaryKey {
+			c.primaryKey = nil
+		}
+		return errors.Trace(err)
+	}
+	return nil
+}
+
 func (txn *tikvTxn) IsReadOnly() bool {
 	return !txn.dirty
 }
@@ -327,6 +327,10 @@ func getColDefaultValue(ctx sessionctx.Context, col *model.ColumnInfo, defaultVa
 	if defaultVal == nil {
 		return getColDefaultValueFromNil(ctx, col)
 	}
+	if v, ok := defaultVal.(string); ok && strings.EqualFold(v, ast.CurrentTimestamp) &&
+		(col.Tp == mysql.TypeTimestamp || col.Tp == mysql.TypeDatetime) {
+		return getCurrentTimestampValue(ctx, col)
+	}
 
 	value, err := CastValue(ctx, types.NewDatum(defaultVal), col)
 	if err != nil {
@@ -335,6 +339,18 @@ func getColDefaultValue(ctx sessionctx.Context, col *model.ColumnInfo, defaultVa
 	return value, nil
 }
 
+// getCurrentTimestampValue gets the time of the current statement for the column with a
+// CURRENT_TIMESTAMP default value.
+func getCurrentTimestampValue(ctx sessionctx.Context, col *model.ColumnInfo) (types.Datum, error) {
+	sc := ctx.GetSessionVars().StmtCtx
+	now := sc.GetNowTsCached().In(ctx.GetSessionVars().Location())
+	t, err := types.NewTime(types.FromGoTime(now), col.Tp, types.MaxFsp).RoundFrac(sc, int8(col.Decimal))
+	if err != nil {
+		return types.Datum{}, errGetDefaultFailed.GenWithStackByArgs(col.Name)
+	}
+	return types.NewTimeDatum(t), nil
+}
+
 func getColDefaultValueFromNil(ctx sessionctx.Context, col *model.ColumnInfo) (types.Datum, error) {
 	if !mysql.HasNotNullFlag(col.Flag) {
 		return types.Datum{}, nil
@@ -370,6 +386,10 @@ func GetZeroValue(col *model.ColumnInfo) types.Datum {
 		d.SetFloat32(0)
 	case mysql.TypeDouble:
 		d.SetFloat64(0)
+	case mysql.TypeNewDecimal:
+		d.SetLength(col.Flen)
+		d.SetFrac(col.Decimal)
+		d.SetMysqlDecimal(new(types.MyDecimal))
 	case mysql.TypeString:
 		if col.Flen > 0 && col.Charset == charset.CharsetBin {
 			d.SetBytes(make([]byte, col.Flen))
@@ -380,6 +400,10 @@ func GetZeroValue(col *model.ColumnInfo) types.Datum {
 		d.SetString("")
 	case mysql.TypeBlob, mysql.TypeTinyBlob, mysql.TypeMediumBlob, mysql.TypeLongBlob:
 		d.SetBytes([]byte{})
+	case mysql.TypeDate, mysql.TypeDatetime, mysql.TypeTimestamp:
+		d.SetMysqlTime(types.NewTime(types.ZeroCoreTime, col.Tp, int8(col.Decimal)))
+	case mysql.TypeDuration:
+		d.SetMysqlDuration(types.ZeroDuration)
 	}
 	return d
 }
@@ -284,7 +284,11 @@ func DecodeColumnValue(data []byte, ft *types.FieldType, loc *time.Location) (ty
 	if err != nil {
 		return types.Datum{}, errors.Trace(err)
 	}
-	return d, nil
+	colDatum, err := unflatten(d, ft, loc)
+	if err != nil {
+		return types.Datum{}, errors.Trace(err)
+	}
+	return colDatum, nil
 }
 
 // DecodeRowWithMap decodes a byte slice into datums with a existing row map.
@@ -381,6 +385,29 @@ func DecodeRowWithMapNew(b []byte, cols map[int64]*types.FieldType, loc *time.Lo
 
 // unflatten converts a raw datum to a column datum.
 func unflatten(datum types.Datum, ft *types.FieldType, loc *time.Location) (types.Datum, error) {
+	if datum.IsNull() {
+		return datum, nil
+	}
+	switch ft.Tp {
+	case mysql.TypeDate, mysql.TypeDatetime, mysql.TypeTimestamp:
+		t := types.NewTime(types.ZeroCoreTime, ft.Tp, int8(ft.Decimal))
+		err := t.FromPackedUint(datum.GetUint64())
+		if err != nil {
+			return datum, errors.Trace(err)
+		}
+		if ft.Tp == mysql.TypeTimestamp && !t.IsZero() && loc != nil && loc != time.UTC {
+			err = t.ConvertTimeZone(time.UTC, loc)
+			if err != nil {
+				return datum, errors.Trace(err)
+			}
+		}
+		datum.SetMysqlTime(t)
+		return datum, nil
+	case mysql.TypeDuration:
+		dur := types.Duration{Duration: time.Duration(datum.GetInt64()), Fsp: int8(ft.Decimal)}
+		datum.SetMysqlDuration(dur)
+		return datum, nil
+	}
 	return datum, nil
 }
 
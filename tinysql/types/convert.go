@@ -173,6 +173,44 @@ func ConvertFloatToUint(sc *stmtctx.StatementContext, fval float64, upperBound u
 	return uint64(val), nil
 }
 
+// ConvertDecimalToInt converts a decimal value to an int value, the fractional part is rounded.
+func ConvertDecimalToInt(dec *MyDecimal, lowerBound, upperBound int64, tp byte) (int64, error) {
+	var to MyDecimal
+	err := dec.Round(&to, 0, ModeHalfUp)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	val, err := to.ToInt()
+	if err != nil {
+		if to.IsNegative() {
+			return lowerBound, overflow(dec, tp)
+		}
+		return upperBound, overflow(dec, tp)
+	}
+	return ConvertIntToInt(val, lowerBound, upperBound, tp)
+}
+
+// ConvertDecimalToUint converts a decimal value to an uint value, the fractional part is rounded.
+func ConvertDecimalToUint(sc *stmtctx.StatementContext, dec *MyDecimal, upperBound uint64, tp byte) (uint64, error) {
+	var to MyDecimal
+	err := dec.Round(&to, 0, ModeHalfUp)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	if to.IsNegative() {
+		val, err := ConvertDecimalToInt(&to, math.MinInt64, math.MaxInt64, mysql.TypeLonglong)
+		if err != nil {
+			return 0, overflow(dec, tp)
+		}
+		return ConvertIntToUint(sc, val, upperBound, tp)
+	}
+	val, err := to.ToUint()
+	if err != nil {
+		return upperBound, overflow(dec, tp)
+	}
+	return ConvertUintToUint(val, upperBound, tp)
+}
+
 // convertScientificNotation converts a decimal string with scientific notation to a normal decimal string.
 // 1E6 => 1000000, .12345E+5 => 12345
 func convertScientificNotation(str string) (string, error) {
@@ -428,7 +466,7 @@ func StrToFloat(sc *stmtctx.StatementContext, str string) (float64, error) {
 
 // getValidFloatPrefix gets prefix of string which can be successfully parsed as float.
 func getValidFloatPrefix(sc *stmtctx.StatementContext, s string) (valid string, err error) {
-	if (sc.InDeleteStmt || sc.InSelectStmt) && s == "" {
+	if (sc.InDeleteStmt || sc.InSelectStmt || sc.InUpdateStmt) && s == "" {
 		return "0", nil
 	}
 
@@ -499,6 +537,8 @@ func ToString(value interface{}) (string, error) {
 		return v, nil
 	case []byte:
 		return string(v), nil
+	case *MyDecimal:
+		return v.String(), nil
 	default:
 		return "", errors.Errorf("cannot convert %v(type %T) to string", value, value)
 	}
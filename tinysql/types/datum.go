@@ -19,6 +19,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 	"unicode/utf8"
 
 	"github.com/pingcap/errors"
@@ -38,7 +39,9 @@ const (
 	KindFloat64       byte = 4
 	KindString        byte = 5
 	KindBytes         byte = 6
-	KindBinaryLiteral byte = 7  // Used for BIT / HEX literals.
+	KindBinaryLiteral byte = 7 // Used for BIT / HEX literals.
+	KindMysqlDecimal  byte = 8
+	KindMysqlDuration byte = 9
 	KindMysqlBit      byte = 11 // Used for BIT table column values.
 	KindMysqlSet      byte = 12
 	KindMysqlTime     byte = 13
@@ -233,6 +236,40 @@ func (d *Datum) SetMysqlBit(b BinaryLiteral) {
 	d.b = b
 }
 
+// GetMysqlDecimal gets Decimal value
+func (d *Datum) GetMysqlDecimal() *MyDecimal {
+	return d.x.(*MyDecimal)
+}
+
+// SetMysqlDecimal sets Decimal value
+func (d *Datum) SetMysqlDecimal(b *MyDecimal) {
+	d.k = KindMysqlDecimal
+	d.x = b
+}
+
+// GetMysqlDuration gets Duration value
+func (d *Datum) GetMysqlDuration() Duration {
+	return Duration{Duration: time.Duration(d.i), Fsp: int8(d.decimal)}
+}
+
+// SetMysqlDuration sets Duration value
+func (d *Datum) SetMysqlDuration(b Duration) {
+	d.k = KindMysqlDuration
+	d.i = int64(b.Duration)
+	d.decimal = uint16(b.Fsp)
+}
+
+// GetMysqlTime gets types.Time value
+func (d *Datum) GetMysqlTime() Time {
+	return Time{coreTime: CoreTime(d.i)}
+}
+
+// SetMysqlTime sets types.Time value
+func (d *Datum) SetMysqlTime(b Time) {
+	d.k = KindMysqlTime
+	d.i = int64(b.coreTime)
+}
+
 // SetRaw sets raw value.
 func (d *Datum) SetRaw(b []byte) {
 	d.k = KindRaw
@@ -281,6 +318,10 @@ func (d Datum) String() string {
 		t = "KindMysqlJSON"
 	case KindMysqlTime:
 		t = "KindMysqlTime"
+	case KindMysqlDecimal:
+		t = "KindMysqlDecimal"
+	case KindMysqlDuration:
+		t = "KindMysqlDuration"
 	default:
 		t = "Unknown"
 	}
@@ -308,6 +349,12 @@ func (d *Datum) GetValue() interface{} {
 		return d.GetBytes()
 	case KindBinaryLiteral, KindMysqlBit:
 		return d.GetBinaryLiteral()
+	case KindMysqlDecimal:
+		return d.GetMysqlDecimal()
+	case KindMysqlDuration:
+		return d.GetMysqlDuration()
+	case KindMysqlTime:
+		return d.GetMysqlTime()
 	default:
 		return d.GetInterface()
 	}
@@ -344,6 +391,12 @@ func (d *Datum) SetValue(val interface{}) {
 		d.SetBinaryLiteral(BinaryLiteral(x))
 	case HexLiteral:
 		d.SetBinaryLiteral(BinaryLiteral(x))
+	case *MyDecimal:
+		d.SetMysqlDecimal(x)
+	case Duration:
+		d.SetMysqlDuration(x)
+	case Time:
+		d.SetMysqlTime(x)
 	default:
 		d.SetInterface(x)
 	}
@@ -386,6 +439,12 @@ func (d *Datum) CompareDatum(sc *stmtctx.StatementContext, ad *Datum) (int, erro
 		return d.compareBytes(sc, ad.GetBytes())
 	case KindBinaryLiteral, KindMysqlBit:
 		return d.compareBinaryLiteral(sc, ad.GetBinaryLiteral())
+	case KindMysqlDecimal:
+		return d.compareMysqlDecimal(sc, ad.GetMysqlDecimal())
+	case KindMysqlDuration:
+		return d.compareMysqlDuration(sc, ad.GetMysqlDuration())
+	case KindMysqlTime:
+		return d.compareMysqlTime(sc, ad.GetMysqlTime())
 	default:
 		return 0, nil
 	}
@@ -402,6 +461,8 @@ func (d *Datum) compareInt64(sc *stmtctx.StatementContext, i int64) (int, error)
 			return 1, nil
 		}
 		return CompareInt64(d.i, i), nil
+	case KindMysqlDecimal:
+		return d.GetMysqlDecimal().Compare(NewDecFromInt(i)), nil
 	default:
 		return d.compareFloat64(sc, float64(i))
 	}
@@ -418,6 +479,8 @@ func (d *Datum) compareUint64(sc *stmtctx.StatementContext, u uint64) (int, erro
 		return CompareInt64(d.i, int64(u)), nil
 	case KindUint64:
 		return CompareUint64(d.GetUint64(), u), nil
+	case KindMysqlDecimal:
+		return d.GetMysqlDecimal().Compare(NewDecFromUint(u)), nil
 	default:
 		return d.compareFloat64(sc, float64(u))
 	}
@@ -442,6 +505,15 @@ func (d *Datum) compareFloat64(sc *stmtctx.StatementContext, f float64) (int, er
 		val, err := d.GetBinaryLiteral().ToInt(sc)
 		fVal := float64(val)
 		return CompareFloat64(fVal, f), errors.Trace(err)
+	case KindMysqlDecimal:
+		fVal, err := d.GetMysqlDecimal().ToFloat64()
+		return CompareFloat64(fVal, f), errors.Trace(err)
+	case KindMysqlDuration:
+		fVal := d.GetMysqlDuration().ToFloat64()
+		return CompareFloat64(fVal, f), nil
+	case KindMysqlTime:
+		fVal := d.GetMysqlTime().ToFloat64()
+		return CompareFloat64(fVal, f), nil
 	default:
 		return -1, nil
 	}
@@ -457,6 +529,22 @@ func (d *Datum) compareString(sc *stmtctx.StatementContext, s string) (int, erro
 		return CompareString(d.GetString(), s), nil
 	case KindBinaryLiteral, KindMysqlBit:
 		return CompareString(d.GetBinaryLiteral().ToString(), s), nil
+	case KindMysqlDecimal:
+		dec := new(MyDecimal)
+		err := sc.HandleTruncate(dec.FromString(hack.Slice(s)))
+		return d.GetMysqlDecimal().Compare(dec), errors.Trace(err)
+	case KindMysqlDuration:
+		dur, err := ParseDuration(sc, s, MaxFsp)
+		if err != nil {
+			return 0, errors.Trace(err)
+		}
+		return d.GetMysqlDuration().Compare(dur), nil
+	case KindMysqlTime:
+		dt, err := ParseTime(sc, s, mysql.TypeDatetime, GetFsp(s))
+		if err != nil {
+			return 0, errors.Trace(err)
+		}
+		return d.GetMysqlTime().Compare(dt), nil
 	default:
 		fVal, err := StrToFloat(sc, s)
 		if err != nil {
@@ -487,6 +575,55 @@ func (d *Datum) compareBinaryLiteral(sc *stmtctx.StatementContext, b BinaryLiter
 	}
 }
 
+func (d *Datum) compareMysqlDecimal(sc *stmtctx.StatementContext, dec *MyDecimal) (int, error) {
+	switch d.k {
+	case KindNull, KindMinNotNull:
+		return -1, nil
+	case KindMaxValue:
+		return 1, nil
+	case KindMysqlDecimal:
+		return d.GetMysqlDecimal().Compare(dec), nil
+	case KindInt64:
+		return NewDecFromInt(d.GetInt64()).Compare(dec), nil
+	case KindUint64:
+		return NewDecFromUint(d.GetUint64()).Compare(dec), nil
+	case KindString, KindBytes:
+		dDec := new(MyDecimal)
+		err := sc.HandleTruncate(dDec.FromString(d.GetBytes()))
+		return dDec.Compare(dec), errors.Trace(err)
+	default:
+		fVal, err := dec.ToFloat64()
+		if err != nil {
+			return 0, errors.Trace(err)
+		}
+		return d.compareFloat64(sc, fVal)
+	}
+}
+
+func (d *Datum) compareMysqlDuration(sc *stmtctx.StatementContext, dur Duration) (int, error) {
+	switch d.k {
+	case KindMysqlDuration:
+		return d.GetMysqlDuration().Compare(dur), nil
+	case KindString, KindBytes:
+		dDur, err := ParseDuration(sc, d.GetString(), MaxFsp)
+		return dDur.Compare(dur), errors.Trace(err)
+	default:
+		return d.compareFloat64(sc, dur.ToFloat64())
+	}
+}
+
+func (d *Datum) compareMysqlTime(sc *stmtctx.StatementContext, t Time) (int, error) {
+	switch d.k {
+	case KindString, KindBytes:
+		dt, err := ParseTime(sc, d.GetString(), mysql.TypeDatetime, GetFsp(d.GetString()))
+		return dt.Compare(t), errors.Trace(err)
+	case KindMysqlTime:
+		return d.GetMysqlTime().Compare(t), nil
+	default:
+		return d.compareFloat64(sc, t.ToFloat64())
+	}
+}
+
 // ConvertTo converts a datum to the target field type.
 func (d *Datum) ConvertTo(sc *stmtctx.StatementContext, target *FieldType) (Datum, error) {
 	if d.k == KindNull {
@@ -501,11 +638,17 @@ func (d *Datum) ConvertTo(sc *stmtctx.StatementContext, target *FieldType) (Datu
 		return d.convertToInt(sc, target)
 	case mysql.TypeFloat, mysql.TypeDouble:
 		return d.convertToFloat(sc, target)
+	case mysql.TypeNewDecimal:
+		return d.convertToMysqlDecimal(sc, target)
 	case mysql.TypeBlob, mysql.TypeTinyBlob, mysql.TypeMediumBlob, mysql.TypeLongBlob,
 		mysql.TypeString, mysql.TypeVarchar, mysql.TypeVarString:
 		return d.convertToString(sc, target)
 	case mysql.TypeBit:
 		return d.convertToMysqlBit(sc, target)
+	case mysql.TypeTimestamp, mysql.TypeDatetime, mysql.TypeDate:
+		return d.convertToMysqlTime(sc, target)
+	case mysql.TypeDuration:
+		return d.convertToMysqlDuration(sc, target)
 	case mysql.TypeNull:
 		return Datum{}, nil
 	default:
@@ -533,6 +676,12 @@ func (d *Datum) convertToFloat(sc *stmtctx.StatementContext, target *FieldType)
 	case KindBinaryLiteral, KindMysqlBit:
 		val, err1 := d.GetBinaryLiteral().ToInt(sc)
 		f, err = float64(val), err1
+	case KindMysqlDecimal:
+		f, err = d.GetMysqlDecimal().ToFloat64()
+	case KindMysqlTime:
+		f = d.GetMysqlTime().ToFloat64()
+	case KindMysqlDuration:
+		f = d.GetMysqlDuration().ToFloat64()
 	default:
 		return invalidConv(d, target.Tp)
 	}
@@ -581,6 +730,12 @@ func (d *Datum) convertToString(sc *stmtctx.StatementContext, target *FieldType)
 		s = d.GetString()
 	case KindBinaryLiteral, KindMysqlBit:
 		s = d.GetBinaryLiteral().ToString()
+	case KindMysqlDecimal:
+		s = d.GetMysqlDecimal().String()
+	case KindMysqlTime:
+		s = d.GetMysqlTime().String()
+	case KindMysqlDuration:
+		s = d.GetMysqlDuration().String()
 	default:
 		return invalidConv(d, target.Tp)
 	}
@@ -663,6 +818,12 @@ func (d *Datum) convertToUint(sc *stmtctx.StatementContext, target *FieldType) (
 		err = err1
 	case KindBinaryLiteral, KindMysqlBit:
 		val, err = d.GetBinaryLiteral().ToInt(sc)
+	case KindMysqlDecimal:
+		val, err = ConvertDecimalToUint(sc, d.GetMysqlDecimal(), upperBound, tp)
+	case KindMysqlTime:
+		val, err = ConvertIntToUint(sc, d.GetMysqlTime().ToInt64(), upperBound, tp)
+	case KindMysqlDuration:
+		val, err = ConvertIntToUint(sc, d.GetMysqlDuration().ToInt64(), upperBound, tp)
 	default:
 		return invalidConv(d, target.Tp)
 	}
@@ -696,6 +857,187 @@ func (d *Datum) convertToMysqlBit(sc *stmtctx.StatementContext, target *FieldTyp
 	return ret, errors.Trace(err)
 }
 
+func (d *Datum) convertToMysqlTime(sc *stmtctx.StatementContext, target *FieldType) (Datum, error) {
+	tp := target.Tp
+	fsp := DefaultFsp
+	if target.Decimal != UnspecifiedLength {
+		fsp = int8(target.Decimal)
+	}
+	var (
+		ret Datum
+		t   Time
+		err error
+	)
+	switch d.k {
+	case KindMysqlTime:
+		t, err = d.GetMysqlTime().Convert(sc, tp)
+		if err != nil {
+			ret.SetMysqlTime(t)
+			return ret, errors.Trace(err)
+		}
+		t, err = t.RoundFrac(sc, fsp)
+	case KindMysqlDuration:
+		t, err = d.GetMysqlDuration().ConvertToTime(sc, tp)
+		if err != nil {
+			ret.SetMysqlTime(t)
+			return ret, errors.Trace(err)
+		}
+		t, err = t.RoundFrac(sc, fsp)
+	case KindString, KindBytes:
+		t, err = ParseTime(sc, d.GetString(), tp, fsp)
+	case KindInt64:
+		t, err = ParseTimeFromNum(sc, d.GetInt64(), tp, fsp)
+	case KindUint64:
+		if d.GetUint64() > math.MaxInt64 {
+			return ret, errors.Trace(ErrWrongValue.GenWithStackByArgs(TimeStr, strconv.FormatUint(d.GetUint64(), 10)))
+		}
+		t, err = ParseTimeFromNum(sc, int64(d.GetUint64()), tp, fsp)
+	case KindFloat32, KindFloat64:
+		s := strconv.FormatFloat(d.GetFloat64(), 'f', -1, 64)
+		t, err = ParseTimeFromFloatString(sc, s, tp, fsp)
+	case KindMysqlDecimal:
+		t, err = ParseTimeFromFloatString(sc, d.GetMysqlDecimal().String(), tp, fsp)
+	default:
+		return invalidConv(d, tp)
+	}
+	if tp == mysql.TypeDate {
+		// Truncate hh:mm:ss part if the type is Date.
+		t.SetCoreTime(FromDate(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0))
+	}
+	ret.SetMysqlTime(t)
+	if err != nil {
+		return ret, errors.Trace(err)
+	}
+	return ret, nil
+}
+
+func (d *Datum) convertToMysqlDuration(sc *stmtctx.StatementContext, target *FieldType) (Datum, error) {
+	tp := target.Tp
+	fsp := DefaultFsp
+	if target.Decimal != UnspecifiedLength {
+		fsp = int8(target.Decimal)
+	}
+	var ret Datum
+	switch d.k {
+	case KindMysqlTime:
+		dur, err := d.GetMysqlTime().ConvertToDuration()
+		if err != nil {
+			ret.SetMysqlDuration(dur)
+			return ret, errors.Trace(err)
+		}
+		dur, err = dur.RoundFrac(fsp)
+		ret.SetMysqlDuration(dur)
+		if err != nil {
+			return ret, errors.Trace(err)
+		}
+	case KindMysqlDuration:
+		dur, err := d.GetMysqlDuration().RoundFrac(fsp)
+		ret.SetMysqlDuration(dur)
+		if err != nil {
+			return ret, errors.Trace(err)
+		}
+	case KindInt64, KindUint64, KindFloat32, KindFloat64, KindMysqlDecimal:
+		// TODO: support the fractional part of the float and decimal values.
+		i64, err := d.toSignedInteger(sc, mysql.TypeLonglong)
+		if err != nil {
+			return ret, errors.Trace(err)
+		}
+		dur, err := NumberToDuration(i64, fsp)
+		ret.SetMysqlDuration(dur)
+		if err != nil {
+			return ret, errors.Trace(err)
+		}
+	case KindString, KindBytes:
+		dur, err := ParseDuration(sc, d.GetString(), fsp)
+		ret.SetMysqlDuration(dur)
+		if err != nil {
+			return ret, errors.Trace(err)
+		}
+	default:
+		return invalidConv(d, tp)
+	}
+	return ret, nil
+}
+
+func (d *Datum) convertToMysqlDecimal(sc *stmtctx.StatementContext, target *FieldType) (Datum, error) {
+	var ret Datum
+	ret.SetLength(target.Flen)
+	ret.SetFrac(target.Decimal)
+	var dec = &MyDecimal{}
+	var err error
+	switch d.k {
+	case KindInt64:
+		dec.FromInt(d.GetInt64())
+	case KindUint64:
+		dec.FromUint(d.GetUint64())
+	case KindFloat32, KindFloat64:
+		err = dec.FromFloat64(d.GetFloat64())
+	case KindString, KindBytes:
+		err = dec.FromString(d.GetBytes())
+	case KindMysqlDecimal:
+		*dec = *d.GetMysqlDecimal()
+	case KindMysqlTime:
+		dec = d.GetMysqlTime().ToNumber()
+	case KindMysqlDuration:
+		dec = d.GetMysqlDuration().ToNumber()
+	case KindBinaryLiteral, KindMysqlBit:
+		val, err1 := d.GetBinaryLiteral().ToInt(sc)
+		err = err1
+		dec.FromUint(val)
+	default:
+		return invalidConv(d, target.Tp)
+	}
+	if err != nil {
+		err = sc.HandleTruncate(err)
+		if err != nil {
+			return ret, errors.Trace(err)
+		}
+	}
+	dec, err = ProduceDecimalWithSpecifiedTp(dec, target.Flen, target.Decimal, sc)
+	if err != nil {
+		return ret, errors.Trace(err)
+	}
+	if mysql.HasUnsignedFlag(target.Flag) && dec.IsNegative() {
+		dec = new(MyDecimal)
+		err = ErrOverflow.GenWithStackByArgs("DECIMAL", fmt.Sprintf("(%d, %d)", target.Flen, target.Decimal))
+		err = sc.HandleOverflow(err, err)
+	}
+	ret.SetMysqlDecimal(dec)
+	return ret, errors.Trace(err)
+}
+
+// ProduceDecimalWithSpecifiedTp produces a new decimal according to `flen` and `decimal`.
+func ProduceDecimalWithSpecifiedTp(dec *MyDecimal, flen, decimal int, sc *stmtctx.StatementContext) (_ *MyDecimal, err error) {
+	if flen == UnspecifiedLength || decimal == UnspecifiedLength {
+		return dec, nil
+	}
+	if flen < decimal {
+		return nil, ErrMBiggerThanD.GenWithStackByArgs("")
+	}
+	if _, frac := dec.PrecisionAndFrac(); frac != decimal {
+		old := *dec
+		err = dec.Round(dec, decimal, ModeHalfUp)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if frac > decimal && dec.Compare(&old) != 0 {
+			// Inserting and updating only warn about the lost digits, like MySQL does.
+			if sc.InInsertStmt || sc.InUpdateStmt || sc.InDeleteStmt {
+				sc.AppendWarning(ErrTruncated)
+			} else if err = sc.HandleTruncate(ErrTruncated); err != nil {
+				return nil, errors.Trace(err)
+			}
+		}
+	}
+	if prec, frac := dec.PrecisionAndFrac(); !dec.IsZero() && prec-frac > flen-decimal {
+		// e.g. 1000 doesn't fit in decimal(4, 2), it becomes 99.99.
+		dec = NewMaxOrMinDec(dec.IsNegative(), flen, decimal)
+		err = ErrOverflow.GenWithStackByArgs("DECIMAL", fmt.Sprintf("(%d, %d)", flen, decimal))
+		err = sc.HandleOverflow(err, err)
+	}
+	return dec, errors.Trace(err)
+}
+
 // ToBool converts to a bool.
 // We will use 1 for true, and 0 for false.
 func (d *Datum) ToBool(sc *stmtctx.StatementContext) (int64, error) {
@@ -716,6 +1058,12 @@ func (d *Datum) ToBool(sc *stmtctx.StatementContext) (int64, error) {
 	case KindBinaryLiteral, KindMysqlBit:
 		val, err1 := d.GetBinaryLiteral().ToInt(sc)
 		isZero, err = val == 0, err1
+	case KindMysqlDecimal:
+		isZero = d.GetMysqlDecimal().IsZero()
+	case KindMysqlTime:
+		isZero = d.GetMysqlTime().IsZero()
+	case KindMysqlDuration:
+		isZero = d.GetMysqlDuration().Duration == 0
 	default:
 		return 0, errors.Errorf("cannot convert %v(type %T) to bool", d.GetValue(), d.GetValue())
 	}
@@ -758,6 +1106,12 @@ func (d *Datum) toSignedInteger(sc *stmtctx.StatementContext, tp byte) (int64, e
 	case KindBinaryLiteral, KindMysqlBit:
 		val, err := d.GetBinaryLiteral().ToInt(sc)
 		return int64(val), errors.Trace(err)
+	case KindMysqlDecimal:
+		return ConvertDecimalToInt(d.GetMysqlDecimal(), lowerBound, upperBound, tp)
+	case KindMysqlTime:
+		return ConvertIntToInt(d.GetMysqlTime().ToInt64(), lowerBound, upperBound, tp)
+	case KindMysqlDuration:
+		return ConvertIntToInt(d.GetMysqlDuration().ToInt64(), lowerBound, upperBound, tp)
 	default:
 		return 0, errors.Errorf("cannot convert %v(type %T) to int64", d.GetValue(), d.GetValue())
 	}
@@ -781,11 +1135,33 @@ func (d *Datum) ToFloat64(sc *stmtctx.StatementContext) (float64, error) {
 	case KindBinaryLiteral, KindMysqlBit:
 		val, err := d.GetBinaryLiteral().ToInt(sc)
 		return float64(val), errors.Trace(err)
+	case KindMysqlDecimal:
+		f, err := d.GetMysqlDecimal().ToFloat64()
+		return f, errors.Trace(err)
+	case KindMysqlTime:
+		return d.GetMysqlTime().ToFloat64(), nil
+	case KindMysqlDuration:
+		return d.GetMysqlDuration().ToFloat64(), nil
 	default:
 		return 0, errors.Errorf("cannot convert %v(type %T) to float64", d.GetValue(), d.GetValue())
 	}
 }
 
+// ToDecimal converts to a decimal.
+func (d *Datum) ToDecimal(sc *stmtctx.StatementContext) (*MyDecimal, error) {
+	if d.IsNull() {
+		return nil, nil
+	}
+	if d.Kind() == KindMysqlDecimal {
+		return d.GetMysqlDecimal(), nil
+	}
+	converted, err := d.ConvertTo(sc, NewFieldType(mysql.TypeNewDecimal))
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return converted.GetMysqlDecimal(), nil
+}
+
 // ToString gets the string representation of the datum.
 func (d *Datum) ToString() (string, error) {
 	switch d.Kind() {
@@ -803,6 +1179,12 @@ func (d *Datum) ToString() (string, error) {
 		return d.GetString(), nil
 	case KindBinaryLiteral, KindMysqlBit:
 		return d.GetBinaryLiteral().ToString(), nil
+	case KindMysqlDecimal:
+		return d.GetMysqlDecimal().String(), nil
+	case KindMysqlTime:
+		return d.GetMysqlTime().String(), nil
+	case KindMysqlDuration:
+		return d.GetMysqlDuration().String(), nil
 	default:
 		return "", errors.Errorf("cannot convert %v(type %T) to string", d.GetValue(), d.GetValue())
 	}
@@ -873,6 +1255,24 @@ func NewFloat32Datum(f float32) (d Datum) {
 	return d
 }
 
+// NewDecimalDatum creates a new Datum from a MyDecimal value.
+func NewDecimalDatum(dec *MyDecimal) (d Datum) {
+	d.SetMysqlDecimal(dec)
+	return d
+}
+
+// NewTimeDatum creates a new Time from a Time value.
+func NewTimeDatum(t Time) (d Datum) {
+	d.SetMysqlTime(t)
+	return d
+}
+
+// NewDurationDatum creates a new Datum from a Duration value.
+func NewDurationDatum(dur Duration) (d Datum) {
+	d.SetMysqlDuration(dur)
+	return d
+}
+
 // MakeDatums creates datum slice from interfaces.
 func MakeDatums(args ...interface{}) []Datum {
 	datums := make([]Datum, len(args))
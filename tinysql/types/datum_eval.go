@@ -50,6 +50,14 @@ func ComputePlus(a, b Datum) (d Datum, err error) {
 			d.SetFloat64(r)
 			return d, nil
 		}
+	case KindMysqlDecimal:
+		switch b.Kind() {
+		case KindMysqlDecimal:
+			r := new(MyDecimal)
+			err = DecimalAdd(a.GetMysqlDecimal(), b.GetMysqlDecimal(), r)
+			d.SetMysqlDecimal(r)
+			return d, err
+		}
 	}
 	_, err = InvOp2(a.GetValue(), b.GetValue(), opcode.Plus)
 	return d, err
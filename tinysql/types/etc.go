@@ -46,6 +46,12 @@ func IsTypeUnspecified(tp byte) bool {
 	return tp == mysql.TypeUnspecified
 }
 
+// IsTypeTime returns a boolean indicating
+// whether the tp is time type like datetime, date or timestamp.
+func IsTypeTime(tp byte) bool {
+	return tp == mysql.TypeDatetime || tp == mysql.TypeDate || tp == mysql.TypeTimestamp
+}
+
 // IsTypePrefixable returns a boolean indicating
 // whether an index on a column with the tp can be defined with a prefix.
 func IsTypePrefixable(tp byte) bool {
@@ -84,17 +90,20 @@ func IsString(tp byte) bool {
 }
 
 var kind2Str = map[byte]string{
-	KindNull:       "null",
-	KindInt64:      "bigint",
-	KindUint64:     "unsigned bigint",
-	KindFloat32:    "float",
-	KindFloat64:    "double",
-	KindString:     "char",
-	KindBytes:      "bytes",
-	KindInterface:  "interface",
-	KindMinNotNull: "min_not_null",
-	KindMaxValue:   "max_value",
-	KindRaw:        "raw",
+	KindNull:          "null",
+	KindInt64:         "bigint",
+	KindUint64:        "unsigned bigint",
+	KindFloat32:       "float",
+	KindFloat64:       "double",
+	KindString:        "char",
+	KindBytes:         "bytes",
+	KindMysqlDecimal:  "decimal",
+	KindMysqlDuration: "time",
+	KindMysqlTime:     "time",
+	KindInterface:     "interface",
+	KindMinNotNull:    "min_not_null",
+	KindMaxValue:      "max_value",
+	KindRaw:           "raw",
 }
 
 // TypeStr converts tp to a string.
@@ -109,6 +118,7 @@ func KindStr(kind byte) (r string) {
 // It is used for converting Text to Blob,
 // or converting Char to Binary.
 // Args:
+//
 //	tp: type enum
 //	cs: charset
 var TypeToStr = ast.TypeToStr
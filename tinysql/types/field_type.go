@@ -105,6 +105,8 @@ func mergeEvalType(lhs, rhs EvalType, lft, rft *FieldType, isLHSUnsigned, isRHSU
 		return ETString
 	} else if lhs == ETReal || rhs == ETReal {
 		return ETReal
+	} else if lhs == ETDecimal || rhs == ETDecimal {
+		return ETDecimal
 	}
 	return ETInt
 }
@@ -197,6 +199,33 @@ func DefaultTypeForValue(value interface{}, tp *FieldType) {
 		tp.Flen = len(x)
 		tp.Decimal = UnspecifiedLength
 		SetBinChsClnFlag(tp)
+	case Time:
+		tp.Tp = x.Type()
+		switch x.Type() {
+		case mysql.TypeDate:
+			tp.Flen = mysql.MaxDateWidth
+			tp.Decimal = UnspecifiedLength
+		case mysql.TypeDatetime, mysql.TypeTimestamp:
+			tp.Flen = mysql.MaxDatetimeWidthNoFsp
+			if x.Fsp() > DefaultFsp { // consider point('.') and the fractional part.
+				tp.Flen += int(x.Fsp()) + 1
+			}
+			tp.Decimal = int(x.Fsp())
+		}
+		SetBinChsClnFlag(tp)
+	case *MyDecimal:
+		tp.Tp = mysql.TypeNewDecimal
+		tp.Flen = len(x.ToString())
+		tp.Decimal = int(x.digitsFrac)
+		SetBinChsClnFlag(tp)
+	case Duration:
+		tp.Tp = mysql.TypeDuration
+		tp.Flen = len(x.String())
+		if x.Fsp > DefaultFsp { // consider point('.') and the fractional part.
+			tp.Flen += int(x.Fsp) + 1
+		}
+		tp.Decimal = int(x.Fsp)
+		SetBinChsClnFlag(tp)
 	default:
 		tp.Tp = mysql.TypeUnspecified
 		tp.Flen = UnspecifiedLength
@@ -97,6 +97,10 @@ func isDigit(c byte) bool {
 	return c >= '0' && c <= '9'
 }
 
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t'
+}
+
 const (
 	maxUint    = uint64(math.MaxUint64)
 	uintCutOff = maxUint/uint64(10) + 1
@@ -0,0 +1,889 @@
+// Copyright 2016 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"math"
+	"math/big"
+	"strconv"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/parser/terror"
+)
+
+// RoundMode is the type for round mode.
+type RoundMode int32
+
+const (
+	// ModeHalfUp rounds half away from zero, like MySQL does for exact-value numbers.
+	ModeHalfUp RoundMode = 5
+	// ModeTruncate just truncates the decimal.
+	ModeTruncate RoundMode = 10
+)
+
+const (
+	digitsPerWord = 9 // A word holds 9 digits.
+	wordSize      = 4 // A word is 4 bytes int32.
+	wordBase      = 1000000000
+	wordBufLen    = 9 // A MyDecimal holds at most 9 words, that is 81 digits.
+	maxDigits     = wordBufLen * digitsPerWord
+
+	// DivFracIncr is the number of fractional digits a division adds to the dividend's.
+	DivFracIncr = 4
+
+	// MyDecimalStructSize is the struct size of MyDecimal.
+	MyDecimalStructSize = 40
+)
+
+var (
+	powers10  = [10]int32{1, 10, 100, 1000, 10000, 100000, 1000000, 10000000, 100000000, 1000000000}
+	dig2bytes = [10]int{0, 1, 1, 2, 2, 3, 3, 4, 4, 4}
+
+	zeroMyDecimal = MyDecimal{}
+)
+
+func digitsToWords(digits int) int {
+	return (digits + digitsPerWord - 1) / digitsPerWord
+}
+
+func myMax(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func myMin(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// MyDecimal represents a fixed-point decimal value.
+// The digits are kept in base 10^9 words, the integral words first. The first integral word is
+// right-aligned and the last fractional word is left-aligned, so the point is always on a word border.
+// It contains no pointers, so it can be stored in a chunk column as fixed-length bytes.
+type MyDecimal struct {
+	digitsInt  int8 // the number of decimal digits before the point.
+	digitsFrac int8 // the number of decimal digits after the point.
+	resultFrac int8 // the number of fractional digits to show, String() rounds the value to it.
+	negative   bool
+
+	wordBuf [wordBufLen]int32
+}
+
+// IsNegative returns whether a decimal is negative.
+func (d *MyDecimal) IsNegative() bool {
+	return d.negative
+}
+
+// GetDigitsFrac returns the digitsFrac.
+func (d *MyDecimal) GetDigitsFrac() int8 {
+	return d.digitsFrac
+}
+
+// GetDigitsInt returns the digitsInt.
+func (d *MyDecimal) GetDigitsInt() int8 {
+	return d.digitsInt
+}
+
+// IsZero checks whether it's a zero decimal.
+func (d *MyDecimal) IsZero() bool {
+	for _, w := range d.wordBuf[:d.wordsInt()+d.wordsFrac()] {
+		if w != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (d *MyDecimal) wordsInt() int {
+	return digitsToWords(int(d.digitsInt))
+}
+
+func (d *MyDecimal) wordsFrac() int {
+	return digitsToWords(int(d.digitsFrac))
+}
+
+// alignedWord returns the word at idx when the words of d are aligned to a layout with wordsInt integral words.
+func (d *MyDecimal) alignedWord(idx, wordsInt int) int32 {
+	dWordsInt := d.wordsInt()
+	i := idx - (wordsInt - dWordsInt)
+	if i < 0 || i >= dWordsInt+d.wordsFrac() {
+		return 0
+	}
+	return d.wordBuf[i]
+}
+
+// digits unpacks d into decimal digits without the leading zeros, the first intDigits of them
+// are before the point.
+func (d *MyDecimal) digits() (buf []byte, intDigits int) {
+	wordsInt := d.wordsInt()
+	buf = make([]byte, 0, int(d.digitsInt)+int(d.digitsFrac))
+	for i := 0; i < wordsInt; i++ {
+		n := digitsPerWord
+		if i == 0 {
+			n = int(d.digitsInt) - (wordsInt-1)*digitsPerWord
+		}
+		for j := n - 1; j >= 0; j-- {
+			buf = append(buf, byte(d.wordBuf[i]/powers10[j]%10))
+		}
+	}
+	intDigits = len(buf)
+	remain := int(d.digitsFrac)
+	for i := wordsInt; remain > 0; i++ {
+		for j := digitsPerWord - 1; j >= 0 && remain > 0; j-- {
+			buf = append(buf, byte(d.wordBuf[i]/powers10[j]%10))
+			remain--
+		}
+	}
+	lead := 0
+	for lead < intDigits && buf[lead] == 0 {
+		lead++
+	}
+	return buf[lead:], intDigits - lead
+}
+
+// fromDigits packs the decimal digits in buf into d, the first intDigits of them are before the point.
+// The fractional digits which don't fit in the word buffer are truncated, and if the integral part
+// doesn't fit, d is set to the max decimal with the same sign.
+func (d *MyDecimal) fromDigits(buf []byte, intDigits int, negative bool) error {
+	for intDigits > 0 && buf[0] == 0 {
+		buf = buf[1:]
+		intDigits--
+	}
+	wordsInt := digitsToWords(intDigits)
+	if wordsInt > wordBufLen {
+		d.setMax(maxDigits, 0, negative)
+		return ErrOverflow
+	}
+	var err error
+	fracDigits := len(buf) - intDigits
+	if wordsInt+digitsToWords(fracDigits) > wordBufLen {
+		fracDigits = (wordBufLen - wordsInt) * digitsPerWord
+		buf = buf[:intDigits+fracDigits]
+		err = ErrTruncated
+	}
+	*d = MyDecimal{
+		digitsInt:  int8(intDigits),
+		digitsFrac: int8(fracDigits),
+		resultFrac: int8(fracDigits),
+		negative:   negative,
+	}
+	pos := 0
+	for i := 0; i < wordsInt; i++ {
+		n := digitsPerWord
+		if i == 0 {
+			n = intDigits - (wordsInt-1)*digitsPerWord
+		}
+		var w int32
+		for j := 0; j < n; j++ {
+			w = w*10 + int32(buf[pos])
+			pos++
+		}
+		d.wordBuf[i] = w
+	}
+	for i := wordsInt; pos < len(buf); i++ {
+		var w int32
+		j := 0
+		for ; j < digitsPerWord && pos < len(buf); j++ {
+			w = w*10 + int32(buf[pos])
+			pos++
+		}
+		d.wordBuf[i] = w * powers10[digitsPerWord-j]
+	}
+	if d.IsZero() {
+		d.negative = false
+	}
+	return err
+}
+
+// fromWords sets d from base 10^9 words whose first wordsInt words are integral, only the first
+// digitsFrac fractional digits are kept.
+func (d *MyDecimal) fromWords(words []int32, wordsInt, digitsFrac int, negative bool) error {
+	for wordsInt > 0 && words[0] == 0 {
+		words = words[1:]
+		wordsInt--
+	}
+	if wordsInt > wordBufLen {
+		d.setMax(maxDigits, 0, negative)
+		return ErrOverflow
+	}
+	var err error
+	if wordsInt+digitsToWords(digitsFrac) > wordBufLen {
+		digitsFrac = (wordBufLen - wordsInt) * digitsPerWord
+		err = ErrTruncated
+	}
+	digitsInt := 0
+	if wordsInt > 0 {
+		digitsInt = (wordsInt-1)*digitsPerWord + 1
+		for i := 1; i < digitsPerWord && words[0] >= powers10[i]; i++ {
+			digitsInt++
+		}
+	}
+	*d = MyDecimal{
+		digitsInt:  int8(digitsInt),
+		digitsFrac: int8(digitsFrac),
+		resultFrac: int8(digitsFrac),
+		negative:   negative,
+	}
+	copy(d.wordBuf[:], words[:wordsInt+digitsToWords(digitsFrac)])
+	if d.IsZero() {
+		d.negative = false
+	}
+	return err
+}
+
+// setMax sets d to the max decimal with precision digits and frac fractional digits.
+func (d *MyDecimal) setMax(precision, frac int, negative bool) {
+	buf := make([]byte, precision)
+	for i := range buf {
+		buf[i] = 9
+	}
+	terror.Log(d.fromDigits(buf, precision-frac, negative))
+}
+
+// String returns the decimal string representation rounded to resultFrac.
+func (d *MyDecimal) String() string {
+	tmp := *d
+	err := tmp.Round(&tmp, int(tmp.resultFrac), ModeHalfUp)
+	terror.Log(errors.Trace(err))
+	return string(tmp.ToString())
+}
+
+// ToString converts decimal to its printable string representation without rounding.
+func (d *MyDecimal) ToString() []byte {
+	buf, intDigits := d.digits()
+	str := make([]byte, 0, len(buf)+3)
+	if d.negative && !d.IsZero() {
+		str = append(str, '-')
+	}
+	if intDigits == 0 {
+		str = append(str, '0')
+	}
+	for i, c := range buf {
+		if i == intDigits {
+			str = append(str, '.')
+		}
+		str = append(str, '0'+c)
+	}
+	return str
+}
+
+// FromString parses decimal from string, an exponent like "1.5e3" is accepted.
+func (d *MyDecimal) FromString(str []byte) error {
+	for len(str) > 0 && isSpace(str[0]) {
+		str = str[1:]
+	}
+	negative := false
+	if len(str) > 0 && (str[0] == '-' || str[0] == '+') {
+		negative = str[0] == '-'
+		str = str[1:]
+	}
+	buf := make([]byte, 0, len(str))
+	idx := 0
+	for ; idx < len(str) && isDigit(str[idx]); idx++ {
+		buf = append(buf, str[idx]-'0')
+	}
+	intDigits := len(buf)
+	if idx < len(str) && str[idx] == '.' {
+		for idx++; idx < len(str) && isDigit(str[idx]); idx++ {
+			buf = append(buf, str[idx]-'0')
+		}
+	}
+	if len(buf) == 0 {
+		*d = zeroMyDecimal
+		return ErrBadNumber
+	}
+	var err error
+	if idx < len(str) && (str[idx] == 'e' || str[idx] == 'E') {
+		exponent, err1 := strToInt(string(str[idx+1:]))
+		if err1 != nil {
+			err = ErrTruncated
+		}
+		// Shifting further than this makes all the digits overflow or vanish anyway.
+		if limit := int64(2*maxDigits + len(buf)); exponent > limit {
+			exponent = limit
+		} else if exponent < -limit {
+			exponent = -limit
+		}
+		buf, intDigits = shiftDigits(buf, intDigits, int(exponent))
+		idx = len(str)
+	}
+	for ; idx < len(str); idx++ {
+		if !isSpace(str[idx]) {
+			err = ErrTruncated
+			break
+		}
+	}
+	if err1 := d.fromDigits(buf, intDigits, negative); err1 != nil {
+		err = err1
+	}
+	return err
+}
+
+// shiftDigits moves the point of the digits right by shift, it pads zeros when needed.
+func shiftDigits(buf []byte, intDigits, shift int) ([]byte, int) {
+	intDigits += shift
+	if intDigits < 0 {
+		buf = append(make([]byte, -intDigits, len(buf)-intDigits), buf...)
+		intDigits = 0
+	}
+	if intDigits > len(buf) {
+		buf = append(buf, make([]byte, intDigits-len(buf))...)
+	}
+	return buf, intDigits
+}
+
+// Shift shifts the decimal right by shift digits, a negative shift shifts it left, like d * 10^shift.
+func (d *MyDecimal) Shift(shift int) error {
+	if shift == 0 {
+		return nil
+	}
+	buf, intDigits := d.digits()
+	buf, intDigits = shiftDigits(buf, intDigits, shift)
+	return d.fromDigits(buf, intDigits, d.negative)
+}
+
+// Round rounds the decimal to "frac" digits after the point and stores the result in "to".
+// A negative frac rounds the integral digits, e.g. round 155 to -1 gets 160.
+func (d *MyDecimal) Round(to *MyDecimal, frac int, roundMode RoundMode) error {
+	buf, intDigits := d.digits()
+	negative := d.negative
+	keep := intDigits + frac
+	if keep < 0 {
+		*to = zeroMyDecimal
+		return nil
+	}
+	if keep >= len(buf) {
+		buf = append(buf, make([]byte, keep-len(buf))...)
+	} else {
+		roundUp := roundMode == ModeHalfUp && buf[keep] >= 5
+		buf = buf[:keep]
+		if roundUp {
+			i := keep - 1
+			for ; i >= 0 && buf[i] == 9; i-- {
+				buf[i] = 0
+			}
+			if i >= 0 {
+				buf[i]++
+			} else {
+				buf = append([]byte{1}, buf...)
+				intDigits++
+			}
+		}
+		if frac < 0 {
+			buf = append(buf, make([]byte, -frac)...)
+		}
+	}
+	return to.fromDigits(buf, intDigits, negative)
+}
+
+// FromInt sets the decimal value from int64.
+func (d *MyDecimal) FromInt(val int64) *MyDecimal {
+	uVal := uint64(val)
+	if val < 0 {
+		uVal = uint64(-val)
+	}
+	return d.fromUint(uVal, val < 0)
+}
+
+// FromUint sets the decimal value from uint64.
+func (d *MyDecimal) FromUint(val uint64) *MyDecimal {
+	return d.fromUint(val, false)
+}
+
+func (d *MyDecimal) fromUint(val uint64, negative bool) *MyDecimal {
+	digitsInt := 1
+	for x := val; x >= 10; x /= 10 {
+		digitsInt++
+	}
+	*d = MyDecimal{digitsInt: int8(digitsInt), negative: negative && val != 0}
+	for i := digitsToWords(digitsInt) - 1; i >= 0; i-- {
+		d.wordBuf[i] = int32(val % wordBase)
+		val /= wordBase
+	}
+	return d
+}
+
+// ToInt returns the integral part of the decimal, it returns ErrTruncated if the fractional part is not zero.
+func (d *MyDecimal) ToInt() (int64, error) {
+	var x int64
+	wordIdx := 0
+	// Accumulate the negative value, so math.MinInt64 doesn't overflow.
+	for i := int(d.digitsInt); i > 0; i -= digitsPerWord {
+		y := x
+		x = x*wordBase - int64(d.wordBuf[wordIdx])
+		wordIdx++
+		if y < math.MinInt64/wordBase || x > y {
+			if d.negative {
+				return math.MinInt64, ErrOverflow
+			}
+			return math.MaxInt64, ErrOverflow
+		}
+	}
+	if !d.negative {
+		if x == math.MinInt64 {
+			return math.MaxInt64, ErrOverflow
+		}
+		x = -x
+	}
+	for i := int(d.digitsFrac); i > 0; i -= digitsPerWord {
+		if d.wordBuf[wordIdx] != 0 {
+			return x, ErrTruncated
+		}
+		wordIdx++
+	}
+	return x, nil
+}
+
+// ToUint returns the integral part of the decimal as uint64, it returns ErrTruncated if the fractional
+// part is not zero.
+func (d *MyDecimal) ToUint() (uint64, error) {
+	if d.negative {
+		return 0, ErrOverflow
+	}
+	var x uint64
+	wordIdx := 0
+	for i := int(d.digitsInt); i > 0; i -= digitsPerWord {
+		y := x
+		x = x*wordBase + uint64(d.wordBuf[wordIdx])
+		wordIdx++
+		if y > math.MaxUint64/wordBase || x < y {
+			return math.MaxUint64, ErrOverflow
+		}
+	}
+	for i := int(d.digitsFrac); i > 0; i -= digitsPerWord {
+		if d.wordBuf[wordIdx] != 0 {
+			return x, ErrTruncated
+		}
+		wordIdx++
+	}
+	return x, nil
+}
+
+// FromFloat64 creates a decimal from float as MySQL does.
+func (d *MyDecimal) FromFloat64(f float64) error {
+	s := strconv.FormatFloat(f, 'g', -1, 64)
+	return d.FromString([]byte(s))
+}
+
+// ToFloat64 converts decimal to float64 value.
+func (d *MyDecimal) ToFloat64() (float64, error) {
+	f, err := strconv.ParseFloat(string(d.ToString()), 64)
+	if err != nil {
+		err = ErrOverflow
+	}
+	return f, err
+}
+
+// PrecisionAndFrac returns the internal precision and frac number.
+func (d *MyDecimal) PrecisionAndFrac() (precision, frac int) {
+	_, intDigits := d.digits()
+	frac = int(d.digitsFrac)
+	precision = intDigits + frac
+	if precision == 0 {
+		precision = 1
+	}
+	return
+}
+
+// Compare compares one decimal to another, returns -1/0/1.
+func (d *MyDecimal) Compare(to *MyDecimal) int {
+	if d.negative != to.negative {
+		if d.IsZero() && to.IsZero() {
+			return 0
+		}
+		if d.negative {
+			return -1
+		}
+		return 1
+	}
+	cmp := compareAbs(d, to)
+	if d.negative {
+		return -cmp
+	}
+	return cmp
+}
+
+// compareAbs compares the absolute values of two decimals.
+func compareAbs(from1, from2 *MyDecimal) int {
+	wordsInt := myMax(from1.wordsInt(), from2.wordsInt())
+	words := wordsInt + myMax(from1.wordsFrac(), from2.wordsFrac())
+	for i := 0; i < words; i++ {
+		x, y := from1.alignedWord(i, wordsInt), from2.alignedWord(i, wordsInt)
+		if x != y {
+			if x < y {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// DecimalBinSize returns the size of array to hold a binary representation of a decimal.
+func DecimalBinSize(precision, frac int) int {
+	digitsInt := precision - frac
+	wordsInt := digitsInt / digitsPerWord
+	wordsFrac := frac / digitsPerWord
+	xInt := digitsInt - wordsInt*digitsPerWord
+	xFrac := frac - wordsFrac*digitsPerWord
+	return wordsInt*wordSize + dig2bytes[xInt] + wordsFrac*wordSize + dig2bytes[xFrac]
+}
+
+func checkPrecisionAndFrac(precision, frac int) error {
+	if precision <= 0 || precision > maxDigits || frac < 0 || frac > precision {
+		return ErrBadNumber
+	}
+	return nil
+}
+
+// ToBin converts decimal to its binary fixed-length representation, which is the same as MySQL's,
+// two representations of the same precision and frac can be compared with memcmp.
+//
+// The integral and fractional digits are grouped by 9 from the point, each full group takes 4 bytes,
+// the leading and the trailing partial groups take as few bytes as they need.
+// e.g. decimal(14,4) 1234567890.1234 is stored as 1 byte for 1, 4 bytes for 234567890 and 2 bytes for 1234.
+// The sign bit of the first byte is flipped, and all the bytes are inverted for a negative value.
+func (d *MyDecimal) ToBin(precision, frac int) ([]byte, error) {
+	if err := checkPrecisionAndFrac(precision, frac); err != nil {
+		return nil, err
+	}
+	var tmp MyDecimal
+	err := d.Round(&tmp, frac, ModeHalfUp)
+	if err == nil && tmp.Compare(d) != 0 {
+		err = ErrTruncated
+	}
+	buf, intDigits := tmp.digits()
+	digitsInt := precision - frac
+	if intDigits > digitsInt {
+		tmp.setMax(precision, frac, tmp.negative)
+		buf, intDigits = tmp.digits()
+		err = ErrOverflow
+	}
+	digits := make([]byte, precision)
+	copy(digits[digitsInt-intDigits:], buf[:intDigits])
+	copy(digits[digitsInt:], buf[intDigits:])
+
+	var mask byte
+	if tmp.negative {
+		mask = 0xFF
+	}
+	bin := make([]byte, DecimalBinSize(precision, frac))
+	pos := 0
+	writeGroup := func(group []byte) {
+		var v uint32
+		for _, c := range group {
+			v = v*10 + uint32(c)
+		}
+		size := dig2bytes[len(group)]
+		for i := size - 1; i >= 0; i-- {
+			bin[pos+i] = byte(v) ^ mask
+			v >>= 8
+		}
+		pos += size
+	}
+	xInt := digitsInt % digitsPerWord
+	writeGroup(digits[:xInt])
+	for i := xInt; i < precision; i += digitsPerWord {
+		writeGroup(digits[i:myMin(i+digitsPerWord, precision)])
+	}
+	bin[0] ^= 0x80
+	return bin, err
+}
+
+// FromBin parses the binary representation generated by ToBin, it returns the size of the binary.
+func (d *MyDecimal) FromBin(bin []byte, precision, frac int) (binSize int, err error) {
+	if err = checkPrecisionAndFrac(precision, frac); err != nil {
+		return 0, err
+	}
+	binSize = DecimalBinSize(precision, frac)
+	if len(bin) < binSize {
+		*d = zeroMyDecimal
+		return 0, ErrBadNumber
+	}
+	var mask byte
+	if bin[0]&0x80 == 0 {
+		mask = 0xFF
+	}
+	digits := make([]byte, 0, precision)
+	pos := 0
+	readGroup := func(n int) error {
+		size := dig2bytes[n]
+		var v uint32
+		for i := 0; i < size; i++ {
+			b := bin[pos+i] ^ mask
+			if pos+i == 0 {
+				b ^= 0x80
+			}
+			v = v<<8 | uint32(b)
+		}
+		pos += size
+		if v >= uint32(powers10[n]) {
+			return ErrBadNumber
+		}
+		for j := n - 1; j >= 0; j-- {
+			digits = append(digits, byte(int32(v)/powers10[j]%10))
+		}
+		return nil
+	}
+	digitsInt := precision - frac
+	xInt := digitsInt % digitsPerWord
+	if err = readGroup(xInt); err != nil {
+		*d = zeroMyDecimal
+		return 0, err
+	}
+	for i := xInt; i < precision; i += digitsPerWord {
+		if err = readGroup(myMin(i+digitsPerWord, precision) - i); err != nil {
+			*d = zeroMyDecimal
+			return 0, err
+		}
+	}
+	err = d.fromDigits(digits, digitsInt, mask != 0)
+	return binSize, err
+}
+
+// ToHashKey removes the leading and trailing zeros and generates a hash key.
+// Two decimals with the same value (e.g. 1.5 and 1.50) always have the same hash key.
+func (d *MyDecimal) ToHashKey() ([]byte, error) {
+	buf, intDigits := d.digits()
+	frac := len(buf) - intDigits
+	for frac > 0 && buf[intDigits+frac-1] == 0 {
+		frac--
+	}
+	precision := intDigits + frac
+	if precision == 0 {
+		precision = 1
+	}
+	buf, err := d.ToBin(precision, frac)
+	if err != nil {
+		return nil, err
+	}
+	// The binary doesn't tell the frac, e.g. 1.1 and 1.01 are both encoded as 0x8101.
+	return append(buf, byte(frac)), nil
+}
+
+// toBig returns the unscaled value of d and its scale, the value of d is v / 10^scale.
+func (d *MyDecimal) toBig() (v *big.Int, scale int) {
+	v = new(big.Int)
+	base := big.NewInt(wordBase)
+	word := new(big.Int)
+	for _, w := range d.wordBuf[:d.wordsInt()+d.wordsFrac()] {
+		v.Mul(v, base)
+		v.Add(v, word.SetInt64(int64(w)))
+	}
+	if d.negative {
+		v.Neg(v)
+	}
+	return v, d.wordsFrac() * digitsPerWord
+}
+
+// fromBig sets d to v / 10^scale, only the first digitsFrac fractional digits are kept.
+func (d *MyDecimal) fromBig(v *big.Int, scale, digitsFrac int) error {
+	str := new(big.Int).Abs(v).String()
+	buf := make([]byte, len(str))
+	for i := range str {
+		buf[i] = str[i] - '0'
+	}
+	buf, intDigits := shiftDigits(buf, len(buf), -scale)
+	if frac := len(buf) - intDigits; frac > digitsFrac {
+		buf = buf[:intDigits+digitsFrac]
+	}
+	return d.fromDigits(buf, intDigits, v.Sign() < 0)
+}
+
+func pow10Big(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// DecimalNeg reverses decimal's sign.
+func DecimalNeg(from *MyDecimal) *MyDecimal {
+	to := *from
+	if !to.IsZero() {
+		to.negative = !from.negative
+	}
+	return &to
+}
+
+// DecimalAdd adds two decimals, sets the result to 'to'.
+func DecimalAdd(from1, from2, to *MyDecimal) error {
+	resultFrac := myMax(int(from1.resultFrac), int(from2.resultFrac))
+	var err error
+	if from1.negative == from2.negative {
+		err = doAdd(from1, from2, to)
+	} else {
+		err = doSub(from1, from2, to)
+	}
+	to.resultFrac = int8(myMin(resultFrac, int(to.digitsFrac)))
+	return err
+}
+
+// DecimalSub subs one decimal from another, sets the result to 'to'.
+func DecimalSub(from1, from2, to *MyDecimal) error {
+	resultFrac := myMax(int(from1.resultFrac), int(from2.resultFrac))
+	var err error
+	if from1.negative == from2.negative {
+		err = doSub(from1, from2, to)
+	} else {
+		err = doAdd(from1, from2, to)
+	}
+	to.resultFrac = int8(myMin(resultFrac, int(to.digitsFrac)))
+	return err
+}
+
+// doAdd adds the absolute values of two decimals, the result has the sign of from1.
+func doAdd(from1, from2, to *MyDecimal) error {
+	wordsInt := myMax(from1.wordsInt(), from2.wordsInt())
+	words := wordsInt + myMax(from1.wordsFrac(), from2.wordsFrac())
+	digitsFrac := myMax(int(from1.digitsFrac), int(from2.digitsFrac))
+	// One more word for the carry.
+	var buf [2*wordBufLen + 1]int32
+	var carry int32
+	for i := words - 1; i >= 0; i-- {
+		sum := from1.alignedWord(i, wordsInt) + from2.alignedWord(i, wordsInt) + carry
+		carry = 0
+		if sum >= wordBase {
+			sum -= wordBase
+			carry = 1
+		}
+		buf[i+1] = sum
+	}
+	buf[0] = carry
+	return to.fromWords(buf[:words+1], wordsInt+1, digitsFrac, from1.negative)
+}
+
+// doSub subtracts the absolute value of from2 from from1's, the result has the sign of from1
+// if |from1| >= |from2|, otherwise the opposite sign.
+func doSub(from1, from2, to *MyDecimal) error {
+	negative := from1.negative
+	if compareAbs(from1, from2) < 0 {
+		from1, from2 = from2, from1
+		negative = !negative
+	}
+	wordsInt := myMax(from1.wordsInt(), from2.wordsInt())
+	words := wordsInt + myMax(from1.wordsFrac(), from2.wordsFrac())
+	digitsFrac := myMax(int(from1.digitsFrac), int(from2.digitsFrac))
+	var buf [2 * wordBufLen]int32
+	var borrow int32
+	for i := words - 1; i >= 0; i-- {
+		diff := from1.alignedWord(i, wordsInt) - from2.alignedWord(i, wordsInt) - borrow
+		borrow = 0
+		if diff < 0 {
+			diff += wordBase
+			borrow = 1
+		}
+		buf[i] = diff
+	}
+	return to.fromWords(buf[:words], wordsInt, digitsFrac, negative)
+}
+
+// DecimalMul multiplies two decimals, the result keeps all the fractional digits which fit in the word buffer.
+func DecimalMul(from1, from2, to *MyDecimal) error {
+	resultFrac := myMin(int(from1.resultFrac)+int(from2.resultFrac), mysql.MaxDecimalScale)
+	words1 := from1.wordsInt() + from1.wordsFrac()
+	words2 := from2.wordsInt() + from2.wordsFrac()
+	var buf [2 * wordBufLen]int64
+	for i := words1 - 1; i >= 0; i-- {
+		var carry int64
+		for j := words2 - 1; j >= 0; j-- {
+			p := int64(from1.wordBuf[i])*int64(from2.wordBuf[j]) + buf[i+j+1] + carry
+			buf[i+j+1] = p % wordBase
+			carry = p / wordBase
+		}
+		buf[i] += carry
+	}
+	var words [2 * wordBufLen]int32
+	for i := 0; i < words1+words2; i++ {
+		words[i] = int32(buf[i])
+	}
+	err := to.fromWords(words[:words1+words2], from1.wordsInt()+from2.wordsInt(),
+		int(from1.digitsFrac)+int(from2.digitsFrac), from1.negative != from2.negative)
+	to.resultFrac = int8(myMin(resultFrac, int(to.digitsFrac)))
+	return err
+}
+
+// DecimalDiv does division of two decimals, the result shows fracIncr more fractional digits than from1.
+func DecimalDiv(from1, from2, to *MyDecimal, fracIncr int) error {
+	if from2.IsZero() {
+		return ErrDivByZero
+	}
+	resultFrac := myMin(int(from1.resultFrac)+fracIncr, mysql.MaxDecimalScale)
+	// Keep at least one more digit, so the result is rounded instead of truncated.
+	frac := digitsToWords(resultFrac+1) * digitsPerWord
+	v1, scale1 := from1.toBig()
+	v2, scale2 := from2.toBig()
+	// from1 / from2 * 10^frac = v1 * 10^(scale2 + frac) / (v2 * 10^scale1)
+	v1.Mul(v1, pow10Big(scale2+frac))
+	v2.Mul(v2, pow10Big(scale1))
+	err := to.fromBig(v1.Quo(v1, v2), frac, frac)
+	// Round the extra digits away, so the quotient compares and hashes the same as it is shown.
+	if roundErr := to.Round(to, myMin(resultFrac, int(to.digitsFrac)), ModeHalfUp); err == nil {
+		err = roundErr
+	}
+	to.resultFrac = int8(myMin(resultFrac, int(to.digitsFrac)))
+	return err
+}
+
+// DecimalMod does modulus of two decimals, the result has the sign of from1.
+func DecimalMod(from1, from2, to *MyDecimal) error {
+	if from2.IsZero() {
+		return ErrDivByZero
+	}
+	resultFrac := myMax(int(from1.resultFrac), int(from2.resultFrac))
+	digitsFrac := myMax(int(from1.digitsFrac), int(from2.digitsFrac))
+	v1, scale1 := from1.toBig()
+	v2, scale2 := from2.toBig()
+	scale := myMax(scale1, scale2)
+	v1.Mul(v1, pow10Big(scale-scale1))
+	v2.Mul(v2, pow10Big(scale-scale2))
+	err := to.fromBig(v1.Rem(v1, v2), scale, digitsFrac)
+	to.resultFrac = int8(myMin(resultFrac, int(to.digitsFrac)))
+	return err
+}
+
+// NewDecFromInt creates a MyDecimal from int.
+func NewDecFromInt(i int64) *MyDecimal {
+	return new(MyDecimal).FromInt(i)
+}
+
+// NewDecFromUint creates a MyDecimal from uint.
+func NewDecFromUint(i uint64) *MyDecimal {
+	return new(MyDecimal).FromUint(i)
+}
+
+// NewDecFromFloatForTest creates a MyDecimal from float, as it returns no error, it should only be used in test.
+func NewDecFromFloatForTest(f float64) *MyDecimal {
+	dec := new(MyDecimal)
+	err := dec.FromFloat64(f)
+	terror.Log(errors.Trace(err))
+	return dec
+}
+
+// NewDecFromStringForTest creates a MyDecimal from string, as it returns no error, it should only be used in test.
+func NewDecFromStringForTest(s string) *MyDecimal {
+	dec := new(MyDecimal)
+	err := dec.FromString([]byte(s))
+	terror.Log(errors.Trace(err))
+	return dec
+}
+
+// NewMaxOrMinDec returns the max or min value decimal for given precision and fraction.
+func NewMaxOrMinDec(negative bool, prec, frac int) *MyDecimal {
+	dec := new(MyDecimal)
+	dec.setMax(prec, frac, negative)
+	return dec
+}
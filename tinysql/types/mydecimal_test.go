@@ -0,0 +1,338 @@
+// Copyright 2016 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"math"
+	"strings"
+	"unsafe"
+
+	. "github.com/pingcap/check"
+)
+
+var _ = Suite(&testMyDecimalSuite{})
+
+type testMyDecimalSuite struct {
+}
+
+func (s *testMyDecimalSuite) TestStructSize(c *C) {
+	c.Assert(int(unsafe.Sizeof(MyDecimal{})), Equals, MyDecimalStructSize)
+}
+
+func (s *testMyDecimalSuite) TestFromString(c *C) {
+	tests := []struct {
+		input  string
+		output string
+		err    error
+	}{
+		{"12345", "12345", nil},
+		{"12345.", "12345", nil},
+		{"123.45", "123.45", nil},
+		{"-123.45", "-123.45", nil},
+		{".00012345000098765", "0.00012345000098765", nil},
+		{".12345000098765", "0.12345000098765", nil},
+		{"-.000000012345000098765", "-0.000000012345000098765", nil},
+		{"1234500009876.5", "1234500009876.5", nil},
+		{"111111111.11", "111111111.11", nil},
+		{"000000000.01", "0.01", nil},
+		{"00012.3400", "12.3400", nil},
+		{"-0.000", "0.000", nil},
+		{"  +.5", "0.5", nil},
+		{"1e3", "1000", nil},
+		{"1.5e-3", "0.0015", nil},
+		{"-1.23E2", "-123", nil},
+		{"123E5", "12300000", nil},
+		{"1.2abc", "1.2", ErrTruncated},
+		{"abc", "0", ErrBadNumber},
+		{"", "0", ErrBadNumber},
+	}
+	for _, tt := range tests {
+		var dec MyDecimal
+		err := dec.FromString([]byte(tt.input))
+		c.Assert(err, Equals, tt.err, Commentf("input: %s", tt.input))
+		c.Assert(dec.String(), Equals, tt.output, Commentf("input: %s", tt.input))
+	}
+}
+
+func (s *testMyDecimalSuite) TestFromInt(c *C) {
+	tests := []struct {
+		input  int64
+		output string
+	}{
+		{-12345, "-12345"},
+		{-1, "-1"},
+		{0, "0"},
+		{1, "1"},
+		{999999999, "999999999"},
+		{1000000000, "1000000000"},
+		{math.MaxInt64, "9223372036854775807"},
+		{math.MinInt64, "-9223372036854775808"},
+	}
+	for _, tt := range tests {
+		dec := NewDecFromInt(tt.input)
+		c.Assert(dec.String(), Equals, tt.output)
+		val, err := dec.ToInt()
+		c.Assert(err, IsNil)
+		c.Assert(val, Equals, tt.input)
+	}
+
+	dec := NewDecFromUint(math.MaxUint64)
+	c.Assert(dec.String(), Equals, "18446744073709551615")
+	u, err := dec.ToUint()
+	c.Assert(err, IsNil)
+	c.Assert(u, Equals, uint64(math.MaxUint64))
+	_, err = dec.ToInt()
+	c.Assert(err, Equals, ErrOverflow)
+
+	_, err = NewDecFromStringForTest("-1").ToUint()
+	c.Assert(err, Equals, ErrOverflow)
+	val, err := NewDecFromStringForTest("12.5").ToInt()
+	c.Assert(err, Equals, ErrTruncated)
+	c.Assert(val, Equals, int64(12))
+}
+
+func (s *testMyDecimalSuite) TestFloat(c *C) {
+	tests := []struct {
+		input  float64
+		output string
+	}{
+		{0.1, "0.1"},
+		{-1.5, "-1.5"},
+		{123456789.123, "123456789.123"},
+		{1e20, "100000000000000000000"},
+		{1.5e-5, "0.000015"},
+	}
+	for _, tt := range tests {
+		var dec MyDecimal
+		c.Assert(dec.FromFloat64(tt.input), IsNil)
+		c.Assert(dec.String(), Equals, tt.output)
+		f, err := dec.ToFloat64()
+		c.Assert(err, IsNil)
+		c.Assert(f, Equals, tt.input)
+	}
+}
+
+func (s *testMyDecimalSuite) TestRound(c *C) {
+	tests := []struct {
+		input  string
+		frac   int
+		mode   RoundMode
+		output string
+	}{
+		{"123.456", 2, ModeHalfUp, "123.46"},
+		{"123.456", 2, ModeTruncate, "123.45"},
+		{"123.454", 2, ModeHalfUp, "123.45"},
+		{"-123.455", 2, ModeHalfUp, "-123.46"},
+		{"999.96", 1, ModeHalfUp, "1000.0"},
+		{"-5.5", 0, ModeHalfUp, "-6"},
+		{"0.5", 0, ModeHalfUp, "1"},
+		{"0.4", 0, ModeHalfUp, "0"},
+		{"-0.4", 0, ModeHalfUp, "0"},
+		{"1.5", 3, ModeHalfUp, "1.500"},
+		{"155", -1, ModeHalfUp, "160"},
+		{"155", -2, ModeHalfUp, "200"},
+		{"155", -3, ModeHalfUp, "0"},
+		{"999999999.5", 0, ModeHalfUp, "1000000000"},
+	}
+	for _, tt := range tests {
+		var dec, to MyDecimal
+		c.Assert(dec.FromString([]byte(tt.input)), IsNil)
+		c.Assert(dec.Round(&to, tt.frac, tt.mode), IsNil)
+		c.Assert(to.String(), Equals, tt.output, Commentf("input: %s, frac: %d", tt.input, tt.frac))
+	}
+}
+
+func (s *testMyDecimalSuite) TestShift(c *C) {
+	tests := []struct {
+		input  string
+		shift  int
+		output string
+	}{
+		{"123.456", 2, "12345.6"},
+		{"123.456", -4, "0.0123456"},
+		{"123", 3, "123000"},
+		{"-0.001", 3, "-1"},
+	}
+	for _, tt := range tests {
+		dec := NewDecFromStringForTest(tt.input)
+		c.Assert(dec.Shift(tt.shift), IsNil)
+		c.Assert(dec.String(), Equals, tt.output)
+	}
+}
+
+func (s *testMyDecimalSuite) TestCompare(c *C) {
+	tests := []struct {
+		a   string
+		b   string
+		cmp int
+	}{
+		{"12", "13", -1},
+		{"13", "12", 1},
+		{"-10", "10", -1},
+		{"10", "-10", 1},
+		{"-12", "-13", 1},
+		{"0", "12", -1},
+		{"-10", "0", -1},
+		{"4", "4", 0},
+		{"1.50", "1.5", 0},
+		{"0.000", "-0", 0},
+		{"123456789.123456789", "123456789.12345679", -1},
+		{"1000000000", "999999999.999999999", 1},
+	}
+	for _, tt := range tests {
+		a, b := NewDecFromStringForTest(tt.a), NewDecFromStringForTest(tt.b)
+		c.Assert(a.Compare(b), Equals, tt.cmp, Commentf("%s vs %s", tt.a, tt.b))
+	}
+}
+
+func (s *testMyDecimalSuite) TestArithmetic(c *C) {
+	tests := []struct {
+		a   string
+		b   string
+		add string
+		sub string
+		mul string
+	}{
+		{"0.1", "0.2", "0.3", "-0.1", "0.02"},
+		{"123.45", "-23.45", "100.00", "146.90", "-2894.9025"},
+		{"999999999.999999999", "0.000000001", "1000000000.000000000", "999999999.999999998", "0.999999999999999999"},
+		{"-1", "-1", "-2", "0", "1"},
+		{"1234567890123456789", "9876543210", "1234567899999999999", "1234567880246913579", "12193263112482853211126352690"},
+	}
+	for _, tt := range tests {
+		a, b := NewDecFromStringForTest(tt.a), NewDecFromStringForTest(tt.b)
+		var to MyDecimal
+		c.Assert(DecimalAdd(a, b, &to), IsNil)
+		c.Assert(to.String(), Equals, tt.add, Commentf("%s + %s", tt.a, tt.b))
+		c.Assert(DecimalSub(a, b, &to), IsNil)
+		c.Assert(to.String(), Equals, tt.sub, Commentf("%s - %s", tt.a, tt.b))
+		c.Assert(DecimalMul(a, b, &to), IsNil)
+		c.Assert(to.String(), Equals, tt.mul, Commentf("%s * %s", tt.a, tt.b))
+	}
+
+	// The result may be the same decimal as an operand.
+	a := NewDecFromStringForTest("1.5")
+	c.Assert(DecimalAdd(a, a, a), IsNil)
+	c.Assert(a.String(), Equals, "3.0")
+
+	max := NewDecFromStringForTest(strings.Repeat("9", 81))
+	var to MyDecimal
+	c.Assert(DecimalAdd(max, max, &to), Equals, ErrOverflow)
+}
+
+func (s *testMyDecimalSuite) TestDivMod(c *C) {
+	tests := []struct {
+		a   string
+		b   string
+		div string
+		mod string
+	}{
+		{"2", "3", "0.6667", "2"},
+		{"1.00", "3", "0.333333", "1.00"},
+		{"-7", "2", "-3.5000", "-1"},
+		{"7", "-2", "-3.5000", "1"},
+		{"10.5", "0.25", "42.00000", "0.00"},
+		{"123456789.123", "0.003", "41152263041.0000000", "0.000"},
+	}
+	for _, tt := range tests {
+		a, b := NewDecFromStringForTest(tt.a), NewDecFromStringForTest(tt.b)
+		var to MyDecimal
+		c.Assert(DecimalDiv(a, b, &to, DivFracIncr), IsNil)
+		c.Assert(to.String(), Equals, tt.div, Commentf("%s / %s", tt.a, tt.b))
+		c.Assert(to.Compare(NewDecFromStringForTest(tt.div)), Equals, 0, Commentf("%s / %s", tt.a, tt.b))
+		c.Assert(DecimalMod(a, b, &to), IsNil)
+		c.Assert(to.String(), Equals, tt.mod, Commentf("%s %% %s", tt.a, tt.b))
+	}
+
+	var to MyDecimal
+	c.Assert(DecimalDiv(NewDecFromInt(1), NewDecFromInt(0), &to, DivFracIncr), Equals, ErrDivByZero)
+	c.Assert(DecimalMod(NewDecFromInt(1), NewDecFromInt(0), &to), Equals, ErrDivByZero)
+}
+
+func (s *testMyDecimalSuite) TestToBinFromBin(c *C) {
+	tests := []struct {
+		input     string
+		precision int
+		frac      int
+		output    string
+		err       error
+	}{
+		{"-10.55", 4, 2, "-10.55", nil},
+		{"0.0123456789012345678912345", 30, 25, "0.0123456789012345678912345", nil},
+		{"12345", 5, 0, "12345", nil},
+		{"12345", 10, 3, "12345.000", nil},
+		{"123.45", 10, 3, "123.450", nil},
+		{"-123.45", 20, 10, "-123.4500000000", nil},
+		{".00012345000098765", 15, 14, "0.00012345000099", ErrTruncated},
+		{"1234500009876.5", 15, 1, "1234500009876.5", nil},
+		{"1000", 3, 0, "999", ErrOverflow},
+		{"-1000", 3, 1, "-99.9", ErrOverflow},
+	}
+	for _, tt := range tests {
+		dec := NewDecFromStringForTest(tt.input)
+		bin, err := dec.ToBin(tt.precision, tt.frac)
+		c.Assert(err, Equals, tt.err, Commentf("input: %s", tt.input))
+		c.Assert(len(bin), Equals, DecimalBinSize(tt.precision, tt.frac))
+		var dec2 MyDecimal
+		size, err := dec2.FromBin(bin, tt.precision, tt.frac)
+		c.Assert(err, IsNil)
+		c.Assert(size, Equals, len(bin))
+		c.Assert(dec2.String(), Equals, tt.output)
+	}
+
+	// The binary format is the same as MySQL's.
+	bin, err := NewDecFromStringForTest("1234567890.1234").ToBin(14, 4)
+	c.Assert(err, IsNil)
+	c.Assert(bin, DeepEquals, []byte{0x81, 0x0D, 0xFB, 0x38, 0xD2, 0x04, 0xD2})
+	bin, err = NewDecFromStringForTest("-1234567890.1234").ToBin(14, 4)
+	c.Assert(err, IsNil)
+	c.Assert(bin, DeepEquals, []byte{0x7E, 0xF2, 0x04, 0xC7, 0x2D, 0xFB, 0x2D})
+
+	_, err = NewDecFromInt(1).ToBin(0, 0)
+	c.Assert(err, Equals, ErrBadNumber)
+}
+
+func (s *testMyDecimalSuite) TestHashKey(c *C) {
+	tests := []struct {
+		nums []string
+	}{
+		{[]string{"1.1", "1.1000", "1.1000000", "1.10000000000", "01.1", "0001.1", "001.1000000"}},
+		{[]string{"-1", "-1.000", "-1.000000000000000", "-01"}},
+		{[]string{"0", "0.000", "-0", "-0.000"}},
+		{[]string{"123456789.987654321", "123456789.9876543210000"}},
+	}
+	for _, tt := range tests {
+		keys := make([]string, 0, len(tt.nums))
+		for _, num := range tt.nums {
+			key, err := NewDecFromStringForTest(num).ToHashKey()
+			c.Assert(err, IsNil)
+			keys = append(keys, string(key))
+		}
+		for i := 1; i < len(keys); i++ {
+			c.Assert(keys[0], Equals, keys[i], Commentf("%s vs %s", tt.nums[0], tt.nums[i]))
+		}
+	}
+	key1, err := NewDecFromStringForTest("1.1").ToHashKey()
+	c.Assert(err, IsNil)
+	key2, err := NewDecFromStringForTest("1.01").ToHashKey()
+	c.Assert(err, IsNil)
+	c.Assert(string(key1), Not(Equals), string(key2))
+}
+
+func (s *testMyDecimalSuite) TestMaxOrMinDec(c *C) {
+	c.Assert(NewMaxOrMinDec(false, 5, 2).String(), Equals, "999.99")
+	c.Assert(NewMaxOrMinDec(true, 5, 2).String(), Equals, "-999.99")
+	c.Assert(NewMaxOrMinDec(false, 3, 0).String(), Equals, "999")
+	c.Assert(NewMaxOrMinDec(true, 3, 3).String(), Equals, "-0.999")
+}
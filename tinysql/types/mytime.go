@@ -0,0 +1,269 @@
+// Copyright 2016 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	gotime "time"
+
+	"github.com/pingcap/errors"
+)
+
+// CoreTime is the internal struct type for Time. It packs all the date and
+// time fields into a single uint64, so comparing two CoreTimes can be done
+// by comparing the packed integers directly.
+type CoreTime uint64
+
+// ZeroCoreTime is the zero value for CoreTime, aka '0000-00-00 00:00:00'.
+var ZeroCoreTime = CoreTime(0)
+
+// Core time bit fields.
+const (
+	yearBitFieldOffset, yearBitFieldWidth               uint64 = 50, 14
+	monthBitFieldOffset, monthBitFieldWidth             uint64 = 46, 4
+	dayBitFieldOffset, dayBitFieldWidth                 uint64 = 41, 5
+	hourBitFieldOffset, hourBitFieldWidth               uint64 = 36, 5
+	minuteBitFieldOffset, minuteBitFieldWidth           uint64 = 30, 6
+	secondBitFieldOffset, secondBitFieldWidth           uint64 = 24, 6
+	microsecondBitFieldOffset, microsecondBitFieldWidth uint64 = 4, 20
+	fspTtBitFieldOffset, fspTtBitFieldWidth             uint64 = 0, 4
+
+	yearBitFieldMask        uint64 = ((1 << yearBitFieldWidth) - 1) << yearBitFieldOffset
+	monthBitFieldMask       uint64 = ((1 << monthBitFieldWidth) - 1) << monthBitFieldOffset
+	dayBitFieldMask         uint64 = ((1 << dayBitFieldWidth) - 1) << dayBitFieldOffset
+	hourBitFieldMask        uint64 = ((1 << hourBitFieldWidth) - 1) << hourBitFieldOffset
+	minuteBitFieldMask      uint64 = ((1 << minuteBitFieldWidth) - 1) << minuteBitFieldOffset
+	secondBitFieldMask      uint64 = ((1 << secondBitFieldWidth) - 1) << secondBitFieldOffset
+	microsecondBitFieldMask uint64 = ((1 << microsecondBitFieldWidth) - 1) << microsecondBitFieldOffset
+	fspTtBitFieldMask       uint64 = ((1 << fspTtBitFieldWidth) - 1) << fspTtBitFieldOffset
+)
+
+// FromDate makes a internal time representation from the given date.
+func FromDate(year int, month int, day int, hour int, minute int, second int, microsecond int) CoreTime {
+	v := uint64(ZeroCoreTime)
+	v |= (uint64(microsecond) << microsecondBitFieldOffset) & microsecondBitFieldMask
+	v |= (uint64(second) << secondBitFieldOffset) & secondBitFieldMask
+	v |= (uint64(minute) << minuteBitFieldOffset) & minuteBitFieldMask
+	v |= (uint64(hour) << hourBitFieldOffset) & hourBitFieldMask
+	v |= (uint64(day) << dayBitFieldOffset) & dayBitFieldMask
+	v |= (uint64(month) << monthBitFieldOffset) & monthBitFieldMask
+	v |= (uint64(year) << yearBitFieldOffset) & yearBitFieldMask
+	return CoreTime(v)
+}
+
+// FromGoTime translates time.Time to mysql time internal representation.
+func FromGoTime(t gotime.Time) CoreTime {
+	// Plus 500 nanosecond for rounding of the millisecond part.
+	t = t.Add(500 * gotime.Nanosecond)
+
+	year, month, day := t.Date()
+	hour, minute, second := t.Clock()
+	microsecond := t.Nanosecond() / 1000
+	return FromDate(year, int(month), day, hour, minute, second, microsecond)
+}
+
+func (t CoreTime) getYear() uint16 {
+	return uint16((uint64(t) & yearBitFieldMask) >> yearBitFieldOffset)
+}
+
+func (t CoreTime) getMonth() uint8 {
+	return uint8((uint64(t) & monthBitFieldMask) >> monthBitFieldOffset)
+}
+
+func (t CoreTime) getDay() uint8 {
+	return uint8((uint64(t) & dayBitFieldMask) >> dayBitFieldOffset)
+}
+
+func (t CoreTime) getHour() uint8 {
+	return uint8((uint64(t) & hourBitFieldMask) >> hourBitFieldOffset)
+}
+
+func (t CoreTime) getMinute() uint8 {
+	return uint8((uint64(t) & minuteBitFieldMask) >> minuteBitFieldOffset)
+}
+
+func (t CoreTime) getSecond() uint8 {
+	return uint8((uint64(t) & secondBitFieldMask) >> secondBitFieldOffset)
+}
+
+func (t CoreTime) getMicrosecond() uint32 {
+	return uint32((uint64(t) & microsecondBitFieldMask) >> microsecondBitFieldOffset)
+}
+
+func (t CoreTime) getFspTt() uint8 {
+	return uint8((uint64(t) & fspTtBitFieldMask) >> fspTtBitFieldOffset)
+}
+
+func (t *CoreTime) setFspTt(fspTt uint8) {
+	*t = CoreTime((uint64(*t) &^ fspTtBitFieldMask) | (uint64(fspTt) << fspTtBitFieldOffset))
+}
+
+// Year returns the year value.
+func (t CoreTime) Year() int {
+	return int(t.getYear())
+}
+
+// Month returns the month value.
+func (t CoreTime) Month() int {
+	return int(t.getMonth())
+}
+
+// Day returns the day value.
+func (t CoreTime) Day() int {
+	return int(t.getDay())
+}
+
+// Hour returns the hour value.
+func (t CoreTime) Hour() int {
+	return int(t.getHour())
+}
+
+// Minute returns the minute value.
+func (t CoreTime) Minute() int {
+	return int(t.getMinute())
+}
+
+// Second returns the second value.
+func (t CoreTime) Second() int {
+	return int(t.getSecond())
+}
+
+// Microsecond returns the microsecond value.
+func (t CoreTime) Microsecond() int {
+	return int(t.getMicrosecond())
+}
+
+// Weekday returns the day of the week.
+func (t CoreTime) Weekday() gotime.Weekday {
+	// calcWeekday returns 0 for Monday while gotime.Weekday uses 0 for Sunday.
+	return gotime.Weekday((calcWeekday(calcDaynr(t.Year(), t.Month(), t.Day())) + 1) % 7)
+}
+
+// YearDay returns the day of the year.
+func (t CoreTime) YearDay() int {
+	if t.Month() == 0 || t.Day() == 0 {
+		return 0
+	}
+	return calcDaynr(t.Year(), t.Month(), t.Day()) -
+		calcDaynr(t.Year(), 1, 1) + 1
+}
+
+// Week returns the week value in mode 0, where the week starts on Sunday
+// and the first week of a year is the one containing its first Sunday.
+// The days before the first Sunday belong to week 0.
+func (t CoreTime) Week() int {
+	if t.Month() == 0 || t.Day() == 0 {
+		return 0
+	}
+	// firstWeekday uses 0 for Sunday, so the first Sunday is the
+	// (7-firstWeekday)%7 days after Jan 1st.
+	firstWeekday := (calcWeekday(calcDaynr(t.Year(), 1, 1)) + 1) % 7
+	firstSunday := (7-firstWeekday)%7 + 1
+	yday := t.YearDay()
+	if yday < firstSunday {
+		return 0
+	}
+	return (yday-firstSunday)/7 + 1
+}
+
+// GoTime converts Time to GoTime.
+func (t CoreTime) GoTime(loc *gotime.Location) (gotime.Time, error) {
+	// gotime.Time can't represent month 0 or day 0, date contains 0 would be converted to a nearest date,
+	// For example, 2006-12-00 00:00:00 would become 2015-11-30 23:59:59.
+	year, month, day, hour, minute, second, microsecond := t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Microsecond()
+	tm := gotime.Date(year, gotime.Month(month), day, hour, minute, second, microsecond*1000, loc)
+	year2, month2, day2 := tm.Date()
+	hour2, minute2, second2 := tm.Clock()
+	microsec2 := tm.Nanosecond() / 1000
+	// This function will check the result, and return an error if it's not the same with the origin input.
+	if year2 != year || int(month2) != month || day2 != day ||
+		hour2 != hour || minute2 != minute || second2 != second ||
+		microsec2 != microsecond {
+		return tm, errors.Trace(ErrWrongValue.GenWithStackByArgs(TimeStr, t))
+	}
+	return tm, nil
+}
+
+// IsLeapYear returns if it's leap year.
+func (t CoreTime) IsLeapYear() bool {
+	return isLeapYear(t.getYear())
+}
+
+func isLeapYear(year uint16) bool {
+	return (year%4 == 0 && year%100 != 0) || year%400 == 0
+}
+
+var daysByMonth = [12]int{31, 28, 31, 30, 31, 30, 31, 31, 30, 31, 30, 31}
+
+// GetLastDay returns the last day of the month
+func GetLastDay(year, month int) int {
+	var day = 0
+	if month > 0 && month <= 12 {
+		day = daysByMonth[month-1]
+	}
+	if month == 2 && isLeapYear(uint16(year)) {
+		day = 29
+	}
+	return day
+}
+
+// calcDaynr calculates days since 0000-00-00.
+func calcDaynr(year, month, day int) int {
+	if year == 0 && month == 0 {
+		return 0
+	}
+
+	delsum := 365*year + 31*(month-1) + day
+	if month <= 2 {
+		year--
+	} else {
+		delsum -= (month*4 + 23) / 10
+	}
+	temp := ((year/100 + 1) * 3) / 4
+	return delsum + year/4 - temp
+}
+
+// DateDiff calculates number of days between two days.
+func DateDiff(startTime, endTime CoreTime) int {
+	return calcDaynr(startTime.Year(), startTime.Month(), startTime.Day()) - calcDaynr(endTime.Year(), endTime.Month(), endTime.Day())
+}
+
+// calcWeekday calculates weekday from daynr, returns 0 for Monday, 1 for Tuesday ...
+func calcWeekday(daynr int) int {
+	daynr += 5
+	return daynr % 7
+}
+
+// AddDate fix gap between mysql and golang api
+// When we execute select date_add('2018-01-31',interval 1 month) in mysql we got 2018-02-28
+// but in tidb we got 2018-03-03.
+// Dig it and we found it's caused by golang api time.Date(year int, month Month, day, hour, min, sec, nsec int, loc *Location) Time ,
+// it says October 32 converts to November 1 ,it conflicts with mysql.
+// See https://github.com/mysql/mysql-server/blob/a2757a60d6fc3f1c3a32e5e69bb2d12ba7e1e0f3/sql/sql_time.cc#L1042
+func AddDate(year, month, day int64, ot gotime.Time) (nt gotime.Time) {
+	if year != 0 || month != 0 {
+		months := int64(ot.Year())*12 + int64(ot.Month()) - 1 + year*12 + month
+		newYear, newMonth := int(months/12), int(months%12)+1
+		if months < 0 {
+			// Keep the month in [1, 12] for negative values, the year check
+			// is done by the caller anyway.
+			newYear, newMonth = int((months-11)/12), int((months%12+12)%12)+1
+		}
+		newDay := ot.Day()
+		if lastDay := GetLastDay(newYear, newMonth); newDay > lastDay {
+			newDay = lastDay
+		}
+		ot = gotime.Date(newYear, gotime.Month(newMonth), newDay, ot.Hour(), ot.Minute(), ot.Second(), ot.Nanosecond(), ot.Location())
+	}
+	return ot.AddDate(0, 0, int(day))
+}
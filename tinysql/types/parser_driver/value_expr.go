@@ -90,6 +90,8 @@ func (n *ValueExpr) Format(w io.Writer) {
 		s = strconv.FormatFloat(n.GetFloat64(), 'e', -1, 32)
 	case types.KindFloat64:
 		s = strconv.FormatFloat(n.GetFloat64(), 'e', -1, 64)
+	case types.KindMysqlDecimal:
+		s = n.GetMysqlDecimal().String()
 	case types.KindString, types.KindBytes:
 		s = strconv.Quote(n.GetString())
 	default:
@@ -0,0 +1,1842 @@
+// Copyright 2015 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	gotime "time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/parser/terror"
+	"github.com/pingcap/tidb/sessionctx/stmtctx"
+)
+
+// Time format without fractional seconds precision.
+const (
+	DateFormat = "2006-01-02"
+	TimeFormat = "2006-01-02 15:04:05"
+	// TimeFSPFormat is time format with fractional seconds precision.
+	TimeFSPFormat = "2006-01-02 15:04:05.000000"
+)
+
+const (
+	// MinYear is the minimum for mysql year type.
+	MinYear int16 = 1901
+	// MaxYear is the maximum for mysql year type.
+	MaxYear int16 = 2155
+	// MaxDuration is the maximum for duration.
+	MaxDuration int64 = 838*10000 + 59*100 + 59
+	// MinTime is the minimum for mysql time type.
+	MinTime = -gotime.Duration(838*3600+59*60+59) * gotime.Second
+	// MaxTime is the maximum for mysql time type.
+	MaxTime = gotime.Duration(838*3600+59*60+59) * gotime.Second
+	// ZeroDatetimeStr is the string representation of a zero datetime.
+	ZeroDatetimeStr = "0000-00-00 00:00:00"
+	// ZeroDateStr is the string representation of a zero date.
+	ZeroDateStr = "0000-00-00"
+
+	// TimeMaxHour is the max hour for mysql time type.
+	TimeMaxHour = 838
+	// TimeMaxMinute is the max minute for mysql time type.
+	TimeMaxMinute = 59
+	// TimeMaxSecond is the max second for mysql time type.
+	TimeMaxSecond = 59
+	// TimeMaxValue is the maximum value for mysql time type.
+	TimeMaxValue = TimeMaxHour*10000 + TimeMaxMinute*100 + TimeMaxSecond
+	// TimeMaxValueSeconds is the maximum second value for mysql time type.
+	TimeMaxValueSeconds = TimeMaxHour*3600 + TimeMaxMinute*60 + TimeMaxSecond
+)
+
+const (
+	// GoDurationDay is the gotime.Duration which equals to a Day.
+	GoDurationDay = gotime.Hour * 24
+	// GoDurationWeek is the gotime.Duration which equals to a Week.
+	GoDurationWeek = GoDurationDay * 7
+)
+
+// The lowest bit of fspTt is the time type, the higher 3 bits are the fsp.
+// A date has no fsp, so the invalid fsp 7 is used to mark it.
+const (
+	fspTtForDate uint8 = 0x0e
+	fspForDate   uint8 = 0x07
+)
+
+var (
+	// ZeroDuration is the zero value for Duration type.
+	ZeroDuration = Duration{Duration: gotime.Duration(0), Fsp: DefaultFsp}
+
+	// ZeroTime is the zero value for TimeInternal type.
+	ZeroTime = Time{}
+
+	// ZeroDatetime is the zero value for datetime Time.
+	ZeroDatetime = NewTime(ZeroCoreTime, mysql.TypeDatetime, DefaultFsp)
+
+	// ZeroTimestamp is the zero value for timestamp Time.
+	ZeroTimestamp = NewTime(ZeroCoreTime, mysql.TypeTimestamp, DefaultFsp)
+
+	// ZeroDate is the zero value for date Time.
+	ZeroDate = NewTime(ZeroCoreTime, mysql.TypeDate, DefaultFsp)
+)
+
+var (
+	// MinDatetime is the minimum for mysql datetime type.
+	MinDatetime = FromDate(1000, 1, 1, 0, 0, 0, 0)
+	// MaxDatetime is the maximum for mysql datetime type.
+	MaxDatetime = FromDate(9999, 12, 31, 23, 59, 59, 999999)
+
+	// BoundTimezone is the timezone for min and max timestamp.
+	BoundTimezone = gotime.UTC
+	// MinTimestamp is the minimum for mysql timestamp type.
+	MinTimestamp = NewTime(FromDate(1970, 1, 1, 0, 0, 1, 0), mysql.TypeTimestamp, DefaultFsp)
+	// MaxTimestamp is the maximum for mysql timestamp type.
+	MaxTimestamp = NewTime(FromDate(2038, 1, 19, 3, 14, 7, 999999), mysql.TypeTimestamp, DefaultFsp)
+)
+
+// Time is the struct for handling datetime, timestamp and date.
+type Time struct {
+	coreTime CoreTime
+}
+
+// NewTime constructs time from core time, type and fsp.
+func NewTime(coreTime CoreTime, tp uint8, fsp int8) Time {
+	t := Time{coreTime: coreTime}
+	if fsp == UnspecifiedFsp {
+		fsp = DefaultFsp
+	}
+	switch tp {
+	case mysql.TypeDate:
+		t.coreTime.setFspTt(fspTtForDate)
+	case mysql.TypeTimestamp:
+		t.coreTime.setFspTt(uint8(fsp)<<1 | 1)
+	default:
+		t.coreTime.setFspTt(uint8(fsp) << 1)
+	}
+	return t
+}
+
+// CoreTime returns the internal time representation.
+func (t Time) CoreTime() CoreTime {
+	return CoreTime(uint64(t.coreTime) &^ fspTtBitFieldMask)
+}
+
+// SetCoreTime updates the date and time fields, the type and fsp are kept.
+func (t *Time) SetCoreTime(ct CoreTime) {
+	t.coreTime = CoreTime(uint64(ct)&^fspTtBitFieldMask | uint64(t.coreTime)&fspTtBitFieldMask)
+}
+
+// Type returns the type of the time, it can be TypeDate, TypeDatetime or TypeTimestamp.
+func (t Time) Type() uint8 {
+	fspTt := t.coreTime.getFspTt()
+	if fspTt == fspTtForDate {
+		return mysql.TypeDate
+	}
+	if fspTt&1 == 1 {
+		return mysql.TypeTimestamp
+	}
+	return mysql.TypeDatetime
+}
+
+// SetType updates the type of the time, the fsp of a date is always 0.
+func (t *Time) SetType(tp uint8) {
+	fsp := uint8(t.Fsp())
+	switch tp {
+	case mysql.TypeDate:
+		t.coreTime.setFspTt(fspTtForDate)
+	case mysql.TypeTimestamp:
+		t.coreTime.setFspTt(fsp<<1 | 1)
+	default:
+		t.coreTime.setFspTt(fsp << 1)
+	}
+}
+
+// Fsp returns the fractional seconds precision of the time.
+func (t Time) Fsp() int8 {
+	fspTt := t.coreTime.getFspTt()
+	if fspTt == fspTtForDate {
+		return DefaultFsp
+	}
+	return int8(fspTt >> 1)
+}
+
+// SetFsp updates the fsp of the time, it does nothing to a date.
+func (t *Time) SetFsp(fsp int8) {
+	fspTt := t.coreTime.getFspTt()
+	if fspTt == fspTtForDate {
+		return
+	}
+	if fsp == UnspecifiedFsp {
+		fsp = DefaultFsp
+	}
+	t.coreTime.setFspTt(uint8(fsp)<<1 | fspTt&1)
+}
+
+// Year returns the year value.
+func (t Time) Year() int {
+	return t.coreTime.Year()
+}
+
+// Month returns the month value.
+func (t Time) Month() int {
+	return t.coreTime.Month()
+}
+
+// Day returns the day value.
+func (t Time) Day() int {
+	return t.coreTime.Day()
+}
+
+// Hour returns the hour value.
+func (t Time) Hour() int {
+	return t.coreTime.Hour()
+}
+
+// Minute returns the minute value.
+func (t Time) Minute() int {
+	return t.coreTime.Minute()
+}
+
+// Second returns the second value.
+func (t Time) Second() int {
+	return t.coreTime.Second()
+}
+
+// Microsecond returns the microsecond value.
+func (t Time) Microsecond() int {
+	return t.coreTime.Microsecond()
+}
+
+// GoTime converts Time to GoTime.
+func (t Time) GoTime(loc *gotime.Location) (gotime.Time, error) {
+	return t.coreTime.GoTime(loc)
+}
+
+// String implements fmt.Stringer interface.
+func (t Time) String() string {
+	if t.Type() == mysql.TypeDate {
+		return fmt.Sprintf("%04d-%02d-%02d", t.Year(), t.Month(), t.Day())
+	}
+	s := fmt.Sprintf("%04d-%02d-%02d %02d:%02d:%02d", t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second())
+	if fsp := t.Fsp(); fsp > 0 {
+		s += fmt.Sprintf(".%06d", t.Microsecond())[:fsp+1]
+	}
+	return s
+}
+
+// IsZero returns a boolean indicating whether the time is equal to ZeroTime.
+func (t Time) IsZero() bool {
+	return compareTime(t.coreTime, ZeroCoreTime) == 0
+}
+
+// InvalidZero returns a boolean indicating whether the month or day is zero.
+func (t Time) InvalidZero() bool {
+	return t.Month() == 0 || t.Day() == 0
+}
+
+// toNumberString returns the number representation of the time, like
+// '2012-12-12 12:12:12.123' => "20121212121212.123".
+func (t Time) toNumberString() string {
+	if t.IsZero() {
+		return "0"
+	}
+	var s string
+	if t.Type() == mysql.TypeDate {
+		s = fmt.Sprintf("%04d%02d%02d", t.Year(), t.Month(), t.Day())
+	} else {
+		s = fmt.Sprintf("%04d%02d%02d%02d%02d%02d", t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second())
+	}
+	if fsp := t.Fsp(); fsp > 0 {
+		s += fmt.Sprintf(".%06d", t.Microsecond())[:fsp+1]
+	}
+	return s
+}
+
+// ToInt64 returns the integer representation of the time, the fractional part is rounded,
+// like '2012-12-12 12:12:12.5' => 20121212121213.
+func (t Time) ToInt64() int64 {
+	if t.IsZero() {
+		return 0
+	}
+	v := int64(t.Year())*10000 + int64(t.Month())*100 + int64(t.Day())
+	if t.Type() == mysql.TypeDate {
+		return v
+	}
+	v = v*1000000 + int64(t.Hour())*10000 + int64(t.Minute())*100 + int64(t.Second())
+	if t.Microsecond() >= 500000 {
+		v++
+	}
+	return v
+}
+
+// ToNumber returns the decimal representation of the time, like '2012-12-12 12:12:12.123' => 20121212121212.123.
+func (t Time) ToNumber() *MyDecimal {
+	dec := new(MyDecimal)
+	terror.Log(dec.FromString([]byte(t.toNumberString())))
+	return dec
+}
+
+// ToFloat64 returns the float representation of the time, like '2012-12-12 12:12:12.123' => 20121212121212.123.
+func (t Time) ToFloat64() float64 {
+	f, err := strconv.ParseFloat(t.toNumberString(), 64)
+	if err != nil {
+		return 0
+	}
+	return f
+}
+
+// Compare returns an integer comparing the time instant t to o.
+// If t is after o, returns 1, equal o, returns 0, before o, returns -1.
+func (t Time) Compare(o Time) int {
+	return compareTime(t.coreTime, o.coreTime)
+}
+
+// compareTime compares the date and time fields of two CoreTimes, the fsp and type are ignored.
+func compareTime(a, b CoreTime) int {
+	return CompareUint64(uint64(a)&^fspTtBitFieldMask, uint64(b)&^fspTtBitFieldMask)
+}
+
+// CompareString is like Compare,
+// but parses string to Time then compares.
+func (t Time) CompareString(sc *stmtctx.StatementContext, str string) (int, error) {
+	// use MaxFsp to parse the string
+	o, err := ParseTime(sc, str, t.Type(), MaxFsp)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+
+	return t.Compare(o), nil
+}
+
+// roundTime rounds the time value according to digits count specified by fsp.
+func roundTime(t gotime.Time, fsp int8) gotime.Time {
+	d := gotime.Duration(math.Pow10(9 - int(fsp)))
+	return t.Round(d)
+}
+
+// RoundFrac rounds the fraction part of a time-type value according to `fsp`.
+func (t Time) RoundFrac(sc *stmtctx.StatementContext, fsp int8) (Time, error) {
+	if t.Type() == mysql.TypeDate || t.IsZero() {
+		// date type has no fsp
+		return t, nil
+	}
+
+	fsp, err := CheckFsp(int(fsp))
+	if err != nil {
+		return t, errors.Trace(err)
+	}
+
+	if fsp == t.Fsp() {
+		// have same fsp
+		return t, nil
+	}
+
+	var nt CoreTime
+	if t1, err := t.GoTime(gotime.UTC); err == nil {
+		t1 = roundTime(t1, fsp)
+		nt = FromGoTime(t1)
+	} else {
+		// Take the hh:mm:ss part out to avoid handle month or day = 0.
+		hour, minute, second, microsecond := t.Hour(), t.Minute(), t.Second(), t.Microsecond()
+		t1 := gotime.Date(1, 1, 1, hour, minute, second, microsecond*1000, gotime.UTC)
+		t2 := roundTime(t1, fsp)
+		hour, minute, second = t2.Clock()
+		microsecond = t2.Nanosecond() / 1000
+
+		// TODO: when hh:mm:ss overflow one day after rounding, it should be add to yy:mm:dd part,
+		// but mm:dd may contain 0, it makes the code complex, so we ignore it here.
+		if t2.Day()-1 > 0 {
+			return t, errors.Trace(ErrWrongValue.GenWithStackByArgs(TimeStr, t.String()))
+		}
+		nt = FromDate(t.Year(), t.Month(), t.Day(), hour, minute, second, microsecond)
+	}
+
+	return NewTime(nt, t.Type(), fsp), nil
+}
+
+// ToPackedUint encodes Time to a packed uint64 value.
+//
+//	 1 bit  0
+//	17 bits year*13+month   (year 0-9999, month 0-12)
+//	 5 bits day             (0-31)
+//	 5 bits hour            (0-23)
+//	 6 bits minute          (0-59)
+//	 6 bits second          (0-59)
+//	24 bits microseconds    (0-999999)
+//
+//	Total: 64 bits = 8 bytes
+//
+//	0YYYYYYY.YYYYYYYY.YYdddddh.hhhhmmmm.mmssssss.ffffffff.ffffffff.ffffffff
+func (t Time) ToPackedUint() (uint64, error) {
+	tm := t
+	if t.IsZero() {
+		return 0, nil
+	}
+	year, month, day := tm.Year(), tm.Month(), tm.Day()
+	hour, minute, sec := tm.Hour(), tm.Minute(), tm.Second()
+	ymd := uint64(((year*13 + month) << 5) | day)
+	hms := uint64(hour<<12 | minute<<6 | sec)
+	micro := uint64(tm.Microsecond())
+	return ((ymd<<17 | hms) << 24) | micro, nil
+}
+
+// FromPackedUint decodes Time from a packed uint64 value.
+func (t *Time) FromPackedUint(packed uint64) error {
+	if packed == 0 {
+		t.SetCoreTime(ZeroCoreTime)
+		return nil
+	}
+	ymdhms := packed >> 24
+	ymd := ymdhms >> 17
+	day := int(ymd & (1<<5 - 1))
+	ym := ymd >> 5
+	month := int(ym % 13)
+	year := int(ym / 13)
+
+	hms := ymdhms & (1<<17 - 1)
+	second := int(hms & (1<<6 - 1))
+	minute := int((hms >> 6) & (1<<6 - 1))
+	hour := int(hms >> 12)
+	microsec := int(packed % (1 << 24))
+
+	t.SetCoreTime(FromDate(year, month, day, hour, minute, second, microsec))
+
+	return nil
+}
+
+// Check function checks whether t matches valid Time format.
+func (t Time) Check(sc *stmtctx.StatementContext) error {
+	var err error
+	switch t.Type() {
+	case mysql.TypeTimestamp:
+		err = checkTimestampType(sc, t.coreTime)
+	case mysql.TypeDatetime, mysql.TypeDate:
+		err = checkDatetimeType(t.coreTime)
+	}
+	return errors.Trace(err)
+}
+
+func checkDateType(t CoreTime) error {
+	year, month, day := t.Year(), t.Month(), t.Day()
+	if year == 0 && month == 0 && day == 0 {
+		return nil
+	}
+
+	if err := checkMonthDay(year, month, day); err != nil {
+		return errors.Trace(err)
+	}
+
+	return nil
+}
+
+func checkMonthDay(year, month, day int) error {
+	if month < 1 || month > 12 {
+		return errors.Trace(ErrWrongValue.GenWithStackByArgs(TimeStr, fmt.Sprintf("%d-%d-%d", year, month, day)))
+	}
+
+	maxDay := GetLastDay(year, month)
+	if day < 1 || day > maxDay {
+		return errors.Trace(ErrWrongValue.GenWithStackByArgs(TimeStr, fmt.Sprintf("%d-%d-%d", year, month, day)))
+	}
+	return nil
+}
+
+func checkDatetimeType(t CoreTime) error {
+	if err := checkDateType(t); err != nil {
+		return errors.Trace(err)
+	}
+
+	hour, minute, second := t.Hour(), t.Minute(), t.Second()
+	if hour < 0 || hour >= 24 {
+		return errors.Trace(ErrWrongValue.GenWithStackByArgs(TimeStr, strconv.Itoa(hour)))
+	}
+	if minute < 0 || minute >= 60 {
+		return errors.Trace(ErrWrongValue.GenWithStackByArgs(TimeStr, strconv.Itoa(minute)))
+	}
+	if second < 0 || second >= 60 {
+		return errors.Trace(ErrWrongValue.GenWithStackByArgs(TimeStr, strconv.Itoa(second)))
+	}
+
+	return nil
+}
+
+func checkTimestampType(sc *stmtctx.StatementContext, t CoreTime) error {
+	if compareTime(t, ZeroCoreTime) == 0 {
+		return nil
+	}
+
+	if err := checkDatetimeType(t); err != nil {
+		return errors.Trace(err)
+	}
+
+	var checkTime CoreTime
+	if loc := getTimeZone(sc); loc != BoundTimezone {
+		convertTime := NewTime(t, mysql.TypeTimestamp, DefaultFsp)
+		err := convertTime.ConvertTimeZone(loc, BoundTimezone)
+		if err != nil {
+			return err
+		}
+		checkTime = convertTime.coreTime
+	} else {
+		checkTime = t
+	}
+	if compareTime(checkTime, MaxTimestamp.coreTime) > 0 || compareTime(checkTime, MinTimestamp.coreTime) < 0 {
+		return errors.Trace(ErrWrongValue.GenWithStackByArgs(TimeStr, NewTime(t, mysql.TypeTimestamp, DefaultFsp).String()))
+	}
+
+	return nil
+}
+
+// getTimeZone returns the time zone of the statement, UTC is used when it's not set.
+func getTimeZone(sc *stmtctx.StatementContext) *gotime.Location {
+	if sc == nil || sc.TimeZone == nil {
+		return gotime.UTC
+	}
+	return sc.TimeZone
+}
+
+// ConvertTimeZone converts the time value from one timezone to another.
+// The input time should be a valid timestamp.
+func (t *Time) ConvertTimeZone(from, to *gotime.Location) error {
+	if !t.IsZero() {
+		raw, err := t.GoTime(from)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		converted := raw.In(to)
+		t.SetCoreTime(FromGoTime(converted))
+	}
+	return nil
+}
+
+// Convert converts t with type tp.
+func (t Time) Convert(sc *stmtctx.StatementContext, tp uint8) (Time, error) {
+	t1 := t
+	if t.Type() == tp || t.IsZero() {
+		t1.SetType(tp)
+		return t1, nil
+	}
+
+	t1.SetType(tp)
+	if tp == mysql.TypeDate {
+		// Truncate hh:mm:ss part if the type is Date.
+		t1.SetCoreTime(FromDate(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0))
+	}
+	err := t1.Check(sc)
+	return t1, errors.Trace(err)
+}
+
+// ConvertToDuration converts mysql datetime, timestamp and date to mysql time type.
+// e.g,
+// 2012-12-12T10:10:10 -> 10:10:10
+// 2012-12-12 -> 0
+func (t Time) ConvertToDuration() (Duration, error) {
+	if t.IsZero() {
+		return ZeroDuration, nil
+	}
+
+	hour, minute, second := t.Hour(), t.Minute(), t.Second()
+	frac := t.Microsecond() * 1000
+
+	d := gotime.Duration(hour*3600+minute*60+second)*gotime.Second + gotime.Duration(frac)
+	// TODO: check convert validation
+	return Duration{Duration: d, Fsp: t.Fsp()}, nil
+}
+
+// Add adds d to t, returns the result time value.
+func (t Time) Add(sc *stmtctx.StatementContext, d Duration) (Time, error) {
+	seconds, microseconds, _ := calcTimeDiff(t.coreTime, ZeroCoreTime, 1)
+	dsec := d.Duration.Nanoseconds() / int64(gotime.Second)
+	dmicro := (d.Duration.Nanoseconds() % int64(gotime.Second)) / int64(gotime.Microsecond)
+	seconds += int(dsec)
+	microseconds += int(dmicro)
+	if microseconds >= 1000000 {
+		seconds++
+		microseconds -= 1000000
+	} else if microseconds < 0 {
+		seconds--
+		microseconds += 1000000
+	}
+	tm := calcTimeFromSec(seconds, microseconds)
+
+	fsp := t.Fsp()
+	if d.Fsp > fsp {
+		fsp = d.Fsp
+	}
+	ret := NewTime(tm, t.Type(), fsp)
+	return ret, ret.Check(sc)
+}
+
+// Sub subtracts t1 from t, returns a duration value.
+// Note that sub should not be done on different time types.
+func (t *Time) Sub(sc *stmtctx.StatementContext, t1 *Time) Duration {
+	seconds, microseconds, neg := calcTimeDiff(t.coreTime, t1.coreTime, 1)
+	hours := seconds / 3600
+	if hours > TimeMaxHour {
+		hours = TimeMaxHour
+		seconds = TimeMaxValueSeconds
+		microseconds = 0
+	}
+	d := gotime.Duration(seconds)*gotime.Second + gotime.Duration(microseconds)*gotime.Microsecond
+	if neg {
+		d = -d
+	}
+
+	fsp := t.Fsp()
+	if t1.Fsp() > fsp {
+		fsp = t1.Fsp()
+	}
+	return Duration{Duration: d, Fsp: fsp}
+}
+
+// calcTimeDiff calculates difference of t1 and t2.
+func calcTimeDiff(t1, t2 CoreTime, sign int) (seconds, microseconds int, neg bool) {
+	days := calcDaynr(t1.Year(), t1.Month(), t1.Day())
+	days2 := calcDaynr(t2.Year(), t2.Month(), t2.Day())
+	days -= sign * days2
+
+	tmp := (int64(days)*86400+
+		int64(t1.Hour())*3600+int64(t1.Minute())*60+
+		int64(t1.Second())-
+		int64(sign)*(int64(t2.Hour())*3600+int64(t2.Minute())*60+
+			int64(t2.Second())))*
+		1e6 +
+		int64(t1.Microsecond()) - int64(sign)*int64(t2.Microsecond())
+
+	if tmp < 0 {
+		tmp = -tmp
+		neg = true
+	}
+	seconds = int(tmp / 1e6)
+	microseconds = int(tmp % 1e6)
+	return
+}
+
+// calcTimeFromSec calculates the CoreTime from the seconds since 0000-00-00 00:00:00.
+func calcTimeFromSec(seconds, microseconds int) CoreTime {
+	days := seconds / 86400
+	seconds %= 86400
+	year, month, day := getDateFromDaynr(uint(days))
+	hour := seconds / 3600
+	seconds %= 3600
+	minute := seconds / 60
+	second := seconds % 60
+	return FromDate(int(year), int(month), int(day), hour, minute, second, microseconds)
+}
+
+// getDateFromDaynr changes a daynr to year, month and day,
+// daynr 0 is returned as date 00.00.00
+func getDateFromDaynr(daynr uint) (year uint, month uint, day uint) {
+	if daynr <= 365 || daynr >= 3652500 {
+		return
+	}
+
+	year = daynr * 100 / 36525
+	temp := (((year-1)/100 + 1) * 3) / 4
+	dayOfYear := daynr - year*365 - (year-1)/4 + temp
+	daysInYear := calcDaysInYear(int(year))
+	for dayOfYear > uint(daysInYear) {
+		dayOfYear -= uint(daysInYear)
+		year++
+		daysInYear = calcDaysInYear(int(year))
+	}
+
+	leapDay := uint(0)
+	if daysInYear == 366 {
+		if dayOfYear > 31+28 {
+			dayOfYear--
+			if dayOfYear == 31+28 {
+				// Handle leapyears leapday.
+				leapDay = 1
+			}
+		}
+	}
+
+	month = 1
+	for _, days := range daysByMonth {
+		if dayOfYear <= uint(days) {
+			break
+		}
+		dayOfYear -= uint(days)
+		month++
+	}
+
+	day = dayOfYear + leapDay
+	return
+}
+
+// calcDaysInYear calculates days in one year, it works with 0 <= year <= 99.
+func calcDaysInYear(year int) int {
+	if (year&3) == 0 && (year%100 != 0 || (year%400 == 0 && (year != 0))) {
+		return 366
+	}
+	return 365
+}
+
+// Duration is the type for MySQL TIME type.
+type Duration struct {
+	gotime.Duration
+	// Fsp is short for Fractional Seconds Precision.
+	// See http://dev.mysql.com/doc/refman/5.7/en/fractional-seconds.html
+	Fsp int8
+}
+
+// Add adds d to d, returns a duration value.
+func (d Duration) Add(v Duration) (Duration, error) {
+	dsum, err := AddInt64(int64(d.Duration), int64(v.Duration))
+	if err != nil {
+		return Duration{}, errors.Trace(err)
+	}
+	if d.Fsp >= v.Fsp {
+		return Duration{Duration: gotime.Duration(dsum), Fsp: d.Fsp}, nil
+	}
+	return Duration{Duration: gotime.Duration(dsum), Fsp: v.Fsp}, nil
+}
+
+// Sub subtracts d to d, returns a duration value.
+func (d Duration) Sub(v Duration) (Duration, error) {
+	dsum, err := SubInt64(int64(d.Duration), int64(v.Duration))
+	if err != nil {
+		return Duration{}, errors.Trace(err)
+	}
+	if d.Fsp >= v.Fsp {
+		return Duration{Duration: gotime.Duration(dsum), Fsp: d.Fsp}, nil
+	}
+	return Duration{Duration: gotime.Duration(dsum), Fsp: v.Fsp}, nil
+}
+
+// String returns the time formatted using default TimeFormat and fsp.
+func (d Duration) String() string {
+	var buf strings.Builder
+
+	sign, hours, minutes, seconds, fraction := splitDuration(d.Duration)
+	if sign < 0 {
+		buf.WriteByte('-')
+	}
+
+	fmt.Fprintf(&buf, "%02d:%02d:%02d", hours, minutes, seconds)
+	if d.Fsp > 0 {
+		buf.WriteString(".")
+		buf.WriteString(d.formatFrac(fraction))
+	}
+
+	p := buf.String()
+
+	return p
+}
+
+func (d Duration) formatFrac(frac int) string {
+	s := fmt.Sprintf("%06d", frac)
+	return s[0:d.Fsp]
+}
+
+// toNumberString returns the number representation of the duration, like
+// '-12:12:12.123' => "-121212.123".
+func (d Duration) toNumberString() string {
+	sign, hours, minutes, seconds, fraction := splitDuration(d.Duration)
+	var s string
+	if sign < 0 {
+		s = "-"
+	}
+	s += strconv.Itoa(hours*10000 + minutes*100 + seconds)
+	if d.Fsp > 0 {
+		s += "." + d.formatFrac(fraction)
+	}
+	return s
+}
+
+// ToInt64 returns the integer representation of the duration, the fractional part is rounded,
+// like '12:12:12.5' => 121213.
+func (d Duration) ToInt64() int64 {
+	sign, hours, minutes, seconds, fraction := splitDuration(d.Duration)
+	v := int64(hours*10000 + minutes*100 + seconds)
+	if fraction >= 500000 {
+		v++
+	}
+	if sign < 0 {
+		v = -v
+	}
+	return v
+}
+
+// ToNumber returns the decimal representation of the duration, like '12:12:12.123' => 121212.123.
+func (d Duration) ToNumber() *MyDecimal {
+	dec := new(MyDecimal)
+	terror.Log(dec.FromString([]byte(d.toNumberString())))
+	return dec
+}
+
+// ToFloat64 returns the float representation of the duration, like '12:12:12.123' => 121212.123.
+func (d Duration) ToFloat64() float64 {
+	f, err := strconv.ParseFloat(d.toNumberString(), 64)
+	if err != nil {
+		return 0
+	}
+	return f
+}
+
+// ConvertToTime converts duration to Time.
+// Tp is TypeDatetime, TypeTimestamp and TypeDate.
+func (d Duration) ConvertToTime(sc *stmtctx.StatementContext, tp uint8) (Time, error) {
+	year, month, day := gotime.Now().In(getTimeZone(sc)).Date()
+	datePart := FromDate(year, int(month), day, 0, 0, 0, 0)
+	mixDateAndDuration(&datePart, d)
+
+	t := NewTime(datePart, mysql.TypeDatetime, d.Fsp)
+	return t.Convert(sc, tp)
+}
+
+// mixDateAndDuration mixes a date value and a duration value.
+func mixDateAndDuration(date *CoreTime, dur Duration) {
+	if dur.Duration >= 0 && dur.Duration < GoDurationDay {
+		_, hh, mm, ss, frac := splitDuration(dur.Duration)
+		*date = FromDate(date.Year(), date.Month(), date.Day(), hh, mm, ss, frac)
+		return
+	}
+
+	// Add the duration directly, the duration may be negative or more than a day.
+	seconds, microseconds, _ := calcTimeDiff(*date, ZeroCoreTime, 1)
+	total := int64(seconds)*1e6 + int64(microseconds) + dur.Duration.Nanoseconds()/1000
+	if total < 0 {
+		total = 0
+	}
+	*date = calcTimeFromSec(int(total/1e6), int(total%1e6))
+}
+
+// RoundFrac rounds fractional seconds precision with new fsp and returns a new one.
+// We will use the “round half up” rule, e.g, >= 0.5 -> 1, < 0.5 -> 0,
+// so 10:10:10.999999 round 0 -> 10:10:11
+// and 10:10:10.000000 round 0 -> 10:10:10
+func (d Duration) RoundFrac(fsp int8) (Duration, error) {
+	fsp, err := CheckFsp(int(fsp))
+	if err != nil {
+		return d, errors.Trace(err)
+	}
+
+	if fsp == d.Fsp {
+		return d, nil
+	}
+
+	n := gotime.Date(0, 0, 0, 0, 0, 0, 0, gotime.Local)
+	nd := n.Add(d.Duration).Round(gotime.Duration(math.Pow10(9-int(fsp))) * gotime.Nanosecond).Sub(n)
+	return Duration{Duration: nd, Fsp: fsp}, nil
+}
+
+// Compare returns an integer comparing the Duration instant t to o.
+// If d is after o, returns 1, equal o, returns 0, before o, returns -1.
+func (d Duration) Compare(o Duration) int {
+	if d.Duration > o.Duration {
+		return 1
+	} else if d.Duration == o.Duration {
+		return 0
+	} else {
+		return -1
+	}
+}
+
+// CompareString is like Compare,
+// but parses str to Duration then compares.
+func (d Duration) CompareString(sc *stmtctx.StatementContext, str string) (int, error) {
+	// use MaxFsp to parse the string
+	o, err := ParseDuration(sc, str, MaxFsp)
+	if err != nil {
+		return 0, err
+	}
+
+	return d.Compare(o), nil
+}
+
+// Hour returns current hour.
+// e.g, hour("11:11:11") -> 11
+func (d Duration) Hour() int {
+	_, hour, _, _, _ := splitDuration(d.Duration)
+	return hour
+}
+
+// Minute returns current minute.
+// e.g, hour("11:11:11") -> 11
+func (d Duration) Minute() int {
+	_, _, minute, _, _ := splitDuration(d.Duration)
+	return minute
+}
+
+// Second returns current second.
+// e.g, hour("11:11:11") -> 11
+func (d Duration) Second() int {
+	_, _, _, second, _ := splitDuration(d.Duration)
+	return second
+}
+
+// MicroSecond returns current microsecond.
+// e.g, hour("11:11:11.11") -> 110000
+func (d Duration) MicroSecond() int {
+	_, _, _, _, frac := splitDuration(d.Duration)
+	return frac
+}
+
+// ParseDuration parses the time form a formatted string with a fractional seconds part,
+// returns the duration type Time value.
+// See http://dev.mysql.com/doc/refman/5.7/en/fractional-seconds.html
+func ParseDuration(sc *stmtctx.StatementContext, str string, fsp int8) (Duration, error) {
+	var (
+		day, hour, minute, second int
+		err                       error
+		sign                      = 0
+		dayExists                 = false
+		origStr                   = str
+	)
+	fsp, err = CheckFsp(int(fsp))
+	if err != nil {
+		return ZeroDuration, errors.Trace(err)
+	}
+
+	if len(str) == 0 {
+		return ZeroDuration, nil
+	} else if str[0] == '-' {
+		str = str[1:]
+		sign = -1
+	}
+
+	// Time format may has day.
+	if n := strings.IndexByte(str, ' '); n >= 0 {
+		if day, err = strconv.Atoi(str[:n]); err == nil {
+			dayExists = true
+		}
+		str = str[n+1:]
+	}
+
+	var (
+		integeralPart = str
+		fracPart      int
+		overflow      bool
+	)
+	if n := strings.IndexByte(str, '.'); n >= 0 {
+		// It has fractional precision parts.
+		fracStr := str[n+1:]
+		fracPart, overflow, err = ParseFrac(fracStr, fsp)
+		if err != nil {
+			return ZeroDuration, errors.Trace(err)
+		}
+		integeralPart = str[0:n]
+	}
+
+	// It tries to split integeralPart with delimiter, time delimiter must be :
+	seps := strings.Split(integeralPart, ":")
+
+	switch len(seps) {
+	case 1:
+		if dayExists {
+			hour, err = strconv.Atoi(seps[0])
+		} else {
+			// No delimiter.
+			switch len(integeralPart) {
+			case 7: // HHHMMSS
+				_, err = fmt.Sscanf(integeralPart, "%3d%2d%2d", &hour, &minute, &second)
+			case 6: // HHMMSS
+				_, err = fmt.Sscanf(integeralPart, "%2d%2d%2d", &hour, &minute, &second)
+			case 5: // HMMSS
+				_, err = fmt.Sscanf(integeralPart, "%1d%2d%2d", &hour, &minute, &second)
+			case 4: // MMSS
+				_, err = fmt.Sscanf(integeralPart, "%2d%2d", &minute, &second)
+			case 3: // MSS
+				_, err = fmt.Sscanf(integeralPart, "%1d%2d", &minute, &second)
+			case 2: // SS
+				_, err = fmt.Sscanf(integeralPart, "%2d", &second)
+			case 1: // 0S
+				_, err = fmt.Sscanf(integeralPart, "%1d", &second)
+			default: // Maybe contains date.
+				t, err1 := ParseTime(sc, origStr, mysql.TypeDatetime, GetFsp(origStr))
+				if err1 != nil {
+					return ZeroDuration, ErrTruncatedWrongVal.GenWithStackByArgs(TimeStr, origStr)
+				}
+				var dur Duration
+				dur, err1 = t.ConvertToDuration()
+				if err1 != nil {
+					return ZeroDuration, errors.Trace(err1)
+				}
+				return dur.RoundFrac(fsp)
+			}
+		}
+	case 2:
+		// HH:MM
+		_, err = fmt.Sscanf(integeralPart, "%d:%2d", &hour, &minute)
+	case 3:
+		// Time format maybe HH:MM:SS or HHH:MM:SS.
+		// See https://dev.mysql.com/doc/refman/5.7/en/time.html
+		_, err = fmt.Sscanf(integeralPart, "%d:%2d:%2d", &hour, &minute, &second)
+	default:
+		return ZeroDuration, ErrTruncatedWrongVal.GenWithStackByArgs(TimeStr, origStr)
+	}
+
+	if err != nil {
+		return ZeroDuration, ErrTruncatedWrongVal.GenWithStackByArgs(TimeStr, origStr)
+	}
+
+	if overflow {
+		second++
+		fracPart = 0
+	}
+	// Invalid TIME values are converted to '00:00:00'.
+	// See https://dev.mysql.com/doc/refman/5.7/en/time.html
+	if minute >= 60 || second > 60 || (!overflow && second == 60) {
+		return ZeroDuration, ErrTruncatedWrongVal.GenWithStackByArgs(TimeStr, origStr)
+	}
+	d := gotime.Duration(day*24*3600+hour*3600+minute*60+second)*gotime.Second + gotime.Duration(fracPart)*gotime.Microsecond
+	if sign == -1 {
+		d = -d
+	}
+
+	d, err = TruncateOverflowMySQLTime(d)
+	return Duration{Duration: d, Fsp: fsp}, errors.Trace(err)
+}
+
+// TruncateOverflowMySQLTime truncates d when it overflows, and returns ErrTruncatedWrongVal.
+func TruncateOverflowMySQLTime(d gotime.Duration) (gotime.Duration, error) {
+	if d > MaxTime {
+		return MaxTime, ErrTruncatedWrongVal.GenWithStackByArgs(TimeStr, d)
+	} else if d < MinTime {
+		return MinTime, ErrTruncatedWrongVal.GenWithStackByArgs(TimeStr, d)
+	}
+
+	return d, nil
+}
+
+func splitDuration(t gotime.Duration) (int, int, int, int, int) {
+	sign := 1
+	if t < 0 {
+		t = -t
+		sign = -1
+	}
+
+	hours := t / gotime.Hour
+	t -= hours * gotime.Hour
+	minutes := t / gotime.Minute
+	t -= minutes * gotime.Minute
+	seconds := t / gotime.Second
+	t -= seconds * gotime.Second
+	fraction := t / gotime.Microsecond
+
+	return sign, int(hours), int(minutes), int(seconds), int(fraction)
+}
+
+// NumberToDuration converts number to Duration.
+func NumberToDuration(number int64, fsp int8) (Duration, error) {
+	if number > TimeMaxValue {
+		// Try to check whether it's a datetime.
+		// See http://dev.mysql.com/doc/refman/5.7/en/time.html
+		if number >= 10000000000 { // '2001-00-00 00-00-00'
+			t, err := ParseDatetimeFromNum(nil, number)
+			if err == nil {
+				return t.ConvertToDuration()
+			}
+		}
+		return Duration{Duration: MaxTime, Fsp: fsp}, ErrWrongValue.GenWithStackByArgs(TimeStr, strconv.FormatInt(number, 10))
+	} else if number < -TimeMaxValue {
+		return Duration{Duration: MinTime, Fsp: fsp}, ErrWrongValue.GenWithStackByArgs(TimeStr, strconv.FormatInt(number, 10))
+	}
+	var neg bool
+	if neg = number < 0; neg {
+		number = -number
+	}
+
+	if number/10000 > TimeMaxHour || number%100 >= 60 || (number/100)%100 >= 60 {
+		return ZeroDuration, errors.Trace(ErrWrongValue.GenWithStackByArgs(TimeStr, strconv.FormatInt(number, 10)))
+	}
+	d := gotime.Duration(number/10000)*gotime.Hour + gotime.Duration((number/100)%100)*gotime.Minute + gotime.Duration(number%100)*gotime.Second
+	if neg {
+		d = -d
+	}
+	return Duration{Duration: d, Fsp: fsp}, nil
+}
+
+// CurrentTime returns current time with type tp.
+func CurrentTime(tp uint8) Time {
+	return NewTime(FromGoTime(gotime.Now()), tp, 0)
+}
+
+// isPunctuation checks whether c is a punctuation character that can be used as the date separator.
+func isPunctuation(c byte) bool {
+	return (c >= 0x21 && c <= 0x2F) || (c >= 0x3A && c <= 0x40) || (c >= 0x5B && c <= 0x60) || (c >= 0x7B && c <= 0x7E)
+}
+
+// isValidSeparator checks whether c is a valid separator after prevParts parts have been split.
+// The date and the time can also be separated by a space or a 'T'.
+func isValidSeparator(c byte, prevParts int) bool {
+	if isPunctuation(c) {
+		return true
+	}
+
+	return prevParts == 2 && (c == ' ' || c == 'T')
+}
+
+// ParseDateFormat parses a formatted date string and returns separated components.
+func ParseDateFormat(format string) []string {
+	format = strings.TrimSpace(format)
+
+	if len(format) == 0 {
+		return nil
+	}
+
+	// Date format must start and end with number.
+	if !isDigit(format[0]) || !isDigit(format[len(format)-1]) {
+		return nil
+	}
+
+	start := 0
+	// Initialize `seps` with capacity of 6. The input `format` is typically
+	// a date time of the form "2006-01-02 15:04:05", which has 6 numeric parts
+	// (the fractional second part is usually removed by `splitDateTime`).
+	// Setting `seps`'s capacity to 6 avoids reallocation in this common case.
+	seps := make([]string, 0, 6)
+
+	for i := 1; i < len(format)-1; i++ {
+		if isValidSeparator(format[i], len(seps)) {
+			prevParts := len(seps)
+			seps = append(seps, format[start:i])
+			start = i + 1
+
+			// consume further consecutive separators
+			for j := i + 1; j < len(format); j++ {
+				if !isValidSeparator(format[j], prevParts) {
+					break
+				}
+
+				start++
+				i++
+			}
+
+			continue
+		}
+
+		if !isDigit(format[i]) {
+			return nil
+		}
+	}
+
+	seps = append(seps, format[start:])
+	return seps
+}
+
+// IsDateFormat returns true when the specified time format could contain only date.
+func IsDateFormat(format string) bool {
+	format = strings.TrimSpace(format)
+	seps := ParseDateFormat(format)
+	length := len(format)
+	switch len(seps) {
+	case 1:
+		if (length == 8) || (length == 6) {
+			return true
+		}
+	case 3:
+		return true
+	}
+	return false
+}
+
+// GetFracIndex finds the last '.' for get fracStr, index = -1 means fracStr not found.
+// but for format like '2019.01.01 00:00:00', the index should be -1.
+func GetFracIndex(s string) (index int) {
+	index = -1
+	for i := len(s) - 1; i >= 0; i-- {
+		if isPunctuation(s[i]) {
+			if s[i] == '.' {
+				index = i
+			}
+			break
+		}
+	}
+
+	return index
+}
+
+// splitDateTime splits the datetime string into the separated parts and the fractional part.
+func splitDateTime(format string) (seps []string, fracStr string) {
+	index := GetFracIndex(format)
+	if index > 0 {
+		fracStr = format[index+1:]
+		format = format[:index]
+	}
+
+	seps = ParseDateFormat(format)
+	return
+}
+
+// adjustYear adjusts year according to y.
+// See https://dev.mysql.com/doc/refman/5.7/en/two-digit-years.html
+func adjustYear(y int) int {
+	if y >= 0 && y <= 69 {
+		y = 2000 + y
+	} else if y >= 70 && y <= 99 {
+		y = 1900 + y
+	}
+	return y
+}
+
+// parseDatetime parses the datetime string, isFloat indicates whether the string
+// comes from a float, whose fractional part should be discarded for a date like 20170118.123.
+func parseDatetime(sc *stmtctx.StatementContext, str string, fsp int8, isFloat bool) (Time, error) {
+	var (
+		year, month, day, hour, minute, second int
+		fracStr                                string
+		hhmmss                                 bool
+		err                                    error
+	)
+
+	seps, fracStr := splitDateTime(str)
+	switch len(seps) {
+	case 1:
+		l := len(seps[0])
+		switch l {
+		case 14: // No delimiter.
+			// YYYYMMDDHHMMSS
+			_, err = fmt.Sscanf(seps[0], "%4d%2d%2d%2d%2d%2d", &year, &month, &day, &hour, &minute, &second)
+			hhmmss = true
+		case 12: // YYMMDDHHMMSS
+			_, err = fmt.Sscanf(seps[0], "%2d%2d%2d%2d%2d%2d", &year, &month, &day, &hour, &minute, &second)
+			year = adjustYear(year)
+			hhmmss = true
+		case 10: // YYMMDDHHMM
+			_, err = fmt.Sscanf(seps[0], "%2d%2d%2d%2d%2d", &year, &month, &day, &hour, &minute)
+			year = adjustYear(year)
+		case 8: // YYYYMMDD
+			_, err = fmt.Sscanf(seps[0], "%4d%2d%2d", &year, &month, &day)
+		case 6, 5:
+			// YYMMDD && YYMMD
+			_, err = fmt.Sscanf(seps[0], "%2d%2d%2d", &year, &month, &day)
+			year = adjustYear(year)
+		default:
+			return ZeroDatetime, errors.Trace(ErrWrongValue.GenWithStackByArgs(TimeStr, str))
+		}
+		if l == 5 || l == 6 || l == 8 {
+			// YYMMDD or YYYYMMDD
+			// We must handle float => string => datetime, the difference is that fractional
+			// part of float type is discarded directly, while fractional part of string type
+			// is parsed to HH:MM:SS.
+			if !isFloat {
+				// '20170118.123423' => 2017-01-18 12:34:23
+				switch len(fracStr) {
+				case 0:
+				case 1, 2:
+					_, err = fmt.Sscanf(fracStr, "%2d ", &hour)
+				case 3, 4:
+					_, err = fmt.Sscanf(fracStr, "%2d%2d ", &hour, &minute)
+				default:
+					_, err = fmt.Sscanf(fracStr, "%2d%2d%2d ", &hour, &minute, &second)
+				}
+			}
+			// The fractional part has been consumed.
+			fracStr = ""
+		}
+		if l == 10 {
+			if len(fracStr) == 0 {
+				second = 0
+			} else {
+				_, err = fmt.Sscanf(fracStr, "%2d ", &second)
+			}
+			fracStr = ""
+		}
+		if err != nil {
+			if sc == nil {
+				return ZeroDatetime, errors.Trace(ErrWrongValue.GenWithStackByArgs(TimeStr, str))
+			}
+			sc.AppendWarning(ErrTruncatedWrongVal.GenWithStackByArgs("datetime", str))
+			err = nil
+		}
+	case 3:
+		// YYYY-MM-DD
+		err = scanTimeArgs(seps, &year, &month, &day)
+	case 4:
+		// YYYY-MM-DD HH
+		err = scanTimeArgs(seps, &year, &month, &day, &hour)
+	case 5:
+		// YYYY-MM-DD HH-MM
+		err = scanTimeArgs(seps, &year, &month, &day, &hour, &minute)
+	case 6:
+		// We don't have fractional seconds part.
+		// YYYY-MM-DD HH-MM-SS
+		err = scanTimeArgs(seps, &year, &month, &day, &hour, &minute, &second)
+		hhmmss = true
+	default:
+		return ZeroDatetime, errors.Trace(ErrWrongValue.GenWithStackByArgs(TimeStr, str))
+	}
+	if err != nil {
+		return ZeroDatetime, errors.Trace(err)
+	}
+
+	// If str is sepereated by delimiters, the first one is year, and if the year is 2 digit,
+	// we should adjust it.
+	// TODO: adjust year is very complex, now we only consider the simplest way.
+	if len(seps[0]) == 2 {
+		if year == 0 && month == 0 && day == 0 && hour == 0 && minute == 0 && second == 0 && fracStr == "" {
+			// Skip a special case "00-00-00".
+		} else {
+			year = adjustYear(year)
+		}
+	}
+
+	var microsecond int
+	var overflow bool
+	if hhmmss {
+		// If input string is "20170118.999", without hhmmss, fsp is meaningless.
+		microsecond, overflow, err = ParseFrac(fracStr, fsp)
+		if err != nil {
+			return ZeroDatetime, errors.Trace(err)
+		}
+	}
+
+	tmp, ok := FromDateChecked(year, month, day, hour, minute, second, microsecond)
+	if !ok {
+		return ZeroDatetime, errors.Trace(ErrWrongValue.GenWithStackByArgs(TimeStr, str))
+	}
+	if overflow {
+		// Convert to Go time and add 1 second, to handle input like 2017-01-05 08:40:59.575601
+		t1, err := tmp.GoTime(gotime.Local)
+		if err != nil {
+			return ZeroDatetime, errors.Trace(err)
+		}
+		tmp = FromGoTime(t1.Add(gotime.Second))
+	}
+	return NewTime(tmp, mysql.TypeDatetime, fsp), nil
+}
+
+func scanTimeArgs(seps []string, args ...*int) error {
+	if len(seps) != len(args) {
+		return errors.Trace(ErrWrongValue.GenWithStackByArgs(TimeStr, strings.Join(seps, "-")))
+	}
+
+	var err error
+	for i, s := range seps {
+		*args[i], err = strconv.Atoi(s)
+		if err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+// FromDateChecked makes a internal time representation from the given date,
+// the ranges of the date fields are checked.
+func FromDateChecked(year, month, day, hour, minute, second, microsecond int) (CoreTime, bool) {
+	if year < 0 || year > 9999 || month < 0 || month > 12 || day < 0 || day > 31 ||
+		hour < 0 || hour > 23 || minute < 0 || minute > 59 || second < 0 || second > 59 ||
+		microsecond < 0 || microsecond > 999999 {
+		return ZeroCoreTime, false
+	}
+	return FromDate(year, month, day, hour, minute, second, microsecond), true
+}
+
+// ParseDatetime is a helper function wrapping ParseTime with datetime type and default fsp.
+func ParseDatetime(sc *stmtctx.StatementContext, str string) (Time, error) {
+	return ParseTime(sc, str, mysql.TypeDatetime, DefaultFsp)
+}
+
+// ParseTimestamp is a helper function wrapping ParseTime with timestamp type and default fsp.
+func ParseTimestamp(sc *stmtctx.StatementContext, str string) (Time, error) {
+	return ParseTime(sc, str, mysql.TypeTimestamp, DefaultFsp)
+}
+
+// ParseDate is a helper function wrapping ParseTime with date type.
+func ParseDate(sc *stmtctx.StatementContext, str string) (Time, error) {
+	// date has no fractional seconds precision
+	return ParseTime(sc, str, mysql.TypeDate, MinFsp)
+}
+
+// GetFsp gets the fsp of a string.
+func GetFsp(s string) int8 {
+	index := GetFracIndex(s)
+	var fsp int
+	if index < 0 {
+		fsp = 0
+	} else {
+		fsp = len(s) - index - 1
+	}
+
+	if fsp == len(s) {
+		fsp = 0
+	} else if fsp > 6 {
+		fsp = 6
+	}
+	return int8(fsp)
+}
+
+// ParseTime parses a formatted string with type tp and specific fsp.
+// Type is TypeDatetime, TypeTimestamp and TypeDate.
+// Fsp is in range [0, 6].
+// MySQL supports many valid datetime format, but still has some limitation.
+// If delimiter exists, the date part and time part is separated by a space or T,
+// other punctuation character can be used as the delimiter between date parts or time parts.
+// If no delimiter, the format must be YYYYMMDDHHMMSS or YYMMDDHHMMSS
+// If we have fractional seconds part, we must use decimal points as the delimiter.
+// The valid datetime range is from '1000-01-01 00:00:00.000000' to '9999-12-31 23:59:59.999999'.
+// The valid timestamp range is from '1970-01-01 00:00:01.000000' to '2038-01-19 03:14:07.999999'.
+// The valid date range is from '1000-01-01' to '9999-12-31'
+func ParseTime(sc *stmtctx.StatementContext, str string, tp byte, fsp int8) (Time, error) {
+	return parseTime(sc, str, tp, fsp, false)
+}
+
+// ParseTimeFromFloatString is similar to ParseTime, except that it's used to parse a float converted string.
+func ParseTimeFromFloatString(sc *stmtctx.StatementContext, str string, tp byte, fsp int8) (Time, error) {
+	return parseTime(sc, str, tp, fsp, true)
+}
+
+func parseTime(sc *stmtctx.StatementContext, str string, tp byte, fsp int8, isFloat bool) (Time, error) {
+	fsp, err := CheckFsp(int(fsp))
+	if err != nil {
+		return NewTime(ZeroCoreTime, tp, DefaultFsp), errors.Trace(err)
+	}
+
+	t, err := parseDatetime(sc, strings.TrimSpace(str), fsp, isFloat)
+	if err != nil {
+		return NewTime(ZeroCoreTime, tp, DefaultFsp), errors.Trace(err)
+	}
+
+	t.SetType(tp)
+	if err = t.Check(sc); err != nil {
+		return NewTime(ZeroCoreTime, tp, DefaultFsp), errors.Trace(err)
+	}
+	return t, nil
+}
+
+// ParseDatetimeFromNum is a helper function wrapping ParseTimeFromNum with datetime type and default fsp.
+func ParseDatetimeFromNum(sc *stmtctx.StatementContext, num int64) (Time, error) {
+	return ParseTimeFromNum(sc, num, mysql.TypeDatetime, DefaultFsp)
+}
+
+// ParseTimestampFromNum is a helper function wrapping ParseTimeFromNum with timestamp type and default fsp.
+func ParseTimestampFromNum(sc *stmtctx.StatementContext, num int64) (Time, error) {
+	return ParseTimeFromNum(sc, num, mysql.TypeTimestamp, DefaultFsp)
+}
+
+// ParseDateFromNum is a helper function wrapping ParseTimeFromNum with date type.
+func ParseDateFromNum(sc *stmtctx.StatementContext, num int64) (Time, error) {
+	// date has no fractional seconds precision
+	return ParseTimeFromNum(sc, num, mysql.TypeDate, MinFsp)
+}
+
+// ParseTimeFromNum parses a formatted int64,
+// returns the value which type is tp.
+func ParseTimeFromNum(sc *stmtctx.StatementContext, num int64, tp byte, fsp int8) (Time, error) {
+	fsp, err := CheckFsp(int(fsp))
+	if err != nil {
+		return NewTime(ZeroCoreTime, tp, DefaultFsp), errors.Trace(err)
+	}
+
+	t, err := parseDateTimeFromNum(sc, num)
+	if err != nil {
+		return NewTime(ZeroCoreTime, tp, DefaultFsp), errors.Trace(err)
+	}
+
+	t.SetType(tp)
+	t.SetFsp(fsp)
+	if err := t.Check(sc); err != nil {
+		return NewTime(ZeroCoreTime, tp, DefaultFsp), errors.Trace(err)
+	}
+	return t, nil
+}
+
+// parseDateTimeFromNum parses date time from num.
+// See number_to_datetime function.
+// https://github.com/mysql/mysql-server/blob/5.7/sql-common/my_time.c
+func parseDateTimeFromNum(sc *stmtctx.StatementContext, num int64) (Time, error) {
+	t := ZeroDatetime
+	// Check zero.
+	if num == 0 {
+		return t, nil
+	}
+
+	// Check datetime type.
+	if num >= 10000101000000 {
+		t.SetType(mysql.TypeDatetime)
+		return getTime(sc, num, t.Type())
+	}
+
+	// Check MMDD.
+	if num < 101 {
+		return t, errors.Trace(ErrWrongValue.GenWithStackByArgs(TimeStr, strconv.FormatInt(num, 10)))
+	}
+
+	// Adjust year
+	// YYMMDD, year: 2000-2069
+	if num <= (70-1)*10000+1231 {
+		num = (num + 20000000) * 1000000
+		return getTime(sc, num, t.Type())
+	}
+
+	// Check YYMMDD.
+	if num < 70*10000+101 {
+		return t, errors.Trace(ErrWrongValue.GenWithStackByArgs(TimeStr, strconv.FormatInt(num, 10)))
+	}
+
+	// Adjust year
+	// YYMMDD, year: 1970-1999
+	if num <= 991231 {
+		num = (num + 19000000) * 1000000
+		return getTime(sc, num, t.Type())
+	}
+
+	// Adjust hour/min/second.
+	if num <= 99991231 {
+		num = num * 1000000
+		return getTime(sc, num, t.Type())
+	}
+
+	// Check MMDDHHMMSS.
+	if num < 101000000 {
+		return t, errors.Trace(ErrWrongValue.GenWithStackByArgs(TimeStr, strconv.FormatInt(num, 10)))
+	}
+
+	// Set TypeDatetime type.
+	t.SetType(mysql.TypeDatetime)
+
+	// Adjust year
+	// YYMMDDHHMMSS, 2000-2069
+	if num <= 69*10000000000+1231235959 {
+		num = num + 20000000000000
+		return getTime(sc, num, t.Type())
+	}
+
+	// Check YYYYMMDDHHMMSS.
+	if num < 70*10000000000+101000000 {
+		return t, errors.Trace(ErrWrongValue.GenWithStackByArgs(TimeStr, strconv.FormatInt(num, 10)))
+	}
+
+	// Adjust year
+	// YYMMDDHHMMSS, 1970-1999
+	if num <= 991231235959 {
+		num = num + 19000000000000
+		return getTime(sc, num, t.Type())
+	}
+
+	return getTime(sc, num, t.Type())
+}
+
+// getTime gets a Time from a num like YYYYMMDDHHMMSS.
+func getTime(sc *stmtctx.StatementContext, num int64, tp byte) (Time, error) {
+	s1 := num / 1000000
+	s2 := num - s1*1000000
+
+	year := int(s1 / 10000)
+	s1 %= 10000
+	month := int(s1 / 100)
+	day := int(s1 % 100)
+
+	hour := int(s2 / 10000)
+	s2 %= 10000
+	minute := int(s2 / 100)
+	second := int(s2 % 100)
+
+	ct, ok := FromDateChecked(year, month, day, hour, minute, second, 0)
+	if !ok {
+		return ZeroDatetime, errors.Trace(ErrWrongValue.GenWithStackByArgs(TimeStr, strconv.FormatInt(num, 10)))
+	}
+	t := NewTime(ct, tp, DefaultFsp)
+	err := t.Check(sc)
+	return t, errors.Trace(err)
+}
+
+// IsClockUnit returns true when unit is interval unit with hour, minute or second.
+func IsClockUnit(unit string) bool {
+	switch strings.ToUpper(unit) {
+	case "MICROSECOND", "SECOND", "MINUTE", "HOUR",
+		"SECOND_MICROSECOND", "MINUTE_MICROSECOND", "MINUTE_SECOND",
+		"HOUR_MICROSECOND", "HOUR_SECOND", "HOUR_MINUTE",
+		"DAY_MICROSECOND", "DAY_SECOND", "DAY_MINUTE", "DAY_HOUR":
+		return true
+	default:
+		return false
+	}
+}
+
+// IsDateUnit returns true when unit is interval unit with year, quarter, month, week or day.
+func IsDateUnit(unit string) bool {
+	switch strings.ToUpper(unit) {
+	case "DAY", "WEEK", "MONTH", "QUARTER", "YEAR",
+		"DAY_MICROSECOND", "DAY_SECOND", "DAY_MINUTE", "DAY_HOUR",
+		"YEAR_MONTH":
+		return true
+	default:
+		return false
+	}
+}
+
+// ExtractDatetimeNum extracts time value number from datetime unit and format.
+func ExtractDatetimeNum(t *Time, unit string) (int64, error) {
+	// TODO: Consider time_zone variable.
+	switch strings.ToUpper(unit) {
+	case "DAY":
+		return int64(t.Day()), nil
+	case "WEEK":
+		return int64(t.coreTime.Week()), nil
+	case "MONTH":
+		return int64(t.Month()), nil
+	case "QUARTER":
+		m := int64(t.Month())
+		// 1 - 3 -> 1
+		// 4 - 6 -> 2
+		// 7 - 9 -> 3
+		// 10 - 12 -> 4
+		return (m + 2) / 3, nil
+	case "YEAR":
+		return int64(t.Year()), nil
+	case "DAY_MICROSECOND":
+		h, m, s := t.Hour(), t.Minute(), t.Second()
+		d := t.Day()
+		return int64(d*1000000+h*10000+m*100+s)*1000000 + int64(t.Microsecond()), nil
+	case "DAY_SECOND":
+		h, m, s := t.Hour(), t.Minute(), t.Second()
+		d := t.Day()
+		return int64(d)*1000000 + int64(h)*10000 + int64(m)*100 + int64(s), nil
+	case "DAY_MINUTE":
+		h, m := t.Hour(), t.Minute()
+		d := t.Day()
+		return int64(d)*10000 + int64(h)*100 + int64(m), nil
+	case "DAY_HOUR":
+		h := t.Hour()
+		d := t.Day()
+		return int64(d)*100 + int64(h), nil
+	case "YEAR_MONTH":
+		y, m := t.Year(), t.Month()
+		return int64(y)*100 + int64(m), nil
+	default:
+		return 0, errors.Errorf("invalid unit %v", unit)
+	}
+}
+
+// ExtractDurationNum extracts duration value number from duration unit and format.
+func ExtractDurationNum(d *Duration, unit string) (int64, error) {
+	var result int64
+	switch strings.ToUpper(unit) {
+	case "MICROSECOND":
+		result = int64(d.MicroSecond())
+	case "SECOND":
+		result = int64(d.Second())
+	case "MINUTE":
+		result = int64(d.Minute())
+	case "HOUR":
+		result = int64(d.Hour())
+	case "SECOND_MICROSECOND":
+		result = int64(d.Second())*1000000 + int64(d.MicroSecond())
+	case "MINUTE_MICROSECOND":
+		result = int64(d.Minute())*100000000 + int64(d.Second())*1000000 + int64(d.MicroSecond())
+	case "MINUTE_SECOND":
+		result = int64(d.Minute()*100 + d.Second())
+	case "HOUR_MICROSECOND":
+		result = int64(d.Hour())*10000000000 + int64(d.Minute())*100000000 + int64(d.Second())*1000000 + int64(d.MicroSecond())
+	case "HOUR_SECOND":
+		result = int64(d.Hour())*10000 + int64(d.Minute())*100 + int64(d.Second())
+	case "HOUR_MINUTE":
+		result = int64(d.Hour())*100 + int64(d.Minute())
+	case "DAY_MICROSECOND":
+		result = int64(d.Hour()*10000+d.Minute()*100+d.Second())*1000000 + int64(d.MicroSecond())
+	case "DAY_SECOND":
+		result = int64(d.Hour()*10000 + d.Minute()*100 + d.Second())
+	case "DAY_MINUTE":
+		result = int64(d.Hour()*100 + d.Minute())
+	case "DAY_HOUR":
+		result = int64(d.Hour())
+	default:
+		return 0, errors.Errorf("invalid unit %v", unit)
+	}
+	if d.Duration < 0 {
+		return -result, nil
+	}
+	return result, nil
+}
+
+// intervalUnitFields is the number of fields of the compound interval units,
+// e.g. 'HOUR_SECOND' expects a value like 'HH:MM:SS'.
+var intervalUnitFields = map[string]int{
+	"SECOND_MICROSECOND": 2,
+	"MINUTE_MICROSECOND": 3,
+	"MINUTE_SECOND":      2,
+	"HOUR_MICROSECOND":   4,
+	"HOUR_SECOND":        3,
+	"HOUR_MINUTE":        2,
+	"DAY_MICROSECOND":    5,
+	"DAY_SECOND":         4,
+	"DAY_MINUTE":         3,
+	"DAY_HOUR":           2,
+	"YEAR_MONTH":         2,
+}
+
+// parseSingleTimeValue parses the value of a single interval unit like 'DAY',
+// returns the year, month, day and nanosecond parts of the interval.
+func parseSingleTimeValue(unit string, format string) (int64, int64, int64, int64, error) {
+	// Format is a preformatted number, it format should be A[.[B]].
+	decimalPointPos := strings.IndexRune(format, '.')
+	if decimalPointPos == -1 {
+		decimalPointPos = len(format)
+	}
+	sign := int64(1)
+	if len(format) > 0 && format[0] == '-' {
+		sign = int64(-1)
+	}
+	iv, err := strconv.ParseInt(format[0:decimalPointPos], 10, 64)
+	if err != nil {
+		return 0, 0, 0, 0, ErrWrongValue.GenWithStackByArgs(DateTimeStr, format)
+	}
+	riv := iv // Rounded integer value
+
+	dv := int64(0)
+	lf := len(format) - 1
+	// Has fraction part
+	if decimalPointPos < lf {
+		dvStr := format[decimalPointPos+1:]
+		if len(dvStr) > 6 {
+			// MySQL rounds down to 1e-6.
+			dvStr = dvStr[:6]
+		}
+		if dv, err = strconv.ParseInt(dvStr+"000000"[:6-len(dvStr)], 10, 64); err != nil {
+			return 0, 0, 0, 0, ErrWrongValue.GenWithStackByArgs(DateTimeStr, format)
+		}
+		if dv >= 500000 { // Round up, and we should keep 6 digits for microsecond, so dv should in [000000, 999999].
+			riv += sign
+		}
+		dv *= sign
+	}
+
+	switch strings.ToUpper(unit) {
+	case "MICROSECOND":
+		dayCount := riv / int64(GoDurationDay/gotime.Microsecond)
+		riv %= int64(GoDurationDay / gotime.Microsecond)
+		return 0, 0, dayCount, riv * int64(gotime.Microsecond), nil
+	case "SECOND":
+		dayCount := iv / int64(GoDurationDay/gotime.Second)
+		iv %= int64(GoDurationDay / gotime.Second)
+		return 0, 0, dayCount, iv*int64(gotime.Second) + dv*int64(gotime.Microsecond), nil
+	case "MINUTE":
+		dayCount := riv / int64(GoDurationDay/gotime.Minute)
+		riv %= int64(GoDurationDay / gotime.Minute)
+		return 0, 0, dayCount, riv * int64(gotime.Minute), nil
+	case "HOUR":
+		dayCount := riv / 24
+		riv %= 24
+		return 0, 0, dayCount, riv * int64(gotime.Hour), nil
+	case "DAY":
+		return 0, 0, riv, 0, nil
+	case "WEEK":
+		return 0, 0, 7 * riv, 0, nil
+	case "MONTH":
+		return 0, riv, 0, 0, nil
+	case "QUARTER":
+		return 0, 3 * riv, 0, 0, nil
+	case "YEAR":
+		return riv, 0, 0, 0, nil
+	}
+
+	return 0, 0, 0, 0, errors.Errorf("invalid single timeunit - %s", unit)
+}
+
+// parseTimeValue parses the value of a compound interval unit like 'DAY_SECOND',
+// the value is split into digit groups which are filled from the right, so the
+// leftmost fields are treated as 0 if the value is too short.
+func parseTimeValue(unit string, format string, cnt int) ([]int64, bool, error) {
+	format = strings.TrimSpace(format)
+	neg := strings.HasPrefix(format, "-")
+	if neg {
+		format = format[1:]
+	}
+
+	var fields []string
+	start := -1
+	for i := 0; i < len(format); i++ {
+		if isDigit(format[i]) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		if start >= 0 {
+			fields = append(fields, format[start:i])
+			start = -1
+		}
+	}
+	if start >= 0 {
+		fields = append(fields, format[start:])
+	}
+	if len(fields) == 0 || len(fields) > cnt {
+		return nil, false, ErrWrongValue.GenWithStackByArgs(DateTimeStr, format)
+	}
+
+	values := make([]int64, cnt)
+	offset := cnt - len(fields)
+	for i, field := range fields {
+		if strings.HasSuffix(unit, "MICROSECOND") && offset+i == cnt-1 && len(fields) > 1 {
+			// The microsecond part is the fraction of the second, pad it to 6 digits.
+			if len(field) > 6 {
+				field = field[:6]
+			}
+			field += "000000"[:6-len(field)]
+		}
+		v, err := strconv.ParseInt(field, 10, 64)
+		if err != nil {
+			return nil, false, ErrWrongValue.GenWithStackByArgs(DateTimeStr, format)
+		}
+		values[offset+i] = v
+	}
+	return values, neg, nil
+}
+
+// ParseDurationValue parses the value of an interval with the unit, returns the year,
+// month, day and nanosecond parts of the interval.
+// See https://dev.mysql.com/doc/refman/5.7/en/date-and-time-functions.html#function_date-add
+func ParseDurationValue(unit string, format string) (y int64, m int64, d int64, n int64, _ error) {
+	unit = strings.ToUpper(unit)
+	cnt, ok := intervalUnitFields[unit]
+	if !ok {
+		return parseSingleTimeValue(unit, strings.TrimSpace(format))
+	}
+
+	values, neg, err := parseTimeValue(unit, format, cnt)
+	if err != nil {
+		return 0, 0, 0, 0, err
+	}
+	var dur gotime.Duration
+	switch unit {
+	case "SECOND_MICROSECOND":
+		dur = gotime.Duration(values[0])*gotime.Second + gotime.Duration(values[1])*gotime.Microsecond
+	case "MINUTE_MICROSECOND":
+		dur = gotime.Duration(values[0])*gotime.Minute + gotime.Duration(values[1])*gotime.Second + gotime.Duration(values[2])*gotime.Microsecond
+	case "MINUTE_SECOND":
+		dur = gotime.Duration(values[0])*gotime.Minute + gotime.Duration(values[1])*gotime.Second
+	case "HOUR_MICROSECOND":
+		dur = gotime.Duration(values[0])*gotime.Hour + gotime.Duration(values[1])*gotime.Minute + gotime.Duration(values[2])*gotime.Second + gotime.Duration(values[3])*gotime.Microsecond
+	case "HOUR_SECOND":
+		dur = gotime.Duration(values[0])*gotime.Hour + gotime.Duration(values[1])*gotime.Minute + gotime.Duration(values[2])*gotime.Second
+	case "HOUR_MINUTE":
+		dur = gotime.Duration(values[0])*gotime.Hour + gotime.Duration(values[1])*gotime.Minute
+	case "DAY_MICROSECOND":
+		d = values[0]
+		dur = gotime.Duration(values[1])*gotime.Hour + gotime.Duration(values[2])*gotime.Minute + gotime.Duration(values[3])*gotime.Second + gotime.Duration(values[4])*gotime.Microsecond
+	case "DAY_SECOND":
+		d = values[0]
+		dur = gotime.Duration(values[1])*gotime.Hour + gotime.Duration(values[2])*gotime.Minute + gotime.Duration(values[3])*gotime.Second
+	case "DAY_MINUTE":
+		d = values[0]
+		dur = gotime.Duration(values[1])*gotime.Hour + gotime.Duration(values[2])*gotime.Minute
+	case "DAY_HOUR":
+		d = values[0]
+		dur = gotime.Duration(values[1]) * gotime.Hour
+	case "YEAR_MONTH":
+		y, m = values[0], values[1]
+	}
+	d += int64(dur / GoDurationDay)
+	n = int64(dur % GoDurationDay)
+	if neg {
+		y, m, d, n = -y, -m, -d, -n
+	}
+	return y, m, d, n, nil
+}
@@ -0,0 +1,294 @@
+// Copyright 2015 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	gotime "time"
+
+	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/sessionctx/stmtctx"
+)
+
+var _ = Suite(&testTimeSuite{})
+
+type testTimeSuite struct {
+}
+
+func (s *testTimeSuite) TestDateTime(c *C) {
+	sc := &stmtctx.StatementContext{TimeZone: gotime.UTC}
+	table := []struct {
+		Input  string
+		Expect string
+	}{
+		{"2012-12-31 11:30:45", "2012-12-31 11:30:45"},
+		{"12-12-31 11:30:45", "2012-12-31 11:30:45"},
+		{"2012-12-31T11:30:45", "2012-12-31 11:30:45"},
+		{"2012.12.31 11:30:45", "2012-12-31 11:30:45"},
+		{"20121231113045", "2012-12-31 11:30:45"},
+		{"121231113045", "2012-12-31 11:30:45"},
+		{"2012-12-31", "2012-12-31 00:00:00"},
+		{"20121231", "2012-12-31 00:00:00"},
+		{"2020-02-29", "2020-02-29 00:00:00"},
+		{"0000-00-00 00:00:00", "0000-00-00 00:00:00"},
+		{"2017-01-05 08:40:59.575601", "2017-01-05 08:41:00"},
+	}
+	for _, test := range table {
+		t, err := ParseDatetime(sc, test.Input)
+		c.Assert(err, IsNil)
+		c.Assert(t.String(), Equals, test.Expect)
+		c.Assert(t.Type(), Equals, mysql.TypeDatetime)
+	}
+
+	fspTbl := []struct {
+		Input  string
+		Fsp    int8
+		Expect string
+	}{
+		{"2012-12-31 11:30:45.123456", 6, "2012-12-31 11:30:45.123456"},
+		{"2012-12-31 11:30:45.123456", 3, "2012-12-31 11:30:45.123"},
+		{"2012-12-31 11:30:45.1235", 3, "2012-12-31 11:30:45.124"},
+		{"2012-12-31 11:30:45", 2, "2012-12-31 11:30:45.00"},
+	}
+	for _, test := range fspTbl {
+		t, err := ParseTime(sc, test.Input, mysql.TypeDatetime, test.Fsp)
+		c.Assert(err, IsNil)
+		c.Assert(t.String(), Equals, test.Expect)
+		c.Assert(t.Fsp(), Equals, test.Fsp)
+	}
+
+	errTable := []string{
+		"2019-02-29",
+		"2012-13-01",
+		"2012-12-31 25:00:00",
+		"abc",
+	}
+	for _, test := range errTable {
+		_, err := ParseDatetime(sc, test)
+		c.Assert(err, NotNil, Commentf("%s", test))
+	}
+}
+
+func (s *testTimeSuite) TestDateAndTimestamp(c *C) {
+	sc := &stmtctx.StatementContext{TimeZone: gotime.UTC}
+	t, err := ParseDate(sc, "2012-12-31 11:30:45")
+	c.Assert(err, IsNil)
+	c.Assert(t.String(), Equals, "2012-12-31")
+	c.Assert(t.Type(), Equals, mysql.TypeDate)
+	c.Assert(t.Fsp(), Equals, int8(0))
+
+	t, err = ParseTimestamp(sc, "2038-01-19 03:14:07")
+	c.Assert(err, IsNil)
+	c.Assert(t.Type(), Equals, mysql.TypeTimestamp)
+
+	_, err = ParseTimestamp(sc, "1969-12-31 00:00:00")
+	c.Assert(err, NotNil)
+
+	t, err = ParseTimeFromNum(sc, 20121231113045, mysql.TypeDatetime, 0)
+	c.Assert(err, IsNil)
+	c.Assert(t.String(), Equals, "2012-12-31 11:30:45")
+	t, err = ParseTimeFromNum(sc, 121231, mysql.TypeDate, 0)
+	c.Assert(err, IsNil)
+	c.Assert(t.String(), Equals, "2012-12-31")
+}
+
+func (s *testTimeSuite) TestDuration(c *C) {
+	sc := &stmtctx.StatementContext{TimeZone: gotime.UTC}
+	table := []struct {
+		Input  string
+		Fsp    int8
+		Expect string
+	}{
+		{"10:11:12", 0, "10:11:12"},
+		{"101112", 0, "10:11:12"},
+		{"-10:11:12.5", 1, "-10:11:12.5"},
+		{"1 10:11:12", 0, "34:11:12"},
+		{"11:30", 0, "11:30:00"},
+		{"12", 0, "00:00:12"},
+		{"838:59:59", 0, "838:59:59"},
+		{"2012-12-31 11:30:45", 0, "11:30:45"},
+	}
+	for _, test := range table {
+		d, err := ParseDuration(sc, test.Input, test.Fsp)
+		c.Assert(err, IsNil)
+		c.Assert(d.String(), Equals, test.Expect)
+	}
+
+	_, err := ParseDuration(sc, "839:00:00", 0)
+	c.Assert(err, NotNil)
+
+	d, err := NumberToDuration(-101112, 0)
+	c.Assert(err, IsNil)
+	c.Assert(d.String(), Equals, "-10:11:12")
+	c.Assert(d.ToInt64(), Equals, int64(-101112))
+}
+
+func (s *testTimeSuite) TestCompareAndPack(c *C) {
+	sc := &stmtctx.StatementContext{TimeZone: gotime.UTC}
+	a, err := ParseDatetime(sc, "2012-12-31 11:30:45")
+	c.Assert(err, IsNil)
+	b, err := ParseDatetime(sc, "2013-01-01 00:00:00")
+	c.Assert(err, IsNil)
+	c.Assert(a.Compare(b), Equals, -1)
+	c.Assert(b.Compare(a), Equals, 1)
+	c.Assert(a.Compare(a), Equals, 0)
+
+	ret, err := a.CompareString(sc, "2012-12-31 11:30:45")
+	c.Assert(err, IsNil)
+	c.Assert(ret, Equals, 0)
+
+	pa, err := a.ToPackedUint()
+	c.Assert(err, IsNil)
+	pb, err := b.ToPackedUint()
+	c.Assert(err, IsNil)
+	c.Assert(pa < pb, IsTrue)
+
+	t, err := ParseTime(sc, "2012-12-31 11:30:45.123", mysql.TypeDatetime, 3)
+	c.Assert(err, IsNil)
+	packed, err := t.ToPackedUint()
+	c.Assert(err, IsNil)
+	t1 := NewTime(ZeroCoreTime, mysql.TypeDatetime, 3)
+	c.Assert(t1.FromPackedUint(packed), IsNil)
+	c.Assert(t1.Compare(t), Equals, 0)
+	c.Assert(t1.String(), Equals, t.String())
+
+	c.Assert(a.ToInt64(), Equals, int64(20121231113045))
+	c.Assert(a.ToFloat64(), Equals, float64(20121231113045))
+
+	t, err = t.RoundFrac(sc, 0)
+	c.Assert(err, IsNil)
+	c.Assert(t.String(), Equals, "2012-12-31 11:30:45")
+}
+
+func (s *testTimeSuite) TestTimeArithmetic(c *C) {
+	sc := &stmtctx.StatementContext{TimeZone: gotime.UTC}
+	a, err := ParseDatetime(sc, "2012-12-31 11:30:45")
+	c.Assert(err, IsNil)
+	b, err := ParseDatetime(sc, "2013-01-01 00:00:00")
+	c.Assert(err, IsNil)
+
+	c.Assert(DateDiff(b.CoreTime(), a.CoreTime()), Equals, 1)
+	c.Assert(b.CoreTime().Weekday(), Equals, gotime.Tuesday)
+	c.Assert(b.Sub(sc, &a).String(), Equals, "12:29:15")
+
+	t, err := a.Add(sc, Duration{Duration: 13 * gotime.Hour})
+	c.Assert(err, IsNil)
+	c.Assert(t.String(), Equals, "2013-01-01 00:30:45")
+	t, err = a.Add(sc, Duration{Duration: -13 * gotime.Hour})
+	c.Assert(err, IsNil)
+	c.Assert(t.String(), Equals, "2012-12-30 22:30:45")
+
+	weekTbl := []struct {
+		Input  string
+		Expect int
+	}{
+		{"2013-01-01", 0},
+		{"2013-01-06", 1},
+		{"2013-12-31", 52},
+		{"2017-01-01", 1},
+		{"2008-02-20", 7},
+	}
+	for _, test := range weekTbl {
+		t, err := ParseDate(sc, test.Input)
+		c.Assert(err, IsNil)
+		c.Assert(t.CoreTime().Week(), Equals, test.Expect, Commentf("%s", test.Input))
+	}
+
+	for _, str := range []string{"2000-02-29", "1999-12-31", "2100-03-01", "0001-01-01"} {
+		t, err := ParseDate(sc, str)
+		c.Assert(err, IsNil)
+		year, month, day := getDateFromDaynr(uint(calcDaynr(t.Year(), t.Month(), t.Day())))
+		c.Assert(int(year), Equals, t.Year())
+		c.Assert(int(month), Equals, t.Month())
+		c.Assert(int(day), Equals, t.Day())
+	}
+
+	jan31, err := ParseDate(sc, "2018-01-31")
+	c.Assert(err, IsNil)
+	goTime, err := jan31.GoTime(gotime.UTC)
+	c.Assert(err, IsNil)
+	c.Assert(AddDate(0, 1, 0, goTime).Format(DateFormat), Equals, "2018-02-28")
+	c.Assert(AddDate(0, -13, 0, goTime).Format(DateFormat), Equals, "2016-12-31")
+}
+
+func (s *testTimeSuite) TestParseDurationValue(c *C) {
+	table := []struct {
+		Unit   string
+		Format string
+		Y      int64
+		M      int64
+		D      int64
+		N      int64
+	}{
+		{"DAY", "1", 0, 0, 1, 0},
+		{"SECOND", "1.5", 0, 0, 0, int64(1500 * gotime.Millisecond)},
+		{"HOUR", "25", 0, 0, 1, int64(gotime.Hour)},
+		{"WEEK", "2", 0, 0, 14, 0},
+		{"QUARTER", "1", 0, 3, 0, 0},
+		{"YEAR_MONTH", "1-2", 1, 2, 0, 0},
+		{"YEAR_MONTH", "-1-2", -1, -2, 0, 0},
+		{"DAY_SECOND", "1 01:02:03", 0, 0, 1, int64(gotime.Hour + 2*gotime.Minute + 3*gotime.Second)},
+		{"DAY_SECOND", "01:02", 0, 0, 0, int64(gotime.Minute + 2*gotime.Second)},
+		{"SECOND_MICROSECOND", "1.5", 0, 0, 0, int64(gotime.Second + 500*gotime.Millisecond)},
+	}
+	for _, test := range table {
+		y, m, d, n, err := ParseDurationValue(test.Unit, test.Format)
+		c.Assert(err, IsNil)
+		c.Assert([]int64{y, m, d, n}, DeepEquals, []int64{test.Y, test.M, test.D, test.N}, Commentf("%s %s", test.Unit, test.Format))
+	}
+}
+
+func (s *testTimeSuite) TestExtract(c *C) {
+	sc := &stmtctx.StatementContext{TimeZone: gotime.UTC}
+	t, err := ParseDatetime(sc, "2012-12-31 11:30:45")
+	c.Assert(err, IsNil)
+	table := []struct {
+		Unit   string
+		Expect int64
+	}{
+		{"YEAR", 2012},
+		{"MONTH", 12},
+		{"DAY", 31},
+		{"YEAR_MONTH", 201212},
+		{"DAY_SECOND", 31113045},
+	}
+	for _, test := range table {
+		v, err := ExtractDatetimeNum(&t, test.Unit)
+		c.Assert(err, IsNil)
+		c.Assert(v, Equals, test.Expect, Commentf("%s", test.Unit))
+	}
+
+	d := Duration{Duration: -(10*gotime.Hour + 11*gotime.Minute)}
+	v, err := ExtractDurationNum(&d, "HOUR_MINUTE")
+	c.Assert(err, IsNil)
+	c.Assert(v, Equals, int64(-1011))
+}
+
+func (s *testTimeSuite) TestConvertTimeZone(c *C) {
+	sc := &stmtctx.StatementContext{TimeZone: gotime.UTC}
+	loc, err := gotime.LoadLocation("Asia/Shanghai")
+	c.Assert(err, IsNil)
+	t, err := ParseDatetime(sc, "2012-12-31 11:30:45")
+	c.Assert(err, IsNil)
+	c.Assert(t.ConvertTimeZone(loc, gotime.UTC), IsNil)
+	c.Assert(t.String(), Equals, "2012-12-31 03:30:45")
+	c.Assert(t.ConvertTimeZone(gotime.UTC, loc), IsNil)
+	c.Assert(t.String(), Equals, "2012-12-31 11:30:45")
+}
+
+func (s *testTimeSuite) TestIsDateFormat(c *C) {
+	c.Assert(IsDateFormat("2012-12-31"), IsTrue)
+	c.Assert(IsDateFormat("20121231"), IsTrue)
+	c.Assert(IsDateFormat("2012-12-31 11:30:45"), IsFalse)
+}
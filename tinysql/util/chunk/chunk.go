@@ -530,6 +530,24 @@ func (c *Chunk) AppendBytes(colIdx int, b []byte) {
 	c.columns[colIdx].AppendBytes(b)
 }
 
+// AppendTime appends a Time value to the chunk.
+func (c *Chunk) AppendTime(colIdx int, t types.Time) {
+	c.appendSel(colIdx)
+	c.columns[colIdx].AppendTime(t)
+}
+
+// AppendDuration appends a Duration value to the chunk.
+func (c *Chunk) AppendDuration(colIdx int, dur types.Duration) {
+	c.appendSel(colIdx)
+	c.columns[colIdx].AppendDuration(dur)
+}
+
+// AppendMyDecimal appends a MyDecimal value to the chunk.
+func (c *Chunk) AppendMyDecimal(colIdx int, dec *types.MyDecimal) {
+	c.appendSel(colIdx)
+	c.columns[colIdx].AppendMyDecimal(dec)
+}
+
 func (c *Chunk) appendSel(colIdx int) {
 	if colIdx == 0 && c.sel != nil { // use column 0 as standard
 		c.sel = append(c.sel, c.columns[0].length)
@@ -551,6 +569,12 @@ func (c *Chunk) AppendDatum(colIdx int, d *types.Datum) {
 		c.AppendFloat64(colIdx, d.GetFloat64())
 	case types.KindString, types.KindBytes:
 		c.AppendBytes(colIdx, d.GetBytes())
+	case types.KindMysqlDuration:
+		c.AppendDuration(colIdx, d.GetMysqlDuration())
+	case types.KindMysqlTime:
+		c.AppendTime(colIdx, d.GetMysqlTime())
+	case types.KindMysqlDecimal:
+		c.AppendMyDecimal(colIdx, d.GetMysqlDecimal())
 	}
 }
 
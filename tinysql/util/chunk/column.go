@@ -17,6 +17,7 @@ import (
 	"fmt"
 	"math/bits"
 	"reflect"
+	"time"
 	"unsafe"
 
 	"github.com/pingcap/tidb/types"
@@ -67,6 +68,12 @@ func (c *Column) Reset(eType types.EvalType) {
 		c.ResizeInt64(0, false)
 	case types.ETReal:
 		c.ResizeFloat64(0, false)
+	case types.ETDatetime, types.ETTimestamp:
+		c.ResizeTime(0, false)
+	case types.ETDuration:
+		c.ResizeGoDuration(0, false)
+	case types.ETDecimal:
+		c.ResizeDecimal(0, false)
 	case types.ETString:
 		c.ReserveString(0)
 	default:
@@ -187,6 +194,23 @@ func (c *Column) AppendFloat64(f float64) {
 	c.finishAppendFixed()
 }
 
+// AppendTime appends a time value into this Column.
+func (c *Column) AppendTime(t types.Time) {
+	*(*types.Time)(unsafe.Pointer(&c.elemBuf[0])) = t
+	c.finishAppendFixed()
+}
+
+// AppendDuration appends a duration value into this Column.
+func (c *Column) AppendDuration(dur types.Duration) {
+	c.AppendInt64(int64(dur.Duration))
+}
+
+// AppendMyDecimal appends a MyDecimal value into this Column.
+func (c *Column) AppendMyDecimal(dec *types.MyDecimal) {
+	*(*types.MyDecimal)(unsafe.Pointer(&c.elemBuf[0])) = *dec
+	c.finishAppendFixed()
+}
+
 func (c *Column) finishAppendVar() {
 	c.appendNullBitmap(true)
 	c.offsets = append(c.offsets, int64(len(c.data)))
@@ -206,10 +230,12 @@ func (c *Column) AppendBytes(b []byte) {
 }
 
 const (
-	sizeInt64   = int(unsafe.Sizeof(int64(0)))
-	sizeUint64  = int(unsafe.Sizeof(uint64(0)))
-	sizeFloat32 = int(unsafe.Sizeof(float32(0)))
-	sizeFloat64 = int(unsafe.Sizeof(float64(0)))
+	sizeInt64     = int(unsafe.Sizeof(int64(0)))
+	sizeUint64    = int(unsafe.Sizeof(uint64(0)))
+	sizeFloat32   = int(unsafe.Sizeof(float32(0)))
+	sizeFloat64   = int(unsafe.Sizeof(float64(0)))
+	sizeTime      = int(unsafe.Sizeof(types.ZeroTime))
+	sizeMyDecimal = types.MyDecimalStructSize
 )
 
 var (
@@ -347,6 +373,21 @@ func (c *Column) ResizeFloat64(n int, isNull bool) {
 	c.resize(n, sizeFloat64, isNull)
 }
 
+// ResizeTime resizes the column so that it contains n Time elements.
+func (c *Column) ResizeTime(n int, isNull bool) {
+	c.resize(n, sizeTime, isNull)
+}
+
+// ResizeGoDuration resizes the column so that it contains n duration elements.
+func (c *Column) ResizeGoDuration(n int, isNull bool) {
+	c.resize(n, sizeInt64, isNull)
+}
+
+// ResizeDecimal resizes the column so that it contains n decimal elements.
+func (c *Column) ResizeDecimal(n int, isNull bool) {
+	c.resize(n, sizeMyDecimal, isNull)
+}
+
 // ReserveString changes the column capacity to store n string elements and set the length to zero.
 func (c *Column) ReserveString(n int) {
 	c.reserve(n, 8)
@@ -401,6 +442,28 @@ func (c *Column) Float64s() []float64 {
 	return res
 }
 
+// Times returns a Time slice stored in this Column.
+func (c *Column) Times() []types.Time {
+	var res []types.Time
+	c.castSliceHeader((*reflect.SliceHeader)(unsafe.Pointer(&res)), sizeTime)
+	return res
+}
+
+// GoDurations returns a Golang time.Duration slice stored in this Column.
+// Different from the Row.GetDuration method, the argument Fsp is ignored, so the user should handle it outside.
+func (c *Column) GoDurations() []time.Duration {
+	var res []time.Duration
+	c.castSliceHeader((*reflect.SliceHeader)(unsafe.Pointer(&res)), sizeInt64)
+	return res
+}
+
+// Decimals returns a MyDecimal slice stored in this Column.
+func (c *Column) Decimals() []types.MyDecimal {
+	var res []types.MyDecimal
+	c.castSliceHeader((*reflect.SliceHeader)(unsafe.Pointer(&res)), sizeMyDecimal)
+	return res
+}
+
 // GetInt64 returns the int64 in the specific row.
 func (c *Column) GetInt64(rowID int) int64 {
 	return *(*int64)(unsafe.Pointer(&c.data[rowID*8]))
@@ -421,6 +484,22 @@ func (c *Column) GetFloat64(rowID int) float64 {
 	return *(*float64)(unsafe.Pointer(&c.data[rowID*8]))
 }
 
+// GetTime returns the Time in the specific row.
+func (c *Column) GetTime(rowID int) types.Time {
+	return *(*types.Time)(unsafe.Pointer(&c.data[rowID*sizeTime]))
+}
+
+// GetDuration returns the Duration in the specific row.
+func (c *Column) GetDuration(rowID int, fillFsp int) types.Duration {
+	dur := *(*int64)(unsafe.Pointer(&c.data[rowID*8]))
+	return types.Duration{Duration: time.Duration(dur), Fsp: int8(fillFsp)}
+}
+
+// GetDecimal returns the decimal in the specific row.
+func (c *Column) GetDecimal(rowID int) *types.MyDecimal {
+	return (*types.MyDecimal)(unsafe.Pointer(&c.data[rowID*sizeMyDecimal]))
+}
+
 // GetString returns the string in the specific row.
 func (c *Column) GetString(rowID int) string {
 	return string(hack.String(c.data[c.offsets[rowID]:c.offsets[rowID+1]]))
@@ -38,6 +38,12 @@ func GetCompareFunc(tp *types.FieldType) CompareFunc {
 	case mysql.TypeString, mysql.TypeVarString, mysql.TypeVarchar,
 		mysql.TypeBlob, mysql.TypeTinyBlob, mysql.TypeMediumBlob, mysql.TypeLongBlob:
 		return cmpString
+	case mysql.TypeDate, mysql.TypeDatetime, mysql.TypeTimestamp:
+		return cmpTime
+	case mysql.TypeDuration:
+		return cmpDuration
+	case mysql.TypeNewDecimal:
+		return cmpMyDecimal
 	}
 	return nil
 }
@@ -92,6 +98,33 @@ func cmpFloat64(l Row, lCol int, r Row, rCol int) int {
 	return types.CompareFloat64(l.GetFloat64(lCol), r.GetFloat64(rCol))
 }
 
+func cmpTime(l Row, lCol int, r Row, rCol int) int {
+	lNull, rNull := l.IsNull(lCol), r.IsNull(rCol)
+	if lNull || rNull {
+		return cmpNull(lNull, rNull)
+	}
+	lTime, rTime := l.GetTime(lCol), r.GetTime(rCol)
+	return lTime.Compare(rTime)
+}
+
+func cmpDuration(l Row, lCol int, r Row, rCol int) int {
+	lNull, rNull := l.IsNull(lCol), r.IsNull(rCol)
+	if lNull || rNull {
+		return cmpNull(lNull, rNull)
+	}
+	lDur, rDur := l.GetDuration(lCol, 0).Duration, r.GetDuration(rCol, 0).Duration
+	return types.CompareInt64(int64(lDur), int64(rDur))
+}
+
+func cmpMyDecimal(l Row, lCol int, r Row, rCol int) int {
+	lNull, rNull := l.IsNull(lCol), r.IsNull(rCol)
+	if lNull || rNull {
+		return cmpNull(lNull, rNull)
+	}
+	lDec, rDec := l.GetMyDecimal(lCol), r.GetMyDecimal(rCol)
+	return lDec.Compare(rDec)
+}
+
 // Compare compares the value with ad.
 func Compare(row Row, colIdx int, ad *types.Datum) int {
 	switch ad.Kind() {
@@ -117,6 +150,13 @@ func Compare(row Row, colIdx int, ad *types.Datum) int {
 		return types.CompareFloat64(row.GetFloat64(colIdx), ad.GetFloat64())
 	case types.KindString, types.KindBytes:
 		return types.CompareString(row.GetString(colIdx), ad.GetString())
+	case types.KindMysqlDuration:
+		return types.CompareInt64(int64(row.GetDuration(colIdx, 0).Duration), int64(ad.GetMysqlDuration().Duration))
+	case types.KindMysqlTime:
+		return row.GetTime(colIdx).Compare(ad.GetMysqlTime())
+	case types.KindMysqlDecimal:
+		l, r := row.GetMyDecimal(colIdx), ad.GetMysqlDecimal()
+		return l.Compare(r)
 	default:
 		return 0
 	}
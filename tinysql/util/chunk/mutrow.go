@@ -82,6 +82,16 @@ func zeroValForType(tp *types.FieldType) interface{} {
 		return ""
 	case mysql.TypeBlob, mysql.TypeTinyBlob, mysql.TypeMediumBlob, mysql.TypeLongBlob:
 		return []byte{}
+	case mysql.TypeDuration:
+		return types.ZeroDuration
+	case mysql.TypeDate:
+		return types.ZeroDate
+	case mysql.TypeDatetime:
+		return types.ZeroDatetime
+	case mysql.TypeTimestamp:
+		return types.ZeroTimestamp
+	case mysql.TypeNewDecimal:
+		return types.NewDecFromInt(0)
 	default:
 		return nil
 	}
@@ -109,6 +119,18 @@ func makeMutRowColumn(in interface{}) *Column {
 		return makeMutRowBytesColumn(hack.Slice(x))
 	case []byte:
 		return makeMutRowBytesColumn(x)
+	case types.Duration:
+		col := newMutRowFixedLenColumn(8)
+		*(*int64)(unsafe.Pointer(&col.data[0])) = int64(x.Duration)
+		return col
+	case types.Time:
+		col := newMutRowFixedLenColumn(sizeTime)
+		*(*types.Time)(unsafe.Pointer(&col.data[0])) = x
+		return col
+	case *types.MyDecimal:
+		col := newMutRowFixedLenColumn(sizeMyDecimal)
+		*(*types.MyDecimal)(unsafe.Pointer(&col.data[0])) = *x
+		return col
 	default:
 		return nil
 	}
@@ -198,6 +220,12 @@ func (mr MutRow) SetValue(colIdx int, val interface{}) {
 		setMutRowBytes(col, hack.Slice(x))
 	case []byte:
 		setMutRowBytes(col, x)
+	case types.Duration:
+		*(*int64)(unsafe.Pointer(&col.data[0])) = int64(x.Duration)
+	case types.Time:
+		*(*types.Time)(unsafe.Pointer(&col.data[0])) = x
+	case *types.MyDecimal:
+		*(*types.MyDecimal)(unsafe.Pointer(&col.data[0])) = *x
 	}
 	col.nullBitmap[0] = 1
 }
@@ -223,6 +251,12 @@ func (mr MutRow) SetDatum(colIdx int, d types.Datum) {
 		binary.LittleEndian.PutUint32(mr.c.columns[colIdx].data, math.Float32bits(d.GetFloat32()))
 	case types.KindString, types.KindBytes:
 		setMutRowBytes(col, d.GetBytes())
+	case types.KindMysqlTime:
+		*(*types.Time)(unsafe.Pointer(&col.data[0])) = d.GetMysqlTime()
+	case types.KindMysqlDuration:
+		*(*int64)(unsafe.Pointer(&col.data[0])) = int64(d.GetMysqlDuration().Duration)
+	case types.KindMysqlDecimal:
+		*(*types.MyDecimal)(unsafe.Pointer(&col.data[0])) = *d.GetMysqlDecimal()
 	default:
 		mr.c.columns[colIdx] = makeMutRowColumn(d.GetValue())
 	}
@@ -95,6 +95,12 @@ func (s *testChunkSuite) TestMutRow(c *check.C) {
 	c.Assert(row.IsNull(1), check.IsFalse)
 	c.Assert(row.GetInt64(1), check.Equals, int64(33))
 
+	mutRow = MutRowFromValues(types.NewDecFromStringForTest("-12.345"))
+	c.Assert(mutRow.ToRow().GetMyDecimal(0).String(), check.Equals, "-12.345")
+	mutRow.SetDatums(types.NewDecimalDatum(types.NewDecFromInt(7)))
+	c.Assert(mutRow.ToRow().GetMyDecimal(0).String(), check.Equals, "7")
+
+	mutRow = MutRowFromValues("abcd", 456)
 	mutRow.SetRow(MutRowFromValues("foobar", nil).ToRow())
 	row = mutRow.ToRow()
 	c.Assert(row.IsNull(0), check.IsFalse)
@@ -74,6 +74,21 @@ func (r Row) GetBytes(colIdx int) []byte {
 	return r.c.columns[colIdx].GetBytes(r.idx)
 }
 
+// GetTime returns the Time value with the colIdx.
+func (r Row) GetTime(colIdx int) types.Time {
+	return r.c.columns[colIdx].GetTime(r.idx)
+}
+
+// GetDuration returns the Duration value with the colIdx.
+func (r Row) GetDuration(colIdx int, fillFsp int) types.Duration {
+	return r.c.columns[colIdx].GetDuration(r.idx, fillFsp)
+}
+
+// GetMyDecimal returns the MyDecimal value with the colIdx.
+func (r Row) GetMyDecimal(colIdx int) *types.MyDecimal {
+	return r.c.columns[colIdx].GetDecimal(r.idx)
+}
+
 // GetDatumRow converts chunk.Row to types.DatumRow.
 // Keep in mind that GetDatumRow has a reference to r.c, which is a chunk,
 // this function works only if the underlying chunk is valid or unchanged.
@@ -116,6 +131,30 @@ func (r Row) GetDatum(colIdx int, tp *types.FieldType) types.Datum {
 		if !r.IsNull(colIdx) {
 			d.SetBytes(r.GetBytes(colIdx))
 		}
+	case mysql.TypeDate, mysql.TypeDatetime, mysql.TypeTimestamp:
+		if !r.IsNull(colIdx) {
+			d.SetMysqlTime(r.GetTime(colIdx))
+		}
+	case mysql.TypeDuration:
+		if !r.IsNull(colIdx) {
+			duration := r.GetDuration(colIdx, tp.Decimal)
+			d.SetMysqlDuration(duration)
+		}
+	case mysql.TypeNewDecimal:
+		if !r.IsNull(colIdx) {
+			dec := r.GetMyDecimal(colIdx)
+			d.SetMysqlDecimal(dec)
+			d.SetLength(tp.Flen)
+			// If tp.Decimal is unspecified(-1), we should set it to the real
+			// fraction length of the decimal value, if not, the d.Frac will
+			// be set to MAX_UINT16 which will cause unexpected BadNumber error
+			// when encoding.
+			if tp.Decimal == types.UnspecifiedLength {
+				d.SetFrac(int(dec.GetDigitsFrac()))
+			} else {
+				d.SetFrac(tp.Decimal)
+			}
+		}
 	}
 	return d
 }
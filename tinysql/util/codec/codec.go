@@ -24,6 +24,7 @@ import (
 
 	"github.com/pingcap/errors"
 	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/parser/terror"
 	"github.com/pingcap/tidb/sessionctx/stmtctx"
 	"github.com/pingcap/tidb/types"
 	"github.com/pingcap/tidb/util/chunk"
@@ -54,12 +55,14 @@ func preRealloc(b []byte, vals []types.Datum, comparable bool) []byte {
 	var size int
 	for i := range vals {
 		switch vals[i].Kind() {
-		case types.KindInt64, types.KindUint64:
+		case types.KindInt64, types.KindUint64, types.KindMysqlTime, types.KindMysqlDuration:
 			size += sizeInt(comparable)
 		case types.KindString, types.KindBytes:
 			size += sizeBytes(vals[i].GetBytes(), comparable)
 		case types.KindFloat32, types.KindFloat64:
 			size += 9
+		case types.KindMysqlDecimal:
+			size += 1 + types.MyDecimalStructSize
 		case types.KindNull, types.KindMinNotNull, types.KindMaxValue:
 			size += 1
 		default:
@@ -84,6 +87,26 @@ func encode(sc *stmtctx.StatementContext, b []byte, vals []types.Datum, comparab
 			b = EncodeFloat(b, vals[i].GetFloat64())
 		case types.KindString, types.KindBytes:
 			b = encodeBytes(b, vals[i].GetBytes(), comparable)
+		case types.KindMysqlTime:
+			b, err = encodeTime(sc, b, vals[i].GetMysqlTime(), comparable)
+			if err != nil {
+				return b, err
+			}
+		case types.KindMysqlDuration:
+			// duration may have negative value, so we cannot use String to encode directly.
+			b = append(b, durationFlag)
+			b = EncodeInt(b, int64(vals[i].GetMysqlDuration().Duration))
+		case types.KindMysqlDecimal:
+			b = append(b, decimalFlag)
+			b, err = EncodeDecimal(b, vals[i].GetMysqlDecimal(), vals[i].Length(), vals[i].Frac())
+			if terror.ErrorEqual(err, types.ErrTruncated) {
+				err = sc.HandleTruncate(err)
+			} else if terror.ErrorEqual(err, types.ErrOverflow) {
+				err = sc.HandleOverflow(err, err)
+			}
+			if err != nil {
+				return b, err
+			}
 		case types.KindNull:
 			b = append(b, NilFlag)
 		case types.KindMinNotNull:
@@ -98,6 +121,21 @@ func encode(sc *stmtctx.StatementContext, b []byte, vals []types.Datum, comparab
 	return b, errors.Trace(err)
 }
 
+// encodeTime encodes a time value as its packed uint, a timestamp is converted to UTC first,
+// so the encoded value doesn't depend on the time zone of the session.
+func encodeTime(sc *stmtctx.StatementContext, b []byte, t types.Time, comparable bool) ([]byte, error) {
+	if t.Type() == mysql.TypeTimestamp && sc.TimeZone != nil && sc.TimeZone != time.UTC {
+		if err := t.ConvertTimeZone(sc.TimeZone, time.UTC); err != nil {
+			return b, errors.Trace(err)
+		}
+	}
+	v, err := t.ToPackedUint()
+	if err != nil {
+		return b, errors.Trace(err)
+	}
+	return encodeUnsignedInt(b, v, comparable), nil
+}
+
 // EstimateValueSize uses to estimate the value  size of the encoded values.
 func EstimateValueSize(sc *stmtctx.StatementContext, val types.Datum) (int, error) {
 	l := 0
@@ -106,6 +144,20 @@ func EstimateValueSize(sc *stmtctx.StatementContext, val types.Datum) (int, erro
 		l = valueSizeOfSignedInt(val.GetInt64())
 	case types.KindUint64:
 		l = valueSizeOfUnsignedInt(val.GetUint64())
+	case types.KindMysqlTime:
+		v, err := val.GetMysqlTime().ToPackedUint()
+		if err != nil {
+			return 0, errors.Trace(err)
+		}
+		l = valueSizeOfUnsignedInt(v)
+	case types.KindMysqlDuration:
+		l = 9
+	case types.KindMysqlDecimal:
+		precision, frac := val.Length(), val.Frac()
+		if precision == 0 {
+			precision, frac = val.GetMysqlDecimal().PrecisionAndFrac()
+		}
+		l = 3 + types.DecimalBinSize(precision, frac)
 	case types.KindFloat32, types.KindFloat64:
 		l = 9
 	case types.KindString, types.KindBytes:
@@ -233,6 +285,25 @@ func encodeHashChunkRowIdx(sc *stmtctx.StatementContext, row chunk.Row, tp *type
 	case mysql.TypeVarchar, mysql.TypeVarString, mysql.TypeString, mysql.TypeBlob, mysql.TypeTinyBlob, mysql.TypeMediumBlob, mysql.TypeLongBlob:
 		flag = compactBytesFlag
 		b = row.GetBytes(idx)
+	case mysql.TypeDate, mysql.TypeDatetime, mysql.TypeTimestamp:
+		flag = uintFlag
+		var v uint64
+		v, err = row.GetTime(idx).ToPackedUint()
+		if err != nil {
+			return
+		}
+		b = (*[unsafe.Sizeof(v)]byte)(unsafe.Pointer(&v))[:]
+	case mysql.TypeDuration:
+		flag = durationFlag
+		// duration may have negative value, so we cannot use String to encode directly.
+		b = row.GetRaw(idx)
+	case mysql.TypeNewDecimal:
+		flag = decimalFlag
+		// If hash is true, we only consider the original value of this decimal and ignore it's precision.
+		b, err = row.GetMyDecimal(idx).ToHashKey()
+		if err != nil {
+			return
+		}
 	default:
 		return 0, nil, errors.Errorf("unsupport column type for encode %d", tp.Tp)
 	}
@@ -326,6 +397,72 @@ func HashChunkSelected(sc *stmtctx.StatementContext, h []hash.Hash64, chk *chunk
 				b = column.GetBytes(i)
 			}
 
+			// As the golang doc described, `Hash.Write` never returns an error.
+			// See https://golang.org/pkg/hash/#Hash
+			_, _ = h[i].Write(buf)
+			_, _ = h[i].Write(b)
+		}
+	case mysql.TypeDate, mysql.TypeDatetime, mysql.TypeTimestamp:
+		ts := column.Times()
+		for i, t := range ts {
+			if sel != nil && !sel[i] {
+				continue
+			}
+			if column.IsNull(i) {
+				buf[0], b = NilFlag, nil
+				isNull[i] = true
+			} else {
+				buf[0] = uintFlag
+				var v uint64
+				v, err = t.ToPackedUint()
+				if err != nil {
+					return
+				}
+				b = (*[sizeUint64]byte)(unsafe.Pointer(&v))[:]
+			}
+
+			// As the golang doc described, `Hash.Write` never returns an error.
+			// See https://golang.org/pkg/hash/#Hash
+			_, _ = h[i].Write(buf)
+			_, _ = h[i].Write(b)
+		}
+	case mysql.TypeDuration:
+		for i := 0; i < rows; i++ {
+			if sel != nil && !sel[i] {
+				continue
+			}
+			if column.IsNull(i) {
+				buf[0], b = NilFlag, nil
+				isNull[i] = true
+			} else {
+				buf[0] = durationFlag
+				// duration may have negative value, so we cannot use String to encode directly.
+				b = column.GetRaw(i)
+			}
+
+			// As the golang doc described, `Hash.Write` never returns an error.
+			// See https://golang.org/pkg/hash/#Hash
+			_, _ = h[i].Write(buf)
+			_, _ = h[i].Write(b)
+		}
+	case mysql.TypeNewDecimal:
+		ds := column.Decimals()
+		for i := range ds {
+			if sel != nil && !sel[i] {
+				continue
+			}
+			if column.IsNull(i) {
+				buf[0], b = NilFlag, nil
+				isNull[i] = true
+			} else {
+				buf[0] = decimalFlag
+				// If hash is true, we only consider the original value of this decimal and ignore it's precision.
+				b, err = ds[i].ToHashKey()
+				if err != nil {
+					return
+				}
+			}
+
 			// As the golang doc described, `Hash.Write` never returns an error.
 			// See https://golang.org/pkg/hash/#Hash
 			_, _ = h[i].Write(buf)
@@ -480,6 +617,25 @@ func DecodeOne(b []byte) (remain []byte, d types.Datum, err error) {
 		var v []byte
 		b, v, err = DecodeCompactBytes(b)
 		d.SetBytes(v)
+	case durationFlag:
+		var r int64
+		b, r, err = DecodeInt(b)
+		if err == nil {
+			// use max fsp, let outer to do round manually.
+			v := types.Duration{Duration: time.Duration(r), Fsp: types.MaxFsp}
+			d.SetMysqlDuration(v)
+		}
+	case decimalFlag:
+		var (
+			dec             *types.MyDecimal
+			precision, frac int
+		)
+		b, dec, precision, frac, err = DecodeDecimal(b)
+		if err == nil {
+			d.SetMysqlDecimal(dec)
+			d.SetLength(precision)
+			d.SetFrac(frac)
+		}
 	case NilFlag:
 	default:
 		return b, d, errors.Errorf("invalid encoded key flag %v", flag)
@@ -542,6 +698,8 @@ func peek(b []byte) (length int, err error) {
 		l, err = peekBytes(b)
 	case compactBytesFlag:
 		l, err = peekCompactBytes(b)
+	case decimalFlag:
+		l, err = peekDecimal(b)
 	case varintFlag:
 		l, err = peekVarint(b)
 	case uvarintFlag:
@@ -680,6 +838,30 @@ func (decoder *Decoder) DecodeOne(b []byte, colIdx int, ft *types.FieldType) (re
 			return nil, errors.Trace(err)
 		}
 		chk.AppendBytes(colIdx, v)
+	case durationFlag:
+		var r int64
+		b, r, err = DecodeInt(b)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		v := types.Duration{Duration: time.Duration(r), Fsp: int8(ft.Decimal)}
+		chk.AppendDuration(colIdx, v)
+	case decimalFlag:
+		var dec *types.MyDecimal
+		var frac int
+		b, dec, _, frac, err = DecodeDecimal(b)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if ft.Decimal != types.UnspecifiedLength && frac > ft.Decimal {
+			to := new(types.MyDecimal)
+			err = dec.Round(to, ft.Decimal, types.ModeHalfUp)
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			dec = to
+		}
+		chk.AppendMyDecimal(colIdx, dec)
 	case NilFlag:
 		chk.AppendNull(colIdx)
 	default:
@@ -696,7 +878,23 @@ func appendIntToChunk(val int64, chk *chunk.Chunk, colIdx int, ft *types.FieldTy
 }
 
 func appendUintToChunk(val uint64, chk *chunk.Chunk, colIdx int, ft *types.FieldType, loc *time.Location) error {
-	chk.AppendUint64(colIdx, val)
+	switch ft.Tp {
+	case mysql.TypeDate, mysql.TypeDatetime, mysql.TypeTimestamp:
+		t := types.NewTime(types.ZeroCoreTime, ft.Tp, int8(ft.Decimal))
+		err := t.FromPackedUint(val)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if ft.Tp == mysql.TypeTimestamp && loc != nil && loc != time.UTC {
+			err = t.ConvertTimeZone(time.UTC, loc)
+			if err != nil {
+				return errors.Trace(err)
+			}
+		}
+		chk.AppendTime(colIdx, t)
+	default:
+		chk.AppendUint64(colIdx, val)
+	}
 	return nil
 }
 
@@ -739,6 +937,43 @@ func HashGroupKey(sc *stmtctx.StatementContext, n int, col *chunk.Column, buf []
 				buf[i] = encodeBytes(buf[i], col.GetBytes(i), false)
 			}
 		}
+	case types.ETDatetime, types.ETTimestamp:
+		ts := col.Times()
+		for i := 0; i < n; i++ {
+			if col.IsNull(i) {
+				buf[i] = append(buf[i], NilFlag)
+			} else {
+				v, err := ts[i].ToPackedUint()
+				if err != nil {
+					return nil, errors.Trace(err)
+				}
+				buf[i] = encodeUnsignedInt(buf[i], v, false)
+			}
+		}
+	case types.ETDuration:
+		ds := col.GoDurations()
+		for i := 0; i < n; i++ {
+			if col.IsNull(i) {
+				buf[i] = append(buf[i], NilFlag)
+			} else {
+				buf[i] = append(buf[i], durationFlag)
+				buf[i] = EncodeInt(buf[i], int64(ds[i]))
+			}
+		}
+	case types.ETDecimal:
+		ds := col.Decimals()
+		for i := 0; i < n; i++ {
+			if col.IsNull(i) {
+				buf[i] = append(buf[i], NilFlag)
+			} else {
+				buf[i] = append(buf[i], decimalFlag)
+				b, err := ds[i].ToHashKey()
+				if err != nil {
+					return nil, errors.Trace(err)
+				}
+				buf[i] = append(buf[i], b...)
+			}
+		}
 	default:
 		return nil, errors.New(fmt.Sprintf("invalid eval type %v", ft.EvalType()))
 	}
@@ -441,6 +441,104 @@ func (s *testCodecSuite) TestFloatCodec(c *C) {
 	}
 }
 
+func (s *testCodecSuite) TestDecimal(c *C) {
+	defer testleak.AfterTest(c)()
+	tbl := []string{
+		"1234.00",
+		"1234",
+		"12.34",
+		"12.340",
+		"0.1234",
+		"0.0",
+		"0",
+		"-0.0",
+		"-0.0000",
+		"-1234.00",
+		"-1234",
+		"-12.34",
+		"-12.340",
+		"-0.1234",
+	}
+
+	for _, t := range tbl {
+		dec := new(types.MyDecimal)
+		err := dec.FromString([]byte(t))
+		c.Assert(err, IsNil)
+		b, err := EncodeDecimal(nil, dec, 20, 6)
+		c.Assert(err, IsNil)
+		_, v, precision, frac, err := DecodeDecimal(b)
+		c.Assert(err, IsNil)
+		c.Assert(precision, Equals, 20)
+		c.Assert(frac, Equals, 6)
+		c.Assert(v.Compare(dec), Equals, 0)
+	}
+
+	tblCmp := []struct {
+		Arg1 string
+		Arg2 string
+		Ret  int
+	}{
+		{"1234", "123400", -1},
+		{"12340", "123400", -1},
+		{"1234", "1234.5", -1},
+		{"1234", "1234.0000", 0},
+		{"1234", "12.34", 1},
+		{"12.34", "12.35", -1},
+		{"0.12", "0.1234", -1},
+		{"0.1234", "12.3400", -1},
+		{"0.1234", "0.1235", -1},
+		{"0.123400", "12.34", -1},
+		{"12.34000", "12.34", 0},
+		{"0.01", "0.01", 0},
+		{"0.00", "0", 0},
+		{"-0.0", "0", 0},
+		{"-12.34", "12.34", -1},
+		{"-1", "0", -1},
+		{"-0.0001", "0", -1},
+		{"-1234", "-1234.5", 1},
+		{"-12.34", "-12.35", 1},
+		{"-0.1234", "-12.3400", 1},
+		{"-0.1234", "-0.1235", 1},
+	}
+	for _, t := range tblCmp {
+		d1 := types.NewDecFromStringForTest(t.Arg1)
+		d2 := types.NewDecFromStringForTest(t.Arg2)
+		b1, err := EncodeDecimal(nil, d1, 20, 6)
+		c.Assert(err, IsNil)
+		b2, err := EncodeDecimal(nil, d2, 20, 6)
+		c.Assert(err, IsNil)
+
+		ret := bytes.Compare(b1, b2)
+		c.Assert(ret, Equals, t.Ret, Commentf("%v %v", t.Arg1, t.Arg2))
+	}
+
+	sc := &stmtctx.StatementContext{TimeZone: time.Local}
+	d := types.NewDecimalDatum(types.NewDecFromStringForTest("-123.456"))
+	d.SetLength(10)
+	d.SetFrac(3)
+	b, err := EncodeKey(sc, nil, d)
+	c.Assert(err, IsNil)
+	size, err := EstimateValueSize(sc, d)
+	c.Assert(err, IsNil)
+	c.Assert(len(b), Equals, size)
+	data, remain, err := CutOne(b)
+	c.Assert(err, IsNil)
+	c.Assert(len(data), Equals, len(b))
+	c.Assert(remain, HasLen, 0)
+	_, v, err := DecodeOne(b)
+	c.Assert(err, IsNil)
+	c.Assert(v.Kind(), Equals, types.KindMysqlDecimal)
+	c.Assert(v.GetMysqlDecimal().String(), Equals, "-123.456")
+	c.Assert(v.Length(), Equals, 10)
+	c.Assert(v.Frac(), Equals, 3)
+
+	// Values that don't fit the precision are rejected in a strict statement.
+	d.SetLength(4)
+	d.SetFrac(3)
+	_, err = EncodeKey(sc, nil, d)
+	c.Assert(err, NotNil)
+}
+
 func (s *testCodecSuite) TestBytes(c *C) {
 	defer testleak.AfterTest(c)()
 	tblBytes := [][]byte{
@@ -641,6 +739,11 @@ func datumsForTest(sc *stmtctx.StatementContext) ([]types.Datum, []*types.FieldT
 		{[]byte("abc"), types.NewFieldType(mysql.TypeMediumBlob)},
 		{[]byte("abc"), types.NewFieldType(mysql.TypeLongBlob)},
 		{int64(1), types.NewFieldType(mysql.TypeYear)},
+		{types.NewTime(types.FromDate(2012, 12, 31, 0, 0, 0, 0), mysql.TypeDate, 0), &types.FieldType{Tp: mysql.TypeDate}},
+		{types.NewTime(types.FromDate(2012, 12, 31, 11, 30, 45, 123000), mysql.TypeDatetime, 3), &types.FieldType{Tp: mysql.TypeDatetime, Decimal: 3}},
+		{types.NewTime(types.FromDate(2012, 12, 31, 11, 30, 45, 0), mysql.TypeTimestamp, 0), &types.FieldType{Tp: mysql.TypeTimestamp}},
+		{types.Duration{Duration: 11*time.Hour + 30*time.Minute, Fsp: 0}, &types.FieldType{Tp: mysql.TypeDuration}},
+		{types.NewDecFromStringForTest("-123.45"), &types.FieldType{Tp: mysql.TypeNewDecimal, Flen: 10, Decimal: 2}},
 	}
 
 	datums := make([]types.Datum, 0, len(table)+2)
@@ -766,6 +869,9 @@ func (s *testCodecSuite) TestHashChunkRow(c *C) {
 
 	testHashChunkRowEqual(c, "x", []byte("x"), true)
 	testHashChunkRowEqual(c, "x", []byte("y"), false)
+
+	testHashChunkRowEqual(c, types.NewDecFromStringForTest("1.1"), types.NewDecFromStringForTest("1.10"), true)
+	testHashChunkRowEqual(c, types.NewDecFromStringForTest("1.1"), types.NewDecFromStringForTest("1.01"), false)
 }
 
 func (s *testCodecSuite) TestValueSizeOfSignedInt(c *C) {
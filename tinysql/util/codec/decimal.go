@@ -0,0 +1,65 @@
+// Copyright 2015 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codec
+
+import (
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/types"
+)
+
+// EncodeDecimal encodes a decimal into a byte slice which can be sorted lexicographically later.
+// The precision and frac are written in front of the binary form, so values encoded with the same
+// precision and frac keep their order. If precision is 0, the decimal's own precision and frac are used.
+func EncodeDecimal(b []byte, dec *types.MyDecimal, precision, frac int) ([]byte, error) {
+	if precision == 0 {
+		precision, frac = dec.PrecisionAndFrac()
+	}
+	b = append(b, byte(precision), byte(frac))
+	bin, err := dec.ToBin(precision, frac)
+	b = append(b, bin...)
+	return b, errors.Trace(err)
+}
+
+// DecodeDecimal decodes bytes to decimal, it returns the remained bytes, the decimal and its precision and frac.
+func DecodeDecimal(b []byte) ([]byte, *types.MyDecimal, int, int, error) {
+	if len(b) < 3 {
+		return b, nil, 0, 0, errors.New("insufficient bytes to decode value")
+	}
+	precision := int(b[0])
+	frac := int(b[1])
+	b = b[2:]
+	dec := new(types.MyDecimal)
+	binSize, err := dec.FromBin(b, precision, frac)
+	if err != nil {
+		return b, nil, precision, frac, errors.Trace(err)
+	}
+	return b[binSize:], dec, precision, frac, nil
+}
+
+// peekDecimal returns the length of the encoded decimal at the head of b.
+func peekDecimal(b []byte) (int, error) {
+	if len(b) < 3 {
+		return 0, errors.New("insufficient bytes to decode value")
+	}
+	precision := int(b[0])
+	frac := int(b[1])
+	if precision <= 0 || frac > precision {
+		return 0, errors.Errorf("invalid decimal precision %d and frac %d", precision, frac)
+	}
+	l := 2 + types.DecimalBinSize(precision, frac)
+	if l > len(b) {
+		return 0, errors.New("insufficient bytes to decode value")
+	}
+	return l, nil
+}
@@ -24,6 +24,8 @@ import (
 	"github.com/pingcap/tidb/expression"
 	"github.com/pingcap/tidb/kv"
 	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/mysql"
 	plannercore "github.com/pingcap/tidb/planner/core"
 	"github.com/pingcap/tidb/session"
 	"github.com/pingcap/tidb/sessionctx"
@@ -31,6 +33,7 @@ import (
 	"github.com/pingcap/tidb/store/mockstore"
 	"github.com/pingcap/tidb/store/mockstore/mocktikv"
 	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/mock"
 	"github.com/pingcap/tidb/util/ranger"
 	"github.com/pingcap/tidb/util/testkit"
 	"github.com/pingcap/tidb/util/testleak"
@@ -911,3 +914,84 @@ func (s *testRangerSuite) TestColumnRange(c *C) {
 		c.Assert(got, Equals, tt.resultStr, Commentf("different for expr %s, col: %v", tt.exprStr, col))
 	}
 }
+
+func (s *testRangerSuite) TestIndexRangeForTime(c *C) {
+	defer testleak.AfterTest(c)()
+	sctx := mock.NewContext()
+	datetimeTp := types.NewFieldType(mysql.TypeDatetime)
+	datetimeTp.Decimal = 0
+	dateTp := types.NewFieldType(mysql.TypeDate)
+	cols := []*expression.Column{
+		{UniqueID: 1, RetType: datetimeTp},
+		{UniqueID: 2, RetType: dateTp},
+	}
+
+	tests := []struct {
+		colPos    int
+		funcName  string
+		value     string
+		resultStr string
+	}{
+		// The fractional seconds are rounded by the datetime(0) column, the range is adjusted by the rounding.
+		{
+			colPos:    0,
+			funcName:  ast.GE,
+			value:     "2017-01-05 08:40:59.2",
+			resultStr: "[(2017-01-05 08:40:59,+inf]]",
+		},
+		{
+			colPos:    0,
+			funcName:  ast.LE,
+			value:     "2017-01-05 08:40:59.2",
+			resultStr: "[[-inf,2017-01-05 08:40:59]]",
+		},
+		{
+			colPos:    0,
+			funcName:  ast.GT,
+			value:     "2017-01-05 08:40:59.575601",
+			resultStr: "[[2017-01-05 08:41:00,+inf]]",
+		},
+		{
+			colPos:    0,
+			funcName:  ast.LT,
+			value:     "2017-01-05 08:40:59.575601",
+			resultStr: "[[-inf,2017-01-05 08:41:00)]",
+		},
+		{
+			colPos:    0,
+			funcName:  ast.EQ,
+			value:     "2017-01-05 08:40:59",
+			resultStr: "[[2017-01-05 08:40:59,2017-01-05 08:40:59]]",
+		},
+		// The time part is truncated by the date column.
+		{
+			colPos:    1,
+			funcName:  ast.GE,
+			value:     "2017-01-05 10:00:00",
+			resultStr: "[(2017-01-05,+inf]]",
+		},
+		{
+			colPos:    1,
+			funcName:  ast.LT,
+			value:     "2017-01-05 10:00:00",
+			resultStr: "[[-inf,2017-01-05]]",
+		},
+		{
+			colPos:    1,
+			funcName:  ast.EQ,
+			value:     "2017-01-05",
+			resultStr: "[[2017-01-05,2017-01-05]]",
+		},
+	}
+
+	for _, tt := range tests {
+		col := cols[tt.colPos]
+		con := &expression.Constant{Value: types.NewStringDatum(tt.value), RetType: types.NewFieldType(mysql.TypeVarString)}
+		cond := expression.NewFunctionInternal(sctx, tt.funcName, types.NewFieldType(mysql.TypeLonglong), col, con)
+		res, err := ranger.DetachCondAndBuildRangeForIndex(sctx, []expression.Expression{cond}, []*expression.Column{col}, []int{types.UnspecifiedLength})
+		c.Assert(err, IsNil)
+		c.Assert(res.AccessConds, HasLen, 1, Commentf("for %s %s", tt.funcName, tt.value))
+		got := fmt.Sprintf("%v", res.Ranges)
+		c.Assert(got, Equals, tt.resultStr, Commentf("different for %s %s", tt.funcName, tt.value))
+	}
+}
@@ -33,6 +33,23 @@ type decoder struct {
 	loc         *time.Location
 }
 
+// decodeTime decodes the packed time value, a timestamp is stored in UTC and
+// converted to the time zone of the decoder.
+func (decoder *decoder) decodeTime(col *ColInfo, colData []byte) (types.Time, error) {
+	t := types.NewTime(types.ZeroCoreTime, uint8(col.Tp), int8(col.Decimal))
+	err := t.FromPackedUint(decodeUint(colData))
+	if err != nil {
+		return t, err
+	}
+	if byte(col.Tp) == mysql.TypeTimestamp && !t.IsZero() && decoder.loc != nil && decoder.loc != time.UTC {
+		err = t.ConvertTimeZone(time.UTC, decoder.loc)
+		if err != nil {
+			return t, err
+		}
+	}
+	return t, nil
+}
+
 // NewDecoder creates a decoder.
 func NewDecoder(columns []ColInfo, handleColID int64, loc *time.Location) *decoder {
 	return &decoder{
@@ -130,6 +147,25 @@ func (decoder *DatumMapDecoder) decodeColDatum(col *ColInfo, colData []byte) (ty
 	case mysql.TypeBit:
 		byteSize := (col.Flen + 7) >> 3
 		d.SetMysqlBit(types.NewBinaryLiteralFromUint(decodeUint(colData), byteSize))
+	case mysql.TypeDate, mysql.TypeDatetime, mysql.TypeTimestamp:
+		t, err := decoder.decodeTime(col, colData)
+		if err != nil {
+			return d, err
+		}
+		d.SetMysqlTime(t)
+	case mysql.TypeDuration:
+		var dur types.Duration
+		dur.Duration = time.Duration(decodeInt(colData))
+		dur.Fsp = int8(col.Decimal)
+		d.SetMysqlDuration(dur)
+	case mysql.TypeNewDecimal:
+		_, dec, precision, frac, err := codec.DecodeDecimal(colData)
+		if err != nil {
+			return d, err
+		}
+		d.SetMysqlDecimal(dec)
+		d.SetLength(precision)
+		d.SetFrac(frac)
 	default:
 		return d, errors.Errorf("unknown type %d", col.Tp)
 	}
@@ -223,6 +259,31 @@ func (decoder *ChunkDecoder) decodeColToChunk(colIdx int, col *ColInfo, colData
 	case mysql.TypeBit:
 		byteSize := (col.Flen + 7) >> 3
 		chk.AppendBytes(colIdx, types.NewBinaryLiteralFromUint(decodeUint(colData), byteSize))
+	case mysql.TypeDate, mysql.TypeDatetime, mysql.TypeTimestamp:
+		t, err := decoder.decodeTime(col, colData)
+		if err != nil {
+			return err
+		}
+		chk.AppendTime(colIdx, t)
+	case mysql.TypeDuration:
+		var dur types.Duration
+		dur.Duration = time.Duration(decodeInt(colData))
+		dur.Fsp = int8(col.Decimal)
+		chk.AppendDuration(colIdx, dur)
+	case mysql.TypeNewDecimal:
+		_, dec, _, frac, err := codec.DecodeDecimal(colData)
+		if err != nil {
+			return err
+		}
+		if col.Decimal != types.UnspecifiedLength && frac > col.Decimal {
+			to := new(types.MyDecimal)
+			err := dec.Round(to, col.Decimal, types.ModeHalfUp)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			dec = to
+		}
+		chk.AppendMyDecimal(colIdx, dec)
 	default:
 		return errors.Errorf("unknown type %d", col.Tp)
 	}
@@ -311,6 +311,14 @@ func (s *testSuite) TestTypesNewRowCodec(c *C) {
 			nil,
 			false,
 		},
+		{
+			120,
+			withFlenAndDecimal(types.NewFieldType(mysql.TypeNewDecimal), 10, 2),
+			getDecimalDatum("-12.34", 10, 2),
+			getDecimalDatum("-12.34", 10, 2),
+			nil,
+			false,
+		},
 	}
 
 	// test small
@@ -560,6 +568,17 @@ var (
 		ft.Flag = ft.Flag | mysql.UnsignedFlag
 		return ft
 	}
+	withFlenAndDecimal = func(ft *types.FieldType, flen, decimal int) *types.FieldType {
+		ft.Flen = flen
+		ft.Decimal = decimal
+		return ft
+	}
+	getDecimalDatum = func(str string, length, frac int) types.Datum {
+		d := types.NewDecimalDatum(types.NewDecFromStringForTest(str))
+		d.SetLength(length)
+		d.SetFrac(frac)
+		return d
+	}
 	getOldDatumByte = func(d types.Datum) []byte {
 		b, err := tablecodec.EncodeValue(nil, nil, d)
 		if err != nil {